@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"backend/internal/config"
+	"backend/internal/ent"
+	"backend/internal/ent/refreshtoken"
+	"backend/internal/ent/session"
+	"backend/internal/ent/user"
+	"backend/internal/rbac"
+
+	_ "github.com/lib/pq"
+
+	// 注册 schema 中的默认值与 hook
+	_ "backend/internal/ent/runtime"
+)
+
+// grant-role 直接修改用户角色，用于在没有任何管理员时引导出第一个管理员。
+//
+//	go run ./cmd/grant-role -phone 13800000000 -role admin
+func main() {
+	phone := flag.String("phone", "", "phone number of the target user")
+	role := flag.String("role", "", "role to grant: user, moderator or admin")
+	logout := flag.Bool("logout", false, "revoke all sessions of the user so the new role applies immediately")
+	flag.Parse()
+
+	if *phone == "" || !rbac.ValidRole(*role) {
+		flag.Usage()
+		log.Fatalf("both -phone and a valid -role are required")
+	}
+
+	if err := config.Load(); err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	client, err := ent.Open("postgres", config.GetDBDSN())
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	u, err := client.User.Query().Where(user.PhoneEQ(*phone)).Only(ctx)
+	if err != nil {
+		log.Fatalf("failed to find user %s: %v", *phone, err)
+	}
+
+	if err := client.User.UpdateOne(u).SetRole(user.Role(*role)).Exec(ctx); err != nil {
+		log.Fatalf("failed to update role: %v", err)
+	}
+	log.Printf("user %d (%s): %s -> %s", u.ID, u.Phone, u.Role, *role)
+
+	if !*logout {
+		log.Println("existing tokens keep the old role until their next refresh")
+		return
+	}
+
+	now := time.Now()
+	if err := client.Session.Update().
+		Where(session.UserIDEQ(u.ID), session.RevokedAtIsNil()).
+		SetRevokedAt(now).
+		Exec(ctx); err != nil {
+		log.Fatalf("failed to revoke sessions: %v", err)
+	}
+	if err := client.RefreshToken.Update().
+		Where(refreshtoken.UserIDEQ(u.ID), refreshtoken.RevokedAtIsNil()).
+		SetRevokedAt(now).
+		Exec(ctx); err != nil {
+		log.Fatalf("failed to revoke refresh tokens: %v", err)
+	}
+	log.Println("all sessions revoked")
+}
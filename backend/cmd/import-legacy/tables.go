@@ -0,0 +1,217 @@
+package main
+
+import (
+	"fmt"
+
+	"backend/internal/ent/comment"
+	"backend/internal/ent/follow"
+	"backend/internal/ent/post"
+	"backend/internal/ent/posttag"
+	"backend/internal/ent/question"
+	"backend/internal/ent/questiontag"
+	"backend/internal/ent/user"
+	"backend/internal/ent/userfavoritepost"
+	"backend/internal/ent/userfavoritequestion"
+	"backend/internal/ent/userlikepost"
+	"backend/internal/ent/userlikequestion"
+)
+
+// table 描述一张旧表如何写入 ent 的表。旧库的列名是 Prisma 的驼峰写法，需要加引号；
+// Prisma 中 @map 过的时间列沿用 created_at / updated_at。
+type table struct {
+	// name 是 ent 中的表名，同时作为进度记录的键
+	name   string
+	legacy string
+	// key 是旧表的主键列，按它分批读取和续传，必须排在 columns 最前面且都是整数
+	key     []string
+	columns []string
+	// target 与 convert 之后的每一列一一对应
+	target []string
+	// conflict 是目标表上的主键或唯一键，update 是冲突时用旧库的值覆盖的列，为空时保留已有的行
+	conflict []string
+	update   []string
+	// convert 改写一行旧数据，返回 false 表示跳过这一行；为 nil 时原样写入
+	convert func(imp *importer, row []any) ([]any, bool, error)
+	// ids 为 true 表示沿用旧库的自增 id，导入后要把目标表的序列推到最大 id 之后
+	ids bool
+}
+
+// 旧库的时间列都可以为空。创建时间缺失时用更新时间补上，反之亦然，两者都没有时用导入时刻。
+// 文章和问题的 updated_at 是不带时区的 timestamp(3)，Prisma 写入的是 UTC。
+const (
+	userCreated  = `COALESCE(created_at, updated_at, now())`
+	userUpdated  = `COALESCE(updated_at, created_at, now())`
+	itemCreated  = `COALESCE(created_at, updated_at AT TIME ZONE 'UTC', now())`
+	itemUpdated  = `COALESCE(updated_at AT TIME ZONE 'UTC', created_at, now())`
+	plainCreated = `COALESCE(created_at, now())`
+)
+
+// tables 按外键依赖排列。标签由 importTags 单独处理，在这些表之前执行。
+var tables = []table{
+	{
+		name:     user.Table,
+		legacy:   "users",
+		key:      []string{"id"},
+		columns:  []string{"id", "phone", "nickname", "password", "avatar", userCreated, userUpdated},
+		target:   []string{user.FieldID, user.FieldPhone, user.FieldNickname, user.FieldPassword, user.FieldAvatar, user.FieldCreateTime, user.FieldUpdateTime},
+		conflict: []string{user.FieldID},
+		update:   []string{user.FieldPhone, user.FieldNickname, user.FieldPassword, user.FieldAvatar, user.FieldUpdateTime},
+		ids:      true,
+	},
+	{
+		name:     post.Table,
+		legacy:   "posts",
+		key:      []string{"id"},
+		columns:  []string{"id", "title", "content", `"userId"`, itemCreated, itemUpdated, "embedding::text"},
+		target:   []string{post.FieldID, post.FieldTitle, post.FieldContent, post.FieldUserID, post.FieldCreateTime, post.FieldUpdateTime, post.FieldEmbedding},
+		conflict: []string{post.FieldID},
+		update:   []string{post.FieldTitle, post.FieldContent, post.FieldUserID, post.FieldUpdateTime, post.FieldEmbedding},
+		ids:      true,
+	},
+	{
+		name:     question.Table,
+		legacy:   "questions",
+		key:      []string{"id"},
+		columns:  []string{"id", "title", `"userId"`, itemCreated, itemUpdated, "embedding::text"},
+		target:   []string{question.FieldID, question.FieldTitle, question.FieldUserID, question.FieldCreateTime, question.FieldUpdateTime, question.FieldEmbedding},
+		conflict: []string{question.FieldID},
+		update:   []string{question.FieldTitle, question.FieldUserID, question.FieldUpdateTime, question.FieldEmbedding},
+		ids:      true,
+	},
+	{
+		name:    comment.Table,
+		legacy:  "comments",
+		key:     []string{"id"},
+		columns: []string{"id", "content", `"userId"`, `"postId"`, `"questionId"`, plainCreated},
+		target: []string{
+			comment.FieldID, comment.FieldContent, comment.FieldUserID, comment.FieldPostID, comment.FieldQuestionID,
+			comment.FieldParentID, comment.FieldDepth, comment.FieldReplyToUserID, comment.FieldCreateTime, comment.FieldUpdateTime,
+		},
+		conflict: []string{comment.FieldID},
+		update: []string{
+			comment.FieldContent, comment.FieldUserID, comment.FieldPostID, comment.FieldQuestionID,
+			comment.FieldParentID, comment.FieldDepth, comment.FieldReplyToUserID,
+		},
+		convert: (*importer).convertComment,
+		ids:     true,
+	},
+	{
+		name:     follow.Table,
+		legacy:   "follows",
+		key:      []string{`"followerId"`, `"followingId"`},
+		columns:  []string{`"followerId"`, `"followingId"`, plainCreated},
+		target:   []string{follow.FieldFollowerID, follow.FieldFollowingID, follow.FieldCreateTime, follow.FieldUpdateTime},
+		conflict: []string{follow.FieldFollowerID, follow.FieldFollowingID},
+		convert:  (*importer).convertFollow,
+	},
+	{
+		name:     posttag.Table,
+		legacy:   "post_tags",
+		key:      []string{`"postId"`, `"tagId"`},
+		columns:  []string{`"postId"`, `"tagId"`},
+		target:   []string{posttag.FieldPostID, posttag.FieldTagID},
+		conflict: []string{posttag.FieldPostID, posttag.FieldTagID},
+		convert:  (*importer).convertTagLink,
+	},
+	{
+		name:     questiontag.Table,
+		legacy:   "question_tags",
+		key:      []string{`"questionId"`, `"tagId"`},
+		columns:  []string{`"questionId"`, `"tagId"`},
+		target:   []string{questiontag.FieldQuestionID, questiontag.FieldTagID},
+		conflict: []string{questiontag.FieldQuestionID, questiontag.FieldTagID},
+		convert:  (*importer).convertTagLink,
+	},
+	{
+		name:     userlikepost.Table,
+		legacy:   "user_like_posts",
+		key:      []string{`"userId"`, `"postId"`},
+		columns:  []string{`"userId"`, `"postId"`},
+		target:   []string{userlikepost.FieldUserID, userlikepost.FieldPostID},
+		conflict: []string{userlikepost.FieldUserID, userlikepost.FieldPostID},
+	},
+	{
+		name:     userfavoritepost.Table,
+		legacy:   "user_favorite_posts",
+		key:      []string{`"userId"`, `"postId"`},
+		columns:  []string{`"userId"`, `"postId"`},
+		target:   []string{userfavoritepost.FieldUserID, userfavoritepost.FieldPostID},
+		conflict: []string{userfavoritepost.FieldUserID, userfavoritepost.FieldPostID},
+	},
+	{
+		name:     userlikequestion.Table,
+		legacy:   "user_like_questions",
+		key:      []string{`"userId"`, `"questionId"`},
+		columns:  []string{`"userId"`, `"questionId"`},
+		target:   []string{userlikequestion.FieldUserID, userlikequestion.FieldQuestionID},
+		conflict: []string{userlikequestion.FieldUserID, userlikequestion.FieldQuestionID},
+	},
+	{
+		name:     userfavoritequestion.Table,
+		legacy:   "user_favorite_questions",
+		key:      []string{`"userId"`, `"questionId"`},
+		columns:  []string{`"userId"`, `"questionId"`},
+		target:   []string{userfavoritequestion.FieldUserID, userfavoritequestion.FieldQuestionID},
+		conflict: []string{userfavoritequestion.FieldUserID, userfavoritequestion.FieldQuestionID},
+	},
+}
+
+// convertFollow 跳过自己关注自己的记录，新表有 CHECK 约束禁止这种数据。更新时间取关注时间。
+func (imp *importer) convertFollow(row []any) ([]any, bool, error) {
+	if row[0] == row[1] {
+		return nil, false, nil
+	}
+	return []any{row[0], row[1], row[2], row[2]}, true, nil
+}
+
+// convertTagLink 把标签关联中的旧标签 id 换成合并后的标签 id，名称规范化后为空的标签被丢弃，关联一并跳过。
+func (imp *importer) convertTagLink(row []any) ([]any, bool, error) {
+	id, ok := imp.tagIDs[row[1].(int64)]
+	if !ok {
+		return nil, false, nil
+	}
+	return []any{row[0], id}, true, nil
+}
+
+// commentNode 是旧库评论树中的一个节点。
+type commentNode struct {
+	parent int64
+	user   int64
+}
+
+// convertComment 按 CommentService 的规则重新安排楼层：旧库的回复可以无限嵌套，
+// 超过最大层数的回复挂到最深一层，与在新服务中回复最深一层评论的结果相同；回复都记下被回复的用户。
+func (imp *importer) convertComment(row []any) ([]any, bool, error) {
+	id := row[0].(int64)
+	node, ok := imp.comments[id]
+	if !ok {
+		return nil, false, fmt.Errorf("comment %d was not loaded, rerun the import", id)
+	}
+
+	// 从父评论往上找到根，ancestors[k] 是第 k+1 层的祖先
+	var ancestors []int64
+	for p := node.parent; p != 0; {
+		ancestors = append([]int64{p}, ancestors...)
+		if len(ancestors) > len(imp.comments) {
+			return nil, false, fmt.Errorf("comment %d: cycle in parent chain", id)
+		}
+		n, ok := imp.comments[p]
+		if !ok {
+			return nil, false, fmt.Errorf("comment %d: parent %d does not exist", id, p)
+		}
+		p = n.parent
+	}
+
+	var parent, replyTo any
+	depth := len(ancestors) + 1
+	if depth > 1 {
+		replyTo = imp.comments[node.parent].user
+		if depth > imp.maxDepth {
+			depth = imp.maxDepth
+		}
+		if depth > 1 {
+			parent = ancestors[depth-2]
+		}
+	}
+	return []any{row[0], row[1], row[2], row[3], row[4], parent, depth, replyTo, row[5], row[5]}, true, nil
+}
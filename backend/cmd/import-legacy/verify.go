@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"text/tabwriter"
+
+	"backend/internal/ent/tag"
+)
+
+// verifyShow 是每张表最多打印的缺失行数。
+const verifyShow = 10
+
+// verify 逐行核对旧库的每一行在目标库中都有对应的行，输出每张表的核对结果，返回缺失的行数。
+// 目标库按 conflict 中的唯一键查找：保留 id 的表比对 id，关联表比对两端的外键。
+// legacy 是旧库行数，skipped 是按规则不导入的行数，target 是目标库现有的行数，
+// 合并标签、新服务上线后产生的数据都会让它与 legacy 不同。
+func (imp *importer) verify(ctx context.Context, out io.Writer) (int, error) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "table\tlegacy\tskipped\tmissing\ttarget")
+
+	tags, err := imp.importTags(ctx, false)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", tag.Table, err)
+	}
+	var tagCount int
+	if err := imp.target.QueryRowContext(ctx, `SELECT COUNT(*) FROM `+tag.Table).Scan(&tagCount); err != nil {
+		return 0, fmt.Errorf("%s: %w", tag.Table, err)
+	}
+	fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\n", tag.Table, tags.legacy, tags.skipped, tags.missing, tagCount)
+	missing := tags.missing
+
+	for _, t := range tables {
+		s, err := imp.verifyTable(ctx, t)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", t.name, err)
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\n", t.name, s.legacy, s.skipped, s.missing, s.target)
+		missing += s.missing
+	}
+	return missing, w.Flush()
+}
+
+type tableStats struct {
+	legacy  int
+	skipped int
+	missing int
+	target  int
+}
+
+// verifyTable 先把目标表的唯一键全部读进内存，再按导入时同样的方式读取、转换旧表，逐行查找。
+func (imp *importer) verifyTable(ctx context.Context, t table) (tableStats, error) {
+	var s tableStats
+	present, err := imp.targetKeys(ctx, t)
+	if err != nil {
+		return s, err
+	}
+	s.target = len(present)
+
+	// conflict 中的列在 convert 结果里的位置
+	pos := make([]int, len(t.conflict))
+	for i, c := range t.conflict {
+		pos[i] = -1
+		for j, name := range t.target {
+			if name == c {
+				pos[i] = j
+			}
+		}
+		if pos[i] < 0 {
+			return s, fmt.Errorf("conflict column %s is not imported", c)
+		}
+	}
+
+	var last []int64
+	for {
+		rows, err := imp.readBatch(ctx, t, last)
+		if err != nil {
+			return s, err
+		}
+		if len(rows) == 0 {
+			return s, nil
+		}
+		for _, row := range rows {
+			s.legacy++
+			values, ok, err := imp.convert(t, row)
+			if err != nil {
+				return s, err
+			}
+			if !ok {
+				s.skipped++
+				continue
+			}
+			key := make([]any, len(pos))
+			for i, p := range pos {
+				key[i] = values[p]
+			}
+			if _, ok := present[rowKey(key)]; ok {
+				continue
+			}
+			if s.missing < verifyShow {
+				log.Printf("%s: legacy row %v is missing (%s = %v)", t.name, keyOf(t, row), strings.Join(t.conflict, ", "), key)
+			}
+			s.missing++
+		}
+		last = keyOf(t, rows[len(rows)-1])
+	}
+}
+
+func (imp *importer) targetKeys(ctx context.Context, t table) (map[string]struct{}, error) {
+	rows, err := imp.target.QueryContext(ctx, fmt.Sprintf(`SELECT %s FROM %s`, strings.Join(t.conflict, ", "), t.name))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := make(map[string]struct{})
+	for rows.Next() {
+		key := make([]any, len(t.conflict))
+		dest := make([]any, len(key))
+		for i := range key {
+			dest[i] = &key[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		keys[rowKey(key)] = struct{}{}
+	}
+	return keys, rows.Err()
+}
+
+// rowKey 把一组整数列拼成 map 的键，两个库读出来的都是 int64。
+func rowKey(values []any) string {
+	return fmt.Sprint(values...)
+}
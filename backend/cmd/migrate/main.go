@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 
+	"backend/internal/dbmigrate"
 	"backend/internal/ent/migrate"
 
 	"entgo.io/ent/dialect"
@@ -21,9 +22,9 @@ func main() {
 	defer db.Close()
 
 	ctx := context.Background()
-	_, err = db.ExecContext(ctx, "CREATE EXTENSION IF NOT EXISTS vector")
-	if err != nil {
-		log.Printf("warning: could not create vector extension: %v", err)
+	// 创建 vector、pg_trgm 扩展并整理历史数据，之后才能建 vector 列和相关索引
+	if err := dbmigrate.BeforeSchema(ctx, db.DB()); err != nil {
+		log.Fatalf("failed to prepare database: %v", err)
 	}
 
 	schema := migrate.NewSchema(db)
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"backend/internal/config"
+	"backend/internal/ent/hook"
+
+	_ "github.com/lib/pq"
+)
+
+// reconcile-counters 从关系表重新统计冗余计数，报告与计数列不一致的记录，加 -fix 时直接修正。
+// 新增计数列上线后先执行一次 -fix 回填历史数据。不带 -fix 时发现偏差以状态码 1 退出，便于定时巡检。
+//
+//	go run ./cmd/reconcile-counters
+//	go run ./cmd/reconcile-counters -fix
+func main() {
+	fix := flag.Bool("fix", false, "overwrite drifted counters with recomputed values")
+	show := flag.Int("show", 10, "number of drifted rows to print per counter")
+	flag.Parse()
+
+	if err := config.Load(); err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	db, err := sql.Open("postgres", config.GetDBDSN())
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	drifted := 0
+	for _, c := range hook.AllCounters {
+		n, err := report(ctx, db, c, *show)
+		if err != nil {
+			log.Fatalf("%s.%s: %v", c.Target, c.Field, err)
+		}
+		drifted += n
+		if n == 0 || !*fix {
+			continue
+		}
+		fixed, err := repair(ctx, db, c)
+		if err != nil {
+			log.Fatalf("%s.%s: failed to fix: %v", c.Target, c.Field, err)
+		}
+		log.Printf("%s.%s: fixed %d rows", c.Target, c.Field, fixed)
+	}
+
+	if drifted == 0 {
+		log.Println("all counters are consistent")
+		return
+	}
+	if !*fix {
+		log.Printf("%d rows drifted, run with -fix to repair", drifted)
+		os.Exit(1)
+	}
+}
+
+// driftQuery 选出计数列与关系表实际行数不一致的记录：id、当前计数、实际数量。评论墓碑等不计数的行不算在实际数量里。
+func driftQuery(c hook.Counter) string {
+	return fmt.Sprintf(`SELECT t.id, t.%[3]s, COALESCE(g.n, 0)
+		FROM %[2]s t
+		LEFT JOIN (SELECT %[4]s AS id, COUNT(*) AS n FROM %[1]s WHERE %[4]s IS NOT NULL AND %[5]s GROUP BY %[4]s) g ON g.id = t.id
+		WHERE t.%[3]s <> COALESCE(g.n, 0)`,
+		c.Table, c.Target, c.Field, c.Column, c.Filter(c.Table))
+}
+
+func report(ctx context.Context, db *sql.DB, c hook.Counter, show int) (int, error) {
+	rows, err := db.QueryContext(ctx, driftQuery(c)+" ORDER BY t.id")
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	n := 0
+	for rows.Next() {
+		var id, stored, actual int
+		if err := rows.Scan(&id, &stored, &actual); err != nil {
+			return 0, err
+		}
+		if n < show {
+			log.Printf("%s.%s: id=%d stored=%d actual=%d", c.Target, c.Field, id, stored, actual)
+		}
+		n++
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if n > show {
+		log.Printf("%s.%s: ... %d more", c.Target, c.Field, n-show)
+	}
+	return n, nil
+}
+
+// repair 用实际数量覆盖计数列。修正期间以 SHARE 模式锁住关系表，
+// 挡住并发的点赞、评论等写入，避免用过期的统计值覆盖 hook 刚做的增减。
+func repair(ctx context.Context, db *sql.DB, c hook.Counter) (int64, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("LOCK TABLE %s IN SHARE MODE", c.Table)); err != nil {
+		return 0, err
+	}
+	res, err := tx.ExecContext(ctx, fmt.Sprintf(`UPDATE %[1]s SET %[2]s = d.actual
+		FROM (%[3]s) AS d(id, stored, actual)
+		WHERE %[1]s.id = d.id`,
+		c.Target, c.Field, driftQuery(c)))
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return n, tx.Commit()
+}
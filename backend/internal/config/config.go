@@ -1,13 +1,26 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"net"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
 
+const (
+	// EnvDevelopment 放宽默认密钥检查，只在本地通过环境变量或自己的 .env 开启，仓库里的 .env 不设置 APP_ENV
+	EnvDevelopment = "development"
+
+	defaultJWTSecret     = "your-secret-key-change-this-in-production"
+	defaultSMSCodeSecret = "your-sms-code-secret-change-this-in-production"
+)
+
 type Config struct {
+	AppEnv     string
 	ServerPort string
 	DBHost     string
 	DBPort     string
@@ -15,6 +28,37 @@ type Config struct {
 	DBPassword string
 	DBName     string
 	JWTSecret  string
+
+	// JWTKeysDir 非空时改用其中的 RSA / Ed25519 密钥签名（RS256 / EdDSA），文件名即 kid
+	JWTKeysDir string
+	// JWTSigningKeyID 指定 JWTKeysDir 中用于签名的 kid，其余密钥只用于验签
+	JWTSigningKeyID string
+	// JWTLegacyHS256 为 true 时仍接受 JWTSecret 签发的旧 token，便于从 HS256 平滑迁移
+	JWTLegacyHS256 bool
+
+	// TrustedProxies 可信反向代理的 IP 或网段，只有来自它们的请求才采信 X-Forwarded-For / X-Real-IP，
+	// 为空时一律使用连接的对端地址，避免客户端伪造 IP 绕过按 IP 的限流
+	TrustedProxies []*net.IPNet
+
+	// LoginGuardStore 登录失败计数的存储：postgres（多实例共享）或 memory（单实例）
+	LoginGuardStore string
+
+	// SMSLogFile 非空时短信内容写入该文件，否则打印到日志
+	SMSLogFile string
+	// SMSCodeSecret 用于对验证码做 HMAC，库里只存哈希
+	SMSCodeSecret string
+
+	// CommentMaxDepth 评论楼中楼的最大层数，一级评论为第 1 层；更深的回复挂到最深一层并标注回复对象
+	CommentMaxDepth int
+
+	// EmbeddingProvider 计算文章、问题 embedding 的方式：openai（兼容 OpenAI 接口的服务）、hash（离线开发用的散列向量）或 none
+	EmbeddingProvider string
+	// EmbeddingBaseURL 兼容 OpenAI 接口的地址，不含 /embeddings，默认为 DashScope 兼容模式
+	EmbeddingBaseURL string
+	EmbeddingAPIKey  string
+	EmbeddingModel   string
+	// EmbeddingCacheSize 按内容缓存的向量条数，0 表示不缓存
+	EmbeddingCacheSize int
 }
 
 var AppConfig *Config
@@ -26,18 +70,115 @@ func Load() error {
 	}
 
 	AppConfig = &Config{
+		AppEnv:     getEnv("APP_ENV", "production"),
 		ServerPort: getEnv("SERVER_PORT", "3002"),
 		DBHost:     getEnv("DB_HOST", "localhost"),
 		DBPort:     getEnv("DB_PORT", "5433"),
 		DBUser:     getEnv("DB_USER", "postgres"),
 		DBPassword: getEnv("DB_PASSWORD", "123456"),
 		DBName:     getEnv("DB_NAME", "postgres"),
-		JWTSecret:  getEnv("JWT_SECRET", "your-secret-key-change-this-in-production"),
+		JWTSecret:  getEnv("JWT_SECRET", defaultJWTSecret),
+
+		JWTKeysDir:      getEnv("JWT_KEYS_DIR", ""),
+		JWTSigningKeyID: getEnv("JWT_SIGNING_KID", ""),
+		JWTLegacyHS256:  getEnv("JWT_LEGACY_HS256", "false") == "true",
+
+		LoginGuardStore: getEnv("LOGIN_GUARD_STORE", "postgres"),
+
+		SMSLogFile:    getEnv("SMS_LOG_FILE", ""),
+		SMSCodeSecret: getEnv("SMS_CODE_SECRET", defaultSMSCodeSecret),
+
+		EmbeddingBaseURL: getEnv("EMBEDDING_BASE_URL", "https://dashscope.aliyuncs.com/compatible-mode/v1"),
+		EmbeddingAPIKey:  getEnv("EMBEDDING_API_KEY", os.Getenv("DASHSCOPE_API_KEY")),
+		EmbeddingModel:   getEnv("EMBEDDING_MODEL", "text-embedding-v2"),
 	}
 
+	if AppConfig.TrustedProxies, err = parseCIDRs(getEnv("TRUSTED_PROXIES", "")); err != nil {
+		return fmt.Errorf("TRUSTED_PROXIES: %w", err)
+	}
+	if AppConfig.CommentMaxDepth, err = strconv.Atoi(getEnv("COMMENT_MAX_DEPTH", "2")); err != nil {
+		return fmt.Errorf("COMMENT_MAX_DEPTH: %w", err)
+	}
+
+	// 开发环境默认用散列向量，不需要模型服务的 key；其余环境没有配置 key 时默认关闭语义检索
+	defaultProvider := "openai"
+	if AppConfig.AppEnv == EnvDevelopment {
+		defaultProvider = "hash"
+	} else if AppConfig.EmbeddingAPIKey == "" {
+		defaultProvider = "none"
+	}
+	AppConfig.EmbeddingProvider = getEnv("EMBEDDING_PROVIDER", defaultProvider)
+	if AppConfig.EmbeddingCacheSize, err = strconv.Atoi(getEnv("EMBEDDING_CACHE_SIZE", "1000")); err != nil {
+		return fmt.Errorf("EMBEDDING_CACHE_SIZE: %w", err)
+	}
+
+	return AppConfig.validate()
+}
+
+// validate 校验配置，并拒绝在非开发环境下使用仓库里公开的默认密钥启动。
+func (c *Config) validate() error {
+	if c.JWTKeysDir != "" && c.JWTSigningKeyID == "" {
+		return errors.New("JWT_SIGNING_KID is required when JWT_KEYS_DIR is set")
+	}
+	if c.LoginGuardStore != "postgres" && c.LoginGuardStore != "memory" {
+		return fmt.Errorf("LOGIN_GUARD_STORE must be postgres or memory, got %q", c.LoginGuardStore)
+	}
+	if c.CommentMaxDepth < 1 {
+		return errors.New("COMMENT_MAX_DEPTH must be at least 1")
+	}
+	switch c.EmbeddingProvider {
+	case "openai", "hash", "none":
+	default:
+		return fmt.Errorf("EMBEDDING_PROVIDER must be openai, hash or none, got %q", c.EmbeddingProvider)
+	}
+	if c.EmbeddingProvider == "openai" && c.EmbeddingAPIKey == "" {
+		return errors.New("EMBEDDING_API_KEY (or DASHSCOPE_API_KEY) is required when EMBEDDING_PROVIDER is openai")
+	}
+	if c.EmbeddingCacheSize < 0 {
+		return errors.New("EMBEDDING_CACHE_SIZE must not be negative")
+	}
+	if c.AppEnv == EnvDevelopment {
+		return nil
+	}
+	// 只用非对称密钥签名且不再接受旧 token 时，JWT_SECRET 不参与签发和验签
+	if (c.JWTKeysDir == "" || c.JWTLegacyHS256) && c.JWTSecret == defaultJWTSecret {
+		return errors.New("JWT_SECRET is still the default value; set a real secret or APP_ENV=development")
+	}
+	if c.SMSCodeSecret == defaultSMSCodeSecret {
+		return errors.New("SMS_CODE_SECRET is not set; set a real secret or APP_ENV=development")
+	}
 	return nil
 }
 
+// parseCIDRs 解析逗号分隔的网段，单个 IP 视为只含它自己的网段。
+func parseCIDRs(value string) ([]*net.IPNet, error) {
+	var out []*net.IPNet
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if !strings.Contains(item, "/") {
+			ip := net.ParseIP(item)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP %q", item)
+			}
+			bits := 128
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			out = append(out, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, cidr, err := net.ParseCIDR(item)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, cidr)
+	}
+	return out, nil
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -0,0 +1,201 @@
+// Package dbmigrate 存放 ent 自动迁移之前需要执行的数据修正。
+// 自动迁移只会加列、加索引，遇到不满足新约束的历史数据会直接失败，
+// 这里先把数据整理好。每一步都必须可以重复执行。
+package dbmigrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"backend/internal/pgvector"
+)
+
+type step struct {
+	name string
+	run  func(ctx context.Context, db *sql.DB) error
+}
+
+var steps = []step{
+	{name: "enable pgvector", run: enableVector},
+	{name: "enable pg_trgm", run: enableTrigram},
+	{name: "dedupe interactions", run: dedupeInteractions},
+	{name: "clean follows", run: cleanFollows},
+	{name: "normalize tags", run: normalizeTags},
+	{name: "drop stray edge columns", run: dropStrayColumns},
+	{name: "clean orphan rows", run: cleanOrphans},
+}
+
+// BeforeSchema 依次执行所有数据修正，应在 client.Schema.Create 之前调用。
+func BeforeSchema(ctx context.Context, db *sql.DB) error {
+	for _, s := range steps {
+		if err := s.run(ctx, db); err != nil {
+			return fmt.Errorf("%s: %w", s.name, err)
+		}
+	}
+	return nil
+}
+
+// dedupeInteractions 删除重复的点赞、收藏记录，每组只保留最早的一条，
+// 之后才能建立 (user_id, post_id)/(user_id, question_id) 唯一索引。
+func dedupeInteractions(ctx context.Context, db *sql.DB) error {
+	tables := []struct {
+		name   string
+		target string
+	}{
+		{"user_like_posts", "post_id"},
+		{"user_favorite_posts", "post_id"},
+		{"user_like_questions", "question_id"},
+		{"user_favorite_questions", "question_id"},
+	}
+	for _, t := range tables {
+		exists, err := tableExists(ctx, db, t.name)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+		res, err := db.ExecContext(ctx, fmt.Sprintf(
+			`DELETE FROM %[1]s a USING %[1]s b
+			WHERE a.user_id = b.user_id AND a.%[2]s = b.%[2]s AND a.id > b.id`,
+			t.name, t.target,
+		))
+		if err != nil {
+			return fmt.Errorf("%s: %w", t.name, err)
+		}
+		if n, _ := res.RowsAffected(); n > 0 {
+			log.Printf("dbmigrate: removed %d duplicate rows from %s", n, t.name)
+		}
+	}
+	return nil
+}
+
+// cleanFollows 删除自己关注自己的记录和重复的关注，之后才能加上唯一索引和 CHECK 约束。
+func cleanFollows(ctx context.Context, db *sql.DB) error {
+	exists, err := tableExists(ctx, db, "follows")
+	if err != nil || !exists {
+		return err
+	}
+	res, err := db.ExecContext(ctx, `DELETE FROM follows WHERE follower_id = following_id`)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		log.Printf("dbmigrate: removed %d self-follows", n)
+	}
+	res, err = db.ExecContext(ctx, `DELETE FROM follows a USING follows b
+		WHERE a.follower_id = b.follower_id AND a.following_id = b.following_id AND a.id > b.id`)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		log.Printf("dbmigrate: removed %d duplicate follows", n)
+	}
+	return nil
+}
+
+// normalizeTags 把历史标签名改成规范写法（与 service 中的 NormalizeTagName 一致），
+// 规范化后重名的标签合并到 id 最小的一个，关联改指过去并去掉重复关联。
+// 规范化后为空的标签保持原样。标签计数列由 cmd/reconcile-counters -fix 回填。
+func normalizeTags(ctx context.Context, db *sql.DB) error {
+	for _, name := range []string{"tags", "post_tags", "question_tags"} {
+		exists, err := tableExists(ctx, db, name)
+		if err != nil || !exists {
+			return err
+		}
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `CREATE TEMP TABLE tag_norm ON COMMIT DROP AS
+		SELECT id, norm, min(id) OVER (PARTITION BY norm) AS keep
+		FROM (
+			SELECT id, lower(regexp_replace(btrim(ltrim(btrim(name), '#')), '\s+', ' ', 'g')) AS norm
+			FROM tags
+		) t
+		WHERE norm <> ''`); err != nil {
+		return err
+	}
+	for _, t := range []struct{ table, parent string }{
+		{"post_tags", "post_id"},
+		{"question_tags", "question_id"},
+	} {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(
+			`UPDATE %s r SET tag_id = n.keep FROM tag_norm n WHERE r.tag_id = n.id AND n.id <> n.keep`,
+			t.table,
+		)); err != nil {
+			return fmt.Errorf("%s: %w", t.table, err)
+		}
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(
+			`DELETE FROM %[1]s a USING %[1]s b
+			WHERE a.%[2]s = b.%[2]s AND a.tag_id = b.tag_id AND a.id > b.id`,
+			t.table, t.parent,
+		)); err != nil {
+			return fmt.Errorf("%s: %w", t.table, err)
+		}
+	}
+	res, err := tx.ExecContext(ctx, `DELETE FROM tags t USING tag_norm n WHERE t.id = n.id AND n.id <> n.keep`)
+	if err != nil {
+		return err
+	}
+	merged, _ := res.RowsAffected()
+	res, err = tx.ExecContext(ctx, `UPDATE tags t SET name = n.norm FROM tag_norm n WHERE t.id = n.id AND t.name <> n.norm`)
+	if err != nil {
+		return err
+	}
+	renamed, _ := res.RowsAffected()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	if merged > 0 || renamed > 0 {
+		log.Printf("dbmigrate: merged %d duplicate tags, renamed %d tags", merged, renamed)
+	}
+	return nil
+}
+
+// enableVector 创建 vector 扩展，并把 embedding 列改成 schema 中的 vector(N)。
+// 旧的 bytea 列或维度不同的 vector 列无法直接转换，改类型时清空，之后重新生成 embedding。
+func enableVector(ctx context.Context, db *sql.DB) error {
+	if _, err := db.ExecContext(ctx, `CREATE EXTENSION IF NOT EXISTS vector`); err != nil {
+		return fmt.Errorf("pgvector is required: %w", err)
+	}
+	want := fmt.Sprintf("vector(%d)", pgvector.Dimensions)
+	for _, table := range []string{"posts", "questions"} {
+		var typ string
+		err := db.QueryRowContext(ctx, `SELECT format_type(atttypid, atttypmod) FROM pg_attribute
+			WHERE attrelid = to_regclass($1) AND attname = 'embedding' AND NOT attisdropped`, table).Scan(&typ)
+		if err == sql.ErrNoRows || typ == want {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", table, err)
+		}
+		if _, err := db.ExecContext(ctx, fmt.Sprintf(
+			`ALTER TABLE %s ALTER COLUMN embedding TYPE %s USING NULL`, table, want,
+		)); err != nil {
+			return fmt.Errorf("%s: %w", table, err)
+		}
+		log.Printf("dbmigrate: converted %s.embedding from %s to %s, embeddings need to be regenerated", table, typ, want)
+	}
+	return nil
+}
+
+// enableTrigram 创建 pg_trgm 扩展，标题、正文上的 gin_trgm_ops 索引依赖它。
+func enableTrigram(ctx context.Context, db *sql.DB) error {
+	if _, err := db.ExecContext(ctx, `CREATE EXTENSION IF NOT EXISTS pg_trgm`); err != nil {
+		return fmt.Errorf("pg_trgm is required: %w", err)
+	}
+	return nil
+}
+
+func tableExists(ctx context.Context, db *sql.DB, name string) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx, `SELECT to_regclass($1) IS NOT NULL`, name).Scan(&exists)
+	return exists, err
+}
@@ -0,0 +1,201 @@
+package dbmigrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+)
+
+// strayColumn 是早期 schema 的边没有绑定外键字段时 ent 额外生成的列。
+// 值应当写到 target 表的 field 列：target 与 table 相同时是同一行上的另一列；
+// 不同时多余列保存的是 target 表某一行的 id，那一行的 field 应指回本行。
+type strayColumn struct {
+	table  string
+	column string
+	target string
+	field  string
+}
+
+var strayColumns = []strayColumn{
+	{"comments", "post_comments", "comments", "post_id"},
+	{"comments", "question_comments", "comments", "question_id"},
+	{"comments", "user_comments", "comments", "user_id"},
+	{"follows", "user_following", "follows", "follower_id"},
+	{"follows", "user_followed_by", "follows", "following_id"},
+	{"posts", "user_posts", "posts", "user_id"},
+	{"questions", "user_questions", "questions", "user_id"},
+	{"post_tags", "post_tags", "post_tags", "post_id"},
+	{"post_tags", "tag_posts", "post_tags", "tag_id"},
+	{"question_tags", "question_tags", "question_tags", "question_id"},
+	{"question_tags", "tag_questions", "question_tags", "tag_id"},
+	{"user_like_posts", "post_likes", "user_like_posts", "post_id"},
+	{"user_like_posts", "user_like_posts", "user_like_posts", "user_id"},
+	{"user_favorite_posts", "post_favorites", "user_favorite_posts", "post_id"},
+	{"user_favorite_posts", "user_favorite_posts", "user_favorite_posts", "user_id"},
+	{"user_like_questions", "question_likes", "user_like_questions", "question_id"},
+	{"user_like_questions", "user_like_questions", "user_like_questions", "user_id"},
+	{"user_favorite_questions", "question_favorites", "user_favorite_questions", "question_id"},
+	{"user_favorite_questions", "user_favorite_questions", "user_favorite_questions", "user_id"},
+
+	{"posts", "comment_post", "comments", "post_id"},
+	{"posts", "post_tag_post", "post_tags", "post_id"},
+	{"posts", "user_like_post_post", "user_like_posts", "post_id"},
+	{"posts", "user_favorite_post_post", "user_favorite_posts", "post_id"},
+	{"questions", "comment_question", "comments", "question_id"},
+	{"questions", "question_tag_question", "question_tags", "question_id"},
+	{"questions", "user_like_question_question", "user_like_questions", "question_id"},
+	{"questions", "user_favorite_question_question", "user_favorite_questions", "question_id"},
+	{"tags", "post_tag_tag", "post_tags", "tag_id"},
+	{"tags", "question_tag_tag", "question_tags", "tag_id"},
+	{"users", "post_user", "posts", "user_id"},
+	{"users", "question_user", "questions", "user_id"},
+	{"users", "comment_user", "comments", "user_id"},
+	{"users", "follow_follower", "follows", "follower_id"},
+	{"users", "follow_following", "follows", "following_id"},
+	{"users", "user_like_post_user", "user_like_posts", "user_id"},
+	{"users", "user_favorite_post_user", "user_favorite_posts", "user_id"},
+	{"users", "user_like_question_user", "user_like_questions", "user_id"},
+	{"users", "user_favorite_question_user", "user_favorite_questions", "user_id"},
+}
+
+// foreignKey 是 schema 中绑定到字段的外键。cascade 为 false 时对应 ON DELETE SET NULL。
+// 按依赖顺序排列：先清理父表的孤儿行，子表随后才能发现指向它们的引用。
+type foreignKey struct {
+	table   string
+	column  string
+	ref     string
+	cascade bool
+}
+
+var foreignKeys = []foreignKey{
+	{"posts", "user_id", "users", false},
+	{"questions", "user_id", "users", false},
+	{"answers", "question_id", "questions", true},
+	{"answers", "user_id", "users", true},
+	{"questions", "accepted_answer_id", "answers", false},
+	{"answer_votes", "answer_id", "answers", true},
+	{"answer_votes", "user_id", "users", true},
+	{"comments", "user_id", "users", true},
+	{"comments", "post_id", "posts", true},
+	{"comments", "question_id", "questions", true},
+	{"comments", "answer_id", "answers", true},
+	{"comments", "parent_id", "comments", false},
+	{"comments", "reply_to_user_id", "users", false},
+	{"follows", "follower_id", "users", true},
+	{"follows", "following_id", "users", true},
+	{"post_tags", "post_id", "posts", true},
+	{"post_tags", "tag_id", "tags", true},
+	{"question_tags", "question_id", "questions", true},
+	{"question_tags", "tag_id", "tags", true},
+	{"user_like_posts", "user_id", "users", true},
+	{"user_like_posts", "post_id", "posts", true},
+	{"user_favorite_posts", "user_id", "users", true},
+	{"user_favorite_posts", "post_id", "posts", true},
+	{"user_like_questions", "user_id", "users", true},
+	{"user_like_questions", "question_id", "questions", true},
+	{"user_favorite_questions", "user_id", "users", true},
+	{"user_favorite_questions", "question_id", "questions", true},
+	{"sessions", "user_id", "users", true},
+	{"refresh_tokens", "session_id", "sessions", true},
+	{"refresh_tokens", "user_id", "users", true},
+}
+
+// dropStrayColumns 把多余列里的值补到真正的外键字段上（字段已有值时以字段为准），然后删掉多余列。
+// 多余列上的外键约束与列一起删除，之后 ent 才能以同样的约束名在外键字段上建约束。
+func dropStrayColumns(ctx context.Context, db *sql.DB) error {
+	for _, c := range strayColumns {
+		exists, err := columnExists(ctx, db, c.table, c.column)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		var res sql.Result
+		if c.table == c.target {
+			res, err = tx.ExecContext(ctx, fmt.Sprintf(
+				`UPDATE %[1]s SET %[3]s = %[2]s WHERE %[3]s IS NULL AND %[2]s IS NOT NULL`,
+				c.table, c.column, c.field,
+			))
+		} else {
+			res, err = tx.ExecContext(ctx, fmt.Sprintf(
+				`UPDATE %[3]s t SET %[4]s = s.id FROM %[1]s s WHERE s.%[2]s = t.id AND t.%[4]s IS NULL`,
+				c.table, c.column, c.target, c.field,
+			))
+		}
+		if err == nil {
+			_, err = tx.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE %s DROP COLUMN %s`, c.table, c.column))
+		}
+		if err == nil {
+			err = tx.Commit()
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("%s.%s: %w", c.table, c.column, err)
+		}
+		n, _ := res.RowsAffected()
+		log.Printf("dbmigrate: dropped %s.%s, copied %d values into %s.%s", c.table, c.column, n, c.target, c.field)
+	}
+	return nil
+}
+
+// cleanOrphans 处理外键字段上指向不存在记录的值：级联外键删掉整行，SET NULL 外键清空该列。
+// 已经建好外键约束的列不会有孤儿，直接跳过。直接删除的行不经过计数 hook，之后应执行一次 cmd/reconcile-counters -fix。
+func cleanOrphans(ctx context.Context, db *sql.DB) error {
+	for _, fk := range foreignKeys {
+		exists, err := columnExists(ctx, db, fk.table, fk.column)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+		constrained, err := hasForeignKey(ctx, db, fk.table, fk.column)
+		if err != nil {
+			return err
+		}
+		if constrained {
+			continue
+		}
+
+		orphan := fmt.Sprintf(`%[1]s.%[2]s IS NOT NULL AND NOT EXISTS (SELECT 1 FROM %[3]s r WHERE r.id = %[1]s.%[2]s)`,
+			fk.table, fk.column, fk.ref)
+		query := fmt.Sprintf(`UPDATE %s SET %s = NULL WHERE %s`, fk.table, fk.column, orphan)
+		if fk.cascade {
+			query = fmt.Sprintf(`DELETE FROM %s WHERE %s`, fk.table, orphan)
+		}
+		res, err := db.ExecContext(ctx, query)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", fk.table, fk.column, err)
+		}
+		if n, _ := res.RowsAffected(); n > 0 {
+			log.Printf("dbmigrate: fixed %d rows in %s whose %s points to a missing %s row", n, fk.table, fk.column, fk.ref)
+		}
+	}
+	return nil
+}
+
+func columnExists(ctx context.Context, db *sql.DB, table, column string) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx, `SELECT EXISTS (
+		SELECT 1 FROM pg_attribute
+		WHERE attrelid = to_regclass($1) AND attname = $2 AND NOT attisdropped
+	)`, table, column).Scan(&exists)
+	return exists, err
+}
+
+func hasForeignKey(ctx context.Context, db *sql.DB, table, column string) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx, `SELECT EXISTS (
+		SELECT 1 FROM pg_constraint c
+		JOIN pg_attribute a ON a.attrelid = c.conrelid AND a.attnum = ANY (c.conkey)
+		WHERE c.conrelid = to_regclass($1) AND c.contype = 'f' AND a.attname = $2
+	)`, table, column).Scan(&exists)
+	return exists, err
+}
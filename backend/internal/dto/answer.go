@@ -0,0 +1,25 @@
+package dto
+
+type CreateAnswerRequest struct {
+	Content string `json:"content" vd:"len($)>0; msg:'回答不能为空'"`
+}
+
+type UpdateAnswerRequest struct {
+	Content string `json:"content" vd:"len($)>0; msg:'回答不能为空'"`
+}
+
+// AnswerListQuery 是回答列表的参数，sort 为 votes（默认）按票数排序，为 time 按发布时间排序。
+type AnswerListQuery struct {
+	Sort   string `query:"sort" vd:"$=='' || $=='votes' || $=='time'; msg:'sort 只能是 votes 或 time'"`
+	Cursor string `query:"cursor"`
+	Limit  int    `query:"limit" vd:"$>=0 && $<=50; msg:'limit 需在1到50之间'"`
+}
+
+// VoteAnswerRequest 中 value 为 1 赞成、-1 反对、0 取消投票。
+type VoteAnswerRequest struct {
+	Value int `json:"value" vd:"$>=-1 && $<=1; msg:'value 只能是 1、-1 或 0'"`
+}
+
+type AcceptAnswerRequest struct {
+	AnswerID int `json:"answerId" vd:"$>0; msg:'answerId 无效'"`
+}
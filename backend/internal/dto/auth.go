@@ -1,10 +1,29 @@
 package dto
 
 type LoginRequest struct {
-	Phone    string `json:"phone" binding:"required,len=11"`
-	Password string `json:"password" binding:"required,min=6"`
+	Phone    string `json:"phone" vd:"len($)==11; msg:'手机号必须是11位'"`
+	Password string `json:"password" vd:"len($)>=6 && len($)<=72; msg:'密码长度需在6到72位之间'"`
+	// Device 客户端自报的设备名，仅用于会话列表展示
+	Device string `json:"device"`
 }
 
 type RefreshRequest struct {
-	RefreshToken string `json:"refresh_token" binding:"required"`
+	RefreshToken string `json:"refresh_token" vd:"len($)>0; msg:'refresh_token 不能为空'"`
+}
+
+type SendCodeRequest struct {
+	Phone   string `json:"phone" vd:"regexp('^1\\d{10}$'); msg:'手机号必须是11位'"`
+	Purpose string `json:"purpose" vd:"in($,'login','reset_password'); msg:'purpose 只能是 login 或 reset_password'"`
+}
+
+type CodeLoginRequest struct {
+	Phone  string `json:"phone" vd:"regexp('^1\\d{10}$'); msg:'手机号必须是11位'"`
+	Code   string `json:"code" vd:"regexp('^\\d{6}$'); msg:'验证码必须是6位数字'"`
+	Device string `json:"device"`
+}
+
+type ResetPasswordRequest struct {
+	Phone    string `json:"phone" vd:"regexp('^1\\d{10}$'); msg:'手机号必须是11位'"`
+	Code     string `json:"code" vd:"regexp('^\\d{6}$'); msg:'验证码必须是6位数字'"`
+	Password string `json:"password" vd:"len($)>=6 && len($)<=72; msg:'密码长度需在6到72位之间'"`
 }
@@ -0,0 +1,18 @@
+package dto
+
+type CreateCommentRequest struct {
+	Content string `json:"content" vd:"len($)>0 && len($)<=5000; msg:'评论不能为空且不能超过5000个字节'"`
+	// ParentID 为 0 表示一级评论，否则是对该评论的回复
+	ParentID int `json:"parentId"`
+}
+
+type UpdateCommentRequest struct {
+	Content string `json:"content" vd:"len($)>0 && len($)<=5000; msg:'评论不能为空且不能超过5000个字节'"`
+}
+
+// CommentListQuery 是一级评论的分页参数，replies 为每条一级评论附带的回复条数，不传使用默认值。
+type CommentListQuery struct {
+	Cursor  string `query:"cursor"`
+	Limit   int    `query:"limit" vd:"$>=0 && $<=50; msg:'limit 需在1到50之间'"`
+	Replies *int   `query:"replies" vd:"$==nil || ($>=0 && $<=20); msg:'replies 需在0到20之间'"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+// FeedQuery 是信息流的游标分页参数，cursor 取自上一页响应的 nextCursor。
+type FeedQuery struct {
+	Cursor string `query:"cursor"`
+	Limit  int    `query:"limit" vd:"$>=0 && $<=50; msg:'limit 需在1到50之间'"`
+}
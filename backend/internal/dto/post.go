@@ -0,0 +1,14 @@
+package dto
+
+type CreatePostRequest struct {
+	Title   string   `json:"title" vd:"len($)>0 && len($)<=255; msg:'标题不能为空且不能超过255个字节'"`
+	Content string   `json:"content" vd:"len($)>0; msg:'正文不能为空'"`
+	Tags    []string `json:"tags"`
+}
+
+// UpdatePostRequest 整体替换文章内容，tags 为空表示清空标签。
+type UpdatePostRequest struct {
+	Title   string   `json:"title" vd:"len($)>0 && len($)<=255; msg:'标题不能为空且不能超过255个字节'"`
+	Content string   `json:"content" vd:"len($)>0; msg:'正文不能为空'"`
+	Tags    []string `json:"tags"`
+}
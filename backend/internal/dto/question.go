@@ -0,0 +1,21 @@
+package dto
+
+type CreateQuestionRequest struct {
+	Title string   `json:"title" vd:"len($)>0 && len($)<=255; msg:'标题不能为空且不能超过255个字节'"`
+	Body  string   `json:"body"`
+	Tags  []string `json:"tags"`
+}
+
+// UpdateQuestionRequest 整体替换问题内容，tags 为空表示清空标签。
+type UpdateQuestionRequest struct {
+	Title string   `json:"title" vd:"len($)>0 && len($)<=255; msg:'标题不能为空且不能超过255个字节'"`
+	Body  string   `json:"body"`
+	Tags  []string `json:"tags"`
+}
+
+// QuestionFeedQuery 是问题列表的参数，filter 为 unanswered 只看无人回答的问题，为 unaccepted 只看未采纳回答的问题。
+type QuestionFeedQuery struct {
+	Cursor string `query:"cursor"`
+	Limit  int    `query:"limit" vd:"$>=0 && $<=50; msg:'limit 需在1到50之间'"`
+	Filter string `query:"filter" vd:"$=='' || $=='unanswered' || $=='unaccepted'; msg:'filter 只能是 unanswered 或 unaccepted'"`
+}
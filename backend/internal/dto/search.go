@@ -0,0 +1,17 @@
+package dto
+
+// SearchQuery 是搜索参数。type 默认为 post；mode 默认为 hybrid，lexical 只做关键词匹配；
+// page 从 1 开始，结果最多翻到第 200 条。
+type SearchQuery struct {
+	Keyword string `query:"keyword" vd:"len($)<=100; msg:'keyword 不能超过100个字符'"`
+	Type    string `query:"type" vd:"$=='' || $=='post' || $=='question'; msg:'type 只能是 post 或 question'"`
+	Mode    string `query:"mode" vd:"$=='' || $=='hybrid' || $=='lexical'; msg:'mode 只能是 hybrid 或 lexical'"`
+	Page    int    `query:"page" vd:"$>=0; msg:'page 需大于0'"`
+	Limit   int    `query:"limit" vd:"$>=0 && $<=50; msg:'limit 需在1到50之间'"`
+}
+
+// SuggestQuery 是搜索建议参数，limit 默认为 7。
+type SuggestQuery struct {
+	Keyword string `query:"keyword" vd:"len($)<=100; msg:'keyword 不能超过100个字符'"`
+	Limit   int    `query:"limit" vd:"$>=0 && $<=10; msg:'limit 需在1到10之间'"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+// TagSuggestQuery 是标签自动补全的参数，q 为用户已输入的前缀。
+type TagSuggestQuery struct {
+	Q     string `query:"q"`
+	Limit int    `query:"limit" vd:"$>=0 && $<=50; msg:'limit 需在1到50之间'"`
+}
+
+type TagLookupQuery struct {
+	Name string `query:"name" vd:"len($)>0; msg:'name 不能为空'"`
+}
+
+// TrendingTagsQuery 中 days 是统计窗口的天数，默认 7 天，最多 30 天。
+type TrendingTagsQuery struct {
+	Days  int `query:"days" vd:"$>=0 && $<=30; msg:'days 需在1到30之间'"`
+	Limit int `query:"limit" vd:"$>=0 && $<=50; msg:'limit 需在1到50之间'"`
+}
+
+// MergeTagRequest 把路径中的标签合并进 targetId。
+type MergeTagRequest struct {
+	TargetID int `json:"targetId" vd:"$>0; msg:'targetId 无效'"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+type RegisterRequest struct {
+	Phone    string `json:"phone" vd:"regexp('^1\\d{10}$'); msg:'手机号必须是11位'"`
+	Password string `json:"password" vd:"len($)>=6 && len($)<=72; msg:'密码长度需在6到72位之间'"`
+	Nickname string `json:"nickname" vd:"len($)>0 && len($)<=50; msg:'昵称不能为空且不能超过50个字节'"`
+}
+
+type DeleteAccountRequest struct {
+	Password string `json:"password" vd:"len($)>0; msg:'请输入密码以确认注销'"`
+}
+
+// PostInteractionRequest 用于点赞、收藏文章：不传 status 时切换当前状态，传了则直接设为该状态。
+type PostInteractionRequest struct {
+	PostID int   `json:"postId" vd:"$>0; msg:'postId 无效'"`
+	Status *bool `json:"status"`
+}
+
+// QuestionInteractionRequest 用于点赞、收藏问题，status 的含义同 PostInteractionRequest。
+type QuestionInteractionRequest struct {
+	QuestionID int   `json:"questionId" vd:"$>0; msg:'questionId 无效'"`
+	Status     *bool `json:"status"`
+}
+
+// FollowRequest 用于关注、取关用户，status 的含义同 PostInteractionRequest。
+type FollowRequest struct {
+	TargetFollowID int   `json:"targetFollowId" vd:"$>0; msg:'targetFollowId 无效'"`
+	Status         *bool `json:"status"`
+}
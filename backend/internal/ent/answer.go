@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/ent/answer"
+	"backend/internal/ent/question"
+	"backend/internal/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Answer is the model entity for the Answer schema.
+type Answer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// QuestionID holds the value of the "question_id" field.
+	QuestionID int `json:"question_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// VoteScore holds the value of the "vote_score" field.
+	VoteScore int `json:"vote_score,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AnswerQuery when eager-loading is set.
+	Edges        AnswerEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AnswerEdges holds the relations/edges for other nodes in the graph.
+type AnswerEdges struct {
+	// Question holds the value of the question edge.
+	Question *Question `json:"question,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Votes holds the value of the votes edge.
+	Votes []*AnswerVote `json:"votes,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// QuestionOrErr returns the Question value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AnswerEdges) QuestionOrErr() (*Question, error) {
+	if e.Question != nil {
+		return e.Question, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: question.Label}
+	}
+	return nil, &NotLoadedError{edge: "question"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AnswerEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// VotesOrErr returns the Votes value or an error if the edge
+// was not loaded in eager-loading.
+func (e AnswerEdges) VotesOrErr() ([]*AnswerVote, error) {
+	if e.loadedTypes[2] {
+		return e.Votes, nil
+	}
+	return nil, &NotLoadedError{edge: "votes"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e AnswerEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[3] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Answer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case answer.FieldID, answer.FieldQuestionID, answer.FieldUserID, answer.FieldVoteScore:
+			values[i] = new(sql.NullInt64)
+		case answer.FieldContent:
+			values[i] = new(sql.NullString)
+		case answer.FieldCreateTime, answer.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Answer fields.
+func (a *Answer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case answer.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			a.ID = int(value.Int64)
+		case answer.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				a.CreateTime = value.Time
+			}
+		case answer.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				a.UpdateTime = value.Time
+			}
+		case answer.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				a.Content = value.String
+			}
+		case answer.FieldQuestionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field question_id", values[i])
+			} else if value.Valid {
+				a.QuestionID = int(value.Int64)
+			}
+		case answer.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				a.UserID = int(value.Int64)
+			}
+		case answer.FieldVoteScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vote_score", values[i])
+			} else if value.Valid {
+				a.VoteScore = int(value.Int64)
+			}
+		default:
+			a.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Answer.
+// This includes values selected through modifiers, order, etc.
+func (a *Answer) Value(name string) (ent.Value, error) {
+	return a.selectValues.Get(name)
+}
+
+// QueryQuestion queries the "question" edge of the Answer entity.
+func (a *Answer) QueryQuestion() *QuestionQuery {
+	return NewAnswerClient(a.config).QueryQuestion(a)
+}
+
+// QueryUser queries the "user" edge of the Answer entity.
+func (a *Answer) QueryUser() *UserQuery {
+	return NewAnswerClient(a.config).QueryUser(a)
+}
+
+// QueryVotes queries the "votes" edge of the Answer entity.
+func (a *Answer) QueryVotes() *AnswerVoteQuery {
+	return NewAnswerClient(a.config).QueryVotes(a)
+}
+
+// QueryComments queries the "comments" edge of the Answer entity.
+func (a *Answer) QueryComments() *CommentQuery {
+	return NewAnswerClient(a.config).QueryComments(a)
+}
+
+// Update returns a builder for updating this Answer.
+// Note that you need to call Answer.Unwrap() before calling this method if this Answer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Answer) Update() *AnswerUpdateOne {
+	return NewAnswerClient(a.config).UpdateOne(a)
+}
+
+// Unwrap unwraps the Answer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Answer) Unwrap() *Answer {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Answer is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Answer) String() string {
+	var builder strings.Builder
+	builder.WriteString("Answer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(a.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(a.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(a.Content)
+	builder.WriteString(", ")
+	builder.WriteString("question_id=")
+	builder.WriteString(fmt.Sprintf("%v", a.QuestionID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", a.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("vote_score=")
+	builder.WriteString(fmt.Sprintf("%v", a.VoteScore))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Answers is a parsable slice of Answer.
+type Answers []*Answer
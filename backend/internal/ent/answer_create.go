@@ -0,0 +1,871 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/ent/answer"
+	"backend/internal/ent/answervote"
+	"backend/internal/ent/comment"
+	"backend/internal/ent/question"
+	"backend/internal/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AnswerCreate is the builder for creating a Answer entity.
+type AnswerCreate struct {
+	config
+	mutation *AnswerMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (ac *AnswerCreate) SetCreateTime(t time.Time) *AnswerCreate {
+	ac.mutation.SetCreateTime(t)
+	return ac
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (ac *AnswerCreate) SetNillableCreateTime(t *time.Time) *AnswerCreate {
+	if t != nil {
+		ac.SetCreateTime(*t)
+	}
+	return ac
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ac *AnswerCreate) SetUpdateTime(t time.Time) *AnswerCreate {
+	ac.mutation.SetUpdateTime(t)
+	return ac
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (ac *AnswerCreate) SetNillableUpdateTime(t *time.Time) *AnswerCreate {
+	if t != nil {
+		ac.SetUpdateTime(*t)
+	}
+	return ac
+}
+
+// SetContent sets the "content" field.
+func (ac *AnswerCreate) SetContent(s string) *AnswerCreate {
+	ac.mutation.SetContent(s)
+	return ac
+}
+
+// SetQuestionID sets the "question_id" field.
+func (ac *AnswerCreate) SetQuestionID(i int) *AnswerCreate {
+	ac.mutation.SetQuestionID(i)
+	return ac
+}
+
+// SetUserID sets the "user_id" field.
+func (ac *AnswerCreate) SetUserID(i int) *AnswerCreate {
+	ac.mutation.SetUserID(i)
+	return ac
+}
+
+// SetVoteScore sets the "vote_score" field.
+func (ac *AnswerCreate) SetVoteScore(i int) *AnswerCreate {
+	ac.mutation.SetVoteScore(i)
+	return ac
+}
+
+// SetNillableVoteScore sets the "vote_score" field if the given value is not nil.
+func (ac *AnswerCreate) SetNillableVoteScore(i *int) *AnswerCreate {
+	if i != nil {
+		ac.SetVoteScore(*i)
+	}
+	return ac
+}
+
+// SetQuestion sets the "question" edge to the Question entity.
+func (ac *AnswerCreate) SetQuestion(q *Question) *AnswerCreate {
+	return ac.SetQuestionID(q.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ac *AnswerCreate) SetUser(u *User) *AnswerCreate {
+	return ac.SetUserID(u.ID)
+}
+
+// AddVoteIDs adds the "votes" edge to the AnswerVote entity by IDs.
+func (ac *AnswerCreate) AddVoteIDs(ids ...int) *AnswerCreate {
+	ac.mutation.AddVoteIDs(ids...)
+	return ac
+}
+
+// AddVotes adds the "votes" edges to the AnswerVote entity.
+func (ac *AnswerCreate) AddVotes(a ...*AnswerVote) *AnswerCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ac.AddVoteIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (ac *AnswerCreate) AddCommentIDs(ids ...int) *AnswerCreate {
+	ac.mutation.AddCommentIDs(ids...)
+	return ac
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (ac *AnswerCreate) AddComments(c ...*Comment) *AnswerCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ac.AddCommentIDs(ids...)
+}
+
+// Mutation returns the AnswerMutation object of the builder.
+func (ac *AnswerCreate) Mutation() *AnswerMutation {
+	return ac.mutation
+}
+
+// Save creates the Answer in the database.
+func (ac *AnswerCreate) Save(ctx context.Context) (*Answer, error) {
+	if err := ac.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AnswerCreate) SaveX(ctx context.Context) *Answer {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *AnswerCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *AnswerCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *AnswerCreate) defaults() error {
+	if _, ok := ac.mutation.CreateTime(); !ok {
+		if answer.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized answer.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := answer.DefaultCreateTime()
+		ac.mutation.SetCreateTime(v)
+	}
+	if _, ok := ac.mutation.UpdateTime(); !ok {
+		if answer.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized answer.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := answer.DefaultUpdateTime()
+		ac.mutation.SetUpdateTime(v)
+	}
+	if _, ok := ac.mutation.VoteScore(); !ok {
+		v := answer.DefaultVoteScore
+		ac.mutation.SetVoteScore(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AnswerCreate) check() error {
+	if _, ok := ac.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Answer.create_time"`)}
+	}
+	if _, ok := ac.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Answer.update_time"`)}
+	}
+	if _, ok := ac.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "Answer.content"`)}
+	}
+	if _, ok := ac.mutation.QuestionID(); !ok {
+		return &ValidationError{Name: "question_id", err: errors.New(`ent: missing required field "Answer.question_id"`)}
+	}
+	if _, ok := ac.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Answer.user_id"`)}
+	}
+	if _, ok := ac.mutation.VoteScore(); !ok {
+		return &ValidationError{Name: "vote_score", err: errors.New(`ent: missing required field "Answer.vote_score"`)}
+	}
+	if _, ok := ac.mutation.QuestionID(); !ok {
+		return &ValidationError{Name: "question", err: errors.New(`ent: missing required edge "Answer.question"`)}
+	}
+	if _, ok := ac.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Answer.user"`)}
+	}
+	return nil
+}
+
+func (ac *AnswerCreate) sqlSave(ctx context.Context) (*Answer, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *AnswerCreate) createSpec() (*Answer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Answer{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(answer.Table, sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ac.conflict
+	if value, ok := ac.mutation.CreateTime(); ok {
+		_spec.SetField(answer.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := ac.mutation.UpdateTime(); ok {
+		_spec.SetField(answer.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := ac.mutation.Content(); ok {
+		_spec.SetField(answer.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := ac.mutation.VoteScore(); ok {
+		_spec.SetField(answer.FieldVoteScore, field.TypeInt, value)
+		_node.VoteScore = value
+	}
+	if nodes := ac.mutation.QuestionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.QuestionTable,
+			Columns: []string{answer.QuestionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.QuestionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.UserTable,
+			Columns: []string{answer.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   answer.VotesTable,
+			Columns: []string{answer.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answervote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   answer.CommentsTable,
+			Columns: []string{answer.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Answer.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnswerUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (ac *AnswerCreate) OnConflict(opts ...sql.ConflictOption) *AnswerUpsertOne {
+	ac.conflict = opts
+	return &AnswerUpsertOne{
+		create: ac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Answer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ac *AnswerCreate) OnConflictColumns(columns ...string) *AnswerUpsertOne {
+	ac.conflict = append(ac.conflict, sql.ConflictColumns(columns...))
+	return &AnswerUpsertOne{
+		create: ac,
+	}
+}
+
+type (
+	// AnswerUpsertOne is the builder for "upsert"-ing
+	//  one Answer node.
+	AnswerUpsertOne struct {
+		create *AnswerCreate
+	}
+
+	// AnswerUpsert is the "OnConflict" setter.
+	AnswerUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AnswerUpsert) SetUpdateTime(v time.Time) *AnswerUpsert {
+	u.Set(answer.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AnswerUpsert) UpdateUpdateTime() *AnswerUpsert {
+	u.SetExcluded(answer.FieldUpdateTime)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *AnswerUpsert) SetContent(v string) *AnswerUpsert {
+	u.Set(answer.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *AnswerUpsert) UpdateContent() *AnswerUpsert {
+	u.SetExcluded(answer.FieldContent)
+	return u
+}
+
+// SetQuestionID sets the "question_id" field.
+func (u *AnswerUpsert) SetQuestionID(v int) *AnswerUpsert {
+	u.Set(answer.FieldQuestionID, v)
+	return u
+}
+
+// UpdateQuestionID sets the "question_id" field to the value that was provided on create.
+func (u *AnswerUpsert) UpdateQuestionID() *AnswerUpsert {
+	u.SetExcluded(answer.FieldQuestionID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AnswerUpsert) SetUserID(v int) *AnswerUpsert {
+	u.Set(answer.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AnswerUpsert) UpdateUserID() *AnswerUpsert {
+	u.SetExcluded(answer.FieldUserID)
+	return u
+}
+
+// SetVoteScore sets the "vote_score" field.
+func (u *AnswerUpsert) SetVoteScore(v int) *AnswerUpsert {
+	u.Set(answer.FieldVoteScore, v)
+	return u
+}
+
+// UpdateVoteScore sets the "vote_score" field to the value that was provided on create.
+func (u *AnswerUpsert) UpdateVoteScore() *AnswerUpsert {
+	u.SetExcluded(answer.FieldVoteScore)
+	return u
+}
+
+// AddVoteScore adds v to the "vote_score" field.
+func (u *AnswerUpsert) AddVoteScore(v int) *AnswerUpsert {
+	u.Add(answer.FieldVoteScore, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Answer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AnswerUpsertOne) UpdateNewValues() *AnswerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(answer.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Answer.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AnswerUpsertOne) Ignore() *AnswerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnswerUpsertOne) DoNothing() *AnswerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnswerCreate.OnConflict
+// documentation for more info.
+func (u *AnswerUpsertOne) Update(set func(*AnswerUpsert)) *AnswerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnswerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AnswerUpsertOne) SetUpdateTime(v time.Time) *AnswerUpsertOne {
+	return u.Update(func(s *AnswerUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AnswerUpsertOne) UpdateUpdateTime() *AnswerUpsertOne {
+	return u.Update(func(s *AnswerUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *AnswerUpsertOne) SetContent(v string) *AnswerUpsertOne {
+	return u.Update(func(s *AnswerUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *AnswerUpsertOne) UpdateContent() *AnswerUpsertOne {
+	return u.Update(func(s *AnswerUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetQuestionID sets the "question_id" field.
+func (u *AnswerUpsertOne) SetQuestionID(v int) *AnswerUpsertOne {
+	return u.Update(func(s *AnswerUpsert) {
+		s.SetQuestionID(v)
+	})
+}
+
+// UpdateQuestionID sets the "question_id" field to the value that was provided on create.
+func (u *AnswerUpsertOne) UpdateQuestionID() *AnswerUpsertOne {
+	return u.Update(func(s *AnswerUpsert) {
+		s.UpdateQuestionID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AnswerUpsertOne) SetUserID(v int) *AnswerUpsertOne {
+	return u.Update(func(s *AnswerUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AnswerUpsertOne) UpdateUserID() *AnswerUpsertOne {
+	return u.Update(func(s *AnswerUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetVoteScore sets the "vote_score" field.
+func (u *AnswerUpsertOne) SetVoteScore(v int) *AnswerUpsertOne {
+	return u.Update(func(s *AnswerUpsert) {
+		s.SetVoteScore(v)
+	})
+}
+
+// AddVoteScore adds v to the "vote_score" field.
+func (u *AnswerUpsertOne) AddVoteScore(v int) *AnswerUpsertOne {
+	return u.Update(func(s *AnswerUpsert) {
+		s.AddVoteScore(v)
+	})
+}
+
+// UpdateVoteScore sets the "vote_score" field to the value that was provided on create.
+func (u *AnswerUpsertOne) UpdateVoteScore() *AnswerUpsertOne {
+	return u.Update(func(s *AnswerUpsert) {
+		s.UpdateVoteScore()
+	})
+}
+
+// Exec executes the query.
+func (u *AnswerUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnswerCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnswerUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AnswerUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AnswerUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AnswerCreateBulk is the builder for creating many Answer entities in bulk.
+type AnswerCreateBulk struct {
+	config
+	err      error
+	builders []*AnswerCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Answer entities in the database.
+func (acb *AnswerCreateBulk) Save(ctx context.Context) ([]*Answer, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Answer, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AnswerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = acb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *AnswerCreateBulk) SaveX(ctx context.Context) []*Answer {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *AnswerCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *AnswerCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Answer.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnswerUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (acb *AnswerCreateBulk) OnConflict(opts ...sql.ConflictOption) *AnswerUpsertBulk {
+	acb.conflict = opts
+	return &AnswerUpsertBulk{
+		create: acb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Answer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (acb *AnswerCreateBulk) OnConflictColumns(columns ...string) *AnswerUpsertBulk {
+	acb.conflict = append(acb.conflict, sql.ConflictColumns(columns...))
+	return &AnswerUpsertBulk{
+		create: acb,
+	}
+}
+
+// AnswerUpsertBulk is the builder for "upsert"-ing
+// a bulk of Answer nodes.
+type AnswerUpsertBulk struct {
+	create *AnswerCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Answer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AnswerUpsertBulk) UpdateNewValues() *AnswerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(answer.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Answer.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AnswerUpsertBulk) Ignore() *AnswerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnswerUpsertBulk) DoNothing() *AnswerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnswerCreateBulk.OnConflict
+// documentation for more info.
+func (u *AnswerUpsertBulk) Update(set func(*AnswerUpsert)) *AnswerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnswerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AnswerUpsertBulk) SetUpdateTime(v time.Time) *AnswerUpsertBulk {
+	return u.Update(func(s *AnswerUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AnswerUpsertBulk) UpdateUpdateTime() *AnswerUpsertBulk {
+	return u.Update(func(s *AnswerUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *AnswerUpsertBulk) SetContent(v string) *AnswerUpsertBulk {
+	return u.Update(func(s *AnswerUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *AnswerUpsertBulk) UpdateContent() *AnswerUpsertBulk {
+	return u.Update(func(s *AnswerUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetQuestionID sets the "question_id" field.
+func (u *AnswerUpsertBulk) SetQuestionID(v int) *AnswerUpsertBulk {
+	return u.Update(func(s *AnswerUpsert) {
+		s.SetQuestionID(v)
+	})
+}
+
+// UpdateQuestionID sets the "question_id" field to the value that was provided on create.
+func (u *AnswerUpsertBulk) UpdateQuestionID() *AnswerUpsertBulk {
+	return u.Update(func(s *AnswerUpsert) {
+		s.UpdateQuestionID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AnswerUpsertBulk) SetUserID(v int) *AnswerUpsertBulk {
+	return u.Update(func(s *AnswerUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AnswerUpsertBulk) UpdateUserID() *AnswerUpsertBulk {
+	return u.Update(func(s *AnswerUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetVoteScore sets the "vote_score" field.
+func (u *AnswerUpsertBulk) SetVoteScore(v int) *AnswerUpsertBulk {
+	return u.Update(func(s *AnswerUpsert) {
+		s.SetVoteScore(v)
+	})
+}
+
+// AddVoteScore adds v to the "vote_score" field.
+func (u *AnswerUpsertBulk) AddVoteScore(v int) *AnswerUpsertBulk {
+	return u.Update(func(s *AnswerUpsert) {
+		s.AddVoteScore(v)
+	})
+}
+
+// UpdateVoteScore sets the "vote_score" field to the value that was provided on create.
+func (u *AnswerUpsertBulk) UpdateVoteScore() *AnswerUpsertBulk {
+	return u.Update(func(s *AnswerUpsert) {
+		s.UpdateVoteScore()
+	})
+}
+
+// Exec executes the query.
+func (u *AnswerUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AnswerCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnswerCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnswerUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,900 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/ent/answer"
+	"backend/internal/ent/answervote"
+	"backend/internal/ent/comment"
+	"backend/internal/ent/predicate"
+	"backend/internal/ent/question"
+	"backend/internal/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AnswerUpdate is the builder for updating Answer entities.
+type AnswerUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AnswerMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AnswerUpdate builder.
+func (au *AnswerUpdate) Where(ps ...predicate.Answer) *AnswerUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (au *AnswerUpdate) SetUpdateTime(t time.Time) *AnswerUpdate {
+	au.mutation.SetUpdateTime(t)
+	return au
+}
+
+// SetContent sets the "content" field.
+func (au *AnswerUpdate) SetContent(s string) *AnswerUpdate {
+	au.mutation.SetContent(s)
+	return au
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (au *AnswerUpdate) SetNillableContent(s *string) *AnswerUpdate {
+	if s != nil {
+		au.SetContent(*s)
+	}
+	return au
+}
+
+// SetQuestionID sets the "question_id" field.
+func (au *AnswerUpdate) SetQuestionID(i int) *AnswerUpdate {
+	au.mutation.SetQuestionID(i)
+	return au
+}
+
+// SetNillableQuestionID sets the "question_id" field if the given value is not nil.
+func (au *AnswerUpdate) SetNillableQuestionID(i *int) *AnswerUpdate {
+	if i != nil {
+		au.SetQuestionID(*i)
+	}
+	return au
+}
+
+// SetUserID sets the "user_id" field.
+func (au *AnswerUpdate) SetUserID(i int) *AnswerUpdate {
+	au.mutation.SetUserID(i)
+	return au
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (au *AnswerUpdate) SetNillableUserID(i *int) *AnswerUpdate {
+	if i != nil {
+		au.SetUserID(*i)
+	}
+	return au
+}
+
+// SetVoteScore sets the "vote_score" field.
+func (au *AnswerUpdate) SetVoteScore(i int) *AnswerUpdate {
+	au.mutation.ResetVoteScore()
+	au.mutation.SetVoteScore(i)
+	return au
+}
+
+// SetNillableVoteScore sets the "vote_score" field if the given value is not nil.
+func (au *AnswerUpdate) SetNillableVoteScore(i *int) *AnswerUpdate {
+	if i != nil {
+		au.SetVoteScore(*i)
+	}
+	return au
+}
+
+// AddVoteScore adds i to the "vote_score" field.
+func (au *AnswerUpdate) AddVoteScore(i int) *AnswerUpdate {
+	au.mutation.AddVoteScore(i)
+	return au
+}
+
+// SetQuestion sets the "question" edge to the Question entity.
+func (au *AnswerUpdate) SetQuestion(q *Question) *AnswerUpdate {
+	return au.SetQuestionID(q.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (au *AnswerUpdate) SetUser(u *User) *AnswerUpdate {
+	return au.SetUserID(u.ID)
+}
+
+// AddVoteIDs adds the "votes" edge to the AnswerVote entity by IDs.
+func (au *AnswerUpdate) AddVoteIDs(ids ...int) *AnswerUpdate {
+	au.mutation.AddVoteIDs(ids...)
+	return au
+}
+
+// AddVotes adds the "votes" edges to the AnswerVote entity.
+func (au *AnswerUpdate) AddVotes(a ...*AnswerVote) *AnswerUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.AddVoteIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (au *AnswerUpdate) AddCommentIDs(ids ...int) *AnswerUpdate {
+	au.mutation.AddCommentIDs(ids...)
+	return au
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (au *AnswerUpdate) AddComments(c ...*Comment) *AnswerUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return au.AddCommentIDs(ids...)
+}
+
+// Mutation returns the AnswerMutation object of the builder.
+func (au *AnswerUpdate) Mutation() *AnswerMutation {
+	return au.mutation
+}
+
+// ClearQuestion clears the "question" edge to the Question entity.
+func (au *AnswerUpdate) ClearQuestion() *AnswerUpdate {
+	au.mutation.ClearQuestion()
+	return au
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (au *AnswerUpdate) ClearUser() *AnswerUpdate {
+	au.mutation.ClearUser()
+	return au
+}
+
+// ClearVotes clears all "votes" edges to the AnswerVote entity.
+func (au *AnswerUpdate) ClearVotes() *AnswerUpdate {
+	au.mutation.ClearVotes()
+	return au
+}
+
+// RemoveVoteIDs removes the "votes" edge to AnswerVote entities by IDs.
+func (au *AnswerUpdate) RemoveVoteIDs(ids ...int) *AnswerUpdate {
+	au.mutation.RemoveVoteIDs(ids...)
+	return au
+}
+
+// RemoveVotes removes "votes" edges to AnswerVote entities.
+func (au *AnswerUpdate) RemoveVotes(a ...*AnswerVote) *AnswerUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.RemoveVoteIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (au *AnswerUpdate) ClearComments() *AnswerUpdate {
+	au.mutation.ClearComments()
+	return au
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (au *AnswerUpdate) RemoveCommentIDs(ids ...int) *AnswerUpdate {
+	au.mutation.RemoveCommentIDs(ids...)
+	return au
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (au *AnswerUpdate) RemoveComments(c ...*Comment) *AnswerUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return au.RemoveCommentIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AnswerUpdate) Save(ctx context.Context) (int, error) {
+	if err := au.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AnswerUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AnswerUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AnswerUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (au *AnswerUpdate) defaults() error {
+	if _, ok := au.mutation.UpdateTime(); !ok {
+		if answer.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized answer.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := answer.UpdateDefaultUpdateTime()
+		au.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AnswerUpdate) check() error {
+	if _, ok := au.mutation.QuestionID(); au.mutation.QuestionCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Answer.question"`)
+	}
+	if _, ok := au.mutation.UserID(); au.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Answer.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (au *AnswerUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnswerUpdate {
+	au.modifiers = append(au.modifiers, modifiers...)
+	return au
+}
+
+func (au *AnswerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(answer.Table, answer.Columns, sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.UpdateTime(); ok {
+		_spec.SetField(answer.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := au.mutation.Content(); ok {
+		_spec.SetField(answer.FieldContent, field.TypeString, value)
+	}
+	if value, ok := au.mutation.VoteScore(); ok {
+		_spec.SetField(answer.FieldVoteScore, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedVoteScore(); ok {
+		_spec.AddField(answer.FieldVoteScore, field.TypeInt, value)
+	}
+	if au.mutation.QuestionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.QuestionTable,
+			Columns: []string{answer.QuestionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.QuestionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.QuestionTable,
+			Columns: []string{answer.QuestionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.UserTable,
+			Columns: []string{answer.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.UserTable,
+			Columns: []string{answer.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   answer.VotesTable,
+			Columns: []string{answer.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answervote.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedVotesIDs(); len(nodes) > 0 && !au.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   answer.VotesTable,
+			Columns: []string{answer.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answervote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   answer.VotesTable,
+			Columns: []string{answer.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answervote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   answer.CommentsTable,
+			Columns: []string{answer.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !au.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   answer.CommentsTable,
+			Columns: []string{answer.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   answer.CommentsTable,
+			Columns: []string{answer.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(au.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{answer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AnswerUpdateOne is the builder for updating a single Answer entity.
+type AnswerUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AnswerMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (auo *AnswerUpdateOne) SetUpdateTime(t time.Time) *AnswerUpdateOne {
+	auo.mutation.SetUpdateTime(t)
+	return auo
+}
+
+// SetContent sets the "content" field.
+func (auo *AnswerUpdateOne) SetContent(s string) *AnswerUpdateOne {
+	auo.mutation.SetContent(s)
+	return auo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (auo *AnswerUpdateOne) SetNillableContent(s *string) *AnswerUpdateOne {
+	if s != nil {
+		auo.SetContent(*s)
+	}
+	return auo
+}
+
+// SetQuestionID sets the "question_id" field.
+func (auo *AnswerUpdateOne) SetQuestionID(i int) *AnswerUpdateOne {
+	auo.mutation.SetQuestionID(i)
+	return auo
+}
+
+// SetNillableQuestionID sets the "question_id" field if the given value is not nil.
+func (auo *AnswerUpdateOne) SetNillableQuestionID(i *int) *AnswerUpdateOne {
+	if i != nil {
+		auo.SetQuestionID(*i)
+	}
+	return auo
+}
+
+// SetUserID sets the "user_id" field.
+func (auo *AnswerUpdateOne) SetUserID(i int) *AnswerUpdateOne {
+	auo.mutation.SetUserID(i)
+	return auo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (auo *AnswerUpdateOne) SetNillableUserID(i *int) *AnswerUpdateOne {
+	if i != nil {
+		auo.SetUserID(*i)
+	}
+	return auo
+}
+
+// SetVoteScore sets the "vote_score" field.
+func (auo *AnswerUpdateOne) SetVoteScore(i int) *AnswerUpdateOne {
+	auo.mutation.ResetVoteScore()
+	auo.mutation.SetVoteScore(i)
+	return auo
+}
+
+// SetNillableVoteScore sets the "vote_score" field if the given value is not nil.
+func (auo *AnswerUpdateOne) SetNillableVoteScore(i *int) *AnswerUpdateOne {
+	if i != nil {
+		auo.SetVoteScore(*i)
+	}
+	return auo
+}
+
+// AddVoteScore adds i to the "vote_score" field.
+func (auo *AnswerUpdateOne) AddVoteScore(i int) *AnswerUpdateOne {
+	auo.mutation.AddVoteScore(i)
+	return auo
+}
+
+// SetQuestion sets the "question" edge to the Question entity.
+func (auo *AnswerUpdateOne) SetQuestion(q *Question) *AnswerUpdateOne {
+	return auo.SetQuestionID(q.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (auo *AnswerUpdateOne) SetUser(u *User) *AnswerUpdateOne {
+	return auo.SetUserID(u.ID)
+}
+
+// AddVoteIDs adds the "votes" edge to the AnswerVote entity by IDs.
+func (auo *AnswerUpdateOne) AddVoteIDs(ids ...int) *AnswerUpdateOne {
+	auo.mutation.AddVoteIDs(ids...)
+	return auo
+}
+
+// AddVotes adds the "votes" edges to the AnswerVote entity.
+func (auo *AnswerUpdateOne) AddVotes(a ...*AnswerVote) *AnswerUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.AddVoteIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (auo *AnswerUpdateOne) AddCommentIDs(ids ...int) *AnswerUpdateOne {
+	auo.mutation.AddCommentIDs(ids...)
+	return auo
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (auo *AnswerUpdateOne) AddComments(c ...*Comment) *AnswerUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return auo.AddCommentIDs(ids...)
+}
+
+// Mutation returns the AnswerMutation object of the builder.
+func (auo *AnswerUpdateOne) Mutation() *AnswerMutation {
+	return auo.mutation
+}
+
+// ClearQuestion clears the "question" edge to the Question entity.
+func (auo *AnswerUpdateOne) ClearQuestion() *AnswerUpdateOne {
+	auo.mutation.ClearQuestion()
+	return auo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (auo *AnswerUpdateOne) ClearUser() *AnswerUpdateOne {
+	auo.mutation.ClearUser()
+	return auo
+}
+
+// ClearVotes clears all "votes" edges to the AnswerVote entity.
+func (auo *AnswerUpdateOne) ClearVotes() *AnswerUpdateOne {
+	auo.mutation.ClearVotes()
+	return auo
+}
+
+// RemoveVoteIDs removes the "votes" edge to AnswerVote entities by IDs.
+func (auo *AnswerUpdateOne) RemoveVoteIDs(ids ...int) *AnswerUpdateOne {
+	auo.mutation.RemoveVoteIDs(ids...)
+	return auo
+}
+
+// RemoveVotes removes "votes" edges to AnswerVote entities.
+func (auo *AnswerUpdateOne) RemoveVotes(a ...*AnswerVote) *AnswerUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.RemoveVoteIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (auo *AnswerUpdateOne) ClearComments() *AnswerUpdateOne {
+	auo.mutation.ClearComments()
+	return auo
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (auo *AnswerUpdateOne) RemoveCommentIDs(ids ...int) *AnswerUpdateOne {
+	auo.mutation.RemoveCommentIDs(ids...)
+	return auo
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (auo *AnswerUpdateOne) RemoveComments(c ...*Comment) *AnswerUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return auo.RemoveCommentIDs(ids...)
+}
+
+// Where appends a list predicates to the AnswerUpdate builder.
+func (auo *AnswerUpdateOne) Where(ps ...predicate.Answer) *AnswerUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AnswerUpdateOne) Select(field string, fields ...string) *AnswerUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Answer entity.
+func (auo *AnswerUpdateOne) Save(ctx context.Context) (*Answer, error) {
+	if err := auo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AnswerUpdateOne) SaveX(ctx context.Context) *Answer {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AnswerUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AnswerUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (auo *AnswerUpdateOne) defaults() error {
+	if _, ok := auo.mutation.UpdateTime(); !ok {
+		if answer.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized answer.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := answer.UpdateDefaultUpdateTime()
+		auo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AnswerUpdateOne) check() error {
+	if _, ok := auo.mutation.QuestionID(); auo.mutation.QuestionCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Answer.question"`)
+	}
+	if _, ok := auo.mutation.UserID(); auo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Answer.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (auo *AnswerUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnswerUpdateOne {
+	auo.modifiers = append(auo.modifiers, modifiers...)
+	return auo
+}
+
+func (auo *AnswerUpdateOne) sqlSave(ctx context.Context) (_node *Answer, err error) {
+	if err := auo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(answer.Table, answer.Columns, sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Answer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, answer.FieldID)
+		for _, f := range fields {
+			if !answer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != answer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.UpdateTime(); ok {
+		_spec.SetField(answer.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := auo.mutation.Content(); ok {
+		_spec.SetField(answer.FieldContent, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.VoteScore(); ok {
+		_spec.SetField(answer.FieldVoteScore, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedVoteScore(); ok {
+		_spec.AddField(answer.FieldVoteScore, field.TypeInt, value)
+	}
+	if auo.mutation.QuestionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.QuestionTable,
+			Columns: []string{answer.QuestionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.QuestionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.QuestionTable,
+			Columns: []string{answer.QuestionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.UserTable,
+			Columns: []string{answer.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answer.UserTable,
+			Columns: []string{answer.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   answer.VotesTable,
+			Columns: []string{answer.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answervote.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedVotesIDs(); len(nodes) > 0 && !auo.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   answer.VotesTable,
+			Columns: []string{answer.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answervote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   answer.VotesTable,
+			Columns: []string{answer.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answervote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   answer.CommentsTable,
+			Columns: []string{answer.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !auo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   answer.CommentsTable,
+			Columns: []string{answer.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   answer.CommentsTable,
+			Columns: []string{answer.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(auo.modifiers...)
+	_node = &Answer{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{answer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
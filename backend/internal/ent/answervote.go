@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/ent/answer"
+	"backend/internal/ent/answervote"
+	"backend/internal/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AnswerVote is the model entity for the AnswerVote schema.
+type AnswerVote struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// AnswerID holds the value of the "answer_id" field.
+	AnswerID int `json:"answer_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Value holds the value of the "value" field.
+	Value int `json:"value,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AnswerVoteQuery when eager-loading is set.
+	Edges        AnswerVoteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AnswerVoteEdges holds the relations/edges for other nodes in the graph.
+type AnswerVoteEdges struct {
+	// Answer holds the value of the answer edge.
+	Answer *Answer `json:"answer,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AnswerOrErr returns the Answer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AnswerVoteEdges) AnswerOrErr() (*Answer, error) {
+	if e.Answer != nil {
+		return e.Answer, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: answer.Label}
+	}
+	return nil, &NotLoadedError{edge: "answer"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AnswerVoteEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AnswerVote) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case answervote.FieldID, answervote.FieldAnswerID, answervote.FieldUserID, answervote.FieldValue:
+			values[i] = new(sql.NullInt64)
+		case answervote.FieldCreateTime, answervote.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AnswerVote fields.
+func (av *AnswerVote) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case answervote.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			av.ID = int(value.Int64)
+		case answervote.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				av.CreateTime = value.Time
+			}
+		case answervote.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				av.UpdateTime = value.Time
+			}
+		case answervote.FieldAnswerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field answer_id", values[i])
+			} else if value.Valid {
+				av.AnswerID = int(value.Int64)
+			}
+		case answervote.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				av.UserID = int(value.Int64)
+			}
+		case answervote.FieldValue:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				av.Value = int(value.Int64)
+			}
+		default:
+			av.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the AnswerVote.
+// This includes values selected through modifiers, order, etc.
+func (av *AnswerVote) GetValue(name string) (ent.Value, error) {
+	return av.selectValues.Get(name)
+}
+
+// QueryAnswer queries the "answer" edge of the AnswerVote entity.
+func (av *AnswerVote) QueryAnswer() *AnswerQuery {
+	return NewAnswerVoteClient(av.config).QueryAnswer(av)
+}
+
+// QueryUser queries the "user" edge of the AnswerVote entity.
+func (av *AnswerVote) QueryUser() *UserQuery {
+	return NewAnswerVoteClient(av.config).QueryUser(av)
+}
+
+// Update returns a builder for updating this AnswerVote.
+// Note that you need to call AnswerVote.Unwrap() before calling this method if this AnswerVote
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (av *AnswerVote) Update() *AnswerVoteUpdateOne {
+	return NewAnswerVoteClient(av.config).UpdateOne(av)
+}
+
+// Unwrap unwraps the AnswerVote entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (av *AnswerVote) Unwrap() *AnswerVote {
+	_tx, ok := av.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AnswerVote is not a transactional entity")
+	}
+	av.config.driver = _tx.drv
+	return av
+}
+
+// String implements the fmt.Stringer.
+func (av *AnswerVote) String() string {
+	var builder strings.Builder
+	builder.WriteString("AnswerVote(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", av.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(av.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(av.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("answer_id=")
+	builder.WriteString(fmt.Sprintf("%v", av.AnswerID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", av.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", av.Value))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AnswerVotes is a parsable slice of AnswerVote.
+type AnswerVotes []*AnswerVote
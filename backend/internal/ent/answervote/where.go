@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package answervote
+
+import (
+	"backend/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// AnswerID applies equality check predicate on the "answer_id" field. It's identical to AnswerIDEQ.
+func AnswerID(v int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldEQ(FieldAnswerID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldEQ(FieldUserID, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldEQ(FieldValue, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// AnswerIDEQ applies the EQ predicate on the "answer_id" field.
+func AnswerIDEQ(v int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldEQ(FieldAnswerID, v))
+}
+
+// AnswerIDNEQ applies the NEQ predicate on the "answer_id" field.
+func AnswerIDNEQ(v int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldNEQ(FieldAnswerID, v))
+}
+
+// AnswerIDIn applies the In predicate on the "answer_id" field.
+func AnswerIDIn(vs ...int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldIn(FieldAnswerID, vs...))
+}
+
+// AnswerIDNotIn applies the NotIn predicate on the "answer_id" field.
+func AnswerIDNotIn(vs ...int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldNotIn(FieldAnswerID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v int) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.FieldLTE(FieldValue, v))
+}
+
+// HasAnswer applies the HasEdge predicate on the "answer" edge.
+func HasAnswer() predicate.AnswerVote {
+	return predicate.AnswerVote(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AnswerTable, AnswerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAnswerWith applies the HasEdge predicate on the "answer" edge with a given conditions (other predicates).
+func HasAnswerWith(preds ...predicate.Answer) predicate.AnswerVote {
+	return predicate.AnswerVote(func(s *sql.Selector) {
+		step := newAnswerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.AnswerVote {
+	return predicate.AnswerVote(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.AnswerVote {
+	return predicate.AnswerVote(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AnswerVote) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AnswerVote) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AnswerVote) predicate.AnswerVote {
+	return predicate.AnswerVote(sql.NotPredicates(p))
+}
@@ -0,0 +1,733 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/ent/answer"
+	"backend/internal/ent/answervote"
+	"backend/internal/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AnswerVoteCreate is the builder for creating a AnswerVote entity.
+type AnswerVoteCreate struct {
+	config
+	mutation *AnswerVoteMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (avc *AnswerVoteCreate) SetCreateTime(t time.Time) *AnswerVoteCreate {
+	avc.mutation.SetCreateTime(t)
+	return avc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (avc *AnswerVoteCreate) SetNillableCreateTime(t *time.Time) *AnswerVoteCreate {
+	if t != nil {
+		avc.SetCreateTime(*t)
+	}
+	return avc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (avc *AnswerVoteCreate) SetUpdateTime(t time.Time) *AnswerVoteCreate {
+	avc.mutation.SetUpdateTime(t)
+	return avc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (avc *AnswerVoteCreate) SetNillableUpdateTime(t *time.Time) *AnswerVoteCreate {
+	if t != nil {
+		avc.SetUpdateTime(*t)
+	}
+	return avc
+}
+
+// SetAnswerID sets the "answer_id" field.
+func (avc *AnswerVoteCreate) SetAnswerID(i int) *AnswerVoteCreate {
+	avc.mutation.SetAnswerID(i)
+	return avc
+}
+
+// SetUserID sets the "user_id" field.
+func (avc *AnswerVoteCreate) SetUserID(i int) *AnswerVoteCreate {
+	avc.mutation.SetUserID(i)
+	return avc
+}
+
+// SetValue sets the "value" field.
+func (avc *AnswerVoteCreate) SetValue(i int) *AnswerVoteCreate {
+	avc.mutation.SetValue(i)
+	return avc
+}
+
+// SetAnswer sets the "answer" edge to the Answer entity.
+func (avc *AnswerVoteCreate) SetAnswer(a *Answer) *AnswerVoteCreate {
+	return avc.SetAnswerID(a.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (avc *AnswerVoteCreate) SetUser(u *User) *AnswerVoteCreate {
+	return avc.SetUserID(u.ID)
+}
+
+// Mutation returns the AnswerVoteMutation object of the builder.
+func (avc *AnswerVoteCreate) Mutation() *AnswerVoteMutation {
+	return avc.mutation
+}
+
+// Save creates the AnswerVote in the database.
+func (avc *AnswerVoteCreate) Save(ctx context.Context) (*AnswerVote, error) {
+	avc.defaults()
+	return withHooks(ctx, avc.sqlSave, avc.mutation, avc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (avc *AnswerVoteCreate) SaveX(ctx context.Context) *AnswerVote {
+	v, err := avc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (avc *AnswerVoteCreate) Exec(ctx context.Context) error {
+	_, err := avc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (avc *AnswerVoteCreate) ExecX(ctx context.Context) {
+	if err := avc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (avc *AnswerVoteCreate) defaults() {
+	if _, ok := avc.mutation.CreateTime(); !ok {
+		v := answervote.DefaultCreateTime()
+		avc.mutation.SetCreateTime(v)
+	}
+	if _, ok := avc.mutation.UpdateTime(); !ok {
+		v := answervote.DefaultUpdateTime()
+		avc.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (avc *AnswerVoteCreate) check() error {
+	if _, ok := avc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "AnswerVote.create_time"`)}
+	}
+	if _, ok := avc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "AnswerVote.update_time"`)}
+	}
+	if _, ok := avc.mutation.AnswerID(); !ok {
+		return &ValidationError{Name: "answer_id", err: errors.New(`ent: missing required field "AnswerVote.answer_id"`)}
+	}
+	if _, ok := avc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AnswerVote.user_id"`)}
+	}
+	if _, ok := avc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "AnswerVote.value"`)}
+	}
+	if _, ok := avc.mutation.AnswerID(); !ok {
+		return &ValidationError{Name: "answer", err: errors.New(`ent: missing required edge "AnswerVote.answer"`)}
+	}
+	if _, ok := avc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "AnswerVote.user"`)}
+	}
+	return nil
+}
+
+func (avc *AnswerVoteCreate) sqlSave(ctx context.Context) (*AnswerVote, error) {
+	if err := avc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := avc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, avc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	avc.mutation.id = &_node.ID
+	avc.mutation.done = true
+	return _node, nil
+}
+
+func (avc *AnswerVoteCreate) createSpec() (*AnswerVote, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AnswerVote{config: avc.config}
+		_spec = sqlgraph.NewCreateSpec(answervote.Table, sqlgraph.NewFieldSpec(answervote.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = avc.conflict
+	if value, ok := avc.mutation.CreateTime(); ok {
+		_spec.SetField(answervote.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := avc.mutation.UpdateTime(); ok {
+		_spec.SetField(answervote.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := avc.mutation.Value(); ok {
+		_spec.SetField(answervote.FieldValue, field.TypeInt, value)
+		_node.Value = value
+	}
+	if nodes := avc.mutation.AnswerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answervote.AnswerTable,
+			Columns: []string{answervote.AnswerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AnswerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := avc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answervote.UserTable,
+			Columns: []string{answervote.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AnswerVote.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnswerVoteUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (avc *AnswerVoteCreate) OnConflict(opts ...sql.ConflictOption) *AnswerVoteUpsertOne {
+	avc.conflict = opts
+	return &AnswerVoteUpsertOne{
+		create: avc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AnswerVote.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (avc *AnswerVoteCreate) OnConflictColumns(columns ...string) *AnswerVoteUpsertOne {
+	avc.conflict = append(avc.conflict, sql.ConflictColumns(columns...))
+	return &AnswerVoteUpsertOne{
+		create: avc,
+	}
+}
+
+type (
+	// AnswerVoteUpsertOne is the builder for "upsert"-ing
+	//  one AnswerVote node.
+	AnswerVoteUpsertOne struct {
+		create *AnswerVoteCreate
+	}
+
+	// AnswerVoteUpsert is the "OnConflict" setter.
+	AnswerVoteUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AnswerVoteUpsert) SetUpdateTime(v time.Time) *AnswerVoteUpsert {
+	u.Set(answervote.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AnswerVoteUpsert) UpdateUpdateTime() *AnswerVoteUpsert {
+	u.SetExcluded(answervote.FieldUpdateTime)
+	return u
+}
+
+// SetAnswerID sets the "answer_id" field.
+func (u *AnswerVoteUpsert) SetAnswerID(v int) *AnswerVoteUpsert {
+	u.Set(answervote.FieldAnswerID, v)
+	return u
+}
+
+// UpdateAnswerID sets the "answer_id" field to the value that was provided on create.
+func (u *AnswerVoteUpsert) UpdateAnswerID() *AnswerVoteUpsert {
+	u.SetExcluded(answervote.FieldAnswerID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AnswerVoteUpsert) SetUserID(v int) *AnswerVoteUpsert {
+	u.Set(answervote.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AnswerVoteUpsert) UpdateUserID() *AnswerVoteUpsert {
+	u.SetExcluded(answervote.FieldUserID)
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *AnswerVoteUpsert) SetValue(v int) *AnswerVoteUpsert {
+	u.Set(answervote.FieldValue, v)
+	return u
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *AnswerVoteUpsert) UpdateValue() *AnswerVoteUpsert {
+	u.SetExcluded(answervote.FieldValue)
+	return u
+}
+
+// AddValue adds v to the "value" field.
+func (u *AnswerVoteUpsert) AddValue(v int) *AnswerVoteUpsert {
+	u.Add(answervote.FieldValue, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.AnswerVote.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AnswerVoteUpsertOne) UpdateNewValues() *AnswerVoteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(answervote.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AnswerVote.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AnswerVoteUpsertOne) Ignore() *AnswerVoteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnswerVoteUpsertOne) DoNothing() *AnswerVoteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnswerVoteCreate.OnConflict
+// documentation for more info.
+func (u *AnswerVoteUpsertOne) Update(set func(*AnswerVoteUpsert)) *AnswerVoteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnswerVoteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AnswerVoteUpsertOne) SetUpdateTime(v time.Time) *AnswerVoteUpsertOne {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AnswerVoteUpsertOne) UpdateUpdateTime() *AnswerVoteUpsertOne {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetAnswerID sets the "answer_id" field.
+func (u *AnswerVoteUpsertOne) SetAnswerID(v int) *AnswerVoteUpsertOne {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.SetAnswerID(v)
+	})
+}
+
+// UpdateAnswerID sets the "answer_id" field to the value that was provided on create.
+func (u *AnswerVoteUpsertOne) UpdateAnswerID() *AnswerVoteUpsertOne {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.UpdateAnswerID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AnswerVoteUpsertOne) SetUserID(v int) *AnswerVoteUpsertOne {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AnswerVoteUpsertOne) UpdateUserID() *AnswerVoteUpsertOne {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *AnswerVoteUpsertOne) SetValue(v int) *AnswerVoteUpsertOne {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// AddValue adds v to the "value" field.
+func (u *AnswerVoteUpsertOne) AddValue(v int) *AnswerVoteUpsertOne {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.AddValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *AnswerVoteUpsertOne) UpdateValue() *AnswerVoteUpsertOne {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// Exec executes the query.
+func (u *AnswerVoteUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnswerVoteCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnswerVoteUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AnswerVoteUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AnswerVoteUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AnswerVoteCreateBulk is the builder for creating many AnswerVote entities in bulk.
+type AnswerVoteCreateBulk struct {
+	config
+	err      error
+	builders []*AnswerVoteCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AnswerVote entities in the database.
+func (avcb *AnswerVoteCreateBulk) Save(ctx context.Context) ([]*AnswerVote, error) {
+	if avcb.err != nil {
+		return nil, avcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(avcb.builders))
+	nodes := make([]*AnswerVote, len(avcb.builders))
+	mutators := make([]Mutator, len(avcb.builders))
+	for i := range avcb.builders {
+		func(i int, root context.Context) {
+			builder := avcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AnswerVoteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, avcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = avcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, avcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, avcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (avcb *AnswerVoteCreateBulk) SaveX(ctx context.Context) []*AnswerVote {
+	v, err := avcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (avcb *AnswerVoteCreateBulk) Exec(ctx context.Context) error {
+	_, err := avcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (avcb *AnswerVoteCreateBulk) ExecX(ctx context.Context) {
+	if err := avcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AnswerVote.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnswerVoteUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (avcb *AnswerVoteCreateBulk) OnConflict(opts ...sql.ConflictOption) *AnswerVoteUpsertBulk {
+	avcb.conflict = opts
+	return &AnswerVoteUpsertBulk{
+		create: avcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AnswerVote.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (avcb *AnswerVoteCreateBulk) OnConflictColumns(columns ...string) *AnswerVoteUpsertBulk {
+	avcb.conflict = append(avcb.conflict, sql.ConflictColumns(columns...))
+	return &AnswerVoteUpsertBulk{
+		create: avcb,
+	}
+}
+
+// AnswerVoteUpsertBulk is the builder for "upsert"-ing
+// a bulk of AnswerVote nodes.
+type AnswerVoteUpsertBulk struct {
+	create *AnswerVoteCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AnswerVote.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AnswerVoteUpsertBulk) UpdateNewValues() *AnswerVoteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(answervote.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AnswerVote.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AnswerVoteUpsertBulk) Ignore() *AnswerVoteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnswerVoteUpsertBulk) DoNothing() *AnswerVoteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnswerVoteCreateBulk.OnConflict
+// documentation for more info.
+func (u *AnswerVoteUpsertBulk) Update(set func(*AnswerVoteUpsert)) *AnswerVoteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnswerVoteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AnswerVoteUpsertBulk) SetUpdateTime(v time.Time) *AnswerVoteUpsertBulk {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AnswerVoteUpsertBulk) UpdateUpdateTime() *AnswerVoteUpsertBulk {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetAnswerID sets the "answer_id" field.
+func (u *AnswerVoteUpsertBulk) SetAnswerID(v int) *AnswerVoteUpsertBulk {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.SetAnswerID(v)
+	})
+}
+
+// UpdateAnswerID sets the "answer_id" field to the value that was provided on create.
+func (u *AnswerVoteUpsertBulk) UpdateAnswerID() *AnswerVoteUpsertBulk {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.UpdateAnswerID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AnswerVoteUpsertBulk) SetUserID(v int) *AnswerVoteUpsertBulk {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AnswerVoteUpsertBulk) UpdateUserID() *AnswerVoteUpsertBulk {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *AnswerVoteUpsertBulk) SetValue(v int) *AnswerVoteUpsertBulk {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// AddValue adds v to the "value" field.
+func (u *AnswerVoteUpsertBulk) AddValue(v int) *AnswerVoteUpsertBulk {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.AddValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *AnswerVoteUpsertBulk) UpdateValue() *AnswerVoteUpsertBulk {
+	return u.Update(func(s *AnswerVoteUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// Exec executes the query.
+func (u *AnswerVoteUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AnswerVoteCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnswerVoteCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnswerVoteUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/ent/answervote"
+	"backend/internal/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AnswerVoteDelete is the builder for deleting a AnswerVote entity.
+type AnswerVoteDelete struct {
+	config
+	hooks    []Hook
+	mutation *AnswerVoteMutation
+}
+
+// Where appends a list predicates to the AnswerVoteDelete builder.
+func (avd *AnswerVoteDelete) Where(ps ...predicate.AnswerVote) *AnswerVoteDelete {
+	avd.mutation.Where(ps...)
+	return avd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (avd *AnswerVoteDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, avd.sqlExec, avd.mutation, avd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (avd *AnswerVoteDelete) ExecX(ctx context.Context) int {
+	n, err := avd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (avd *AnswerVoteDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(answervote.Table, sqlgraph.NewFieldSpec(answervote.FieldID, field.TypeInt))
+	if ps := avd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, avd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	avd.mutation.done = true
+	return affected, err
+}
+
+// AnswerVoteDeleteOne is the builder for deleting a single AnswerVote entity.
+type AnswerVoteDeleteOne struct {
+	avd *AnswerVoteDelete
+}
+
+// Where appends a list predicates to the AnswerVoteDelete builder.
+func (avdo *AnswerVoteDeleteOne) Where(ps ...predicate.AnswerVote) *AnswerVoteDeleteOne {
+	avdo.avd.mutation.Where(ps...)
+	return avdo
+}
+
+// Exec executes the deletion query.
+func (avdo *AnswerVoteDeleteOne) Exec(ctx context.Context) error {
+	n, err := avdo.avd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{answervote.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (avdo *AnswerVoteDeleteOne) ExecX(ctx context.Context) {
+	if err := avdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
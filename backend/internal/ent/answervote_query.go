@@ -0,0 +1,702 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/ent/answer"
+	"backend/internal/ent/answervote"
+	"backend/internal/ent/predicate"
+	"backend/internal/ent/user"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AnswerVoteQuery is the builder for querying AnswerVote entities.
+type AnswerVoteQuery struct {
+	config
+	ctx        *QueryContext
+	order      []answervote.OrderOption
+	inters     []Interceptor
+	predicates []predicate.AnswerVote
+	withAnswer *AnswerQuery
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AnswerVoteQuery builder.
+func (avq *AnswerVoteQuery) Where(ps ...predicate.AnswerVote) *AnswerVoteQuery {
+	avq.predicates = append(avq.predicates, ps...)
+	return avq
+}
+
+// Limit the number of records to be returned by this query.
+func (avq *AnswerVoteQuery) Limit(limit int) *AnswerVoteQuery {
+	avq.ctx.Limit = &limit
+	return avq
+}
+
+// Offset to start from.
+func (avq *AnswerVoteQuery) Offset(offset int) *AnswerVoteQuery {
+	avq.ctx.Offset = &offset
+	return avq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (avq *AnswerVoteQuery) Unique(unique bool) *AnswerVoteQuery {
+	avq.ctx.Unique = &unique
+	return avq
+}
+
+// Order specifies how the records should be ordered.
+func (avq *AnswerVoteQuery) Order(o ...answervote.OrderOption) *AnswerVoteQuery {
+	avq.order = append(avq.order, o...)
+	return avq
+}
+
+// QueryAnswer chains the current query on the "answer" edge.
+func (avq *AnswerVoteQuery) QueryAnswer() *AnswerQuery {
+	query := (&AnswerClient{config: avq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := avq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := avq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(answervote.Table, answervote.FieldID, selector),
+			sqlgraph.To(answer.Table, answer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, answervote.AnswerTable, answervote.AnswerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(avq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (avq *AnswerVoteQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: avq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := avq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := avq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(answervote.Table, answervote.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, answervote.UserTable, answervote.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(avq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first AnswerVote entity from the query.
+// Returns a *NotFoundError when no AnswerVote was found.
+func (avq *AnswerVoteQuery) First(ctx context.Context) (*AnswerVote, error) {
+	nodes, err := avq.Limit(1).All(setContextOp(ctx, avq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{answervote.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (avq *AnswerVoteQuery) FirstX(ctx context.Context) *AnswerVote {
+	node, err := avq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AnswerVote ID from the query.
+// Returns a *NotFoundError when no AnswerVote ID was found.
+func (avq *AnswerVoteQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = avq.Limit(1).IDs(setContextOp(ctx, avq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{answervote.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (avq *AnswerVoteQuery) FirstIDX(ctx context.Context) int {
+	id, err := avq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AnswerVote entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AnswerVote entity is found.
+// Returns a *NotFoundError when no AnswerVote entities are found.
+func (avq *AnswerVoteQuery) Only(ctx context.Context) (*AnswerVote, error) {
+	nodes, err := avq.Limit(2).All(setContextOp(ctx, avq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{answervote.Label}
+	default:
+		return nil, &NotSingularError{answervote.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (avq *AnswerVoteQuery) OnlyX(ctx context.Context) *AnswerVote {
+	node, err := avq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AnswerVote ID in the query.
+// Returns a *NotSingularError when more than one AnswerVote ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (avq *AnswerVoteQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = avq.Limit(2).IDs(setContextOp(ctx, avq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{answervote.Label}
+	default:
+		err = &NotSingularError{answervote.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (avq *AnswerVoteQuery) OnlyIDX(ctx context.Context) int {
+	id, err := avq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AnswerVotes.
+func (avq *AnswerVoteQuery) All(ctx context.Context) ([]*AnswerVote, error) {
+	ctx = setContextOp(ctx, avq.ctx, "All")
+	if err := avq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AnswerVote, *AnswerVoteQuery]()
+	return withInterceptors[[]*AnswerVote](ctx, avq, qr, avq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (avq *AnswerVoteQuery) AllX(ctx context.Context) []*AnswerVote {
+	nodes, err := avq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AnswerVote IDs.
+func (avq *AnswerVoteQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if avq.ctx.Unique == nil && avq.path != nil {
+		avq.Unique(true)
+	}
+	ctx = setContextOp(ctx, avq.ctx, "IDs")
+	if err = avq.Select(answervote.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (avq *AnswerVoteQuery) IDsX(ctx context.Context) []int {
+	ids, err := avq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (avq *AnswerVoteQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, avq.ctx, "Count")
+	if err := avq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, avq, querierCount[*AnswerVoteQuery](), avq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (avq *AnswerVoteQuery) CountX(ctx context.Context) int {
+	count, err := avq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (avq *AnswerVoteQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, avq.ctx, "Exist")
+	switch _, err := avq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (avq *AnswerVoteQuery) ExistX(ctx context.Context) bool {
+	exist, err := avq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AnswerVoteQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (avq *AnswerVoteQuery) Clone() *AnswerVoteQuery {
+	if avq == nil {
+		return nil
+	}
+	return &AnswerVoteQuery{
+		config:     avq.config,
+		ctx:        avq.ctx.Clone(),
+		order:      append([]answervote.OrderOption{}, avq.order...),
+		inters:     append([]Interceptor{}, avq.inters...),
+		predicates: append([]predicate.AnswerVote{}, avq.predicates...),
+		withAnswer: avq.withAnswer.Clone(),
+		withUser:   avq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  avq.sql.Clone(),
+		path: avq.path,
+	}
+}
+
+// WithAnswer tells the query-builder to eager-load the nodes that are connected to
+// the "answer" edge. The optional arguments are used to configure the query builder of the edge.
+func (avq *AnswerVoteQuery) WithAnswer(opts ...func(*AnswerQuery)) *AnswerVoteQuery {
+	query := (&AnswerClient{config: avq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	avq.withAnswer = query
+	return avq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (avq *AnswerVoteQuery) WithUser(opts ...func(*UserQuery)) *AnswerVoteQuery {
+	query := (&UserClient{config: avq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	avq.withUser = query
+	return avq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AnswerVote.Query().
+//		GroupBy(answervote.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (avq *AnswerVoteQuery) GroupBy(field string, fields ...string) *AnswerVoteGroupBy {
+	avq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AnswerVoteGroupBy{build: avq}
+	grbuild.flds = &avq.ctx.Fields
+	grbuild.label = answervote.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.AnswerVote.Query().
+//		Select(answervote.FieldCreateTime).
+//		Scan(ctx, &v)
+func (avq *AnswerVoteQuery) Select(fields ...string) *AnswerVoteSelect {
+	avq.ctx.Fields = append(avq.ctx.Fields, fields...)
+	sbuild := &AnswerVoteSelect{AnswerVoteQuery: avq}
+	sbuild.label = answervote.Label
+	sbuild.flds, sbuild.scan = &avq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AnswerVoteSelect configured with the given aggregations.
+func (avq *AnswerVoteQuery) Aggregate(fns ...AggregateFunc) *AnswerVoteSelect {
+	return avq.Select().Aggregate(fns...)
+}
+
+func (avq *AnswerVoteQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range avq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, avq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range avq.ctx.Fields {
+		if !answervote.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if avq.path != nil {
+		prev, err := avq.path(ctx)
+		if err != nil {
+			return err
+		}
+		avq.sql = prev
+	}
+	return nil
+}
+
+func (avq *AnswerVoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AnswerVote, error) {
+	var (
+		nodes       = []*AnswerVote{}
+		_spec       = avq.querySpec()
+		loadedTypes = [2]bool{
+			avq.withAnswer != nil,
+			avq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AnswerVote).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AnswerVote{config: avq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(avq.modifiers) > 0 {
+		_spec.Modifiers = avq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, avq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := avq.withAnswer; query != nil {
+		if err := avq.loadAnswer(ctx, query, nodes, nil,
+			func(n *AnswerVote, e *Answer) { n.Edges.Answer = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := avq.withUser; query != nil {
+		if err := avq.loadUser(ctx, query, nodes, nil,
+			func(n *AnswerVote, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (avq *AnswerVoteQuery) loadAnswer(ctx context.Context, query *AnswerQuery, nodes []*AnswerVote, init func(*AnswerVote), assign func(*AnswerVote, *Answer)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*AnswerVote)
+	for i := range nodes {
+		fk := nodes[i].AnswerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(answer.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "answer_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (avq *AnswerVoteQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*AnswerVote, init func(*AnswerVote), assign func(*AnswerVote, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*AnswerVote)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (avq *AnswerVoteQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := avq.querySpec()
+	if len(avq.modifiers) > 0 {
+		_spec.Modifiers = avq.modifiers
+	}
+	_spec.Node.Columns = avq.ctx.Fields
+	if len(avq.ctx.Fields) > 0 {
+		_spec.Unique = avq.ctx.Unique != nil && *avq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, avq.driver, _spec)
+}
+
+func (avq *AnswerVoteQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(answervote.Table, answervote.Columns, sqlgraph.NewFieldSpec(answervote.FieldID, field.TypeInt))
+	_spec.From = avq.sql
+	if unique := avq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if avq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := avq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, answervote.FieldID)
+		for i := range fields {
+			if fields[i] != answervote.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if avq.withAnswer != nil {
+			_spec.Node.AddColumnOnce(answervote.FieldAnswerID)
+		}
+		if avq.withUser != nil {
+			_spec.Node.AddColumnOnce(answervote.FieldUserID)
+		}
+	}
+	if ps := avq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := avq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := avq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := avq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (avq *AnswerVoteQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(avq.driver.Dialect())
+	t1 := builder.Table(answervote.Table)
+	columns := avq.ctx.Fields
+	if len(columns) == 0 {
+		columns = answervote.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if avq.sql != nil {
+		selector = avq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if avq.ctx.Unique != nil && *avq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range avq.modifiers {
+		m(selector)
+	}
+	for _, p := range avq.predicates {
+		p(selector)
+	}
+	for _, p := range avq.order {
+		p(selector)
+	}
+	if offset := avq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := avq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (avq *AnswerVoteQuery) Modify(modifiers ...func(s *sql.Selector)) *AnswerVoteSelect {
+	avq.modifiers = append(avq.modifiers, modifiers...)
+	return avq.Select()
+}
+
+// AnswerVoteGroupBy is the group-by builder for AnswerVote entities.
+type AnswerVoteGroupBy struct {
+	selector
+	build *AnswerVoteQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (avgb *AnswerVoteGroupBy) Aggregate(fns ...AggregateFunc) *AnswerVoteGroupBy {
+	avgb.fns = append(avgb.fns, fns...)
+	return avgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (avgb *AnswerVoteGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, avgb.build.ctx, "GroupBy")
+	if err := avgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AnswerVoteQuery, *AnswerVoteGroupBy](ctx, avgb.build, avgb, avgb.build.inters, v)
+}
+
+func (avgb *AnswerVoteGroupBy) sqlScan(ctx context.Context, root *AnswerVoteQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(avgb.fns))
+	for _, fn := range avgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*avgb.flds)+len(avgb.fns))
+		for _, f := range *avgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*avgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := avgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AnswerVoteSelect is the builder for selecting fields of AnswerVote entities.
+type AnswerVoteSelect struct {
+	*AnswerVoteQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (avs *AnswerVoteSelect) Aggregate(fns ...AggregateFunc) *AnswerVoteSelect {
+	avs.fns = append(avs.fns, fns...)
+	return avs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (avs *AnswerVoteSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, avs.ctx, "Select")
+	if err := avs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AnswerVoteQuery, *AnswerVoteSelect](ctx, avs.AnswerVoteQuery, avs, avs.inters, v)
+}
+
+func (avs *AnswerVoteSelect) sqlScan(ctx context.Context, root *AnswerVoteQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(avs.fns))
+	for _, fn := range avs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*avs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := avs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (avs *AnswerVoteSelect) Modify(modifiers ...func(s *sql.Selector)) *AnswerVoteSelect {
+	avs.modifiers = append(avs.modifiers, modifiers...)
+	return avs
+}
@@ -0,0 +1,528 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/ent/answer"
+	"backend/internal/ent/answervote"
+	"backend/internal/ent/predicate"
+	"backend/internal/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AnswerVoteUpdate is the builder for updating AnswerVote entities.
+type AnswerVoteUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AnswerVoteMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AnswerVoteUpdate builder.
+func (avu *AnswerVoteUpdate) Where(ps ...predicate.AnswerVote) *AnswerVoteUpdate {
+	avu.mutation.Where(ps...)
+	return avu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (avu *AnswerVoteUpdate) SetUpdateTime(t time.Time) *AnswerVoteUpdate {
+	avu.mutation.SetUpdateTime(t)
+	return avu
+}
+
+// SetAnswerID sets the "answer_id" field.
+func (avu *AnswerVoteUpdate) SetAnswerID(i int) *AnswerVoteUpdate {
+	avu.mutation.SetAnswerID(i)
+	return avu
+}
+
+// SetNillableAnswerID sets the "answer_id" field if the given value is not nil.
+func (avu *AnswerVoteUpdate) SetNillableAnswerID(i *int) *AnswerVoteUpdate {
+	if i != nil {
+		avu.SetAnswerID(*i)
+	}
+	return avu
+}
+
+// SetUserID sets the "user_id" field.
+func (avu *AnswerVoteUpdate) SetUserID(i int) *AnswerVoteUpdate {
+	avu.mutation.SetUserID(i)
+	return avu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (avu *AnswerVoteUpdate) SetNillableUserID(i *int) *AnswerVoteUpdate {
+	if i != nil {
+		avu.SetUserID(*i)
+	}
+	return avu
+}
+
+// SetValue sets the "value" field.
+func (avu *AnswerVoteUpdate) SetValue(i int) *AnswerVoteUpdate {
+	avu.mutation.ResetValue()
+	avu.mutation.SetValue(i)
+	return avu
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (avu *AnswerVoteUpdate) SetNillableValue(i *int) *AnswerVoteUpdate {
+	if i != nil {
+		avu.SetValue(*i)
+	}
+	return avu
+}
+
+// AddValue adds i to the "value" field.
+func (avu *AnswerVoteUpdate) AddValue(i int) *AnswerVoteUpdate {
+	avu.mutation.AddValue(i)
+	return avu
+}
+
+// SetAnswer sets the "answer" edge to the Answer entity.
+func (avu *AnswerVoteUpdate) SetAnswer(a *Answer) *AnswerVoteUpdate {
+	return avu.SetAnswerID(a.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (avu *AnswerVoteUpdate) SetUser(u *User) *AnswerVoteUpdate {
+	return avu.SetUserID(u.ID)
+}
+
+// Mutation returns the AnswerVoteMutation object of the builder.
+func (avu *AnswerVoteUpdate) Mutation() *AnswerVoteMutation {
+	return avu.mutation
+}
+
+// ClearAnswer clears the "answer" edge to the Answer entity.
+func (avu *AnswerVoteUpdate) ClearAnswer() *AnswerVoteUpdate {
+	avu.mutation.ClearAnswer()
+	return avu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (avu *AnswerVoteUpdate) ClearUser() *AnswerVoteUpdate {
+	avu.mutation.ClearUser()
+	return avu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (avu *AnswerVoteUpdate) Save(ctx context.Context) (int, error) {
+	avu.defaults()
+	return withHooks(ctx, avu.sqlSave, avu.mutation, avu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (avu *AnswerVoteUpdate) SaveX(ctx context.Context) int {
+	affected, err := avu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (avu *AnswerVoteUpdate) Exec(ctx context.Context) error {
+	_, err := avu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (avu *AnswerVoteUpdate) ExecX(ctx context.Context) {
+	if err := avu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (avu *AnswerVoteUpdate) defaults() {
+	if _, ok := avu.mutation.UpdateTime(); !ok {
+		v := answervote.UpdateDefaultUpdateTime()
+		avu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (avu *AnswerVoteUpdate) check() error {
+	if _, ok := avu.mutation.AnswerID(); avu.mutation.AnswerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AnswerVote.answer"`)
+	}
+	if _, ok := avu.mutation.UserID(); avu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AnswerVote.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (avu *AnswerVoteUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnswerVoteUpdate {
+	avu.modifiers = append(avu.modifiers, modifiers...)
+	return avu
+}
+
+func (avu *AnswerVoteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := avu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(answervote.Table, answervote.Columns, sqlgraph.NewFieldSpec(answervote.FieldID, field.TypeInt))
+	if ps := avu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := avu.mutation.UpdateTime(); ok {
+		_spec.SetField(answervote.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := avu.mutation.Value(); ok {
+		_spec.SetField(answervote.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := avu.mutation.AddedValue(); ok {
+		_spec.AddField(answervote.FieldValue, field.TypeInt, value)
+	}
+	if avu.mutation.AnswerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answervote.AnswerTable,
+			Columns: []string{answervote.AnswerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := avu.mutation.AnswerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answervote.AnswerTable,
+			Columns: []string{answervote.AnswerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if avu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answervote.UserTable,
+			Columns: []string{answervote.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := avu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answervote.UserTable,
+			Columns: []string{answervote.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(avu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, avu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{answervote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	avu.mutation.done = true
+	return n, nil
+}
+
+// AnswerVoteUpdateOne is the builder for updating a single AnswerVote entity.
+type AnswerVoteUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AnswerVoteMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (avuo *AnswerVoteUpdateOne) SetUpdateTime(t time.Time) *AnswerVoteUpdateOne {
+	avuo.mutation.SetUpdateTime(t)
+	return avuo
+}
+
+// SetAnswerID sets the "answer_id" field.
+func (avuo *AnswerVoteUpdateOne) SetAnswerID(i int) *AnswerVoteUpdateOne {
+	avuo.mutation.SetAnswerID(i)
+	return avuo
+}
+
+// SetNillableAnswerID sets the "answer_id" field if the given value is not nil.
+func (avuo *AnswerVoteUpdateOne) SetNillableAnswerID(i *int) *AnswerVoteUpdateOne {
+	if i != nil {
+		avuo.SetAnswerID(*i)
+	}
+	return avuo
+}
+
+// SetUserID sets the "user_id" field.
+func (avuo *AnswerVoteUpdateOne) SetUserID(i int) *AnswerVoteUpdateOne {
+	avuo.mutation.SetUserID(i)
+	return avuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (avuo *AnswerVoteUpdateOne) SetNillableUserID(i *int) *AnswerVoteUpdateOne {
+	if i != nil {
+		avuo.SetUserID(*i)
+	}
+	return avuo
+}
+
+// SetValue sets the "value" field.
+func (avuo *AnswerVoteUpdateOne) SetValue(i int) *AnswerVoteUpdateOne {
+	avuo.mutation.ResetValue()
+	avuo.mutation.SetValue(i)
+	return avuo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (avuo *AnswerVoteUpdateOne) SetNillableValue(i *int) *AnswerVoteUpdateOne {
+	if i != nil {
+		avuo.SetValue(*i)
+	}
+	return avuo
+}
+
+// AddValue adds i to the "value" field.
+func (avuo *AnswerVoteUpdateOne) AddValue(i int) *AnswerVoteUpdateOne {
+	avuo.mutation.AddValue(i)
+	return avuo
+}
+
+// SetAnswer sets the "answer" edge to the Answer entity.
+func (avuo *AnswerVoteUpdateOne) SetAnswer(a *Answer) *AnswerVoteUpdateOne {
+	return avuo.SetAnswerID(a.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (avuo *AnswerVoteUpdateOne) SetUser(u *User) *AnswerVoteUpdateOne {
+	return avuo.SetUserID(u.ID)
+}
+
+// Mutation returns the AnswerVoteMutation object of the builder.
+func (avuo *AnswerVoteUpdateOne) Mutation() *AnswerVoteMutation {
+	return avuo.mutation
+}
+
+// ClearAnswer clears the "answer" edge to the Answer entity.
+func (avuo *AnswerVoteUpdateOne) ClearAnswer() *AnswerVoteUpdateOne {
+	avuo.mutation.ClearAnswer()
+	return avuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (avuo *AnswerVoteUpdateOne) ClearUser() *AnswerVoteUpdateOne {
+	avuo.mutation.ClearUser()
+	return avuo
+}
+
+// Where appends a list predicates to the AnswerVoteUpdate builder.
+func (avuo *AnswerVoteUpdateOne) Where(ps ...predicate.AnswerVote) *AnswerVoteUpdateOne {
+	avuo.mutation.Where(ps...)
+	return avuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (avuo *AnswerVoteUpdateOne) Select(field string, fields ...string) *AnswerVoteUpdateOne {
+	avuo.fields = append([]string{field}, fields...)
+	return avuo
+}
+
+// Save executes the query and returns the updated AnswerVote entity.
+func (avuo *AnswerVoteUpdateOne) Save(ctx context.Context) (*AnswerVote, error) {
+	avuo.defaults()
+	return withHooks(ctx, avuo.sqlSave, avuo.mutation, avuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (avuo *AnswerVoteUpdateOne) SaveX(ctx context.Context) *AnswerVote {
+	node, err := avuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (avuo *AnswerVoteUpdateOne) Exec(ctx context.Context) error {
+	_, err := avuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (avuo *AnswerVoteUpdateOne) ExecX(ctx context.Context) {
+	if err := avuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (avuo *AnswerVoteUpdateOne) defaults() {
+	if _, ok := avuo.mutation.UpdateTime(); !ok {
+		v := answervote.UpdateDefaultUpdateTime()
+		avuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (avuo *AnswerVoteUpdateOne) check() error {
+	if _, ok := avuo.mutation.AnswerID(); avuo.mutation.AnswerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AnswerVote.answer"`)
+	}
+	if _, ok := avuo.mutation.UserID(); avuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AnswerVote.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (avuo *AnswerVoteUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AnswerVoteUpdateOne {
+	avuo.modifiers = append(avuo.modifiers, modifiers...)
+	return avuo
+}
+
+func (avuo *AnswerVoteUpdateOne) sqlSave(ctx context.Context) (_node *AnswerVote, err error) {
+	if err := avuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(answervote.Table, answervote.Columns, sqlgraph.NewFieldSpec(answervote.FieldID, field.TypeInt))
+	id, ok := avuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AnswerVote.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := avuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, answervote.FieldID)
+		for _, f := range fields {
+			if !answervote.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != answervote.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := avuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := avuo.mutation.UpdateTime(); ok {
+		_spec.SetField(answervote.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := avuo.mutation.Value(); ok {
+		_spec.SetField(answervote.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := avuo.mutation.AddedValue(); ok {
+		_spec.AddField(answervote.FieldValue, field.TypeInt, value)
+	}
+	if avuo.mutation.AnswerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answervote.AnswerTable,
+			Columns: []string{answervote.AnswerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := avuo.mutation.AnswerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answervote.AnswerTable,
+			Columns: []string{answervote.AnswerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if avuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answervote.UserTable,
+			Columns: []string{answervote.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := avuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   answervote.UserTable,
+			Columns: []string{answervote.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(avuo.modifiers...)
+	_node = &AnswerVote{config: avuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, avuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{answervote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	avuo.mutation.done = true
+	return _node, nil
+}
@@ -11,23 +11,32 @@ import (
 
 	"backend/internal/ent/migrate"
 
+	"backend/internal/ent/answer"
+	"backend/internal/ent/answervote"
 	"backend/internal/ent/comment"
 	"backend/internal/ent/follow"
+	"backend/internal/ent/loginattempt"
 	"backend/internal/ent/post"
 	"backend/internal/ent/posttag"
 	"backend/internal/ent/question"
 	"backend/internal/ent/questiontag"
+	"backend/internal/ent/refreshtoken"
+	"backend/internal/ent/session"
 	"backend/internal/ent/tag"
+	"backend/internal/ent/tagalias"
 	"backend/internal/ent/user"
 	"backend/internal/ent/userfavoritepost"
 	"backend/internal/ent/userfavoritequestion"
 	"backend/internal/ent/userlikepost"
 	"backend/internal/ent/userlikequestion"
+	"backend/internal/ent/verificationcode"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -35,10 +44,16 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Answer is the client for interacting with the Answer builders.
+	Answer *AnswerClient
+	// AnswerVote is the client for interacting with the AnswerVote builders.
+	AnswerVote *AnswerVoteClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
 	// Follow is the client for interacting with the Follow builders.
 	Follow *FollowClient
+	// LoginAttempt is the client for interacting with the LoginAttempt builders.
+	LoginAttempt *LoginAttemptClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
 	// PostTag is the client for interacting with the PostTag builders.
@@ -47,8 +62,14 @@ type Client struct {
 	Question *QuestionClient
 	// QuestionTag is the client for interacting with the QuestionTag builders.
 	QuestionTag *QuestionTagClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
+	// TagAlias is the client for interacting with the TagAlias builders.
+	TagAlias *TagAliasClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserFavoritePost is the client for interacting with the UserFavoritePost builders.
@@ -59,6 +80,8 @@ type Client struct {
 	UserLikePost *UserLikePostClient
 	// UserLikeQuestion is the client for interacting with the UserLikeQuestion builders.
 	UserLikeQuestion *UserLikeQuestionClient
+	// VerificationCode is the client for interacting with the VerificationCode builders.
+	VerificationCode *VerificationCodeClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -70,18 +93,25 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Answer = NewAnswerClient(c.config)
+	c.AnswerVote = NewAnswerVoteClient(c.config)
 	c.Comment = NewCommentClient(c.config)
 	c.Follow = NewFollowClient(c.config)
+	c.LoginAttempt = NewLoginAttemptClient(c.config)
 	c.Post = NewPostClient(c.config)
 	c.PostTag = NewPostTagClient(c.config)
 	c.Question = NewQuestionClient(c.config)
 	c.QuestionTag = NewQuestionTagClient(c.config)
+	c.RefreshToken = NewRefreshTokenClient(c.config)
+	c.Session = NewSessionClient(c.config)
 	c.Tag = NewTagClient(c.config)
+	c.TagAlias = NewTagAliasClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserFavoritePost = NewUserFavoritePostClient(c.config)
 	c.UserFavoriteQuestion = NewUserFavoriteQuestionClient(c.config)
 	c.UserLikePost = NewUserLikePostClient(c.config)
 	c.UserLikeQuestion = NewUserLikeQuestionClient(c.config)
+	c.VerificationCode = NewVerificationCodeClient(c.config)
 }
 
 type (
@@ -174,18 +204,25 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                  ctx,
 		config:               cfg,
+		Answer:               NewAnswerClient(cfg),
+		AnswerVote:           NewAnswerVoteClient(cfg),
 		Comment:              NewCommentClient(cfg),
 		Follow:               NewFollowClient(cfg),
+		LoginAttempt:         NewLoginAttemptClient(cfg),
 		Post:                 NewPostClient(cfg),
 		PostTag:              NewPostTagClient(cfg),
 		Question:             NewQuestionClient(cfg),
 		QuestionTag:          NewQuestionTagClient(cfg),
+		RefreshToken:         NewRefreshTokenClient(cfg),
+		Session:              NewSessionClient(cfg),
 		Tag:                  NewTagClient(cfg),
+		TagAlias:             NewTagAliasClient(cfg),
 		User:                 NewUserClient(cfg),
 		UserFavoritePost:     NewUserFavoritePostClient(cfg),
 		UserFavoriteQuestion: NewUserFavoriteQuestionClient(cfg),
 		UserLikePost:         NewUserLikePostClient(cfg),
 		UserLikeQuestion:     NewUserLikeQuestionClient(cfg),
+		VerificationCode:     NewVerificationCodeClient(cfg),
 	}, nil
 }
 
@@ -205,25 +242,32 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                  ctx,
 		config:               cfg,
+		Answer:               NewAnswerClient(cfg),
+		AnswerVote:           NewAnswerVoteClient(cfg),
 		Comment:              NewCommentClient(cfg),
 		Follow:               NewFollowClient(cfg),
+		LoginAttempt:         NewLoginAttemptClient(cfg),
 		Post:                 NewPostClient(cfg),
 		PostTag:              NewPostTagClient(cfg),
 		Question:             NewQuestionClient(cfg),
 		QuestionTag:          NewQuestionTagClient(cfg),
+		RefreshToken:         NewRefreshTokenClient(cfg),
+		Session:              NewSessionClient(cfg),
 		Tag:                  NewTagClient(cfg),
+		TagAlias:             NewTagAliasClient(cfg),
 		User:                 NewUserClient(cfg),
 		UserFavoritePost:     NewUserFavoritePostClient(cfg),
 		UserFavoriteQuestion: NewUserFavoriteQuestionClient(cfg),
 		UserLikePost:         NewUserLikePostClient(cfg),
 		UserLikeQuestion:     NewUserLikeQuestionClient(cfg),
+		VerificationCode:     NewVerificationCodeClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Comment.
+//		Answer.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -246,9 +290,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Comment, c.Follow, c.Post, c.PostTag, c.Question, c.QuestionTag, c.Tag,
+		c.Answer, c.AnswerVote, c.Comment, c.Follow, c.LoginAttempt, c.Post, c.PostTag,
+		c.Question, c.QuestionTag, c.RefreshToken, c.Session, c.Tag, c.TagAlias,
 		c.User, c.UserFavoritePost, c.UserFavoriteQuestion, c.UserLikePost,
-		c.UserLikeQuestion,
+		c.UserLikeQuestion, c.VerificationCode,
 	} {
 		n.Use(hooks...)
 	}
@@ -258,9 +303,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Comment, c.Follow, c.Post, c.PostTag, c.Question, c.QuestionTag, c.Tag,
+		c.Answer, c.AnswerVote, c.Comment, c.Follow, c.LoginAttempt, c.Post, c.PostTag,
+		c.Question, c.QuestionTag, c.RefreshToken, c.Session, c.Tag, c.TagAlias,
 		c.User, c.UserFavoritePost, c.UserFavoriteQuestion, c.UserLikePost,
-		c.UserLikeQuestion,
+		c.UserLikeQuestion, c.VerificationCode,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -269,10 +315,16 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AnswerMutation:
+		return c.Answer.mutate(ctx, m)
+	case *AnswerVoteMutation:
+		return c.AnswerVote.mutate(ctx, m)
 	case *CommentMutation:
 		return c.Comment.mutate(ctx, m)
 	case *FollowMutation:
 		return c.Follow.mutate(ctx, m)
+	case *LoginAttemptMutation:
+		return c.LoginAttempt.mutate(ctx, m)
 	case *PostMutation:
 		return c.Post.mutate(ctx, m)
 	case *PostTagMutation:
@@ -281,8 +333,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Question.mutate(ctx, m)
 	case *QuestionTagMutation:
 		return c.QuestionTag.mutate(ctx, m)
+	case *RefreshTokenMutation:
+		return c.RefreshToken.mutate(ctx, m)
+	case *SessionMutation:
+		return c.Session.mutate(ctx, m)
 	case *TagMutation:
 		return c.Tag.mutate(ctx, m)
+	case *TagAliasMutation:
+		return c.TagAlias.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *UserFavoritePostMutation:
@@ -293,11 +351,376 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.UserLikePost.mutate(ctx, m)
 	case *UserLikeQuestionMutation:
 		return c.UserLikeQuestion.mutate(ctx, m)
+	case *VerificationCodeMutation:
+		return c.VerificationCode.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AnswerClient is a client for the Answer schema.
+type AnswerClient struct {
+	config
+}
+
+// NewAnswerClient returns a client for the Answer from the given config.
+func NewAnswerClient(c config) *AnswerClient {
+	return &AnswerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `answer.Hooks(f(g(h())))`.
+func (c *AnswerClient) Use(hooks ...Hook) {
+	c.hooks.Answer = append(c.hooks.Answer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `answer.Intercept(f(g(h())))`.
+func (c *AnswerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Answer = append(c.inters.Answer, interceptors...)
+}
+
+// Create returns a builder for creating a Answer entity.
+func (c *AnswerClient) Create() *AnswerCreate {
+	mutation := newAnswerMutation(c.config, OpCreate)
+	return &AnswerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Answer entities.
+func (c *AnswerClient) CreateBulk(builders ...*AnswerCreate) *AnswerCreateBulk {
+	return &AnswerCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AnswerClient) MapCreateBulk(slice any, setFunc func(*AnswerCreate, int)) *AnswerCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AnswerCreateBulk{err: fmt.Errorf("calling to AnswerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AnswerCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AnswerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Answer.
+func (c *AnswerClient) Update() *AnswerUpdate {
+	mutation := newAnswerMutation(c.config, OpUpdate)
+	return &AnswerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AnswerClient) UpdateOne(a *Answer) *AnswerUpdateOne {
+	mutation := newAnswerMutation(c.config, OpUpdateOne, withAnswer(a))
+	return &AnswerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AnswerClient) UpdateOneID(id int) *AnswerUpdateOne {
+	mutation := newAnswerMutation(c.config, OpUpdateOne, withAnswerID(id))
+	return &AnswerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Answer.
+func (c *AnswerClient) Delete() *AnswerDelete {
+	mutation := newAnswerMutation(c.config, OpDelete)
+	return &AnswerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AnswerClient) DeleteOne(a *Answer) *AnswerDeleteOne {
+	return c.DeleteOneID(a.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AnswerClient) DeleteOneID(id int) *AnswerDeleteOne {
+	builder := c.Delete().Where(answer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AnswerDeleteOne{builder}
+}
+
+// Query returns a query builder for Answer.
+func (c *AnswerClient) Query() *AnswerQuery {
+	return &AnswerQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAnswer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Answer entity by its id.
+func (c *AnswerClient) Get(ctx context.Context, id int) (*Answer, error) {
+	return c.Query().Where(answer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AnswerClient) GetX(ctx context.Context, id int) *Answer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryQuestion queries the question edge of a Answer.
+func (c *AnswerClient) QueryQuestion(a *Answer) *QuestionQuery {
+	query := (&QuestionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(answer.Table, answer.FieldID, id),
+			sqlgraph.To(question.Table, question.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, answer.QuestionTable, answer.QuestionColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a Answer.
+func (c *AnswerClient) QueryUser(a *Answer) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(answer.Table, answer.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, answer.UserTable, answer.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVotes queries the votes edge of a Answer.
+func (c *AnswerClient) QueryVotes(a *Answer) *AnswerVoteQuery {
+	query := (&AnswerVoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(answer.Table, answer.FieldID, id),
+			sqlgraph.To(answervote.Table, answervote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, answer.VotesTable, answer.VotesColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryComments queries the comments edge of a Answer.
+func (c *AnswerClient) QueryComments(a *Answer) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(answer.Table, answer.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, answer.CommentsTable, answer.CommentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AnswerClient) Hooks() []Hook {
+	hooks := c.hooks.Answer
+	return append(hooks[:len(hooks):len(hooks)], answer.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AnswerClient) Interceptors() []Interceptor {
+	return c.inters.Answer
+}
+
+func (c *AnswerClient) mutate(ctx context.Context, m *AnswerMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AnswerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AnswerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AnswerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AnswerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Answer mutation op: %q", m.Op())
+	}
+}
+
+// AnswerVoteClient is a client for the AnswerVote schema.
+type AnswerVoteClient struct {
+	config
+}
+
+// NewAnswerVoteClient returns a client for the AnswerVote from the given config.
+func NewAnswerVoteClient(c config) *AnswerVoteClient {
+	return &AnswerVoteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `answervote.Hooks(f(g(h())))`.
+func (c *AnswerVoteClient) Use(hooks ...Hook) {
+	c.hooks.AnswerVote = append(c.hooks.AnswerVote, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `answervote.Intercept(f(g(h())))`.
+func (c *AnswerVoteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AnswerVote = append(c.inters.AnswerVote, interceptors...)
+}
+
+// Create returns a builder for creating a AnswerVote entity.
+func (c *AnswerVoteClient) Create() *AnswerVoteCreate {
+	mutation := newAnswerVoteMutation(c.config, OpCreate)
+	return &AnswerVoteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AnswerVote entities.
+func (c *AnswerVoteClient) CreateBulk(builders ...*AnswerVoteCreate) *AnswerVoteCreateBulk {
+	return &AnswerVoteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AnswerVoteClient) MapCreateBulk(slice any, setFunc func(*AnswerVoteCreate, int)) *AnswerVoteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AnswerVoteCreateBulk{err: fmt.Errorf("calling to AnswerVoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AnswerVoteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AnswerVoteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AnswerVote.
+func (c *AnswerVoteClient) Update() *AnswerVoteUpdate {
+	mutation := newAnswerVoteMutation(c.config, OpUpdate)
+	return &AnswerVoteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AnswerVoteClient) UpdateOne(av *AnswerVote) *AnswerVoteUpdateOne {
+	mutation := newAnswerVoteMutation(c.config, OpUpdateOne, withAnswerVote(av))
+	return &AnswerVoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AnswerVoteClient) UpdateOneID(id int) *AnswerVoteUpdateOne {
+	mutation := newAnswerVoteMutation(c.config, OpUpdateOne, withAnswerVoteID(id))
+	return &AnswerVoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AnswerVote.
+func (c *AnswerVoteClient) Delete() *AnswerVoteDelete {
+	mutation := newAnswerVoteMutation(c.config, OpDelete)
+	return &AnswerVoteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AnswerVoteClient) DeleteOne(av *AnswerVote) *AnswerVoteDeleteOne {
+	return c.DeleteOneID(av.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AnswerVoteClient) DeleteOneID(id int) *AnswerVoteDeleteOne {
+	builder := c.Delete().Where(answervote.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AnswerVoteDeleteOne{builder}
+}
+
+// Query returns a query builder for AnswerVote.
+func (c *AnswerVoteClient) Query() *AnswerVoteQuery {
+	return &AnswerVoteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAnswerVote},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AnswerVote entity by its id.
+func (c *AnswerVoteClient) Get(ctx context.Context, id int) (*AnswerVote, error) {
+	return c.Query().Where(answervote.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AnswerVoteClient) GetX(ctx context.Context, id int) *AnswerVote {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAnswer queries the answer edge of a AnswerVote.
+func (c *AnswerVoteClient) QueryAnswer(av *AnswerVote) *AnswerQuery {
+	query := (&AnswerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := av.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(answervote.Table, answervote.FieldID, id),
+			sqlgraph.To(answer.Table, answer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, answervote.AnswerTable, answervote.AnswerColumn),
+		)
+		fromV = sqlgraph.Neighbors(av.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a AnswerVote.
+func (c *AnswerVoteClient) QueryUser(av *AnswerVote) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := av.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(answervote.Table, answervote.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, answervote.UserTable, answervote.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(av.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AnswerVoteClient) Hooks() []Hook {
+	return c.hooks.AnswerVote
+}
+
+// Interceptors returns the client interceptors.
+func (c *AnswerVoteClient) Interceptors() []Interceptor {
+	return c.inters.AnswerVote
+}
+
+func (c *AnswerVoteClient) mutate(ctx context.Context, m *AnswerVoteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AnswerVoteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AnswerVoteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AnswerVoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AnswerVoteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AnswerVote mutation op: %q", m.Op())
+	}
+}
+
 // CommentClient is a client for the Comment schema.
 type CommentClient struct {
 	config
@@ -414,7 +837,7 @@ func (c *CommentClient) QueryUser(co *Comment) *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(comment.Table, comment.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, comment.UserTable, comment.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.UserTable, comment.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
 		return fromV, nil
@@ -430,7 +853,7 @@ func (c *CommentClient) QueryPost(co *Comment) *PostQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(comment.Table, comment.FieldID, id),
 			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, comment.PostTable, comment.PostColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.PostTable, comment.PostColumn),
 		)
 		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
 		return fromV, nil
@@ -446,7 +869,39 @@ func (c *CommentClient) QueryQuestion(co *Comment) *QuestionQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(comment.Table, comment.FieldID, id),
 			sqlgraph.To(question.Table, question.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, comment.QuestionTable, comment.QuestionColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.QuestionTable, comment.QuestionColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAnswer queries the answer edge of a Comment.
+func (c *CommentClient) QueryAnswer(co *Comment) *AnswerQuery {
+	query := (&AnswerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(answer.Table, answer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.AnswerTable, comment.AnswerColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplyToUser queries the reply_to_user edge of a Comment.
+func (c *CommentClient) QueryReplyToUser(co *Comment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, comment.ReplyToUserTable, comment.ReplyToUserColumn),
 		)
 		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
 		return fromV, nil
@@ -462,7 +917,7 @@ func (c *CommentClient) QueryParent(co *Comment) *CommentQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(comment.Table, comment.FieldID, id),
 			sqlgraph.To(comment.Table, comment.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, comment.ParentTable, comment.ParentPrimaryKey...),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.ParentTable, comment.ParentColumn),
 		)
 		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
 		return fromV, nil
@@ -478,7 +933,7 @@ func (c *CommentClient) QueryReplies(co *Comment) *CommentQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(comment.Table, comment.FieldID, id),
 			sqlgraph.To(comment.Table, comment.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, comment.RepliesTable, comment.RepliesPrimaryKey...),
+			sqlgraph.Edge(sqlgraph.O2M, false, comment.RepliesTable, comment.RepliesColumn),
 		)
 		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
 		return fromV, nil
@@ -488,7 +943,8 @@ func (c *CommentClient) QueryReplies(co *Comment) *CommentQuery {
 
 // Hooks returns the client hooks.
 func (c *CommentClient) Hooks() []Hook {
-	return c.hooks.Comment
+	hooks := c.hooks.Comment
+	return append(hooks[:len(hooks):len(hooks)], comment.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -627,7 +1083,7 @@ func (c *FollowClient) QueryFollower(f *Follow) *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(follow.Table, follow.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, follow.FollowerTable, follow.FollowerColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, follow.FollowerTable, follow.FollowerColumn),
 		)
 		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
 		return fromV, nil
@@ -643,36 +1099,170 @@ func (c *FollowClient) QueryFollowing(f *Follow) *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(follow.Table, follow.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, follow.FollowingTable, follow.FollowingColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, follow.FollowingTable, follow.FollowingColumn),
 		)
 		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FollowClient) Hooks() []Hook {
+	hooks := c.hooks.Follow
+	return append(hooks[:len(hooks):len(hooks)], follow.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *FollowClient) Interceptors() []Interceptor {
+	return c.inters.Follow
+}
+
+func (c *FollowClient) mutate(ctx context.Context, m *FollowMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FollowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FollowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FollowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Follow mutation op: %q", m.Op())
+	}
+}
+
+// LoginAttemptClient is a client for the LoginAttempt schema.
+type LoginAttemptClient struct {
+	config
+}
+
+// NewLoginAttemptClient returns a client for the LoginAttempt from the given config.
+func NewLoginAttemptClient(c config) *LoginAttemptClient {
+	return &LoginAttemptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `loginattempt.Hooks(f(g(h())))`.
+func (c *LoginAttemptClient) Use(hooks ...Hook) {
+	c.hooks.LoginAttempt = append(c.hooks.LoginAttempt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `loginattempt.Intercept(f(g(h())))`.
+func (c *LoginAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LoginAttempt = append(c.inters.LoginAttempt, interceptors...)
+}
+
+// Create returns a builder for creating a LoginAttempt entity.
+func (c *LoginAttemptClient) Create() *LoginAttemptCreate {
+	mutation := newLoginAttemptMutation(c.config, OpCreate)
+	return &LoginAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LoginAttempt entities.
+func (c *LoginAttemptClient) CreateBulk(builders ...*LoginAttemptCreate) *LoginAttemptCreateBulk {
+	return &LoginAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LoginAttemptClient) MapCreateBulk(slice any, setFunc func(*LoginAttemptCreate, int)) *LoginAttemptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LoginAttemptCreateBulk{err: fmt.Errorf("calling to LoginAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LoginAttemptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LoginAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LoginAttempt.
+func (c *LoginAttemptClient) Update() *LoginAttemptUpdate {
+	mutation := newLoginAttemptMutation(c.config, OpUpdate)
+	return &LoginAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LoginAttemptClient) UpdateOne(la *LoginAttempt) *LoginAttemptUpdateOne {
+	mutation := newLoginAttemptMutation(c.config, OpUpdateOne, withLoginAttempt(la))
+	return &LoginAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LoginAttemptClient) UpdateOneID(id int) *LoginAttemptUpdateOne {
+	mutation := newLoginAttemptMutation(c.config, OpUpdateOne, withLoginAttemptID(id))
+	return &LoginAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LoginAttempt.
+func (c *LoginAttemptClient) Delete() *LoginAttemptDelete {
+	mutation := newLoginAttemptMutation(c.config, OpDelete)
+	return &LoginAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LoginAttemptClient) DeleteOne(la *LoginAttempt) *LoginAttemptDeleteOne {
+	return c.DeleteOneID(la.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LoginAttemptClient) DeleteOneID(id int) *LoginAttemptDeleteOne {
+	builder := c.Delete().Where(loginattempt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LoginAttemptDeleteOne{builder}
+}
+
+// Query returns a query builder for LoginAttempt.
+func (c *LoginAttemptClient) Query() *LoginAttemptQuery {
+	return &LoginAttemptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLoginAttempt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LoginAttempt entity by its id.
+func (c *LoginAttemptClient) Get(ctx context.Context, id int) (*LoginAttempt, error) {
+	return c.Query().Where(loginattempt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LoginAttemptClient) GetX(ctx context.Context, id int) *LoginAttempt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *FollowClient) Hooks() []Hook {
-	return c.hooks.Follow
+func (c *LoginAttemptClient) Hooks() []Hook {
+	return c.hooks.LoginAttempt
 }
 
 // Interceptors returns the client interceptors.
-func (c *FollowClient) Interceptors() []Interceptor {
-	return c.inters.Follow
+func (c *LoginAttemptClient) Interceptors() []Interceptor {
+	return c.inters.LoginAttempt
 }
 
-func (c *FollowClient) mutate(ctx context.Context, m *FollowMutation) (Value, error) {
+func (c *LoginAttemptClient) mutate(ctx context.Context, m *LoginAttemptMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FollowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LoginAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FollowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LoginAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LoginAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FollowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&LoginAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Follow mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown LoginAttempt mutation op: %q", m.Op())
 	}
 }
 
@@ -792,7 +1382,7 @@ func (c *PostClient) QueryUser(po *Post) *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(post.Table, post.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, post.UserTable, post.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, post.UserTable, post.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
 		return fromV, nil
@@ -1005,7 +1595,7 @@ func (c *PostTagClient) QueryPost(pt *PostTag) *PostQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(posttag.Table, posttag.FieldID, id),
 			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, posttag.PostTable, posttag.PostColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, posttag.PostTable, posttag.PostColumn),
 		)
 		fromV = sqlgraph.Neighbors(pt.driver.Dialect(), step)
 		return fromV, nil
@@ -1021,7 +1611,7 @@ func (c *PostTagClient) QueryTag(pt *PostTag) *TagQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(posttag.Table, posttag.FieldID, id),
 			sqlgraph.To(tag.Table, tag.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, posttag.TagTable, posttag.TagColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, posttag.TagTable, posttag.TagColumn),
 		)
 		fromV = sqlgraph.Neighbors(pt.driver.Dialect(), step)
 		return fromV, nil
@@ -1031,7 +1621,8 @@ func (c *PostTagClient) QueryTag(pt *PostTag) *TagQuery {
 
 // Hooks returns the client hooks.
 func (c *PostTagClient) Hooks() []Hook {
-	return c.hooks.PostTag
+	hooks := c.hooks.PostTag
+	return append(hooks[:len(hooks):len(hooks)], posttag.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -1170,7 +1761,7 @@ func (c *QuestionClient) QueryUser(q *Question) *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(question.Table, question.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, question.UserTable, question.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, question.UserTable, question.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
 		return fromV, nil
@@ -1194,6 +1785,22 @@ func (c *QuestionClient) QueryComments(q *Question) *CommentQuery {
 	return query
 }
 
+// QueryAnswers queries the answers edge of a Question.
+func (c *QuestionClient) QueryAnswers(q *Question) *AnswerQuery {
+	query := (&AnswerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := q.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(question.Table, question.FieldID, id),
+			sqlgraph.To(answer.Table, answer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, question.AnswersTable, question.AnswersColumn),
+		)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryTags queries the tags edge of a Question.
 func (c *QuestionClient) QueryTags(q *Question) *QuestionTagQuery {
 	query := (&QuestionTagClient{config: c.config}).Query()
@@ -1242,6 +1849,22 @@ func (c *QuestionClient) QueryFavorites(q *Question) *UserFavoriteQuestionQuery
 	return query
 }
 
+// QueryAcceptedAnswer queries the accepted_answer edge of a Question.
+func (c *QuestionClient) QueryAcceptedAnswer(q *Question) *AnswerQuery {
+	query := (&AnswerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := q.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(question.Table, question.FieldID, id),
+			sqlgraph.To(answer.Table, answer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, question.AcceptedAnswerTable, question.AcceptedAnswerColumn),
+		)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *QuestionClient) Hooks() []Hook {
 	return c.hooks.Question
@@ -1345,29 +1968,360 @@ func (c *QuestionTagClient) DeleteOne(qt *QuestionTag) *QuestionTagDeleteOne {
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *QuestionTagClient) DeleteOneID(id int) *QuestionTagDeleteOne {
-	builder := c.Delete().Where(questiontag.ID(id))
+func (c *QuestionTagClient) DeleteOneID(id int) *QuestionTagDeleteOne {
+	builder := c.Delete().Where(questiontag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuestionTagDeleteOne{builder}
+}
+
+// Query returns a query builder for QuestionTag.
+func (c *QuestionTagClient) Query() *QuestionTagQuery {
+	return &QuestionTagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuestionTag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QuestionTag entity by its id.
+func (c *QuestionTagClient) Get(ctx context.Context, id int) (*QuestionTag, error) {
+	return c.Query().Where(questiontag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuestionTagClient) GetX(ctx context.Context, id int) *QuestionTag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryQuestion queries the question edge of a QuestionTag.
+func (c *QuestionTagClient) QueryQuestion(qt *QuestionTag) *QuestionQuery {
+	query := (&QuestionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := qt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questiontag.Table, questiontag.FieldID, id),
+			sqlgraph.To(question.Table, question.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questiontag.QuestionTable, questiontag.QuestionColumn),
+		)
+		fromV = sqlgraph.Neighbors(qt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTag queries the tag edge of a QuestionTag.
+func (c *QuestionTagClient) QueryTag(qt *QuestionTag) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := qt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questiontag.Table, questiontag.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questiontag.TagTable, questiontag.TagColumn),
+		)
+		fromV = sqlgraph.Neighbors(qt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QuestionTagClient) Hooks() []Hook {
+	hooks := c.hooks.QuestionTag
+	return append(hooks[:len(hooks):len(hooks)], questiontag.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuestionTagClient) Interceptors() []Interceptor {
+	return c.inters.QuestionTag
+}
+
+func (c *QuestionTagClient) mutate(ctx context.Context, m *QuestionTagMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuestionTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuestionTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuestionTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuestionTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown QuestionTag mutation op: %q", m.Op())
+	}
+}
+
+// RefreshTokenClient is a client for the RefreshToken schema.
+type RefreshTokenClient struct {
+	config
+}
+
+// NewRefreshTokenClient returns a client for the RefreshToken from the given config.
+func NewRefreshTokenClient(c config) *RefreshTokenClient {
+	return &RefreshTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `refreshtoken.Hooks(f(g(h())))`.
+func (c *RefreshTokenClient) Use(hooks ...Hook) {
+	c.hooks.RefreshToken = append(c.hooks.RefreshToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `refreshtoken.Intercept(f(g(h())))`.
+func (c *RefreshTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RefreshToken = append(c.inters.RefreshToken, interceptors...)
+}
+
+// Create returns a builder for creating a RefreshToken entity.
+func (c *RefreshTokenClient) Create() *RefreshTokenCreate {
+	mutation := newRefreshTokenMutation(c.config, OpCreate)
+	return &RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RefreshToken entities.
+func (c *RefreshTokenClient) CreateBulk(builders ...*RefreshTokenCreate) *RefreshTokenCreateBulk {
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RefreshTokenClient) MapCreateBulk(slice any, setFunc func(*RefreshTokenCreate, int)) *RefreshTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RefreshTokenCreateBulk{err: fmt.Errorf("calling to RefreshTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RefreshTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RefreshToken.
+func (c *RefreshTokenClient) Update() *RefreshTokenUpdate {
+	mutation := newRefreshTokenMutation(c.config, OpUpdate)
+	return &RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RefreshTokenClient) UpdateOne(rt *RefreshToken) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshToken(rt))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RefreshTokenClient) UpdateOneID(id int) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshTokenID(id))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RefreshToken.
+func (c *RefreshTokenClient) Delete() *RefreshTokenDelete {
+	mutation := newRefreshTokenMutation(c.config, OpDelete)
+	return &RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RefreshTokenClient) DeleteOne(rt *RefreshToken) *RefreshTokenDeleteOne {
+	return c.DeleteOneID(rt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RefreshTokenClient) DeleteOneID(id int) *RefreshTokenDeleteOne {
+	builder := c.Delete().Where(refreshtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RefreshTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for RefreshToken.
+func (c *RefreshTokenClient) Query() *RefreshTokenQuery {
+	return &RefreshTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRefreshToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RefreshToken entity by its id.
+func (c *RefreshTokenClient) Get(ctx context.Context, id int) (*RefreshToken, error) {
+	return c.Query().Where(refreshtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RefreshTokenClient) GetX(ctx context.Context, id int) *RefreshToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a RefreshToken.
+func (c *RefreshTokenClient) QueryUser(rt *RefreshToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(refreshtoken.Table, refreshtoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, refreshtoken.UserTable, refreshtoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(rt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySession queries the session edge of a RefreshToken.
+func (c *RefreshTokenClient) QuerySession(rt *RefreshToken) *SessionQuery {
+	query := (&SessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(refreshtoken.Table, refreshtoken.FieldID, id),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, refreshtoken.SessionTable, refreshtoken.SessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(rt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RefreshTokenClient) Hooks() []Hook {
+	return c.hooks.RefreshToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *RefreshTokenClient) Interceptors() []Interceptor {
+	return c.inters.RefreshToken
+}
+
+func (c *RefreshTokenClient) mutate(ctx context.Context, m *RefreshTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RefreshToken mutation op: %q", m.Op())
+	}
+}
+
+// SessionClient is a client for the Session schema.
+type SessionClient struct {
+	config
+}
+
+// NewSessionClient returns a client for the Session from the given config.
+func NewSessionClient(c config) *SessionClient {
+	return &SessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `session.Hooks(f(g(h())))`.
+func (c *SessionClient) Use(hooks ...Hook) {
+	c.hooks.Session = append(c.hooks.Session, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `session.Intercept(f(g(h())))`.
+func (c *SessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Session = append(c.inters.Session, interceptors...)
+}
+
+// Create returns a builder for creating a Session entity.
+func (c *SessionClient) Create() *SessionCreate {
+	mutation := newSessionMutation(c.config, OpCreate)
+	return &SessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Session entities.
+func (c *SessionClient) CreateBulk(builders ...*SessionCreate) *SessionCreateBulk {
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SessionClient) MapCreateBulk(slice any, setFunc func(*SessionCreate, int)) *SessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SessionCreateBulk{err: fmt.Errorf("calling to SessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Session.
+func (c *SessionClient) Update() *SessionUpdate {
+	mutation := newSessionMutation(c.config, OpUpdate)
+	return &SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SessionClient) UpdateOne(s *Session) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSession(s))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SessionClient) UpdateOneID(id int) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSessionID(id))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Session.
+func (c *SessionClient) Delete() *SessionDelete {
+	mutation := newSessionMutation(c.config, OpDelete)
+	return &SessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SessionClient) DeleteOne(s *Session) *SessionDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SessionClient) DeleteOneID(id int) *SessionDeleteOne {
+	builder := c.Delete().Where(session.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &QuestionTagDeleteOne{builder}
+	return &SessionDeleteOne{builder}
 }
 
-// Query returns a query builder for QuestionTag.
-func (c *QuestionTagClient) Query() *QuestionTagQuery {
-	return &QuestionTagQuery{
+// Query returns a query builder for Session.
+func (c *SessionClient) Query() *SessionQuery {
+	return &SessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeQuestionTag},
+		ctx:    &QueryContext{Type: TypeSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a QuestionTag entity by its id.
-func (c *QuestionTagClient) Get(ctx context.Context, id int) (*QuestionTag, error) {
-	return c.Query().Where(questiontag.ID(id)).Only(ctx)
+// Get returns a Session entity by its id.
+func (c *SessionClient) Get(ctx context.Context, id int) (*Session, error) {
+	return c.Query().Where(session.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *QuestionTagClient) GetX(ctx context.Context, id int) *QuestionTag {
+func (c *SessionClient) GetX(ctx context.Context, id int) *Session {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1375,60 +2329,60 @@ func (c *QuestionTagClient) GetX(ctx context.Context, id int) *QuestionTag {
 	return obj
 }
 
-// QueryQuestion queries the question edge of a QuestionTag.
-func (c *QuestionTagClient) QueryQuestion(qt *QuestionTag) *QuestionQuery {
-	query := (&QuestionClient{config: c.config}).Query()
+// QueryUser queries the user edge of a Session.
+func (c *SessionClient) QueryUser(s *Session) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := qt.ID
+		id := s.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(questiontag.Table, questiontag.FieldID, id),
-			sqlgraph.To(question.Table, question.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, questiontag.QuestionTable, questiontag.QuestionColumn),
+			sqlgraph.From(session.Table, session.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, session.UserTable, session.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(qt.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryTag queries the tag edge of a QuestionTag.
-func (c *QuestionTagClient) QueryTag(qt *QuestionTag) *TagQuery {
-	query := (&TagClient{config: c.config}).Query()
+// QueryRefreshTokens queries the refresh_tokens edge of a Session.
+func (c *SessionClient) QueryRefreshTokens(s *Session) *RefreshTokenQuery {
+	query := (&RefreshTokenClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := qt.ID
+		id := s.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(questiontag.Table, questiontag.FieldID, id),
-			sqlgraph.To(tag.Table, tag.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, questiontag.TagTable, questiontag.TagColumn),
+			sqlgraph.From(session.Table, session.FieldID, id),
+			sqlgraph.To(refreshtoken.Table, refreshtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, session.RefreshTokensTable, session.RefreshTokensColumn),
 		)
-		fromV = sqlgraph.Neighbors(qt.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *QuestionTagClient) Hooks() []Hook {
-	return c.hooks.QuestionTag
+func (c *SessionClient) Hooks() []Hook {
+	return c.hooks.Session
 }
 
 // Interceptors returns the client interceptors.
-func (c *QuestionTagClient) Interceptors() []Interceptor {
-	return c.inters.QuestionTag
+func (c *SessionClient) Interceptors() []Interceptor {
+	return c.inters.Session
 }
 
-func (c *QuestionTagClient) mutate(ctx context.Context, m *QuestionTagMutation) (Value, error) {
+func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&QuestionTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&QuestionTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&QuestionTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&QuestionTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown QuestionTag mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Session mutation op: %q", m.Op())
 	}
 }
 
@@ -1572,6 +2526,22 @@ func (c *TagClient) QueryQuestions(t *Tag) *QuestionTagQuery {
 	return query
 }
 
+// QueryAliases queries the aliases edge of a Tag.
+func (c *TagClient) QueryAliases(t *Tag) *TagAliasQuery {
+	query := (&TagAliasClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(tagalias.Table, tagalias.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tag.AliasesTable, tag.AliasesColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TagClient) Hooks() []Hook {
 	return c.hooks.Tag
@@ -1597,6 +2567,155 @@ func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
 	}
 }
 
+// TagAliasClient is a client for the TagAlias schema.
+type TagAliasClient struct {
+	config
+}
+
+// NewTagAliasClient returns a client for the TagAlias from the given config.
+func NewTagAliasClient(c config) *TagAliasClient {
+	return &TagAliasClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tagalias.Hooks(f(g(h())))`.
+func (c *TagAliasClient) Use(hooks ...Hook) {
+	c.hooks.TagAlias = append(c.hooks.TagAlias, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tagalias.Intercept(f(g(h())))`.
+func (c *TagAliasClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TagAlias = append(c.inters.TagAlias, interceptors...)
+}
+
+// Create returns a builder for creating a TagAlias entity.
+func (c *TagAliasClient) Create() *TagAliasCreate {
+	mutation := newTagAliasMutation(c.config, OpCreate)
+	return &TagAliasCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TagAlias entities.
+func (c *TagAliasClient) CreateBulk(builders ...*TagAliasCreate) *TagAliasCreateBulk {
+	return &TagAliasCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TagAliasClient) MapCreateBulk(slice any, setFunc func(*TagAliasCreate, int)) *TagAliasCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TagAliasCreateBulk{err: fmt.Errorf("calling to TagAliasClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TagAliasCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TagAliasCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TagAlias.
+func (c *TagAliasClient) Update() *TagAliasUpdate {
+	mutation := newTagAliasMutation(c.config, OpUpdate)
+	return &TagAliasUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagAliasClient) UpdateOne(ta *TagAlias) *TagAliasUpdateOne {
+	mutation := newTagAliasMutation(c.config, OpUpdateOne, withTagAlias(ta))
+	return &TagAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TagAliasClient) UpdateOneID(id int) *TagAliasUpdateOne {
+	mutation := newTagAliasMutation(c.config, OpUpdateOne, withTagAliasID(id))
+	return &TagAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TagAlias.
+func (c *TagAliasClient) Delete() *TagAliasDelete {
+	mutation := newTagAliasMutation(c.config, OpDelete)
+	return &TagAliasDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TagAliasClient) DeleteOne(ta *TagAlias) *TagAliasDeleteOne {
+	return c.DeleteOneID(ta.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TagAliasClient) DeleteOneID(id int) *TagAliasDeleteOne {
+	builder := c.Delete().Where(tagalias.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TagAliasDeleteOne{builder}
+}
+
+// Query returns a query builder for TagAlias.
+func (c *TagAliasClient) Query() *TagAliasQuery {
+	return &TagAliasQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTagAlias},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TagAlias entity by its id.
+func (c *TagAliasClient) Get(ctx context.Context, id int) (*TagAlias, error) {
+	return c.Query().Where(tagalias.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TagAliasClient) GetX(ctx context.Context, id int) *TagAlias {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTag queries the tag edge of a TagAlias.
+func (c *TagAliasClient) QueryTag(ta *TagAlias) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ta.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tagalias.Table, tagalias.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tagalias.TagTable, tagalias.TagColumn),
+		)
+		fromV = sqlgraph.Neighbors(ta.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TagAliasClient) Hooks() []Hook {
+	return c.hooks.TagAlias
+}
+
+// Interceptors returns the client interceptors.
+func (c *TagAliasClient) Interceptors() []Interceptor {
+	return c.inters.TagAlias
+}
+
+func (c *TagAliasClient) mutate(ctx context.Context, m *TagAliasMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TagAliasCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TagAliasUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TagAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TagAliasDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TagAlias mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -1737,6 +2856,38 @@ func (c *UserClient) QueryQuestions(u *User) *QuestionQuery {
 	return query
 }
 
+// QueryAnswers queries the answers edge of a User.
+func (c *UserClient) QueryAnswers(u *User) *AnswerQuery {
+	query := (&AnswerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(answer.Table, answer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AnswersTable, user.AnswersColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAnswerVotes queries the answer_votes edge of a User.
+func (c *UserClient) QueryAnswerVotes(u *User) *AnswerVoteQuery {
+	query := (&AnswerVoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(answervote.Table, answervote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AnswerVotesTable, user.AnswerVotesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryComments queries the comments edge of a User.
 func (c *UserClient) QueryComments(u *User) *CommentQuery {
 	query := (&CommentClient{config: c.config}).Query()
@@ -1849,6 +3000,38 @@ func (c *UserClient) QueryFollowedBy(u *User) *FollowQuery {
 	return query
 }
 
+// QueryRefreshTokens queries the refresh_tokens edge of a User.
+func (c *UserClient) QueryRefreshTokens(u *User) *RefreshTokenQuery {
+	query := (&RefreshTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(refreshtoken.Table, refreshtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RefreshTokensTable, user.RefreshTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySessions queries the sessions edge of a User.
+func (c *UserClient) QuerySessions(u *User) *SessionQuery {
+	query := (&SessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SessionsTable, user.SessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1990,7 +3173,7 @@ func (c *UserFavoritePostClient) QueryUser(ufp *UserFavoritePost) *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(userfavoritepost.Table, userfavoritepost.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, userfavoritepost.UserTable, userfavoritepost.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, userfavoritepost.UserTable, userfavoritepost.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(ufp.driver.Dialect(), step)
 		return fromV, nil
@@ -2006,7 +3189,7 @@ func (c *UserFavoritePostClient) QueryPost(ufp *UserFavoritePost) *PostQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(userfavoritepost.Table, userfavoritepost.FieldID, id),
 			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, userfavoritepost.PostTable, userfavoritepost.PostColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, userfavoritepost.PostTable, userfavoritepost.PostColumn),
 		)
 		fromV = sqlgraph.Neighbors(ufp.driver.Dialect(), step)
 		return fromV, nil
@@ -2016,7 +3199,8 @@ func (c *UserFavoritePostClient) QueryPost(ufp *UserFavoritePost) *PostQuery {
 
 // Hooks returns the client hooks.
 func (c *UserFavoritePostClient) Hooks() []Hook {
-	return c.hooks.UserFavoritePost
+	hooks := c.hooks.UserFavoritePost
+	return append(hooks[:len(hooks):len(hooks)], userfavoritepost.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -2155,7 +3339,7 @@ func (c *UserFavoriteQuestionClient) QueryUser(ufq *UserFavoriteQuestion) *UserQ
 		step := sqlgraph.NewStep(
 			sqlgraph.From(userfavoritequestion.Table, userfavoritequestion.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, userfavoritequestion.UserTable, userfavoritequestion.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, userfavoritequestion.UserTable, userfavoritequestion.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(ufq.driver.Dialect(), step)
 		return fromV, nil
@@ -2171,7 +3355,7 @@ func (c *UserFavoriteQuestionClient) QueryQuestion(ufq *UserFavoriteQuestion) *Q
 		step := sqlgraph.NewStep(
 			sqlgraph.From(userfavoritequestion.Table, userfavoritequestion.FieldID, id),
 			sqlgraph.To(question.Table, question.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, userfavoritequestion.QuestionTable, userfavoritequestion.QuestionColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, userfavoritequestion.QuestionTable, userfavoritequestion.QuestionColumn),
 		)
 		fromV = sqlgraph.Neighbors(ufq.driver.Dialect(), step)
 		return fromV, nil
@@ -2181,7 +3365,8 @@ func (c *UserFavoriteQuestionClient) QueryQuestion(ufq *UserFavoriteQuestion) *Q
 
 // Hooks returns the client hooks.
 func (c *UserFavoriteQuestionClient) Hooks() []Hook {
-	return c.hooks.UserFavoriteQuestion
+	hooks := c.hooks.UserFavoriteQuestion
+	return append(hooks[:len(hooks):len(hooks)], userfavoritequestion.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -2320,7 +3505,7 @@ func (c *UserLikePostClient) QueryUser(ulp *UserLikePost) *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(userlikepost.Table, userlikepost.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, userlikepost.UserTable, userlikepost.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, userlikepost.UserTable, userlikepost.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(ulp.driver.Dialect(), step)
 		return fromV, nil
@@ -2336,7 +3521,7 @@ func (c *UserLikePostClient) QueryPost(ulp *UserLikePost) *PostQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(userlikepost.Table, userlikepost.FieldID, id),
 			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, userlikepost.PostTable, userlikepost.PostColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, userlikepost.PostTable, userlikepost.PostColumn),
 		)
 		fromV = sqlgraph.Neighbors(ulp.driver.Dialect(), step)
 		return fromV, nil
@@ -2346,7 +3531,8 @@ func (c *UserLikePostClient) QueryPost(ulp *UserLikePost) *PostQuery {
 
 // Hooks returns the client hooks.
 func (c *UserLikePostClient) Hooks() []Hook {
-	return c.hooks.UserLikePost
+	hooks := c.hooks.UserLikePost
+	return append(hooks[:len(hooks):len(hooks)], userlikepost.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -2485,7 +3671,7 @@ func (c *UserLikeQuestionClient) QueryUser(ulq *UserLikeQuestion) *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(userlikequestion.Table, userlikequestion.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, userlikequestion.UserTable, userlikequestion.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, userlikequestion.UserTable, userlikequestion.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(ulq.driver.Dialect(), step)
 		return fromV, nil
@@ -2501,7 +3687,7 @@ func (c *UserLikeQuestionClient) QueryQuestion(ulq *UserLikeQuestion) *QuestionQ
 		step := sqlgraph.NewStep(
 			sqlgraph.From(userlikequestion.Table, userlikequestion.FieldID, id),
 			sqlgraph.To(question.Table, question.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, userlikequestion.QuestionTable, userlikequestion.QuestionColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, userlikequestion.QuestionTable, userlikequestion.QuestionColumn),
 		)
 		fromV = sqlgraph.Neighbors(ulq.driver.Dialect(), step)
 		return fromV, nil
@@ -2511,7 +3697,8 @@ func (c *UserLikeQuestionClient) QueryQuestion(ulq *UserLikeQuestion) *QuestionQ
 
 // Hooks returns the client hooks.
 func (c *UserLikeQuestionClient) Hooks() []Hook {
-	return c.hooks.UserLikeQuestion
+	hooks := c.hooks.UserLikeQuestion
+	return append(hooks[:len(hooks):len(hooks)], userlikequestion.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -2534,16 +3721,175 @@ func (c *UserLikeQuestionClient) mutate(ctx context.Context, m *UserLikeQuestion
 	}
 }
 
+// VerificationCodeClient is a client for the VerificationCode schema.
+type VerificationCodeClient struct {
+	config
+}
+
+// NewVerificationCodeClient returns a client for the VerificationCode from the given config.
+func NewVerificationCodeClient(c config) *VerificationCodeClient {
+	return &VerificationCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `verificationcode.Hooks(f(g(h())))`.
+func (c *VerificationCodeClient) Use(hooks ...Hook) {
+	c.hooks.VerificationCode = append(c.hooks.VerificationCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `verificationcode.Intercept(f(g(h())))`.
+func (c *VerificationCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VerificationCode = append(c.inters.VerificationCode, interceptors...)
+}
+
+// Create returns a builder for creating a VerificationCode entity.
+func (c *VerificationCodeClient) Create() *VerificationCodeCreate {
+	mutation := newVerificationCodeMutation(c.config, OpCreate)
+	return &VerificationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VerificationCode entities.
+func (c *VerificationCodeClient) CreateBulk(builders ...*VerificationCodeCreate) *VerificationCodeCreateBulk {
+	return &VerificationCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VerificationCodeClient) MapCreateBulk(slice any, setFunc func(*VerificationCodeCreate, int)) *VerificationCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VerificationCodeCreateBulk{err: fmt.Errorf("calling to VerificationCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VerificationCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VerificationCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VerificationCode.
+func (c *VerificationCodeClient) Update() *VerificationCodeUpdate {
+	mutation := newVerificationCodeMutation(c.config, OpUpdate)
+	return &VerificationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VerificationCodeClient) UpdateOne(vc *VerificationCode) *VerificationCodeUpdateOne {
+	mutation := newVerificationCodeMutation(c.config, OpUpdateOne, withVerificationCode(vc))
+	return &VerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VerificationCodeClient) UpdateOneID(id int) *VerificationCodeUpdateOne {
+	mutation := newVerificationCodeMutation(c.config, OpUpdateOne, withVerificationCodeID(id))
+	return &VerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VerificationCode.
+func (c *VerificationCodeClient) Delete() *VerificationCodeDelete {
+	mutation := newVerificationCodeMutation(c.config, OpDelete)
+	return &VerificationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VerificationCodeClient) DeleteOne(vc *VerificationCode) *VerificationCodeDeleteOne {
+	return c.DeleteOneID(vc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VerificationCodeClient) DeleteOneID(id int) *VerificationCodeDeleteOne {
+	builder := c.Delete().Where(verificationcode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VerificationCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for VerificationCode.
+func (c *VerificationCodeClient) Query() *VerificationCodeQuery {
+	return &VerificationCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVerificationCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VerificationCode entity by its id.
+func (c *VerificationCodeClient) Get(ctx context.Context, id int) (*VerificationCode, error) {
+	return c.Query().Where(verificationcode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VerificationCodeClient) GetX(ctx context.Context, id int) *VerificationCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *VerificationCodeClient) Hooks() []Hook {
+	return c.hooks.VerificationCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *VerificationCodeClient) Interceptors() []Interceptor {
+	return c.inters.VerificationCode
+}
+
+func (c *VerificationCodeClient) mutate(ctx context.Context, m *VerificationCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VerificationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VerificationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VerificationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VerificationCode mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Comment, Follow, Post, PostTag, Question, QuestionTag, Tag, User,
-		UserFavoritePost, UserFavoriteQuestion, UserLikePost,
-		UserLikeQuestion []ent.Hook
+		Answer, AnswerVote, Comment, Follow, LoginAttempt, Post, PostTag, Question,
+		QuestionTag, RefreshToken, Session, Tag, TagAlias, User, UserFavoritePost,
+		UserFavoriteQuestion, UserLikePost, UserLikeQuestion,
+		VerificationCode []ent.Hook
 	}
 	inters struct {
-		Comment, Follow, Post, PostTag, Question, QuestionTag, Tag, User,
-		UserFavoritePost, UserFavoriteQuestion, UserLikePost,
-		UserLikeQuestion []ent.Interceptor
+		Answer, AnswerVote, Comment, Follow, LoginAttempt, Post, PostTag, Question,
+		QuestionTag, RefreshToken, Session, Tag, TagAlias, User, UserFavoritePost,
+		UserFavoriteQuestion, UserLikePost, UserLikeQuestion,
+		VerificationCode []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -3,7 +3,11 @@
 package ent
 
 import (
+	"backend/internal/ent/answer"
 	"backend/internal/ent/comment"
+	"backend/internal/ent/post"
+	"backend/internal/ent/question"
+	"backend/internal/ent/user"
 	"fmt"
 	"strings"
 	"time"
@@ -29,66 +33,105 @@ type Comment struct {
 	PostID int `json:"post_id,omitempty"`
 	// QuestionID holds the value of the "question_id" field.
 	QuestionID int `json:"question_id,omitempty"`
+	// AnswerID holds the value of the "answer_id" field.
+	AnswerID int `json:"answer_id,omitempty"`
 	// ParentID holds the value of the "parent_id" field.
 	ParentID int `json:"parent_id,omitempty"`
+	// Depth holds the value of the "depth" field.
+	Depth int `json:"depth,omitempty"`
+	// ReplyToUserID holds the value of the "reply_to_user_id" field.
+	ReplyToUserID int `json:"reply_to_user_id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CommentQuery when eager-loading is set.
-	Edges             CommentEdges `json:"edges"`
-	post_comments     *int
-	question_comments *int
-	user_comments     *int
-	selectValues      sql.SelectValues
+	Edges        CommentEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // CommentEdges holds the relations/edges for other nodes in the graph.
 type CommentEdges struct {
 	// User holds the value of the user edge.
-	User []*User `json:"user,omitempty"`
+	User *User `json:"user,omitempty"`
 	// Post holds the value of the post edge.
-	Post []*Post `json:"post,omitempty"`
+	Post *Post `json:"post,omitempty"`
 	// Question holds the value of the question edge.
-	Question []*Question `json:"question,omitempty"`
+	Question *Question `json:"question,omitempty"`
+	// Answer holds the value of the answer edge.
+	Answer *Answer `json:"answer,omitempty"`
+	// ReplyToUser holds the value of the reply_to_user edge.
+	ReplyToUser *User `json:"reply_to_user,omitempty"`
 	// Parent holds the value of the parent edge.
-	Parent []*Comment `json:"parent,omitempty"`
+	Parent *Comment `json:"parent,omitempty"`
 	// Replies holds the value of the replies edge.
 	Replies []*Comment `json:"replies,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [7]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
-// was not loaded in eager-loading.
-func (e CommentEdges) UserOrErr() ([]*User, error) {
-	if e.loadedTypes[0] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
 		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "user"}
 }
 
 // PostOrErr returns the Post value or an error if the edge
-// was not loaded in eager-loading.
-func (e CommentEdges) PostOrErr() ([]*Post, error) {
-	if e.loadedTypes[1] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
 		return e.Post, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: post.Label}
 	}
 	return nil, &NotLoadedError{edge: "post"}
 }
 
 // QuestionOrErr returns the Question value or an error if the edge
-// was not loaded in eager-loading.
-func (e CommentEdges) QuestionOrErr() ([]*Question, error) {
-	if e.loadedTypes[2] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) QuestionOrErr() (*Question, error) {
+	if e.Question != nil {
 		return e.Question, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: question.Label}
 	}
 	return nil, &NotLoadedError{edge: "question"}
 }
 
+// AnswerOrErr returns the Answer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) AnswerOrErr() (*Answer, error) {
+	if e.Answer != nil {
+		return e.Answer, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: answer.Label}
+	}
+	return nil, &NotLoadedError{edge: "answer"}
+}
+
+// ReplyToUserOrErr returns the ReplyToUser value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) ReplyToUserOrErr() (*User, error) {
+	if e.ReplyToUser != nil {
+		return e.ReplyToUser, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "reply_to_user"}
+}
+
 // ParentOrErr returns the Parent value or an error if the edge
-// was not loaded in eager-loading.
-func (e CommentEdges) ParentOrErr() ([]*Comment, error) {
-	if e.loadedTypes[3] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) ParentOrErr() (*Comment, error) {
+	if e.Parent != nil {
 		return e.Parent, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: comment.Label}
 	}
 	return nil, &NotLoadedError{edge: "parent"}
 }
@@ -96,7 +139,7 @@ func (e CommentEdges) ParentOrErr() ([]*Comment, error) {
 // RepliesOrErr returns the Replies value or an error if the edge
 // was not loaded in eager-loading.
 func (e CommentEdges) RepliesOrErr() ([]*Comment, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[6] {
 		return e.Replies, nil
 	}
 	return nil, &NotLoadedError{edge: "replies"}
@@ -107,18 +150,12 @@ func (*Comment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case comment.FieldID, comment.FieldUserID, comment.FieldPostID, comment.FieldQuestionID, comment.FieldParentID:
+		case comment.FieldID, comment.FieldUserID, comment.FieldPostID, comment.FieldQuestionID, comment.FieldAnswerID, comment.FieldParentID, comment.FieldDepth, comment.FieldReplyToUserID:
 			values[i] = new(sql.NullInt64)
 		case comment.FieldContent:
 			values[i] = new(sql.NullString)
-		case comment.FieldCreateTime, comment.FieldUpdateTime:
+		case comment.FieldCreateTime, comment.FieldUpdateTime, comment.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
-		case comment.ForeignKeys[0]: // post_comments
-			values[i] = new(sql.NullInt64)
-		case comment.ForeignKeys[1]: // question_comments
-			values[i] = new(sql.NullInt64)
-		case comment.ForeignKeys[2]: // user_comments
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -176,32 +213,36 @@ func (c *Comment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.QuestionID = int(value.Int64)
 			}
+		case comment.FieldAnswerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field answer_id", values[i])
+			} else if value.Valid {
+				c.AnswerID = int(value.Int64)
+			}
 		case comment.FieldParentID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
 			} else if value.Valid {
 				c.ParentID = int(value.Int64)
 			}
-		case comment.ForeignKeys[0]:
+		case comment.FieldDepth:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field post_comments", value)
+				return fmt.Errorf("unexpected type %T for field depth", values[i])
 			} else if value.Valid {
-				c.post_comments = new(int)
-				*c.post_comments = int(value.Int64)
+				c.Depth = int(value.Int64)
 			}
-		case comment.ForeignKeys[1]:
+		case comment.FieldReplyToUserID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field question_comments", value)
+				return fmt.Errorf("unexpected type %T for field reply_to_user_id", values[i])
 			} else if value.Valid {
-				c.question_comments = new(int)
-				*c.question_comments = int(value.Int64)
+				c.ReplyToUserID = int(value.Int64)
 			}
-		case comment.ForeignKeys[2]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_comments", value)
+		case comment.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
 			} else if value.Valid {
-				c.user_comments = new(int)
-				*c.user_comments = int(value.Int64)
+				c.DeletedAt = new(time.Time)
+				*c.DeletedAt = value.Time
 			}
 		default:
 			c.selectValues.Set(columns[i], values[i])
@@ -231,6 +272,16 @@ func (c *Comment) QueryQuestion() *QuestionQuery {
 	return NewCommentClient(c.config).QueryQuestion(c)
 }
 
+// QueryAnswer queries the "answer" edge of the Comment entity.
+func (c *Comment) QueryAnswer() *AnswerQuery {
+	return NewCommentClient(c.config).QueryAnswer(c)
+}
+
+// QueryReplyToUser queries the "reply_to_user" edge of the Comment entity.
+func (c *Comment) QueryReplyToUser() *UserQuery {
+	return NewCommentClient(c.config).QueryReplyToUser(c)
+}
+
 // QueryParent queries the "parent" edge of the Comment entity.
 func (c *Comment) QueryParent() *CommentQuery {
 	return NewCommentClient(c.config).QueryParent(c)
@@ -282,8 +333,22 @@ func (c *Comment) String() string {
 	builder.WriteString("question_id=")
 	builder.WriteString(fmt.Sprintf("%v", c.QuestionID))
 	builder.WriteString(", ")
+	builder.WriteString("answer_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.AnswerID))
+	builder.WriteString(", ")
 	builder.WriteString("parent_id=")
 	builder.WriteString(fmt.Sprintf("%v", c.ParentID))
+	builder.WriteString(", ")
+	builder.WriteString("depth=")
+	builder.WriteString(fmt.Sprintf("%v", c.Depth))
+	builder.WriteString(", ")
+	builder.WriteString("reply_to_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.ReplyToUserID))
+	builder.WriteString(", ")
+	if v := c.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -5,6 +5,7 @@ package comment
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -26,14 +27,26 @@ const (
 	FieldPostID = "post_id"
 	// FieldQuestionID holds the string denoting the question_id field in the database.
 	FieldQuestionID = "question_id"
+	// FieldAnswerID holds the string denoting the answer_id field in the database.
+	FieldAnswerID = "answer_id"
 	// FieldParentID holds the string denoting the parent_id field in the database.
 	FieldParentID = "parent_id"
+	// FieldDepth holds the string denoting the depth field in the database.
+	FieldDepth = "depth"
+	// FieldReplyToUserID holds the string denoting the reply_to_user_id field in the database.
+	FieldReplyToUserID = "reply_to_user_id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgePost holds the string denoting the post edge name in mutations.
 	EdgePost = "post"
 	// EdgeQuestion holds the string denoting the question edge name in mutations.
 	EdgeQuestion = "question"
+	// EdgeAnswer holds the string denoting the answer edge name in mutations.
+	EdgeAnswer = "answer"
+	// EdgeReplyToUser holds the string denoting the reply_to_user edge name in mutations.
+	EdgeReplyToUser = "reply_to_user"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
 	EdgeParent = "parent"
 	// EdgeReplies holds the string denoting the replies edge name in mutations.
@@ -41,30 +54,48 @@ const (
 	// Table holds the table name of the comment in the database.
 	Table = "comments"
 	// UserTable is the table that holds the user relation/edge.
-	UserTable = "users"
+	UserTable = "comments"
 	// UserInverseTable is the table name for the User entity.
 	// It exists in this package in order to avoid circular dependency with the "user" package.
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
-	UserColumn = "comment_user"
+	UserColumn = "user_id"
 	// PostTable is the table that holds the post relation/edge.
-	PostTable = "posts"
+	PostTable = "comments"
 	// PostInverseTable is the table name for the Post entity.
 	// It exists in this package in order to avoid circular dependency with the "post" package.
 	PostInverseTable = "posts"
 	// PostColumn is the table column denoting the post relation/edge.
-	PostColumn = "comment_post"
+	PostColumn = "post_id"
 	// QuestionTable is the table that holds the question relation/edge.
-	QuestionTable = "questions"
+	QuestionTable = "comments"
 	// QuestionInverseTable is the table name for the Question entity.
 	// It exists in this package in order to avoid circular dependency with the "question" package.
 	QuestionInverseTable = "questions"
 	// QuestionColumn is the table column denoting the question relation/edge.
-	QuestionColumn = "comment_question"
-	// ParentTable is the table that holds the parent relation/edge. The primary key declared below.
-	ParentTable = "comment_parent"
-	// RepliesTable is the table that holds the replies relation/edge. The primary key declared below.
-	RepliesTable = "comment_replies"
+	QuestionColumn = "question_id"
+	// AnswerTable is the table that holds the answer relation/edge.
+	AnswerTable = "comments"
+	// AnswerInverseTable is the table name for the Answer entity.
+	// It exists in this package in order to avoid circular dependency with the "answer" package.
+	AnswerInverseTable = "answers"
+	// AnswerColumn is the table column denoting the answer relation/edge.
+	AnswerColumn = "answer_id"
+	// ReplyToUserTable is the table that holds the reply_to_user relation/edge.
+	ReplyToUserTable = "comments"
+	// ReplyToUserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ReplyToUserInverseTable = "users"
+	// ReplyToUserColumn is the table column denoting the reply_to_user relation/edge.
+	ReplyToUserColumn = "reply_to_user_id"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "comments"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "parent_id"
+	// RepliesTable is the table that holds the replies relation/edge.
+	RepliesTable = "comments"
+	// RepliesColumn is the table column denoting the replies relation/edge.
+	RepliesColumn = "parent_id"
 )
 
 // Columns holds all SQL columns for comment fields.
@@ -76,26 +107,13 @@ var Columns = []string{
 	FieldUserID,
 	FieldPostID,
 	FieldQuestionID,
+	FieldAnswerID,
 	FieldParentID,
+	FieldDepth,
+	FieldReplyToUserID,
+	FieldDeletedAt,
 }
 
-// ForeignKeys holds the SQL foreign-keys that are owned by the "comments"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"post_comments",
-	"question_comments",
-	"user_comments",
-}
-
-var (
-	// ParentPrimaryKey and ParentColumn2 are the table columns denoting the
-	// primary key for the parent relation (M2M).
-	ParentPrimaryKey = []string{"comment_id", "parent_id"}
-	// RepliesPrimaryKey and RepliesColumn2 are the table columns denoting the
-	// primary key for the replies relation (M2M).
-	RepliesPrimaryKey = []string{"comment_id", "reply_id"}
-)
-
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -103,21 +121,24 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "backend/internal/ent/runtime"
 var (
+	Hooks [1]ent.Hook
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
 	DefaultCreateTime func() time.Time
 	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
 	DefaultUpdateTime func() time.Time
 	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
 	UpdateDefaultUpdateTime func() time.Time
+	// DefaultDepth holds the default value on creation for the "depth" field.
+	DefaultDepth int
 )
 
 // OrderOption defines the ordering options for the Comment queries.
@@ -158,64 +179,70 @@ func ByQuestionID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQuestionID, opts...).ToFunc()
 }
 
+// ByAnswerID orders the results by the answer_id field.
+func ByAnswerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswerID, opts...).ToFunc()
+}
+
 // ByParentID orders the results by the parent_id field.
 func ByParentID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldParentID, opts...).ToFunc()
 }
 
-// ByUserCount orders the results by user count.
-func ByUserCount(opts ...sql.OrderTermOption) OrderOption {
-	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newUserStep(), opts...)
-	}
+// ByDepth orders the results by the depth field.
+func ByDepth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepth, opts...).ToFunc()
 }
 
-// ByUser orders the results by user terms.
-func ByUser(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
-	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newUserStep(), append([]sql.OrderTerm{term}, terms...)...)
-	}
+// ByReplyToUserID orders the results by the reply_to_user_id field.
+func ByReplyToUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplyToUserID, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
 }
 
-// ByPostCount orders the results by post count.
-func ByPostCount(opts ...sql.OrderTermOption) OrderOption {
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newPostStep(), opts...)
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
 
-// ByPost orders the results by post terms.
-func ByPost(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+// ByPostField orders the results by post field.
+func ByPostField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newPostStep(), append([]sql.OrderTerm{term}, terms...)...)
+		sqlgraph.OrderByNeighborTerms(s, newPostStep(), sql.OrderByField(field, opts...))
 	}
 }
 
-// ByQuestionCount orders the results by question count.
-func ByQuestionCount(opts ...sql.OrderTermOption) OrderOption {
+// ByQuestionField orders the results by question field.
+func ByQuestionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newQuestionStep(), opts...)
+		sqlgraph.OrderByNeighborTerms(s, newQuestionStep(), sql.OrderByField(field, opts...))
 	}
 }
 
-// ByQuestion orders the results by question terms.
-func ByQuestion(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+// ByAnswerField orders the results by answer field.
+func ByAnswerField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newQuestionStep(), append([]sql.OrderTerm{term}, terms...)...)
+		sqlgraph.OrderByNeighborTerms(s, newAnswerStep(), sql.OrderByField(field, opts...))
 	}
 }
 
-// ByParentCount orders the results by parent count.
-func ByParentCount(opts ...sql.OrderTermOption) OrderOption {
+// ByReplyToUserField orders the results by reply_to_user field.
+func ByReplyToUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newParentStep(), opts...)
+		sqlgraph.OrderByNeighborTerms(s, newReplyToUserStep(), sql.OrderByField(field, opts...))
 	}
 }
 
-// ByParent orders the results by parent terms.
-func ByParent(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+// ByParentField orders the results by parent field.
+func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newParentStep(), append([]sql.OrderTerm{term}, terms...)...)
+		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
 	}
 }
 
@@ -236,34 +263,48 @@ func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(UserInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, UserTable, UserColumn),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
 func newPostStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(PostInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, PostTable, PostColumn),
+		sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
 	)
 }
 func newQuestionStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(QuestionInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, QuestionTable, QuestionColumn),
+		sqlgraph.Edge(sqlgraph.M2O, true, QuestionTable, QuestionColumn),
+	)
+}
+func newAnswerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AnswerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AnswerTable, AnswerColumn),
+	)
+}
+func newReplyToUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReplyToUserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ReplyToUserTable, ReplyToUserColumn),
 	)
 }
 func newParentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(Table, FieldID),
-		sqlgraph.Edge(sqlgraph.M2M, false, ParentTable, ParentPrimaryKey...),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
 	)
 }
 func newRepliesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(Table, FieldID),
-		sqlgraph.Edge(sqlgraph.M2M, false, RepliesTable, RepliesPrimaryKey...),
+		sqlgraph.Edge(sqlgraph.O2M, false, RepliesTable, RepliesColumn),
 	)
 }
@@ -85,11 +85,31 @@ func QuestionID(v int) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldQuestionID, v))
 }
 
+// AnswerID applies equality check predicate on the "answer_id" field. It's identical to AnswerIDEQ.
+func AnswerID(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldAnswerID, v))
+}
+
 // ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
 func ParentID(v int) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldParentID, v))
 }
 
+// Depth applies equality check predicate on the "depth" field. It's identical to DepthEQ.
+func Depth(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldDepth, v))
+}
+
+// ReplyToUserID applies equality check predicate on the "reply_to_user_id" field. It's identical to ReplyToUserIDEQ.
+func ReplyToUserID(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldReplyToUserID, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldCreateTime, v))
@@ -265,26 +285,6 @@ func UserIDNotIn(vs ...int) predicate.Comment {
 	return predicate.Comment(sql.FieldNotIn(FieldUserID, vs...))
 }
 
-// UserIDGT applies the GT predicate on the "user_id" field.
-func UserIDGT(v int) predicate.Comment {
-	return predicate.Comment(sql.FieldGT(FieldUserID, v))
-}
-
-// UserIDGTE applies the GTE predicate on the "user_id" field.
-func UserIDGTE(v int) predicate.Comment {
-	return predicate.Comment(sql.FieldGTE(FieldUserID, v))
-}
-
-// UserIDLT applies the LT predicate on the "user_id" field.
-func UserIDLT(v int) predicate.Comment {
-	return predicate.Comment(sql.FieldLT(FieldUserID, v))
-}
-
-// UserIDLTE applies the LTE predicate on the "user_id" field.
-func UserIDLTE(v int) predicate.Comment {
-	return predicate.Comment(sql.FieldLTE(FieldUserID, v))
-}
-
 // PostIDEQ applies the EQ predicate on the "post_id" field.
 func PostIDEQ(v int) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldPostID, v))
@@ -305,26 +305,6 @@ func PostIDNotIn(vs ...int) predicate.Comment {
 	return predicate.Comment(sql.FieldNotIn(FieldPostID, vs...))
 }
 
-// PostIDGT applies the GT predicate on the "post_id" field.
-func PostIDGT(v int) predicate.Comment {
-	return predicate.Comment(sql.FieldGT(FieldPostID, v))
-}
-
-// PostIDGTE applies the GTE predicate on the "post_id" field.
-func PostIDGTE(v int) predicate.Comment {
-	return predicate.Comment(sql.FieldGTE(FieldPostID, v))
-}
-
-// PostIDLT applies the LT predicate on the "post_id" field.
-func PostIDLT(v int) predicate.Comment {
-	return predicate.Comment(sql.FieldLT(FieldPostID, v))
-}
-
-// PostIDLTE applies the LTE predicate on the "post_id" field.
-func PostIDLTE(v int) predicate.Comment {
-	return predicate.Comment(sql.FieldLTE(FieldPostID, v))
-}
-
 // PostIDIsNil applies the IsNil predicate on the "post_id" field.
 func PostIDIsNil() predicate.Comment {
 	return predicate.Comment(sql.FieldIsNull(FieldPostID))
@@ -355,34 +335,44 @@ func QuestionIDNotIn(vs ...int) predicate.Comment {
 	return predicate.Comment(sql.FieldNotIn(FieldQuestionID, vs...))
 }
 
-// QuestionIDGT applies the GT predicate on the "question_id" field.
-func QuestionIDGT(v int) predicate.Comment {
-	return predicate.Comment(sql.FieldGT(FieldQuestionID, v))
+// QuestionIDIsNil applies the IsNil predicate on the "question_id" field.
+func QuestionIDIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldQuestionID))
 }
 
-// QuestionIDGTE applies the GTE predicate on the "question_id" field.
-func QuestionIDGTE(v int) predicate.Comment {
-	return predicate.Comment(sql.FieldGTE(FieldQuestionID, v))
+// QuestionIDNotNil applies the NotNil predicate on the "question_id" field.
+func QuestionIDNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldQuestionID))
 }
 
-// QuestionIDLT applies the LT predicate on the "question_id" field.
-func QuestionIDLT(v int) predicate.Comment {
-	return predicate.Comment(sql.FieldLT(FieldQuestionID, v))
+// AnswerIDEQ applies the EQ predicate on the "answer_id" field.
+func AnswerIDEQ(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldAnswerID, v))
 }
 
-// QuestionIDLTE applies the LTE predicate on the "question_id" field.
-func QuestionIDLTE(v int) predicate.Comment {
-	return predicate.Comment(sql.FieldLTE(FieldQuestionID, v))
+// AnswerIDNEQ applies the NEQ predicate on the "answer_id" field.
+func AnswerIDNEQ(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldAnswerID, v))
 }
 
-// QuestionIDIsNil applies the IsNil predicate on the "question_id" field.
-func QuestionIDIsNil() predicate.Comment {
-	return predicate.Comment(sql.FieldIsNull(FieldQuestionID))
+// AnswerIDIn applies the In predicate on the "answer_id" field.
+func AnswerIDIn(vs ...int) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldAnswerID, vs...))
 }
 
-// QuestionIDNotNil applies the NotNil predicate on the "question_id" field.
-func QuestionIDNotNil() predicate.Comment {
-	return predicate.Comment(sql.FieldNotNull(FieldQuestionID))
+// AnswerIDNotIn applies the NotIn predicate on the "answer_id" field.
+func AnswerIDNotIn(vs ...int) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldAnswerID, vs...))
+}
+
+// AnswerIDIsNil applies the IsNil predicate on the "answer_id" field.
+func AnswerIDIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldAnswerID))
+}
+
+// AnswerIDNotNil applies the NotNil predicate on the "answer_id" field.
+func AnswerIDNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldAnswerID))
 }
 
 // ParentIDEQ applies the EQ predicate on the "parent_id" field.
@@ -405,34 +395,134 @@ func ParentIDNotIn(vs ...int) predicate.Comment {
 	return predicate.Comment(sql.FieldNotIn(FieldParentID, vs...))
 }
 
-// ParentIDGT applies the GT predicate on the "parent_id" field.
-func ParentIDGT(v int) predicate.Comment {
-	return predicate.Comment(sql.FieldGT(FieldParentID, v))
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldParentID))
 }
 
-// ParentIDGTE applies the GTE predicate on the "parent_id" field.
-func ParentIDGTE(v int) predicate.Comment {
-	return predicate.Comment(sql.FieldGTE(FieldParentID, v))
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldParentID))
 }
 
-// ParentIDLT applies the LT predicate on the "parent_id" field.
-func ParentIDLT(v int) predicate.Comment {
-	return predicate.Comment(sql.FieldLT(FieldParentID, v))
+// DepthEQ applies the EQ predicate on the "depth" field.
+func DepthEQ(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldDepth, v))
 }
 
-// ParentIDLTE applies the LTE predicate on the "parent_id" field.
-func ParentIDLTE(v int) predicate.Comment {
-	return predicate.Comment(sql.FieldLTE(FieldParentID, v))
+// DepthNEQ applies the NEQ predicate on the "depth" field.
+func DepthNEQ(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldDepth, v))
 }
 
-// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
-func ParentIDIsNil() predicate.Comment {
-	return predicate.Comment(sql.FieldIsNull(FieldParentID))
+// DepthIn applies the In predicate on the "depth" field.
+func DepthIn(vs ...int) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldDepth, vs...))
 }
 
-// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
-func ParentIDNotNil() predicate.Comment {
-	return predicate.Comment(sql.FieldNotNull(FieldParentID))
+// DepthNotIn applies the NotIn predicate on the "depth" field.
+func DepthNotIn(vs ...int) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldDepth, vs...))
+}
+
+// DepthGT applies the GT predicate on the "depth" field.
+func DepthGT(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldDepth, v))
+}
+
+// DepthGTE applies the GTE predicate on the "depth" field.
+func DepthGTE(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldDepth, v))
+}
+
+// DepthLT applies the LT predicate on the "depth" field.
+func DepthLT(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldDepth, v))
+}
+
+// DepthLTE applies the LTE predicate on the "depth" field.
+func DepthLTE(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldDepth, v))
+}
+
+// ReplyToUserIDEQ applies the EQ predicate on the "reply_to_user_id" field.
+func ReplyToUserIDEQ(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldReplyToUserID, v))
+}
+
+// ReplyToUserIDNEQ applies the NEQ predicate on the "reply_to_user_id" field.
+func ReplyToUserIDNEQ(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldReplyToUserID, v))
+}
+
+// ReplyToUserIDIn applies the In predicate on the "reply_to_user_id" field.
+func ReplyToUserIDIn(vs ...int) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldReplyToUserID, vs...))
+}
+
+// ReplyToUserIDNotIn applies the NotIn predicate on the "reply_to_user_id" field.
+func ReplyToUserIDNotIn(vs ...int) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldReplyToUserID, vs...))
+}
+
+// ReplyToUserIDIsNil applies the IsNil predicate on the "reply_to_user_id" field.
+func ReplyToUserIDIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldReplyToUserID))
+}
+
+// ReplyToUserIDNotNil applies the NotNil predicate on the "reply_to_user_id" field.
+func ReplyToUserIDNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldReplyToUserID))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldDeletedAt))
 }
 
 // HasUser applies the HasEdge predicate on the "user" edge.
@@ -440,7 +530,7 @@ func HasUser() predicate.Comment {
 	return predicate.Comment(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, UserTable, UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -463,7 +553,7 @@ func HasPost() predicate.Comment {
 	return predicate.Comment(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, PostTable, PostColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -486,7 +576,7 @@ func HasQuestion() predicate.Comment {
 	return predicate.Comment(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, QuestionTable, QuestionColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, QuestionTable, QuestionColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -504,12 +594,58 @@ func HasQuestionWith(preds ...predicate.Question) predicate.Comment {
 	})
 }
 
+// HasAnswer applies the HasEdge predicate on the "answer" edge.
+func HasAnswer() predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AnswerTable, AnswerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAnswerWith applies the HasEdge predicate on the "answer" edge with a given conditions (other predicates).
+func HasAnswerWith(preds ...predicate.Answer) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		step := newAnswerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReplyToUser applies the HasEdge predicate on the "reply_to_user" edge.
+func HasReplyToUser() predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ReplyToUserTable, ReplyToUserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReplyToUserWith applies the HasEdge predicate on the "reply_to_user" edge with a given conditions (other predicates).
+func HasReplyToUserWith(preds ...predicate.User) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		step := newReplyToUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.Comment {
 	return predicate.Comment(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, ParentTable, ParentPrimaryKey...),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -532,7 +668,7 @@ func HasReplies() predicate.Comment {
 	return predicate.Comment(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, RepliesTable, RepliesPrimaryKey...),
+			sqlgraph.Edge(sqlgraph.O2M, false, RepliesTable, RepliesColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
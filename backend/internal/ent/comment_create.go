@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"backend/internal/ent/answer"
 	"backend/internal/ent/comment"
 	"backend/internal/ent/post"
 	"backend/internal/ent/question"
@@ -12,6 +13,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -21,6 +23,7 @@ type CommentCreate struct {
 	config
 	mutation *CommentMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreateTime sets the "create_time" field.
@@ -99,6 +102,20 @@ func (cc *CommentCreate) SetNillableQuestionID(i *int) *CommentCreate {
 	return cc
 }
 
+// SetAnswerID sets the "answer_id" field.
+func (cc *CommentCreate) SetAnswerID(i int) *CommentCreate {
+	cc.mutation.SetAnswerID(i)
+	return cc
+}
+
+// SetNillableAnswerID sets the "answer_id" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableAnswerID(i *int) *CommentCreate {
+	if i != nil {
+		cc.SetAnswerID(*i)
+	}
+	return cc
+}
+
 // SetParentID sets the "parent_id" field.
 func (cc *CommentCreate) SetParentID(i int) *CommentCreate {
 	cc.mutation.SetParentID(i)
@@ -113,64 +130,76 @@ func (cc *CommentCreate) SetNillableParentID(i *int) *CommentCreate {
 	return cc
 }
 
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (cc *CommentCreate) AddUserIDs(ids ...int) *CommentCreate {
-	cc.mutation.AddUserIDs(ids...)
+// SetDepth sets the "depth" field.
+func (cc *CommentCreate) SetDepth(i int) *CommentCreate {
+	cc.mutation.SetDepth(i)
 	return cc
 }
 
-// AddUser adds the "user" edges to the User entity.
-func (cc *CommentCreate) AddUser(u ...*User) *CommentCreate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
+// SetNillableDepth sets the "depth" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableDepth(i *int) *CommentCreate {
+	if i != nil {
+		cc.SetDepth(*i)
 	}
-	return cc.AddUserIDs(ids...)
+	return cc
 }
 
-// AddPostIDs adds the "post" edge to the Post entity by IDs.
-func (cc *CommentCreate) AddPostIDs(ids ...int) *CommentCreate {
-	cc.mutation.AddPostIDs(ids...)
+// SetReplyToUserID sets the "reply_to_user_id" field.
+func (cc *CommentCreate) SetReplyToUserID(i int) *CommentCreate {
+	cc.mutation.SetReplyToUserID(i)
 	return cc
 }
 
-// AddPost adds the "post" edges to the Post entity.
-func (cc *CommentCreate) AddPost(p ...*Post) *CommentCreate {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+// SetNillableReplyToUserID sets the "reply_to_user_id" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableReplyToUserID(i *int) *CommentCreate {
+	if i != nil {
+		cc.SetReplyToUserID(*i)
 	}
-	return cc.AddPostIDs(ids...)
+	return cc
 }
 
-// AddQuestionIDs adds the "question" edge to the Question entity by IDs.
-func (cc *CommentCreate) AddQuestionIDs(ids ...int) *CommentCreate {
-	cc.mutation.AddQuestionIDs(ids...)
+// SetDeletedAt sets the "deleted_at" field.
+func (cc *CommentCreate) SetDeletedAt(t time.Time) *CommentCreate {
+	cc.mutation.SetDeletedAt(t)
 	return cc
 }
 
-// AddQuestion adds the "question" edges to the Question entity.
-func (cc *CommentCreate) AddQuestion(q ...*Question) *CommentCreate {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableDeletedAt(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetDeletedAt(*t)
 	}
-	return cc.AddQuestionIDs(ids...)
+	return cc
 }
 
-// AddParentIDs adds the "parent" edge to the Comment entity by IDs.
-func (cc *CommentCreate) AddParentIDs(ids ...int) *CommentCreate {
-	cc.mutation.AddParentIDs(ids...)
-	return cc
+// SetUser sets the "user" edge to the User entity.
+func (cc *CommentCreate) SetUser(u *User) *CommentCreate {
+	return cc.SetUserID(u.ID)
 }
 
-// AddParent adds the "parent" edges to the Comment entity.
-func (cc *CommentCreate) AddParent(c ...*Comment) *CommentCreate {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
-	}
-	return cc.AddParentIDs(ids...)
+// SetPost sets the "post" edge to the Post entity.
+func (cc *CommentCreate) SetPost(p *Post) *CommentCreate {
+	return cc.SetPostID(p.ID)
+}
+
+// SetQuestion sets the "question" edge to the Question entity.
+func (cc *CommentCreate) SetQuestion(q *Question) *CommentCreate {
+	return cc.SetQuestionID(q.ID)
+}
+
+// SetAnswer sets the "answer" edge to the Answer entity.
+func (cc *CommentCreate) SetAnswer(a *Answer) *CommentCreate {
+	return cc.SetAnswerID(a.ID)
+}
+
+// SetReplyToUser sets the "reply_to_user" edge to the User entity.
+func (cc *CommentCreate) SetReplyToUser(u *User) *CommentCreate {
+	return cc.SetReplyToUserID(u.ID)
+}
+
+// SetParent sets the "parent" edge to the Comment entity.
+func (cc *CommentCreate) SetParent(c *Comment) *CommentCreate {
+	return cc.SetParentID(c.ID)
 }
 
 // AddReplyIDs adds the "replies" edge to the Comment entity by IDs.
@@ -195,7 +224,9 @@ func (cc *CommentCreate) Mutation() *CommentMutation {
 
 // Save creates the Comment in the database.
 func (cc *CommentCreate) Save(ctx context.Context) (*Comment, error) {
-	cc.defaults()
+	if err := cc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
 }
 
@@ -222,15 +253,26 @@ func (cc *CommentCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (cc *CommentCreate) defaults() {
+func (cc *CommentCreate) defaults() error {
 	if _, ok := cc.mutation.CreateTime(); !ok {
+		if comment.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized comment.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
 		v := comment.DefaultCreateTime()
 		cc.mutation.SetCreateTime(v)
 	}
 	if _, ok := cc.mutation.UpdateTime(); !ok {
+		if comment.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized comment.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := comment.DefaultUpdateTime()
 		cc.mutation.SetUpdateTime(v)
 	}
+	if _, ok := cc.mutation.Depth(); !ok {
+		v := comment.DefaultDepth
+		cc.mutation.SetDepth(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -244,7 +286,10 @@ func (cc *CommentCreate) check() error {
 	if _, ok := cc.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Comment.user_id"`)}
 	}
-	if len(cc.mutation.UserIDs()) == 0 {
+	if _, ok := cc.mutation.Depth(); !ok {
+		return &ValidationError{Name: "depth", err: errors.New(`ent: missing required field "Comment.depth"`)}
+	}
+	if _, ok := cc.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Comment.user"`)}
 	}
 	return nil
@@ -273,6 +318,7 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		_node = &Comment{config: cc.config}
 		_spec = sqlgraph.NewCreateSpec(comment.Table, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = cc.conflict
 	if value, ok := cc.mutation.CreateTime(); ok {
 		_spec.SetField(comment.FieldCreateTime, field.TypeTime, value)
 		_node.CreateTime = value
@@ -285,26 +331,18 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		_spec.SetField(comment.FieldContent, field.TypeString, value)
 		_node.Content = value
 	}
-	if value, ok := cc.mutation.UserID(); ok {
-		_spec.SetField(comment.FieldUserID, field.TypeInt, value)
-		_node.UserID = value
-	}
-	if value, ok := cc.mutation.PostID(); ok {
-		_spec.SetField(comment.FieldPostID, field.TypeInt, value)
-		_node.PostID = value
+	if value, ok := cc.mutation.Depth(); ok {
+		_spec.SetField(comment.FieldDepth, field.TypeInt, value)
+		_node.Depth = value
 	}
-	if value, ok := cc.mutation.QuestionID(); ok {
-		_spec.SetField(comment.FieldQuestionID, field.TypeInt, value)
-		_node.QuestionID = value
-	}
-	if value, ok := cc.mutation.ParentID(); ok {
-		_spec.SetField(comment.FieldParentID, field.TypeInt, value)
-		_node.ParentID = value
+	if value, ok := cc.mutation.DeletedAt(); ok {
+		_spec.SetField(comment.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
 	}
 	if nodes := cc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.UserTable,
 			Columns: []string{comment.UserColumn},
 			Bidi:    false,
@@ -315,12 +353,13 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := cc.mutation.PostIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.PostTable,
 			Columns: []string{comment.PostColumn},
 			Bidi:    false,
@@ -331,12 +370,13 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.PostID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := cc.mutation.QuestionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.QuestionTable,
 			Columns: []string{comment.QuestionColumn},
 			Bidi:    false,
@@ -347,15 +387,50 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.QuestionID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := cc.mutation.ParentIDs(); len(nodes) > 0 {
+	if nodes := cc.mutation.AnswerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.AnswerTable,
+			Columns: []string{comment.AnswerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AnswerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.ReplyToUserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
+			Table:   comment.ReplyToUserTable,
+			Columns: []string{comment.ReplyToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReplyToUserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.ParentTable,
-			Columns: comment.ParentPrimaryKey,
-			Bidi:    true,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
 			},
@@ -363,15 +438,16 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.ParentID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := cc.mutation.RepliesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   comment.RepliesTable,
-			Columns: comment.RepliesPrimaryKey,
-			Bidi:    true,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
 			},
@@ -384,11 +460,503 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Comment.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommentUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (cc *CommentCreate) OnConflict(opts ...sql.ConflictOption) *CommentUpsertOne {
+	cc.conflict = opts
+	return &CommentUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cc *CommentCreate) OnConflictColumns(columns ...string) *CommentUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &CommentUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// CommentUpsertOne is the builder for "upsert"-ing
+	//  one Comment node.
+	CommentUpsertOne struct {
+		create *CommentCreate
+	}
+
+	// CommentUpsert is the "OnConflict" setter.
+	CommentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *CommentUpsert) SetUpdateTime(v time.Time) *CommentUpsert {
+	u.Set(comment.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateUpdateTime() *CommentUpsert {
+	u.SetExcluded(comment.FieldUpdateTime)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *CommentUpsert) SetContent(v string) *CommentUpsert {
+	u.Set(comment.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateContent() *CommentUpsert {
+	u.SetExcluded(comment.FieldContent)
+	return u
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *CommentUpsert) ClearContent() *CommentUpsert {
+	u.SetNull(comment.FieldContent)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CommentUpsert) SetUserID(v int) *CommentUpsert {
+	u.Set(comment.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateUserID() *CommentUpsert {
+	u.SetExcluded(comment.FieldUserID)
+	return u
+}
+
+// SetPostID sets the "post_id" field.
+func (u *CommentUpsert) SetPostID(v int) *CommentUpsert {
+	u.Set(comment.FieldPostID, v)
+	return u
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *CommentUpsert) UpdatePostID() *CommentUpsert {
+	u.SetExcluded(comment.FieldPostID)
+	return u
+}
+
+// ClearPostID clears the value of the "post_id" field.
+func (u *CommentUpsert) ClearPostID() *CommentUpsert {
+	u.SetNull(comment.FieldPostID)
+	return u
+}
+
+// SetQuestionID sets the "question_id" field.
+func (u *CommentUpsert) SetQuestionID(v int) *CommentUpsert {
+	u.Set(comment.FieldQuestionID, v)
+	return u
+}
+
+// UpdateQuestionID sets the "question_id" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateQuestionID() *CommentUpsert {
+	u.SetExcluded(comment.FieldQuestionID)
+	return u
+}
+
+// ClearQuestionID clears the value of the "question_id" field.
+func (u *CommentUpsert) ClearQuestionID() *CommentUpsert {
+	u.SetNull(comment.FieldQuestionID)
+	return u
+}
+
+// SetAnswerID sets the "answer_id" field.
+func (u *CommentUpsert) SetAnswerID(v int) *CommentUpsert {
+	u.Set(comment.FieldAnswerID, v)
+	return u
+}
+
+// UpdateAnswerID sets the "answer_id" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateAnswerID() *CommentUpsert {
+	u.SetExcluded(comment.FieldAnswerID)
+	return u
+}
+
+// ClearAnswerID clears the value of the "answer_id" field.
+func (u *CommentUpsert) ClearAnswerID() *CommentUpsert {
+	u.SetNull(comment.FieldAnswerID)
+	return u
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *CommentUpsert) SetParentID(v int) *CommentUpsert {
+	u.Set(comment.FieldParentID, v)
+	return u
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateParentID() *CommentUpsert {
+	u.SetExcluded(comment.FieldParentID)
+	return u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *CommentUpsert) ClearParentID() *CommentUpsert {
+	u.SetNull(comment.FieldParentID)
+	return u
+}
+
+// SetDepth sets the "depth" field.
+func (u *CommentUpsert) SetDepth(v int) *CommentUpsert {
+	u.Set(comment.FieldDepth, v)
+	return u
+}
+
+// UpdateDepth sets the "depth" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateDepth() *CommentUpsert {
+	u.SetExcluded(comment.FieldDepth)
+	return u
+}
+
+// AddDepth adds v to the "depth" field.
+func (u *CommentUpsert) AddDepth(v int) *CommentUpsert {
+	u.Add(comment.FieldDepth, v)
+	return u
+}
+
+// SetReplyToUserID sets the "reply_to_user_id" field.
+func (u *CommentUpsert) SetReplyToUserID(v int) *CommentUpsert {
+	u.Set(comment.FieldReplyToUserID, v)
+	return u
+}
+
+// UpdateReplyToUserID sets the "reply_to_user_id" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateReplyToUserID() *CommentUpsert {
+	u.SetExcluded(comment.FieldReplyToUserID)
+	return u
+}
+
+// ClearReplyToUserID clears the value of the "reply_to_user_id" field.
+func (u *CommentUpsert) ClearReplyToUserID() *CommentUpsert {
+	u.SetNull(comment.FieldReplyToUserID)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CommentUpsert) SetDeletedAt(v time.Time) *CommentUpsert {
+	u.Set(comment.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateDeletedAt() *CommentUpsert {
+	u.SetExcluded(comment.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CommentUpsert) ClearDeletedAt() *CommentUpsert {
+	u.SetNull(comment.FieldDeletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CommentUpsertOne) UpdateNewValues() *CommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(comment.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CommentUpsertOne) Ignore() *CommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommentUpsertOne) DoNothing() *CommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommentCreate.OnConflict
+// documentation for more info.
+func (u *CommentUpsertOne) Update(set func(*CommentUpsert)) *CommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *CommentUpsertOne) SetUpdateTime(v time.Time) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateUpdateTime() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *CommentUpsertOne) SetContent(v string) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateContent() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *CommentUpsertOne) ClearContent() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CommentUpsertOne) SetUserID(v int) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateUserID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPostID sets the "post_id" field.
+func (u *CommentUpsertOne) SetPostID(v int) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdatePostID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// ClearPostID clears the value of the "post_id" field.
+func (u *CommentUpsertOne) ClearPostID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearPostID()
+	})
+}
+
+// SetQuestionID sets the "question_id" field.
+func (u *CommentUpsertOne) SetQuestionID(v int) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetQuestionID(v)
+	})
+}
+
+// UpdateQuestionID sets the "question_id" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateQuestionID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateQuestionID()
+	})
+}
+
+// ClearQuestionID clears the value of the "question_id" field.
+func (u *CommentUpsertOne) ClearQuestionID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearQuestionID()
+	})
+}
+
+// SetAnswerID sets the "answer_id" field.
+func (u *CommentUpsertOne) SetAnswerID(v int) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetAnswerID(v)
+	})
+}
+
+// UpdateAnswerID sets the "answer_id" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateAnswerID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateAnswerID()
+	})
+}
+
+// ClearAnswerID clears the value of the "answer_id" field.
+func (u *CommentUpsertOne) ClearAnswerID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearAnswerID()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *CommentUpsertOne) SetParentID(v int) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateParentID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *CommentUpsertOne) ClearParentID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetDepth sets the "depth" field.
+func (u *CommentUpsertOne) SetDepth(v int) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetDepth(v)
+	})
+}
+
+// AddDepth adds v to the "depth" field.
+func (u *CommentUpsertOne) AddDepth(v int) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.AddDepth(v)
+	})
+}
+
+// UpdateDepth sets the "depth" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateDepth() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateDepth()
+	})
+}
+
+// SetReplyToUserID sets the "reply_to_user_id" field.
+func (u *CommentUpsertOne) SetReplyToUserID(v int) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetReplyToUserID(v)
+	})
+}
+
+// UpdateReplyToUserID sets the "reply_to_user_id" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateReplyToUserID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateReplyToUserID()
+	})
+}
+
+// ClearReplyToUserID clears the value of the "reply_to_user_id" field.
+func (u *CommentUpsertOne) ClearReplyToUserID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearReplyToUserID()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CommentUpsertOne) SetDeletedAt(v time.Time) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateDeletedAt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CommentUpsertOne) ClearDeletedAt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CommentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CommentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CommentUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CommentUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // CommentCreateBulk is the builder for creating many Comment entities in bulk.
 type CommentCreateBulk struct {
 	config
 	err      error
 	builders []*CommentCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Comment entities in the database.
@@ -418,6 +986,7 @@ func (ccb *CommentCreateBulk) Save(ctx context.Context) ([]*Comment, error) {
 					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -471,3 +1040,310 @@ func (ccb *CommentCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Comment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommentUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (ccb *CommentCreateBulk) OnConflict(opts ...sql.ConflictOption) *CommentUpsertBulk {
+	ccb.conflict = opts
+	return &CommentUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ccb *CommentCreateBulk) OnConflictColumns(columns ...string) *CommentUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &CommentUpsertBulk{
+		create: ccb,
+	}
+}
+
+// CommentUpsertBulk is the builder for "upsert"-ing
+// a bulk of Comment nodes.
+type CommentUpsertBulk struct {
+	create *CommentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CommentUpsertBulk) UpdateNewValues() *CommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(comment.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CommentUpsertBulk) Ignore() *CommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommentUpsertBulk) DoNothing() *CommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommentCreateBulk.OnConflict
+// documentation for more info.
+func (u *CommentUpsertBulk) Update(set func(*CommentUpsert)) *CommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *CommentUpsertBulk) SetUpdateTime(v time.Time) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateUpdateTime() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *CommentUpsertBulk) SetContent(v string) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateContent() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *CommentUpsertBulk) ClearContent() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CommentUpsertBulk) SetUserID(v int) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateUserID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPostID sets the "post_id" field.
+func (u *CommentUpsertBulk) SetPostID(v int) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdatePostID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// ClearPostID clears the value of the "post_id" field.
+func (u *CommentUpsertBulk) ClearPostID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearPostID()
+	})
+}
+
+// SetQuestionID sets the "question_id" field.
+func (u *CommentUpsertBulk) SetQuestionID(v int) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetQuestionID(v)
+	})
+}
+
+// UpdateQuestionID sets the "question_id" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateQuestionID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateQuestionID()
+	})
+}
+
+// ClearQuestionID clears the value of the "question_id" field.
+func (u *CommentUpsertBulk) ClearQuestionID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearQuestionID()
+	})
+}
+
+// SetAnswerID sets the "answer_id" field.
+func (u *CommentUpsertBulk) SetAnswerID(v int) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetAnswerID(v)
+	})
+}
+
+// UpdateAnswerID sets the "answer_id" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateAnswerID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateAnswerID()
+	})
+}
+
+// ClearAnswerID clears the value of the "answer_id" field.
+func (u *CommentUpsertBulk) ClearAnswerID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearAnswerID()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *CommentUpsertBulk) SetParentID(v int) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateParentID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *CommentUpsertBulk) ClearParentID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetDepth sets the "depth" field.
+func (u *CommentUpsertBulk) SetDepth(v int) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetDepth(v)
+	})
+}
+
+// AddDepth adds v to the "depth" field.
+func (u *CommentUpsertBulk) AddDepth(v int) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.AddDepth(v)
+	})
+}
+
+// UpdateDepth sets the "depth" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateDepth() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateDepth()
+	})
+}
+
+// SetReplyToUserID sets the "reply_to_user_id" field.
+func (u *CommentUpsertBulk) SetReplyToUserID(v int) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetReplyToUserID(v)
+	})
+}
+
+// UpdateReplyToUserID sets the "reply_to_user_id" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateReplyToUserID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateReplyToUserID()
+	})
+}
+
+// ClearReplyToUserID clears the value of the "reply_to_user_id" field.
+func (u *CommentUpsertBulk) ClearReplyToUserID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearReplyToUserID()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CommentUpsertBulk) SetDeletedAt(v time.Time) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateDeletedAt() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CommentUpsertBulk) ClearDeletedAt() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CommentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CommentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CommentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"backend/internal/ent/answer"
 	"backend/internal/ent/comment"
 	"backend/internal/ent/post"
 	"backend/internal/ent/predicate"
@@ -21,16 +22,18 @@ import (
 // CommentQuery is the builder for querying Comment entities.
 type CommentQuery struct {
 	config
-	ctx          *QueryContext
-	order        []comment.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Comment
-	withUser     *UserQuery
-	withPost     *PostQuery
-	withQuestion *QuestionQuery
-	withParent   *CommentQuery
-	withReplies  *CommentQuery
-	withFKs      bool
+	ctx             *QueryContext
+	order           []comment.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Comment
+	withUser        *UserQuery
+	withPost        *PostQuery
+	withQuestion    *QuestionQuery
+	withAnswer      *AnswerQuery
+	withReplyToUser *UserQuery
+	withParent      *CommentQuery
+	withReplies     *CommentQuery
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -81,7 +84,7 @@ func (cq *CommentQuery) QueryUser() *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(comment.Table, comment.FieldID, selector),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, comment.UserTable, comment.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.UserTable, comment.UserColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
 		return fromU, nil
@@ -103,7 +106,7 @@ func (cq *CommentQuery) QueryPost() *PostQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(comment.Table, comment.FieldID, selector),
 			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, comment.PostTable, comment.PostColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.PostTable, comment.PostColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
 		return fromU, nil
@@ -125,7 +128,51 @@ func (cq *CommentQuery) QueryQuestion() *QuestionQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(comment.Table, comment.FieldID, selector),
 			sqlgraph.To(question.Table, question.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, comment.QuestionTable, comment.QuestionColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.QuestionTable, comment.QuestionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAnswer chains the current query on the "answer" edge.
+func (cq *CommentQuery) QueryAnswer() *AnswerQuery {
+	query := (&AnswerClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, selector),
+			sqlgraph.To(answer.Table, answer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.AnswerTable, comment.AnswerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReplyToUser chains the current query on the "reply_to_user" edge.
+func (cq *CommentQuery) QueryReplyToUser() *UserQuery {
+	query := (&UserClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, comment.ReplyToUserTable, comment.ReplyToUserColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
 		return fromU, nil
@@ -147,7 +194,7 @@ func (cq *CommentQuery) QueryParent() *CommentQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(comment.Table, comment.FieldID, selector),
 			sqlgraph.To(comment.Table, comment.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, comment.ParentTable, comment.ParentPrimaryKey...),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.ParentTable, comment.ParentColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
 		return fromU, nil
@@ -169,7 +216,7 @@ func (cq *CommentQuery) QueryReplies() *CommentQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(comment.Table, comment.FieldID, selector),
 			sqlgraph.To(comment.Table, comment.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, comment.RepliesTable, comment.RepliesPrimaryKey...),
+			sqlgraph.Edge(sqlgraph.O2M, false, comment.RepliesTable, comment.RepliesColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
 		return fromU, nil
@@ -364,16 +411,18 @@ func (cq *CommentQuery) Clone() *CommentQuery {
 		return nil
 	}
 	return &CommentQuery{
-		config:       cq.config,
-		ctx:          cq.ctx.Clone(),
-		order:        append([]comment.OrderOption{}, cq.order...),
-		inters:       append([]Interceptor{}, cq.inters...),
-		predicates:   append([]predicate.Comment{}, cq.predicates...),
-		withUser:     cq.withUser.Clone(),
-		withPost:     cq.withPost.Clone(),
-		withQuestion: cq.withQuestion.Clone(),
-		withParent:   cq.withParent.Clone(),
-		withReplies:  cq.withReplies.Clone(),
+		config:          cq.config,
+		ctx:             cq.ctx.Clone(),
+		order:           append([]comment.OrderOption{}, cq.order...),
+		inters:          append([]Interceptor{}, cq.inters...),
+		predicates:      append([]predicate.Comment{}, cq.predicates...),
+		withUser:        cq.withUser.Clone(),
+		withPost:        cq.withPost.Clone(),
+		withQuestion:    cq.withQuestion.Clone(),
+		withAnswer:      cq.withAnswer.Clone(),
+		withReplyToUser: cq.withReplyToUser.Clone(),
+		withParent:      cq.withParent.Clone(),
+		withReplies:     cq.withReplies.Clone(),
 		// clone intermediate query.
 		sql:  cq.sql.Clone(),
 		path: cq.path,
@@ -413,6 +462,28 @@ func (cq *CommentQuery) WithQuestion(opts ...func(*QuestionQuery)) *CommentQuery
 	return cq
 }
 
+// WithAnswer tells the query-builder to eager-load the nodes that are connected to
+// the "answer" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CommentQuery) WithAnswer(opts ...func(*AnswerQuery)) *CommentQuery {
+	query := (&AnswerClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withAnswer = query
+	return cq
+}
+
+// WithReplyToUser tells the query-builder to eager-load the nodes that are connected to
+// the "reply_to_user" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CommentQuery) WithReplyToUser(opts ...func(*UserQuery)) *CommentQuery {
+	query := (&UserClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withReplyToUser = query
+	return cq
+}
+
 // WithParent tells the query-builder to eager-load the nodes that are connected to
 // the "parent" edge. The optional arguments are used to configure the query builder of the edge.
 func (cq *CommentQuery) WithParent(opts ...func(*CommentQuery)) *CommentQuery {
@@ -512,19 +583,17 @@ func (cq *CommentQuery) prepareQuery(ctx context.Context) error {
 func (cq *CommentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Comment, error) {
 	var (
 		nodes       = []*Comment{}
-		withFKs     = cq.withFKs
 		_spec       = cq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [7]bool{
 			cq.withUser != nil,
 			cq.withPost != nil,
 			cq.withQuestion != nil,
+			cq.withAnswer != nil,
+			cq.withReplyToUser != nil,
 			cq.withParent != nil,
 			cq.withReplies != nil,
 		}
 	)
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, comment.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Comment).scanValues(nil, columns)
 	}
@@ -534,6 +603,9 @@ func (cq *CommentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Comm
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -544,30 +616,38 @@ func (cq *CommentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Comm
 		return nodes, nil
 	}
 	if query := cq.withUser; query != nil {
-		if err := cq.loadUser(ctx, query, nodes,
-			func(n *Comment) { n.Edges.User = []*User{} },
-			func(n *Comment, e *User) { n.Edges.User = append(n.Edges.User, e) }); err != nil {
+		if err := cq.loadUser(ctx, query, nodes, nil,
+			func(n *Comment, e *User) { n.Edges.User = e }); err != nil {
 			return nil, err
 		}
 	}
 	if query := cq.withPost; query != nil {
-		if err := cq.loadPost(ctx, query, nodes,
-			func(n *Comment) { n.Edges.Post = []*Post{} },
-			func(n *Comment, e *Post) { n.Edges.Post = append(n.Edges.Post, e) }); err != nil {
+		if err := cq.loadPost(ctx, query, nodes, nil,
+			func(n *Comment, e *Post) { n.Edges.Post = e }); err != nil {
 			return nil, err
 		}
 	}
 	if query := cq.withQuestion; query != nil {
-		if err := cq.loadQuestion(ctx, query, nodes,
-			func(n *Comment) { n.Edges.Question = []*Question{} },
-			func(n *Comment, e *Question) { n.Edges.Question = append(n.Edges.Question, e) }); err != nil {
+		if err := cq.loadQuestion(ctx, query, nodes, nil,
+			func(n *Comment, e *Question) { n.Edges.Question = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cq.withAnswer; query != nil {
+		if err := cq.loadAnswer(ctx, query, nodes, nil,
+			func(n *Comment, e *Answer) { n.Edges.Answer = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cq.withReplyToUser; query != nil {
+		if err := cq.loadReplyToUser(ctx, query, nodes, nil,
+			func(n *Comment, e *User) { n.Edges.ReplyToUser = e }); err != nil {
 			return nil, err
 		}
 	}
 	if query := cq.withParent; query != nil {
-		if err := cq.loadParent(ctx, query, nodes,
-			func(n *Comment) { n.Edges.Parent = []*Comment{} },
-			func(n *Comment, e *Comment) { n.Edges.Parent = append(n.Edges.Parent, e) }); err != nil {
+		if err := cq.loadParent(ctx, query, nodes, nil,
+			func(n *Comment, e *Comment) { n.Edges.Parent = e }); err != nil {
 			return nil, err
 		}
 	}
@@ -582,223 +662,215 @@ func (cq *CommentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Comm
 }
 
 func (cq *CommentQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Comment, init func(*Comment), assign func(*Comment, *User)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*Comment)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Comment)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	query.withFKs = true
-	query.Where(predicate.User(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(comment.UserColumn), fks...))
-	}))
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.comment_user
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "comment_user" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "comment_user" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
 func (cq *CommentQuery) loadPost(ctx context.Context, query *PostQuery, nodes []*Comment, init func(*Comment), assign func(*Comment, *Post)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*Comment)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Comment)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].PostID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	query.withFKs = true
-	query.Where(predicate.Post(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(comment.PostColumn), fks...))
-	}))
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(post.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.comment_post
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "comment_post" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "comment_post" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "post_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
 func (cq *CommentQuery) loadQuestion(ctx context.Context, query *QuestionQuery, nodes []*Comment, init func(*Comment), assign func(*Comment, *Question)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*Comment)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Comment)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].QuestionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	query.withFKs = true
-	query.Where(predicate.Question(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(comment.QuestionColumn), fks...))
-	}))
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(question.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.comment_question
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "comment_question" is nil for node %v`, n.ID)
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "question_id" returned %v`, n.ID)
 		}
-		node, ok := nodeids[*fk]
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cq *CommentQuery) loadAnswer(ctx context.Context, query *AnswerQuery, nodes []*Comment, init func(*Comment), assign func(*Comment, *Answer)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Comment)
+	for i := range nodes {
+		fk := nodes[i].AnswerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(answer.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "comment_question" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "answer_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
-func (cq *CommentQuery) loadParent(ctx context.Context, query *CommentQuery, nodes []*Comment, init func(*Comment), assign func(*Comment, *Comment)) error {
-	edgeIDs := make([]driver.Value, len(nodes))
-	byID := make(map[int]*Comment)
-	nids := make(map[int]map[*Comment]struct{})
-	for i, node := range nodes {
-		edgeIDs[i] = node.ID
-		byID[node.ID] = node
-		if init != nil {
-			init(node)
-		}
-	}
-	query.Where(func(s *sql.Selector) {
-		joinT := sql.Table(comment.ParentTable)
-		s.Join(joinT).On(s.C(comment.FieldID), joinT.C(comment.ParentPrimaryKey[1]))
-		s.Where(sql.InValues(joinT.C(comment.ParentPrimaryKey[0]), edgeIDs...))
-		columns := s.SelectedColumns()
-		s.Select(joinT.C(comment.ParentPrimaryKey[0]))
-		s.AppendSelect(columns...)
-		s.SetDistinct(false)
-	})
-	if err := query.prepareQuery(ctx); err != nil {
+func (cq *CommentQuery) loadReplyToUser(ctx context.Context, query *UserQuery, nodes []*Comment, init func(*Comment), assign func(*Comment, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Comment)
+	for i := range nodes {
+		fk := nodes[i].ReplyToUserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
 		return err
 	}
-	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
-		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
-			assign := spec.Assign
-			values := spec.ScanValues
-			spec.ScanValues = func(columns []string) ([]any, error) {
-				values, err := values(columns[1:])
-				if err != nil {
-					return nil, err
-				}
-				return append([]any{new(sql.NullInt64)}, values...), nil
-			}
-			spec.Assign = func(columns []string, values []any) error {
-				outValue := int(values[0].(*sql.NullInt64).Int64)
-				inValue := int(values[1].(*sql.NullInt64).Int64)
-				if nids[inValue] == nil {
-					nids[inValue] = map[*Comment]struct{}{byID[outValue]: {}}
-					return assign(columns[1:], values[1:])
-				}
-				nids[inValue][byID[outValue]] = struct{}{}
-				return nil
-			}
-		})
-	})
-	neighbors, err := withInterceptors[[]*Comment](ctx, query, qr, query.inters)
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "reply_to_user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cq *CommentQuery) loadParent(ctx context.Context, query *CommentQuery, nodes []*Comment, init func(*Comment), assign func(*Comment, *Comment)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Comment)
+	for i := range nodes {
+		fk := nodes[i].ParentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(comment.IDIn(ids...))
+	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		nodes, ok := nids[n.ID]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected "parent" node returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "parent_id" returned %v`, n.ID)
 		}
-		for kn := range nodes {
-			assign(kn, n)
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
 	}
 	return nil
 }
 func (cq *CommentQuery) loadReplies(ctx context.Context, query *CommentQuery, nodes []*Comment, init func(*Comment), assign func(*Comment, *Comment)) error {
-	edgeIDs := make([]driver.Value, len(nodes))
-	byID := make(map[int]*Comment)
-	nids := make(map[int]map[*Comment]struct{})
-	for i, node := range nodes {
-		edgeIDs[i] = node.ID
-		byID[node.ID] = node
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Comment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
 		if init != nil {
-			init(node)
-		}
-	}
-	query.Where(func(s *sql.Selector) {
-		joinT := sql.Table(comment.RepliesTable)
-		s.Join(joinT).On(s.C(comment.FieldID), joinT.C(comment.RepliesPrimaryKey[1]))
-		s.Where(sql.InValues(joinT.C(comment.RepliesPrimaryKey[0]), edgeIDs...))
-		columns := s.SelectedColumns()
-		s.Select(joinT.C(comment.RepliesPrimaryKey[0]))
-		s.AppendSelect(columns...)
-		s.SetDistinct(false)
-	})
-	if err := query.prepareQuery(ctx); err != nil {
-		return err
+			init(nodes[i])
+		}
 	}
-	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
-		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
-			assign := spec.Assign
-			values := spec.ScanValues
-			spec.ScanValues = func(columns []string) ([]any, error) {
-				values, err := values(columns[1:])
-				if err != nil {
-					return nil, err
-				}
-				return append([]any{new(sql.NullInt64)}, values...), nil
-			}
-			spec.Assign = func(columns []string, values []any) error {
-				outValue := int(values[0].(*sql.NullInt64).Int64)
-				inValue := int(values[1].(*sql.NullInt64).Int64)
-				if nids[inValue] == nil {
-					nids[inValue] = map[*Comment]struct{}{byID[outValue]: {}}
-					return assign(columns[1:], values[1:])
-				}
-				nids[inValue][byID[outValue]] = struct{}{}
-				return nil
-			}
-		})
-	})
-	neighbors, err := withInterceptors[[]*Comment](ctx, query, qr, query.inters)
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(comment.FieldParentID)
+	}
+	query.Where(predicate.Comment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(comment.RepliesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		nodes, ok := nids[n.ID]
+		fk := n.ParentID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected "replies" node returned %v`, n.ID)
-		}
-		for kn := range nodes {
-			assign(kn, n)
+			return fmt.Errorf(`unexpected referenced foreign-key "parent_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
 	return nil
 }
 
 func (cq *CommentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
 	_spec.Node.Columns = cq.ctx.Fields
 	if len(cq.ctx.Fields) > 0 {
 		_spec.Unique = cq.ctx.Unique != nil && *cq.ctx.Unique
@@ -822,6 +894,24 @@ func (cq *CommentQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if cq.withUser != nil {
+			_spec.Node.AddColumnOnce(comment.FieldUserID)
+		}
+		if cq.withPost != nil {
+			_spec.Node.AddColumnOnce(comment.FieldPostID)
+		}
+		if cq.withQuestion != nil {
+			_spec.Node.AddColumnOnce(comment.FieldQuestionID)
+		}
+		if cq.withAnswer != nil {
+			_spec.Node.AddColumnOnce(comment.FieldAnswerID)
+		}
+		if cq.withReplyToUser != nil {
+			_spec.Node.AddColumnOnce(comment.FieldReplyToUserID)
+		}
+		if cq.withParent != nil {
+			_spec.Node.AddColumnOnce(comment.FieldParentID)
+		}
 	}
 	if ps := cq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -861,6 +951,9 @@ func (cq *CommentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if cq.ctx.Unique != nil && *cq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range cq.modifiers {
+		m(selector)
+	}
 	for _, p := range cq.predicates {
 		p(selector)
 	}
@@ -878,6 +971,12 @@ func (cq *CommentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (cq *CommentQuery) Modify(modifiers ...func(s *sql.Selector)) *CommentSelect {
+	cq.modifiers = append(cq.modifiers, modifiers...)
+	return cq.Select()
+}
+
 // CommentGroupBy is the group-by builder for Comment entities.
 type CommentGroupBy struct {
 	selector
@@ -967,3 +1066,9 @@ func (cs *CommentSelect) sqlScan(ctx context.Context, root *CommentQuery, v any)
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (cs *CommentSelect) Modify(modifiers ...func(s *sql.Selector)) *CommentSelect {
+	cs.modifiers = append(cs.modifiers, modifiers...)
+	return cs
+}
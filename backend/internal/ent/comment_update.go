@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"backend/internal/ent/answer"
 	"backend/internal/ent/comment"
 	"backend/internal/ent/post"
 	"backend/internal/ent/predicate"
@@ -21,8 +22,9 @@ import (
 // CommentUpdate is the builder for updating Comment entities.
 type CommentUpdate struct {
 	config
-	hooks    []Hook
-	mutation *CommentMutation
+	hooks     []Hook
+	mutation  *CommentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the CommentUpdate builder.
@@ -59,7 +61,6 @@ func (cu *CommentUpdate) ClearContent() *CommentUpdate {
 
 // SetUserID sets the "user_id" field.
 func (cu *CommentUpdate) SetUserID(i int) *CommentUpdate {
-	cu.mutation.ResetUserID()
 	cu.mutation.SetUserID(i)
 	return cu
 }
@@ -72,15 +73,8 @@ func (cu *CommentUpdate) SetNillableUserID(i *int) *CommentUpdate {
 	return cu
 }
 
-// AddUserID adds i to the "user_id" field.
-func (cu *CommentUpdate) AddUserID(i int) *CommentUpdate {
-	cu.mutation.AddUserID(i)
-	return cu
-}
-
 // SetPostID sets the "post_id" field.
 func (cu *CommentUpdate) SetPostID(i int) *CommentUpdate {
-	cu.mutation.ResetPostID()
 	cu.mutation.SetPostID(i)
 	return cu
 }
@@ -93,12 +87,6 @@ func (cu *CommentUpdate) SetNillablePostID(i *int) *CommentUpdate {
 	return cu
 }
 
-// AddPostID adds i to the "post_id" field.
-func (cu *CommentUpdate) AddPostID(i int) *CommentUpdate {
-	cu.mutation.AddPostID(i)
-	return cu
-}
-
 // ClearPostID clears the value of the "post_id" field.
 func (cu *CommentUpdate) ClearPostID() *CommentUpdate {
 	cu.mutation.ClearPostID()
@@ -107,7 +95,6 @@ func (cu *CommentUpdate) ClearPostID() *CommentUpdate {
 
 // SetQuestionID sets the "question_id" field.
 func (cu *CommentUpdate) SetQuestionID(i int) *CommentUpdate {
-	cu.mutation.ResetQuestionID()
 	cu.mutation.SetQuestionID(i)
 	return cu
 }
@@ -120,21 +107,34 @@ func (cu *CommentUpdate) SetNillableQuestionID(i *int) *CommentUpdate {
 	return cu
 }
 
-// AddQuestionID adds i to the "question_id" field.
-func (cu *CommentUpdate) AddQuestionID(i int) *CommentUpdate {
-	cu.mutation.AddQuestionID(i)
-	return cu
-}
-
 // ClearQuestionID clears the value of the "question_id" field.
 func (cu *CommentUpdate) ClearQuestionID() *CommentUpdate {
 	cu.mutation.ClearQuestionID()
 	return cu
 }
 
+// SetAnswerID sets the "answer_id" field.
+func (cu *CommentUpdate) SetAnswerID(i int) *CommentUpdate {
+	cu.mutation.SetAnswerID(i)
+	return cu
+}
+
+// SetNillableAnswerID sets the "answer_id" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableAnswerID(i *int) *CommentUpdate {
+	if i != nil {
+		cu.SetAnswerID(*i)
+	}
+	return cu
+}
+
+// ClearAnswerID clears the value of the "answer_id" field.
+func (cu *CommentUpdate) ClearAnswerID() *CommentUpdate {
+	cu.mutation.ClearAnswerID()
+	return cu
+}
+
 // SetParentID sets the "parent_id" field.
 func (cu *CommentUpdate) SetParentID(i int) *CommentUpdate {
-	cu.mutation.ResetParentID()
 	cu.mutation.SetParentID(i)
 	return cu
 }
@@ -147,76 +147,101 @@ func (cu *CommentUpdate) SetNillableParentID(i *int) *CommentUpdate {
 	return cu
 }
 
-// AddParentID adds i to the "parent_id" field.
-func (cu *CommentUpdate) AddParentID(i int) *CommentUpdate {
-	cu.mutation.AddParentID(i)
-	return cu
-}
-
 // ClearParentID clears the value of the "parent_id" field.
 func (cu *CommentUpdate) ClearParentID() *CommentUpdate {
 	cu.mutation.ClearParentID()
 	return cu
 }
 
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (cu *CommentUpdate) AddUserIDs(ids ...int) *CommentUpdate {
-	cu.mutation.AddUserIDs(ids...)
+// SetDepth sets the "depth" field.
+func (cu *CommentUpdate) SetDepth(i int) *CommentUpdate {
+	cu.mutation.ResetDepth()
+	cu.mutation.SetDepth(i)
 	return cu
 }
 
-// AddUser adds the "user" edges to the User entity.
-func (cu *CommentUpdate) AddUser(u ...*User) *CommentUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
+// SetNillableDepth sets the "depth" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableDepth(i *int) *CommentUpdate {
+	if i != nil {
+		cu.SetDepth(*i)
 	}
-	return cu.AddUserIDs(ids...)
+	return cu
+}
+
+// AddDepth adds i to the "depth" field.
+func (cu *CommentUpdate) AddDepth(i int) *CommentUpdate {
+	cu.mutation.AddDepth(i)
+	return cu
 }
 
-// AddPostIDs adds the "post" edge to the Post entity by IDs.
-func (cu *CommentUpdate) AddPostIDs(ids ...int) *CommentUpdate {
-	cu.mutation.AddPostIDs(ids...)
+// SetReplyToUserID sets the "reply_to_user_id" field.
+func (cu *CommentUpdate) SetReplyToUserID(i int) *CommentUpdate {
+	cu.mutation.SetReplyToUserID(i)
 	return cu
 }
 
-// AddPost adds the "post" edges to the Post entity.
-func (cu *CommentUpdate) AddPost(p ...*Post) *CommentUpdate {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+// SetNillableReplyToUserID sets the "reply_to_user_id" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableReplyToUserID(i *int) *CommentUpdate {
+	if i != nil {
+		cu.SetReplyToUserID(*i)
 	}
-	return cu.AddPostIDs(ids...)
+	return cu
+}
+
+// ClearReplyToUserID clears the value of the "reply_to_user_id" field.
+func (cu *CommentUpdate) ClearReplyToUserID() *CommentUpdate {
+	cu.mutation.ClearReplyToUserID()
+	return cu
 }
 
-// AddQuestionIDs adds the "question" edge to the Question entity by IDs.
-func (cu *CommentUpdate) AddQuestionIDs(ids ...int) *CommentUpdate {
-	cu.mutation.AddQuestionIDs(ids...)
+// SetDeletedAt sets the "deleted_at" field.
+func (cu *CommentUpdate) SetDeletedAt(t time.Time) *CommentUpdate {
+	cu.mutation.SetDeletedAt(t)
 	return cu
 }
 
-// AddQuestion adds the "question" edges to the Question entity.
-func (cu *CommentUpdate) AddQuestion(q ...*Question) *CommentUpdate {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableDeletedAt(t *time.Time) *CommentUpdate {
+	if t != nil {
+		cu.SetDeletedAt(*t)
 	}
-	return cu.AddQuestionIDs(ids...)
+	return cu
 }
 
-// AddParentIDs adds the "parent" edge to the Comment entity by IDs.
-func (cu *CommentUpdate) AddParentIDs(ids ...int) *CommentUpdate {
-	cu.mutation.AddParentIDs(ids...)
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cu *CommentUpdate) ClearDeletedAt() *CommentUpdate {
+	cu.mutation.ClearDeletedAt()
 	return cu
 }
 
-// AddParent adds the "parent" edges to the Comment entity.
-func (cu *CommentUpdate) AddParent(c ...*Comment) *CommentUpdate {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
-	}
-	return cu.AddParentIDs(ids...)
+// SetUser sets the "user" edge to the User entity.
+func (cu *CommentUpdate) SetUser(u *User) *CommentUpdate {
+	return cu.SetUserID(u.ID)
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (cu *CommentUpdate) SetPost(p *Post) *CommentUpdate {
+	return cu.SetPostID(p.ID)
+}
+
+// SetQuestion sets the "question" edge to the Question entity.
+func (cu *CommentUpdate) SetQuestion(q *Question) *CommentUpdate {
+	return cu.SetQuestionID(q.ID)
+}
+
+// SetAnswer sets the "answer" edge to the Answer entity.
+func (cu *CommentUpdate) SetAnswer(a *Answer) *CommentUpdate {
+	return cu.SetAnswerID(a.ID)
+}
+
+// SetReplyToUser sets the "reply_to_user" edge to the User entity.
+func (cu *CommentUpdate) SetReplyToUser(u *User) *CommentUpdate {
+	return cu.SetReplyToUserID(u.ID)
+}
+
+// SetParent sets the "parent" edge to the Comment entity.
+func (cu *CommentUpdate) SetParent(c *Comment) *CommentUpdate {
+	return cu.SetParentID(c.ID)
 }
 
 // AddReplyIDs adds the "replies" edge to the Comment entity by IDs.
@@ -239,90 +264,42 @@ func (cu *CommentUpdate) Mutation() *CommentMutation {
 	return cu.mutation
 }
 
-// ClearUser clears all "user" edges to the User entity.
+// ClearUser clears the "user" edge to the User entity.
 func (cu *CommentUpdate) ClearUser() *CommentUpdate {
 	cu.mutation.ClearUser()
 	return cu
 }
 
-// RemoveUserIDs removes the "user" edge to User entities by IDs.
-func (cu *CommentUpdate) RemoveUserIDs(ids ...int) *CommentUpdate {
-	cu.mutation.RemoveUserIDs(ids...)
-	return cu
-}
-
-// RemoveUser removes "user" edges to User entities.
-func (cu *CommentUpdate) RemoveUser(u ...*User) *CommentUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return cu.RemoveUserIDs(ids...)
-}
-
-// ClearPost clears all "post" edges to the Post entity.
+// ClearPost clears the "post" edge to the Post entity.
 func (cu *CommentUpdate) ClearPost() *CommentUpdate {
 	cu.mutation.ClearPost()
 	return cu
 }
 
-// RemovePostIDs removes the "post" edge to Post entities by IDs.
-func (cu *CommentUpdate) RemovePostIDs(ids ...int) *CommentUpdate {
-	cu.mutation.RemovePostIDs(ids...)
-	return cu
-}
-
-// RemovePost removes "post" edges to Post entities.
-func (cu *CommentUpdate) RemovePost(p ...*Post) *CommentUpdate {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
-	}
-	return cu.RemovePostIDs(ids...)
-}
-
-// ClearQuestion clears all "question" edges to the Question entity.
+// ClearQuestion clears the "question" edge to the Question entity.
 func (cu *CommentUpdate) ClearQuestion() *CommentUpdate {
 	cu.mutation.ClearQuestion()
 	return cu
 }
 
-// RemoveQuestionIDs removes the "question" edge to Question entities by IDs.
-func (cu *CommentUpdate) RemoveQuestionIDs(ids ...int) *CommentUpdate {
-	cu.mutation.RemoveQuestionIDs(ids...)
+// ClearAnswer clears the "answer" edge to the Answer entity.
+func (cu *CommentUpdate) ClearAnswer() *CommentUpdate {
+	cu.mutation.ClearAnswer()
 	return cu
 }
 
-// RemoveQuestion removes "question" edges to Question entities.
-func (cu *CommentUpdate) RemoveQuestion(q ...*Question) *CommentUpdate {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
-	}
-	return cu.RemoveQuestionIDs(ids...)
+// ClearReplyToUser clears the "reply_to_user" edge to the User entity.
+func (cu *CommentUpdate) ClearReplyToUser() *CommentUpdate {
+	cu.mutation.ClearReplyToUser()
+	return cu
 }
 
-// ClearParent clears all "parent" edges to the Comment entity.
+// ClearParent clears the "parent" edge to the Comment entity.
 func (cu *CommentUpdate) ClearParent() *CommentUpdate {
 	cu.mutation.ClearParent()
 	return cu
 }
 
-// RemoveParentIDs removes the "parent" edge to Comment entities by IDs.
-func (cu *CommentUpdate) RemoveParentIDs(ids ...int) *CommentUpdate {
-	cu.mutation.RemoveParentIDs(ids...)
-	return cu
-}
-
-// RemoveParent removes "parent" edges to Comment entities.
-func (cu *CommentUpdate) RemoveParent(c ...*Comment) *CommentUpdate {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
-	}
-	return cu.RemoveParentIDs(ids...)
-}
-
 // ClearReplies clears all "replies" edges to the Comment entity.
 func (cu *CommentUpdate) ClearReplies() *CommentUpdate {
 	cu.mutation.ClearReplies()
@@ -346,7 +323,9 @@ func (cu *CommentUpdate) RemoveReplies(c ...*Comment) *CommentUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *CommentUpdate) Save(ctx context.Context) (int, error) {
-	cu.defaults()
+	if err := cu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
 }
 
@@ -373,14 +352,35 @@ func (cu *CommentUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (cu *CommentUpdate) defaults() {
+func (cu *CommentUpdate) defaults() error {
 	if _, ok := cu.mutation.UpdateTime(); !ok {
+		if comment.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized comment.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := comment.UpdateDefaultUpdateTime()
 		cu.mutation.SetUpdateTime(v)
 	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CommentUpdate) check() error {
+	if _, ok := cu.mutation.UserID(); cu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Comment.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cu *CommentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommentUpdate {
+	cu.modifiers = append(cu.modifiers, modifiers...)
+	return cu
 }
 
 func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt))
 	if ps := cu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -398,56 +398,22 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.ContentCleared() {
 		_spec.ClearField(comment.FieldContent, field.TypeString)
 	}
-	if value, ok := cu.mutation.UserID(); ok {
-		_spec.SetField(comment.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := cu.mutation.AddedUserID(); ok {
-		_spec.AddField(comment.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := cu.mutation.PostID(); ok {
-		_spec.SetField(comment.FieldPostID, field.TypeInt, value)
-	}
-	if value, ok := cu.mutation.AddedPostID(); ok {
-		_spec.AddField(comment.FieldPostID, field.TypeInt, value)
-	}
-	if cu.mutation.PostIDCleared() {
-		_spec.ClearField(comment.FieldPostID, field.TypeInt)
-	}
-	if value, ok := cu.mutation.QuestionID(); ok {
-		_spec.SetField(comment.FieldQuestionID, field.TypeInt, value)
+	if value, ok := cu.mutation.Depth(); ok {
+		_spec.SetField(comment.FieldDepth, field.TypeInt, value)
 	}
-	if value, ok := cu.mutation.AddedQuestionID(); ok {
-		_spec.AddField(comment.FieldQuestionID, field.TypeInt, value)
+	if value, ok := cu.mutation.AddedDepth(); ok {
+		_spec.AddField(comment.FieldDepth, field.TypeInt, value)
 	}
-	if cu.mutation.QuestionIDCleared() {
-		_spec.ClearField(comment.FieldQuestionID, field.TypeInt)
+	if value, ok := cu.mutation.DeletedAt(); ok {
+		_spec.SetField(comment.FieldDeletedAt, field.TypeTime, value)
 	}
-	if value, ok := cu.mutation.ParentID(); ok {
-		_spec.SetField(comment.FieldParentID, field.TypeInt, value)
-	}
-	if value, ok := cu.mutation.AddedParentID(); ok {
-		_spec.AddField(comment.FieldParentID, field.TypeInt, value)
-	}
-	if cu.mutation.ParentIDCleared() {
-		_spec.ClearField(comment.FieldParentID, field.TypeInt)
+	if cu.mutation.DeletedAtCleared() {
+		_spec.ClearField(comment.FieldDeletedAt, field.TypeTime)
 	}
 	if cu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   comment.UserTable,
-			Columns: []string{comment.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := cu.mutation.RemovedUserIDs(); len(nodes) > 0 && !cu.mutation.UserCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.UserTable,
 			Columns: []string{comment.UserColumn},
 			Bidi:    false,
@@ -455,15 +421,12 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := cu.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.UserTable,
 			Columns: []string{comment.UserColumn},
 			Bidi:    false,
@@ -478,8 +441,8 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if cu.mutation.PostCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.PostTable,
 			Columns: []string{comment.PostColumn},
 			Bidi:    false,
@@ -489,26 +452,10 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := cu.mutation.RemovedPostIDs(); len(nodes) > 0 && !cu.mutation.PostCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   comment.PostTable,
-			Columns: []string{comment.PostColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
 	if nodes := cu.mutation.PostIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.PostTable,
 			Columns: []string{comment.PostColumn},
 			Bidi:    false,
@@ -523,8 +470,8 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if cu.mutation.QuestionCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.QuestionTable,
 			Columns: []string{comment.QuestionColumn},
 			Bidi:    false,
@@ -534,10 +481,10 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := cu.mutation.RemovedQuestionIDs(); len(nodes) > 0 && !cu.mutation.QuestionCleared() {
+	if nodes := cu.mutation.QuestionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.QuestionTable,
 			Columns: []string{comment.QuestionColumn},
 			Bidi:    false,
@@ -548,17 +495,30 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.AnswerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.AnswerTable,
+			Columns: []string{comment.AnswerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := cu.mutation.QuestionIDs(); len(nodes) > 0 {
+	if nodes := cu.mutation.AnswerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   comment.QuestionTable,
-			Columns: []string{comment.QuestionColumn},
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.AnswerTable,
+			Columns: []string{comment.AnswerColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -566,42 +526,55 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if cu.mutation.ParentCleared() {
+	if cu.mutation.ReplyToUserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   comment.ParentTable,
-			Columns: comment.ParentPrimaryKey,
-			Bidi:    true,
+			Table:   comment.ReplyToUserTable,
+			Columns: []string{comment.ReplyToUserColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := cu.mutation.RemovedParentIDs(); len(nodes) > 0 && !cu.mutation.ParentCleared() {
+	if nodes := cu.mutation.ReplyToUserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   comment.ParentTable,
-			Columns: comment.ParentPrimaryKey,
-			Bidi:    true,
+			Table:   comment.ReplyToUserTable,
+			Columns: []string{comment.ReplyToUserColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := cu.mutation.ParentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.ParentTable,
-			Columns: comment.ParentPrimaryKey,
-			Bidi:    true,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
 			},
@@ -613,11 +586,11 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if cu.mutation.RepliesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   comment.RepliesTable,
-			Columns: comment.RepliesPrimaryKey,
-			Bidi:    true,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
 			},
@@ -626,11 +599,11 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if nodes := cu.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !cu.mutation.RepliesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   comment.RepliesTable,
-			Columns: comment.RepliesPrimaryKey,
-			Bidi:    true,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
 			},
@@ -642,11 +615,11 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if nodes := cu.mutation.RepliesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   comment.RepliesTable,
-			Columns: comment.RepliesPrimaryKey,
-			Bidi:    true,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
 			},
@@ -656,6 +629,7 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(cu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{comment.Label}
@@ -671,9 +645,10 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // CommentUpdateOne is the builder for updating a single Comment entity.
 type CommentUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CommentMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *CommentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUpdateTime sets the "update_time" field.
@@ -704,7 +679,6 @@ func (cuo *CommentUpdateOne) ClearContent() *CommentUpdateOne {
 
 // SetUserID sets the "user_id" field.
 func (cuo *CommentUpdateOne) SetUserID(i int) *CommentUpdateOne {
-	cuo.mutation.ResetUserID()
 	cuo.mutation.SetUserID(i)
 	return cuo
 }
@@ -717,15 +691,8 @@ func (cuo *CommentUpdateOne) SetNillableUserID(i *int) *CommentUpdateOne {
 	return cuo
 }
 
-// AddUserID adds i to the "user_id" field.
-func (cuo *CommentUpdateOne) AddUserID(i int) *CommentUpdateOne {
-	cuo.mutation.AddUserID(i)
-	return cuo
-}
-
 // SetPostID sets the "post_id" field.
 func (cuo *CommentUpdateOne) SetPostID(i int) *CommentUpdateOne {
-	cuo.mutation.ResetPostID()
 	cuo.mutation.SetPostID(i)
 	return cuo
 }
@@ -738,12 +705,6 @@ func (cuo *CommentUpdateOne) SetNillablePostID(i *int) *CommentUpdateOne {
 	return cuo
 }
 
-// AddPostID adds i to the "post_id" field.
-func (cuo *CommentUpdateOne) AddPostID(i int) *CommentUpdateOne {
-	cuo.mutation.AddPostID(i)
-	return cuo
-}
-
 // ClearPostID clears the value of the "post_id" field.
 func (cuo *CommentUpdateOne) ClearPostID() *CommentUpdateOne {
 	cuo.mutation.ClearPostID()
@@ -752,7 +713,6 @@ func (cuo *CommentUpdateOne) ClearPostID() *CommentUpdateOne {
 
 // SetQuestionID sets the "question_id" field.
 func (cuo *CommentUpdateOne) SetQuestionID(i int) *CommentUpdateOne {
-	cuo.mutation.ResetQuestionID()
 	cuo.mutation.SetQuestionID(i)
 	return cuo
 }
@@ -765,21 +725,34 @@ func (cuo *CommentUpdateOne) SetNillableQuestionID(i *int) *CommentUpdateOne {
 	return cuo
 }
 
-// AddQuestionID adds i to the "question_id" field.
-func (cuo *CommentUpdateOne) AddQuestionID(i int) *CommentUpdateOne {
-	cuo.mutation.AddQuestionID(i)
-	return cuo
-}
-
 // ClearQuestionID clears the value of the "question_id" field.
 func (cuo *CommentUpdateOne) ClearQuestionID() *CommentUpdateOne {
 	cuo.mutation.ClearQuestionID()
 	return cuo
 }
 
+// SetAnswerID sets the "answer_id" field.
+func (cuo *CommentUpdateOne) SetAnswerID(i int) *CommentUpdateOne {
+	cuo.mutation.SetAnswerID(i)
+	return cuo
+}
+
+// SetNillableAnswerID sets the "answer_id" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableAnswerID(i *int) *CommentUpdateOne {
+	if i != nil {
+		cuo.SetAnswerID(*i)
+	}
+	return cuo
+}
+
+// ClearAnswerID clears the value of the "answer_id" field.
+func (cuo *CommentUpdateOne) ClearAnswerID() *CommentUpdateOne {
+	cuo.mutation.ClearAnswerID()
+	return cuo
+}
+
 // SetParentID sets the "parent_id" field.
 func (cuo *CommentUpdateOne) SetParentID(i int) *CommentUpdateOne {
-	cuo.mutation.ResetParentID()
 	cuo.mutation.SetParentID(i)
 	return cuo
 }
@@ -792,76 +765,101 @@ func (cuo *CommentUpdateOne) SetNillableParentID(i *int) *CommentUpdateOne {
 	return cuo
 }
 
-// AddParentID adds i to the "parent_id" field.
-func (cuo *CommentUpdateOne) AddParentID(i int) *CommentUpdateOne {
-	cuo.mutation.AddParentID(i)
-	return cuo
-}
-
 // ClearParentID clears the value of the "parent_id" field.
 func (cuo *CommentUpdateOne) ClearParentID() *CommentUpdateOne {
 	cuo.mutation.ClearParentID()
 	return cuo
 }
 
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (cuo *CommentUpdateOne) AddUserIDs(ids ...int) *CommentUpdateOne {
-	cuo.mutation.AddUserIDs(ids...)
+// SetDepth sets the "depth" field.
+func (cuo *CommentUpdateOne) SetDepth(i int) *CommentUpdateOne {
+	cuo.mutation.ResetDepth()
+	cuo.mutation.SetDepth(i)
 	return cuo
 }
 
-// AddUser adds the "user" edges to the User entity.
-func (cuo *CommentUpdateOne) AddUser(u ...*User) *CommentUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
+// SetNillableDepth sets the "depth" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableDepth(i *int) *CommentUpdateOne {
+	if i != nil {
+		cuo.SetDepth(*i)
 	}
-	return cuo.AddUserIDs(ids...)
+	return cuo
+}
+
+// AddDepth adds i to the "depth" field.
+func (cuo *CommentUpdateOne) AddDepth(i int) *CommentUpdateOne {
+	cuo.mutation.AddDepth(i)
+	return cuo
 }
 
-// AddPostIDs adds the "post" edge to the Post entity by IDs.
-func (cuo *CommentUpdateOne) AddPostIDs(ids ...int) *CommentUpdateOne {
-	cuo.mutation.AddPostIDs(ids...)
+// SetReplyToUserID sets the "reply_to_user_id" field.
+func (cuo *CommentUpdateOne) SetReplyToUserID(i int) *CommentUpdateOne {
+	cuo.mutation.SetReplyToUserID(i)
 	return cuo
 }
 
-// AddPost adds the "post" edges to the Post entity.
-func (cuo *CommentUpdateOne) AddPost(p ...*Post) *CommentUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+// SetNillableReplyToUserID sets the "reply_to_user_id" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableReplyToUserID(i *int) *CommentUpdateOne {
+	if i != nil {
+		cuo.SetReplyToUserID(*i)
 	}
-	return cuo.AddPostIDs(ids...)
+	return cuo
+}
+
+// ClearReplyToUserID clears the value of the "reply_to_user_id" field.
+func (cuo *CommentUpdateOne) ClearReplyToUserID() *CommentUpdateOne {
+	cuo.mutation.ClearReplyToUserID()
+	return cuo
 }
 
-// AddQuestionIDs adds the "question" edge to the Question entity by IDs.
-func (cuo *CommentUpdateOne) AddQuestionIDs(ids ...int) *CommentUpdateOne {
-	cuo.mutation.AddQuestionIDs(ids...)
+// SetDeletedAt sets the "deleted_at" field.
+func (cuo *CommentUpdateOne) SetDeletedAt(t time.Time) *CommentUpdateOne {
+	cuo.mutation.SetDeletedAt(t)
 	return cuo
 }
 
-// AddQuestion adds the "question" edges to the Question entity.
-func (cuo *CommentUpdateOne) AddQuestion(q ...*Question) *CommentUpdateOne {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableDeletedAt(t *time.Time) *CommentUpdateOne {
+	if t != nil {
+		cuo.SetDeletedAt(*t)
 	}
-	return cuo.AddQuestionIDs(ids...)
+	return cuo
 }
 
-// AddParentIDs adds the "parent" edge to the Comment entity by IDs.
-func (cuo *CommentUpdateOne) AddParentIDs(ids ...int) *CommentUpdateOne {
-	cuo.mutation.AddParentIDs(ids...)
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cuo *CommentUpdateOne) ClearDeletedAt() *CommentUpdateOne {
+	cuo.mutation.ClearDeletedAt()
 	return cuo
 }
 
-// AddParent adds the "parent" edges to the Comment entity.
-func (cuo *CommentUpdateOne) AddParent(c ...*Comment) *CommentUpdateOne {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
-	}
-	return cuo.AddParentIDs(ids...)
+// SetUser sets the "user" edge to the User entity.
+func (cuo *CommentUpdateOne) SetUser(u *User) *CommentUpdateOne {
+	return cuo.SetUserID(u.ID)
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (cuo *CommentUpdateOne) SetPost(p *Post) *CommentUpdateOne {
+	return cuo.SetPostID(p.ID)
+}
+
+// SetQuestion sets the "question" edge to the Question entity.
+func (cuo *CommentUpdateOne) SetQuestion(q *Question) *CommentUpdateOne {
+	return cuo.SetQuestionID(q.ID)
+}
+
+// SetAnswer sets the "answer" edge to the Answer entity.
+func (cuo *CommentUpdateOne) SetAnswer(a *Answer) *CommentUpdateOne {
+	return cuo.SetAnswerID(a.ID)
+}
+
+// SetReplyToUser sets the "reply_to_user" edge to the User entity.
+func (cuo *CommentUpdateOne) SetReplyToUser(u *User) *CommentUpdateOne {
+	return cuo.SetReplyToUserID(u.ID)
+}
+
+// SetParent sets the "parent" edge to the Comment entity.
+func (cuo *CommentUpdateOne) SetParent(c *Comment) *CommentUpdateOne {
+	return cuo.SetParentID(c.ID)
 }
 
 // AddReplyIDs adds the "replies" edge to the Comment entity by IDs.
@@ -884,90 +882,42 @@ func (cuo *CommentUpdateOne) Mutation() *CommentMutation {
 	return cuo.mutation
 }
 
-// ClearUser clears all "user" edges to the User entity.
+// ClearUser clears the "user" edge to the User entity.
 func (cuo *CommentUpdateOne) ClearUser() *CommentUpdateOne {
 	cuo.mutation.ClearUser()
 	return cuo
 }
 
-// RemoveUserIDs removes the "user" edge to User entities by IDs.
-func (cuo *CommentUpdateOne) RemoveUserIDs(ids ...int) *CommentUpdateOne {
-	cuo.mutation.RemoveUserIDs(ids...)
-	return cuo
-}
-
-// RemoveUser removes "user" edges to User entities.
-func (cuo *CommentUpdateOne) RemoveUser(u ...*User) *CommentUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return cuo.RemoveUserIDs(ids...)
-}
-
-// ClearPost clears all "post" edges to the Post entity.
+// ClearPost clears the "post" edge to the Post entity.
 func (cuo *CommentUpdateOne) ClearPost() *CommentUpdateOne {
 	cuo.mutation.ClearPost()
 	return cuo
 }
 
-// RemovePostIDs removes the "post" edge to Post entities by IDs.
-func (cuo *CommentUpdateOne) RemovePostIDs(ids ...int) *CommentUpdateOne {
-	cuo.mutation.RemovePostIDs(ids...)
-	return cuo
-}
-
-// RemovePost removes "post" edges to Post entities.
-func (cuo *CommentUpdateOne) RemovePost(p ...*Post) *CommentUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
-	}
-	return cuo.RemovePostIDs(ids...)
-}
-
-// ClearQuestion clears all "question" edges to the Question entity.
+// ClearQuestion clears the "question" edge to the Question entity.
 func (cuo *CommentUpdateOne) ClearQuestion() *CommentUpdateOne {
 	cuo.mutation.ClearQuestion()
 	return cuo
 }
 
-// RemoveQuestionIDs removes the "question" edge to Question entities by IDs.
-func (cuo *CommentUpdateOne) RemoveQuestionIDs(ids ...int) *CommentUpdateOne {
-	cuo.mutation.RemoveQuestionIDs(ids...)
+// ClearAnswer clears the "answer" edge to the Answer entity.
+func (cuo *CommentUpdateOne) ClearAnswer() *CommentUpdateOne {
+	cuo.mutation.ClearAnswer()
 	return cuo
 }
 
-// RemoveQuestion removes "question" edges to Question entities.
-func (cuo *CommentUpdateOne) RemoveQuestion(q ...*Question) *CommentUpdateOne {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
-	}
-	return cuo.RemoveQuestionIDs(ids...)
+// ClearReplyToUser clears the "reply_to_user" edge to the User entity.
+func (cuo *CommentUpdateOne) ClearReplyToUser() *CommentUpdateOne {
+	cuo.mutation.ClearReplyToUser()
+	return cuo
 }
 
-// ClearParent clears all "parent" edges to the Comment entity.
+// ClearParent clears the "parent" edge to the Comment entity.
 func (cuo *CommentUpdateOne) ClearParent() *CommentUpdateOne {
 	cuo.mutation.ClearParent()
 	return cuo
 }
 
-// RemoveParentIDs removes the "parent" edge to Comment entities by IDs.
-func (cuo *CommentUpdateOne) RemoveParentIDs(ids ...int) *CommentUpdateOne {
-	cuo.mutation.RemoveParentIDs(ids...)
-	return cuo
-}
-
-// RemoveParent removes "parent" edges to Comment entities.
-func (cuo *CommentUpdateOne) RemoveParent(c ...*Comment) *CommentUpdateOne {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
-	}
-	return cuo.RemoveParentIDs(ids...)
-}
-
 // ClearReplies clears all "replies" edges to the Comment entity.
 func (cuo *CommentUpdateOne) ClearReplies() *CommentUpdateOne {
 	cuo.mutation.ClearReplies()
@@ -1004,7 +954,9 @@ func (cuo *CommentUpdateOne) Select(field string, fields ...string) *CommentUpda
 
 // Save executes the query and returns the updated Comment entity.
 func (cuo *CommentUpdateOne) Save(ctx context.Context) (*Comment, error) {
-	cuo.defaults()
+	if err := cuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
 }
 
@@ -1031,14 +983,35 @@ func (cuo *CommentUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (cuo *CommentUpdateOne) defaults() {
+func (cuo *CommentUpdateOne) defaults() error {
 	if _, ok := cuo.mutation.UpdateTime(); !ok {
+		if comment.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized comment.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := comment.UpdateDefaultUpdateTime()
 		cuo.mutation.SetUpdateTime(v)
 	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CommentUpdateOne) check() error {
+	if _, ok := cuo.mutation.UserID(); cuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Comment.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cuo *CommentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommentUpdateOne {
+	cuo.modifiers = append(cuo.modifiers, modifiers...)
+	return cuo
 }
 
 func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt))
 	id, ok := cuo.mutation.ID()
 	if !ok {
@@ -1073,56 +1046,22 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 	if cuo.mutation.ContentCleared() {
 		_spec.ClearField(comment.FieldContent, field.TypeString)
 	}
-	if value, ok := cuo.mutation.UserID(); ok {
-		_spec.SetField(comment.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := cuo.mutation.AddedUserID(); ok {
-		_spec.AddField(comment.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := cuo.mutation.PostID(); ok {
-		_spec.SetField(comment.FieldPostID, field.TypeInt, value)
-	}
-	if value, ok := cuo.mutation.AddedPostID(); ok {
-		_spec.AddField(comment.FieldPostID, field.TypeInt, value)
-	}
-	if cuo.mutation.PostIDCleared() {
-		_spec.ClearField(comment.FieldPostID, field.TypeInt)
-	}
-	if value, ok := cuo.mutation.QuestionID(); ok {
-		_spec.SetField(comment.FieldQuestionID, field.TypeInt, value)
+	if value, ok := cuo.mutation.Depth(); ok {
+		_spec.SetField(comment.FieldDepth, field.TypeInt, value)
 	}
-	if value, ok := cuo.mutation.AddedQuestionID(); ok {
-		_spec.AddField(comment.FieldQuestionID, field.TypeInt, value)
+	if value, ok := cuo.mutation.AddedDepth(); ok {
+		_spec.AddField(comment.FieldDepth, field.TypeInt, value)
 	}
-	if cuo.mutation.QuestionIDCleared() {
-		_spec.ClearField(comment.FieldQuestionID, field.TypeInt)
+	if value, ok := cuo.mutation.DeletedAt(); ok {
+		_spec.SetField(comment.FieldDeletedAt, field.TypeTime, value)
 	}
-	if value, ok := cuo.mutation.ParentID(); ok {
-		_spec.SetField(comment.FieldParentID, field.TypeInt, value)
-	}
-	if value, ok := cuo.mutation.AddedParentID(); ok {
-		_spec.AddField(comment.FieldParentID, field.TypeInt, value)
-	}
-	if cuo.mutation.ParentIDCleared() {
-		_spec.ClearField(comment.FieldParentID, field.TypeInt)
+	if cuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(comment.FieldDeletedAt, field.TypeTime)
 	}
 	if cuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   comment.UserTable,
-			Columns: []string{comment.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := cuo.mutation.RemovedUserIDs(); len(nodes) > 0 && !cuo.mutation.UserCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.UserTable,
 			Columns: []string{comment.UserColumn},
 			Bidi:    false,
@@ -1130,15 +1069,12 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := cuo.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.UserTable,
 			Columns: []string{comment.UserColumn},
 			Bidi:    false,
@@ -1153,8 +1089,8 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 	}
 	if cuo.mutation.PostCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.PostTable,
 			Columns: []string{comment.PostColumn},
 			Bidi:    false,
@@ -1164,26 +1100,10 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := cuo.mutation.RemovedPostIDs(); len(nodes) > 0 && !cuo.mutation.PostCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   comment.PostTable,
-			Columns: []string{comment.PostColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
 	if nodes := cuo.mutation.PostIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.PostTable,
 			Columns: []string{comment.PostColumn},
 			Bidi:    false,
@@ -1198,8 +1118,8 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 	}
 	if cuo.mutation.QuestionCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.QuestionTable,
 			Columns: []string{comment.QuestionColumn},
 			Bidi:    false,
@@ -1209,10 +1129,10 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := cuo.mutation.RemovedQuestionIDs(); len(nodes) > 0 && !cuo.mutation.QuestionCleared() {
+	if nodes := cuo.mutation.QuestionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.QuestionTable,
 			Columns: []string{comment.QuestionColumn},
 			Bidi:    false,
@@ -1223,17 +1143,30 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.AnswerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.AnswerTable,
+			Columns: []string{comment.AnswerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := cuo.mutation.QuestionIDs(); len(nodes) > 0 {
+	if nodes := cuo.mutation.AnswerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   comment.QuestionTable,
-			Columns: []string{comment.QuestionColumn},
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.AnswerTable,
+			Columns: []string{comment.AnswerColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1241,42 +1174,55 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if cuo.mutation.ParentCleared() {
+	if cuo.mutation.ReplyToUserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   comment.ParentTable,
-			Columns: comment.ParentPrimaryKey,
-			Bidi:    true,
+			Table:   comment.ReplyToUserTable,
+			Columns: []string{comment.ReplyToUserColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := cuo.mutation.RemovedParentIDs(); len(nodes) > 0 && !cuo.mutation.ParentCleared() {
+	if nodes := cuo.mutation.ReplyToUserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   comment.ParentTable,
-			Columns: comment.ParentPrimaryKey,
-			Bidi:    true,
+			Table:   comment.ReplyToUserTable,
+			Columns: []string{comment.ReplyToUserColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := cuo.mutation.ParentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   comment.ParentTable,
-			Columns: comment.ParentPrimaryKey,
-			Bidi:    true,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
 			},
@@ -1288,11 +1234,11 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 	}
 	if cuo.mutation.RepliesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   comment.RepliesTable,
-			Columns: comment.RepliesPrimaryKey,
-			Bidi:    true,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
 			},
@@ -1301,11 +1247,11 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 	}
 	if nodes := cuo.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !cuo.mutation.RepliesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   comment.RepliesTable,
-			Columns: comment.RepliesPrimaryKey,
-			Bidi:    true,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
 			},
@@ -1317,11 +1263,11 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 	}
 	if nodes := cuo.mutation.RepliesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   comment.RepliesTable,
-			Columns: comment.RepliesPrimaryKey,
-			Bidi:    true,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
 			},
@@ -1331,6 +1277,7 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(cuo.modifiers...)
 	_node = &Comment{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
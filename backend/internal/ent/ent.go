@@ -3,18 +3,25 @@
 package ent
 
 import (
+	"backend/internal/ent/answer"
+	"backend/internal/ent/answervote"
 	"backend/internal/ent/comment"
 	"backend/internal/ent/follow"
+	"backend/internal/ent/loginattempt"
 	"backend/internal/ent/post"
 	"backend/internal/ent/posttag"
 	"backend/internal/ent/question"
 	"backend/internal/ent/questiontag"
+	"backend/internal/ent/refreshtoken"
+	"backend/internal/ent/session"
 	"backend/internal/ent/tag"
+	"backend/internal/ent/tagalias"
 	"backend/internal/ent/user"
 	"backend/internal/ent/userfavoritepost"
 	"backend/internal/ent/userfavoritequestion"
 	"backend/internal/ent/userlikepost"
 	"backend/internal/ent/userlikequestion"
+	"backend/internal/ent/verificationcode"
 	"context"
 	"errors"
 	"fmt"
@@ -84,18 +91,25 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			answer.Table:               answer.ValidColumn,
+			answervote.Table:           answervote.ValidColumn,
 			comment.Table:              comment.ValidColumn,
 			follow.Table:               follow.ValidColumn,
+			loginattempt.Table:         loginattempt.ValidColumn,
 			post.Table:                 post.ValidColumn,
 			posttag.Table:              posttag.ValidColumn,
 			question.Table:             question.ValidColumn,
 			questiontag.Table:          questiontag.ValidColumn,
+			refreshtoken.Table:         refreshtoken.ValidColumn,
+			session.Table:              session.ValidColumn,
 			tag.Table:                  tag.ValidColumn,
+			tagalias.Table:             tagalias.ValidColumn,
 			user.Table:                 user.ValidColumn,
 			userfavoritepost.Table:     userfavoritepost.ValidColumn,
 			userfavoritequestion.Table: userfavoritequestion.ValidColumn,
 			userlikepost.Table:         userlikepost.ValidColumn,
 			userlikequestion.Table:     userlikequestion.ValidColumn,
+			verificationcode.Table:     verificationcode.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
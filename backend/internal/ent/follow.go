@@ -4,6 +4,7 @@ package ent
 
 import (
 	"backend/internal/ent/follow"
+	"backend/internal/ent/user"
 	"fmt"
 	"strings"
 	"time"
@@ -27,37 +28,39 @@ type Follow struct {
 	FollowingID int `json:"following_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FollowQuery when eager-loading is set.
-	Edges            FollowEdges `json:"edges"`
-	user_following   *int
-	user_followed_by *int
-	selectValues     sql.SelectValues
+	Edges        FollowEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // FollowEdges holds the relations/edges for other nodes in the graph.
 type FollowEdges struct {
 	// Follower holds the value of the follower edge.
-	Follower []*User `json:"follower,omitempty"`
+	Follower *User `json:"follower,omitempty"`
 	// Following holds the value of the following edge.
-	Following []*User `json:"following,omitempty"`
+	Following *User `json:"following,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [2]bool
 }
 
 // FollowerOrErr returns the Follower value or an error if the edge
-// was not loaded in eager-loading.
-func (e FollowEdges) FollowerOrErr() ([]*User, error) {
-	if e.loadedTypes[0] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FollowEdges) FollowerOrErr() (*User, error) {
+	if e.Follower != nil {
 		return e.Follower, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "follower"}
 }
 
 // FollowingOrErr returns the Following value or an error if the edge
-// was not loaded in eager-loading.
-func (e FollowEdges) FollowingOrErr() ([]*User, error) {
-	if e.loadedTypes[1] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FollowEdges) FollowingOrErr() (*User, error) {
+	if e.Following != nil {
 		return e.Following, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "following"}
 }
@@ -71,10 +74,6 @@ func (*Follow) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case follow.FieldCreateTime, follow.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
-		case follow.ForeignKeys[0]: // user_following
-			values[i] = new(sql.NullInt64)
-		case follow.ForeignKeys[1]: // user_followed_by
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -120,20 +119,6 @@ func (f *Follow) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				f.FollowingID = int(value.Int64)
 			}
-		case follow.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_following", value)
-			} else if value.Valid {
-				f.user_following = new(int)
-				*f.user_following = int(value.Int64)
-			}
-		case follow.ForeignKeys[1]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_followed_by", value)
-			} else if value.Valid {
-				f.user_followed_by = new(int)
-				*f.user_followed_by = int(value.Int64)
-			}
 		default:
 			f.selectValues.Set(columns[i], values[i])
 		}
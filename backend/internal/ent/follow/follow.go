@@ -5,6 +5,7 @@ package follow
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -29,19 +30,19 @@ const (
 	// Table holds the table name of the follow in the database.
 	Table = "follows"
 	// FollowerTable is the table that holds the follower relation/edge.
-	FollowerTable = "users"
+	FollowerTable = "follows"
 	// FollowerInverseTable is the table name for the User entity.
 	// It exists in this package in order to avoid circular dependency with the "user" package.
 	FollowerInverseTable = "users"
 	// FollowerColumn is the table column denoting the follower relation/edge.
-	FollowerColumn = "follow_follower"
+	FollowerColumn = "follower_id"
 	// FollowingTable is the table that holds the following relation/edge.
-	FollowingTable = "users"
+	FollowingTable = "follows"
 	// FollowingInverseTable is the table name for the User entity.
 	// It exists in this package in order to avoid circular dependency with the "user" package.
 	FollowingInverseTable = "users"
 	// FollowingColumn is the table column denoting the following relation/edge.
-	FollowingColumn = "follow_following"
+	FollowingColumn = "following_id"
 )
 
 // Columns holds all SQL columns for follow fields.
@@ -53,13 +54,6 @@ var Columns = []string{
 	FieldFollowingID,
 }
 
-// ForeignKeys holds the SQL foreign-keys that are owned by the "follows"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"user_following",
-	"user_followed_by",
-}
-
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -67,15 +61,16 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "backend/internal/ent/runtime"
 var (
+	Hooks [1]ent.Hook
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
 	DefaultCreateTime func() time.Time
 	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
@@ -112,44 +107,30 @@ func ByFollowingID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFollowingID, opts...).ToFunc()
 }
 
-// ByFollowerCount orders the results by follower count.
-func ByFollowerCount(opts ...sql.OrderTermOption) OrderOption {
-	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newFollowerStep(), opts...)
-	}
-}
-
-// ByFollower orders the results by follower terms.
-func ByFollower(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
-	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newFollowerStep(), append([]sql.OrderTerm{term}, terms...)...)
-	}
-}
-
-// ByFollowingCount orders the results by following count.
-func ByFollowingCount(opts ...sql.OrderTermOption) OrderOption {
+// ByFollowerField orders the results by follower field.
+func ByFollowerField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newFollowingStep(), opts...)
+		sqlgraph.OrderByNeighborTerms(s, newFollowerStep(), sql.OrderByField(field, opts...))
 	}
 }
 
-// ByFollowing orders the results by following terms.
-func ByFollowing(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+// ByFollowingField orders the results by following field.
+func ByFollowingField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newFollowingStep(), append([]sql.OrderTerm{term}, terms...)...)
+		sqlgraph.OrderByNeighborTerms(s, newFollowingStep(), sql.OrderByField(field, opts...))
 	}
 }
 func newFollowerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(FollowerInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, FollowerTable, FollowerColumn),
+		sqlgraph.Edge(sqlgraph.M2O, true, FollowerTable, FollowerColumn),
 	)
 }
 func newFollowingStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(FollowingInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, FollowingTable, FollowingColumn),
+		sqlgraph.Edge(sqlgraph.M2O, true, FollowingTable, FollowingColumn),
 	)
 }
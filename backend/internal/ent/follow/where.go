@@ -175,26 +175,6 @@ func FollowerIDNotIn(vs ...int) predicate.Follow {
 	return predicate.Follow(sql.FieldNotIn(FieldFollowerID, vs...))
 }
 
-// FollowerIDGT applies the GT predicate on the "follower_id" field.
-func FollowerIDGT(v int) predicate.Follow {
-	return predicate.Follow(sql.FieldGT(FieldFollowerID, v))
-}
-
-// FollowerIDGTE applies the GTE predicate on the "follower_id" field.
-func FollowerIDGTE(v int) predicate.Follow {
-	return predicate.Follow(sql.FieldGTE(FieldFollowerID, v))
-}
-
-// FollowerIDLT applies the LT predicate on the "follower_id" field.
-func FollowerIDLT(v int) predicate.Follow {
-	return predicate.Follow(sql.FieldLT(FieldFollowerID, v))
-}
-
-// FollowerIDLTE applies the LTE predicate on the "follower_id" field.
-func FollowerIDLTE(v int) predicate.Follow {
-	return predicate.Follow(sql.FieldLTE(FieldFollowerID, v))
-}
-
 // FollowingIDEQ applies the EQ predicate on the "following_id" field.
 func FollowingIDEQ(v int) predicate.Follow {
 	return predicate.Follow(sql.FieldEQ(FieldFollowingID, v))
@@ -215,32 +195,12 @@ func FollowingIDNotIn(vs ...int) predicate.Follow {
 	return predicate.Follow(sql.FieldNotIn(FieldFollowingID, vs...))
 }
 
-// FollowingIDGT applies the GT predicate on the "following_id" field.
-func FollowingIDGT(v int) predicate.Follow {
-	return predicate.Follow(sql.FieldGT(FieldFollowingID, v))
-}
-
-// FollowingIDGTE applies the GTE predicate on the "following_id" field.
-func FollowingIDGTE(v int) predicate.Follow {
-	return predicate.Follow(sql.FieldGTE(FieldFollowingID, v))
-}
-
-// FollowingIDLT applies the LT predicate on the "following_id" field.
-func FollowingIDLT(v int) predicate.Follow {
-	return predicate.Follow(sql.FieldLT(FieldFollowingID, v))
-}
-
-// FollowingIDLTE applies the LTE predicate on the "following_id" field.
-func FollowingIDLTE(v int) predicate.Follow {
-	return predicate.Follow(sql.FieldLTE(FieldFollowingID, v))
-}
-
 // HasFollower applies the HasEdge predicate on the "follower" edge.
 func HasFollower() predicate.Follow {
 	return predicate.Follow(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, FollowerTable, FollowerColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, FollowerTable, FollowerColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -263,7 +223,7 @@ func HasFollowing() predicate.Follow {
 	return predicate.Follow(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, FollowingTable, FollowingColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, FollowingTable, FollowingColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +20,7 @@ type FollowCreate struct {
 	config
 	mutation *FollowMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreateTime sets the "create_time" field.
@@ -61,34 +63,14 @@ func (fc *FollowCreate) SetFollowingID(i int) *FollowCreate {
 	return fc
 }
 
-// AddFollowerIDs adds the "follower" edge to the User entity by IDs.
-func (fc *FollowCreate) AddFollowerIDs(ids ...int) *FollowCreate {
-	fc.mutation.AddFollowerIDs(ids...)
-	return fc
+// SetFollower sets the "follower" edge to the User entity.
+func (fc *FollowCreate) SetFollower(u *User) *FollowCreate {
+	return fc.SetFollowerID(u.ID)
 }
 
-// AddFollower adds the "follower" edges to the User entity.
-func (fc *FollowCreate) AddFollower(u ...*User) *FollowCreate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return fc.AddFollowerIDs(ids...)
-}
-
-// AddFollowingIDs adds the "following" edge to the User entity by IDs.
-func (fc *FollowCreate) AddFollowingIDs(ids ...int) *FollowCreate {
-	fc.mutation.AddFollowingIDs(ids...)
-	return fc
-}
-
-// AddFollowing adds the "following" edges to the User entity.
-func (fc *FollowCreate) AddFollowing(u ...*User) *FollowCreate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return fc.AddFollowingIDs(ids...)
+// SetFollowing sets the "following" edge to the User entity.
+func (fc *FollowCreate) SetFollowing(u *User) *FollowCreate {
+	return fc.SetFollowingID(u.ID)
 }
 
 // Mutation returns the FollowMutation object of the builder.
@@ -98,7 +80,9 @@ func (fc *FollowCreate) Mutation() *FollowMutation {
 
 // Save creates the Follow in the database.
 func (fc *FollowCreate) Save(ctx context.Context) (*Follow, error) {
-	fc.defaults()
+	if err := fc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, fc.sqlSave, fc.mutation, fc.hooks)
 }
 
@@ -125,15 +109,22 @@ func (fc *FollowCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (fc *FollowCreate) defaults() {
+func (fc *FollowCreate) defaults() error {
 	if _, ok := fc.mutation.CreateTime(); !ok {
+		if follow.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized follow.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
 		v := follow.DefaultCreateTime()
 		fc.mutation.SetCreateTime(v)
 	}
 	if _, ok := fc.mutation.UpdateTime(); !ok {
+		if follow.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized follow.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := follow.DefaultUpdateTime()
 		fc.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -150,6 +141,12 @@ func (fc *FollowCreate) check() error {
 	if _, ok := fc.mutation.FollowingID(); !ok {
 		return &ValidationError{Name: "following_id", err: errors.New(`ent: missing required field "Follow.following_id"`)}
 	}
+	if _, ok := fc.mutation.FollowerID(); !ok {
+		return &ValidationError{Name: "follower", err: errors.New(`ent: missing required edge "Follow.follower"`)}
+	}
+	if _, ok := fc.mutation.FollowingID(); !ok {
+		return &ValidationError{Name: "following", err: errors.New(`ent: missing required edge "Follow.following"`)}
+	}
 	return nil
 }
 
@@ -176,6 +173,7 @@ func (fc *FollowCreate) createSpec() (*Follow, *sqlgraph.CreateSpec) {
 		_node = &Follow{config: fc.config}
 		_spec = sqlgraph.NewCreateSpec(follow.Table, sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = fc.conflict
 	if value, ok := fc.mutation.CreateTime(); ok {
 		_spec.SetField(follow.FieldCreateTime, field.TypeTime, value)
 		_node.CreateTime = value
@@ -184,18 +182,10 @@ func (fc *FollowCreate) createSpec() (*Follow, *sqlgraph.CreateSpec) {
 		_spec.SetField(follow.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
-	if value, ok := fc.mutation.FollowerID(); ok {
-		_spec.SetField(follow.FieldFollowerID, field.TypeInt, value)
-		_node.FollowerID = value
-	}
-	if value, ok := fc.mutation.FollowingID(); ok {
-		_spec.SetField(follow.FieldFollowingID, field.TypeInt, value)
-		_node.FollowingID = value
-	}
 	if nodes := fc.mutation.FollowerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   follow.FollowerTable,
 			Columns: []string{follow.FollowerColumn},
 			Bidi:    false,
@@ -206,12 +196,13 @@ func (fc *FollowCreate) createSpec() (*Follow, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.FollowerID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := fc.mutation.FollowingIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   follow.FollowingTable,
 			Columns: []string{follow.FollowingColumn},
 			Bidi:    false,
@@ -222,16 +213,223 @@ func (fc *FollowCreate) createSpec() (*Follow, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.FollowingID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Follow.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FollowUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (fc *FollowCreate) OnConflict(opts ...sql.ConflictOption) *FollowUpsertOne {
+	fc.conflict = opts
+	return &FollowUpsertOne{
+		create: fc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Follow.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (fc *FollowCreate) OnConflictColumns(columns ...string) *FollowUpsertOne {
+	fc.conflict = append(fc.conflict, sql.ConflictColumns(columns...))
+	return &FollowUpsertOne{
+		create: fc,
+	}
+}
+
+type (
+	// FollowUpsertOne is the builder for "upsert"-ing
+	//  one Follow node.
+	FollowUpsertOne struct {
+		create *FollowCreate
+	}
+
+	// FollowUpsert is the "OnConflict" setter.
+	FollowUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *FollowUpsert) SetUpdateTime(v time.Time) *FollowUpsert {
+	u.Set(follow.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *FollowUpsert) UpdateUpdateTime() *FollowUpsert {
+	u.SetExcluded(follow.FieldUpdateTime)
+	return u
+}
+
+// SetFollowerID sets the "follower_id" field.
+func (u *FollowUpsert) SetFollowerID(v int) *FollowUpsert {
+	u.Set(follow.FieldFollowerID, v)
+	return u
+}
+
+// UpdateFollowerID sets the "follower_id" field to the value that was provided on create.
+func (u *FollowUpsert) UpdateFollowerID() *FollowUpsert {
+	u.SetExcluded(follow.FieldFollowerID)
+	return u
+}
+
+// SetFollowingID sets the "following_id" field.
+func (u *FollowUpsert) SetFollowingID(v int) *FollowUpsert {
+	u.Set(follow.FieldFollowingID, v)
+	return u
+}
+
+// UpdateFollowingID sets the "following_id" field to the value that was provided on create.
+func (u *FollowUpsert) UpdateFollowingID() *FollowUpsert {
+	u.SetExcluded(follow.FieldFollowingID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Follow.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FollowUpsertOne) UpdateNewValues() *FollowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(follow.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Follow.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FollowUpsertOne) Ignore() *FollowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FollowUpsertOne) DoNothing() *FollowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FollowCreate.OnConflict
+// documentation for more info.
+func (u *FollowUpsertOne) Update(set func(*FollowUpsert)) *FollowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FollowUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *FollowUpsertOne) SetUpdateTime(v time.Time) *FollowUpsertOne {
+	return u.Update(func(s *FollowUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *FollowUpsertOne) UpdateUpdateTime() *FollowUpsertOne {
+	return u.Update(func(s *FollowUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetFollowerID sets the "follower_id" field.
+func (u *FollowUpsertOne) SetFollowerID(v int) *FollowUpsertOne {
+	return u.Update(func(s *FollowUpsert) {
+		s.SetFollowerID(v)
+	})
+}
+
+// UpdateFollowerID sets the "follower_id" field to the value that was provided on create.
+func (u *FollowUpsertOne) UpdateFollowerID() *FollowUpsertOne {
+	return u.Update(func(s *FollowUpsert) {
+		s.UpdateFollowerID()
+	})
+}
+
+// SetFollowingID sets the "following_id" field.
+func (u *FollowUpsertOne) SetFollowingID(v int) *FollowUpsertOne {
+	return u.Update(func(s *FollowUpsert) {
+		s.SetFollowingID(v)
+	})
+}
+
+// UpdateFollowingID sets the "following_id" field to the value that was provided on create.
+func (u *FollowUpsertOne) UpdateFollowingID() *FollowUpsertOne {
+	return u.Update(func(s *FollowUpsert) {
+		s.UpdateFollowingID()
+	})
+}
+
+// Exec executes the query.
+func (u *FollowUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FollowCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FollowUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FollowUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FollowUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // FollowCreateBulk is the builder for creating many Follow entities in bulk.
 type FollowCreateBulk struct {
 	config
 	err      error
 	builders []*FollowCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Follow entities in the database.
@@ -261,6 +459,7 @@ func (fcb *FollowCreateBulk) Save(ctx context.Context) ([]*Follow, error) {
 					_, err = mutators[i+1].Mutate(root, fcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = fcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, fcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -314,3 +513,156 @@ func (fcb *FollowCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Follow.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FollowUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (fcb *FollowCreateBulk) OnConflict(opts ...sql.ConflictOption) *FollowUpsertBulk {
+	fcb.conflict = opts
+	return &FollowUpsertBulk{
+		create: fcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Follow.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (fcb *FollowCreateBulk) OnConflictColumns(columns ...string) *FollowUpsertBulk {
+	fcb.conflict = append(fcb.conflict, sql.ConflictColumns(columns...))
+	return &FollowUpsertBulk{
+		create: fcb,
+	}
+}
+
+// FollowUpsertBulk is the builder for "upsert"-ing
+// a bulk of Follow nodes.
+type FollowUpsertBulk struct {
+	create *FollowCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Follow.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FollowUpsertBulk) UpdateNewValues() *FollowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(follow.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Follow.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FollowUpsertBulk) Ignore() *FollowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FollowUpsertBulk) DoNothing() *FollowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FollowCreateBulk.OnConflict
+// documentation for more info.
+func (u *FollowUpsertBulk) Update(set func(*FollowUpsert)) *FollowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FollowUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *FollowUpsertBulk) SetUpdateTime(v time.Time) *FollowUpsertBulk {
+	return u.Update(func(s *FollowUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *FollowUpsertBulk) UpdateUpdateTime() *FollowUpsertBulk {
+	return u.Update(func(s *FollowUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetFollowerID sets the "follower_id" field.
+func (u *FollowUpsertBulk) SetFollowerID(v int) *FollowUpsertBulk {
+	return u.Update(func(s *FollowUpsert) {
+		s.SetFollowerID(v)
+	})
+}
+
+// UpdateFollowerID sets the "follower_id" field to the value that was provided on create.
+func (u *FollowUpsertBulk) UpdateFollowerID() *FollowUpsertBulk {
+	return u.Update(func(s *FollowUpsert) {
+		s.UpdateFollowerID()
+	})
+}
+
+// SetFollowingID sets the "following_id" field.
+func (u *FollowUpsertBulk) SetFollowingID(v int) *FollowUpsertBulk {
+	return u.Update(func(s *FollowUpsert) {
+		s.SetFollowingID(v)
+	})
+}
+
+// UpdateFollowingID sets the "following_id" field to the value that was provided on create.
+func (u *FollowUpsertBulk) UpdateFollowingID() *FollowUpsertBulk {
+	return u.Update(func(s *FollowUpsert) {
+		s.UpdateFollowingID()
+	})
+}
+
+// Exec executes the query.
+func (u *FollowUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FollowCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FollowCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FollowUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
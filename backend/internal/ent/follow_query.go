@@ -7,7 +7,6 @@ import (
 	"backend/internal/ent/predicate"
 	"backend/internal/ent/user"
 	"context"
-	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -25,7 +24,7 @@ type FollowQuery struct {
 	predicates    []predicate.Follow
 	withFollower  *UserQuery
 	withFollowing *UserQuery
-	withFKs       bool
+	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -76,7 +75,7 @@ func (fq *FollowQuery) QueryFollower() *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(follow.Table, follow.FieldID, selector),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, follow.FollowerTable, follow.FollowerColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, follow.FollowerTable, follow.FollowerColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
 		return fromU, nil
@@ -98,7 +97,7 @@ func (fq *FollowQuery) QueryFollowing() *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(follow.Table, follow.FieldID, selector),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, follow.FollowingTable, follow.FollowingColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, follow.FollowingTable, follow.FollowingColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
 		return fromU, nil
@@ -405,16 +404,12 @@ func (fq *FollowQuery) prepareQuery(ctx context.Context) error {
 func (fq *FollowQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Follow, error) {
 	var (
 		nodes       = []*Follow{}
-		withFKs     = fq.withFKs
 		_spec       = fq.querySpec()
 		loadedTypes = [2]bool{
 			fq.withFollower != nil,
 			fq.withFollowing != nil,
 		}
 	)
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, follow.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Follow).scanValues(nil, columns)
 	}
@@ -424,6 +419,9 @@ func (fq *FollowQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Follo
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(fq.modifiers) > 0 {
+		_spec.Modifiers = fq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -434,16 +432,14 @@ func (fq *FollowQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Follo
 		return nodes, nil
 	}
 	if query := fq.withFollower; query != nil {
-		if err := fq.loadFollower(ctx, query, nodes,
-			func(n *Follow) { n.Edges.Follower = []*User{} },
-			func(n *Follow, e *User) { n.Edges.Follower = append(n.Edges.Follower, e) }); err != nil {
+		if err := fq.loadFollower(ctx, query, nodes, nil,
+			func(n *Follow, e *User) { n.Edges.Follower = e }); err != nil {
 			return nil, err
 		}
 	}
 	if query := fq.withFollowing; query != nil {
-		if err := fq.loadFollowing(ctx, query, nodes,
-			func(n *Follow) { n.Edges.Following = []*User{} },
-			func(n *Follow, e *User) { n.Edges.Following = append(n.Edges.Following, e) }); err != nil {
+		if err := fq.loadFollowing(ctx, query, nodes, nil,
+			func(n *Follow, e *User) { n.Edges.Following = e }); err != nil {
 			return nil, err
 		}
 	}
@@ -451,70 +447,69 @@ func (fq *FollowQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Follo
 }
 
 func (fq *FollowQuery) loadFollower(ctx context.Context, query *UserQuery, nodes []*Follow, init func(*Follow), assign func(*Follow, *User)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*Follow)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Follow)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].FollowerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
 	}
-	query.withFKs = true
-	query.Where(predicate.User(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(follow.FollowerColumn), fks...))
-	}))
+	query.Where(user.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.follow_follower
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "follow_follower" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "follow_follower" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "follower_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
 func (fq *FollowQuery) loadFollowing(ctx context.Context, query *UserQuery, nodes []*Follow, init func(*Follow), assign func(*Follow, *User)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*Follow)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Follow)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].FollowingID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	query.withFKs = true
-	query.Where(predicate.User(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(follow.FollowingColumn), fks...))
-	}))
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.follow_following
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "follow_following" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "follow_following" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "following_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
 
 func (fq *FollowQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := fq.querySpec()
+	if len(fq.modifiers) > 0 {
+		_spec.Modifiers = fq.modifiers
+	}
 	_spec.Node.Columns = fq.ctx.Fields
 	if len(fq.ctx.Fields) > 0 {
 		_spec.Unique = fq.ctx.Unique != nil && *fq.ctx.Unique
@@ -538,6 +533,12 @@ func (fq *FollowQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if fq.withFollower != nil {
+			_spec.Node.AddColumnOnce(follow.FieldFollowerID)
+		}
+		if fq.withFollowing != nil {
+			_spec.Node.AddColumnOnce(follow.FieldFollowingID)
+		}
 	}
 	if ps := fq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -577,6 +578,9 @@ func (fq *FollowQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if fq.ctx.Unique != nil && *fq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range fq.modifiers {
+		m(selector)
+	}
 	for _, p := range fq.predicates {
 		p(selector)
 	}
@@ -594,6 +598,12 @@ func (fq *FollowQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (fq *FollowQuery) Modify(modifiers ...func(s *sql.Selector)) *FollowSelect {
+	fq.modifiers = append(fq.modifiers, modifiers...)
+	return fq.Select()
+}
+
 // FollowGroupBy is the group-by builder for Follow entities.
 type FollowGroupBy struct {
 	selector
@@ -683,3 +693,9 @@ func (fs *FollowSelect) sqlScan(ctx context.Context, root *FollowQuery, v any) e
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (fs *FollowSelect) Modify(modifiers ...func(s *sql.Selector)) *FollowSelect {
+	fs.modifiers = append(fs.modifiers, modifiers...)
+	return fs
+}
@@ -19,8 +19,9 @@ import (
 // FollowUpdate is the builder for updating Follow entities.
 type FollowUpdate struct {
 	config
-	hooks    []Hook
-	mutation *FollowMutation
+	hooks     []Hook
+	mutation  *FollowMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the FollowUpdate builder.
@@ -37,7 +38,6 @@ func (fu *FollowUpdate) SetUpdateTime(t time.Time) *FollowUpdate {
 
 // SetFollowerID sets the "follower_id" field.
 func (fu *FollowUpdate) SetFollowerID(i int) *FollowUpdate {
-	fu.mutation.ResetFollowerID()
 	fu.mutation.SetFollowerID(i)
 	return fu
 }
@@ -50,15 +50,8 @@ func (fu *FollowUpdate) SetNillableFollowerID(i *int) *FollowUpdate {
 	return fu
 }
 
-// AddFollowerID adds i to the "follower_id" field.
-func (fu *FollowUpdate) AddFollowerID(i int) *FollowUpdate {
-	fu.mutation.AddFollowerID(i)
-	return fu
-}
-
 // SetFollowingID sets the "following_id" field.
 func (fu *FollowUpdate) SetFollowingID(i int) *FollowUpdate {
-	fu.mutation.ResetFollowingID()
 	fu.mutation.SetFollowingID(i)
 	return fu
 }
@@ -71,40 +64,14 @@ func (fu *FollowUpdate) SetNillableFollowingID(i *int) *FollowUpdate {
 	return fu
 }
 
-// AddFollowingID adds i to the "following_id" field.
-func (fu *FollowUpdate) AddFollowingID(i int) *FollowUpdate {
-	fu.mutation.AddFollowingID(i)
-	return fu
+// SetFollower sets the "follower" edge to the User entity.
+func (fu *FollowUpdate) SetFollower(u *User) *FollowUpdate {
+	return fu.SetFollowerID(u.ID)
 }
 
-// AddFollowerIDs adds the "follower" edge to the User entity by IDs.
-func (fu *FollowUpdate) AddFollowerIDs(ids ...int) *FollowUpdate {
-	fu.mutation.AddFollowerIDs(ids...)
-	return fu
-}
-
-// AddFollower adds the "follower" edges to the User entity.
-func (fu *FollowUpdate) AddFollower(u ...*User) *FollowUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return fu.AddFollowerIDs(ids...)
-}
-
-// AddFollowingIDs adds the "following" edge to the User entity by IDs.
-func (fu *FollowUpdate) AddFollowingIDs(ids ...int) *FollowUpdate {
-	fu.mutation.AddFollowingIDs(ids...)
-	return fu
-}
-
-// AddFollowing adds the "following" edges to the User entity.
-func (fu *FollowUpdate) AddFollowing(u ...*User) *FollowUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return fu.AddFollowingIDs(ids...)
+// SetFollowing sets the "following" edge to the User entity.
+func (fu *FollowUpdate) SetFollowing(u *User) *FollowUpdate {
+	return fu.SetFollowingID(u.ID)
 }
 
 // Mutation returns the FollowMutation object of the builder.
@@ -112,51 +79,23 @@ func (fu *FollowUpdate) Mutation() *FollowMutation {
 	return fu.mutation
 }
 
-// ClearFollower clears all "follower" edges to the User entity.
+// ClearFollower clears the "follower" edge to the User entity.
 func (fu *FollowUpdate) ClearFollower() *FollowUpdate {
 	fu.mutation.ClearFollower()
 	return fu
 }
 
-// RemoveFollowerIDs removes the "follower" edge to User entities by IDs.
-func (fu *FollowUpdate) RemoveFollowerIDs(ids ...int) *FollowUpdate {
-	fu.mutation.RemoveFollowerIDs(ids...)
-	return fu
-}
-
-// RemoveFollower removes "follower" edges to User entities.
-func (fu *FollowUpdate) RemoveFollower(u ...*User) *FollowUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return fu.RemoveFollowerIDs(ids...)
-}
-
-// ClearFollowing clears all "following" edges to the User entity.
+// ClearFollowing clears the "following" edge to the User entity.
 func (fu *FollowUpdate) ClearFollowing() *FollowUpdate {
 	fu.mutation.ClearFollowing()
 	return fu
 }
 
-// RemoveFollowingIDs removes the "following" edge to User entities by IDs.
-func (fu *FollowUpdate) RemoveFollowingIDs(ids ...int) *FollowUpdate {
-	fu.mutation.RemoveFollowingIDs(ids...)
-	return fu
-}
-
-// RemoveFollowing removes "following" edges to User entities.
-func (fu *FollowUpdate) RemoveFollowing(u ...*User) *FollowUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return fu.RemoveFollowingIDs(ids...)
-}
-
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (fu *FollowUpdate) Save(ctx context.Context) (int, error) {
-	fu.defaults()
+	if err := fu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, fu.sqlSave, fu.mutation, fu.hooks)
 }
 
@@ -183,14 +122,38 @@ func (fu *FollowUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (fu *FollowUpdate) defaults() {
+func (fu *FollowUpdate) defaults() error {
 	if _, ok := fu.mutation.UpdateTime(); !ok {
+		if follow.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized follow.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := follow.UpdateDefaultUpdateTime()
 		fu.mutation.SetUpdateTime(v)
 	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fu *FollowUpdate) check() error {
+	if _, ok := fu.mutation.FollowerID(); fu.mutation.FollowerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Follow.follower"`)
+	}
+	if _, ok := fu.mutation.FollowingID(); fu.mutation.FollowingCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Follow.following"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fu *FollowUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FollowUpdate {
+	fu.modifiers = append(fu.modifiers, modifiers...)
+	return fu
 }
 
 func (fu *FollowUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(follow.Table, follow.Columns, sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt))
 	if ps := fu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -202,22 +165,10 @@ func (fu *FollowUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := fu.mutation.UpdateTime(); ok {
 		_spec.SetField(follow.FieldUpdateTime, field.TypeTime, value)
 	}
-	if value, ok := fu.mutation.FollowerID(); ok {
-		_spec.SetField(follow.FieldFollowerID, field.TypeInt, value)
-	}
-	if value, ok := fu.mutation.AddedFollowerID(); ok {
-		_spec.AddField(follow.FieldFollowerID, field.TypeInt, value)
-	}
-	if value, ok := fu.mutation.FollowingID(); ok {
-		_spec.SetField(follow.FieldFollowingID, field.TypeInt, value)
-	}
-	if value, ok := fu.mutation.AddedFollowingID(); ok {
-		_spec.AddField(follow.FieldFollowingID, field.TypeInt, value)
-	}
 	if fu.mutation.FollowerCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   follow.FollowerTable,
 			Columns: []string{follow.FollowerColumn},
 			Bidi:    false,
@@ -227,26 +178,10 @@ func (fu *FollowUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := fu.mutation.RemovedFollowerIDs(); len(nodes) > 0 && !fu.mutation.FollowerCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   follow.FollowerTable,
-			Columns: []string{follow.FollowerColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
 	if nodes := fu.mutation.FollowerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   follow.FollowerTable,
 			Columns: []string{follow.FollowerColumn},
 			Bidi:    false,
@@ -261,21 +196,8 @@ func (fu *FollowUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if fu.mutation.FollowingCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   follow.FollowingTable,
-			Columns: []string{follow.FollowingColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := fu.mutation.RemovedFollowingIDs(); len(nodes) > 0 && !fu.mutation.FollowingCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   follow.FollowingTable,
 			Columns: []string{follow.FollowingColumn},
 			Bidi:    false,
@@ -283,15 +205,12 @@ func (fu *FollowUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := fu.mutation.FollowingIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   follow.FollowingTable,
 			Columns: []string{follow.FollowingColumn},
 			Bidi:    false,
@@ -304,6 +223,7 @@ func (fu *FollowUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(fu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{follow.Label}
@@ -319,9 +239,10 @@ func (fu *FollowUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // FollowUpdateOne is the builder for updating a single Follow entity.
 type FollowUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FollowMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *FollowMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUpdateTime sets the "update_time" field.
@@ -332,7 +253,6 @@ func (fuo *FollowUpdateOne) SetUpdateTime(t time.Time) *FollowUpdateOne {
 
 // SetFollowerID sets the "follower_id" field.
 func (fuo *FollowUpdateOne) SetFollowerID(i int) *FollowUpdateOne {
-	fuo.mutation.ResetFollowerID()
 	fuo.mutation.SetFollowerID(i)
 	return fuo
 }
@@ -345,15 +265,8 @@ func (fuo *FollowUpdateOne) SetNillableFollowerID(i *int) *FollowUpdateOne {
 	return fuo
 }
 
-// AddFollowerID adds i to the "follower_id" field.
-func (fuo *FollowUpdateOne) AddFollowerID(i int) *FollowUpdateOne {
-	fuo.mutation.AddFollowerID(i)
-	return fuo
-}
-
 // SetFollowingID sets the "following_id" field.
 func (fuo *FollowUpdateOne) SetFollowingID(i int) *FollowUpdateOne {
-	fuo.mutation.ResetFollowingID()
 	fuo.mutation.SetFollowingID(i)
 	return fuo
 }
@@ -366,40 +279,14 @@ func (fuo *FollowUpdateOne) SetNillableFollowingID(i *int) *FollowUpdateOne {
 	return fuo
 }
 
-// AddFollowingID adds i to the "following_id" field.
-func (fuo *FollowUpdateOne) AddFollowingID(i int) *FollowUpdateOne {
-	fuo.mutation.AddFollowingID(i)
-	return fuo
+// SetFollower sets the "follower" edge to the User entity.
+func (fuo *FollowUpdateOne) SetFollower(u *User) *FollowUpdateOne {
+	return fuo.SetFollowerID(u.ID)
 }
 
-// AddFollowerIDs adds the "follower" edge to the User entity by IDs.
-func (fuo *FollowUpdateOne) AddFollowerIDs(ids ...int) *FollowUpdateOne {
-	fuo.mutation.AddFollowerIDs(ids...)
-	return fuo
-}
-
-// AddFollower adds the "follower" edges to the User entity.
-func (fuo *FollowUpdateOne) AddFollower(u ...*User) *FollowUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return fuo.AddFollowerIDs(ids...)
-}
-
-// AddFollowingIDs adds the "following" edge to the User entity by IDs.
-func (fuo *FollowUpdateOne) AddFollowingIDs(ids ...int) *FollowUpdateOne {
-	fuo.mutation.AddFollowingIDs(ids...)
-	return fuo
-}
-
-// AddFollowing adds the "following" edges to the User entity.
-func (fuo *FollowUpdateOne) AddFollowing(u ...*User) *FollowUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return fuo.AddFollowingIDs(ids...)
+// SetFollowing sets the "following" edge to the User entity.
+func (fuo *FollowUpdateOne) SetFollowing(u *User) *FollowUpdateOne {
+	return fuo.SetFollowingID(u.ID)
 }
 
 // Mutation returns the FollowMutation object of the builder.
@@ -407,48 +294,18 @@ func (fuo *FollowUpdateOne) Mutation() *FollowMutation {
 	return fuo.mutation
 }
 
-// ClearFollower clears all "follower" edges to the User entity.
+// ClearFollower clears the "follower" edge to the User entity.
 func (fuo *FollowUpdateOne) ClearFollower() *FollowUpdateOne {
 	fuo.mutation.ClearFollower()
 	return fuo
 }
 
-// RemoveFollowerIDs removes the "follower" edge to User entities by IDs.
-func (fuo *FollowUpdateOne) RemoveFollowerIDs(ids ...int) *FollowUpdateOne {
-	fuo.mutation.RemoveFollowerIDs(ids...)
-	return fuo
-}
-
-// RemoveFollower removes "follower" edges to User entities.
-func (fuo *FollowUpdateOne) RemoveFollower(u ...*User) *FollowUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return fuo.RemoveFollowerIDs(ids...)
-}
-
-// ClearFollowing clears all "following" edges to the User entity.
+// ClearFollowing clears the "following" edge to the User entity.
 func (fuo *FollowUpdateOne) ClearFollowing() *FollowUpdateOne {
 	fuo.mutation.ClearFollowing()
 	return fuo
 }
 
-// RemoveFollowingIDs removes the "following" edge to User entities by IDs.
-func (fuo *FollowUpdateOne) RemoveFollowingIDs(ids ...int) *FollowUpdateOne {
-	fuo.mutation.RemoveFollowingIDs(ids...)
-	return fuo
-}
-
-// RemoveFollowing removes "following" edges to User entities.
-func (fuo *FollowUpdateOne) RemoveFollowing(u ...*User) *FollowUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return fuo.RemoveFollowingIDs(ids...)
-}
-
 // Where appends a list predicates to the FollowUpdate builder.
 func (fuo *FollowUpdateOne) Where(ps ...predicate.Follow) *FollowUpdateOne {
 	fuo.mutation.Where(ps...)
@@ -464,7 +321,9 @@ func (fuo *FollowUpdateOne) Select(field string, fields ...string) *FollowUpdate
 
 // Save executes the query and returns the updated Follow entity.
 func (fuo *FollowUpdateOne) Save(ctx context.Context) (*Follow, error) {
-	fuo.defaults()
+	if err := fuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, fuo.sqlSave, fuo.mutation, fuo.hooks)
 }
 
@@ -491,14 +350,38 @@ func (fuo *FollowUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (fuo *FollowUpdateOne) defaults() {
+func (fuo *FollowUpdateOne) defaults() error {
 	if _, ok := fuo.mutation.UpdateTime(); !ok {
+		if follow.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized follow.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := follow.UpdateDefaultUpdateTime()
 		fuo.mutation.SetUpdateTime(v)
 	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fuo *FollowUpdateOne) check() error {
+	if _, ok := fuo.mutation.FollowerID(); fuo.mutation.FollowerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Follow.follower"`)
+	}
+	if _, ok := fuo.mutation.FollowingID(); fuo.mutation.FollowingCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Follow.following"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fuo *FollowUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FollowUpdateOne {
+	fuo.modifiers = append(fuo.modifiers, modifiers...)
+	return fuo
 }
 
 func (fuo *FollowUpdateOne) sqlSave(ctx context.Context) (_node *Follow, err error) {
+	if err := fuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(follow.Table, follow.Columns, sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt))
 	id, ok := fuo.mutation.ID()
 	if !ok {
@@ -527,22 +410,10 @@ func (fuo *FollowUpdateOne) sqlSave(ctx context.Context) (_node *Follow, err err
 	if value, ok := fuo.mutation.UpdateTime(); ok {
 		_spec.SetField(follow.FieldUpdateTime, field.TypeTime, value)
 	}
-	if value, ok := fuo.mutation.FollowerID(); ok {
-		_spec.SetField(follow.FieldFollowerID, field.TypeInt, value)
-	}
-	if value, ok := fuo.mutation.AddedFollowerID(); ok {
-		_spec.AddField(follow.FieldFollowerID, field.TypeInt, value)
-	}
-	if value, ok := fuo.mutation.FollowingID(); ok {
-		_spec.SetField(follow.FieldFollowingID, field.TypeInt, value)
-	}
-	if value, ok := fuo.mutation.AddedFollowingID(); ok {
-		_spec.AddField(follow.FieldFollowingID, field.TypeInt, value)
-	}
 	if fuo.mutation.FollowerCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   follow.FollowerTable,
 			Columns: []string{follow.FollowerColumn},
 			Bidi:    false,
@@ -552,26 +423,10 @@ func (fuo *FollowUpdateOne) sqlSave(ctx context.Context) (_node *Follow, err err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := fuo.mutation.RemovedFollowerIDs(); len(nodes) > 0 && !fuo.mutation.FollowerCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   follow.FollowerTable,
-			Columns: []string{follow.FollowerColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
 	if nodes := fuo.mutation.FollowerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   follow.FollowerTable,
 			Columns: []string{follow.FollowerColumn},
 			Bidi:    false,
@@ -586,21 +441,8 @@ func (fuo *FollowUpdateOne) sqlSave(ctx context.Context) (_node *Follow, err err
 	}
 	if fuo.mutation.FollowingCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   follow.FollowingTable,
-			Columns: []string{follow.FollowingColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := fuo.mutation.RemovedFollowingIDs(); len(nodes) > 0 && !fuo.mutation.FollowingCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   follow.FollowingTable,
 			Columns: []string{follow.FollowingColumn},
 			Bidi:    false,
@@ -608,15 +450,12 @@ func (fuo *FollowUpdateOne) sqlSave(ctx context.Context) (_node *Follow, err err
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := fuo.mutation.FollowingIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   follow.FollowingTable,
 			Columns: []string{follow.FollowingColumn},
 			Bidi:    false,
@@ -629,6 +468,7 @@ func (fuo *FollowUpdateOne) sqlSave(ctx context.Context) (_node *Follow, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(fuo.modifiers...)
 	_node = &Follow{config: fuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
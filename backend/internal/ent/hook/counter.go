@@ -0,0 +1,205 @@
+package hook
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Counter 描述一个冗余计数：关系表 Table 中每一行通过外键 Column 给 Target 表对应记录的 Field 列计一次数。
+// Until 是关系表上一个可空的时间列，设置后该列非空的行不计数，例如评论的墓碑。
+type Counter struct {
+	Table  string
+	Column string
+	Target string
+	Field  string
+	Until  string
+}
+
+// 全部冗余计数。新增计数时在这里登记，并在关系表 schema 的 Hooks 中挂上 Counters。
+var (
+	PostLikes         = Counter{Table: "user_like_posts", Column: "post_id", Target: "posts", Field: "like_count"}
+	PostFavorites     = Counter{Table: "user_favorite_posts", Column: "post_id", Target: "posts", Field: "favorite_count"}
+	PostComments      = Counter{Table: "comments", Column: "post_id", Target: "posts", Field: "comment_count", Until: "deleted_at"}
+	QuestionLikes     = Counter{Table: "user_like_questions", Column: "question_id", Target: "questions", Field: "like_count"}
+	QuestionFavorites = Counter{Table: "user_favorite_questions", Column: "question_id", Target: "questions", Field: "favorite_count"}
+	QuestionComments  = Counter{Table: "comments", Column: "question_id", Target: "questions", Field: "comment_count", Until: "deleted_at"}
+	QuestionAnswers   = Counter{Table: "answers", Column: "question_id", Target: "questions", Field: "answer_count"}
+	UserFollowers     = Counter{Table: "follows", Column: "following_id", Target: "users", Field: "follower_count"}
+	UserFollowings    = Counter{Table: "follows", Column: "follower_id", Target: "users", Field: "following_count"}
+	TagPosts          = Counter{Table: "post_tags", Column: "tag_id", Target: "tags", Field: "post_count"}
+	TagQuestions      = Counter{Table: "question_tags", Column: "tag_id", Target: "tags", Field: "question_count"}
+	AllCounters       = []Counter{PostLikes, PostFavorites, PostComments, QuestionLikes, QuestionFavorites, QuestionComments, QuestionAnswers, UserFollowers, UserFollowings, TagPosts, TagQuestions}
+)
+
+// execQuerier 由开启 sql/execquery 特性后的 mutation 实现，在事务中调用时语句也落在同一事务里。
+type execQuerier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error)
+}
+
+type idsMutation interface {
+	IDs(ctx context.Context) ([]int, error)
+}
+
+// Counters 返回维护冗余计数的 hook，传入的计数必须属于同一张关系表。
+// 创建成功后对应计数加一；删除前先用 SELECT ... FOR UPDATE 锁住将被删除的行并记下外键，
+// 删除后按外键分组减去实际行数，并发删除同一行时只有一方会计数。
+// 设置了 Until 时，把 Until 列写成非空的更新按删除处理，已经非空的行在删除时不再减。
+// 计数列用 col = col ± n 原地更新，行锁保证并发写入不会丢失更新。
+// 调用方应在事务中写关系表，计数才会与关系行一起提交或回滚；
+// 修改外键、清空 Until 列的更新不在维护范围内。
+func Counters(counters ...Counter) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			eq, ok := m.(execQuerier)
+			if !ok {
+				return nil, fmt.Errorf("hook: %T does not support raw queries, enable the sql/execquery feature", m)
+			}
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return v, err
+				}
+				// 直接以墓碑状态写入的行不计数
+				if settingUntil(m, counters) {
+					return v, nil
+				}
+				for _, c := range counters {
+					if id, ok := intField(m, c.Column); ok {
+						if err := c.add(ctx, eq, map[int]int{id: 1}); err != nil {
+							return nil, err
+						}
+					}
+				}
+				return v, nil
+
+			case m.Op().Is(ent.OpDelete | ent.OpDeleteOne), m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) && settingUntil(m, counters):
+				im, ok := m.(idsMutation)
+				if !ok {
+					return nil, fmt.Errorf("hook: unexpected mutation type %T", m)
+				}
+				ids, err := im.IDs(ctx)
+				if err != nil {
+					return nil, err
+				}
+				if len(ids) == 0 {
+					return next.Mutate(ctx, m)
+				}
+				deltas, err := lockParents(ctx, eq, counters, ids)
+				if err != nil {
+					return nil, err
+				}
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return v, err
+				}
+				for i, c := range counters {
+					if err := c.add(ctx, eq, deltas[i]); err != nil {
+						return nil, err
+					}
+				}
+				return v, nil
+
+			default:
+				return next.Mutate(ctx, m)
+			}
+		})
+	}
+}
+
+// lockParents 锁住即将删除的关系行，返回每个计数下各父记录需要减去的数量（负数）。
+func lockParents(ctx context.Context, eq execQuerier, counters []Counter, ids []int) ([]map[int]int, error) {
+	columns := make([]string, len(counters))
+	for i, c := range counters {
+		columns[i] = c.Column
+	}
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	where := sql.In("id", args...)
+	if until := counters[0].Until; until != "" {
+		where = sql.And(where, sql.IsNull(until))
+	}
+	query, qargs := sql.Dialect(dialect.Postgres).
+		Select(columns...).
+		From(sql.Table(counters[0].Table)).
+		Where(where).
+		ForUpdate().
+		Query()
+	rows, err := eq.QueryContext(ctx, query, qargs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deltas := make([]map[int]int, len(counters))
+	for i := range deltas {
+		deltas[i] = make(map[int]int)
+	}
+	values := make([]stdsql.NullInt64, len(counters))
+	dest := make([]any, len(counters))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		for i, v := range values {
+			if v.Valid && v.Int64 != 0 {
+				deltas[i][int(v.Int64)]--
+			}
+		}
+	}
+	return deltas, rows.Err()
+}
+
+// Filter 返回关系表中计数的行需要满足的 SQL 条件，table 是关系表在查询中的名字或别名，
+// 供 cmd/reconcile-counters 等直接写 SQL 重新统计的地方使用。没有额外条件时返回 TRUE。
+func (c Counter) Filter(table string) string {
+	if c.Until == "" {
+		return "TRUE"
+	}
+	return fmt.Sprintf("%s.%s IS NULL", table, c.Until)
+}
+
+// add 把 deltas 中的增量加到各父记录的计数列上。
+func (c Counter) add(ctx context.Context, eq execQuerier, deltas map[int]int) error {
+	for id, n := range deltas {
+		query, args := sql.Dialect(dialect.Postgres).
+			Update(c.Target).
+			Add(c.Field, n).
+			Where(sql.EQ("id", id)).
+			Query()
+		if _, err := eq.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("hook: update %s.%s: %w", c.Target, c.Field, err)
+		}
+	}
+	return nil
+}
+
+// settingUntil 报告更新是否把计数的 Until 列写成非空值。
+func settingUntil(m ent.Mutation, counters []Counter) bool {
+	until := counters[0].Until
+	if until == "" {
+		return false
+	}
+	_, ok := m.Field(until)
+	return ok
+}
+
+func intField(m ent.Mutation, name string) (int, bool) {
+	v, ok := m.Field(name)
+	if !ok {
+		return 0, false
+	}
+	id, ok := v.(int)
+	return id, ok && id != 0
+}
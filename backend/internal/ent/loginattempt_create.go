@@ -0,0 +1,469 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/ent/loginattempt"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginAttemptCreate is the builder for creating a LoginAttempt entity.
+type LoginAttemptCreate struct {
+	config
+	mutation *LoginAttemptMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (lac *LoginAttemptCreate) SetCreateTime(t time.Time) *LoginAttemptCreate {
+	lac.mutation.SetCreateTime(t)
+	return lac
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableCreateTime(t *time.Time) *LoginAttemptCreate {
+	if t != nil {
+		lac.SetCreateTime(*t)
+	}
+	return lac
+}
+
+// SetKey sets the "key" field.
+func (lac *LoginAttemptCreate) SetKey(s string) *LoginAttemptCreate {
+	lac.mutation.SetKey(s)
+	return lac
+}
+
+// Mutation returns the LoginAttemptMutation object of the builder.
+func (lac *LoginAttemptCreate) Mutation() *LoginAttemptMutation {
+	return lac.mutation
+}
+
+// Save creates the LoginAttempt in the database.
+func (lac *LoginAttemptCreate) Save(ctx context.Context) (*LoginAttempt, error) {
+	lac.defaults()
+	return withHooks(ctx, lac.sqlSave, lac.mutation, lac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lac *LoginAttemptCreate) SaveX(ctx context.Context) *LoginAttempt {
+	v, err := lac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lac *LoginAttemptCreate) Exec(ctx context.Context) error {
+	_, err := lac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lac *LoginAttemptCreate) ExecX(ctx context.Context) {
+	if err := lac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lac *LoginAttemptCreate) defaults() {
+	if _, ok := lac.mutation.CreateTime(); !ok {
+		v := loginattempt.DefaultCreateTime()
+		lac.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lac *LoginAttemptCreate) check() error {
+	if _, ok := lac.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "LoginAttempt.create_time"`)}
+	}
+	if _, ok := lac.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "LoginAttempt.key"`)}
+	}
+	if v, ok := lac.mutation.Key(); ok {
+		if err := loginattempt.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "LoginAttempt.key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lac *LoginAttemptCreate) sqlSave(ctx context.Context) (*LoginAttempt, error) {
+	if err := lac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	lac.mutation.id = &_node.ID
+	lac.mutation.done = true
+	return _node, nil
+}
+
+func (lac *LoginAttemptCreate) createSpec() (*LoginAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginAttempt{config: lac.config}
+		_spec = sqlgraph.NewCreateSpec(loginattempt.Table, sqlgraph.NewFieldSpec(loginattempt.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = lac.conflict
+	if value, ok := lac.mutation.CreateTime(); ok {
+		_spec.SetField(loginattempt.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := lac.mutation.Key(); ok {
+		_spec.SetField(loginattempt.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoginAttempt.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoginAttemptUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (lac *LoginAttemptCreate) OnConflict(opts ...sql.ConflictOption) *LoginAttemptUpsertOne {
+	lac.conflict = opts
+	return &LoginAttemptUpsertOne{
+		create: lac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoginAttempt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lac *LoginAttemptCreate) OnConflictColumns(columns ...string) *LoginAttemptUpsertOne {
+	lac.conflict = append(lac.conflict, sql.ConflictColumns(columns...))
+	return &LoginAttemptUpsertOne{
+		create: lac,
+	}
+}
+
+type (
+	// LoginAttemptUpsertOne is the builder for "upsert"-ing
+	//  one LoginAttempt node.
+	LoginAttemptUpsertOne struct {
+		create *LoginAttemptCreate
+	}
+
+	// LoginAttemptUpsert is the "OnConflict" setter.
+	LoginAttemptUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.LoginAttempt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *LoginAttemptUpsertOne) UpdateNewValues() *LoginAttemptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(loginattempt.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.Key(); exists {
+			s.SetIgnore(loginattempt.FieldKey)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoginAttempt.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LoginAttemptUpsertOne) Ignore() *LoginAttemptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoginAttemptUpsertOne) DoNothing() *LoginAttemptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoginAttemptCreate.OnConflict
+// documentation for more info.
+func (u *LoginAttemptUpsertOne) Update(set func(*LoginAttemptUpsert)) *LoginAttemptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoginAttemptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *LoginAttemptUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoginAttemptCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoginAttemptUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LoginAttemptUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LoginAttemptUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LoginAttemptCreateBulk is the builder for creating many LoginAttempt entities in bulk.
+type LoginAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*LoginAttemptCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LoginAttempt entities in the database.
+func (lacb *LoginAttemptCreateBulk) Save(ctx context.Context) ([]*LoginAttempt, error) {
+	if lacb.err != nil {
+		return nil, lacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lacb.builders))
+	nodes := make([]*LoginAttempt, len(lacb.builders))
+	mutators := make([]Mutator, len(lacb.builders))
+	for i := range lacb.builders {
+		func(i int, root context.Context) {
+			builder := lacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = lacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lacb *LoginAttemptCreateBulk) SaveX(ctx context.Context) []*LoginAttempt {
+	v, err := lacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lacb *LoginAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := lacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lacb *LoginAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := lacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoginAttempt.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoginAttemptUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (lacb *LoginAttemptCreateBulk) OnConflict(opts ...sql.ConflictOption) *LoginAttemptUpsertBulk {
+	lacb.conflict = opts
+	return &LoginAttemptUpsertBulk{
+		create: lacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoginAttempt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lacb *LoginAttemptCreateBulk) OnConflictColumns(columns ...string) *LoginAttemptUpsertBulk {
+	lacb.conflict = append(lacb.conflict, sql.ConflictColumns(columns...))
+	return &LoginAttemptUpsertBulk{
+		create: lacb,
+	}
+}
+
+// LoginAttemptUpsertBulk is the builder for "upsert"-ing
+// a bulk of LoginAttempt nodes.
+type LoginAttemptUpsertBulk struct {
+	create *LoginAttemptCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LoginAttempt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *LoginAttemptUpsertBulk) UpdateNewValues() *LoginAttemptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(loginattempt.FieldCreateTime)
+			}
+			if _, exists := b.mutation.Key(); exists {
+				s.SetIgnore(loginattempt.FieldKey)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoginAttempt.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LoginAttemptUpsertBulk) Ignore() *LoginAttemptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoginAttemptUpsertBulk) DoNothing() *LoginAttemptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoginAttemptCreateBulk.OnConflict
+// documentation for more info.
+func (u *LoginAttemptUpsertBulk) Update(set func(*LoginAttemptUpsert)) *LoginAttemptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoginAttemptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *LoginAttemptUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LoginAttemptCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoginAttemptCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoginAttemptUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -3,24 +3,114 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// AnswersColumns holds the columns for the "answers" table.
+	AnswersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "vote_score", Type: field.TypeInt, Default: 0},
+		{Name: "question_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// AnswersTable holds the schema information for the "answers" table.
+	AnswersTable = &schema.Table{
+		Name:       "answers",
+		Columns:    AnswersColumns,
+		PrimaryKey: []*schema.Column{AnswersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "answers_questions_answers",
+				Columns:    []*schema.Column{AnswersColumns[5]},
+				RefColumns: []*schema.Column{QuestionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "answers_users_answers",
+				Columns:    []*schema.Column{AnswersColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "answer_question_id_vote_score_id",
+				Unique:  false,
+				Columns: []*schema.Column{AnswersColumns[5], AnswersColumns[4], AnswersColumns[0]},
+			},
+			{
+				Name:    "answer_question_id_create_time_id",
+				Unique:  false,
+				Columns: []*schema.Column{AnswersColumns[5], AnswersColumns[1], AnswersColumns[0]},
+			},
+			{
+				Name:    "answer_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{AnswersColumns[6]},
+			},
+		},
+	}
+	// AnswerVotesColumns holds the columns for the "answer_votes" table.
+	AnswerVotesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "value", Type: field.TypeInt},
+		{Name: "answer_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// AnswerVotesTable holds the schema information for the "answer_votes" table.
+	AnswerVotesTable = &schema.Table{
+		Name:       "answer_votes",
+		Columns:    AnswerVotesColumns,
+		PrimaryKey: []*schema.Column{AnswerVotesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "answer_votes_answers_votes",
+				Columns:    []*schema.Column{AnswerVotesColumns[4]},
+				RefColumns: []*schema.Column{AnswersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "answer_votes_users_answer_votes",
+				Columns:    []*schema.Column{AnswerVotesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "answervote_answer_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{AnswerVotesColumns[4], AnswerVotesColumns[5]},
+			},
+			{
+				Name:    "answervote_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{AnswerVotesColumns[5]},
+			},
+		},
+	}
 	// CommentsColumns holds the columns for the "comments" table.
 	CommentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "content", Type: field.TypeString, Nullable: true},
-		{Name: "user_id", Type: field.TypeInt},
+		{Name: "depth", Type: field.TypeInt, Default: 1},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "answer_id", Type: field.TypeInt, Nullable: true},
+		{Name: "reply_to_user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
 		{Name: "post_id", Type: field.TypeInt, Nullable: true},
 		{Name: "question_id", Type: field.TypeInt, Nullable: true},
-		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
-		{Name: "post_comments", Type: field.TypeInt, Nullable: true},
-		{Name: "question_comments", Type: field.TypeInt, Nullable: true},
-		{Name: "user_comments", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
 	}
 	// CommentsTable holds the schema information for the "comments" table.
 	CommentsTable = &schema.Table{
@@ -29,44 +119,67 @@ var (
 		PrimaryKey: []*schema.Column{CommentsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "comments_posts_comments",
+				Symbol:     "comments_answers_comments",
+				Columns:    []*schema.Column{CommentsColumns[6]},
+				RefColumns: []*schema.Column{AnswersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "comments_users_reply_to_user",
+				Columns:    []*schema.Column{CommentsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "comments_comments_replies",
 				Columns:    []*schema.Column{CommentsColumns[8]},
-				RefColumns: []*schema.Column{PostsColumns[0]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
-				Symbol:     "comments_questions_comments",
+				Symbol:     "comments_posts_comments",
 				Columns:    []*schema.Column{CommentsColumns[9]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "comments_questions_comments",
+				Columns:    []*schema.Column{CommentsColumns[10]},
 				RefColumns: []*schema.Column{QuestionsColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "comments_users_comments",
-				Columns:    []*schema.Column{CommentsColumns[10]},
+				Columns:    []*schema.Column{CommentsColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "comment_post_id",
 				Unique:  false,
-				Columns: []*schema.Column{CommentsColumns[5]},
+				Columns: []*schema.Column{CommentsColumns[9]},
 			},
 			{
 				Name:    "comment_question_id",
 				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[10]},
+			},
+			{
+				Name:    "comment_answer_id",
+				Unique:  false,
 				Columns: []*schema.Column{CommentsColumns[6]},
 			},
 			{
 				Name:    "comment_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{CommentsColumns[4]},
+				Columns: []*schema.Column{CommentsColumns[11]},
 			},
 			{
-				Name:    "comment_parent_id",
+				Name:    "comment_parent_id_create_time_id",
 				Unique:  false,
-				Columns: []*schema.Column{CommentsColumns[7]},
+				Columns: []*schema.Column{CommentsColumns[8], CommentsColumns[1], CommentsColumns[0]},
 			},
 		},
 	}
@@ -77,8 +190,6 @@ var (
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "follower_id", Type: field.TypeInt},
 		{Name: "following_id", Type: field.TypeInt},
-		{Name: "user_following", Type: field.TypeInt, Nullable: true},
-		{Name: "user_followed_by", Type: field.TypeInt, Nullable: true},
 	}
 	// FollowsTable holds the schema information for the "follows" table.
 	FollowsTable = &schema.Table{
@@ -88,27 +199,51 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "follows_users_following",
-				Columns:    []*schema.Column{FollowsColumns[5]},
+				Columns:    []*schema.Column{FollowsColumns[3]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "follows_users_followed_by",
-				Columns:    []*schema.Column{FollowsColumns[6]},
+				Columns:    []*schema.Column{FollowsColumns[4]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "follow_follower_id",
+				Name:    "follow_follower_id_following_id",
+				Unique:  true,
+				Columns: []*schema.Column{FollowsColumns[3], FollowsColumns[4]},
+			},
+			{
+				Name:    "follow_follower_id_create_time_id",
 				Unique:  false,
-				Columns: []*schema.Column{FollowsColumns[3]},
+				Columns: []*schema.Column{FollowsColumns[3], FollowsColumns[1], FollowsColumns[0]},
 			},
 			{
-				Name:    "follow_following_id",
+				Name:    "follow_following_id_create_time_id",
 				Unique:  false,
-				Columns: []*schema.Column{FollowsColumns[4]},
+				Columns: []*schema.Column{FollowsColumns[4], FollowsColumns[1], FollowsColumns[0]},
+			},
+		},
+	}
+	// LoginAttemptsColumns holds the columns for the "login_attempts" table.
+	LoginAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "key", Type: field.TypeString, Size: 128},
+	}
+	// LoginAttemptsTable holds the schema information for the "login_attempts" table.
+	LoginAttemptsTable = &schema.Table{
+		Name:       "login_attempts",
+		Columns:    LoginAttemptsColumns,
+		PrimaryKey: []*schema.Column{LoginAttemptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loginattempt_key_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{LoginAttemptsColumns[2], LoginAttemptsColumns[1]},
 			},
 		},
 	}
@@ -119,12 +254,11 @@ var (
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "title", Type: field.TypeString, Size: 255},
 		{Name: "content", Type: field.TypeString, Nullable: true},
-		{Name: "embedding", Type: field.TypeBytes, Nullable: true},
-		{Name: "comment_post", Type: field.TypeInt, Nullable: true},
-		{Name: "post_tag_post", Type: field.TypeInt, Nullable: true},
-		{Name: "user_posts", Type: field.TypeInt, Nullable: true},
-		{Name: "user_favorite_post_post", Type: field.TypeInt, Nullable: true},
-		{Name: "user_like_post_post", Type: field.TypeInt, Nullable: true},
+		{Name: "embedding", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector(1536)"}},
+		{Name: "like_count", Type: field.TypeInt, Default: 0},
+		{Name: "favorite_count", Type: field.TypeInt, Default: 0},
+		{Name: "comment_count", Type: field.TypeInt, Default: 0},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
 	}
 	// PostsTable holds the schema information for the "posts" table.
 	PostsTable = &schema.Table{
@@ -133,41 +267,57 @@ var (
 		PrimaryKey: []*schema.Column{PostsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "posts_comments_post",
-				Columns:    []*schema.Column{PostsColumns[6]},
-				RefColumns: []*schema.Column{CommentsColumns[0]},
+				Symbol:     "posts_users_posts",
+				Columns:    []*schema.Column{PostsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+		},
+		Indexes: []*schema.Index{
 			{
-				Symbol:     "posts_post_tags_post",
-				Columns:    []*schema.Column{PostsColumns[7]},
-				RefColumns: []*schema.Column{PostTagsColumns[0]},
-				OnDelete:   schema.SetNull,
+				Name:    "post_title",
+				Unique:  false,
+				Columns: []*schema.Column{PostsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "varchar_pattern_ops",
+				},
 			},
 			{
-				Symbol:     "posts_users_posts",
-				Columns:    []*schema.Column{PostsColumns[8]},
-				RefColumns: []*schema.Column{UsersColumns[0]},
-				OnDelete:   schema.SetNull,
+				Name:    "post_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PostsColumns[9]},
 			},
 			{
-				Symbol:     "posts_user_favorite_posts_post",
-				Columns:    []*schema.Column{PostsColumns[9]},
-				RefColumns: []*schema.Column{UserFavoritePostsColumns[0]},
-				OnDelete:   schema.SetNull,
+				Name:    "post_create_time_id",
+				Unique:  false,
+				Columns: []*schema.Column{PostsColumns[1], PostsColumns[0]},
 			},
 			{
-				Symbol:     "posts_user_like_posts_post",
-				Columns:    []*schema.Column{PostsColumns[10]},
-				RefColumns: []*schema.Column{UserLikePostsColumns[0]},
-				OnDelete:   schema.SetNull,
+				Name:    "post_embedding",
+				Unique:  false,
+				Columns: []*schema.Column{PostsColumns[5]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "vector_cosine_ops",
+					Type:    "hnsw",
+				},
 			},
-		},
-		Indexes: []*schema.Index{
 			{
-				Name:    "post_title",
+				Name:    "post_title_trgm",
 				Unique:  false,
 				Columns: []*schema.Column{PostsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+			{
+				Name:    "post_content_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{PostsColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
 			},
 		},
 	}
@@ -176,8 +326,6 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "post_id", Type: field.TypeInt},
 		{Name: "tag_id", Type: field.TypeInt},
-		{Name: "post_tags", Type: field.TypeInt, Nullable: true},
-		{Name: "tag_posts", Type: field.TypeInt, Nullable: true},
 	}
 	// PostTagsTable holds the schema information for the "post_tags" table.
 	PostTagsTable = &schema.Table{
@@ -187,15 +335,15 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "post_tags_posts_tags",
-				Columns:    []*schema.Column{PostTagsColumns[3]},
+				Columns:    []*schema.Column{PostTagsColumns[1]},
 				RefColumns: []*schema.Column{PostsColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "post_tags_tags_posts",
-				Columns:    []*schema.Column{PostTagsColumns[4]},
+				Columns:    []*schema.Column{PostTagsColumns[2]},
 				RefColumns: []*schema.Column{TagsColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
@@ -205,9 +353,9 @@ var (
 				Columns: []*schema.Column{PostTagsColumns[2]},
 			},
 			{
-				Name:    "posttag_post_id",
-				Unique:  false,
-				Columns: []*schema.Column{PostTagsColumns[1]},
+				Name:    "posttag_post_id_tag_id",
+				Unique:  true,
+				Columns: []*schema.Column{PostTagsColumns[1], PostTagsColumns[2]},
 			},
 		},
 	}
@@ -217,12 +365,14 @@ var (
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "title", Type: field.TypeString, Size: 255},
-		{Name: "embedding", Type: field.TypeBytes, Nullable: true},
-		{Name: "comment_question", Type: field.TypeInt, Nullable: true},
-		{Name: "question_tag_question", Type: field.TypeInt, Nullable: true},
-		{Name: "user_questions", Type: field.TypeInt, Nullable: true},
-		{Name: "user_favorite_question_question", Type: field.TypeInt, Nullable: true},
-		{Name: "user_like_question_question", Type: field.TypeInt, Nullable: true},
+		{Name: "body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "embedding", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector(1536)"}},
+		{Name: "like_count", Type: field.TypeInt, Default: 0},
+		{Name: "favorite_count", Type: field.TypeInt, Default: 0},
+		{Name: "comment_count", Type: field.TypeInt, Default: 0},
+		{Name: "answer_count", Type: field.TypeInt, Default: 0},
+		{Name: "accepted_answer_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
 	}
 	// QuestionsTable holds the schema information for the "questions" table.
 	QuestionsTable = &schema.Table{
@@ -231,41 +381,63 @@ var (
 		PrimaryKey: []*schema.Column{QuestionsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "questions_comments_question",
-				Columns:    []*schema.Column{QuestionsColumns[5]},
-				RefColumns: []*schema.Column{CommentsColumns[0]},
-				OnDelete:   schema.SetNull,
-			},
-			{
-				Symbol:     "questions_question_tags_question",
-				Columns:    []*schema.Column{QuestionsColumns[6]},
-				RefColumns: []*schema.Column{QuestionTagsColumns[0]},
+				Symbol:     "questions_answers_accepted_answer",
+				Columns:    []*schema.Column{QuestionsColumns[10]},
+				RefColumns: []*schema.Column{AnswersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "questions_users_questions",
-				Columns:    []*schema.Column{QuestionsColumns[7]},
+				Columns:    []*schema.Column{QuestionsColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+		},
+		Indexes: []*schema.Index{
 			{
-				Symbol:     "questions_user_favorite_questions_question",
-				Columns:    []*schema.Column{QuestionsColumns[8]},
-				RefColumns: []*schema.Column{UserFavoriteQuestionsColumns[0]},
-				OnDelete:   schema.SetNull,
+				Name:    "question_title",
+				Unique:  false,
+				Columns: []*schema.Column{QuestionsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "varchar_pattern_ops",
+				},
 			},
 			{
-				Symbol:     "questions_user_like_questions_question",
-				Columns:    []*schema.Column{QuestionsColumns[9]},
-				RefColumns: []*schema.Column{UserLikeQuestionsColumns[0]},
-				OnDelete:   schema.SetNull,
+				Name:    "question_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuestionsColumns[11]},
 			},
-		},
-		Indexes: []*schema.Index{
 			{
-				Name:    "question_title",
+				Name:    "question_create_time_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuestionsColumns[1], QuestionsColumns[0]},
+			},
+			{
+				Name:    "question_embedding",
+				Unique:  false,
+				Columns: []*schema.Column{QuestionsColumns[5]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "vector_cosine_ops",
+					Type:    "hnsw",
+				},
+			},
+			{
+				Name:    "question_title_trgm",
 				Unique:  false,
 				Columns: []*schema.Column{QuestionsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+			{
+				Name:    "question_body_trgm",
+				Unique:  false,
+				Columns: []*schema.Column{QuestionsColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
 			},
 		},
 	}
@@ -274,8 +446,6 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "question_id", Type: field.TypeInt},
 		{Name: "tag_id", Type: field.TypeInt},
-		{Name: "question_tags", Type: field.TypeInt, Nullable: true},
-		{Name: "tag_questions", Type: field.TypeInt, Nullable: true},
 	}
 	// QuestionTagsTable holds the schema information for the "question_tags" table.
 	QuestionTagsTable = &schema.Table{
@@ -285,15 +455,15 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "question_tags_questions_tags",
-				Columns:    []*schema.Column{QuestionTagsColumns[3]},
+				Columns:    []*schema.Column{QuestionTagsColumns[1]},
 				RefColumns: []*schema.Column{QuestionsColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "question_tags_tags_questions",
-				Columns:    []*schema.Column{QuestionTagsColumns[4]},
+				Columns:    []*schema.Column{QuestionTagsColumns[2]},
 				RefColumns: []*schema.Column{TagsColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
@@ -303,9 +473,86 @@ var (
 				Columns: []*schema.Column{QuestionTagsColumns[2]},
 			},
 			{
-				Name:    "questiontag_question_id",
+				Name:    "questiontag_question_id_tag_id",
+				Unique:  true,
+				Columns: []*schema.Column{QuestionTagsColumns[1], QuestionTagsColumns[2]},
+			},
+		},
+	}
+	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
+	RefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "jti", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "session_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
+	RefreshTokensTable = &schema.Table{
+		Name:       "refresh_tokens",
+		Columns:    RefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RefreshTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "refresh_tokens_sessions_refresh_tokens",
+				Columns:    []*schema.Column{RefreshTokensColumns[7]},
+				RefColumns: []*schema.Column{SessionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "refresh_tokens_users_refresh_tokens",
+				Columns:    []*schema.Column{RefreshTokensColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "refreshtoken_session_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[7]},
+			},
+			{
+				Name:    "refreshtoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[8]},
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "device", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "last_refresh_time", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{QuestionTagsColumns[1]},
+				Columns: []*schema.Column{SessionsColumns[8]},
 			},
 		},
 	}
@@ -313,33 +560,59 @@ var (
 	TagsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Unique: true, Size: 255},
-		{Name: "post_tag_tag", Type: field.TypeInt, Nullable: true},
-		{Name: "question_tag_tag", Type: field.TypeInt, Nullable: true},
+		{Name: "post_count", Type: field.TypeInt, Default: 0},
+		{Name: "question_count", Type: field.TypeInt, Default: 0},
 	}
 	// TagsTable holds the schema information for the "tags" table.
 	TagsTable = &schema.Table{
 		Name:       "tags",
 		Columns:    TagsColumns,
 		PrimaryKey: []*schema.Column{TagsColumns[0]},
-		ForeignKeys: []*schema.ForeignKey{
+		Indexes: []*schema.Index{
 			{
-				Symbol:     "tags_post_tags_tag",
-				Columns:    []*schema.Column{TagsColumns[2]},
-				RefColumns: []*schema.Column{PostTagsColumns[0]},
-				OnDelete:   schema.SetNull,
+				Name:    "tag_name",
+				Unique:  false,
+				Columns: []*schema.Column{TagsColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "varchar_pattern_ops",
+				},
 			},
+		},
+	}
+	// TagAliasColumns holds the columns for the "tag_alias" table.
+	TagAliasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 255},
+		{Name: "tag_id", Type: field.TypeInt},
+	}
+	// TagAliasTable holds the schema information for the "tag_alias" table.
+	TagAliasTable = &schema.Table{
+		Name:       "tag_alias",
+		Columns:    TagAliasColumns,
+		PrimaryKey: []*schema.Column{TagAliasColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "tags_question_tags_tag",
-				Columns:    []*schema.Column{TagsColumns[3]},
-				RefColumns: []*schema.Column{QuestionTagsColumns[0]},
-				OnDelete:   schema.SetNull,
+				Symbol:     "tag_alias_tags_aliases",
+				Columns:    []*schema.Column{TagAliasColumns[4]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "tag_name",
+				Name:    "tagalias_tag_id",
 				Unique:  false,
-				Columns: []*schema.Column{TagsColumns[1]},
+				Columns: []*schema.Column{TagAliasColumns[4]},
+			},
+			{
+				Name:    "tagalias_name",
+				Unique:  false,
+				Columns: []*schema.Column{TagAliasColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "varchar_pattern_ops",
+				},
 			},
 		},
 	}
@@ -352,77 +625,17 @@ var (
 		{Name: "nickname", Type: field.TypeString, Nullable: true, Size: 50},
 		{Name: "password", Type: field.TypeString, Size: 255},
 		{Name: "avatar", Type: field.TypeString, Nullable: true, Size: 255},
-		{Name: "comment_user", Type: field.TypeInt, Nullable: true},
-		{Name: "follow_follower", Type: field.TypeInt, Nullable: true},
-		{Name: "follow_following", Type: field.TypeInt, Nullable: true},
-		{Name: "post_user", Type: field.TypeInt, Nullable: true},
-		{Name: "question_user", Type: field.TypeInt, Nullable: true},
-		{Name: "user_favorite_post_user", Type: field.TypeInt, Nullable: true},
-		{Name: "user_favorite_question_user", Type: field.TypeInt, Nullable: true},
-		{Name: "user_like_post_user", Type: field.TypeInt, Nullable: true},
-		{Name: "user_like_question_user", Type: field.TypeInt, Nullable: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "moderator", "admin"}, Default: "user"},
+		{Name: "deletion_requested_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "follower_count", Type: field.TypeInt, Default: 0},
+		{Name: "following_count", Type: field.TypeInt, Default: 0},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
 		Name:       "users",
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
-		ForeignKeys: []*schema.ForeignKey{
-			{
-				Symbol:     "users_comments_user",
-				Columns:    []*schema.Column{UsersColumns[7]},
-				RefColumns: []*schema.Column{CommentsColumns[0]},
-				OnDelete:   schema.SetNull,
-			},
-			{
-				Symbol:     "users_follows_follower",
-				Columns:    []*schema.Column{UsersColumns[8]},
-				RefColumns: []*schema.Column{FollowsColumns[0]},
-				OnDelete:   schema.SetNull,
-			},
-			{
-				Symbol:     "users_follows_following",
-				Columns:    []*schema.Column{UsersColumns[9]},
-				RefColumns: []*schema.Column{FollowsColumns[0]},
-				OnDelete:   schema.SetNull,
-			},
-			{
-				Symbol:     "users_posts_user",
-				Columns:    []*schema.Column{UsersColumns[10]},
-				RefColumns: []*schema.Column{PostsColumns[0]},
-				OnDelete:   schema.SetNull,
-			},
-			{
-				Symbol:     "users_questions_user",
-				Columns:    []*schema.Column{UsersColumns[11]},
-				RefColumns: []*schema.Column{QuestionsColumns[0]},
-				OnDelete:   schema.SetNull,
-			},
-			{
-				Symbol:     "users_user_favorite_posts_user",
-				Columns:    []*schema.Column{UsersColumns[12]},
-				RefColumns: []*schema.Column{UserFavoritePostsColumns[0]},
-				OnDelete:   schema.SetNull,
-			},
-			{
-				Symbol:     "users_user_favorite_questions_user",
-				Columns:    []*schema.Column{UsersColumns[13]},
-				RefColumns: []*schema.Column{UserFavoriteQuestionsColumns[0]},
-				OnDelete:   schema.SetNull,
-			},
-			{
-				Symbol:     "users_user_like_posts_user",
-				Columns:    []*schema.Column{UsersColumns[14]},
-				RefColumns: []*schema.Column{UserLikePostsColumns[0]},
-				OnDelete:   schema.SetNull,
-			},
-			{
-				Symbol:     "users_user_like_questions_user",
-				Columns:    []*schema.Column{UsersColumns[15]},
-				RefColumns: []*schema.Column{UserLikeQuestionsColumns[0]},
-				OnDelete:   schema.SetNull,
-			},
-		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "user_phone",
@@ -434,10 +647,8 @@ var (
 	// UserFavoritePostsColumns holds the columns for the "user_favorite_posts" table.
 	UserFavoritePostsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "user_id", Type: field.TypeInt},
 		{Name: "post_id", Type: field.TypeInt},
-		{Name: "post_favorites", Type: field.TypeInt, Nullable: true},
-		{Name: "user_favorite_posts", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
 	}
 	// UserFavoritePostsTable holds the schema information for the "user_favorite_posts" table.
 	UserFavoritePostsTable = &schema.Table{
@@ -447,37 +658,35 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_favorite_posts_posts_favorites",
-				Columns:    []*schema.Column{UserFavoritePostsColumns[3]},
+				Columns:    []*schema.Column{UserFavoritePostsColumns[1]},
 				RefColumns: []*schema.Column{PostsColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "user_favorite_posts_users_favorite_posts",
-				Columns:    []*schema.Column{UserFavoritePostsColumns[4]},
+				Columns:    []*schema.Column{UserFavoritePostsColumns[2]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "userfavoritepost_post_id",
 				Unique:  false,
-				Columns: []*schema.Column{UserFavoritePostsColumns[2]},
+				Columns: []*schema.Column{UserFavoritePostsColumns[1]},
 			},
 			{
-				Name:    "userfavoritepost_user_id",
-				Unique:  false,
-				Columns: []*schema.Column{UserFavoritePostsColumns[1]},
+				Name:    "userfavoritepost_user_id_post_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserFavoritePostsColumns[2], UserFavoritePostsColumns[1]},
 			},
 		},
 	}
 	// UserFavoriteQuestionsColumns holds the columns for the "user_favorite_questions" table.
 	UserFavoriteQuestionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "user_id", Type: field.TypeInt},
 		{Name: "question_id", Type: field.TypeInt},
-		{Name: "question_favorites", Type: field.TypeInt, Nullable: true},
-		{Name: "user_favorite_questions", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
 	}
 	// UserFavoriteQuestionsTable holds the schema information for the "user_favorite_questions" table.
 	UserFavoriteQuestionsTable = &schema.Table{
@@ -487,37 +696,35 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_favorite_questions_questions_favorites",
-				Columns:    []*schema.Column{UserFavoriteQuestionsColumns[3]},
+				Columns:    []*schema.Column{UserFavoriteQuestionsColumns[1]},
 				RefColumns: []*schema.Column{QuestionsColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "user_favorite_questions_users_favorite_questions",
-				Columns:    []*schema.Column{UserFavoriteQuestionsColumns[4]},
+				Columns:    []*schema.Column{UserFavoriteQuestionsColumns[2]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "userfavoritequestion_question_id",
 				Unique:  false,
-				Columns: []*schema.Column{UserFavoriteQuestionsColumns[2]},
+				Columns: []*schema.Column{UserFavoriteQuestionsColumns[1]},
 			},
 			{
-				Name:    "userfavoritequestion_user_id",
-				Unique:  false,
-				Columns: []*schema.Column{UserFavoriteQuestionsColumns[1]},
+				Name:    "userfavoritequestion_user_id_question_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserFavoriteQuestionsColumns[2], UserFavoriteQuestionsColumns[1]},
 			},
 		},
 	}
 	// UserLikePostsColumns holds the columns for the "user_like_posts" table.
 	UserLikePostsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "user_id", Type: field.TypeInt},
 		{Name: "post_id", Type: field.TypeInt},
-		{Name: "post_likes", Type: field.TypeInt, Nullable: true},
-		{Name: "user_like_posts", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
 	}
 	// UserLikePostsTable holds the schema information for the "user_like_posts" table.
 	UserLikePostsTable = &schema.Table{
@@ -527,37 +734,35 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_like_posts_posts_likes",
-				Columns:    []*schema.Column{UserLikePostsColumns[3]},
+				Columns:    []*schema.Column{UserLikePostsColumns[1]},
 				RefColumns: []*schema.Column{PostsColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "user_like_posts_users_like_posts",
-				Columns:    []*schema.Column{UserLikePostsColumns[4]},
+				Columns:    []*schema.Column{UserLikePostsColumns[2]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "userlikepost_post_id",
 				Unique:  false,
-				Columns: []*schema.Column{UserLikePostsColumns[2]},
+				Columns: []*schema.Column{UserLikePostsColumns[1]},
 			},
 			{
-				Name:    "userlikepost_user_id",
-				Unique:  false,
-				Columns: []*schema.Column{UserLikePostsColumns[1]},
+				Name:    "userlikepost_user_id_post_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserLikePostsColumns[2], UserLikePostsColumns[1]},
 			},
 		},
 	}
 	// UserLikeQuestionsColumns holds the columns for the "user_like_questions" table.
 	UserLikeQuestionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "user_id", Type: field.TypeInt},
 		{Name: "question_id", Type: field.TypeInt},
-		{Name: "question_likes", Type: field.TypeInt, Nullable: true},
-		{Name: "user_like_questions", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
 	}
 	// UserLikeQuestionsTable holds the schema information for the "user_like_questions" table.
 	UserLikeQuestionsTable = &schema.Table{
@@ -567,130 +772,113 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_like_questions_questions_likes",
-				Columns:    []*schema.Column{UserLikeQuestionsColumns[3]},
+				Columns:    []*schema.Column{UserLikeQuestionsColumns[1]},
 				RefColumns: []*schema.Column{QuestionsColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "user_like_questions_users_like_questions",
-				Columns:    []*schema.Column{UserLikeQuestionsColumns[4]},
+				Columns:    []*schema.Column{UserLikeQuestionsColumns[2]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "userlikequestion_question_id",
 				Unique:  false,
-				Columns: []*schema.Column{UserLikeQuestionsColumns[2]},
-			},
-			{
-				Name:    "userlikequestion_user_id",
-				Unique:  false,
 				Columns: []*schema.Column{UserLikeQuestionsColumns[1]},
 			},
-		},
-	}
-	// CommentParentColumns holds the columns for the "comment_parent" table.
-	CommentParentColumns = []*schema.Column{
-		{Name: "comment_id", Type: field.TypeInt},
-		{Name: "parent_id", Type: field.TypeInt},
-	}
-	// CommentParentTable holds the schema information for the "comment_parent" table.
-	CommentParentTable = &schema.Table{
-		Name:       "comment_parent",
-		Columns:    CommentParentColumns,
-		PrimaryKey: []*schema.Column{CommentParentColumns[0], CommentParentColumns[1]},
-		ForeignKeys: []*schema.ForeignKey{
-			{
-				Symbol:     "comment_parent_comment_id",
-				Columns:    []*schema.Column{CommentParentColumns[0]},
-				RefColumns: []*schema.Column{CommentsColumns[0]},
-				OnDelete:   schema.Cascade,
-			},
 			{
-				Symbol:     "comment_parent_parent_id",
-				Columns:    []*schema.Column{CommentParentColumns[1]},
-				RefColumns: []*schema.Column{CommentsColumns[0]},
-				OnDelete:   schema.Cascade,
+				Name:    "userlikequestion_user_id_question_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserLikeQuestionsColumns[2], UserLikeQuestionsColumns[1]},
 			},
 		},
 	}
-	// CommentRepliesColumns holds the columns for the "comment_replies" table.
-	CommentRepliesColumns = []*schema.Column{
-		{Name: "comment_id", Type: field.TypeInt},
-		{Name: "reply_id", Type: field.TypeInt},
+	// VerificationCodesColumns holds the columns for the "verification_codes" table.
+	VerificationCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "phone", Type: field.TypeString, Size: 11},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "purpose", Type: field.TypeEnum, Enums: []string{"login", "reset_password"}},
+		{Name: "code_hash", Type: field.TypeString, Size: 64},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "consumed_at", Type: field.TypeTime, Nullable: true},
 	}
-	// CommentRepliesTable holds the schema information for the "comment_replies" table.
-	CommentRepliesTable = &schema.Table{
-		Name:       "comment_replies",
-		Columns:    CommentRepliesColumns,
-		PrimaryKey: []*schema.Column{CommentRepliesColumns[0], CommentRepliesColumns[1]},
-		ForeignKeys: []*schema.ForeignKey{
+	// VerificationCodesTable holds the schema information for the "verification_codes" table.
+	VerificationCodesTable = &schema.Table{
+		Name:       "verification_codes",
+		Columns:    VerificationCodesColumns,
+		PrimaryKey: []*schema.Column{VerificationCodesColumns[0]},
+		Indexes: []*schema.Index{
 			{
-				Symbol:     "comment_replies_comment_id",
-				Columns:    []*schema.Column{CommentRepliesColumns[0]},
-				RefColumns: []*schema.Column{CommentsColumns[0]},
-				OnDelete:   schema.Cascade,
+				Name:    "verificationcode_phone_purpose_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{VerificationCodesColumns[3], VerificationCodesColumns[5], VerificationCodesColumns[1]},
 			},
 			{
-				Symbol:     "comment_replies_reply_id",
-				Columns:    []*schema.Column{CommentRepliesColumns[1]},
-				RefColumns: []*schema.Column{CommentsColumns[0]},
-				OnDelete:   schema.Cascade,
+				Name:    "verificationcode_ip_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{VerificationCodesColumns[4], VerificationCodesColumns[1]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AnswersTable,
+		AnswerVotesTable,
 		CommentsTable,
 		FollowsTable,
+		LoginAttemptsTable,
 		PostsTable,
 		PostTagsTable,
 		QuestionsTable,
 		QuestionTagsTable,
+		RefreshTokensTable,
+		SessionsTable,
 		TagsTable,
+		TagAliasTable,
 		UsersTable,
 		UserFavoritePostsTable,
 		UserFavoriteQuestionsTable,
 		UserLikePostsTable,
 		UserLikeQuestionsTable,
-		CommentParentTable,
-		CommentRepliesTable,
+		VerificationCodesTable,
 	}
 )
 
 func init() {
-	CommentsTable.ForeignKeys[0].RefTable = PostsTable
-	CommentsTable.ForeignKeys[1].RefTable = QuestionsTable
-	CommentsTable.ForeignKeys[2].RefTable = UsersTable
+	AnswersTable.ForeignKeys[0].RefTable = QuestionsTable
+	AnswersTable.ForeignKeys[1].RefTable = UsersTable
+	AnswerVotesTable.ForeignKeys[0].RefTable = AnswersTable
+	AnswerVotesTable.ForeignKeys[1].RefTable = UsersTable
+	CommentsTable.ForeignKeys[0].RefTable = AnswersTable
+	CommentsTable.ForeignKeys[1].RefTable = UsersTable
+	CommentsTable.ForeignKeys[2].RefTable = CommentsTable
+	CommentsTable.ForeignKeys[3].RefTable = PostsTable
+	CommentsTable.ForeignKeys[4].RefTable = QuestionsTable
+	CommentsTable.ForeignKeys[5].RefTable = UsersTable
 	FollowsTable.ForeignKeys[0].RefTable = UsersTable
 	FollowsTable.ForeignKeys[1].RefTable = UsersTable
-	PostsTable.ForeignKeys[0].RefTable = CommentsTable
-	PostsTable.ForeignKeys[1].RefTable = PostTagsTable
-	PostsTable.ForeignKeys[2].RefTable = UsersTable
-	PostsTable.ForeignKeys[3].RefTable = UserFavoritePostsTable
-	PostsTable.ForeignKeys[4].RefTable = UserLikePostsTable
+	FollowsTable.Annotation = &entsql.Annotation{}
+	FollowsTable.Annotation.Checks = map[string]string{
+		"follow_not_self": "follower_id <> following_id",
+	}
+	PostsTable.ForeignKeys[0].RefTable = UsersTable
 	PostTagsTable.ForeignKeys[0].RefTable = PostsTable
 	PostTagsTable.ForeignKeys[1].RefTable = TagsTable
-	QuestionsTable.ForeignKeys[0].RefTable = CommentsTable
-	QuestionsTable.ForeignKeys[1].RefTable = QuestionTagsTable
-	QuestionsTable.ForeignKeys[2].RefTable = UsersTable
-	QuestionsTable.ForeignKeys[3].RefTable = UserFavoriteQuestionsTable
-	QuestionsTable.ForeignKeys[4].RefTable = UserLikeQuestionsTable
+	QuestionsTable.ForeignKeys[0].RefTable = AnswersTable
+	QuestionsTable.ForeignKeys[1].RefTable = UsersTable
 	QuestionTagsTable.ForeignKeys[0].RefTable = QuestionsTable
 	QuestionTagsTable.ForeignKeys[1].RefTable = TagsTable
-	TagsTable.ForeignKeys[0].RefTable = PostTagsTable
-	TagsTable.ForeignKeys[1].RefTable = QuestionTagsTable
-	UsersTable.ForeignKeys[0].RefTable = CommentsTable
-	UsersTable.ForeignKeys[1].RefTable = FollowsTable
-	UsersTable.ForeignKeys[2].RefTable = FollowsTable
-	UsersTable.ForeignKeys[3].RefTable = PostsTable
-	UsersTable.ForeignKeys[4].RefTable = QuestionsTable
-	UsersTable.ForeignKeys[5].RefTable = UserFavoritePostsTable
-	UsersTable.ForeignKeys[6].RefTable = UserFavoriteQuestionsTable
-	UsersTable.ForeignKeys[7].RefTable = UserLikePostsTable
-	UsersTable.ForeignKeys[8].RefTable = UserLikeQuestionsTable
+	RefreshTokensTable.ForeignKeys[0].RefTable = SessionsTable
+	RefreshTokensTable.ForeignKeys[1].RefTable = UsersTable
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	TagAliasTable.ForeignKeys[0].RefTable = TagsTable
 	UserFavoritePostsTable.ForeignKeys[0].RefTable = PostsTable
 	UserFavoritePostsTable.ForeignKeys[1].RefTable = UsersTable
 	UserFavoriteQuestionsTable.ForeignKeys[0].RefTable = QuestionsTable
@@ -699,8 +887,4 @@ func init() {
 	UserLikePostsTable.ForeignKeys[1].RefTable = UsersTable
 	UserLikeQuestionsTable.ForeignKeys[0].RefTable = QuestionsTable
 	UserLikeQuestionsTable.ForeignKeys[1].RefTable = UsersTable
-	CommentParentTable.ForeignKeys[0].RefTable = CommentsTable
-	CommentParentTable.ForeignKeys[1].RefTable = CommentsTable
-	CommentRepliesTable.ForeignKeys[0].RefTable = CommentsTable
-	CommentRepliesTable.ForeignKeys[1].RefTable = CommentsTable
 }
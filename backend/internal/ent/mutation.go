@@ -3,19 +3,27 @@
 package ent
 
 import (
+	"backend/internal/ent/answer"
+	"backend/internal/ent/answervote"
 	"backend/internal/ent/comment"
 	"backend/internal/ent/follow"
+	"backend/internal/ent/loginattempt"
 	"backend/internal/ent/post"
 	"backend/internal/ent/posttag"
 	"backend/internal/ent/predicate"
 	"backend/internal/ent/question"
 	"backend/internal/ent/questiontag"
+	"backend/internal/ent/refreshtoken"
+	"backend/internal/ent/session"
 	"backend/internal/ent/tag"
+	"backend/internal/ent/tagalias"
 	"backend/internal/ent/user"
 	"backend/internal/ent/userfavoritepost"
 	"backend/internal/ent/userfavoritequestion"
 	"backend/internal/ent/userlikepost"
 	"backend/internal/ent/userlikequestion"
+	"backend/internal/ent/verificationcode"
+	"backend/internal/pgvector"
 	"context"
 	"errors"
 	"fmt"
@@ -35,22 +43,29 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeAnswer               = "Answer"
+	TypeAnswerVote           = "AnswerVote"
 	TypeComment              = "Comment"
 	TypeFollow               = "Follow"
+	TypeLoginAttempt         = "LoginAttempt"
 	TypePost                 = "Post"
 	TypePostTag              = "PostTag"
 	TypeQuestion             = "Question"
 	TypeQuestionTag          = "QuestionTag"
+	TypeRefreshToken         = "RefreshToken"
+	TypeSession              = "Session"
 	TypeTag                  = "Tag"
+	TypeTagAlias             = "TagAlias"
 	TypeUser                 = "User"
 	TypeUserFavoritePost     = "UserFavoritePost"
 	TypeUserFavoriteQuestion = "UserFavoriteQuestion"
 	TypeUserLikePost         = "UserLikePost"
 	TypeUserLikeQuestion     = "UserLikeQuestion"
+	TypeVerificationCode     = "VerificationCode"
 )
 
-// CommentMutation represents an operation that mutates the Comment nodes in the graph.
-type CommentMutation struct {
+// AnswerMutation represents an operation that mutates the Answer nodes in the graph.
+type AnswerMutation struct {
 	config
 	op              Op
 	typ             string
@@ -58,46 +73,35 @@ type CommentMutation struct {
 	create_time     *time.Time
 	update_time     *time.Time
 	content         *string
-	user_id         *int
-	adduser_id      *int
-	post_id         *int
-	addpost_id      *int
-	question_id     *int
-	addquestion_id  *int
-	parent_id       *int
-	addparent_id    *int
+	vote_score      *int
+	addvote_score   *int
 	clearedFields   map[string]struct{}
-	user            map[int]struct{}
-	removeduser     map[int]struct{}
-	cleareduser     bool
-	post            map[int]struct{}
-	removedpost     map[int]struct{}
-	clearedpost     bool
-	question        map[int]struct{}
-	removedquestion map[int]struct{}
+	question        *int
 	clearedquestion bool
-	parent          map[int]struct{}
-	removedparent   map[int]struct{}
-	clearedparent   bool
-	replies         map[int]struct{}
-	removedreplies  map[int]struct{}
-	clearedreplies  bool
+	user            *int
+	cleareduser     bool
+	votes           map[int]struct{}
+	removedvotes    map[int]struct{}
+	clearedvotes    bool
+	comments        map[int]struct{}
+	removedcomments map[int]struct{}
+	clearedcomments bool
 	done            bool
-	oldValue        func(context.Context) (*Comment, error)
-	predicates      []predicate.Comment
+	oldValue        func(context.Context) (*Answer, error)
+	predicates      []predicate.Answer
 }
 
-var _ ent.Mutation = (*CommentMutation)(nil)
+var _ ent.Mutation = (*AnswerMutation)(nil)
 
-// commentOption allows management of the mutation configuration using functional options.
-type commentOption func(*CommentMutation)
+// answerOption allows management of the mutation configuration using functional options.
+type answerOption func(*AnswerMutation)
 
-// newCommentMutation creates new mutation for the Comment entity.
-func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation {
-	m := &CommentMutation{
+// newAnswerMutation creates new mutation for the Answer entity.
+func newAnswerMutation(c config, op Op, opts ...answerOption) *AnswerMutation {
+	m := &AnswerMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeComment,
+		typ:           TypeAnswer,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -106,20 +110,20 @@ func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation
 	return m
 }
 
-// withCommentID sets the ID field of the mutation.
-func withCommentID(id int) commentOption {
-	return func(m *CommentMutation) {
+// withAnswerID sets the ID field of the mutation.
+func withAnswerID(id int) answerOption {
+	return func(m *AnswerMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Comment
+			value *Answer
 		)
-		m.oldValue = func(ctx context.Context) (*Comment, error) {
+		m.oldValue = func(ctx context.Context) (*Answer, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Comment.Get(ctx, id)
+					value, err = m.Client().Answer.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -128,10 +132,10 @@ func withCommentID(id int) commentOption {
 	}
 }
 
-// withComment sets the old Comment of the mutation.
-func withComment(node *Comment) commentOption {
-	return func(m *CommentMutation) {
-		m.oldValue = func(context.Context) (*Comment, error) {
+// withAnswer sets the old Answer of the mutation.
+func withAnswer(node *Answer) answerOption {
+	return func(m *AnswerMutation) {
+		m.oldValue = func(context.Context) (*Answer, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -140,7 +144,7 @@ func withComment(node *Comment) commentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CommentMutation) Client() *Client {
+func (m AnswerMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -148,7 +152,7 @@ func (m CommentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CommentMutation) Tx() (*Tx, error) {
+func (m AnswerMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -159,7 +163,7 @@ func (m CommentMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CommentMutation) ID() (id int, exists bool) {
+func (m *AnswerMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -170,7 +174,7 @@ func (m *CommentMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CommentMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AnswerMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -179,19 +183,19 @@ func (m *CommentMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Comment.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Answer.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *CommentMutation) SetCreateTime(t time.Time) {
+func (m *AnswerMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *CommentMutation) CreateTime() (r time.Time, exists bool) {
+func (m *AnswerMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -199,10 +203,10 @@ func (m *CommentMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Answer entity.
+// If the Answer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AnswerMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -217,17 +221,17 @@ func (m *CommentMutation) OldCreateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *CommentMutation) ResetCreateTime() {
+func (m *AnswerMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *CommentMutation) SetUpdateTime(t time.Time) {
+func (m *AnswerMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *CommentMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *AnswerMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -235,10 +239,10 @@ func (m *CommentMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Answer entity.
+// If the Answer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AnswerMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -253,17 +257,17 @@ func (m *CommentMutation) OldUpdateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *CommentMutation) ResetUpdateTime() {
+func (m *AnswerMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
 // SetContent sets the "content" field.
-func (m *CommentMutation) SetContent(s string) {
+func (m *AnswerMutation) SetContent(s string) {
 	m.content = &s
 }
 
 // Content returns the value of the "content" field in the mutation.
-func (m *CommentMutation) Content() (r string, exists bool) {
+func (m *AnswerMutation) Content() (r string, exists bool) {
 	v := m.content
 	if v == nil {
 		return
@@ -271,10 +275,10 @@ func (m *CommentMutation) Content() (r string, exists bool) {
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the Answer entity.
+// If the Answer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *AnswerMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
@@ -288,569 +292,310 @@ func (m *CommentMutation) OldContent(ctx context.Context) (v string, err error)
 	return oldValue.Content, nil
 }
 
-// ClearContent clears the value of the "content" field.
-func (m *CommentMutation) ClearContent() {
-	m.content = nil
-	m.clearedFields[comment.FieldContent] = struct{}{}
-}
-
-// ContentCleared returns if the "content" field was cleared in this mutation.
-func (m *CommentMutation) ContentCleared() bool {
-	_, ok := m.clearedFields[comment.FieldContent]
-	return ok
-}
-
 // ResetContent resets all changes to the "content" field.
-func (m *CommentMutation) ResetContent() {
+func (m *AnswerMutation) ResetContent() {
 	m.content = nil
-	delete(m.clearedFields, comment.FieldContent)
 }
 
-// SetUserID sets the "user_id" field.
-func (m *CommentMutation) SetUserID(i int) {
-	m.user_id = &i
-	m.adduser_id = nil
+// SetQuestionID sets the "question_id" field.
+func (m *AnswerMutation) SetQuestionID(i int) {
+	m.question = &i
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *CommentMutation) UserID() (r int, exists bool) {
-	v := m.user_id
+// QuestionID returns the value of the "question_id" field in the mutation.
+func (m *AnswerMutation) QuestionID() (r int, exists bool) {
+	v := m.question
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldQuestionID returns the old "question_id" field's value of the Answer entity.
+// If the Answer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *AnswerMutation) OldQuestionID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldQuestionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldQuestionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
-	}
-	return oldValue.UserID, nil
-}
-
-// AddUserID adds i to the "user_id" field.
-func (m *CommentMutation) AddUserID(i int) {
-	if m.adduser_id != nil {
-		*m.adduser_id += i
-	} else {
-		m.adduser_id = &i
-	}
-}
-
-// AddedUserID returns the value that was added to the "user_id" field in this mutation.
-func (m *CommentMutation) AddedUserID() (r int, exists bool) {
-	v := m.adduser_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldQuestionID: %w", err)
 	}
-	return *v, true
+	return oldValue.QuestionID, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *CommentMutation) ResetUserID() {
-	m.user_id = nil
-	m.adduser_id = nil
+// ResetQuestionID resets all changes to the "question_id" field.
+func (m *AnswerMutation) ResetQuestionID() {
+	m.question = nil
 }
 
-// SetPostID sets the "post_id" field.
-func (m *CommentMutation) SetPostID(i int) {
-	m.post_id = &i
-	m.addpost_id = nil
+// SetUserID sets the "user_id" field.
+func (m *AnswerMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// PostID returns the value of the "post_id" field in the mutation.
-func (m *CommentMutation) PostID() (r int, exists bool) {
-	v := m.post_id
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AnswerMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPostID returns the old "post_id" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Answer entity.
+// If the Answer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldPostID(ctx context.Context) (v int, err error) {
+func (m *AnswerMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPostID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPostID requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPostID: %w", err)
-	}
-	return oldValue.PostID, nil
-}
-
-// AddPostID adds i to the "post_id" field.
-func (m *CommentMutation) AddPostID(i int) {
-	if m.addpost_id != nil {
-		*m.addpost_id += i
-	} else {
-		m.addpost_id = &i
-	}
-}
-
-// AddedPostID returns the value that was added to the "post_id" field in this mutation.
-func (m *CommentMutation) AddedPostID() (r int, exists bool) {
-	v := m.addpost_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return *v, true
-}
-
-// ClearPostID clears the value of the "post_id" field.
-func (m *CommentMutation) ClearPostID() {
-	m.post_id = nil
-	m.addpost_id = nil
-	m.clearedFields[comment.FieldPostID] = struct{}{}
-}
-
-// PostIDCleared returns if the "post_id" field was cleared in this mutation.
-func (m *CommentMutation) PostIDCleared() bool {
-	_, ok := m.clearedFields[comment.FieldPostID]
-	return ok
+	return oldValue.UserID, nil
 }
 
-// ResetPostID resets all changes to the "post_id" field.
-func (m *CommentMutation) ResetPostID() {
-	m.post_id = nil
-	m.addpost_id = nil
-	delete(m.clearedFields, comment.FieldPostID)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AnswerMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetQuestionID sets the "question_id" field.
-func (m *CommentMutation) SetQuestionID(i int) {
-	m.question_id = &i
-	m.addquestion_id = nil
+// SetVoteScore sets the "vote_score" field.
+func (m *AnswerMutation) SetVoteScore(i int) {
+	m.vote_score = &i
+	m.addvote_score = nil
 }
 
-// QuestionID returns the value of the "question_id" field in the mutation.
-func (m *CommentMutation) QuestionID() (r int, exists bool) {
-	v := m.question_id
+// VoteScore returns the value of the "vote_score" field in the mutation.
+func (m *AnswerMutation) VoteScore() (r int, exists bool) {
+	v := m.vote_score
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuestionID returns the old "question_id" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldVoteScore returns the old "vote_score" field's value of the Answer entity.
+// If the Answer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldQuestionID(ctx context.Context) (v int, err error) {
+func (m *AnswerMutation) OldVoteScore(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuestionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldVoteScore is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuestionID requires an ID field in the mutation")
+		return v, errors.New("OldVoteScore requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuestionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldVoteScore: %w", err)
 	}
-	return oldValue.QuestionID, nil
+	return oldValue.VoteScore, nil
 }
 
-// AddQuestionID adds i to the "question_id" field.
-func (m *CommentMutation) AddQuestionID(i int) {
-	if m.addquestion_id != nil {
-		*m.addquestion_id += i
+// AddVoteScore adds i to the "vote_score" field.
+func (m *AnswerMutation) AddVoteScore(i int) {
+	if m.addvote_score != nil {
+		*m.addvote_score += i
 	} else {
-		m.addquestion_id = &i
+		m.addvote_score = &i
 	}
 }
 
-// AddedQuestionID returns the value that was added to the "question_id" field in this mutation.
-func (m *CommentMutation) AddedQuestionID() (r int, exists bool) {
-	v := m.addquestion_id
+// AddedVoteScore returns the value that was added to the "vote_score" field in this mutation.
+func (m *AnswerMutation) AddedVoteScore() (r int, exists bool) {
+	v := m.addvote_score
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearQuestionID clears the value of the "question_id" field.
-func (m *CommentMutation) ClearQuestionID() {
-	m.question_id = nil
-	m.addquestion_id = nil
-	m.clearedFields[comment.FieldQuestionID] = struct{}{}
-}
-
-// QuestionIDCleared returns if the "question_id" field was cleared in this mutation.
-func (m *CommentMutation) QuestionIDCleared() bool {
-	_, ok := m.clearedFields[comment.FieldQuestionID]
-	return ok
-}
-
-// ResetQuestionID resets all changes to the "question_id" field.
-func (m *CommentMutation) ResetQuestionID() {
-	m.question_id = nil
-	m.addquestion_id = nil
-	delete(m.clearedFields, comment.FieldQuestionID)
+// ResetVoteScore resets all changes to the "vote_score" field.
+func (m *AnswerMutation) ResetVoteScore() {
+	m.vote_score = nil
+	m.addvote_score = nil
 }
 
-// SetParentID sets the "parent_id" field.
-func (m *CommentMutation) SetParentID(i int) {
-	m.parent_id = &i
-	m.addparent_id = nil
+// ClearQuestion clears the "question" edge to the Question entity.
+func (m *AnswerMutation) ClearQuestion() {
+	m.clearedquestion = true
+	m.clearedFields[answer.FieldQuestionID] = struct{}{}
 }
 
-// ParentID returns the value of the "parent_id" field in the mutation.
-func (m *CommentMutation) ParentID() (r int, exists bool) {
-	v := m.parent_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// QuestionCleared reports if the "question" edge to the Question entity was cleared.
+func (m *AnswerMutation) QuestionCleared() bool {
+	return m.clearedquestion
 }
 
-// OldParentID returns the old "parent_id" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldParentID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+// QuestionIDs returns the "question" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuestionID instead. It exists only for internal usage by the builders.
+func (m *AnswerMutation) QuestionIDs() (ids []int) {
+	if id := m.question; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.ParentID, nil
+	return
 }
 
-// AddParentID adds i to the "parent_id" field.
-func (m *CommentMutation) AddParentID(i int) {
-	if m.addparent_id != nil {
-		*m.addparent_id += i
-	} else {
-		m.addparent_id = &i
-	}
+// ResetQuestion resets all changes to the "question" edge.
+func (m *AnswerMutation) ResetQuestion() {
+	m.question = nil
+	m.clearedquestion = false
 }
 
-// AddedParentID returns the value that was added to the "parent_id" field in this mutation.
-func (m *CommentMutation) AddedParentID() (r int, exists bool) {
-	v := m.addparent_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearUser clears the "user" edge to the User entity.
+func (m *AnswerMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[answer.FieldUserID] = struct{}{}
 }
 
-// ClearParentID clears the value of the "parent_id" field.
-func (m *CommentMutation) ClearParentID() {
-	m.parent_id = nil
-	m.addparent_id = nil
-	m.clearedFields[comment.FieldParentID] = struct{}{}
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *AnswerMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
-func (m *CommentMutation) ParentIDCleared() bool {
-	_, ok := m.clearedFields[comment.FieldParentID]
-	return ok
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *AnswerMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// ResetParentID resets all changes to the "parent_id" field.
-func (m *CommentMutation) ResetParentID() {
-	m.parent_id = nil
-	m.addparent_id = nil
-	delete(m.clearedFields, comment.FieldParentID)
+// ResetUser resets all changes to the "user" edge.
+func (m *AnswerMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// AddUserIDs adds the "user" edge to the User entity by ids.
-func (m *CommentMutation) AddUserIDs(ids ...int) {
-	if m.user == nil {
-		m.user = make(map[int]struct{})
+// AddVoteIDs adds the "votes" edge to the AnswerVote entity by ids.
+func (m *AnswerMutation) AddVoteIDs(ids ...int) {
+	if m.votes == nil {
+		m.votes = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.user[ids[i]] = struct{}{}
+		m.votes[ids[i]] = struct{}{}
 	}
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *CommentMutation) ClearUser() {
-	m.cleareduser = true
+// ClearVotes clears the "votes" edge to the AnswerVote entity.
+func (m *AnswerMutation) ClearVotes() {
+	m.clearedvotes = true
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *CommentMutation) UserCleared() bool {
-	return m.cleareduser
+// VotesCleared reports if the "votes" edge to the AnswerVote entity was cleared.
+func (m *AnswerMutation) VotesCleared() bool {
+	return m.clearedvotes
 }
 
-// RemoveUserIDs removes the "user" edge to the User entity by IDs.
-func (m *CommentMutation) RemoveUserIDs(ids ...int) {
-	if m.removeduser == nil {
-		m.removeduser = make(map[int]struct{})
+// RemoveVoteIDs removes the "votes" edge to the AnswerVote entity by IDs.
+func (m *AnswerMutation) RemoveVoteIDs(ids ...int) {
+	if m.removedvotes == nil {
+		m.removedvotes = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.user, ids[i])
-		m.removeduser[ids[i]] = struct{}{}
+		delete(m.votes, ids[i])
+		m.removedvotes[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedUser returns the removed IDs of the "user" edge to the User entity.
-func (m *CommentMutation) RemovedUserIDs() (ids []int) {
-	for id := range m.removeduser {
+// RemovedVotes returns the removed IDs of the "votes" edge to the AnswerVote entity.
+func (m *AnswerMutation) RemovedVotesIDs() (ids []int) {
+	for id := range m.removedvotes {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-func (m *CommentMutation) UserIDs() (ids []int) {
-	for id := range m.user {
+// VotesIDs returns the "votes" edge IDs in the mutation.
+func (m *AnswerMutation) VotesIDs() (ids []int) {
+	for id := range m.votes {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *CommentMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-	m.removeduser = nil
+// ResetVotes resets all changes to the "votes" edge.
+func (m *AnswerMutation) ResetVotes() {
+	m.votes = nil
+	m.clearedvotes = false
+	m.removedvotes = nil
 }
 
-// AddPostIDs adds the "post" edge to the Post entity by ids.
-func (m *CommentMutation) AddPostIDs(ids ...int) {
-	if m.post == nil {
-		m.post = make(map[int]struct{})
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *AnswerMutation) AddCommentIDs(ids ...int) {
+	if m.comments == nil {
+		m.comments = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.post[ids[i]] = struct{}{}
+		m.comments[ids[i]] = struct{}{}
 	}
 }
 
-// ClearPost clears the "post" edge to the Post entity.
-func (m *CommentMutation) ClearPost() {
-	m.clearedpost = true
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *AnswerMutation) ClearComments() {
+	m.clearedcomments = true
 }
 
-// PostCleared reports if the "post" edge to the Post entity was cleared.
-func (m *CommentMutation) PostCleared() bool {
-	return m.clearedpost
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *AnswerMutation) CommentsCleared() bool {
+	return m.clearedcomments
 }
 
-// RemovePostIDs removes the "post" edge to the Post entity by IDs.
-func (m *CommentMutation) RemovePostIDs(ids ...int) {
-	if m.removedpost == nil {
-		m.removedpost = make(map[int]struct{})
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *AnswerMutation) RemoveCommentIDs(ids ...int) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.post, ids[i])
-		m.removedpost[ids[i]] = struct{}{}
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedPost returns the removed IDs of the "post" edge to the Post entity.
-func (m *CommentMutation) RemovedPostIDs() (ids []int) {
-	for id := range m.removedpost {
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *AnswerMutation) RemovedCommentsIDs() (ids []int) {
+	for id := range m.removedcomments {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// PostIDs returns the "post" edge IDs in the mutation.
-func (m *CommentMutation) PostIDs() (ids []int) {
-	for id := range m.post {
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *AnswerMutation) CommentsIDs() (ids []int) {
+	for id := range m.comments {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetPost resets all changes to the "post" edge.
-func (m *CommentMutation) ResetPost() {
-	m.post = nil
-	m.clearedpost = false
-	m.removedpost = nil
-}
-
-// AddQuestionIDs adds the "question" edge to the Question entity by ids.
-func (m *CommentMutation) AddQuestionIDs(ids ...int) {
-	if m.question == nil {
-		m.question = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.question[ids[i]] = struct{}{}
-	}
-}
-
-// ClearQuestion clears the "question" edge to the Question entity.
-func (m *CommentMutation) ClearQuestion() {
-	m.clearedquestion = true
-}
-
-// QuestionCleared reports if the "question" edge to the Question entity was cleared.
-func (m *CommentMutation) QuestionCleared() bool {
-	return m.clearedquestion
-}
-
-// RemoveQuestionIDs removes the "question" edge to the Question entity by IDs.
-func (m *CommentMutation) RemoveQuestionIDs(ids ...int) {
-	if m.removedquestion == nil {
-		m.removedquestion = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.question, ids[i])
-		m.removedquestion[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedQuestion returns the removed IDs of the "question" edge to the Question entity.
-func (m *CommentMutation) RemovedQuestionIDs() (ids []int) {
-	for id := range m.removedquestion {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// QuestionIDs returns the "question" edge IDs in the mutation.
-func (m *CommentMutation) QuestionIDs() (ids []int) {
-	for id := range m.question {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetQuestion resets all changes to the "question" edge.
-func (m *CommentMutation) ResetQuestion() {
-	m.question = nil
-	m.clearedquestion = false
-	m.removedquestion = nil
-}
-
-// AddParentIDs adds the "parent" edge to the Comment entity by ids.
-func (m *CommentMutation) AddParentIDs(ids ...int) {
-	if m.parent == nil {
-		m.parent = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.parent[ids[i]] = struct{}{}
-	}
-}
-
-// ClearParent clears the "parent" edge to the Comment entity.
-func (m *CommentMutation) ClearParent() {
-	m.clearedparent = true
-}
-
-// ParentCleared reports if the "parent" edge to the Comment entity was cleared.
-func (m *CommentMutation) ParentCleared() bool {
-	return m.clearedparent
-}
-
-// RemoveParentIDs removes the "parent" edge to the Comment entity by IDs.
-func (m *CommentMutation) RemoveParentIDs(ids ...int) {
-	if m.removedparent == nil {
-		m.removedparent = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.parent, ids[i])
-		m.removedparent[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedParent returns the removed IDs of the "parent" edge to the Comment entity.
-func (m *CommentMutation) RemovedParentIDs() (ids []int) {
-	for id := range m.removedparent {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ParentIDs returns the "parent" edge IDs in the mutation.
-func (m *CommentMutation) ParentIDs() (ids []int) {
-	for id := range m.parent {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetParent resets all changes to the "parent" edge.
-func (m *CommentMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
-	m.removedparent = nil
-}
-
-// AddReplyIDs adds the "replies" edge to the Comment entity by ids.
-func (m *CommentMutation) AddReplyIDs(ids ...int) {
-	if m.replies == nil {
-		m.replies = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.replies[ids[i]] = struct{}{}
-	}
-}
-
-// ClearReplies clears the "replies" edge to the Comment entity.
-func (m *CommentMutation) ClearReplies() {
-	m.clearedreplies = true
-}
-
-// RepliesCleared reports if the "replies" edge to the Comment entity was cleared.
-func (m *CommentMutation) RepliesCleared() bool {
-	return m.clearedreplies
-}
-
-// RemoveReplyIDs removes the "replies" edge to the Comment entity by IDs.
-func (m *CommentMutation) RemoveReplyIDs(ids ...int) {
-	if m.removedreplies == nil {
-		m.removedreplies = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.replies, ids[i])
-		m.removedreplies[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedReplies returns the removed IDs of the "replies" edge to the Comment entity.
-func (m *CommentMutation) RemovedRepliesIDs() (ids []int) {
-	for id := range m.removedreplies {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// RepliesIDs returns the "replies" edge IDs in the mutation.
-func (m *CommentMutation) RepliesIDs() (ids []int) {
-	for id := range m.replies {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetReplies resets all changes to the "replies" edge.
-func (m *CommentMutation) ResetReplies() {
-	m.replies = nil
-	m.clearedreplies = false
-	m.removedreplies = nil
+// ResetComments resets all changes to the "comments" edge.
+func (m *AnswerMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
 }
 
-// Where appends a list predicates to the CommentMutation builder.
-func (m *CommentMutation) Where(ps ...predicate.Comment) {
+// Where appends a list predicates to the AnswerMutation builder.
+func (m *AnswerMutation) Where(ps ...predicate.Answer) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AnswerMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Comment, len(ps))
+func (m *AnswerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Answer, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -858,45 +603,42 @@ func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CommentMutation) Op() Op {
+func (m *AnswerMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CommentMutation) SetOp(op Op) {
+func (m *AnswerMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Comment).
-func (m *CommentMutation) Type() string {
+// Type returns the node type of this mutation (Answer).
+func (m *AnswerMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CommentMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *AnswerMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.create_time != nil {
-		fields = append(fields, comment.FieldCreateTime)
+		fields = append(fields, answer.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, comment.FieldUpdateTime)
+		fields = append(fields, answer.FieldUpdateTime)
 	}
 	if m.content != nil {
-		fields = append(fields, comment.FieldContent)
-	}
-	if m.user_id != nil {
-		fields = append(fields, comment.FieldUserID)
+		fields = append(fields, answer.FieldContent)
 	}
-	if m.post_id != nil {
-		fields = append(fields, comment.FieldPostID)
+	if m.question != nil {
+		fields = append(fields, answer.FieldQuestionID)
 	}
-	if m.question_id != nil {
-		fields = append(fields, comment.FieldQuestionID)
+	if m.user != nil {
+		fields = append(fields, answer.FieldUserID)
 	}
-	if m.parent_id != nil {
-		fields = append(fields, comment.FieldParentID)
+	if m.vote_score != nil {
+		fields = append(fields, answer.FieldVoteScore)
 	}
 	return fields
 }
@@ -904,22 +646,20 @@ func (m *CommentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CommentMutation) Field(name string) (ent.Value, bool) {
+func (m *AnswerMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case comment.FieldCreateTime:
+	case answer.FieldCreateTime:
 		return m.CreateTime()
-	case comment.FieldUpdateTime:
+	case answer.FieldUpdateTime:
 		return m.UpdateTime()
-	case comment.FieldContent:
+	case answer.FieldContent:
 		return m.Content()
-	case comment.FieldUserID:
-		return m.UserID()
-	case comment.FieldPostID:
-		return m.PostID()
-	case comment.FieldQuestionID:
+	case answer.FieldQuestionID:
 		return m.QuestionID()
-	case comment.FieldParentID:
-		return m.ParentID()
+	case answer.FieldUserID:
+		return m.UserID()
+	case answer.FieldVoteScore:
+		return m.VoteScore()
 	}
 	return nil, false
 }
@@ -927,99 +667,81 @@ func (m *CommentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AnswerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case comment.FieldCreateTime:
+	case answer.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case comment.FieldUpdateTime:
+	case answer.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case comment.FieldContent:
+	case answer.FieldContent:
 		return m.OldContent(ctx)
-	case comment.FieldUserID:
-		return m.OldUserID(ctx)
-	case comment.FieldPostID:
-		return m.OldPostID(ctx)
-	case comment.FieldQuestionID:
+	case answer.FieldQuestionID:
 		return m.OldQuestionID(ctx)
-	case comment.FieldParentID:
-		return m.OldParentID(ctx)
+	case answer.FieldUserID:
+		return m.OldUserID(ctx)
+	case answer.FieldVoteScore:
+		return m.OldVoteScore(ctx)
 	}
-	return nil, fmt.Errorf("unknown Comment field %s", name)
+	return nil, fmt.Errorf("unknown Answer field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CommentMutation) SetField(name string, value ent.Value) error {
+func (m *AnswerMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case comment.FieldCreateTime:
+	case answer.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case comment.FieldUpdateTime:
+	case answer.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case comment.FieldContent:
+	case answer.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetContent(v)
 		return nil
-	case comment.FieldUserID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case comment.FieldPostID:
+	case answer.FieldQuestionID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPostID(v)
+		m.SetQuestionID(v)
 		return nil
-	case comment.FieldQuestionID:
+	case answer.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuestionID(v)
+		m.SetUserID(v)
 		return nil
-	case comment.FieldParentID:
+	case answer.FieldVoteScore:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParentID(v)
+		m.SetVoteScore(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Comment field %s", name)
+	return fmt.Errorf("unknown Answer field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CommentMutation) AddedFields() []string {
+func (m *AnswerMutation) AddedFields() []string {
 	var fields []string
-	if m.adduser_id != nil {
-		fields = append(fields, comment.FieldUserID)
-	}
-	if m.addpost_id != nil {
-		fields = append(fields, comment.FieldPostID)
-	}
-	if m.addquestion_id != nil {
-		fields = append(fields, comment.FieldQuestionID)
-	}
-	if m.addparent_id != nil {
-		fields = append(fields, comment.FieldParentID)
+	if m.addvote_score != nil {
+		fields = append(fields, answer.FieldVoteScore)
 	}
 	return fields
 }
@@ -1027,16 +749,10 @@ func (m *CommentMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AnswerMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case comment.FieldUserID:
-		return m.AddedUserID()
-	case comment.FieldPostID:
-		return m.AddedPostID()
-	case comment.FieldQuestionID:
-		return m.AddedQuestionID()
-	case comment.FieldParentID:
-		return m.AddedParentID()
+	case answer.FieldVoteScore:
+		return m.AddedVoteScore()
 	}
 	return nil, false
 }
@@ -1044,167 +760,103 @@ func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CommentMutation) AddField(name string, value ent.Value) error {
+func (m *AnswerMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case comment.FieldUserID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUserID(v)
-		return nil
-	case comment.FieldPostID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPostID(v)
-		return nil
-	case comment.FieldQuestionID:
+	case answer.FieldVoteScore:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddQuestionID(v)
-		return nil
-	case comment.FieldParentID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddParentID(v)
+		m.AddVoteScore(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Comment numeric field %s", name)
+	return fmt.Errorf("unknown Answer numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CommentMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(comment.FieldContent) {
-		fields = append(fields, comment.FieldContent)
-	}
-	if m.FieldCleared(comment.FieldPostID) {
-		fields = append(fields, comment.FieldPostID)
-	}
-	if m.FieldCleared(comment.FieldQuestionID) {
-		fields = append(fields, comment.FieldQuestionID)
-	}
-	if m.FieldCleared(comment.FieldParentID) {
-		fields = append(fields, comment.FieldParentID)
-	}
-	return fields
+func (m *AnswerMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CommentMutation) FieldCleared(name string) bool {
+func (m *AnswerMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CommentMutation) ClearField(name string) error {
+func (m *AnswerMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Answer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AnswerMutation) ResetField(name string) error {
 	switch name {
-	case comment.FieldContent:
-		m.ClearContent()
+	case answer.FieldCreateTime:
+		m.ResetCreateTime()
 		return nil
-	case comment.FieldPostID:
-		m.ClearPostID()
-		return nil
-	case comment.FieldQuestionID:
-		m.ClearQuestionID()
-		return nil
-	case comment.FieldParentID:
-		m.ClearParentID()
-		return nil
-	}
-	return fmt.Errorf("unknown Comment nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *CommentMutation) ResetField(name string) error {
-	switch name {
-	case comment.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case comment.FieldUpdateTime:
+	case answer.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case comment.FieldContent:
+	case answer.FieldContent:
 		m.ResetContent()
 		return nil
-	case comment.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case comment.FieldPostID:
-		m.ResetPostID()
-		return nil
-	case comment.FieldQuestionID:
+	case answer.FieldQuestionID:
 		m.ResetQuestionID()
 		return nil
-	case comment.FieldParentID:
-		m.ResetParentID()
+	case answer.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case answer.FieldVoteScore:
+		m.ResetVoteScore()
 		return nil
 	}
-	return fmt.Errorf("unknown Comment field %s", name)
+	return fmt.Errorf("unknown Answer field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CommentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.user != nil {
-		edges = append(edges, comment.EdgeUser)
-	}
-	if m.post != nil {
-		edges = append(edges, comment.EdgePost)
-	}
+func (m *AnswerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.question != nil {
-		edges = append(edges, comment.EdgeQuestion)
+		edges = append(edges, answer.EdgeQuestion)
 	}
-	if m.parent != nil {
-		edges = append(edges, comment.EdgeParent)
+	if m.user != nil {
+		edges = append(edges, answer.EdgeUser)
 	}
-	if m.replies != nil {
-		edges = append(edges, comment.EdgeReplies)
+	if m.votes != nil {
+		edges = append(edges, answer.EdgeVotes)
+	}
+	if m.comments != nil {
+		edges = append(edges, answer.EdgeComments)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CommentMutation) AddedIDs(name string) []ent.Value {
+func (m *AnswerMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case comment.EdgeUser:
-		ids := make([]ent.Value, 0, len(m.user))
-		for id := range m.user {
-			ids = append(ids, id)
-		}
-		return ids
-	case comment.EdgePost:
-		ids := make([]ent.Value, 0, len(m.post))
-		for id := range m.post {
-			ids = append(ids, id)
+	case answer.EdgeQuestion:
+		if id := m.question; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case comment.EdgeQuestion:
-		ids := make([]ent.Value, 0, len(m.question))
-		for id := range m.question {
-			ids = append(ids, id)
+	case answer.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case comment.EdgeParent:
-		ids := make([]ent.Value, 0, len(m.parent))
-		for id := range m.parent {
+	case answer.EdgeVotes:
+		ids := make([]ent.Value, 0, len(m.votes))
+		for id := range m.votes {
 			ids = append(ids, id)
 		}
 		return ids
-	case comment.EdgeReplies:
-		ids := make([]ent.Value, 0, len(m.replies))
-		for id := range m.replies {
+	case answer.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1213,57 +865,30 @@ func (m *CommentMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CommentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removeduser != nil {
-		edges = append(edges, comment.EdgeUser)
-	}
-	if m.removedpost != nil {
-		edges = append(edges, comment.EdgePost)
+func (m *AnswerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedvotes != nil {
+		edges = append(edges, answer.EdgeVotes)
 	}
-	if m.removedquestion != nil {
-		edges = append(edges, comment.EdgeQuestion)
-	}
-	if m.removedparent != nil {
-		edges = append(edges, comment.EdgeParent)
-	}
-	if m.removedreplies != nil {
-		edges = append(edges, comment.EdgeReplies)
+	if m.removedcomments != nil {
+		edges = append(edges, answer.EdgeComments)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
+func (m *AnswerMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case comment.EdgeUser:
-		ids := make([]ent.Value, 0, len(m.removeduser))
-		for id := range m.removeduser {
-			ids = append(ids, id)
-		}
-		return ids
-	case comment.EdgePost:
-		ids := make([]ent.Value, 0, len(m.removedpost))
-		for id := range m.removedpost {
-			ids = append(ids, id)
-		}
-		return ids
-	case comment.EdgeQuestion:
-		ids := make([]ent.Value, 0, len(m.removedquestion))
-		for id := range m.removedquestion {
-			ids = append(ids, id)
-		}
-		return ids
-	case comment.EdgeParent:
-		ids := make([]ent.Value, 0, len(m.removedparent))
-		for id := range m.removedparent {
+	case answer.EdgeVotes:
+		ids := make([]ent.Value, 0, len(m.removedvotes))
+		for id := range m.removedvotes {
 			ids = append(ids, id)
 		}
 		return ids
-	case comment.EdgeReplies:
-		ids := make([]ent.Value, 0, len(m.removedreplies))
-		for id := range m.removedreplies {
+	case answer.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1272,110 +897,104 @@ func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CommentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.cleareduser {
-		edges = append(edges, comment.EdgeUser)
-	}
-	if m.clearedpost {
-		edges = append(edges, comment.EdgePost)
-	}
+func (m *AnswerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.clearedquestion {
-		edges = append(edges, comment.EdgeQuestion)
+		edges = append(edges, answer.EdgeQuestion)
 	}
-	if m.clearedparent {
-		edges = append(edges, comment.EdgeParent)
+	if m.cleareduser {
+		edges = append(edges, answer.EdgeUser)
 	}
-	if m.clearedreplies {
-		edges = append(edges, comment.EdgeReplies)
+	if m.clearedvotes {
+		edges = append(edges, answer.EdgeVotes)
+	}
+	if m.clearedcomments {
+		edges = append(edges, answer.EdgeComments)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CommentMutation) EdgeCleared(name string) bool {
+func (m *AnswerMutation) EdgeCleared(name string) bool {
 	switch name {
-	case comment.EdgeUser:
-		return m.cleareduser
-	case comment.EdgePost:
-		return m.clearedpost
-	case comment.EdgeQuestion:
+	case answer.EdgeQuestion:
 		return m.clearedquestion
-	case comment.EdgeParent:
-		return m.clearedparent
-	case comment.EdgeReplies:
-		return m.clearedreplies
+	case answer.EdgeUser:
+		return m.cleareduser
+	case answer.EdgeVotes:
+		return m.clearedvotes
+	case answer.EdgeComments:
+		return m.clearedcomments
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CommentMutation) ClearEdge(name string) error {
+func (m *AnswerMutation) ClearEdge(name string) error {
 	switch name {
+	case answer.EdgeQuestion:
+		m.ClearQuestion()
+		return nil
+	case answer.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
-	return fmt.Errorf("unknown Comment unique edge %s", name)
+	return fmt.Errorf("unknown Answer unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CommentMutation) ResetEdge(name string) error {
+func (m *AnswerMutation) ResetEdge(name string) error {
 	switch name {
-	case comment.EdgeUser:
-		m.ResetUser()
-		return nil
-	case comment.EdgePost:
-		m.ResetPost()
-		return nil
-	case comment.EdgeQuestion:
+	case answer.EdgeQuestion:
 		m.ResetQuestion()
 		return nil
-	case comment.EdgeParent:
-		m.ResetParent()
+	case answer.EdgeUser:
+		m.ResetUser()
 		return nil
-	case comment.EdgeReplies:
-		m.ResetReplies()
+	case answer.EdgeVotes:
+		m.ResetVotes()
+		return nil
+	case answer.EdgeComments:
+		m.ResetComments()
 		return nil
 	}
-	return fmt.Errorf("unknown Comment edge %s", name)
+	return fmt.Errorf("unknown Answer edge %s", name)
 }
 
-// FollowMutation represents an operation that mutates the Follow nodes in the graph.
-type FollowMutation struct {
+// AnswerVoteMutation represents an operation that mutates the AnswerVote nodes in the graph.
+type AnswerVoteMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	create_time      *time.Time
-	update_time      *time.Time
-	follower_id      *int
-	addfollower_id   *int
-	following_id     *int
-	addfollowing_id  *int
-	clearedFields    map[string]struct{}
-	follower         map[int]struct{}
-	removedfollower  map[int]struct{}
-	clearedfollower  bool
-	following        map[int]struct{}
-	removedfollowing map[int]struct{}
-	clearedfollowing bool
-	done             bool
-	oldValue         func(context.Context) (*Follow, error)
-	predicates       []predicate.Follow
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	value         *int
+	addvalue      *int
+	clearedFields map[string]struct{}
+	answer        *int
+	clearedanswer bool
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*AnswerVote, error)
+	predicates    []predicate.AnswerVote
 }
 
-var _ ent.Mutation = (*FollowMutation)(nil)
+var _ ent.Mutation = (*AnswerVoteMutation)(nil)
 
-// followOption allows management of the mutation configuration using functional options.
-type followOption func(*FollowMutation)
+// answervoteOption allows management of the mutation configuration using functional options.
+type answervoteOption func(*AnswerVoteMutation)
 
-// newFollowMutation creates new mutation for the Follow entity.
-func newFollowMutation(c config, op Op, opts ...followOption) *FollowMutation {
-	m := &FollowMutation{
+// newAnswerVoteMutation creates new mutation for the AnswerVote entity.
+func newAnswerVoteMutation(c config, op Op, opts ...answervoteOption) *AnswerVoteMutation {
+	m := &AnswerVoteMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFollow,
+		typ:           TypeAnswerVote,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1384,20 +1003,20 @@ func newFollowMutation(c config, op Op, opts ...followOption) *FollowMutation {
 	return m
 }
 
-// withFollowID sets the ID field of the mutation.
-func withFollowID(id int) followOption {
-	return func(m *FollowMutation) {
+// withAnswerVoteID sets the ID field of the mutation.
+func withAnswerVoteID(id int) answervoteOption {
+	return func(m *AnswerVoteMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Follow
+			value *AnswerVote
 		)
-		m.oldValue = func(ctx context.Context) (*Follow, error) {
+		m.oldValue = func(ctx context.Context) (*AnswerVote, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Follow.Get(ctx, id)
+					value, err = m.Client().AnswerVote.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1406,10 +1025,10 @@ func withFollowID(id int) followOption {
 	}
 }
 
-// withFollow sets the old Follow of the mutation.
-func withFollow(node *Follow) followOption {
-	return func(m *FollowMutation) {
-		m.oldValue = func(context.Context) (*Follow, error) {
+// withAnswerVote sets the old AnswerVote of the mutation.
+func withAnswerVote(node *AnswerVote) answervoteOption {
+	return func(m *AnswerVoteMutation) {
+		m.oldValue = func(context.Context) (*AnswerVote, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1418,7 +1037,7 @@ func withFollow(node *Follow) followOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FollowMutation) Client() *Client {
+func (m AnswerVoteMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1426,7 +1045,7 @@ func (m FollowMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FollowMutation) Tx() (*Tx, error) {
+func (m AnswerVoteMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1437,7 +1056,7 @@ func (m FollowMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FollowMutation) ID() (id int, exists bool) {
+func (m *AnswerVoteMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1448,7 +1067,7 @@ func (m *FollowMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FollowMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AnswerVoteMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1457,19 +1076,19 @@ func (m *FollowMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Follow.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AnswerVote.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *FollowMutation) SetCreateTime(t time.Time) {
+func (m *AnswerVoteMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *FollowMutation) CreateTime() (r time.Time, exists bool) {
+func (m *AnswerVoteMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -1477,10 +1096,10 @@ func (m *FollowMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Follow entity.
-// If the Follow object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the AnswerVote entity.
+// If the AnswerVote object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FollowMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AnswerVoteMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -1495,17 +1114,17 @@ func (m *FollowMutation) OldCreateTime(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *FollowMutation) ResetCreateTime() {
+func (m *AnswerVoteMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *FollowMutation) SetUpdateTime(t time.Time) {
+func (m *AnswerVoteMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *FollowMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *AnswerVoteMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -1513,10 +1132,10 @@ func (m *FollowMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Follow entity.
-// If the Follow object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the AnswerVote entity.
+// If the AnswerVote object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FollowMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AnswerVoteMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -1531,239 +1150,201 @@ func (m *FollowMutation) OldUpdateTime(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *FollowMutation) ResetUpdateTime() {
+func (m *AnswerVoteMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetFollowerID sets the "follower_id" field.
-func (m *FollowMutation) SetFollowerID(i int) {
-	m.follower_id = &i
-	m.addfollower_id = nil
+// SetAnswerID sets the "answer_id" field.
+func (m *AnswerVoteMutation) SetAnswerID(i int) {
+	m.answer = &i
 }
 
-// FollowerID returns the value of the "follower_id" field in the mutation.
-func (m *FollowMutation) FollowerID() (r int, exists bool) {
-	v := m.follower_id
+// AnswerID returns the value of the "answer_id" field in the mutation.
+func (m *AnswerVoteMutation) AnswerID() (r int, exists bool) {
+	v := m.answer
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFollowerID returns the old "follower_id" field's value of the Follow entity.
-// If the Follow object wasn't provided to the builder, the object is fetched from the database.
+// OldAnswerID returns the old "answer_id" field's value of the AnswerVote entity.
+// If the AnswerVote object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FollowMutation) OldFollowerID(ctx context.Context) (v int, err error) {
+func (m *AnswerVoteMutation) OldAnswerID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFollowerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAnswerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFollowerID requires an ID field in the mutation")
+		return v, errors.New("OldAnswerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFollowerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAnswerID: %w", err)
 	}
-	return oldValue.FollowerID, nil
+	return oldValue.AnswerID, nil
 }
 
-// AddFollowerID adds i to the "follower_id" field.
-func (m *FollowMutation) AddFollowerID(i int) {
-	if m.addfollower_id != nil {
-		*m.addfollower_id += i
-	} else {
-		m.addfollower_id = &i
-	}
+// ResetAnswerID resets all changes to the "answer_id" field.
+func (m *AnswerVoteMutation) ResetAnswerID() {
+	m.answer = nil
 }
 
-// AddedFollowerID returns the value that was added to the "follower_id" field in this mutation.
-func (m *FollowMutation) AddedFollowerID() (r int, exists bool) {
-	v := m.addfollower_id
+// SetUserID sets the "user_id" field.
+func (m *AnswerVoteMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AnswerVoteMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetFollowerID resets all changes to the "follower_id" field.
-func (m *FollowMutation) ResetFollowerID() {
-	m.follower_id = nil
-	m.addfollower_id = nil
+// OldUserID returns the old "user_id" field's value of the AnswerVote entity.
+// If the AnswerVote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnswerVoteMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
 }
 
-// SetFollowingID sets the "following_id" field.
-func (m *FollowMutation) SetFollowingID(i int) {
-	m.following_id = &i
-	m.addfollowing_id = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AnswerVoteMutation) ResetUserID() {
+	m.user = nil
 }
 
-// FollowingID returns the value of the "following_id" field in the mutation.
-func (m *FollowMutation) FollowingID() (r int, exists bool) {
-	v := m.following_id
+// SetValue sets the "value" field.
+func (m *AnswerVoteMutation) SetValue(i int) {
+	m.value = &i
+	m.addvalue = nil
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *AnswerVoteMutation) Value() (r int, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFollowingID returns the old "following_id" field's value of the Follow entity.
-// If the Follow object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the AnswerVote entity.
+// If the AnswerVote object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FollowMutation) OldFollowingID(ctx context.Context) (v int, err error) {
+func (m *AnswerVoteMutation) OldValue(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFollowingID is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFollowingID requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFollowingID: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.FollowingID, nil
+	return oldValue.Value, nil
 }
 
-// AddFollowingID adds i to the "following_id" field.
-func (m *FollowMutation) AddFollowingID(i int) {
-	if m.addfollowing_id != nil {
-		*m.addfollowing_id += i
+// AddValue adds i to the "value" field.
+func (m *AnswerVoteMutation) AddValue(i int) {
+	if m.addvalue != nil {
+		*m.addvalue += i
 	} else {
-		m.addfollowing_id = &i
+		m.addvalue = &i
 	}
 }
 
-// AddedFollowingID returns the value that was added to the "following_id" field in this mutation.
-func (m *FollowMutation) AddedFollowingID() (r int, exists bool) {
-	v := m.addfollowing_id
+// AddedValue returns the value that was added to the "value" field in this mutation.
+func (m *AnswerVoteMutation) AddedValue() (r int, exists bool) {
+	v := m.addvalue
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetFollowingID resets all changes to the "following_id" field.
-func (m *FollowMutation) ResetFollowingID() {
-	m.following_id = nil
-	m.addfollowing_id = nil
+// ResetValue resets all changes to the "value" field.
+func (m *AnswerVoteMutation) ResetValue() {
+	m.value = nil
+	m.addvalue = nil
 }
 
-// AddFollowerIDs adds the "follower" edge to the User entity by ids.
-func (m *FollowMutation) AddFollowerIDs(ids ...int) {
-	if m.follower == nil {
-		m.follower = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.follower[ids[i]] = struct{}{}
-	}
+// ClearAnswer clears the "answer" edge to the Answer entity.
+func (m *AnswerVoteMutation) ClearAnswer() {
+	m.clearedanswer = true
+	m.clearedFields[answervote.FieldAnswerID] = struct{}{}
 }
 
-// ClearFollower clears the "follower" edge to the User entity.
-func (m *FollowMutation) ClearFollower() {
-	m.clearedfollower = true
+// AnswerCleared reports if the "answer" edge to the Answer entity was cleared.
+func (m *AnswerVoteMutation) AnswerCleared() bool {
+	return m.clearedanswer
 }
 
-// FollowerCleared reports if the "follower" edge to the User entity was cleared.
-func (m *FollowMutation) FollowerCleared() bool {
-	return m.clearedfollower
-}
-
-// RemoveFollowerIDs removes the "follower" edge to the User entity by IDs.
-func (m *FollowMutation) RemoveFollowerIDs(ids ...int) {
-	if m.removedfollower == nil {
-		m.removedfollower = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.follower, ids[i])
-		m.removedfollower[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedFollower returns the removed IDs of the "follower" edge to the User entity.
-func (m *FollowMutation) RemovedFollowerIDs() (ids []int) {
-	for id := range m.removedfollower {
-		ids = append(ids, id)
+// AnswerIDs returns the "answer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AnswerID instead. It exists only for internal usage by the builders.
+func (m *AnswerVoteMutation) AnswerIDs() (ids []int) {
+	if id := m.answer; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// FollowerIDs returns the "follower" edge IDs in the mutation.
-func (m *FollowMutation) FollowerIDs() (ids []int) {
-	for id := range m.follower {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetFollower resets all changes to the "follower" edge.
-func (m *FollowMutation) ResetFollower() {
-	m.follower = nil
-	m.clearedfollower = false
-	m.removedfollower = nil
-}
-
-// AddFollowingIDs adds the "following" edge to the User entity by ids.
-func (m *FollowMutation) AddFollowingIDs(ids ...int) {
-	if m.following == nil {
-		m.following = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.following[ids[i]] = struct{}{}
-	}
-}
-
-// ClearFollowing clears the "following" edge to the User entity.
-func (m *FollowMutation) ClearFollowing() {
-	m.clearedfollowing = true
-}
-
-// FollowingCleared reports if the "following" edge to the User entity was cleared.
-func (m *FollowMutation) FollowingCleared() bool {
-	return m.clearedfollowing
+// ResetAnswer resets all changes to the "answer" edge.
+func (m *AnswerVoteMutation) ResetAnswer() {
+	m.answer = nil
+	m.clearedanswer = false
 }
 
-// RemoveFollowingIDs removes the "following" edge to the User entity by IDs.
-func (m *FollowMutation) RemoveFollowingIDs(ids ...int) {
-	if m.removedfollowing == nil {
-		m.removedfollowing = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.following, ids[i])
-		m.removedfollowing[ids[i]] = struct{}{}
-	}
+// ClearUser clears the "user" edge to the User entity.
+func (m *AnswerVoteMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[answervote.FieldUserID] = struct{}{}
 }
 
-// RemovedFollowing returns the removed IDs of the "following" edge to the User entity.
-func (m *FollowMutation) RemovedFollowingIDs() (ids []int) {
-	for id := range m.removedfollowing {
-		ids = append(ids, id)
-	}
-	return
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *AnswerVoteMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// FollowingIDs returns the "following" edge IDs in the mutation.
-func (m *FollowMutation) FollowingIDs() (ids []int) {
-	for id := range m.following {
-		ids = append(ids, id)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *AnswerVoteMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetFollowing resets all changes to the "following" edge.
-func (m *FollowMutation) ResetFollowing() {
-	m.following = nil
-	m.clearedfollowing = false
-	m.removedfollowing = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *AnswerVoteMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the FollowMutation builder.
-func (m *FollowMutation) Where(ps ...predicate.Follow) {
+// Where appends a list predicates to the AnswerVoteMutation builder.
+func (m *AnswerVoteMutation) Where(ps ...predicate.AnswerVote) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FollowMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AnswerVoteMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FollowMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Follow, len(ps))
+func (m *AnswerVoteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AnswerVote, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1771,36 +1352,39 @@ func (m *FollowMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FollowMutation) Op() Op {
+func (m *AnswerVoteMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FollowMutation) SetOp(op Op) {
+func (m *AnswerVoteMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Follow).
-func (m *FollowMutation) Type() string {
+// Type returns the node type of this mutation (AnswerVote).
+func (m *AnswerVoteMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FollowMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+func (m *AnswerVoteMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.create_time != nil {
-		fields = append(fields, follow.FieldCreateTime)
+		fields = append(fields, answervote.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, follow.FieldUpdateTime)
+		fields = append(fields, answervote.FieldUpdateTime)
 	}
-	if m.follower_id != nil {
-		fields = append(fields, follow.FieldFollowerID)
+	if m.answer != nil {
+		fields = append(fields, answervote.FieldAnswerID)
 	}
-	if m.following_id != nil {
-		fields = append(fields, follow.FieldFollowingID)
+	if m.user != nil {
+		fields = append(fields, answervote.FieldUserID)
+	}
+	if m.value != nil {
+		fields = append(fields, answervote.FieldValue)
 	}
 	return fields
 }
@@ -1808,16 +1392,18 @@ func (m *FollowMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FollowMutation) Field(name string) (ent.Value, bool) {
+func (m *AnswerVoteMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case follow.FieldCreateTime:
+	case answervote.FieldCreateTime:
 		return m.CreateTime()
-	case follow.FieldUpdateTime:
+	case answervote.FieldUpdateTime:
 		return m.UpdateTime()
-	case follow.FieldFollowerID:
-		return m.FollowerID()
-	case follow.FieldFollowingID:
-		return m.FollowingID()
+	case answervote.FieldAnswerID:
+		return m.AnswerID()
+	case answervote.FieldUserID:
+		return m.UserID()
+	case answervote.FieldValue:
+		return m.Value()
 	}
 	return nil, false
 }
@@ -1825,66 +1411,72 @@ func (m *FollowMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FollowMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AnswerVoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case follow.FieldCreateTime:
+	case answervote.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case follow.FieldUpdateTime:
+	case answervote.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case follow.FieldFollowerID:
-		return m.OldFollowerID(ctx)
-	case follow.FieldFollowingID:
-		return m.OldFollowingID(ctx)
+	case answervote.FieldAnswerID:
+		return m.OldAnswerID(ctx)
+	case answervote.FieldUserID:
+		return m.OldUserID(ctx)
+	case answervote.FieldValue:
+		return m.OldValue(ctx)
 	}
-	return nil, fmt.Errorf("unknown Follow field %s", name)
+	return nil, fmt.Errorf("unknown AnswerVote field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FollowMutation) SetField(name string, value ent.Value) error {
+func (m *AnswerVoteMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case follow.FieldCreateTime:
+	case answervote.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case follow.FieldUpdateTime:
+	case answervote.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case follow.FieldFollowerID:
+	case answervote.FieldAnswerID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFollowerID(v)
+		m.SetAnswerID(v)
 		return nil
-	case follow.FieldFollowingID:
+	case answervote.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFollowingID(v)
+		m.SetUserID(v)
+		return nil
+	case answervote.FieldValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Follow field %s", name)
+	return fmt.Errorf("unknown AnswerVote field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FollowMutation) AddedFields() []string {
+func (m *AnswerVoteMutation) AddedFields() []string {
 	var fields []string
-	if m.addfollower_id != nil {
-		fields = append(fields, follow.FieldFollowerID)
-	}
-	if m.addfollowing_id != nil {
-		fields = append(fields, follow.FieldFollowingID)
+	if m.addvalue != nil {
+		fields = append(fields, answervote.FieldValue)
 	}
 	return fields
 }
@@ -1892,12 +1484,10 @@ func (m *FollowMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FollowMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AnswerVoteMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case follow.FieldFollowerID:
-		return m.AddedFollowerID()
-	case follow.FieldFollowingID:
-		return m.AddedFollowingID()
+	case answervote.FieldValue:
+		return m.AddedValue()
 	}
 	return nil, false
 }
@@ -1905,218 +1495,197 @@ func (m *FollowMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FollowMutation) AddField(name string, value ent.Value) error {
+func (m *AnswerVoteMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case follow.FieldFollowerID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFollowerID(v)
-		return nil
-	case follow.FieldFollowingID:
+	case answervote.FieldValue:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFollowingID(v)
+		m.AddValue(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Follow numeric field %s", name)
+	return fmt.Errorf("unknown AnswerVote numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FollowMutation) ClearedFields() []string {
+func (m *AnswerVoteMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FollowMutation) FieldCleared(name string) bool {
+func (m *AnswerVoteMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FollowMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Follow nullable field %s", name)
+func (m *AnswerVoteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AnswerVote nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FollowMutation) ResetField(name string) error {
+func (m *AnswerVoteMutation) ResetField(name string) error {
 	switch name {
-	case follow.FieldCreateTime:
+	case answervote.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case follow.FieldUpdateTime:
+	case answervote.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case follow.FieldFollowerID:
-		m.ResetFollowerID()
+	case answervote.FieldAnswerID:
+		m.ResetAnswerID()
 		return nil
-	case follow.FieldFollowingID:
-		m.ResetFollowingID()
+	case answervote.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case answervote.FieldValue:
+		m.ResetValue()
 		return nil
 	}
-	return fmt.Errorf("unknown Follow field %s", name)
+	return fmt.Errorf("unknown AnswerVote field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FollowMutation) AddedEdges() []string {
+func (m *AnswerVoteMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.follower != nil {
-		edges = append(edges, follow.EdgeFollower)
+	if m.answer != nil {
+		edges = append(edges, answervote.EdgeAnswer)
 	}
-	if m.following != nil {
-		edges = append(edges, follow.EdgeFollowing)
+	if m.user != nil {
+		edges = append(edges, answervote.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FollowMutation) AddedIDs(name string) []ent.Value {
+func (m *AnswerVoteMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case follow.EdgeFollower:
-		ids := make([]ent.Value, 0, len(m.follower))
-		for id := range m.follower {
-			ids = append(ids, id)
+	case answervote.EdgeAnswer:
+		if id := m.answer; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case follow.EdgeFollowing:
-		ids := make([]ent.Value, 0, len(m.following))
-		for id := range m.following {
-			ids = append(ids, id)
+	case answervote.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FollowMutation) RemovedEdges() []string {
+func (m *AnswerVoteMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedfollower != nil {
-		edges = append(edges, follow.EdgeFollower)
-	}
-	if m.removedfollowing != nil {
-		edges = append(edges, follow.EdgeFollowing)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FollowMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case follow.EdgeFollower:
-		ids := make([]ent.Value, 0, len(m.removedfollower))
-		for id := range m.removedfollower {
-			ids = append(ids, id)
-		}
-		return ids
-	case follow.EdgeFollowing:
-		ids := make([]ent.Value, 0, len(m.removedfollowing))
-		for id := range m.removedfollowing {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AnswerVoteMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FollowMutation) ClearedEdges() []string {
+func (m *AnswerVoteMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedfollower {
-		edges = append(edges, follow.EdgeFollower)
+	if m.clearedanswer {
+		edges = append(edges, answervote.EdgeAnswer)
 	}
-	if m.clearedfollowing {
-		edges = append(edges, follow.EdgeFollowing)
+	if m.cleareduser {
+		edges = append(edges, answervote.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FollowMutation) EdgeCleared(name string) bool {
+func (m *AnswerVoteMutation) EdgeCleared(name string) bool {
 	switch name {
-	case follow.EdgeFollower:
-		return m.clearedfollower
-	case follow.EdgeFollowing:
-		return m.clearedfollowing
+	case answervote.EdgeAnswer:
+		return m.clearedanswer
+	case answervote.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FollowMutation) ClearEdge(name string) error {
+func (m *AnswerVoteMutation) ClearEdge(name string) error {
 	switch name {
+	case answervote.EdgeAnswer:
+		m.ClearAnswer()
+		return nil
+	case answervote.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
-	return fmt.Errorf("unknown Follow unique edge %s", name)
+	return fmt.Errorf("unknown AnswerVote unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FollowMutation) ResetEdge(name string) error {
+func (m *AnswerVoteMutation) ResetEdge(name string) error {
 	switch name {
-	case follow.EdgeFollower:
-		m.ResetFollower()
+	case answervote.EdgeAnswer:
+		m.ResetAnswer()
 		return nil
-	case follow.EdgeFollowing:
-		m.ResetFollowing()
+	case answervote.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Follow edge %s", name)
+	return fmt.Errorf("unknown AnswerVote edge %s", name)
 }
 
-// PostMutation represents an operation that mutates the Post nodes in the graph.
-type PostMutation struct {
+// CommentMutation represents an operation that mutates the Comment nodes in the graph.
+type CommentMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	create_time      *time.Time
-	update_time      *time.Time
-	title            *string
-	content          *string
-	embedding        *[]byte
-	clearedFields    map[string]struct{}
-	user             map[int]struct{}
-	removeduser      map[int]struct{}
-	cleareduser      bool
-	comments         map[int]struct{}
-	removedcomments  map[int]struct{}
-	clearedcomments  bool
-	tags             map[int]struct{}
-	removedtags      map[int]struct{}
-	clearedtags      bool
-	likes            map[int]struct{}
-	removedlikes     map[int]struct{}
-	clearedlikes     bool
-	favorites        map[int]struct{}
-	removedfavorites map[int]struct{}
-	clearedfavorites bool
-	done             bool
-	oldValue         func(context.Context) (*Post, error)
-	predicates       []predicate.Post
+	op                   Op
+	typ                  string
+	id                   *int
+	create_time          *time.Time
+	update_time          *time.Time
+	content              *string
+	depth                *int
+	adddepth             *int
+	deleted_at           *time.Time
+	clearedFields        map[string]struct{}
+	user                 *int
+	cleareduser          bool
+	post                 *int
+	clearedpost          bool
+	question             *int
+	clearedquestion      bool
+	answer               *int
+	clearedanswer        bool
+	reply_to_user        *int
+	clearedreply_to_user bool
+	parent               *int
+	clearedparent        bool
+	replies              map[int]struct{}
+	removedreplies       map[int]struct{}
+	clearedreplies       bool
+	done                 bool
+	oldValue             func(context.Context) (*Comment, error)
+	predicates           []predicate.Comment
 }
 
-var _ ent.Mutation = (*PostMutation)(nil)
+var _ ent.Mutation = (*CommentMutation)(nil)
 
-// postOption allows management of the mutation configuration using functional options.
-type postOption func(*PostMutation)
+// commentOption allows management of the mutation configuration using functional options.
+type commentOption func(*CommentMutation)
 
-// newPostMutation creates new mutation for the Post entity.
-func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
-	m := &PostMutation{
+// newCommentMutation creates new mutation for the Comment entity.
+func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation {
+	m := &CommentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePost,
+		typ:           TypeComment,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2125,20 +1694,20 @@ func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
 	return m
 }
 
-// withPostID sets the ID field of the mutation.
-func withPostID(id int) postOption {
-	return func(m *PostMutation) {
+// withCommentID sets the ID field of the mutation.
+func withCommentID(id int) commentOption {
+	return func(m *CommentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Post
+			value *Comment
 		)
-		m.oldValue = func(ctx context.Context) (*Post, error) {
+		m.oldValue = func(ctx context.Context) (*Comment, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Post.Get(ctx, id)
+					value, err = m.Client().Comment.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2147,10 +1716,10 @@ func withPostID(id int) postOption {
 	}
 }
 
-// withPost sets the old Post of the mutation.
-func withPost(node *Post) postOption {
-	return func(m *PostMutation) {
-		m.oldValue = func(context.Context) (*Post, error) {
+// withComment sets the old Comment of the mutation.
+func withComment(node *Comment) commentOption {
+	return func(m *CommentMutation) {
+		m.oldValue = func(context.Context) (*Comment, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2159,7 +1728,7 @@ func withPost(node *Post) postOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PostMutation) Client() *Client {
+func (m CommentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2167,7 +1736,7 @@ func (m PostMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PostMutation) Tx() (*Tx, error) {
+func (m CommentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2178,7 +1747,7 @@ func (m PostMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PostMutation) ID() (id int, exists bool) {
+func (m *CommentMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2189,7 +1758,7 @@ func (m *PostMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PostMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CommentMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2198,19 +1767,19 @@ func (m *PostMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Comment.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *PostMutation) SetCreateTime(t time.Time) {
+func (m *CommentMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *PostMutation) CreateTime() (r time.Time, exists bool) {
+func (m *CommentMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -2218,10 +1787,10 @@ func (m *PostMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *CommentMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -2236,17 +1805,17 @@ func (m *PostMutation) OldCreateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *PostMutation) ResetCreateTime() {
+func (m *CommentMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *PostMutation) SetUpdateTime(t time.Time) {
+func (m *CommentMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *PostMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *CommentMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -2254,10 +1823,10 @@ func (m *PostMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *CommentMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -2272,53 +1841,17 @@ func (m *PostMutation) OldUpdateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *PostMutation) ResetUpdateTime() {
+func (m *CommentMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *PostMutation) SetTitle(s string) {
-	m.title = &s
-}
-
-// Title returns the value of the "title" field in the mutation.
-func (m *PostMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTitle returns the old "title" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
-	}
-	return oldValue.Title, nil
-}
-
-// ResetTitle resets all changes to the "title" field.
-func (m *PostMutation) ResetTitle() {
-	m.title = nil
-}
-
 // SetContent sets the "content" field.
-func (m *PostMutation) SetContent(s string) {
+func (m *CommentMutation) SetContent(s string) {
 	m.content = &s
 }
 
 // Content returns the value of the "content" field in the mutation.
-func (m *PostMutation) Content() (r string, exists bool) {
+func (m *CommentMutation) Content() (r string, exists bool) {
 	v := m.content
 	if v == nil {
 		return
@@ -2326,10 +1859,10 @@ func (m *PostMutation) Content() (r string, exists bool) {
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *CommentMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
@@ -2344,391 +1877,692 @@ func (m *PostMutation) OldContent(ctx context.Context) (v string, err error) {
 }
 
 // ClearContent clears the value of the "content" field.
-func (m *PostMutation) ClearContent() {
+func (m *CommentMutation) ClearContent() {
 	m.content = nil
-	m.clearedFields[post.FieldContent] = struct{}{}
+	m.clearedFields[comment.FieldContent] = struct{}{}
 }
 
 // ContentCleared returns if the "content" field was cleared in this mutation.
-func (m *PostMutation) ContentCleared() bool {
-	_, ok := m.clearedFields[post.FieldContent]
+func (m *CommentMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[comment.FieldContent]
 	return ok
 }
 
 // ResetContent resets all changes to the "content" field.
-func (m *PostMutation) ResetContent() {
+func (m *CommentMutation) ResetContent() {
 	m.content = nil
-	delete(m.clearedFields, post.FieldContent)
+	delete(m.clearedFields, comment.FieldContent)
 }
 
-// SetEmbedding sets the "embedding" field.
-func (m *PostMutation) SetEmbedding(b []byte) {
-	m.embedding = &b
+// SetUserID sets the "user_id" field.
+func (m *CommentMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// Embedding returns the value of the "embedding" field in the mutation.
-func (m *PostMutation) Embedding() (r []byte, exists bool) {
-	v := m.embedding
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *CommentMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmbedding returns the old "embedding" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldEmbedding(ctx context.Context) (v []byte, err error) {
+func (m *CommentMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Embedding, nil
+	return oldValue.UserID, nil
 }
 
-// ClearEmbedding clears the value of the "embedding" field.
-func (m *PostMutation) ClearEmbedding() {
-	m.embedding = nil
-	m.clearedFields[post.FieldEmbedding] = struct{}{}
+// ResetUserID resets all changes to the "user_id" field.
+func (m *CommentMutation) ResetUserID() {
+	m.user = nil
 }
 
-// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
-func (m *PostMutation) EmbeddingCleared() bool {
-	_, ok := m.clearedFields[post.FieldEmbedding]
-	return ok
+// SetPostID sets the "post_id" field.
+func (m *CommentMutation) SetPostID(i int) {
+	m.post = &i
 }
 
-// ResetEmbedding resets all changes to the "embedding" field.
-func (m *PostMutation) ResetEmbedding() {
-	m.embedding = nil
-	delete(m.clearedFields, post.FieldEmbedding)
+// PostID returns the value of the "post_id" field in the mutation.
+func (m *CommentMutation) PostID() (r int, exists bool) {
+	v := m.post
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddUserIDs adds the "user" edge to the User entity by ids.
-func (m *PostMutation) AddUserIDs(ids ...int) {
-	if m.user == nil {
-		m.user = make(map[int]struct{})
+// OldPostID returns the old "post_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldPostID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.user[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostID: %w", err)
 	}
+	return oldValue.PostID, nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *PostMutation) ClearUser() {
-	m.cleareduser = true
+// ClearPostID clears the value of the "post_id" field.
+func (m *CommentMutation) ClearPostID() {
+	m.post = nil
+	m.clearedFields[comment.FieldPostID] = struct{}{}
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *PostMutation) UserCleared() bool {
-	return m.cleareduser
+// PostIDCleared returns if the "post_id" field was cleared in this mutation.
+func (m *CommentMutation) PostIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldPostID]
+	return ok
 }
 
-// RemoveUserIDs removes the "user" edge to the User entity by IDs.
-func (m *PostMutation) RemoveUserIDs(ids ...int) {
-	if m.removeduser == nil {
-		m.removeduser = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.user, ids[i])
-		m.removeduser[ids[i]] = struct{}{}
-	}
+// ResetPostID resets all changes to the "post_id" field.
+func (m *CommentMutation) ResetPostID() {
+	m.post = nil
+	delete(m.clearedFields, comment.FieldPostID)
 }
 
-// RemovedUser returns the removed IDs of the "user" edge to the User entity.
-func (m *PostMutation) RemovedUserIDs() (ids []int) {
-	for id := range m.removeduser {
-		ids = append(ids, id)
+// SetQuestionID sets the "question_id" field.
+func (m *CommentMutation) SetQuestionID(i int) {
+	m.question = &i
+}
+
+// QuestionID returns the value of the "question_id" field in the mutation.
+func (m *CommentMutation) QuestionID() (r int, exists bool) {
+	v := m.question
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-func (m *PostMutation) UserIDs() (ids []int) {
-	for id := range m.user {
-		ids = append(ids, id)
+// OldQuestionID returns the old "question_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldQuestionID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionID: %w", err)
+	}
+	return oldValue.QuestionID, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *PostMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-	m.removeduser = nil
+// ClearQuestionID clears the value of the "question_id" field.
+func (m *CommentMutation) ClearQuestionID() {
+	m.question = nil
+	m.clearedFields[comment.FieldQuestionID] = struct{}{}
 }
 
-// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
-func (m *PostMutation) AddCommentIDs(ids ...int) {
-	if m.comments == nil {
-		m.comments = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.comments[ids[i]] = struct{}{}
-	}
+// QuestionIDCleared returns if the "question_id" field was cleared in this mutation.
+func (m *CommentMutation) QuestionIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldQuestionID]
+	return ok
 }
 
-// ClearComments clears the "comments" edge to the Comment entity.
-func (m *PostMutation) ClearComments() {
-	m.clearedcomments = true
+// ResetQuestionID resets all changes to the "question_id" field.
+func (m *CommentMutation) ResetQuestionID() {
+	m.question = nil
+	delete(m.clearedFields, comment.FieldQuestionID)
 }
 
-// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
-func (m *PostMutation) CommentsCleared() bool {
-	return m.clearedcomments
+// SetAnswerID sets the "answer_id" field.
+func (m *CommentMutation) SetAnswerID(i int) {
+	m.answer = &i
 }
 
-// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
-func (m *PostMutation) RemoveCommentIDs(ids ...int) {
-	if m.removedcomments == nil {
-		m.removedcomments = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.comments, ids[i])
-		m.removedcomments[ids[i]] = struct{}{}
+// AnswerID returns the value of the "answer_id" field in the mutation.
+func (m *CommentMutation) AnswerID() (r int, exists bool) {
+	v := m.answer
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
-func (m *PostMutation) RemovedCommentsIDs() (ids []int) {
-	for id := range m.removedcomments {
-		ids = append(ids, id)
+// OldAnswerID returns the old "answer_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldAnswerID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswerID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswerID: %w", err)
+	}
+	return oldValue.AnswerID, nil
 }
 
-// CommentsIDs returns the "comments" edge IDs in the mutation.
-func (m *PostMutation) CommentsIDs() (ids []int) {
-	for id := range m.comments {
-		ids = append(ids, id)
-	}
-	return
+// ClearAnswerID clears the value of the "answer_id" field.
+func (m *CommentMutation) ClearAnswerID() {
+	m.answer = nil
+	m.clearedFields[comment.FieldAnswerID] = struct{}{}
 }
 
-// ResetComments resets all changes to the "comments" edge.
-func (m *PostMutation) ResetComments() {
-	m.comments = nil
-	m.clearedcomments = false
-	m.removedcomments = nil
+// AnswerIDCleared returns if the "answer_id" field was cleared in this mutation.
+func (m *CommentMutation) AnswerIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldAnswerID]
+	return ok
 }
 
-// AddTagIDs adds the "tags" edge to the PostTag entity by ids.
-func (m *PostMutation) AddTagIDs(ids ...int) {
-	if m.tags == nil {
-		m.tags = make(map[int]struct{})
+// ResetAnswerID resets all changes to the "answer_id" field.
+func (m *CommentMutation) ResetAnswerID() {
+	m.answer = nil
+	delete(m.clearedFields, comment.FieldAnswerID)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *CommentMutation) SetParentID(i int) {
+	m.parent = &i
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *CommentMutation) ParentID() (r int, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.tags[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldParentID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
 }
 
-// ClearTags clears the "tags" edge to the PostTag entity.
-func (m *PostMutation) ClearTags() {
-	m.clearedtags = true
+// ClearParentID clears the value of the "parent_id" field.
+func (m *CommentMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[comment.FieldParentID] = struct{}{}
 }
 
-// TagsCleared reports if the "tags" edge to the PostTag entity was cleared.
-func (m *PostMutation) TagsCleared() bool {
-	return m.clearedtags
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *CommentMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldParentID]
+	return ok
 }
 
-// RemoveTagIDs removes the "tags" edge to the PostTag entity by IDs.
-func (m *PostMutation) RemoveTagIDs(ids ...int) {
-	if m.removedtags == nil {
-		m.removedtags = make(map[int]struct{})
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *CommentMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, comment.FieldParentID)
+}
+
+// SetDepth sets the "depth" field.
+func (m *CommentMutation) SetDepth(i int) {
+	m.depth = &i
+	m.adddepth = nil
+}
+
+// Depth returns the value of the "depth" field in the mutation.
+func (m *CommentMutation) Depth() (r int, exists bool) {
+	v := m.depth
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.tags, ids[i])
-		m.removedtags[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldDepth returns the old "depth" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldDepth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDepth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDepth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDepth: %w", err)
 	}
+	return oldValue.Depth, nil
 }
 
-// RemovedTags returns the removed IDs of the "tags" edge to the PostTag entity.
-func (m *PostMutation) RemovedTagsIDs() (ids []int) {
-	for id := range m.removedtags {
-		ids = append(ids, id)
+// AddDepth adds i to the "depth" field.
+func (m *CommentMutation) AddDepth(i int) {
+	if m.adddepth != nil {
+		*m.adddepth += i
+	} else {
+		m.adddepth = &i
 	}
-	return
 }
 
-// TagsIDs returns the "tags" edge IDs in the mutation.
-func (m *PostMutation) TagsIDs() (ids []int) {
-	for id := range m.tags {
-		ids = append(ids, id)
+// AddedDepth returns the value that was added to the "depth" field in this mutation.
+func (m *CommentMutation) AddedDepth() (r int, exists bool) {
+	v := m.adddepth
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetTags resets all changes to the "tags" edge.
-func (m *PostMutation) ResetTags() {
-	m.tags = nil
-	m.clearedtags = false
-	m.removedtags = nil
+// ResetDepth resets all changes to the "depth" field.
+func (m *CommentMutation) ResetDepth() {
+	m.depth = nil
+	m.adddepth = nil
 }
 
-// AddLikeIDs adds the "likes" edge to the UserLikePost entity by ids.
-func (m *PostMutation) AddLikeIDs(ids ...int) {
-	if m.likes == nil {
-		m.likes = make(map[int]struct{})
+// SetReplyToUserID sets the "reply_to_user_id" field.
+func (m *CommentMutation) SetReplyToUserID(i int) {
+	m.reply_to_user = &i
+}
+
+// ReplyToUserID returns the value of the "reply_to_user_id" field in the mutation.
+func (m *CommentMutation) ReplyToUserID() (r int, exists bool) {
+	v := m.reply_to_user
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.likes[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldReplyToUserID returns the old "reply_to_user_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldReplyToUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplyToUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplyToUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplyToUserID: %w", err)
 	}
+	return oldValue.ReplyToUserID, nil
 }
 
-// ClearLikes clears the "likes" edge to the UserLikePost entity.
-func (m *PostMutation) ClearLikes() {
-	m.clearedlikes = true
+// ClearReplyToUserID clears the value of the "reply_to_user_id" field.
+func (m *CommentMutation) ClearReplyToUserID() {
+	m.reply_to_user = nil
+	m.clearedFields[comment.FieldReplyToUserID] = struct{}{}
 }
 
-// LikesCleared reports if the "likes" edge to the UserLikePost entity was cleared.
-func (m *PostMutation) LikesCleared() bool {
-	return m.clearedlikes
+// ReplyToUserIDCleared returns if the "reply_to_user_id" field was cleared in this mutation.
+func (m *CommentMutation) ReplyToUserIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldReplyToUserID]
+	return ok
 }
 
-// RemoveLikeIDs removes the "likes" edge to the UserLikePost entity by IDs.
-func (m *PostMutation) RemoveLikeIDs(ids ...int) {
-	if m.removedlikes == nil {
-		m.removedlikes = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.likes, ids[i])
-		m.removedlikes[ids[i]] = struct{}{}
-	}
+// ResetReplyToUserID resets all changes to the "reply_to_user_id" field.
+func (m *CommentMutation) ResetReplyToUserID() {
+	m.reply_to_user = nil
+	delete(m.clearedFields, comment.FieldReplyToUserID)
 }
 
-// RemovedLikes returns the removed IDs of the "likes" edge to the UserLikePost entity.
-func (m *PostMutation) RemovedLikesIDs() (ids []int) {
-	for id := range m.removedlikes {
-		ids = append(ids, id)
+// SetDeletedAt sets the "deleted_at" field.
+func (m *CommentMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *CommentMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// LikesIDs returns the "likes" edge IDs in the mutation.
-func (m *PostMutation) LikesIDs() (ids []int) {
-	for id := range m.likes {
-		ids = append(ids, id)
+// OldDeletedAt returns the old "deleted_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
 }
 
-// ResetLikes resets all changes to the "likes" edge.
-func (m *PostMutation) ResetLikes() {
-	m.likes = nil
-	m.clearedlikes = false
-	m.removedlikes = nil
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *CommentMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[comment.FieldDeletedAt] = struct{}{}
 }
 
-// AddFavoriteIDs adds the "favorites" edge to the UserFavoritePost entity by ids.
-func (m *PostMutation) AddFavoriteIDs(ids ...int) {
-	if m.favorites == nil {
-		m.favorites = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.favorites[ids[i]] = struct{}{}
-	}
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *CommentMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[comment.FieldDeletedAt]
+	return ok
 }
 
-// ClearFavorites clears the "favorites" edge to the UserFavoritePost entity.
-func (m *PostMutation) ClearFavorites() {
-	m.clearedfavorites = true
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *CommentMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, comment.FieldDeletedAt)
 }
 
-// FavoritesCleared reports if the "favorites" edge to the UserFavoritePost entity was cleared.
-func (m *PostMutation) FavoritesCleared() bool {
-	return m.clearedfavorites
+// ClearUser clears the "user" edge to the User entity.
+func (m *CommentMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[comment.FieldUserID] = struct{}{}
 }
 
-// RemoveFavoriteIDs removes the "favorites" edge to the UserFavoritePost entity by IDs.
-func (m *PostMutation) RemoveFavoriteIDs(ids ...int) {
-	if m.removedfavorites == nil {
-		m.removedfavorites = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.favorites, ids[i])
-		m.removedfavorites[ids[i]] = struct{}{}
-	}
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *CommentMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// RemovedFavorites returns the removed IDs of the "favorites" edge to the UserFavoritePost entity.
-func (m *PostMutation) RemovedFavoritesIDs() (ids []int) {
-	for id := range m.removedfavorites {
-		ids = append(ids, id)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// FavoritesIDs returns the "favorites" edge IDs in the mutation.
-func (m *PostMutation) FavoritesIDs() (ids []int) {
-	for id := range m.favorites {
-		ids = append(ids, id)
-	}
-	return
+// ResetUser resets all changes to the "user" edge.
+func (m *CommentMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// ResetFavorites resets all changes to the "favorites" edge.
-func (m *PostMutation) ResetFavorites() {
-	m.favorites = nil
-	m.clearedfavorites = false
-	m.removedfavorites = nil
+// ClearPost clears the "post" edge to the Post entity.
+func (m *CommentMutation) ClearPost() {
+	m.clearedpost = true
+	m.clearedFields[comment.FieldPostID] = struct{}{}
 }
 
-// Where appends a list predicates to the PostMutation builder.
-func (m *PostMutation) Where(ps ...predicate.Post) {
-	m.predicates = append(m.predicates, ps...)
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *CommentMutation) PostCleared() bool {
+	return m.PostIDCleared() || m.clearedpost
 }
 
-// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Post, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) PostIDs() (ids []int) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
 	}
-	m.Where(p...)
+	return
 }
 
-// Op returns the operation name.
-func (m *PostMutation) Op() Op {
-	return m.op
+// ResetPost resets all changes to the "post" edge.
+func (m *CommentMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
 }
 
-// SetOp allows setting the mutation operation.
-func (m *PostMutation) SetOp(op Op) {
-	m.op = op
+// ClearQuestion clears the "question" edge to the Question entity.
+func (m *CommentMutation) ClearQuestion() {
+	m.clearedquestion = true
+	m.clearedFields[comment.FieldQuestionID] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Post).
-func (m *PostMutation) Type() string {
-	return m.typ
+// QuestionCleared reports if the "question" edge to the Question entity was cleared.
+func (m *CommentMutation) QuestionCleared() bool {
+	return m.QuestionIDCleared() || m.clearedquestion
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PostMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.create_time != nil {
-		fields = append(fields, post.FieldCreateTime)
+// QuestionIDs returns the "question" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuestionID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) QuestionIDs() (ids []int) {
+	if id := m.question; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.update_time != nil {
-		fields = append(fields, post.FieldUpdateTime)
+	return
+}
+
+// ResetQuestion resets all changes to the "question" edge.
+func (m *CommentMutation) ResetQuestion() {
+	m.question = nil
+	m.clearedquestion = false
+}
+
+// ClearAnswer clears the "answer" edge to the Answer entity.
+func (m *CommentMutation) ClearAnswer() {
+	m.clearedanswer = true
+	m.clearedFields[comment.FieldAnswerID] = struct{}{}
+}
+
+// AnswerCleared reports if the "answer" edge to the Answer entity was cleared.
+func (m *CommentMutation) AnswerCleared() bool {
+	return m.AnswerIDCleared() || m.clearedanswer
+}
+
+// AnswerIDs returns the "answer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AnswerID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) AnswerIDs() (ids []int) {
+	if id := m.answer; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.title != nil {
-		fields = append(fields, post.FieldTitle)
+	return
+}
+
+// ResetAnswer resets all changes to the "answer" edge.
+func (m *CommentMutation) ResetAnswer() {
+	m.answer = nil
+	m.clearedanswer = false
+}
+
+// ClearReplyToUser clears the "reply_to_user" edge to the User entity.
+func (m *CommentMutation) ClearReplyToUser() {
+	m.clearedreply_to_user = true
+	m.clearedFields[comment.FieldReplyToUserID] = struct{}{}
+}
+
+// ReplyToUserCleared reports if the "reply_to_user" edge to the User entity was cleared.
+func (m *CommentMutation) ReplyToUserCleared() bool {
+	return m.ReplyToUserIDCleared() || m.clearedreply_to_user
+}
+
+// ReplyToUserIDs returns the "reply_to_user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReplyToUserID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) ReplyToUserIDs() (ids []int) {
+	if id := m.reply_to_user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReplyToUser resets all changes to the "reply_to_user" edge.
+func (m *CommentMutation) ResetReplyToUser() {
+	m.reply_to_user = nil
+	m.clearedreply_to_user = false
+}
+
+// ClearParent clears the "parent" edge to the Comment entity.
+func (m *CommentMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[comment.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Comment entity was cleared.
+func (m *CommentMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *CommentMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddReplyIDs adds the "replies" edge to the Comment entity by ids.
+func (m *CommentMutation) AddReplyIDs(ids ...int) {
+	if m.replies == nil {
+		m.replies = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.replies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReplies clears the "replies" edge to the Comment entity.
+func (m *CommentMutation) ClearReplies() {
+	m.clearedreplies = true
+}
+
+// RepliesCleared reports if the "replies" edge to the Comment entity was cleared.
+func (m *CommentMutation) RepliesCleared() bool {
+	return m.clearedreplies
+}
+
+// RemoveReplyIDs removes the "replies" edge to the Comment entity by IDs.
+func (m *CommentMutation) RemoveReplyIDs(ids ...int) {
+	if m.removedreplies == nil {
+		m.removedreplies = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.replies, ids[i])
+		m.removedreplies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReplies returns the removed IDs of the "replies" edge to the Comment entity.
+func (m *CommentMutation) RemovedRepliesIDs() (ids []int) {
+	for id := range m.removedreplies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RepliesIDs returns the "replies" edge IDs in the mutation.
+func (m *CommentMutation) RepliesIDs() (ids []int) {
+	for id := range m.replies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReplies resets all changes to the "replies" edge.
+func (m *CommentMutation) ResetReplies() {
+	m.replies = nil
+	m.clearedreplies = false
+	m.removedreplies = nil
+}
+
+// Where appends a list predicates to the CommentMutation builder.
+func (m *CommentMutation) Where(ps ...predicate.Comment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Comment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CommentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CommentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Comment).
+func (m *CommentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CommentMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.create_time != nil {
+		fields = append(fields, comment.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, comment.FieldUpdateTime)
 	}
 	if m.content != nil {
-		fields = append(fields, post.FieldContent)
+		fields = append(fields, comment.FieldContent)
 	}
-	if m.embedding != nil {
-		fields = append(fields, post.FieldEmbedding)
+	if m.user != nil {
+		fields = append(fields, comment.FieldUserID)
+	}
+	if m.post != nil {
+		fields = append(fields, comment.FieldPostID)
+	}
+	if m.question != nil {
+		fields = append(fields, comment.FieldQuestionID)
+	}
+	if m.answer != nil {
+		fields = append(fields, comment.FieldAnswerID)
+	}
+	if m.parent != nil {
+		fields = append(fields, comment.FieldParentID)
+	}
+	if m.depth != nil {
+		fields = append(fields, comment.FieldDepth)
+	}
+	if m.reply_to_user != nil {
+		fields = append(fields, comment.FieldReplyToUserID)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, comment.FieldDeletedAt)
 	}
 	return fields
 }
@@ -2736,18 +2570,30 @@ func (m *PostMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PostMutation) Field(name string) (ent.Value, bool) {
+func (m *CommentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case post.FieldCreateTime:
+	case comment.FieldCreateTime:
 		return m.CreateTime()
-	case post.FieldUpdateTime:
+	case comment.FieldUpdateTime:
 		return m.UpdateTime()
-	case post.FieldTitle:
-		return m.Title()
-	case post.FieldContent:
+	case comment.FieldContent:
 		return m.Content()
-	case post.FieldEmbedding:
-		return m.Embedding()
+	case comment.FieldUserID:
+		return m.UserID()
+	case comment.FieldPostID:
+		return m.PostID()
+	case comment.FieldQuestionID:
+		return m.QuestionID()
+	case comment.FieldAnswerID:
+		return m.AnswerID()
+	case comment.FieldParentID:
+		return m.ParentID()
+	case comment.FieldDepth:
+		return m.Depth()
+	case comment.FieldReplyToUserID:
+		return m.ReplyToUserID()
+	case comment.FieldDeletedAt:
+		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -2755,197 +2601,320 @@ func (m *PostMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case post.FieldCreateTime:
+	case comment.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case post.FieldUpdateTime:
+	case comment.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case post.FieldTitle:
-		return m.OldTitle(ctx)
-	case post.FieldContent:
+	case comment.FieldContent:
 		return m.OldContent(ctx)
-	case post.FieldEmbedding:
-		return m.OldEmbedding(ctx)
+	case comment.FieldUserID:
+		return m.OldUserID(ctx)
+	case comment.FieldPostID:
+		return m.OldPostID(ctx)
+	case comment.FieldQuestionID:
+		return m.OldQuestionID(ctx)
+	case comment.FieldAnswerID:
+		return m.OldAnswerID(ctx)
+	case comment.FieldParentID:
+		return m.OldParentID(ctx)
+	case comment.FieldDepth:
+		return m.OldDepth(ctx)
+	case comment.FieldReplyToUserID:
+		return m.OldReplyToUserID(ctx)
+	case comment.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Post field %s", name)
+	return nil, fmt.Errorf("unknown Comment field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostMutation) SetField(name string, value ent.Value) error {
+func (m *CommentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case post.FieldCreateTime:
+	case comment.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case post.FieldUpdateTime:
+	case comment.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case post.FieldTitle:
+	case comment.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetContent(v)
 		return nil
-	case post.FieldContent:
-		v, ok := value.(string)
+	case comment.FieldUserID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetUserID(v)
 		return nil
-	case post.FieldEmbedding:
-		v, ok := value.([]byte)
+	case comment.FieldPostID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmbedding(v)
+		m.SetPostID(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Post field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *PostMutation) AddedFields() []string {
-	return nil
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PostMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Post numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
+	case comment.FieldQuestionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionID(v)
+		return nil
+	case comment.FieldAnswerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerID(v)
+		return nil
+	case comment.FieldParentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case comment.FieldDepth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDepth(v)
+		return nil
+	case comment.FieldReplyToUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplyToUserID(v)
+		return nil
+	case comment.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Comment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CommentMutation) AddedFields() []string {
+	var fields []string
+	if m.adddepth != nil {
+		fields = append(fields, comment.FieldDepth)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case comment.FieldDepth:
+		return m.AddedDepth()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case comment.FieldDepth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDepth(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Comment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PostMutation) ClearedFields() []string {
+func (m *CommentMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(post.FieldContent) {
-		fields = append(fields, post.FieldContent)
+	if m.FieldCleared(comment.FieldContent) {
+		fields = append(fields, comment.FieldContent)
 	}
-	if m.FieldCleared(post.FieldEmbedding) {
-		fields = append(fields, post.FieldEmbedding)
+	if m.FieldCleared(comment.FieldPostID) {
+		fields = append(fields, comment.FieldPostID)
+	}
+	if m.FieldCleared(comment.FieldQuestionID) {
+		fields = append(fields, comment.FieldQuestionID)
+	}
+	if m.FieldCleared(comment.FieldAnswerID) {
+		fields = append(fields, comment.FieldAnswerID)
+	}
+	if m.FieldCleared(comment.FieldParentID) {
+		fields = append(fields, comment.FieldParentID)
+	}
+	if m.FieldCleared(comment.FieldReplyToUserID) {
+		fields = append(fields, comment.FieldReplyToUserID)
+	}
+	if m.FieldCleared(comment.FieldDeletedAt) {
+		fields = append(fields, comment.FieldDeletedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PostMutation) FieldCleared(name string) bool {
+func (m *CommentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PostMutation) ClearField(name string) error {
+func (m *CommentMutation) ClearField(name string) error {
 	switch name {
-	case post.FieldContent:
+	case comment.FieldContent:
 		m.ClearContent()
 		return nil
-	case post.FieldEmbedding:
-		m.ClearEmbedding()
+	case comment.FieldPostID:
+		m.ClearPostID()
+		return nil
+	case comment.FieldQuestionID:
+		m.ClearQuestionID()
+		return nil
+	case comment.FieldAnswerID:
+		m.ClearAnswerID()
+		return nil
+	case comment.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case comment.FieldReplyToUserID:
+		m.ClearReplyToUserID()
+		return nil
+	case comment.FieldDeletedAt:
+		m.ClearDeletedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Post nullable field %s", name)
+	return fmt.Errorf("unknown Comment nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PostMutation) ResetField(name string) error {
+func (m *CommentMutation) ResetField(name string) error {
 	switch name {
-	case post.FieldCreateTime:
+	case comment.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case post.FieldUpdateTime:
+	case comment.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case post.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case post.FieldContent:
+	case comment.FieldContent:
 		m.ResetContent()
 		return nil
-	case post.FieldEmbedding:
-		m.ResetEmbedding()
+	case comment.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case comment.FieldPostID:
+		m.ResetPostID()
+		return nil
+	case comment.FieldQuestionID:
+		m.ResetQuestionID()
+		return nil
+	case comment.FieldAnswerID:
+		m.ResetAnswerID()
+		return nil
+	case comment.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case comment.FieldDepth:
+		m.ResetDepth()
+		return nil
+	case comment.FieldReplyToUserID:
+		m.ResetReplyToUserID()
+		return nil
+	case comment.FieldDeletedAt:
+		m.ResetDeletedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Post field %s", name)
+	return fmt.Errorf("unknown Comment field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PostMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
+func (m *CommentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
 	if m.user != nil {
-		edges = append(edges, post.EdgeUser)
+		edges = append(edges, comment.EdgeUser)
 	}
-	if m.comments != nil {
-		edges = append(edges, post.EdgeComments)
+	if m.post != nil {
+		edges = append(edges, comment.EdgePost)
 	}
-	if m.tags != nil {
-		edges = append(edges, post.EdgeTags)
+	if m.question != nil {
+		edges = append(edges, comment.EdgeQuestion)
 	}
-	if m.likes != nil {
-		edges = append(edges, post.EdgeLikes)
+	if m.answer != nil {
+		edges = append(edges, comment.EdgeAnswer)
 	}
-	if m.favorites != nil {
-		edges = append(edges, post.EdgeFavorites)
+	if m.reply_to_user != nil {
+		edges = append(edges, comment.EdgeReplyToUser)
+	}
+	if m.parent != nil {
+		edges = append(edges, comment.EdgeParent)
+	}
+	if m.replies != nil {
+		edges = append(edges, comment.EdgeReplies)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PostMutation) AddedIDs(name string) []ent.Value {
+func (m *CommentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case post.EdgeUser:
-		ids := make([]ent.Value, 0, len(m.user))
-		for id := range m.user {
-			ids = append(ids, id)
+	case comment.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case post.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.comments))
-		for id := range m.comments {
-			ids = append(ids, id)
+	case comment.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case post.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.tags))
-		for id := range m.tags {
-			ids = append(ids, id)
+	case comment.EdgeQuestion:
+		if id := m.question; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case post.EdgeLikes:
-		ids := make([]ent.Value, 0, len(m.likes))
-		for id := range m.likes {
-			ids = append(ids, id)
+	case comment.EdgeAnswer:
+		if id := m.answer; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case post.EdgeFavorites:
-		ids := make([]ent.Value, 0, len(m.favorites))
-		for id := range m.favorites {
+	case comment.EdgeReplyToUser:
+		if id := m.reply_to_user; id != nil {
+			return []ent.Value{*id}
+		}
+	case comment.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case comment.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.replies))
+		for id := range m.replies {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2954,57 +2923,21 @@ func (m *PostMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PostMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removeduser != nil {
-		edges = append(edges, post.EdgeUser)
-	}
-	if m.removedcomments != nil {
-		edges = append(edges, post.EdgeComments)
-	}
-	if m.removedtags != nil {
-		edges = append(edges, post.EdgeTags)
-	}
-	if m.removedlikes != nil {
-		edges = append(edges, post.EdgeLikes)
-	}
-	if m.removedfavorites != nil {
-		edges = append(edges, post.EdgeFavorites)
+func (m *CommentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedreplies != nil {
+		edges = append(edges, comment.EdgeReplies)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PostMutation) RemovedIDs(name string) []ent.Value {
+func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case post.EdgeUser:
-		ids := make([]ent.Value, 0, len(m.removeduser))
-		for id := range m.removeduser {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.removedcomments))
-		for id := range m.removedcomments {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.removedtags))
-		for id := range m.removedtags {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeLikes:
-		ids := make([]ent.Value, 0, len(m.removedlikes))
-		for id := range m.removedlikes {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeFavorites:
-		ids := make([]ent.Value, 0, len(m.removedfavorites))
-		for id := range m.removedfavorites {
+	case comment.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.removedreplies))
+		for id := range m.removedreplies {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3013,108 +2946,138 @@ func (m *PostMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PostMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
+func (m *CommentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
 	if m.cleareduser {
-		edges = append(edges, post.EdgeUser)
+		edges = append(edges, comment.EdgeUser)
 	}
-	if m.clearedcomments {
-		edges = append(edges, post.EdgeComments)
+	if m.clearedpost {
+		edges = append(edges, comment.EdgePost)
 	}
-	if m.clearedtags {
-		edges = append(edges, post.EdgeTags)
+	if m.clearedquestion {
+		edges = append(edges, comment.EdgeQuestion)
 	}
-	if m.clearedlikes {
-		edges = append(edges, post.EdgeLikes)
+	if m.clearedanswer {
+		edges = append(edges, comment.EdgeAnswer)
 	}
-	if m.clearedfavorites {
-		edges = append(edges, post.EdgeFavorites)
+	if m.clearedreply_to_user {
+		edges = append(edges, comment.EdgeReplyToUser)
+	}
+	if m.clearedparent {
+		edges = append(edges, comment.EdgeParent)
+	}
+	if m.clearedreplies {
+		edges = append(edges, comment.EdgeReplies)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PostMutation) EdgeCleared(name string) bool {
+func (m *CommentMutation) EdgeCleared(name string) bool {
 	switch name {
-	case post.EdgeUser:
+	case comment.EdgeUser:
 		return m.cleareduser
-	case post.EdgeComments:
-		return m.clearedcomments
-	case post.EdgeTags:
-		return m.clearedtags
-	case post.EdgeLikes:
-		return m.clearedlikes
-	case post.EdgeFavorites:
-		return m.clearedfavorites
+	case comment.EdgePost:
+		return m.clearedpost
+	case comment.EdgeQuestion:
+		return m.clearedquestion
+	case comment.EdgeAnswer:
+		return m.clearedanswer
+	case comment.EdgeReplyToUser:
+		return m.clearedreply_to_user
+	case comment.EdgeParent:
+		return m.clearedparent
+	case comment.EdgeReplies:
+		return m.clearedreplies
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PostMutation) ClearEdge(name string) error {
+func (m *CommentMutation) ClearEdge(name string) error {
 	switch name {
+	case comment.EdgeUser:
+		m.ClearUser()
+		return nil
+	case comment.EdgePost:
+		m.ClearPost()
+		return nil
+	case comment.EdgeQuestion:
+		m.ClearQuestion()
+		return nil
+	case comment.EdgeAnswer:
+		m.ClearAnswer()
+		return nil
+	case comment.EdgeReplyToUser:
+		m.ClearReplyToUser()
+		return nil
+	case comment.EdgeParent:
+		m.ClearParent()
+		return nil
 	}
-	return fmt.Errorf("unknown Post unique edge %s", name)
+	return fmt.Errorf("unknown Comment unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PostMutation) ResetEdge(name string) error {
+func (m *CommentMutation) ResetEdge(name string) error {
 	switch name {
-	case post.EdgeUser:
+	case comment.EdgeUser:
 		m.ResetUser()
 		return nil
-	case post.EdgeComments:
-		m.ResetComments()
+	case comment.EdgePost:
+		m.ResetPost()
 		return nil
-	case post.EdgeTags:
-		m.ResetTags()
+	case comment.EdgeQuestion:
+		m.ResetQuestion()
 		return nil
-	case post.EdgeLikes:
-		m.ResetLikes()
+	case comment.EdgeAnswer:
+		m.ResetAnswer()
 		return nil
-	case post.EdgeFavorites:
-		m.ResetFavorites()
+	case comment.EdgeReplyToUser:
+		m.ResetReplyToUser()
+		return nil
+	case comment.EdgeParent:
+		m.ResetParent()
+		return nil
+	case comment.EdgeReplies:
+		m.ResetReplies()
 		return nil
 	}
-	return fmt.Errorf("unknown Post edge %s", name)
+	return fmt.Errorf("unknown Comment edge %s", name)
 }
 
-// PostTagMutation represents an operation that mutates the PostTag nodes in the graph.
-type PostTagMutation struct {
+// FollowMutation represents an operation that mutates the Follow nodes in the graph.
+type FollowMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	post_id       *int
-	addpost_id    *int
-	tag_id        *int
-	addtag_id     *int
-	clearedFields map[string]struct{}
-	post          map[int]struct{}
-	removedpost   map[int]struct{}
-	clearedpost   bool
-	tag           map[int]struct{}
-	removedtag    map[int]struct{}
-	clearedtag    bool
-	done          bool
-	oldValue      func(context.Context) (*PostTag, error)
-	predicates    []predicate.PostTag
+	op               Op
+	typ              string
+	id               *int
+	create_time      *time.Time
+	update_time      *time.Time
+	clearedFields    map[string]struct{}
+	follower         *int
+	clearedfollower  bool
+	following        *int
+	clearedfollowing bool
+	done             bool
+	oldValue         func(context.Context) (*Follow, error)
+	predicates       []predicate.Follow
 }
 
-var _ ent.Mutation = (*PostTagMutation)(nil)
+var _ ent.Mutation = (*FollowMutation)(nil)
 
-// posttagOption allows management of the mutation configuration using functional options.
-type posttagOption func(*PostTagMutation)
+// followOption allows management of the mutation configuration using functional options.
+type followOption func(*FollowMutation)
 
-// newPostTagMutation creates new mutation for the PostTag entity.
-func newPostTagMutation(c config, op Op, opts ...posttagOption) *PostTagMutation {
-	m := &PostTagMutation{
+// newFollowMutation creates new mutation for the Follow entity.
+func newFollowMutation(c config, op Op, opts ...followOption) *FollowMutation {
+	m := &FollowMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePostTag,
+		typ:           TypeFollow,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3123,20 +3086,20 @@ func newPostTagMutation(c config, op Op, opts ...posttagOption) *PostTagMutation
 	return m
 }
 
-// withPostTagID sets the ID field of the mutation.
-func withPostTagID(id int) posttagOption {
-	return func(m *PostTagMutation) {
+// withFollowID sets the ID field of the mutation.
+func withFollowID(id int) followOption {
+	return func(m *FollowMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PostTag
+			value *Follow
 		)
-		m.oldValue = func(ctx context.Context) (*PostTag, error) {
+		m.oldValue = func(ctx context.Context) (*Follow, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PostTag.Get(ctx, id)
+					value, err = m.Client().Follow.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3145,10 +3108,10 @@ func withPostTagID(id int) posttagOption {
 	}
 }
 
-// withPostTag sets the old PostTag of the mutation.
-func withPostTag(node *PostTag) posttagOption {
-	return func(m *PostTagMutation) {
-		m.oldValue = func(context.Context) (*PostTag, error) {
+// withFollow sets the old Follow of the mutation.
+func withFollow(node *Follow) followOption {
+	return func(m *FollowMutation) {
+		m.oldValue = func(context.Context) (*Follow, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3157,7 +3120,7 @@ func withPostTag(node *PostTag) posttagOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PostTagMutation) Client() *Client {
+func (m FollowMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3165,7 +3128,7 @@ func (m PostTagMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PostTagMutation) Tx() (*Tx, error) {
+func (m FollowMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3176,7 +3139,7 @@ func (m PostTagMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PostTagMutation) ID() (id int, exists bool) {
+func (m *FollowMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3187,7 +3150,7 @@ func (m *PostTagMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PostTagMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *FollowMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3196,241 +3159,219 @@ func (m *PostTagMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PostTag.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Follow.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetPostID sets the "post_id" field.
-func (m *PostTagMutation) SetPostID(i int) {
-	m.post_id = &i
-	m.addpost_id = nil
+// SetCreateTime sets the "create_time" field.
+func (m *FollowMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// PostID returns the value of the "post_id" field in the mutation.
-func (m *PostTagMutation) PostID() (r int, exists bool) {
-	v := m.post_id
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *FollowMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPostID returns the old "post_id" field's value of the PostTag entity.
-// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Follow entity.
+// If the Follow object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostTagMutation) OldPostID(ctx context.Context) (v int, err error) {
+func (m *FollowMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPostID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPostID requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPostID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.PostID, nil
+	return oldValue.CreateTime, nil
 }
 
-// AddPostID adds i to the "post_id" field.
-func (m *PostTagMutation) AddPostID(i int) {
-	if m.addpost_id != nil {
-		*m.addpost_id += i
-	} else {
-		m.addpost_id = &i
-	}
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *FollowMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *FollowMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// AddedPostID returns the value that was added to the "post_id" field in this mutation.
-func (m *PostTagMutation) AddedPostID() (r int, exists bool) {
-	v := m.addpost_id
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *FollowMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPostID resets all changes to the "post_id" field.
-func (m *PostTagMutation) ResetPostID() {
-	m.post_id = nil
-	m.addpost_id = nil
+// OldUpdateTime returns the old "update_time" field's value of the Follow entity.
+// If the Follow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FollowMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
 }
 
-// SetTagID sets the "tag_id" field.
-func (m *PostTagMutation) SetTagID(i int) {
-	m.tag_id = &i
-	m.addtag_id = nil
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *FollowMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// TagID returns the value of the "tag_id" field in the mutation.
-func (m *PostTagMutation) TagID() (r int, exists bool) {
-	v := m.tag_id
+// SetFollowerID sets the "follower_id" field.
+func (m *FollowMutation) SetFollowerID(i int) {
+	m.follower = &i
+}
+
+// FollowerID returns the value of the "follower_id" field in the mutation.
+func (m *FollowMutation) FollowerID() (r int, exists bool) {
+	v := m.follower
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTagID returns the old "tag_id" field's value of the PostTag entity.
-// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
+// OldFollowerID returns the old "follower_id" field's value of the Follow entity.
+// If the Follow object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostTagMutation) OldTagID(ctx context.Context) (v int, err error) {
+func (m *FollowMutation) OldFollowerID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTagID is only allowed on UpdateOne operations")
+		return v, errors.New("OldFollowerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTagID requires an ID field in the mutation")
+		return v, errors.New("OldFollowerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTagID: %w", err)
+		return v, fmt.Errorf("querying old value for OldFollowerID: %w", err)
 	}
-	return oldValue.TagID, nil
+	return oldValue.FollowerID, nil
 }
 
-// AddTagID adds i to the "tag_id" field.
-func (m *PostTagMutation) AddTagID(i int) {
-	if m.addtag_id != nil {
-		*m.addtag_id += i
-	} else {
-		m.addtag_id = &i
-	}
+// ResetFollowerID resets all changes to the "follower_id" field.
+func (m *FollowMutation) ResetFollowerID() {
+	m.follower = nil
+}
+
+// SetFollowingID sets the "following_id" field.
+func (m *FollowMutation) SetFollowingID(i int) {
+	m.following = &i
 }
 
-// AddedTagID returns the value that was added to the "tag_id" field in this mutation.
-func (m *PostTagMutation) AddedTagID() (r int, exists bool) {
-	v := m.addtag_id
+// FollowingID returns the value of the "following_id" field in the mutation.
+func (m *FollowMutation) FollowingID() (r int, exists bool) {
+	v := m.following
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTagID resets all changes to the "tag_id" field.
-func (m *PostTagMutation) ResetTagID() {
-	m.tag_id = nil
-	m.addtag_id = nil
-}
-
-// AddPostIDs adds the "post" edge to the Post entity by ids.
-func (m *PostTagMutation) AddPostIDs(ids ...int) {
-	if m.post == nil {
-		m.post = make(map[int]struct{})
+// OldFollowingID returns the old "following_id" field's value of the Follow entity.
+// If the Follow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FollowMutation) OldFollowingID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFollowingID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.post[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFollowingID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFollowingID: %w", err)
 	}
+	return oldValue.FollowingID, nil
 }
 
-// ClearPost clears the "post" edge to the Post entity.
-func (m *PostTagMutation) ClearPost() {
-	m.clearedpost = true
+// ResetFollowingID resets all changes to the "following_id" field.
+func (m *FollowMutation) ResetFollowingID() {
+	m.following = nil
 }
 
-// PostCleared reports if the "post" edge to the Post entity was cleared.
-func (m *PostTagMutation) PostCleared() bool {
-	return m.clearedpost
-}
-
-// RemovePostIDs removes the "post" edge to the Post entity by IDs.
-func (m *PostTagMutation) RemovePostIDs(ids ...int) {
-	if m.removedpost == nil {
-		m.removedpost = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.post, ids[i])
-		m.removedpost[ids[i]] = struct{}{}
-	}
+// ClearFollower clears the "follower" edge to the User entity.
+func (m *FollowMutation) ClearFollower() {
+	m.clearedfollower = true
+	m.clearedFields[follow.FieldFollowerID] = struct{}{}
 }
 
-// RemovedPost returns the removed IDs of the "post" edge to the Post entity.
-func (m *PostTagMutation) RemovedPostIDs() (ids []int) {
-	for id := range m.removedpost {
-		ids = append(ids, id)
-	}
-	return
+// FollowerCleared reports if the "follower" edge to the User entity was cleared.
+func (m *FollowMutation) FollowerCleared() bool {
+	return m.clearedfollower
 }
 
-// PostIDs returns the "post" edge IDs in the mutation.
-func (m *PostTagMutation) PostIDs() (ids []int) {
-	for id := range m.post {
-		ids = append(ids, id)
+// FollowerIDs returns the "follower" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FollowerID instead. It exists only for internal usage by the builders.
+func (m *FollowMutation) FollowerIDs() (ids []int) {
+	if id := m.follower; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPost resets all changes to the "post" edge.
-func (m *PostTagMutation) ResetPost() {
-	m.post = nil
-	m.clearedpost = false
-	m.removedpost = nil
-}
-
-// AddTagIDs adds the "tag" edge to the Tag entity by ids.
-func (m *PostTagMutation) AddTagIDs(ids ...int) {
-	if m.tag == nil {
-		m.tag = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.tag[ids[i]] = struct{}{}
-	}
-}
-
-// ClearTag clears the "tag" edge to the Tag entity.
-func (m *PostTagMutation) ClearTag() {
-	m.clearedtag = true
-}
-
-// TagCleared reports if the "tag" edge to the Tag entity was cleared.
-func (m *PostTagMutation) TagCleared() bool {
-	return m.clearedtag
+// ResetFollower resets all changes to the "follower" edge.
+func (m *FollowMutation) ResetFollower() {
+	m.follower = nil
+	m.clearedfollower = false
 }
 
-// RemoveTagIDs removes the "tag" edge to the Tag entity by IDs.
-func (m *PostTagMutation) RemoveTagIDs(ids ...int) {
-	if m.removedtag == nil {
-		m.removedtag = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.tag, ids[i])
-		m.removedtag[ids[i]] = struct{}{}
-	}
+// ClearFollowing clears the "following" edge to the User entity.
+func (m *FollowMutation) ClearFollowing() {
+	m.clearedfollowing = true
+	m.clearedFields[follow.FieldFollowingID] = struct{}{}
 }
 
-// RemovedTag returns the removed IDs of the "tag" edge to the Tag entity.
-func (m *PostTagMutation) RemovedTagIDs() (ids []int) {
-	for id := range m.removedtag {
-		ids = append(ids, id)
-	}
-	return
+// FollowingCleared reports if the "following" edge to the User entity was cleared.
+func (m *FollowMutation) FollowingCleared() bool {
+	return m.clearedfollowing
 }
 
-// TagIDs returns the "tag" edge IDs in the mutation.
-func (m *PostTagMutation) TagIDs() (ids []int) {
-	for id := range m.tag {
-		ids = append(ids, id)
+// FollowingIDs returns the "following" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FollowingID instead. It exists only for internal usage by the builders.
+func (m *FollowMutation) FollowingIDs() (ids []int) {
+	if id := m.following; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTag resets all changes to the "tag" edge.
-func (m *PostTagMutation) ResetTag() {
-	m.tag = nil
-	m.clearedtag = false
-	m.removedtag = nil
+// ResetFollowing resets all changes to the "following" edge.
+func (m *FollowMutation) ResetFollowing() {
+	m.following = nil
+	m.clearedfollowing = false
 }
 
-// Where appends a list predicates to the PostTagMutation builder.
-func (m *PostTagMutation) Where(ps ...predicate.PostTag) {
+// Where appends a list predicates to the FollowMutation builder.
+func (m *FollowMutation) Where(ps ...predicate.Follow) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PostTagMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FollowMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PostTagMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PostTag, len(ps))
+func (m *FollowMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Follow, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3438,30 +3379,36 @@ func (m *PostTagMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PostTagMutation) Op() Op {
+func (m *FollowMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PostTagMutation) SetOp(op Op) {
+func (m *FollowMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PostTag).
-func (m *PostTagMutation) Type() string {
+// Type returns the node type of this mutation (Follow).
+func (m *FollowMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PostTagMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.post_id != nil {
-		fields = append(fields, posttag.FieldPostID)
+func (m *FollowMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.create_time != nil {
+		fields = append(fields, follow.FieldCreateTime)
 	}
-	if m.tag_id != nil {
-		fields = append(fields, posttag.FieldTagID)
+	if m.update_time != nil {
+		fields = append(fields, follow.FieldUpdateTime)
+	}
+	if m.follower != nil {
+		fields = append(fields, follow.FieldFollowerID)
+	}
+	if m.following != nil {
+		fields = append(fields, follow.FieldFollowingID)
 	}
 	return fields
 }
@@ -3469,12 +3416,16 @@ func (m *PostTagMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PostTagMutation) Field(name string) (ent.Value, bool) {
+func (m *FollowMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case posttag.FieldPostID:
-		return m.PostID()
-	case posttag.FieldTagID:
-		return m.TagID()
+	case follow.FieldCreateTime:
+		return m.CreateTime()
+	case follow.FieldUpdateTime:
+		return m.UpdateTime()
+	case follow.FieldFollowerID:
+		return m.FollowerID()
+	case follow.FieldFollowingID:
+		return m.FollowingID()
 	}
 	return nil, false
 }
@@ -3482,61 +3433,69 @@ func (m *PostTagMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PostTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FollowMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case posttag.FieldPostID:
-		return m.OldPostID(ctx)
-	case posttag.FieldTagID:
-		return m.OldTagID(ctx)
+	case follow.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case follow.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case follow.FieldFollowerID:
+		return m.OldFollowerID(ctx)
+	case follow.FieldFollowingID:
+		return m.OldFollowingID(ctx)
 	}
-	return nil, fmt.Errorf("unknown PostTag field %s", name)
+	return nil, fmt.Errorf("unknown Follow field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostTagMutation) SetField(name string, value ent.Value) error {
+func (m *FollowMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case posttag.FieldPostID:
+	case follow.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case follow.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case follow.FieldFollowerID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPostID(v)
+		m.SetFollowerID(v)
 		return nil
-	case posttag.FieldTagID:
+	case follow.FieldFollowingID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTagID(v)
+		m.SetFollowingID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PostTag field %s", name)
+	return fmt.Errorf("unknown Follow field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PostTagMutation) AddedFields() []string {
+func (m *FollowMutation) AddedFields() []string {
 	var fields []string
-	if m.addpost_id != nil {
-		fields = append(fields, posttag.FieldPostID)
-	}
-	if m.addtag_id != nil {
-		fields = append(fields, posttag.FieldTagID)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PostTagMutation) AddedField(name string) (ent.Value, bool) {
+func (m *FollowMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case posttag.FieldPostID:
-		return m.AddedPostID()
-	case posttag.FieldTagID:
-		return m.AddedTagID()
 	}
 	return nil, false
 }
@@ -3544,211 +3503,168 @@ func (m *PostTagMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostTagMutation) AddField(name string, value ent.Value) error {
+func (m *FollowMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case posttag.FieldPostID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPostID(v)
-		return nil
-	case posttag.FieldTagID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTagID(v)
-		return nil
 	}
-	return fmt.Errorf("unknown PostTag numeric field %s", name)
+	return fmt.Errorf("unknown Follow numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PostTagMutation) ClearedFields() []string {
+func (m *FollowMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PostTagMutation) FieldCleared(name string) bool {
+func (m *FollowMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PostTagMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PostTag nullable field %s", name)
+func (m *FollowMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Follow nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PostTagMutation) ResetField(name string) error {
+func (m *FollowMutation) ResetField(name string) error {
 	switch name {
-	case posttag.FieldPostID:
-		m.ResetPostID()
+	case follow.FieldCreateTime:
+		m.ResetCreateTime()
 		return nil
-	case posttag.FieldTagID:
-		m.ResetTagID()
+	case follow.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case follow.FieldFollowerID:
+		m.ResetFollowerID()
+		return nil
+	case follow.FieldFollowingID:
+		m.ResetFollowingID()
 		return nil
 	}
-	return fmt.Errorf("unknown PostTag field %s", name)
+	return fmt.Errorf("unknown Follow field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PostTagMutation) AddedEdges() []string {
+func (m *FollowMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.post != nil {
-		edges = append(edges, posttag.EdgePost)
+	if m.follower != nil {
+		edges = append(edges, follow.EdgeFollower)
 	}
-	if m.tag != nil {
-		edges = append(edges, posttag.EdgeTag)
+	if m.following != nil {
+		edges = append(edges, follow.EdgeFollowing)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PostTagMutation) AddedIDs(name string) []ent.Value {
+func (m *FollowMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case posttag.EdgePost:
-		ids := make([]ent.Value, 0, len(m.post))
-		for id := range m.post {
-			ids = append(ids, id)
+	case follow.EdgeFollower:
+		if id := m.follower; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case posttag.EdgeTag:
-		ids := make([]ent.Value, 0, len(m.tag))
-		for id := range m.tag {
-			ids = append(ids, id)
+	case follow.EdgeFollowing:
+		if id := m.following; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PostTagMutation) RemovedEdges() []string {
+func (m *FollowMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedpost != nil {
-		edges = append(edges, posttag.EdgePost)
-	}
-	if m.removedtag != nil {
-		edges = append(edges, posttag.EdgeTag)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PostTagMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case posttag.EdgePost:
-		ids := make([]ent.Value, 0, len(m.removedpost))
-		for id := range m.removedpost {
-			ids = append(ids, id)
-		}
-		return ids
-	case posttag.EdgeTag:
-		ids := make([]ent.Value, 0, len(m.removedtag))
-		for id := range m.removedtag {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *FollowMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PostTagMutation) ClearedEdges() []string {
+func (m *FollowMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedpost {
-		edges = append(edges, posttag.EdgePost)
+	if m.clearedfollower {
+		edges = append(edges, follow.EdgeFollower)
 	}
-	if m.clearedtag {
-		edges = append(edges, posttag.EdgeTag)
+	if m.clearedfollowing {
+		edges = append(edges, follow.EdgeFollowing)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PostTagMutation) EdgeCleared(name string) bool {
+func (m *FollowMutation) EdgeCleared(name string) bool {
 	switch name {
-	case posttag.EdgePost:
-		return m.clearedpost
-	case posttag.EdgeTag:
-		return m.clearedtag
+	case follow.EdgeFollower:
+		return m.clearedfollower
+	case follow.EdgeFollowing:
+		return m.clearedfollowing
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PostTagMutation) ClearEdge(name string) error {
+func (m *FollowMutation) ClearEdge(name string) error {
 	switch name {
+	case follow.EdgeFollower:
+		m.ClearFollower()
+		return nil
+	case follow.EdgeFollowing:
+		m.ClearFollowing()
+		return nil
 	}
-	return fmt.Errorf("unknown PostTag unique edge %s", name)
+	return fmt.Errorf("unknown Follow unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PostTagMutation) ResetEdge(name string) error {
+func (m *FollowMutation) ResetEdge(name string) error {
 	switch name {
-	case posttag.EdgePost:
-		m.ResetPost()
+	case follow.EdgeFollower:
+		m.ResetFollower()
 		return nil
-	case posttag.EdgeTag:
-		m.ResetTag()
+	case follow.EdgeFollowing:
+		m.ResetFollowing()
 		return nil
 	}
-	return fmt.Errorf("unknown PostTag edge %s", name)
+	return fmt.Errorf("unknown Follow edge %s", name)
 }
 
-// QuestionMutation represents an operation that mutates the Question nodes in the graph.
-type QuestionMutation struct {
+// LoginAttemptMutation represents an operation that mutates the LoginAttempt nodes in the graph.
+type LoginAttemptMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	create_time      *time.Time
-	update_time      *time.Time
-	title            *string
-	embedding        *[]byte
-	clearedFields    map[string]struct{}
-	user             map[int]struct{}
-	removeduser      map[int]struct{}
-	cleareduser      bool
-	comments         map[int]struct{}
-	removedcomments  map[int]struct{}
-	clearedcomments  bool
-	tags             map[int]struct{}
-	removedtags      map[int]struct{}
-	clearedtags      bool
-	likes            map[int]struct{}
-	removedlikes     map[int]struct{}
-	clearedlikes     bool
-	favorites        map[int]struct{}
-	removedfavorites map[int]struct{}
-	clearedfavorites bool
-	done             bool
-	oldValue         func(context.Context) (*Question, error)
-	predicates       []predicate.Question
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	key           *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*LoginAttempt, error)
+	predicates    []predicate.LoginAttempt
 }
 
-var _ ent.Mutation = (*QuestionMutation)(nil)
+var _ ent.Mutation = (*LoginAttemptMutation)(nil)
 
-// questionOption allows management of the mutation configuration using functional options.
-type questionOption func(*QuestionMutation)
+// loginattemptOption allows management of the mutation configuration using functional options.
+type loginattemptOption func(*LoginAttemptMutation)
 
-// newQuestionMutation creates new mutation for the Question entity.
-func newQuestionMutation(c config, op Op, opts ...questionOption) *QuestionMutation {
-	m := &QuestionMutation{
+// newLoginAttemptMutation creates new mutation for the LoginAttempt entity.
+func newLoginAttemptMutation(c config, op Op, opts ...loginattemptOption) *LoginAttemptMutation {
+	m := &LoginAttemptMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeQuestion,
+		typ:           TypeLoginAttempt,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3757,20 +3673,20 @@ func newQuestionMutation(c config, op Op, opts ...questionOption) *QuestionMutat
 	return m
 }
 
-// withQuestionID sets the ID field of the mutation.
-func withQuestionID(id int) questionOption {
-	return func(m *QuestionMutation) {
+// withLoginAttemptID sets the ID field of the mutation.
+func withLoginAttemptID(id int) loginattemptOption {
+	return func(m *LoginAttemptMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Question
+			value *LoginAttempt
 		)
-		m.oldValue = func(ctx context.Context) (*Question, error) {
+		m.oldValue = func(ctx context.Context) (*LoginAttempt, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Question.Get(ctx, id)
+					value, err = m.Client().LoginAttempt.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3779,10 +3695,10 @@ func withQuestionID(id int) questionOption {
 	}
 }
 
-// withQuestion sets the old Question of the mutation.
-func withQuestion(node *Question) questionOption {
-	return func(m *QuestionMutation) {
-		m.oldValue = func(context.Context) (*Question, error) {
+// withLoginAttempt sets the old LoginAttempt of the mutation.
+func withLoginAttempt(node *LoginAttempt) loginattemptOption {
+	return func(m *LoginAttemptMutation) {
+		m.oldValue = func(context.Context) (*LoginAttempt, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3791,7 +3707,7 @@ func withQuestion(node *Question) questionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m QuestionMutation) Client() *Client {
+func (m LoginAttemptMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3799,7 +3715,7 @@ func (m QuestionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m QuestionMutation) Tx() (*Tx, error) {
+func (m LoginAttemptMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3810,7 +3726,7 @@ func (m QuestionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *QuestionMutation) ID() (id int, exists bool) {
+func (m *LoginAttemptMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3821,7 +3737,7 @@ func (m *QuestionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *QuestionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *LoginAttemptMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3830,19 +3746,19 @@ func (m *QuestionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Question.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().LoginAttempt.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *QuestionMutation) SetCreateTime(t time.Time) {
+func (m *LoginAttemptMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *QuestionMutation) CreateTime() (r time.Time, exists bool) {
+func (m *LoginAttemptMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -3850,10 +3766,10 @@ func (m *QuestionMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the LoginAttempt entity.
+// If the LoginAttempt object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *LoginAttemptMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -3868,1129 +3784,1052 @@ func (m *QuestionMutation) OldCreateTime(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *QuestionMutation) ResetCreateTime() {
+func (m *LoginAttemptMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *QuestionMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// SetKey sets the "key" field.
+func (m *LoginAttemptMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *QuestionMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
+// Key returns the value of the "key" field in the mutation.
+func (m *LoginAttemptMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the LoginAttempt entity.
+// If the LoginAttempt object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *LoginAttemptMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.UpdateTime, nil
+	return oldValue.Key, nil
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *QuestionMutation) ResetUpdateTime() {
-	m.update_time = nil
+// ResetKey resets all changes to the "key" field.
+func (m *LoginAttemptMutation) ResetKey() {
+	m.key = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *QuestionMutation) SetTitle(s string) {
-	m.title = &s
+// Where appends a list predicates to the LoginAttemptMutation builder.
+func (m *LoginAttemptMutation) Where(ps ...predicate.LoginAttempt) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *QuestionMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the LoginAttemptMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LoginAttemptMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LoginAttempt, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldTitle returns the old "title" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
-	}
-	return oldValue.Title, nil
+// Op returns the operation name.
+func (m *LoginAttemptMutation) Op() Op {
+	return m.op
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *QuestionMutation) ResetTitle() {
-	m.title = nil
+// SetOp allows setting the mutation operation.
+func (m *LoginAttemptMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetEmbedding sets the "embedding" field.
-func (m *QuestionMutation) SetEmbedding(b []byte) {
-	m.embedding = &b
+// Type returns the node type of this mutation (LoginAttempt).
+func (m *LoginAttemptMutation) Type() string {
+	return m.typ
 }
 
-// Embedding returns the value of the "embedding" field in the mutation.
-func (m *QuestionMutation) Embedding() (r []byte, exists bool) {
-	v := m.embedding
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LoginAttemptMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.create_time != nil {
+		fields = append(fields, loginattempt.FieldCreateTime)
 	}
-	return *v, true
+	if m.key != nil {
+		fields = append(fields, loginattempt.FieldKey)
+	}
+	return fields
 }
 
-// OldEmbedding returns the old "embedding" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldEmbedding(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LoginAttemptMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case loginattempt.FieldCreateTime:
+		return m.CreateTime()
+	case loginattempt.FieldKey:
+		return m.Key()
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LoginAttemptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case loginattempt.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case loginattempt.FieldKey:
+		return m.OldKey(ctx)
 	}
-	return oldValue.Embedding, nil
+	return nil, fmt.Errorf("unknown LoginAttempt field %s", name)
 }
 
-// ClearEmbedding clears the value of the "embedding" field.
-func (m *QuestionMutation) ClearEmbedding() {
-	m.embedding = nil
-	m.clearedFields[question.FieldEmbedding] = struct{}{}
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginAttemptMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case loginattempt.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case loginattempt.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoginAttempt field %s", name)
 }
 
-// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
-func (m *QuestionMutation) EmbeddingCleared() bool {
-	_, ok := m.clearedFields[question.FieldEmbedding]
-	return ok
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LoginAttemptMutation) AddedFields() []string {
+	return nil
 }
 
-// ResetEmbedding resets all changes to the "embedding" field.
-func (m *QuestionMutation) ResetEmbedding() {
-	m.embedding = nil
-	delete(m.clearedFields, question.FieldEmbedding)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LoginAttemptMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// AddUserIDs adds the "user" edge to the User entity by ids.
-func (m *QuestionMutation) AddUserIDs(ids ...int) {
-	if m.user == nil {
-		m.user = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.user[ids[i]] = struct{}{}
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginAttemptMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
+	return fmt.Errorf("unknown LoginAttempt numeric field %s", name)
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *QuestionMutation) ClearUser() {
-	m.cleareduser = true
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LoginAttemptMutation) ClearedFields() []string {
+	return nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *QuestionMutation) UserCleared() bool {
-	return m.cleareduser
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LoginAttemptMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// RemoveUserIDs removes the "user" edge to the User entity by IDs.
-func (m *QuestionMutation) RemoveUserIDs(ids ...int) {
-	if m.removeduser == nil {
-		m.removeduser = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.user, ids[i])
-		m.removeduser[ids[i]] = struct{}{}
-	}
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LoginAttemptMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LoginAttempt nullable field %s", name)
 }
 
-// RemovedUser returns the removed IDs of the "user" edge to the User entity.
-func (m *QuestionMutation) RemovedUserIDs() (ids []int) {
-	for id := range m.removeduser {
-		ids = append(ids, id)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LoginAttemptMutation) ResetField(name string) error {
+	switch name {
+	case loginattempt.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case loginattempt.FieldKey:
+		m.ResetKey()
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown LoginAttempt field %s", name)
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-func (m *QuestionMutation) UserIDs() (ids []int) {
-	for id := range m.user {
-		ids = append(ids, id)
-	}
-	return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LoginAttemptMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *QuestionMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-	m.removeduser = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LoginAttemptMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
-func (m *QuestionMutation) AddCommentIDs(ids ...int) {
-	if m.comments == nil {
-		m.comments = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.comments[ids[i]] = struct{}{}
-	}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LoginAttemptMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearComments clears the "comments" edge to the Comment entity.
-func (m *QuestionMutation) ClearComments() {
-	m.clearedcomments = true
-}
-
-// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
-func (m *QuestionMutation) CommentsCleared() bool {
-	return m.clearedcomments
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LoginAttemptMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
-func (m *QuestionMutation) RemoveCommentIDs(ids ...int) {
-	if m.removedcomments == nil {
-		m.removedcomments = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.comments, ids[i])
-		m.removedcomments[ids[i]] = struct{}{}
-	}
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LoginAttemptMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
-func (m *QuestionMutation) RemovedCommentsIDs() (ids []int) {
-	for id := range m.removedcomments {
-		ids = append(ids, id)
-	}
-	return
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LoginAttemptMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// CommentsIDs returns the "comments" edge IDs in the mutation.
-func (m *QuestionMutation) CommentsIDs() (ids []int) {
-	for id := range m.comments {
-		ids = append(ids, id)
-	}
-	return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LoginAttemptMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LoginAttempt unique edge %s", name)
 }
 
-// ResetComments resets all changes to the "comments" edge.
-func (m *QuestionMutation) ResetComments() {
-	m.comments = nil
-	m.clearedcomments = false
-	m.removedcomments = nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LoginAttemptMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LoginAttempt edge %s", name)
 }
 
-// AddTagIDs adds the "tags" edge to the QuestionTag entity by ids.
-func (m *QuestionMutation) AddTagIDs(ids ...int) {
-	if m.tags == nil {
-		m.tags = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.tags[ids[i]] = struct{}{}
-	}
+// PostMutation represents an operation that mutates the Post nodes in the graph.
+type PostMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	create_time       *time.Time
+	update_time       *time.Time
+	title             *string
+	content           *string
+	embedding         *pgvector.Vector
+	like_count        *int
+	addlike_count     *int
+	favorite_count    *int
+	addfavorite_count *int
+	comment_count     *int
+	addcomment_count  *int
+	clearedFields     map[string]struct{}
+	user              *int
+	cleareduser       bool
+	comments          map[int]struct{}
+	removedcomments   map[int]struct{}
+	clearedcomments   bool
+	tags              map[int]struct{}
+	removedtags       map[int]struct{}
+	clearedtags       bool
+	likes             map[int]struct{}
+	removedlikes      map[int]struct{}
+	clearedlikes      bool
+	favorites         map[int]struct{}
+	removedfavorites  map[int]struct{}
+	clearedfavorites  bool
+	done              bool
+	oldValue          func(context.Context) (*Post, error)
+	predicates        []predicate.Post
 }
 
-// ClearTags clears the "tags" edge to the QuestionTag entity.
-func (m *QuestionMutation) ClearTags() {
-	m.clearedtags = true
-}
+var _ ent.Mutation = (*PostMutation)(nil)
 
-// TagsCleared reports if the "tags" edge to the QuestionTag entity was cleared.
-func (m *QuestionMutation) TagsCleared() bool {
-	return m.clearedtags
-}
+// postOption allows management of the mutation configuration using functional options.
+type postOption func(*PostMutation)
 
-// RemoveTagIDs removes the "tags" edge to the QuestionTag entity by IDs.
-func (m *QuestionMutation) RemoveTagIDs(ids ...int) {
-	if m.removedtags == nil {
-		m.removedtags = make(map[int]struct{})
+// newPostMutation creates new mutation for the Post entity.
+func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
+	m := &PostMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePost,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		delete(m.tags, ids[i])
-		m.removedtags[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedTags returns the removed IDs of the "tags" edge to the QuestionTag entity.
-func (m *QuestionMutation) RemovedTagsIDs() (ids []int) {
-	for id := range m.removedtags {
-		ids = append(ids, id)
+// withPostID sets the ID field of the mutation.
+func withPostID(id int) postOption {
+	return func(m *PostMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Post
+		)
+		m.oldValue = func(ctx context.Context) (*Post, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Post.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// TagsIDs returns the "tags" edge IDs in the mutation.
-func (m *QuestionMutation) TagsIDs() (ids []int) {
-	for id := range m.tags {
-		ids = append(ids, id)
+// withPost sets the old Post of the mutation.
+func withPost(node *Post) postOption {
+	return func(m *PostMutation) {
+		m.oldValue = func(context.Context) (*Post, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetTags resets all changes to the "tags" edge.
-func (m *QuestionMutation) ResetTags() {
-	m.tags = nil
-	m.clearedtags = false
-	m.removedtags = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddLikeIDs adds the "likes" edge to the UserLikeQuestion entity by ids.
-func (m *QuestionMutation) AddLikeIDs(ids ...int) {
-	if m.likes == nil {
-		m.likes = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.likes[ids[i]] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearLikes clears the "likes" edge to the UserLikeQuestion entity.
-func (m *QuestionMutation) ClearLikes() {
-	m.clearedlikes = true
-}
-
-// LikesCleared reports if the "likes" edge to the UserLikeQuestion entity was cleared.
-func (m *QuestionMutation) LikesCleared() bool {
-	return m.clearedlikes
-}
-
-// RemoveLikeIDs removes the "likes" edge to the UserLikeQuestion entity by IDs.
-func (m *QuestionMutation) RemoveLikeIDs(ids ...int) {
-	if m.removedlikes == nil {
-		m.removedlikes = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.likes, ids[i])
-		m.removedlikes[ids[i]] = struct{}{}
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
+	return *m.id, true
 }
 
-// RemovedLikes returns the removed IDs of the "likes" edge to the UserLikeQuestion entity.
-func (m *QuestionMutation) RemovedLikesIDs() (ids []int) {
-	for id := range m.removedlikes {
-		ids = append(ids, id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// LikesIDs returns the "likes" edge IDs in the mutation.
-func (m *QuestionMutation) LikesIDs() (ids []int) {
-	for id := range m.likes {
-		ids = append(ids, id)
-	}
-	return
+// SetCreateTime sets the "create_time" field.
+func (m *PostMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// ResetLikes resets all changes to the "likes" edge.
-func (m *QuestionMutation) ResetLikes() {
-	m.likes = nil
-	m.clearedlikes = false
-	m.removedlikes = nil
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *PostMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddFavoriteIDs adds the "favorites" edge to the UserFavoriteQuestion entity by ids.
-func (m *QuestionMutation) AddFavoriteIDs(ids ...int) {
-	if m.favorites == nil {
-		m.favorites = make(map[int]struct{})
+// OldCreateTime returns the old "create_time" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.favorites[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
+	return oldValue.CreateTime, nil
 }
 
-// ClearFavorites clears the "favorites" edge to the UserFavoriteQuestion entity.
-func (m *QuestionMutation) ClearFavorites() {
-	m.clearedfavorites = true
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *PostMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// FavoritesCleared reports if the "favorites" edge to the UserFavoriteQuestion entity was cleared.
-func (m *QuestionMutation) FavoritesCleared() bool {
-	return m.clearedfavorites
+// SetUpdateTime sets the "update_time" field.
+func (m *PostMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// RemoveFavoriteIDs removes the "favorites" edge to the UserFavoriteQuestion entity by IDs.
-func (m *QuestionMutation) RemoveFavoriteIDs(ids ...int) {
-	if m.removedfavorites == nil {
-		m.removedfavorites = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.favorites, ids[i])
-		m.removedfavorites[ids[i]] = struct{}{}
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *PostMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedFavorites returns the removed IDs of the "favorites" edge to the UserFavoriteQuestion entity.
-func (m *QuestionMutation) RemovedFavoritesIDs() (ids []int) {
-	for id := range m.removedfavorites {
-		ids = append(ids, id)
+// OldUpdateTime returns the old "update_time" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// FavoritesIDs returns the "favorites" edge IDs in the mutation.
-func (m *QuestionMutation) FavoritesIDs() (ids []int) {
-	for id := range m.favorites {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
 }
 
-// ResetFavorites resets all changes to the "favorites" edge.
-func (m *QuestionMutation) ResetFavorites() {
-	m.favorites = nil
-	m.clearedfavorites = false
-	m.removedfavorites = nil
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *PostMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// Where appends a list predicates to the QuestionMutation builder.
-func (m *QuestionMutation) Where(ps ...predicate.Question) {
-	m.predicates = append(m.predicates, ps...)
+// SetTitle sets the "title" field.
+func (m *PostMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// WhereP appends storage-level predicates to the QuestionMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *QuestionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Question, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Title returns the value of the "title" field in the mutation.
+func (m *PostMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *QuestionMutation) Op() Op {
-	return m.op
+// OldTitle returns the old "title" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *QuestionMutation) SetOp(op Op) {
-	m.op = op
+// ResetTitle resets all changes to the "title" field.
+func (m *PostMutation) ResetTitle() {
+	m.title = nil
 }
 
-// Type returns the node type of this mutation (Question).
-func (m *QuestionMutation) Type() string {
-	return m.typ
+// SetContent sets the "content" field.
+func (m *PostMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *QuestionMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.create_time != nil {
-		fields = append(fields, question.FieldCreateTime)
+// Content returns the value of the "content" field in the mutation.
+func (m *PostMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
 	}
-	if m.update_time != nil {
-		fields = append(fields, question.FieldUpdateTime)
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
-	if m.title != nil {
-		fields = append(fields, question.FieldTitle)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
-	if m.embedding != nil {
-		fields = append(fields, question.FieldEmbedding)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return fields
+	return oldValue.Content, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *QuestionMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case question.FieldCreateTime:
-		return m.CreateTime()
-	case question.FieldUpdateTime:
-		return m.UpdateTime()
-	case question.FieldTitle:
-		return m.Title()
-	case question.FieldEmbedding:
-		return m.Embedding()
-	}
-	return nil, false
+// ClearContent clears the value of the "content" field.
+func (m *PostMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[post.FieldContent] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *QuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case question.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case question.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case question.FieldTitle:
-		return m.OldTitle(ctx)
-	case question.FieldEmbedding:
-		return m.OldEmbedding(ctx)
-	}
-	return nil, fmt.Errorf("unknown Question field %s", name)
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *PostMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[post.FieldContent]
+	return ok
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *QuestionMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case question.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case question.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
-	case question.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case question.FieldEmbedding:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmbedding(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Question field %s", name)
+// ResetContent resets all changes to the "content" field.
+func (m *PostMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, post.FieldContent)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *QuestionMutation) AddedFields() []string {
-	return nil
+// SetEmbedding sets the "embedding" field.
+func (m *PostMutation) SetEmbedding(pg pgvector.Vector) {
+	m.embedding = &pg
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *QuestionMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *PostMutation) Embedding() (r pgvector.Vector, exists bool) {
+	v := m.embedding
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *QuestionMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldEmbedding returns the old "embedding" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldEmbedding(ctx context.Context) (v pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Question numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+	}
+	return oldValue.Embedding, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *QuestionMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(question.FieldEmbedding) {
-		fields = append(fields, question.FieldEmbedding)
-	}
-	return fields
+// ClearEmbedding clears the value of the "embedding" field.
+func (m *PostMutation) ClearEmbedding() {
+	m.embedding = nil
+	m.clearedFields[post.FieldEmbedding] = struct{}{}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *QuestionMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
+func (m *PostMutation) EmbeddingCleared() bool {
+	_, ok := m.clearedFields[post.FieldEmbedding]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *QuestionMutation) ClearField(name string) error {
-	switch name {
-	case question.FieldEmbedding:
-		m.ClearEmbedding()
-		return nil
-	}
-	return fmt.Errorf("unknown Question nullable field %s", name)
-}
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *PostMutation) ResetEmbedding() {
+	m.embedding = nil
+	delete(m.clearedFields, post.FieldEmbedding)
+}
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *QuestionMutation) ResetField(name string) error {
-	switch name {
-	case question.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case question.FieldUpdateTime:
-		m.ResetUpdateTime()
-		return nil
-	case question.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case question.FieldEmbedding:
-		m.ResetEmbedding()
-		return nil
-	}
-	return fmt.Errorf("unknown Question field %s", name)
+// SetUserID sets the "user_id" field.
+func (m *PostMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *QuestionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.user != nil {
-		edges = append(edges, question.EdgeUser)
-	}
-	if m.comments != nil {
-		edges = append(edges, question.EdgeComments)
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PostMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
 	}
-	if m.tags != nil {
-		edges = append(edges, question.EdgeTags)
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-	if m.likes != nil {
-		edges = append(edges, question.EdgeLikes)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
-	if m.favorites != nil {
-		edges = append(edges, question.EdgeFavorites)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return edges
+	return oldValue.UserID, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *QuestionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case question.EdgeUser:
-		ids := make([]ent.Value, 0, len(m.user))
-		for id := range m.user {
-			ids = append(ids, id)
-		}
-		return ids
-	case question.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.comments))
-		for id := range m.comments {
-			ids = append(ids, id)
-		}
-		return ids
-	case question.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.tags))
-		for id := range m.tags {
-			ids = append(ids, id)
-		}
-		return ids
-	case question.EdgeLikes:
-		ids := make([]ent.Value, 0, len(m.likes))
-		for id := range m.likes {
-			ids = append(ids, id)
-		}
-		return ids
-	case question.EdgeFavorites:
-		ids := make([]ent.Value, 0, len(m.favorites))
-		for id := range m.favorites {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearUserID clears the value of the "user_id" field.
+func (m *PostMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[post.FieldUserID] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *QuestionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removeduser != nil {
-		edges = append(edges, question.EdgeUser)
-	}
-	if m.removedcomments != nil {
-		edges = append(edges, question.EdgeComments)
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *PostMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[post.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PostMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, post.FieldUserID)
+}
+
+// SetLikeCount sets the "like_count" field.
+func (m *PostMutation) SetLikeCount(i int) {
+	m.like_count = &i
+	m.addlike_count = nil
+}
+
+// LikeCount returns the value of the "like_count" field in the mutation.
+func (m *PostMutation) LikeCount() (r int, exists bool) {
+	v := m.like_count
+	if v == nil {
+		return
 	}
-	if m.removedtags != nil {
-		edges = append(edges, question.EdgeTags)
+	return *v, true
+}
+
+// OldLikeCount returns the old "like_count" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldLikeCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLikeCount is only allowed on UpdateOne operations")
 	}
-	if m.removedlikes != nil {
-		edges = append(edges, question.EdgeLikes)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLikeCount requires an ID field in the mutation")
 	}
-	if m.removedfavorites != nil {
-		edges = append(edges, question.EdgeFavorites)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLikeCount: %w", err)
 	}
-	return edges
+	return oldValue.LikeCount, nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *QuestionMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case question.EdgeUser:
-		ids := make([]ent.Value, 0, len(m.removeduser))
-		for id := range m.removeduser {
-			ids = append(ids, id)
-		}
-		return ids
-	case question.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.removedcomments))
-		for id := range m.removedcomments {
-			ids = append(ids, id)
-		}
-		return ids
-	case question.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.removedtags))
-		for id := range m.removedtags {
-			ids = append(ids, id)
-		}
-		return ids
-	case question.EdgeLikes:
-		ids := make([]ent.Value, 0, len(m.removedlikes))
-		for id := range m.removedlikes {
-			ids = append(ids, id)
-		}
-		return ids
-	case question.EdgeFavorites:
-		ids := make([]ent.Value, 0, len(m.removedfavorites))
-		for id := range m.removedfavorites {
-			ids = append(ids, id)
-		}
-		return ids
+// AddLikeCount adds i to the "like_count" field.
+func (m *PostMutation) AddLikeCount(i int) {
+	if m.addlike_count != nil {
+		*m.addlike_count += i
+	} else {
+		m.addlike_count = &i
 	}
-	return nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *QuestionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.cleareduser {
-		edges = append(edges, question.EdgeUser)
+// AddedLikeCount returns the value that was added to the "like_count" field in this mutation.
+func (m *PostMutation) AddedLikeCount() (r int, exists bool) {
+	v := m.addlike_count
+	if v == nil {
+		return
 	}
-	if m.clearedcomments {
-		edges = append(edges, question.EdgeComments)
+	return *v, true
+}
+
+// ResetLikeCount resets all changes to the "like_count" field.
+func (m *PostMutation) ResetLikeCount() {
+	m.like_count = nil
+	m.addlike_count = nil
+}
+
+// SetFavoriteCount sets the "favorite_count" field.
+func (m *PostMutation) SetFavoriteCount(i int) {
+	m.favorite_count = &i
+	m.addfavorite_count = nil
+}
+
+// FavoriteCount returns the value of the "favorite_count" field in the mutation.
+func (m *PostMutation) FavoriteCount() (r int, exists bool) {
+	v := m.favorite_count
+	if v == nil {
+		return
 	}
-	if m.clearedtags {
-		edges = append(edges, question.EdgeTags)
+	return *v, true
+}
+
+// OldFavoriteCount returns the old "favorite_count" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldFavoriteCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFavoriteCount is only allowed on UpdateOne operations")
 	}
-	if m.clearedlikes {
-		edges = append(edges, question.EdgeLikes)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFavoriteCount requires an ID field in the mutation")
 	}
-	if m.clearedfavorites {
-		edges = append(edges, question.EdgeFavorites)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFavoriteCount: %w", err)
 	}
-	return edges
+	return oldValue.FavoriteCount, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *QuestionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case question.EdgeUser:
-		return m.cleareduser
-	case question.EdgeComments:
-		return m.clearedcomments
-	case question.EdgeTags:
-		return m.clearedtags
-	case question.EdgeLikes:
-		return m.clearedlikes
-	case question.EdgeFavorites:
-		return m.clearedfavorites
+// AddFavoriteCount adds i to the "favorite_count" field.
+func (m *PostMutation) AddFavoriteCount(i int) {
+	if m.addfavorite_count != nil {
+		*m.addfavorite_count += i
+	} else {
+		m.addfavorite_count = &i
 	}
-	return false
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *QuestionMutation) ClearEdge(name string) error {
-	switch name {
+// AddedFavoriteCount returns the value that was added to the "favorite_count" field in this mutation.
+func (m *PostMutation) AddedFavoriteCount() (r int, exists bool) {
+	v := m.addfavorite_count
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Question unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *QuestionMutation) ResetEdge(name string) error {
-	switch name {
-	case question.EdgeUser:
-		m.ResetUser()
-		return nil
-	case question.EdgeComments:
-		m.ResetComments()
-		return nil
-	case question.EdgeTags:
-		m.ResetTags()
-		return nil
-	case question.EdgeLikes:
-		m.ResetLikes()
-		return nil
-	case question.EdgeFavorites:
-		m.ResetFavorites()
-		return nil
-	}
-	return fmt.Errorf("unknown Question edge %s", name)
+// ResetFavoriteCount resets all changes to the "favorite_count" field.
+func (m *PostMutation) ResetFavoriteCount() {
+	m.favorite_count = nil
+	m.addfavorite_count = nil
 }
 
-// QuestionTagMutation represents an operation that mutates the QuestionTag nodes in the graph.
-type QuestionTagMutation struct {
-	config
-	op              Op
-	typ             string
-	id              *int
-	question_id     *int
-	addquestion_id  *int
-	tag_id          *int
-	addtag_id       *int
-	clearedFields   map[string]struct{}
-	question        map[int]struct{}
-	removedquestion map[int]struct{}
-	clearedquestion bool
-	tag             map[int]struct{}
-	removedtag      map[int]struct{}
-	clearedtag      bool
-	done            bool
-	oldValue        func(context.Context) (*QuestionTag, error)
-	predicates      []predicate.QuestionTag
+// SetCommentCount sets the "comment_count" field.
+func (m *PostMutation) SetCommentCount(i int) {
+	m.comment_count = &i
+	m.addcomment_count = nil
 }
 
-var _ ent.Mutation = (*QuestionTagMutation)(nil)
+// CommentCount returns the value of the "comment_count" field in the mutation.
+func (m *PostMutation) CommentCount() (r int, exists bool) {
+	v := m.comment_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// questiontagOption allows management of the mutation configuration using functional options.
-type questiontagOption func(*QuestionTagMutation)
-
-// newQuestionTagMutation creates new mutation for the QuestionTag entity.
-func newQuestionTagMutation(c config, op Op, opts ...questiontagOption) *QuestionTagMutation {
-	m := &QuestionTagMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeQuestionTag,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
-}
-
-// withQuestionTagID sets the ID field of the mutation.
-func withQuestionTagID(id int) questiontagOption {
-	return func(m *QuestionTagMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *QuestionTag
-		)
-		m.oldValue = func(ctx context.Context) (*QuestionTag, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().QuestionTag.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
-}
-
-// withQuestionTag sets the old QuestionTag of the mutation.
-func withQuestionTag(node *QuestionTag) questiontagOption {
-	return func(m *QuestionTagMutation) {
-		m.oldValue = func(context.Context) (*QuestionTag, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m QuestionTagMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m QuestionTagMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *QuestionTagMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *QuestionTagMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().QuestionTag.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetQuestionID sets the "question_id" field.
-func (m *QuestionTagMutation) SetQuestionID(i int) {
-	m.question_id = &i
-	m.addquestion_id = nil
-}
-
-// QuestionID returns the value of the "question_id" field in the mutation.
-func (m *QuestionTagMutation) QuestionID() (r int, exists bool) {
-	v := m.question_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldQuestionID returns the old "question_id" field's value of the QuestionTag entity.
-// If the QuestionTag object wasn't provided to the builder, the object is fetched from the database.
+// OldCommentCount returns the old "comment_count" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionTagMutation) OldQuestionID(ctx context.Context) (v int, err error) {
+func (m *PostMutation) OldCommentCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuestionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCommentCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuestionID requires an ID field in the mutation")
+		return v, errors.New("OldCommentCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuestionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCommentCount: %w", err)
 	}
-	return oldValue.QuestionID, nil
+	return oldValue.CommentCount, nil
 }
 
-// AddQuestionID adds i to the "question_id" field.
-func (m *QuestionTagMutation) AddQuestionID(i int) {
-	if m.addquestion_id != nil {
-		*m.addquestion_id += i
+// AddCommentCount adds i to the "comment_count" field.
+func (m *PostMutation) AddCommentCount(i int) {
+	if m.addcomment_count != nil {
+		*m.addcomment_count += i
 	} else {
-		m.addquestion_id = &i
+		m.addcomment_count = &i
 	}
 }
 
-// AddedQuestionID returns the value that was added to the "question_id" field in this mutation.
-func (m *QuestionTagMutation) AddedQuestionID() (r int, exists bool) {
-	v := m.addquestion_id
+// AddedCommentCount returns the value that was added to the "comment_count" field in this mutation.
+func (m *PostMutation) AddedCommentCount() (r int, exists bool) {
+	v := m.addcomment_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetQuestionID resets all changes to the "question_id" field.
-func (m *QuestionTagMutation) ResetQuestionID() {
-	m.question_id = nil
-	m.addquestion_id = nil
-}
-
-// SetTagID sets the "tag_id" field.
-func (m *QuestionTagMutation) SetTagID(i int) {
-	m.tag_id = &i
-	m.addtag_id = nil
-}
-
-// TagID returns the value of the "tag_id" field in the mutation.
-func (m *QuestionTagMutation) TagID() (r int, exists bool) {
-	v := m.tag_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetCommentCount resets all changes to the "comment_count" field.
+func (m *PostMutation) ResetCommentCount() {
+	m.comment_count = nil
+	m.addcomment_count = nil
 }
 
-// OldTagID returns the old "tag_id" field's value of the QuestionTag entity.
-// If the QuestionTag object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionTagMutation) OldTagID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTagID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTagID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTagID: %w", err)
-	}
-	return oldValue.TagID, nil
+// ClearUser clears the "user" edge to the User entity.
+func (m *PostMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[post.FieldUserID] = struct{}{}
 }
 
-// AddTagID adds i to the "tag_id" field.
-func (m *QuestionTagMutation) AddTagID(i int) {
-	if m.addtag_id != nil {
-		*m.addtag_id += i
-	} else {
-		m.addtag_id = &i
-	}
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PostMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
 }
 
-// AddedTagID returns the value that was added to the "tag_id" field in this mutation.
-func (m *QuestionTagMutation) AddedTagID() (r int, exists bool) {
-	v := m.addtag_id
-	if v == nil {
-		return
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PostMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// ResetTagID resets all changes to the "tag_id" field.
-func (m *QuestionTagMutation) ResetTagID() {
-	m.tag_id = nil
-	m.addtag_id = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *PostMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// AddQuestionIDs adds the "question" edge to the Question entity by ids.
-func (m *QuestionTagMutation) AddQuestionIDs(ids ...int) {
-	if m.question == nil {
-		m.question = make(map[int]struct{})
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *PostMutation) AddCommentIDs(ids ...int) {
+	if m.comments == nil {
+		m.comments = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.question[ids[i]] = struct{}{}
+		m.comments[ids[i]] = struct{}{}
 	}
 }
 
-// ClearQuestion clears the "question" edge to the Question entity.
-func (m *QuestionTagMutation) ClearQuestion() {
-	m.clearedquestion = true
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *PostMutation) ClearComments() {
+	m.clearedcomments = true
 }
 
-// QuestionCleared reports if the "question" edge to the Question entity was cleared.
-func (m *QuestionTagMutation) QuestionCleared() bool {
-	return m.clearedquestion
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *PostMutation) CommentsCleared() bool {
+	return m.clearedcomments
 }
 
-// RemoveQuestionIDs removes the "question" edge to the Question entity by IDs.
-func (m *QuestionTagMutation) RemoveQuestionIDs(ids ...int) {
-	if m.removedquestion == nil {
-		m.removedquestion = make(map[int]struct{})
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *PostMutation) RemoveCommentIDs(ids ...int) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.question, ids[i])
-		m.removedquestion[ids[i]] = struct{}{}
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedQuestion returns the removed IDs of the "question" edge to the Question entity.
-func (m *QuestionTagMutation) RemovedQuestionIDs() (ids []int) {
-	for id := range m.removedquestion {
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *PostMutation) RemovedCommentsIDs() (ids []int) {
+	for id := range m.removedcomments {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// QuestionIDs returns the "question" edge IDs in the mutation.
-func (m *QuestionTagMutation) QuestionIDs() (ids []int) {
-	for id := range m.question {
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *PostMutation) CommentsIDs() (ids []int) {
+	for id := range m.comments {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetQuestion resets all changes to the "question" edge.
-func (m *QuestionTagMutation) ResetQuestion() {
-	m.question = nil
-	m.clearedquestion = false
-	m.removedquestion = nil
+// ResetComments resets all changes to the "comments" edge.
+func (m *PostMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
 }
 
-// AddTagIDs adds the "tag" edge to the Tag entity by ids.
-func (m *QuestionTagMutation) AddTagIDs(ids ...int) {
-	if m.tag == nil {
-		m.tag = make(map[int]struct{})
+// AddTagIDs adds the "tags" edge to the PostTag entity by ids.
+func (m *PostMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.tag[ids[i]] = struct{}{}
+		m.tags[ids[i]] = struct{}{}
 	}
 }
 
-// ClearTag clears the "tag" edge to the Tag entity.
-func (m *QuestionTagMutation) ClearTag() {
-	m.clearedtag = true
+// ClearTags clears the "tags" edge to the PostTag entity.
+func (m *PostMutation) ClearTags() {
+	m.clearedtags = true
 }
 
-// TagCleared reports if the "tag" edge to the Tag entity was cleared.
-func (m *QuestionTagMutation) TagCleared() bool {
-	return m.clearedtag
+// TagsCleared reports if the "tags" edge to the PostTag entity was cleared.
+func (m *PostMutation) TagsCleared() bool {
+	return m.clearedtags
 }
 
-// RemoveTagIDs removes the "tag" edge to the Tag entity by IDs.
-func (m *QuestionTagMutation) RemoveTagIDs(ids ...int) {
-	if m.removedtag == nil {
-		m.removedtag = make(map[int]struct{})
+// RemoveTagIDs removes the "tags" edge to the PostTag entity by IDs.
+func (m *PostMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.tag, ids[i])
-		m.removedtag[ids[i]] = struct{}{}
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedTag returns the removed IDs of the "tag" edge to the Tag entity.
-func (m *QuestionTagMutation) RemovedTagIDs() (ids []int) {
-	for id := range m.removedtag {
+// RemovedTags returns the removed IDs of the "tags" edge to the PostTag entity.
+func (m *PostMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// TagIDs returns the "tag" edge IDs in the mutation.
-func (m *QuestionTagMutation) TagIDs() (ids []int) {
-	for id := range m.tag {
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *PostMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTag resets all changes to the "tag" edge.
-func (m *QuestionTagMutation) ResetTag() {
-	m.tag = nil
-	m.clearedtag = false
-	m.removedtag = nil
+// ResetTags resets all changes to the "tags" edge.
+func (m *PostMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
 }
 
-// Where appends a list predicates to the QuestionTagMutation builder.
-func (m *QuestionTagMutation) Where(ps ...predicate.QuestionTag) {
-	m.predicates = append(m.predicates, ps...)
+// AddLikeIDs adds the "likes" edge to the UserLikePost entity by ids.
+func (m *PostMutation) AddLikeIDs(ids ...int) {
+	if m.likes == nil {
+		m.likes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.likes[ids[i]] = struct{}{}
+	}
 }
 
-// WhereP appends storage-level predicates to the QuestionTagMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *QuestionTagMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.QuestionTag, len(ps))
+// ClearLikes clears the "likes" edge to the UserLikePost entity.
+func (m *PostMutation) ClearLikes() {
+	m.clearedlikes = true
+}
+
+// LikesCleared reports if the "likes" edge to the UserLikePost entity was cleared.
+func (m *PostMutation) LikesCleared() bool {
+	return m.clearedlikes
+}
+
+// RemoveLikeIDs removes the "likes" edge to the UserLikePost entity by IDs.
+func (m *PostMutation) RemoveLikeIDs(ids ...int) {
+	if m.removedlikes == nil {
+		m.removedlikes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.likes, ids[i])
+		m.removedlikes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLikes returns the removed IDs of the "likes" edge to the UserLikePost entity.
+func (m *PostMutation) RemovedLikesIDs() (ids []int) {
+	for id := range m.removedlikes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LikesIDs returns the "likes" edge IDs in the mutation.
+func (m *PostMutation) LikesIDs() (ids []int) {
+	for id := range m.likes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLikes resets all changes to the "likes" edge.
+func (m *PostMutation) ResetLikes() {
+	m.likes = nil
+	m.clearedlikes = false
+	m.removedlikes = nil
+}
+
+// AddFavoriteIDs adds the "favorites" edge to the UserFavoritePost entity by ids.
+func (m *PostMutation) AddFavoriteIDs(ids ...int) {
+	if m.favorites == nil {
+		m.favorites = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.favorites[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFavorites clears the "favorites" edge to the UserFavoritePost entity.
+func (m *PostMutation) ClearFavorites() {
+	m.clearedfavorites = true
+}
+
+// FavoritesCleared reports if the "favorites" edge to the UserFavoritePost entity was cleared.
+func (m *PostMutation) FavoritesCleared() bool {
+	return m.clearedfavorites
+}
+
+// RemoveFavoriteIDs removes the "favorites" edge to the UserFavoritePost entity by IDs.
+func (m *PostMutation) RemoveFavoriteIDs(ids ...int) {
+	if m.removedfavorites == nil {
+		m.removedfavorites = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.favorites, ids[i])
+		m.removedfavorites[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFavorites returns the removed IDs of the "favorites" edge to the UserFavoritePost entity.
+func (m *PostMutation) RemovedFavoritesIDs() (ids []int) {
+	for id := range m.removedfavorites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FavoritesIDs returns the "favorites" edge IDs in the mutation.
+func (m *PostMutation) FavoritesIDs() (ids []int) {
+	for id := range m.favorites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFavorites resets all changes to the "favorites" edge.
+func (m *PostMutation) ResetFavorites() {
+	m.favorites = nil
+	m.clearedfavorites = false
+	m.removedfavorites = nil
+}
+
+// Where appends a list predicates to the PostMutation builder.
+func (m *PostMutation) Where(ps ...predicate.Post) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Post, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4998,30 +4837,51 @@ func (m *QuestionTagMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *QuestionTagMutation) Op() Op {
+func (m *PostMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *QuestionTagMutation) SetOp(op Op) {
+func (m *PostMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (QuestionTag).
-func (m *QuestionTagMutation) Type() string {
+// Type returns the node type of this mutation (Post).
+func (m *PostMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *QuestionTagMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.question_id != nil {
-		fields = append(fields, questiontag.FieldQuestionID)
+func (m *PostMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_time != nil {
+		fields = append(fields, post.FieldCreateTime)
 	}
-	if m.tag_id != nil {
-		fields = append(fields, questiontag.FieldTagID)
+	if m.update_time != nil {
+		fields = append(fields, post.FieldUpdateTime)
+	}
+	if m.title != nil {
+		fields = append(fields, post.FieldTitle)
+	}
+	if m.content != nil {
+		fields = append(fields, post.FieldContent)
+	}
+	if m.embedding != nil {
+		fields = append(fields, post.FieldEmbedding)
+	}
+	if m.user != nil {
+		fields = append(fields, post.FieldUserID)
+	}
+	if m.like_count != nil {
+		fields = append(fields, post.FieldLikeCount)
+	}
+	if m.favorite_count != nil {
+		fields = append(fields, post.FieldFavoriteCount)
+	}
+	if m.comment_count != nil {
+		fields = append(fields, post.FieldCommentCount)
 	}
 	return fields
 }
@@ -5029,12 +4889,26 @@ func (m *QuestionTagMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *QuestionTagMutation) Field(name string) (ent.Value, bool) {
+func (m *PostMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case questiontag.FieldQuestionID:
-		return m.QuestionID()
-	case questiontag.FieldTagID:
-		return m.TagID()
+	case post.FieldCreateTime:
+		return m.CreateTime()
+	case post.FieldUpdateTime:
+		return m.UpdateTime()
+	case post.FieldTitle:
+		return m.Title()
+	case post.FieldContent:
+		return m.Content()
+	case post.FieldEmbedding:
+		return m.Embedding()
+	case post.FieldUserID:
+		return m.UserID()
+	case post.FieldLikeCount:
+		return m.LikeCount()
+	case post.FieldFavoriteCount:
+		return m.FavoriteCount()
+	case post.FieldCommentCount:
+		return m.CommentCount()
 	}
 	return nil, false
 }
@@ -5042,48 +4916,114 @@ func (m *QuestionTagMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *QuestionTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case questiontag.FieldQuestionID:
-		return m.OldQuestionID(ctx)
-	case questiontag.FieldTagID:
-		return m.OldTagID(ctx)
+	case post.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case post.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case post.FieldTitle:
+		return m.OldTitle(ctx)
+	case post.FieldContent:
+		return m.OldContent(ctx)
+	case post.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case post.FieldUserID:
+		return m.OldUserID(ctx)
+	case post.FieldLikeCount:
+		return m.OldLikeCount(ctx)
+	case post.FieldFavoriteCount:
+		return m.OldFavoriteCount(ctx)
+	case post.FieldCommentCount:
+		return m.OldCommentCount(ctx)
 	}
-	return nil, fmt.Errorf("unknown QuestionTag field %s", name)
+	return nil, fmt.Errorf("unknown Post field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestionTagMutation) SetField(name string, value ent.Value) error {
+func (m *PostMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case questiontag.FieldQuestionID:
+	case post.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case post.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case post.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case post.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case post.FieldEmbedding:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbedding(v)
+		return nil
+	case post.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuestionID(v)
+		m.SetUserID(v)
 		return nil
-	case questiontag.FieldTagID:
+	case post.FieldLikeCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTagID(v)
+		m.SetLikeCount(v)
+		return nil
+	case post.FieldFavoriteCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFavoriteCount(v)
+		return nil
+	case post.FieldCommentCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommentCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown QuestionTag field %s", name)
+	return fmt.Errorf("unknown Post field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *QuestionTagMutation) AddedFields() []string {
+func (m *PostMutation) AddedFields() []string {
 	var fields []string
-	if m.addquestion_id != nil {
-		fields = append(fields, questiontag.FieldQuestionID)
+	if m.addlike_count != nil {
+		fields = append(fields, post.FieldLikeCount)
 	}
-	if m.addtag_id != nil {
-		fields = append(fields, questiontag.FieldTagID)
+	if m.addfavorite_count != nil {
+		fields = append(fields, post.FieldFavoriteCount)
+	}
+	if m.addcomment_count != nil {
+		fields = append(fields, post.FieldCommentCount)
 	}
 	return fields
 }
@@ -5091,12 +5031,14 @@ func (m *QuestionTagMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *QuestionTagMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case questiontag.FieldQuestionID:
-		return m.AddedQuestionID()
-	case questiontag.FieldTagID:
-		return m.AddedTagID()
+	case post.FieldLikeCount:
+		return m.AddedLikeCount()
+	case post.FieldFavoriteCount:
+		return m.AddedFavoriteCount()
+	case post.FieldCommentCount:
+		return m.AddedCommentCount()
 	}
 	return nil, false
 }
@@ -5104,84 +5046,158 @@ func (m *QuestionTagMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestionTagMutation) AddField(name string, value ent.Value) error {
+func (m *PostMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case questiontag.FieldQuestionID:
+	case post.FieldLikeCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddQuestionID(v)
+		m.AddLikeCount(v)
 		return nil
-	case questiontag.FieldTagID:
+	case post.FieldFavoriteCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFavoriteCount(v)
+		return nil
+	case post.FieldCommentCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTagID(v)
+		m.AddCommentCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown QuestionTag numeric field %s", name)
+	return fmt.Errorf("unknown Post numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *QuestionTagMutation) ClearedFields() []string {
-	return nil
-}
-
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *QuestionTagMutation) FieldCleared(name string) bool {
+func (m *PostMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(post.FieldContent) {
+		fields = append(fields, post.FieldContent)
+	}
+	if m.FieldCleared(post.FieldEmbedding) {
+		fields = append(fields, post.FieldEmbedding)
+	}
+	if m.FieldCleared(post.FieldUserID) {
+		fields = append(fields, post.FieldUserID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *QuestionTagMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown QuestionTag nullable field %s", name)
+func (m *PostMutation) ClearField(name string) error {
+	switch name {
+	case post.FieldContent:
+		m.ClearContent()
+		return nil
+	case post.FieldEmbedding:
+		m.ClearEmbedding()
+		return nil
+	case post.FieldUserID:
+		m.ClearUserID()
+		return nil
+	}
+	return fmt.Errorf("unknown Post nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *QuestionTagMutation) ResetField(name string) error {
+func (m *PostMutation) ResetField(name string) error {
 	switch name {
-	case questiontag.FieldQuestionID:
-		m.ResetQuestionID()
+	case post.FieldCreateTime:
+		m.ResetCreateTime()
 		return nil
-	case questiontag.FieldTagID:
-		m.ResetTagID()
+	case post.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case post.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case post.FieldContent:
+		m.ResetContent()
+		return nil
+	case post.FieldEmbedding:
+		m.ResetEmbedding()
+		return nil
+	case post.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case post.FieldLikeCount:
+		m.ResetLikeCount()
+		return nil
+	case post.FieldFavoriteCount:
+		m.ResetFavoriteCount()
+		return nil
+	case post.FieldCommentCount:
+		m.ResetCommentCount()
 		return nil
 	}
-	return fmt.Errorf("unknown QuestionTag field %s", name)
+	return fmt.Errorf("unknown Post field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *QuestionTagMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.question != nil {
-		edges = append(edges, questiontag.EdgeQuestion)
+func (m *PostMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.user != nil {
+		edges = append(edges, post.EdgeUser)
 	}
-	if m.tag != nil {
-		edges = append(edges, questiontag.EdgeTag)
+	if m.comments != nil {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.tags != nil {
+		edges = append(edges, post.EdgeTags)
+	}
+	if m.likes != nil {
+		edges = append(edges, post.EdgeLikes)
+	}
+	if m.favorites != nil {
+		edges = append(edges, post.EdgeFavorites)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *QuestionTagMutation) AddedIDs(name string) []ent.Value {
+func (m *PostMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case questiontag.EdgeQuestion:
-		ids := make([]ent.Value, 0, len(m.question))
-		for id := range m.question {
+	case post.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case post.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
 			ids = append(ids, id)
 		}
 		return ids
-	case questiontag.EdgeTag:
-		ids := make([]ent.Value, 0, len(m.tag))
-		for id := range m.tag {
+	case post.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeLikes:
+		ids := make([]ent.Value, 0, len(m.likes))
+		for id := range m.likes {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeFavorites:
+		ids := make([]ent.Value, 0, len(m.favorites))
+		for id := range m.favorites {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5190,30 +5206,48 @@ func (m *QuestionTagMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *QuestionTagMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedquestion != nil {
-		edges = append(edges, questiontag.EdgeQuestion)
+func (m *PostMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedcomments != nil {
+		edges = append(edges, post.EdgeComments)
 	}
-	if m.removedtag != nil {
-		edges = append(edges, questiontag.EdgeTag)
+	if m.removedtags != nil {
+		edges = append(edges, post.EdgeTags)
+	}
+	if m.removedlikes != nil {
+		edges = append(edges, post.EdgeLikes)
+	}
+	if m.removedfavorites != nil {
+		edges = append(edges, post.EdgeFavorites)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *QuestionTagMutation) RemovedIDs(name string) []ent.Value {
+func (m *PostMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case questiontag.EdgeQuestion:
-		ids := make([]ent.Value, 0, len(m.removedquestion))
-		for id := range m.removedquestion {
+	case post.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
 			ids = append(ids, id)
 		}
 		return ids
-	case questiontag.EdgeTag:
-		ids := make([]ent.Value, 0, len(m.removedtag))
-		for id := range m.removedtag {
+	case post.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeLikes:
+		ids := make([]ent.Value, 0, len(m.removedlikes))
+		for id := range m.removedlikes {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeFavorites:
+		ids := make([]ent.Value, 0, len(m.removedfavorites))
+		for id := range m.removedfavorites {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5222,81 +5256,105 @@ func (m *QuestionTagMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *QuestionTagMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedquestion {
-		edges = append(edges, questiontag.EdgeQuestion)
+func (m *PostMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.cleareduser {
+		edges = append(edges, post.EdgeUser)
 	}
-	if m.clearedtag {
-		edges = append(edges, questiontag.EdgeTag)
+	if m.clearedcomments {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.clearedtags {
+		edges = append(edges, post.EdgeTags)
+	}
+	if m.clearedlikes {
+		edges = append(edges, post.EdgeLikes)
+	}
+	if m.clearedfavorites {
+		edges = append(edges, post.EdgeFavorites)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *QuestionTagMutation) EdgeCleared(name string) bool {
+func (m *PostMutation) EdgeCleared(name string) bool {
 	switch name {
-	case questiontag.EdgeQuestion:
-		return m.clearedquestion
-	case questiontag.EdgeTag:
-		return m.clearedtag
+	case post.EdgeUser:
+		return m.cleareduser
+	case post.EdgeComments:
+		return m.clearedcomments
+	case post.EdgeTags:
+		return m.clearedtags
+	case post.EdgeLikes:
+		return m.clearedlikes
+	case post.EdgeFavorites:
+		return m.clearedfavorites
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *QuestionTagMutation) ClearEdge(name string) error {
+func (m *PostMutation) ClearEdge(name string) error {
 	switch name {
+	case post.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
-	return fmt.Errorf("unknown QuestionTag unique edge %s", name)
+	return fmt.Errorf("unknown Post unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *QuestionTagMutation) ResetEdge(name string) error {
+func (m *PostMutation) ResetEdge(name string) error {
 	switch name {
-	case questiontag.EdgeQuestion:
-		m.ResetQuestion()
+	case post.EdgeUser:
+		m.ResetUser()
 		return nil
-	case questiontag.EdgeTag:
-		m.ResetTag()
+	case post.EdgeComments:
+		m.ResetComments()
+		return nil
+	case post.EdgeTags:
+		m.ResetTags()
+		return nil
+	case post.EdgeLikes:
+		m.ResetLikes()
+		return nil
+	case post.EdgeFavorites:
+		m.ResetFavorites()
 		return nil
 	}
-	return fmt.Errorf("unknown QuestionTag edge %s", name)
+	return fmt.Errorf("unknown Post edge %s", name)
 }
 
-// TagMutation represents an operation that mutates the Tag nodes in the graph.
-type TagMutation struct {
+// PostTagMutation represents an operation that mutates the PostTag nodes in the graph.
+type PostTagMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	name             *string
-	clearedFields    map[string]struct{}
-	posts            map[int]struct{}
-	removedposts     map[int]struct{}
-	clearedposts     bool
-	questions        map[int]struct{}
-	removedquestions map[int]struct{}
-	clearedquestions bool
-	done             bool
-	oldValue         func(context.Context) (*Tag, error)
-	predicates       []predicate.Tag
+	op            Op
+	typ           string
+	id            *int
+	clearedFields map[string]struct{}
+	post          *int
+	clearedpost   bool
+	tag           *int
+	clearedtag    bool
+	done          bool
+	oldValue      func(context.Context) (*PostTag, error)
+	predicates    []predicate.PostTag
 }
 
-var _ ent.Mutation = (*TagMutation)(nil)
+var _ ent.Mutation = (*PostTagMutation)(nil)
 
-// tagOption allows management of the mutation configuration using functional options.
-type tagOption func(*TagMutation)
+// posttagOption allows management of the mutation configuration using functional options.
+type posttagOption func(*PostTagMutation)
 
-// newTagMutation creates new mutation for the Tag entity.
-func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
-	m := &TagMutation{
+// newPostTagMutation creates new mutation for the PostTag entity.
+func newPostTagMutation(c config, op Op, opts ...posttagOption) *PostTagMutation {
+	m := &PostTagMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTag,
+		typ:           TypePostTag,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5305,20 +5363,20 @@ func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
 	return m
 }
 
-// withTagID sets the ID field of the mutation.
-func withTagID(id int) tagOption {
-	return func(m *TagMutation) {
+// withPostTagID sets the ID field of the mutation.
+func withPostTagID(id int) posttagOption {
+	return func(m *PostTagMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Tag
+			value *PostTag
 		)
-		m.oldValue = func(ctx context.Context) (*Tag, error) {
+		m.oldValue = func(ctx context.Context) (*PostTag, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Tag.Get(ctx, id)
+					value, err = m.Client().PostTag.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5327,10 +5385,10 @@ func withTagID(id int) tagOption {
 	}
 }
 
-// withTag sets the old Tag of the mutation.
-func withTag(node *Tag) tagOption {
-	return func(m *TagMutation) {
-		m.oldValue = func(context.Context) (*Tag, error) {
+// withPostTag sets the old PostTag of the mutation.
+func withPostTag(node *PostTag) posttagOption {
+	return func(m *PostTagMutation) {
+		m.oldValue = func(context.Context) (*PostTag, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5339,7 +5397,7 @@ func withTag(node *Tag) tagOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TagMutation) Client() *Client {
+func (m PostTagMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5347,7 +5405,7 @@ func (m TagMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TagMutation) Tx() (*Tx, error) {
+func (m PostTagMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5358,7 +5416,7 @@ func (m TagMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TagMutation) ID() (id int, exists bool) {
+func (m *PostTagMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5369,7 +5427,7 @@ func (m *TagMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *PostTagMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5378,165 +5436,147 @@ func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PostTag.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *TagMutation) SetName(s string) {
-	m.name = &s
+// SetPostID sets the "post_id" field.
+func (m *PostTagMutation) SetPostID(i int) {
+	m.post = &i
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TagMutation) Name() (r string, exists bool) {
-	v := m.name
+// PostID returns the value of the "post_id" field in the mutation.
+func (m *PostTagMutation) PostID() (r int, exists bool) {
+	v := m.post
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Tag entity.
-// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// OldPostID returns the old "post_id" field's value of the PostTag entity.
+// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *PostTagMutation) OldPostID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldPostID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldPostID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldPostID: %w", err)
 	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *TagMutation) ResetName() {
-	m.name = nil
+	return oldValue.PostID, nil
 }
 
-// AddPostIDs adds the "posts" edge to the PostTag entity by ids.
-func (m *TagMutation) AddPostIDs(ids ...int) {
-	if m.posts == nil {
-		m.posts = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.posts[ids[i]] = struct{}{}
-	}
+// ResetPostID resets all changes to the "post_id" field.
+func (m *PostTagMutation) ResetPostID() {
+	m.post = nil
 }
 
-// ClearPosts clears the "posts" edge to the PostTag entity.
-func (m *TagMutation) ClearPosts() {
-	m.clearedposts = true
+// SetTagID sets the "tag_id" field.
+func (m *PostTagMutation) SetTagID(i int) {
+	m.tag = &i
 }
 
-// PostsCleared reports if the "posts" edge to the PostTag entity was cleared.
-func (m *TagMutation) PostsCleared() bool {
-	return m.clearedposts
+// TagID returns the value of the "tag_id" field in the mutation.
+func (m *PostTagMutation) TagID() (r int, exists bool) {
+	v := m.tag
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovePostIDs removes the "posts" edge to the PostTag entity by IDs.
-func (m *TagMutation) RemovePostIDs(ids ...int) {
-	if m.removedposts == nil {
-		m.removedposts = make(map[int]struct{})
+// OldTagID returns the old "tag_id" field's value of the PostTag entity.
+// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostTagMutation) OldTagID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.posts, ids[i])
-		m.removedposts[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagID requires an ID field in the mutation")
 	}
-}
-
-// RemovedPosts returns the removed IDs of the "posts" edge to the PostTag entity.
-func (m *TagMutation) RemovedPostsIDs() (ids []int) {
-	for id := range m.removedposts {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagID: %w", err)
 	}
-	return
+	return oldValue.TagID, nil
 }
 
-// PostsIDs returns the "posts" edge IDs in the mutation.
-func (m *TagMutation) PostsIDs() (ids []int) {
-	for id := range m.posts {
-		ids = append(ids, id)
-	}
-	return
+// ResetTagID resets all changes to the "tag_id" field.
+func (m *PostTagMutation) ResetTagID() {
+	m.tag = nil
 }
 
-// ResetPosts resets all changes to the "posts" edge.
-func (m *TagMutation) ResetPosts() {
-	m.posts = nil
-	m.clearedposts = false
-	m.removedposts = nil
+// ClearPost clears the "post" edge to the Post entity.
+func (m *PostTagMutation) ClearPost() {
+	m.clearedpost = true
+	m.clearedFields[posttag.FieldPostID] = struct{}{}
 }
 
-// AddQuestionIDs adds the "questions" edge to the QuestionTag entity by ids.
-func (m *TagMutation) AddQuestionIDs(ids ...int) {
-	if m.questions == nil {
-		m.questions = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.questions[ids[i]] = struct{}{}
-	}
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *PostTagMutation) PostCleared() bool {
+	return m.clearedpost
 }
 
-// ClearQuestions clears the "questions" edge to the QuestionTag entity.
-func (m *TagMutation) ClearQuestions() {
-	m.clearedquestions = true
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *PostTagMutation) PostIDs() (ids []int) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// QuestionsCleared reports if the "questions" edge to the QuestionTag entity was cleared.
-func (m *TagMutation) QuestionsCleared() bool {
-	return m.clearedquestions
+// ResetPost resets all changes to the "post" edge.
+func (m *PostTagMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
 }
 
-// RemoveQuestionIDs removes the "questions" edge to the QuestionTag entity by IDs.
-func (m *TagMutation) RemoveQuestionIDs(ids ...int) {
-	if m.removedquestions == nil {
-		m.removedquestions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.questions, ids[i])
-		m.removedquestions[ids[i]] = struct{}{}
-	}
+// ClearTag clears the "tag" edge to the Tag entity.
+func (m *PostTagMutation) ClearTag() {
+	m.clearedtag = true
+	m.clearedFields[posttag.FieldTagID] = struct{}{}
 }
 
-// RemovedQuestions returns the removed IDs of the "questions" edge to the QuestionTag entity.
-func (m *TagMutation) RemovedQuestionsIDs() (ids []int) {
-	for id := range m.removedquestions {
-		ids = append(ids, id)
-	}
-	return
+// TagCleared reports if the "tag" edge to the Tag entity was cleared.
+func (m *PostTagMutation) TagCleared() bool {
+	return m.clearedtag
 }
 
-// QuestionsIDs returns the "questions" edge IDs in the mutation.
-func (m *TagMutation) QuestionsIDs() (ids []int) {
-	for id := range m.questions {
-		ids = append(ids, id)
+// TagIDs returns the "tag" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TagID instead. It exists only for internal usage by the builders.
+func (m *PostTagMutation) TagIDs() (ids []int) {
+	if id := m.tag; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetQuestions resets all changes to the "questions" edge.
-func (m *TagMutation) ResetQuestions() {
-	m.questions = nil
-	m.clearedquestions = false
-	m.removedquestions = nil
+// ResetTag resets all changes to the "tag" edge.
+func (m *PostTagMutation) ResetTag() {
+	m.tag = nil
+	m.clearedtag = false
 }
 
-// Where appends a list predicates to the TagMutation builder.
-func (m *TagMutation) Where(ps ...predicate.Tag) {
+// Where appends a list predicates to the PostTagMutation builder.
+func (m *PostTagMutation) Where(ps ...predicate.PostTag) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PostTagMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Tag, len(ps))
+func (m *PostTagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PostTag, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5544,27 +5584,30 @@ func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TagMutation) Op() Op {
+func (m *PostTagMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TagMutation) SetOp(op Op) {
+func (m *PostTagMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Tag).
-func (m *TagMutation) Type() string {
+// Type returns the node type of this mutation (PostTag).
+func (m *PostTagMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TagMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.name != nil {
-		fields = append(fields, tag.FieldName)
+func (m *PostTagMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.post != nil {
+		fields = append(fields, posttag.FieldPostID)
+	}
+	if m.tag != nil {
+		fields = append(fields, posttag.FieldTagID)
 	}
 	return fields
 }
@@ -5572,10 +5615,12 @@ func (m *TagMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TagMutation) Field(name string) (ent.Value, bool) {
+func (m *PostTagMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tag.FieldName:
-		return m.Name()
+	case posttag.FieldPostID:
+		return m.PostID()
+	case posttag.FieldTagID:
+		return m.TagID()
 	}
 	return nil, false
 }
@@ -5583,248 +5628,244 @@ func (m *TagMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PostTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tag.FieldName:
-		return m.OldName(ctx)
+	case posttag.FieldPostID:
+		return m.OldPostID(ctx)
+	case posttag.FieldTagID:
+		return m.OldTagID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Tag field %s", name)
+	return nil, fmt.Errorf("unknown PostTag field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TagMutation) SetField(name string, value ent.Value) error {
+func (m *PostTagMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tag.FieldName:
-		v, ok := value.(string)
+	case posttag.FieldPostID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetPostID(v)
+		return nil
+	case posttag.FieldTagID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Tag field %s", name)
+	return fmt.Errorf("unknown PostTag field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TagMutation) AddedFields() []string {
-	return nil
+func (m *PostTagMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PostTagMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TagMutation) AddField(name string, value ent.Value) error {
+func (m *PostTagMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Tag numeric field %s", name)
+	return fmt.Errorf("unknown PostTag numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TagMutation) ClearedFields() []string {
+func (m *PostTagMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TagMutation) FieldCleared(name string) bool {
+func (m *PostTagMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TagMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Tag nullable field %s", name)
+func (m *PostTagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PostTag nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TagMutation) ResetField(name string) error {
+func (m *PostTagMutation) ResetField(name string) error {
 	switch name {
-	case tag.FieldName:
-		m.ResetName()
+	case posttag.FieldPostID:
+		m.ResetPostID()
 		return nil
-	}
-	return fmt.Errorf("unknown Tag field %s", name)
+	case posttag.FieldTagID:
+		m.ResetTagID()
+		return nil
+	}
+	return fmt.Errorf("unknown PostTag field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TagMutation) AddedEdges() []string {
+func (m *PostTagMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.posts != nil {
-		edges = append(edges, tag.EdgePosts)
+	if m.post != nil {
+		edges = append(edges, posttag.EdgePost)
 	}
-	if m.questions != nil {
-		edges = append(edges, tag.EdgeQuestions)
+	if m.tag != nil {
+		edges = append(edges, posttag.EdgeTag)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TagMutation) AddedIDs(name string) []ent.Value {
+func (m *PostTagMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tag.EdgePosts:
-		ids := make([]ent.Value, 0, len(m.posts))
-		for id := range m.posts {
-			ids = append(ids, id)
+	case posttag.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case tag.EdgeQuestions:
-		ids := make([]ent.Value, 0, len(m.questions))
-		for id := range m.questions {
-			ids = append(ids, id)
+	case posttag.EdgeTag:
+		if id := m.tag; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TagMutation) RemovedEdges() []string {
+func (m *PostTagMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedposts != nil {
-		edges = append(edges, tag.EdgePosts)
-	}
-	if m.removedquestions != nil {
-		edges = append(edges, tag.EdgeQuestions)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TagMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case tag.EdgePosts:
-		ids := make([]ent.Value, 0, len(m.removedposts))
-		for id := range m.removedposts {
-			ids = append(ids, id)
-		}
-		return ids
-	case tag.EdgeQuestions:
-		ids := make([]ent.Value, 0, len(m.removedquestions))
-		for id := range m.removedquestions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *PostTagMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TagMutation) ClearedEdges() []string {
+func (m *PostTagMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedposts {
-		edges = append(edges, tag.EdgePosts)
+	if m.clearedpost {
+		edges = append(edges, posttag.EdgePost)
 	}
-	if m.clearedquestions {
-		edges = append(edges, tag.EdgeQuestions)
+	if m.clearedtag {
+		edges = append(edges, posttag.EdgeTag)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TagMutation) EdgeCleared(name string) bool {
+func (m *PostTagMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tag.EdgePosts:
-		return m.clearedposts
-	case tag.EdgeQuestions:
-		return m.clearedquestions
+	case posttag.EdgePost:
+		return m.clearedpost
+	case posttag.EdgeTag:
+		return m.clearedtag
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TagMutation) ClearEdge(name string) error {
+func (m *PostTagMutation) ClearEdge(name string) error {
 	switch name {
+	case posttag.EdgePost:
+		m.ClearPost()
+		return nil
+	case posttag.EdgeTag:
+		m.ClearTag()
+		return nil
 	}
-	return fmt.Errorf("unknown Tag unique edge %s", name)
+	return fmt.Errorf("unknown PostTag unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TagMutation) ResetEdge(name string) error {
+func (m *PostTagMutation) ResetEdge(name string) error {
 	switch name {
-	case tag.EdgePosts:
-		m.ResetPosts()
+	case posttag.EdgePost:
+		m.ResetPost()
 		return nil
-	case tag.EdgeQuestions:
-		m.ResetQuestions()
+	case posttag.EdgeTag:
+		m.ResetTag()
 		return nil
 	}
-	return fmt.Errorf("unknown Tag edge %s", name)
+	return fmt.Errorf("unknown PostTag edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// QuestionMutation represents an operation that mutates the Question nodes in the graph.
+type QuestionMutation struct {
 	config
-	op                        Op
-	typ                       string
-	id                        *int
-	create_time               *time.Time
-	update_time               *time.Time
-	phone                     *string
-	nickname                  *string
-	password                  *string
-	avatar                    *string
-	clearedFields             map[string]struct{}
-	posts                     map[int]struct{}
-	removedposts              map[int]struct{}
-	clearedposts              bool
-	questions                 map[int]struct{}
-	removedquestions          map[int]struct{}
-	clearedquestions          bool
-	comments                  map[int]struct{}
-	removedcomments           map[int]struct{}
-	clearedcomments           bool
-	like_posts                map[int]struct{}
-	removedlike_posts         map[int]struct{}
-	clearedlike_posts         bool
-	like_questions            map[int]struct{}
-	removedlike_questions     map[int]struct{}
-	clearedlike_questions     bool
-	favorite_posts            map[int]struct{}
-	removedfavorite_posts     map[int]struct{}
-	clearedfavorite_posts     bool
-	favorite_questions        map[int]struct{}
-	removedfavorite_questions map[int]struct{}
-	clearedfavorite_questions bool
-	following                 map[int]struct{}
-	removedfollowing          map[int]struct{}
-	clearedfollowing          bool
-	followed_by               map[int]struct{}
-	removedfollowed_by        map[int]struct{}
-	clearedfollowed_by        bool
-	done                      bool
-	oldValue                  func(context.Context) (*User, error)
-	predicates                []predicate.User
+	op                     Op
+	typ                    string
+	id                     *int
+	create_time            *time.Time
+	update_time            *time.Time
+	title                  *string
+	body                   *string
+	embedding              *pgvector.Vector
+	like_count             *int
+	addlike_count          *int
+	favorite_count         *int
+	addfavorite_count      *int
+	comment_count          *int
+	addcomment_count       *int
+	answer_count           *int
+	addanswer_count        *int
+	clearedFields          map[string]struct{}
+	user                   *int
+	cleareduser            bool
+	comments               map[int]struct{}
+	removedcomments        map[int]struct{}
+	clearedcomments        bool
+	answers                map[int]struct{}
+	removedanswers         map[int]struct{}
+	clearedanswers         bool
+	tags                   map[int]struct{}
+	removedtags            map[int]struct{}
+	clearedtags            bool
+	likes                  map[int]struct{}
+	removedlikes           map[int]struct{}
+	clearedlikes           bool
+	favorites              map[int]struct{}
+	removedfavorites       map[int]struct{}
+	clearedfavorites       bool
+	accepted_answer        *int
+	clearedaccepted_answer bool
+	done                   bool
+	oldValue               func(context.Context) (*Question, error)
+	predicates             []predicate.Question
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*QuestionMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// questionOption allows management of the mutation configuration using functional options.
+type questionOption func(*QuestionMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newQuestionMutation creates new mutation for the Question entity.
+func newQuestionMutation(c config, op Op, opts ...questionOption) *QuestionMutation {
+	m := &QuestionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeQuestion,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5833,20 +5874,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
+// withQuestionID sets the ID field of the mutation.
+func withQuestionID(id int) questionOption {
+	return func(m *QuestionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *Question
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*Question, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().Question.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5855,10 +5896,10 @@ func withUserID(id int) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withQuestion sets the old Question of the mutation.
+func withQuestion(node *Question) questionOption {
+	return func(m *QuestionMutation) {
+		m.oldValue = func(context.Context) (*Question, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5867,7 +5908,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m QuestionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5875,7 +5916,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m QuestionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5886,7 +5927,7 @@ func (m UserMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
+func (m *QuestionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5897,7 +5938,7 @@ func (m *UserMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *QuestionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5906,19 +5947,19 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Question.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *UserMutation) SetCreateTime(t time.Time) {
+func (m *QuestionMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *UserMutation) CreateTime() (r time.Time, exists bool) {
+func (m *QuestionMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -5926,10 +5967,10 @@ func (m *UserMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *QuestionMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -5944,17 +5985,17 @@ func (m *UserMutation) OldCreateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *UserMutation) ResetCreateTime() {
+func (m *QuestionMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *UserMutation) SetUpdateTime(t time.Time) {
+func (m *QuestionMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *UserMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *QuestionMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -5962,10 +6003,10 @@ func (m *UserMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *QuestionMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -5980,675 +6021,7308 @@ func (m *UserMutation) OldUpdateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *UserMutation) ResetUpdateTime() {
+func (m *QuestionMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetPhone sets the "phone" field.
-func (m *UserMutation) SetPhone(s string) {
-	m.phone = &s
+// SetTitle sets the "title" field.
+func (m *QuestionMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// Phone returns the value of the "phone" field in the mutation.
-func (m *UserMutation) Phone() (r string, exists bool) {
-	v := m.phone
+// Title returns the value of the "title" field in the mutation.
+func (m *QuestionMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPhone returns the old "phone" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPhone(ctx context.Context) (v string, err error) {
+func (m *QuestionMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPhone requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.Phone, nil
+	return oldValue.Title, nil
 }
 
-// ResetPhone resets all changes to the "phone" field.
-func (m *UserMutation) ResetPhone() {
-	m.phone = nil
+// ResetTitle resets all changes to the "title" field.
+func (m *QuestionMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetNickname sets the "nickname" field.
-func (m *UserMutation) SetNickname(s string) {
-	m.nickname = &s
+// SetBody sets the "body" field.
+func (m *QuestionMutation) SetBody(s string) {
+	m.body = &s
 }
 
-// Nickname returns the value of the "nickname" field in the mutation.
-func (m *UserMutation) Nickname() (r string, exists bool) {
-	v := m.nickname
+// Body returns the value of the "body" field in the mutation.
+func (m *QuestionMutation) Body() (r string, exists bool) {
+	v := m.body
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNickname returns the old "nickname" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldBody returns the old "body" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldNickname(ctx context.Context) (v string, err error) {
+func (m *QuestionMutation) OldBody(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNickname requires an ID field in the mutation")
+		return v, errors.New("OldBody requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
 	}
-	return oldValue.Nickname, nil
+	return oldValue.Body, nil
 }
 
-// ClearNickname clears the value of the "nickname" field.
-func (m *UserMutation) ClearNickname() {
-	m.nickname = nil
-	m.clearedFields[user.FieldNickname] = struct{}{}
+// ClearBody clears the value of the "body" field.
+func (m *QuestionMutation) ClearBody() {
+	m.body = nil
+	m.clearedFields[question.FieldBody] = struct{}{}
 }
 
-// NicknameCleared returns if the "nickname" field was cleared in this mutation.
-func (m *UserMutation) NicknameCleared() bool {
-	_, ok := m.clearedFields[user.FieldNickname]
+// BodyCleared returns if the "body" field was cleared in this mutation.
+func (m *QuestionMutation) BodyCleared() bool {
+	_, ok := m.clearedFields[question.FieldBody]
 	return ok
 }
 
-// ResetNickname resets all changes to the "nickname" field.
-func (m *UserMutation) ResetNickname() {
-	m.nickname = nil
-	delete(m.clearedFields, user.FieldNickname)
+// ResetBody resets all changes to the "body" field.
+func (m *QuestionMutation) ResetBody() {
+	m.body = nil
+	delete(m.clearedFields, question.FieldBody)
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// SetEmbedding sets the "embedding" field.
+func (m *QuestionMutation) SetEmbedding(pg pgvector.Vector) {
+	m.embedding = &pg
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *QuestionMutation) Embedding() (r pgvector.Vector, exists bool) {
+	v := m.embedding
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldEmbedding returns the old "embedding" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *QuestionMutation) OldEmbedding(ctx context.Context) (v pgvector.Vector, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.Embedding, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// ClearEmbedding clears the value of the "embedding" field.
+func (m *QuestionMutation) ClearEmbedding() {
+	m.embedding = nil
+	m.clearedFields[question.FieldEmbedding] = struct{}{}
 }
 
-// SetAvatar sets the "avatar" field.
-func (m *UserMutation) SetAvatar(s string) {
-	m.avatar = &s
+// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
+func (m *QuestionMutation) EmbeddingCleared() bool {
+	_, ok := m.clearedFields[question.FieldEmbedding]
+	return ok
 }
 
-// Avatar returns the value of the "avatar" field in the mutation.
-func (m *UserMutation) Avatar() (r string, exists bool) {
-	v := m.avatar
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *QuestionMutation) ResetEmbedding() {
+	m.embedding = nil
+	delete(m.clearedFields, question.FieldEmbedding)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *QuestionMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *QuestionMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvatar returns the old "avatar" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+func (m *QuestionMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatar requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Avatar, nil
+	return oldValue.UserID, nil
 }
 
-// ClearAvatar clears the value of the "avatar" field.
-func (m *UserMutation) ClearAvatar() {
-	m.avatar = nil
-	m.clearedFields[user.FieldAvatar] = struct{}{}
+// ClearUserID clears the value of the "user_id" field.
+func (m *QuestionMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[question.FieldUserID] = struct{}{}
 }
 
-// AvatarCleared returns if the "avatar" field was cleared in this mutation.
-func (m *UserMutation) AvatarCleared() bool {
-	_, ok := m.clearedFields[user.FieldAvatar]
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *QuestionMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[question.FieldUserID]
 	return ok
 }
 
-// ResetAvatar resets all changes to the "avatar" field.
-func (m *UserMutation) ResetAvatar() {
-	m.avatar = nil
-	delete(m.clearedFields, user.FieldAvatar)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *QuestionMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, question.FieldUserID)
 }
 
-// AddPostIDs adds the "posts" edge to the Post entity by ids.
-func (m *UserMutation) AddPostIDs(ids ...int) {
-	if m.posts == nil {
-		m.posts = make(map[int]struct{})
+// SetAcceptedAnswerID sets the "accepted_answer_id" field.
+func (m *QuestionMutation) SetAcceptedAnswerID(i int) {
+	m.accepted_answer = &i
+}
+
+// AcceptedAnswerID returns the value of the "accepted_answer_id" field in the mutation.
+func (m *QuestionMutation) AcceptedAnswerID() (r int, exists bool) {
+	v := m.accepted_answer
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.posts[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldAcceptedAnswerID returns the old "accepted_answer_id" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldAcceptedAnswerID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcceptedAnswerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcceptedAnswerID requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcceptedAnswerID: %w", err)
+	}
+	return oldValue.AcceptedAnswerID, nil
 }
 
-// ClearPosts clears the "posts" edge to the Post entity.
-func (m *UserMutation) ClearPosts() {
-	m.clearedposts = true
+// ClearAcceptedAnswerID clears the value of the "accepted_answer_id" field.
+func (m *QuestionMutation) ClearAcceptedAnswerID() {
+	m.accepted_answer = nil
+	m.clearedFields[question.FieldAcceptedAnswerID] = struct{}{}
 }
 
-// PostsCleared reports if the "posts" edge to the Post entity was cleared.
-func (m *UserMutation) PostsCleared() bool {
-	return m.clearedposts
+// AcceptedAnswerIDCleared returns if the "accepted_answer_id" field was cleared in this mutation.
+func (m *QuestionMutation) AcceptedAnswerIDCleared() bool {
+	_, ok := m.clearedFields[question.FieldAcceptedAnswerID]
+	return ok
 }
 
-// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
-func (m *UserMutation) RemovePostIDs(ids ...int) {
-	if m.removedposts == nil {
-		m.removedposts = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.posts, ids[i])
-		m.removedposts[ids[i]] = struct{}{}
-	}
+// ResetAcceptedAnswerID resets all changes to the "accepted_answer_id" field.
+func (m *QuestionMutation) ResetAcceptedAnswerID() {
+	m.accepted_answer = nil
+	delete(m.clearedFields, question.FieldAcceptedAnswerID)
 }
 
-// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
-func (m *UserMutation) RemovedPostsIDs() (ids []int) {
-	for id := range m.removedposts {
-		ids = append(ids, id)
-	}
-	return
+// SetLikeCount sets the "like_count" field.
+func (m *QuestionMutation) SetLikeCount(i int) {
+	m.like_count = &i
+	m.addlike_count = nil
 }
 
-// PostsIDs returns the "posts" edge IDs in the mutation.
-func (m *UserMutation) PostsIDs() (ids []int) {
-	for id := range m.posts {
-		ids = append(ids, id)
+// LikeCount returns the value of the "like_count" field in the mutation.
+func (m *QuestionMutation) LikeCount() (r int, exists bool) {
+	v := m.like_count
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetPosts resets all changes to the "posts" edge.
-func (m *UserMutation) ResetPosts() {
-	m.posts = nil
-	m.clearedposts = false
-	m.removedposts = nil
+// OldLikeCount returns the old "like_count" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldLikeCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLikeCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLikeCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLikeCount: %w", err)
+	}
+	return oldValue.LikeCount, nil
 }
 
-// AddQuestionIDs adds the "questions" edge to the Question entity by ids.
-func (m *UserMutation) AddQuestionIDs(ids ...int) {
-	if m.questions == nil {
-		m.questions = make(map[int]struct{})
+// AddLikeCount adds i to the "like_count" field.
+func (m *QuestionMutation) AddLikeCount(i int) {
+	if m.addlike_count != nil {
+		*m.addlike_count += i
+	} else {
+		m.addlike_count = &i
 	}
-	for i := range ids {
-		m.questions[ids[i]] = struct{}{}
+}
+
+// AddedLikeCount returns the value that was added to the "like_count" field in this mutation.
+func (m *QuestionMutation) AddedLikeCount() (r int, exists bool) {
+	v := m.addlike_count
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearQuestions clears the "questions" edge to the Question entity.
-func (m *UserMutation) ClearQuestions() {
-	m.clearedquestions = true
+// ResetLikeCount resets all changes to the "like_count" field.
+func (m *QuestionMutation) ResetLikeCount() {
+	m.like_count = nil
+	m.addlike_count = nil
 }
 
-// QuestionsCleared reports if the "questions" edge to the Question entity was cleared.
-func (m *UserMutation) QuestionsCleared() bool {
-	return m.clearedquestions
+// SetFavoriteCount sets the "favorite_count" field.
+func (m *QuestionMutation) SetFavoriteCount(i int) {
+	m.favorite_count = &i
+	m.addfavorite_count = nil
 }
 
-// RemoveQuestionIDs removes the "questions" edge to the Question entity by IDs.
-func (m *UserMutation) RemoveQuestionIDs(ids ...int) {
-	if m.removedquestions == nil {
-		m.removedquestions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.questions, ids[i])
-		m.removedquestions[ids[i]] = struct{}{}
+// FavoriteCount returns the value of the "favorite_count" field in the mutation.
+func (m *QuestionMutation) FavoriteCount() (r int, exists bool) {
+	v := m.favorite_count
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedQuestions returns the removed IDs of the "questions" edge to the Question entity.
-func (m *UserMutation) RemovedQuestionsIDs() (ids []int) {
-	for id := range m.removedquestions {
-		ids = append(ids, id)
+// OldFavoriteCount returns the old "favorite_count" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldFavoriteCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFavoriteCount is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFavoriteCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFavoriteCount: %w", err)
+	}
+	return oldValue.FavoriteCount, nil
 }
 
-// QuestionsIDs returns the "questions" edge IDs in the mutation.
-func (m *UserMutation) QuestionsIDs() (ids []int) {
-	for id := range m.questions {
-		ids = append(ids, id)
+// AddFavoriteCount adds i to the "favorite_count" field.
+func (m *QuestionMutation) AddFavoriteCount(i int) {
+	if m.addfavorite_count != nil {
+		*m.addfavorite_count += i
+	} else {
+		m.addfavorite_count = &i
 	}
-	return
 }
 
-// ResetQuestions resets all changes to the "questions" edge.
-func (m *UserMutation) ResetQuestions() {
-	m.questions = nil
-	m.clearedquestions = false
-	m.removedquestions = nil
+// AddedFavoriteCount returns the value that was added to the "favorite_count" field in this mutation.
+func (m *QuestionMutation) AddedFavoriteCount() (r int, exists bool) {
+	v := m.addfavorite_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
-func (m *UserMutation) AddCommentIDs(ids ...int) {
-	if m.comments == nil {
-		m.comments = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.comments[ids[i]] = struct{}{}
-	}
+// ResetFavoriteCount resets all changes to the "favorite_count" field.
+func (m *QuestionMutation) ResetFavoriteCount() {
+	m.favorite_count = nil
+	m.addfavorite_count = nil
 }
 
-// ClearComments clears the "comments" edge to the Comment entity.
-func (m *UserMutation) ClearComments() {
-	m.clearedcomments = true
+// SetCommentCount sets the "comment_count" field.
+func (m *QuestionMutation) SetCommentCount(i int) {
+	m.comment_count = &i
+	m.addcomment_count = nil
 }
 
-// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
-func (m *UserMutation) CommentsCleared() bool {
-	return m.clearedcomments
+// CommentCount returns the value of the "comment_count" field in the mutation.
+func (m *QuestionMutation) CommentCount() (r int, exists bool) {
+	v := m.comment_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
-func (m *UserMutation) RemoveCommentIDs(ids ...int) {
-	if m.removedcomments == nil {
-		m.removedcomments = make(map[int]struct{})
+// OldCommentCount returns the old "comment_count" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldCommentCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommentCount is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.comments, ids[i])
-		m.removedcomments[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommentCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommentCount: %w", err)
 	}
+	return oldValue.CommentCount, nil
 }
 
-// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
-func (m *UserMutation) RemovedCommentsIDs() (ids []int) {
-	for id := range m.removedcomments {
-		ids = append(ids, id)
+// AddCommentCount adds i to the "comment_count" field.
+func (m *QuestionMutation) AddCommentCount(i int) {
+	if m.addcomment_count != nil {
+		*m.addcomment_count += i
+	} else {
+		m.addcomment_count = &i
 	}
-	return
 }
 
-// CommentsIDs returns the "comments" edge IDs in the mutation.
-func (m *UserMutation) CommentsIDs() (ids []int) {
-	for id := range m.comments {
-		ids = append(ids, id)
+// AddedCommentCount returns the value that was added to the "comment_count" field in this mutation.
+func (m *QuestionMutation) AddedCommentCount() (r int, exists bool) {
+	v := m.addcomment_count
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetComments resets all changes to the "comments" edge.
-func (m *UserMutation) ResetComments() {
-	m.comments = nil
-	m.clearedcomments = false
-	m.removedcomments = nil
+// ResetCommentCount resets all changes to the "comment_count" field.
+func (m *QuestionMutation) ResetCommentCount() {
+	m.comment_count = nil
+	m.addcomment_count = nil
 }
 
-// AddLikePostIDs adds the "like_posts" edge to the UserLikePost entity by ids.
-func (m *UserMutation) AddLikePostIDs(ids ...int) {
-	if m.like_posts == nil {
-		m.like_posts = make(map[int]struct{})
+// SetAnswerCount sets the "answer_count" field.
+func (m *QuestionMutation) SetAnswerCount(i int) {
+	m.answer_count = &i
+	m.addanswer_count = nil
+}
+
+// AnswerCount returns the value of the "answer_count" field in the mutation.
+func (m *QuestionMutation) AnswerCount() (r int, exists bool) {
+	v := m.answer_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswerCount returns the old "answer_count" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldAnswerCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswerCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswerCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswerCount: %w", err)
+	}
+	return oldValue.AnswerCount, nil
+}
+
+// AddAnswerCount adds i to the "answer_count" field.
+func (m *QuestionMutation) AddAnswerCount(i int) {
+	if m.addanswer_count != nil {
+		*m.addanswer_count += i
+	} else {
+		m.addanswer_count = &i
+	}
+}
+
+// AddedAnswerCount returns the value that was added to the "answer_count" field in this mutation.
+func (m *QuestionMutation) AddedAnswerCount() (r int, exists bool) {
+	v := m.addanswer_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAnswerCount resets all changes to the "answer_count" field.
+func (m *QuestionMutation) ResetAnswerCount() {
+	m.answer_count = nil
+	m.addanswer_count = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *QuestionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[question.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *QuestionMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *QuestionMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *QuestionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *QuestionMutation) AddCommentIDs(ids ...int) {
+	if m.comments == nil {
+		m.comments = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.like_posts[ids[i]] = struct{}{}
+		m.comments[ids[i]] = struct{}{}
 	}
 }
 
-// ClearLikePosts clears the "like_posts" edge to the UserLikePost entity.
-func (m *UserMutation) ClearLikePosts() {
-	m.clearedlike_posts = true
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *QuestionMutation) ClearComments() {
+	m.clearedcomments = true
 }
 
-// LikePostsCleared reports if the "like_posts" edge to the UserLikePost entity was cleared.
-func (m *UserMutation) LikePostsCleared() bool {
-	return m.clearedlike_posts
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *QuestionMutation) CommentsCleared() bool {
+	return m.clearedcomments
 }
 
-// RemoveLikePostIDs removes the "like_posts" edge to the UserLikePost entity by IDs.
-func (m *UserMutation) RemoveLikePostIDs(ids ...int) {
-	if m.removedlike_posts == nil {
-		m.removedlike_posts = make(map[int]struct{})
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *QuestionMutation) RemoveCommentIDs(ids ...int) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.like_posts, ids[i])
-		m.removedlike_posts[ids[i]] = struct{}{}
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedLikePosts returns the removed IDs of the "like_posts" edge to the UserLikePost entity.
-func (m *UserMutation) RemovedLikePostsIDs() (ids []int) {
-	for id := range m.removedlike_posts {
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *QuestionMutation) RemovedCommentsIDs() (ids []int) {
+	for id := range m.removedcomments {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// LikePostsIDs returns the "like_posts" edge IDs in the mutation.
-func (m *UserMutation) LikePostsIDs() (ids []int) {
-	for id := range m.like_posts {
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *QuestionMutation) CommentsIDs() (ids []int) {
+	for id := range m.comments {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetLikePosts resets all changes to the "like_posts" edge.
-func (m *UserMutation) ResetLikePosts() {
-	m.like_posts = nil
-	m.clearedlike_posts = false
-	m.removedlike_posts = nil
+// ResetComments resets all changes to the "comments" edge.
+func (m *QuestionMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
 }
 
-// AddLikeQuestionIDs adds the "like_questions" edge to the UserLikeQuestion entity by ids.
-func (m *UserMutation) AddLikeQuestionIDs(ids ...int) {
-	if m.like_questions == nil {
-		m.like_questions = make(map[int]struct{})
+// AddAnswerIDs adds the "answers" edge to the Answer entity by ids.
+func (m *QuestionMutation) AddAnswerIDs(ids ...int) {
+	if m.answers == nil {
+		m.answers = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.like_questions[ids[i]] = struct{}{}
+		m.answers[ids[i]] = struct{}{}
 	}
 }
 
-// ClearLikeQuestions clears the "like_questions" edge to the UserLikeQuestion entity.
-func (m *UserMutation) ClearLikeQuestions() {
-	m.clearedlike_questions = true
+// ClearAnswers clears the "answers" edge to the Answer entity.
+func (m *QuestionMutation) ClearAnswers() {
+	m.clearedanswers = true
 }
 
-// LikeQuestionsCleared reports if the "like_questions" edge to the UserLikeQuestion entity was cleared.
-func (m *UserMutation) LikeQuestionsCleared() bool {
-	return m.clearedlike_questions
+// AnswersCleared reports if the "answers" edge to the Answer entity was cleared.
+func (m *QuestionMutation) AnswersCleared() bool {
+	return m.clearedanswers
 }
 
-// RemoveLikeQuestionIDs removes the "like_questions" edge to the UserLikeQuestion entity by IDs.
-func (m *UserMutation) RemoveLikeQuestionIDs(ids ...int) {
-	if m.removedlike_questions == nil {
-		m.removedlike_questions = make(map[int]struct{})
+// RemoveAnswerIDs removes the "answers" edge to the Answer entity by IDs.
+func (m *QuestionMutation) RemoveAnswerIDs(ids ...int) {
+	if m.removedanswers == nil {
+		m.removedanswers = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.like_questions, ids[i])
-		m.removedlike_questions[ids[i]] = struct{}{}
+		delete(m.answers, ids[i])
+		m.removedanswers[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedLikeQuestions returns the removed IDs of the "like_questions" edge to the UserLikeQuestion entity.
-func (m *UserMutation) RemovedLikeQuestionsIDs() (ids []int) {
-	for id := range m.removedlike_questions {
+// RemovedAnswers returns the removed IDs of the "answers" edge to the Answer entity.
+func (m *QuestionMutation) RemovedAnswersIDs() (ids []int) {
+	for id := range m.removedanswers {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// LikeQuestionsIDs returns the "like_questions" edge IDs in the mutation.
-func (m *UserMutation) LikeQuestionsIDs() (ids []int) {
-	for id := range m.like_questions {
+// AnswersIDs returns the "answers" edge IDs in the mutation.
+func (m *QuestionMutation) AnswersIDs() (ids []int) {
+	for id := range m.answers {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetLikeQuestions resets all changes to the "like_questions" edge.
-func (m *UserMutation) ResetLikeQuestions() {
-	m.like_questions = nil
-	m.clearedlike_questions = false
-	m.removedlike_questions = nil
+// ResetAnswers resets all changes to the "answers" edge.
+func (m *QuestionMutation) ResetAnswers() {
+	m.answers = nil
+	m.clearedanswers = false
+	m.removedanswers = nil
 }
 
-// AddFavoritePostIDs adds the "favorite_posts" edge to the UserFavoritePost entity by ids.
-func (m *UserMutation) AddFavoritePostIDs(ids ...int) {
-	if m.favorite_posts == nil {
-		m.favorite_posts = make(map[int]struct{})
+// AddTagIDs adds the "tags" edge to the QuestionTag entity by ids.
+func (m *QuestionMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.favorite_posts[ids[i]] = struct{}{}
+		m.tags[ids[i]] = struct{}{}
 	}
 }
 
-// ClearFavoritePosts clears the "favorite_posts" edge to the UserFavoritePost entity.
-func (m *UserMutation) ClearFavoritePosts() {
-	m.clearedfavorite_posts = true
+// ClearTags clears the "tags" edge to the QuestionTag entity.
+func (m *QuestionMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the QuestionTag entity was cleared.
+func (m *QuestionMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the QuestionTag entity by IDs.
+func (m *QuestionMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the QuestionTag entity.
+func (m *QuestionMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *QuestionMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *QuestionMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddLikeIDs adds the "likes" edge to the UserLikeQuestion entity by ids.
+func (m *QuestionMutation) AddLikeIDs(ids ...int) {
+	if m.likes == nil {
+		m.likes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.likes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLikes clears the "likes" edge to the UserLikeQuestion entity.
+func (m *QuestionMutation) ClearLikes() {
+	m.clearedlikes = true
+}
+
+// LikesCleared reports if the "likes" edge to the UserLikeQuestion entity was cleared.
+func (m *QuestionMutation) LikesCleared() bool {
+	return m.clearedlikes
+}
+
+// RemoveLikeIDs removes the "likes" edge to the UserLikeQuestion entity by IDs.
+func (m *QuestionMutation) RemoveLikeIDs(ids ...int) {
+	if m.removedlikes == nil {
+		m.removedlikes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.likes, ids[i])
+		m.removedlikes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLikes returns the removed IDs of the "likes" edge to the UserLikeQuestion entity.
+func (m *QuestionMutation) RemovedLikesIDs() (ids []int) {
+	for id := range m.removedlikes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LikesIDs returns the "likes" edge IDs in the mutation.
+func (m *QuestionMutation) LikesIDs() (ids []int) {
+	for id := range m.likes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLikes resets all changes to the "likes" edge.
+func (m *QuestionMutation) ResetLikes() {
+	m.likes = nil
+	m.clearedlikes = false
+	m.removedlikes = nil
+}
+
+// AddFavoriteIDs adds the "favorites" edge to the UserFavoriteQuestion entity by ids.
+func (m *QuestionMutation) AddFavoriteIDs(ids ...int) {
+	if m.favorites == nil {
+		m.favorites = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.favorites[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFavorites clears the "favorites" edge to the UserFavoriteQuestion entity.
+func (m *QuestionMutation) ClearFavorites() {
+	m.clearedfavorites = true
+}
+
+// FavoritesCleared reports if the "favorites" edge to the UserFavoriteQuestion entity was cleared.
+func (m *QuestionMutation) FavoritesCleared() bool {
+	return m.clearedfavorites
+}
+
+// RemoveFavoriteIDs removes the "favorites" edge to the UserFavoriteQuestion entity by IDs.
+func (m *QuestionMutation) RemoveFavoriteIDs(ids ...int) {
+	if m.removedfavorites == nil {
+		m.removedfavorites = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.favorites, ids[i])
+		m.removedfavorites[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFavorites returns the removed IDs of the "favorites" edge to the UserFavoriteQuestion entity.
+func (m *QuestionMutation) RemovedFavoritesIDs() (ids []int) {
+	for id := range m.removedfavorites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FavoritesIDs returns the "favorites" edge IDs in the mutation.
+func (m *QuestionMutation) FavoritesIDs() (ids []int) {
+	for id := range m.favorites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFavorites resets all changes to the "favorites" edge.
+func (m *QuestionMutation) ResetFavorites() {
+	m.favorites = nil
+	m.clearedfavorites = false
+	m.removedfavorites = nil
+}
+
+// ClearAcceptedAnswer clears the "accepted_answer" edge to the Answer entity.
+func (m *QuestionMutation) ClearAcceptedAnswer() {
+	m.clearedaccepted_answer = true
+	m.clearedFields[question.FieldAcceptedAnswerID] = struct{}{}
+}
+
+// AcceptedAnswerCleared reports if the "accepted_answer" edge to the Answer entity was cleared.
+func (m *QuestionMutation) AcceptedAnswerCleared() bool {
+	return m.AcceptedAnswerIDCleared() || m.clearedaccepted_answer
+}
+
+// AcceptedAnswerIDs returns the "accepted_answer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AcceptedAnswerID instead. It exists only for internal usage by the builders.
+func (m *QuestionMutation) AcceptedAnswerIDs() (ids []int) {
+	if id := m.accepted_answer; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAcceptedAnswer resets all changes to the "accepted_answer" edge.
+func (m *QuestionMutation) ResetAcceptedAnswer() {
+	m.accepted_answer = nil
+	m.clearedaccepted_answer = false
+}
+
+// Where appends a list predicates to the QuestionMutation builder.
+func (m *QuestionMutation) Where(ps ...predicate.Question) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuestionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuestionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Question, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuestionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuestionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Question).
+func (m *QuestionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuestionMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.create_time != nil {
+		fields = append(fields, question.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, question.FieldUpdateTime)
+	}
+	if m.title != nil {
+		fields = append(fields, question.FieldTitle)
+	}
+	if m.body != nil {
+		fields = append(fields, question.FieldBody)
+	}
+	if m.embedding != nil {
+		fields = append(fields, question.FieldEmbedding)
+	}
+	if m.user != nil {
+		fields = append(fields, question.FieldUserID)
+	}
+	if m.accepted_answer != nil {
+		fields = append(fields, question.FieldAcceptedAnswerID)
+	}
+	if m.like_count != nil {
+		fields = append(fields, question.FieldLikeCount)
+	}
+	if m.favorite_count != nil {
+		fields = append(fields, question.FieldFavoriteCount)
+	}
+	if m.comment_count != nil {
+		fields = append(fields, question.FieldCommentCount)
+	}
+	if m.answer_count != nil {
+		fields = append(fields, question.FieldAnswerCount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuestionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case question.FieldCreateTime:
+		return m.CreateTime()
+	case question.FieldUpdateTime:
+		return m.UpdateTime()
+	case question.FieldTitle:
+		return m.Title()
+	case question.FieldBody:
+		return m.Body()
+	case question.FieldEmbedding:
+		return m.Embedding()
+	case question.FieldUserID:
+		return m.UserID()
+	case question.FieldAcceptedAnswerID:
+		return m.AcceptedAnswerID()
+	case question.FieldLikeCount:
+		return m.LikeCount()
+	case question.FieldFavoriteCount:
+		return m.FavoriteCount()
+	case question.FieldCommentCount:
+		return m.CommentCount()
+	case question.FieldAnswerCount:
+		return m.AnswerCount()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case question.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case question.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case question.FieldTitle:
+		return m.OldTitle(ctx)
+	case question.FieldBody:
+		return m.OldBody(ctx)
+	case question.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case question.FieldUserID:
+		return m.OldUserID(ctx)
+	case question.FieldAcceptedAnswerID:
+		return m.OldAcceptedAnswerID(ctx)
+	case question.FieldLikeCount:
+		return m.OldLikeCount(ctx)
+	case question.FieldFavoriteCount:
+		return m.OldFavoriteCount(ctx)
+	case question.FieldCommentCount:
+		return m.OldCommentCount(ctx)
+	case question.FieldAnswerCount:
+		return m.OldAnswerCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown Question field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case question.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case question.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case question.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case question.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case question.FieldEmbedding:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbedding(v)
+		return nil
+	case question.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case question.FieldAcceptedAnswerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcceptedAnswerID(v)
+		return nil
+	case question.FieldLikeCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLikeCount(v)
+		return nil
+	case question.FieldFavoriteCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFavoriteCount(v)
+		return nil
+	case question.FieldCommentCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommentCount(v)
+		return nil
+	case question.FieldAnswerCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Question field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuestionMutation) AddedFields() []string {
+	var fields []string
+	if m.addlike_count != nil {
+		fields = append(fields, question.FieldLikeCount)
+	}
+	if m.addfavorite_count != nil {
+		fields = append(fields, question.FieldFavoriteCount)
+	}
+	if m.addcomment_count != nil {
+		fields = append(fields, question.FieldCommentCount)
+	}
+	if m.addanswer_count != nil {
+		fields = append(fields, question.FieldAnswerCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuestionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case question.FieldLikeCount:
+		return m.AddedLikeCount()
+	case question.FieldFavoriteCount:
+		return m.AddedFavoriteCount()
+	case question.FieldCommentCount:
+		return m.AddedCommentCount()
+	case question.FieldAnswerCount:
+		return m.AddedAnswerCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case question.FieldLikeCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLikeCount(v)
+		return nil
+	case question.FieldFavoriteCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFavoriteCount(v)
+		return nil
+	case question.FieldCommentCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCommentCount(v)
+		return nil
+	case question.FieldAnswerCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAnswerCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Question numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuestionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(question.FieldBody) {
+		fields = append(fields, question.FieldBody)
+	}
+	if m.FieldCleared(question.FieldEmbedding) {
+		fields = append(fields, question.FieldEmbedding)
+	}
+	if m.FieldCleared(question.FieldUserID) {
+		fields = append(fields, question.FieldUserID)
+	}
+	if m.FieldCleared(question.FieldAcceptedAnswerID) {
+		fields = append(fields, question.FieldAcceptedAnswerID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuestionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuestionMutation) ClearField(name string) error {
+	switch name {
+	case question.FieldBody:
+		m.ClearBody()
+		return nil
+	case question.FieldEmbedding:
+		m.ClearEmbedding()
+		return nil
+	case question.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case question.FieldAcceptedAnswerID:
+		m.ClearAcceptedAnswerID()
+		return nil
+	}
+	return fmt.Errorf("unknown Question nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuestionMutation) ResetField(name string) error {
+	switch name {
+	case question.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case question.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case question.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case question.FieldBody:
+		m.ResetBody()
+		return nil
+	case question.FieldEmbedding:
+		m.ResetEmbedding()
+		return nil
+	case question.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case question.FieldAcceptedAnswerID:
+		m.ResetAcceptedAnswerID()
+		return nil
+	case question.FieldLikeCount:
+		m.ResetLikeCount()
+		return nil
+	case question.FieldFavoriteCount:
+		m.ResetFavoriteCount()
+		return nil
+	case question.FieldCommentCount:
+		m.ResetCommentCount()
+		return nil
+	case question.FieldAnswerCount:
+		m.ResetAnswerCount()
+		return nil
+	}
+	return fmt.Errorf("unknown Question field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuestionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.user != nil {
+		edges = append(edges, question.EdgeUser)
+	}
+	if m.comments != nil {
+		edges = append(edges, question.EdgeComments)
+	}
+	if m.answers != nil {
+		edges = append(edges, question.EdgeAnswers)
+	}
+	if m.tags != nil {
+		edges = append(edges, question.EdgeTags)
+	}
+	if m.likes != nil {
+		edges = append(edges, question.EdgeLikes)
+	}
+	if m.favorites != nil {
+		edges = append(edges, question.EdgeFavorites)
+	}
+	if m.accepted_answer != nil {
+		edges = append(edges, question.EdgeAcceptedAnswer)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuestionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case question.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case question.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case question.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.answers))
+		for id := range m.answers {
+			ids = append(ids, id)
+		}
+		return ids
+	case question.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case question.EdgeLikes:
+		ids := make([]ent.Value, 0, len(m.likes))
+		for id := range m.likes {
+			ids = append(ids, id)
+		}
+		return ids
+	case question.EdgeFavorites:
+		ids := make([]ent.Value, 0, len(m.favorites))
+		for id := range m.favorites {
+			ids = append(ids, id)
+		}
+		return ids
+	case question.EdgeAcceptedAnswer:
+		if id := m.accepted_answer; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuestionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedcomments != nil {
+		edges = append(edges, question.EdgeComments)
+	}
+	if m.removedanswers != nil {
+		edges = append(edges, question.EdgeAnswers)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, question.EdgeTags)
+	}
+	if m.removedlikes != nil {
+		edges = append(edges, question.EdgeLikes)
+	}
+	if m.removedfavorites != nil {
+		edges = append(edges, question.EdgeFavorites)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuestionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case question.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case question.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.removedanswers))
+		for id := range m.removedanswers {
+			ids = append(ids, id)
+		}
+		return ids
+	case question.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case question.EdgeLikes:
+		ids := make([]ent.Value, 0, len(m.removedlikes))
+		for id := range m.removedlikes {
+			ids = append(ids, id)
+		}
+		return ids
+	case question.EdgeFavorites:
+		ids := make([]ent.Value, 0, len(m.removedfavorites))
+		for id := range m.removedfavorites {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuestionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.cleareduser {
+		edges = append(edges, question.EdgeUser)
+	}
+	if m.clearedcomments {
+		edges = append(edges, question.EdgeComments)
+	}
+	if m.clearedanswers {
+		edges = append(edges, question.EdgeAnswers)
+	}
+	if m.clearedtags {
+		edges = append(edges, question.EdgeTags)
+	}
+	if m.clearedlikes {
+		edges = append(edges, question.EdgeLikes)
+	}
+	if m.clearedfavorites {
+		edges = append(edges, question.EdgeFavorites)
+	}
+	if m.clearedaccepted_answer {
+		edges = append(edges, question.EdgeAcceptedAnswer)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuestionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case question.EdgeUser:
+		return m.cleareduser
+	case question.EdgeComments:
+		return m.clearedcomments
+	case question.EdgeAnswers:
+		return m.clearedanswers
+	case question.EdgeTags:
+		return m.clearedtags
+	case question.EdgeLikes:
+		return m.clearedlikes
+	case question.EdgeFavorites:
+		return m.clearedfavorites
+	case question.EdgeAcceptedAnswer:
+		return m.clearedaccepted_answer
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuestionMutation) ClearEdge(name string) error {
+	switch name {
+	case question.EdgeUser:
+		m.ClearUser()
+		return nil
+	case question.EdgeAcceptedAnswer:
+		m.ClearAcceptedAnswer()
+		return nil
+	}
+	return fmt.Errorf("unknown Question unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuestionMutation) ResetEdge(name string) error {
+	switch name {
+	case question.EdgeUser:
+		m.ResetUser()
+		return nil
+	case question.EdgeComments:
+		m.ResetComments()
+		return nil
+	case question.EdgeAnswers:
+		m.ResetAnswers()
+		return nil
+	case question.EdgeTags:
+		m.ResetTags()
+		return nil
+	case question.EdgeLikes:
+		m.ResetLikes()
+		return nil
+	case question.EdgeFavorites:
+		m.ResetFavorites()
+		return nil
+	case question.EdgeAcceptedAnswer:
+		m.ResetAcceptedAnswer()
+		return nil
+	}
+	return fmt.Errorf("unknown Question edge %s", name)
+}
+
+// QuestionTagMutation represents an operation that mutates the QuestionTag nodes in the graph.
+type QuestionTagMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	clearedFields   map[string]struct{}
+	question        *int
+	clearedquestion bool
+	tag             *int
+	clearedtag      bool
+	done            bool
+	oldValue        func(context.Context) (*QuestionTag, error)
+	predicates      []predicate.QuestionTag
+}
+
+var _ ent.Mutation = (*QuestionTagMutation)(nil)
+
+// questiontagOption allows management of the mutation configuration using functional options.
+type questiontagOption func(*QuestionTagMutation)
+
+// newQuestionTagMutation creates new mutation for the QuestionTag entity.
+func newQuestionTagMutation(c config, op Op, opts ...questiontagOption) *QuestionTagMutation {
+	m := &QuestionTagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuestionTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuestionTagID sets the ID field of the mutation.
+func withQuestionTagID(id int) questiontagOption {
+	return func(m *QuestionTagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QuestionTag
+		)
+		m.oldValue = func(ctx context.Context) (*QuestionTag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QuestionTag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuestionTag sets the old QuestionTag of the mutation.
+func withQuestionTag(node *QuestionTag) questiontagOption {
+	return func(m *QuestionTagMutation) {
+		m.oldValue = func(context.Context) (*QuestionTag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuestionTagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuestionTagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuestionTagMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuestionTagMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().QuestionTag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetQuestionID sets the "question_id" field.
+func (m *QuestionTagMutation) SetQuestionID(i int) {
+	m.question = &i
+}
+
+// QuestionID returns the value of the "question_id" field in the mutation.
+func (m *QuestionTagMutation) QuestionID() (r int, exists bool) {
+	v := m.question
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionID returns the old "question_id" field's value of the QuestionTag entity.
+// If the QuestionTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionTagMutation) OldQuestionID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionID: %w", err)
+	}
+	return oldValue.QuestionID, nil
+}
+
+// ResetQuestionID resets all changes to the "question_id" field.
+func (m *QuestionTagMutation) ResetQuestionID() {
+	m.question = nil
+}
+
+// SetTagID sets the "tag_id" field.
+func (m *QuestionTagMutation) SetTagID(i int) {
+	m.tag = &i
+}
+
+// TagID returns the value of the "tag_id" field in the mutation.
+func (m *QuestionTagMutation) TagID() (r int, exists bool) {
+	v := m.tag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagID returns the old "tag_id" field's value of the QuestionTag entity.
+// If the QuestionTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionTagMutation) OldTagID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagID: %w", err)
+	}
+	return oldValue.TagID, nil
+}
+
+// ResetTagID resets all changes to the "tag_id" field.
+func (m *QuestionTagMutation) ResetTagID() {
+	m.tag = nil
+}
+
+// ClearQuestion clears the "question" edge to the Question entity.
+func (m *QuestionTagMutation) ClearQuestion() {
+	m.clearedquestion = true
+	m.clearedFields[questiontag.FieldQuestionID] = struct{}{}
+}
+
+// QuestionCleared reports if the "question" edge to the Question entity was cleared.
+func (m *QuestionTagMutation) QuestionCleared() bool {
+	return m.clearedquestion
+}
+
+// QuestionIDs returns the "question" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuestionID instead. It exists only for internal usage by the builders.
+func (m *QuestionTagMutation) QuestionIDs() (ids []int) {
+	if id := m.question; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetQuestion resets all changes to the "question" edge.
+func (m *QuestionTagMutation) ResetQuestion() {
+	m.question = nil
+	m.clearedquestion = false
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (m *QuestionTagMutation) ClearTag() {
+	m.clearedtag = true
+	m.clearedFields[questiontag.FieldTagID] = struct{}{}
+}
+
+// TagCleared reports if the "tag" edge to the Tag entity was cleared.
+func (m *QuestionTagMutation) TagCleared() bool {
+	return m.clearedtag
+}
+
+// TagIDs returns the "tag" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TagID instead. It exists only for internal usage by the builders.
+func (m *QuestionTagMutation) TagIDs() (ids []int) {
+	if id := m.tag; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTag resets all changes to the "tag" edge.
+func (m *QuestionTagMutation) ResetTag() {
+	m.tag = nil
+	m.clearedtag = false
+}
+
+// Where appends a list predicates to the QuestionTagMutation builder.
+func (m *QuestionTagMutation) Where(ps ...predicate.QuestionTag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuestionTagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuestionTagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QuestionTag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuestionTagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuestionTagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (QuestionTag).
+func (m *QuestionTagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuestionTagMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.question != nil {
+		fields = append(fields, questiontag.FieldQuestionID)
+	}
+	if m.tag != nil {
+		fields = append(fields, questiontag.FieldTagID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuestionTagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case questiontag.FieldQuestionID:
+		return m.QuestionID()
+	case questiontag.FieldTagID:
+		return m.TagID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuestionTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case questiontag.FieldQuestionID:
+		return m.OldQuestionID(ctx)
+	case questiontag.FieldTagID:
+		return m.OldTagID(ctx)
+	}
+	return nil, fmt.Errorf("unknown QuestionTag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionTagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case questiontag.FieldQuestionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionID(v)
+		return nil
+	case questiontag.FieldTagID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QuestionTag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuestionTagMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuestionTagMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionTagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown QuestionTag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuestionTagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuestionTagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuestionTagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown QuestionTag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuestionTagMutation) ResetField(name string) error {
+	switch name {
+	case questiontag.FieldQuestionID:
+		m.ResetQuestionID()
+		return nil
+	case questiontag.FieldTagID:
+		m.ResetTagID()
+		return nil
+	}
+	return fmt.Errorf("unknown QuestionTag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuestionTagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.question != nil {
+		edges = append(edges, questiontag.EdgeQuestion)
+	}
+	if m.tag != nil {
+		edges = append(edges, questiontag.EdgeTag)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuestionTagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case questiontag.EdgeQuestion:
+		if id := m.question; id != nil {
+			return []ent.Value{*id}
+		}
+	case questiontag.EdgeTag:
+		if id := m.tag; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuestionTagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuestionTagMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuestionTagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedquestion {
+		edges = append(edges, questiontag.EdgeQuestion)
+	}
+	if m.clearedtag {
+		edges = append(edges, questiontag.EdgeTag)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuestionTagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case questiontag.EdgeQuestion:
+		return m.clearedquestion
+	case questiontag.EdgeTag:
+		return m.clearedtag
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuestionTagMutation) ClearEdge(name string) error {
+	switch name {
+	case questiontag.EdgeQuestion:
+		m.ClearQuestion()
+		return nil
+	case questiontag.EdgeTag:
+		m.ClearTag()
+		return nil
+	}
+	return fmt.Errorf("unknown QuestionTag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuestionTagMutation) ResetEdge(name string) error {
+	switch name {
+	case questiontag.EdgeQuestion:
+		m.ResetQuestion()
+		return nil
+	case questiontag.EdgeTag:
+		m.ResetTag()
+		return nil
+	}
+	return fmt.Errorf("unknown QuestionTag edge %s", name)
+}
+
+// RefreshTokenMutation represents an operation that mutates the RefreshToken nodes in the graph.
+type RefreshTokenMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	create_time    *time.Time
+	update_time    *time.Time
+	jti            *string
+	expires_at     *time.Time
+	used_at        *time.Time
+	revoked_at     *time.Time
+	clearedFields  map[string]struct{}
+	user           *int
+	cleareduser    bool
+	session        *int
+	clearedsession bool
+	done           bool
+	oldValue       func(context.Context) (*RefreshToken, error)
+	predicates     []predicate.RefreshToken
+}
+
+var _ ent.Mutation = (*RefreshTokenMutation)(nil)
+
+// refreshtokenOption allows management of the mutation configuration using functional options.
+type refreshtokenOption func(*RefreshTokenMutation)
+
+// newRefreshTokenMutation creates new mutation for the RefreshToken entity.
+func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *RefreshTokenMutation {
+	m := &RefreshTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRefreshToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRefreshTokenID sets the ID field of the mutation.
+func withRefreshTokenID(id int) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RefreshToken
+		)
+		m.oldValue = func(ctx context.Context) (*RefreshToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RefreshToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRefreshToken sets the old RefreshToken of the mutation.
+func withRefreshToken(node *RefreshToken) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		m.oldValue = func(context.Context) (*RefreshToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RefreshTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RefreshTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RefreshTokenMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RefreshToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *RefreshTokenMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *RefreshTokenMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *RefreshTokenMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *RefreshTokenMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *RefreshTokenMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *RefreshTokenMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetJti sets the "jti" field.
+func (m *RefreshTokenMutation) SetJti(s string) {
+	m.jti = &s
+}
+
+// Jti returns the value of the "jti" field in the mutation.
+func (m *RefreshTokenMutation) Jti() (r string, exists bool) {
+	v := m.jti
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJti returns the old "jti" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldJti(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJti is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJti requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJti: %w", err)
+	}
+	return oldValue.Jti, nil
+}
+
+// ResetJti resets all changes to the "jti" field.
+func (m *RefreshTokenMutation) ResetJti() {
+	m.jti = nil
+}
+
+// SetSessionID sets the "session_id" field.
+func (m *RefreshTokenMutation) SetSessionID(i int) {
+	m.session = &i
+}
+
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *RefreshTokenMutation) SessionID() (r int, exists bool) {
+	v := m.session
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionID returns the old "session_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldSessionID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+	}
+	return oldValue.SessionID, nil
+}
+
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *RefreshTokenMutation) ResetSessionID() {
+	m.session = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RefreshTokenMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RefreshTokenMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RefreshTokenMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *RefreshTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *RefreshTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *RefreshTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *RefreshTokenMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *RefreshTokenMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsedAt returns the old "used_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+	}
+	return oldValue.UsedAt, nil
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *RefreshTokenMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[refreshtoken.FieldUsedAt] = struct{}{}
+}
+
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *RefreshTokenMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldUsedAt]
+	return ok
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *RefreshTokenMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, refreshtoken.FieldUsedAt)
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *RefreshTokenMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *RefreshTokenMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *RefreshTokenMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[refreshtoken.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *RefreshTokenMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *RefreshTokenMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, refreshtoken.FieldRevokedAt)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RefreshTokenMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[refreshtoken.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RefreshTokenMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RefreshTokenMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RefreshTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearSession clears the "session" edge to the Session entity.
+func (m *RefreshTokenMutation) ClearSession() {
+	m.clearedsession = true
+	m.clearedFields[refreshtoken.FieldSessionID] = struct{}{}
+}
+
+// SessionCleared reports if the "session" edge to the Session entity was cleared.
+func (m *RefreshTokenMutation) SessionCleared() bool {
+	return m.clearedsession
+}
+
+// SessionIDs returns the "session" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SessionID instead. It exists only for internal usage by the builders.
+func (m *RefreshTokenMutation) SessionIDs() (ids []int) {
+	if id := m.session; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSession resets all changes to the "session" edge.
+func (m *RefreshTokenMutation) ResetSession() {
+	m.session = nil
+	m.clearedsession = false
+}
+
+// Where appends a list predicates to the RefreshTokenMutation builder.
+func (m *RefreshTokenMutation) Where(ps ...predicate.RefreshToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RefreshTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RefreshToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RefreshTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RefreshTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RefreshToken).
+func (m *RefreshTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RefreshTokenMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, refreshtoken.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, refreshtoken.FieldUpdateTime)
+	}
+	if m.jti != nil {
+		fields = append(fields, refreshtoken.FieldJti)
+	}
+	if m.session != nil {
+		fields = append(fields, refreshtoken.FieldSessionID)
+	}
+	if m.user != nil {
+		fields = append(fields, refreshtoken.FieldUserID)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, refreshtoken.FieldExpiresAt)
+	}
+	if m.used_at != nil {
+		fields = append(fields, refreshtoken.FieldUsedAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, refreshtoken.FieldRevokedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case refreshtoken.FieldCreateTime:
+		return m.CreateTime()
+	case refreshtoken.FieldUpdateTime:
+		return m.UpdateTime()
+	case refreshtoken.FieldJti:
+		return m.Jti()
+	case refreshtoken.FieldSessionID:
+		return m.SessionID()
+	case refreshtoken.FieldUserID:
+		return m.UserID()
+	case refreshtoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case refreshtoken.FieldUsedAt:
+		return m.UsedAt()
+	case refreshtoken.FieldRevokedAt:
+		return m.RevokedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case refreshtoken.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case refreshtoken.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case refreshtoken.FieldJti:
+		return m.OldJti(ctx)
+	case refreshtoken.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case refreshtoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case refreshtoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case refreshtoken.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case refreshtoken.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case refreshtoken.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case refreshtoken.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case refreshtoken.FieldJti:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJti(v)
+		return nil
+	case refreshtoken.FieldSessionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case refreshtoken.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case refreshtoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case refreshtoken.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RefreshTokenMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RefreshToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RefreshTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(refreshtoken.FieldUsedAt) {
+		fields = append(fields, refreshtoken.FieldUsedAt)
+	}
+	if m.FieldCleared(refreshtoken.FieldRevokedAt) {
+		fields = append(fields, refreshtoken.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RefreshTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ClearField(name string) error {
+	switch name {
+	case refreshtoken.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ResetField(name string) error {
+	switch name {
+	case refreshtoken.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case refreshtoken.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case refreshtoken.FieldJti:
+		m.ResetJti()
+		return nil
+	case refreshtoken.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case refreshtoken.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case refreshtoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case refreshtoken.FieldUsedAt:
+		m.ResetUsedAt()
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RefreshTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, refreshtoken.EdgeUser)
+	}
+	if m.session != nil {
+		edges = append(edges, refreshtoken.EdgeSession)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RefreshTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case refreshtoken.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case refreshtoken.EdgeSession:
+		if id := m.session; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RefreshTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RefreshTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RefreshTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, refreshtoken.EdgeUser)
+	}
+	if m.clearedsession {
+		edges = append(edges, refreshtoken.EdgeSession)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RefreshTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case refreshtoken.EdgeUser:
+		return m.cleareduser
+	case refreshtoken.EdgeSession:
+		return m.clearedsession
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RefreshTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case refreshtoken.EdgeUser:
+		m.ClearUser()
+		return nil
+	case refreshtoken.EdgeSession:
+		m.ClearSession()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RefreshTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case refreshtoken.EdgeUser:
+		m.ResetUser()
+		return nil
+	case refreshtoken.EdgeSession:
+		m.ResetSession()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken edge %s", name)
+}
+
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	create_time           *time.Time
+	update_time           *time.Time
+	device                *string
+	user_agent            *string
+	ip                    *string
+	last_refresh_time     *time.Time
+	revoked_at            *time.Time
+	clearedFields         map[string]struct{}
+	user                  *int
+	cleareduser           bool
+	refresh_tokens        map[int]struct{}
+	removedrefresh_tokens map[int]struct{}
+	clearedrefresh_tokens bool
+	done                  bool
+	oldValue              func(context.Context) (*Session, error)
+	predicates            []predicate.Session
+}
+
+var _ ent.Mutation = (*SessionMutation)(nil)
+
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
+
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id int) sessionOption {
+	return func(m *SessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Session
+		)
+		m.oldValue = func(ctx context.Context) (*Session, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Session.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SessionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SessionMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SessionMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SessionMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SessionMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SessionMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SessionMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SessionMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SessionMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SessionMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetDevice sets the "device" field.
+func (m *SessionMutation) SetDevice(s string) {
+	m.device = &s
+}
+
+// Device returns the value of the "device" field in the mutation.
+func (m *SessionMutation) Device() (r string, exists bool) {
+	v := m.device
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDevice returns the old "device" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldDevice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDevice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDevice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDevice: %w", err)
+	}
+	return oldValue.Device, nil
+}
+
+// ClearDevice clears the value of the "device" field.
+func (m *SessionMutation) ClearDevice() {
+	m.device = nil
+	m.clearedFields[session.FieldDevice] = struct{}{}
+}
+
+// DeviceCleared returns if the "device" field was cleared in this mutation.
+func (m *SessionMutation) DeviceCleared() bool {
+	_, ok := m.clearedFields[session.FieldDevice]
+	return ok
+}
+
+// ResetDevice resets all changes to the "device" field.
+func (m *SessionMutation) ResetDevice() {
+	m.device = nil
+	delete(m.clearedFields, session.FieldDevice)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *SessionMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *SessionMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *SessionMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[session.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *SessionMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[session.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *SessionMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, session.FieldUserAgent)
+}
+
+// SetIP sets the "ip" field.
+func (m *SessionMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the value of the "ip" field in the mutation.
+func (m *SessionMutation) IP() (r string, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIP returns the old "ip" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+	}
+	return oldValue.IP, nil
+}
+
+// ClearIP clears the value of the "ip" field.
+func (m *SessionMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[session.FieldIP] = struct{}{}
+}
+
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *SessionMutation) IPCleared() bool {
+	_, ok := m.clearedFields[session.FieldIP]
+	return ok
+}
+
+// ResetIP resets all changes to the "ip" field.
+func (m *SessionMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, session.FieldIP)
+}
+
+// SetLastRefreshTime sets the "last_refresh_time" field.
+func (m *SessionMutation) SetLastRefreshTime(t time.Time) {
+	m.last_refresh_time = &t
+}
+
+// LastRefreshTime returns the value of the "last_refresh_time" field in the mutation.
+func (m *SessionMutation) LastRefreshTime() (r time.Time, exists bool) {
+	v := m.last_refresh_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastRefreshTime returns the old "last_refresh_time" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldLastRefreshTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRefreshTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRefreshTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRefreshTime: %w", err)
+	}
+	return oldValue.LastRefreshTime, nil
+}
+
+// ResetLastRefreshTime resets all changes to the "last_refresh_time" field.
+func (m *SessionMutation) ResetLastRefreshTime() {
+	m.last_refresh_time = nil
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *SessionMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *SessionMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *SessionMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[session.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *SessionMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[session.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *SessionMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, session.FieldRevokedAt)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SessionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[session.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SessionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SessionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by ids.
+func (m *SessionMutation) AddRefreshTokenIDs(ids ...int) {
+	if m.refresh_tokens == nil {
+		m.refresh_tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.refresh_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRefreshTokens clears the "refresh_tokens" edge to the RefreshToken entity.
+func (m *SessionMutation) ClearRefreshTokens() {
+	m.clearedrefresh_tokens = true
+}
+
+// RefreshTokensCleared reports if the "refresh_tokens" edge to the RefreshToken entity was cleared.
+func (m *SessionMutation) RefreshTokensCleared() bool {
+	return m.clearedrefresh_tokens
+}
+
+// RemoveRefreshTokenIDs removes the "refresh_tokens" edge to the RefreshToken entity by IDs.
+func (m *SessionMutation) RemoveRefreshTokenIDs(ids ...int) {
+	if m.removedrefresh_tokens == nil {
+		m.removedrefresh_tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.refresh_tokens, ids[i])
+		m.removedrefresh_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRefreshTokens returns the removed IDs of the "refresh_tokens" edge to the RefreshToken entity.
+func (m *SessionMutation) RemovedRefreshTokensIDs() (ids []int) {
+	for id := range m.removedrefresh_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RefreshTokensIDs returns the "refresh_tokens" edge IDs in the mutation.
+func (m *SessionMutation) RefreshTokensIDs() (ids []int) {
+	for id := range m.refresh_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRefreshTokens resets all changes to the "refresh_tokens" edge.
+func (m *SessionMutation) ResetRefreshTokens() {
+	m.refresh_tokens = nil
+	m.clearedrefresh_tokens = false
+	m.removedrefresh_tokens = nil
+}
+
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, session.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, session.FieldUpdateTime)
+	}
+	if m.user != nil {
+		fields = append(fields, session.FieldUserID)
+	}
+	if m.device != nil {
+		fields = append(fields, session.FieldDevice)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, session.FieldUserAgent)
+	}
+	if m.ip != nil {
+		fields = append(fields, session.FieldIP)
+	}
+	if m.last_refresh_time != nil {
+		fields = append(fields, session.FieldLastRefreshTime)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, session.FieldRevokedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldCreateTime:
+		return m.CreateTime()
+	case session.FieldUpdateTime:
+		return m.UpdateTime()
+	case session.FieldUserID:
+		return m.UserID()
+	case session.FieldDevice:
+		return m.Device()
+	case session.FieldUserAgent:
+		return m.UserAgent()
+	case session.FieldIP:
+		return m.IP()
+	case session.FieldLastRefreshTime:
+		return m.LastRefreshTime()
+	case session.FieldRevokedAt:
+		return m.RevokedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case session.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case session.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case session.FieldUserID:
+		return m.OldUserID(ctx)
+	case session.FieldDevice:
+		return m.OldDevice(ctx)
+	case session.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case session.FieldIP:
+		return m.OldIP(ctx)
+	case session.FieldLastRefreshTime:
+		return m.OldLastRefreshTime(ctx)
+	case session.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Session field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case session.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case session.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case session.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case session.FieldDevice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDevice(v)
+		return nil
+	case session.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case session.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case session.FieldLastRefreshTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRefreshTime(v)
+		return nil
+	case session.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SessionMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Session numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(session.FieldDevice) {
+		fields = append(fields, session.FieldDevice)
+	}
+	if m.FieldCleared(session.FieldUserAgent) {
+		fields = append(fields, session.FieldUserAgent)
+	}
+	if m.FieldCleared(session.FieldIP) {
+		fields = append(fields, session.FieldIP)
+	}
+	if m.FieldCleared(session.FieldRevokedAt) {
+		fields = append(fields, session.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SessionMutation) ClearField(name string) error {
+	switch name {
+	case session.FieldDevice:
+		m.ClearDevice()
+		return nil
+	case session.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	case session.FieldIP:
+		m.ClearIP()
+		return nil
+	case session.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Session nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SessionMutation) ResetField(name string) error {
+	switch name {
+	case session.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case session.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case session.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case session.FieldDevice:
+		m.ResetDevice()
+		return nil
+	case session.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case session.FieldIP:
+		m.ResetIP()
+		return nil
+	case session.FieldLastRefreshTime:
+		m.ResetLastRefreshTime()
+		return nil
+	case session.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, session.EdgeUser)
+	}
+	if m.refresh_tokens != nil {
+		edges = append(edges, session.EdgeRefreshTokens)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case session.EdgeRefreshTokens:
+		ids := make([]ent.Value, 0, len(m.refresh_tokens))
+		for id := range m.refresh_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedrefresh_tokens != nil {
+		edges = append(edges, session.EdgeRefreshTokens)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgeRefreshTokens:
+		ids := make([]ent.Value, 0, len(m.removedrefresh_tokens))
+		for id := range m.removedrefresh_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, session.EdgeUser)
+	}
+	if m.clearedrefresh_tokens {
+		edges = append(edges, session.EdgeRefreshTokens)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case session.EdgeUser:
+		return m.cleareduser
+	case session.EdgeRefreshTokens:
+		return m.clearedrefresh_tokens
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SessionMutation) ClearEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Session unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SessionMutation) ResetEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ResetUser()
+		return nil
+	case session.EdgeRefreshTokens:
+		m.ResetRefreshTokens()
+		return nil
+	}
+	return fmt.Errorf("unknown Session edge %s", name)
+}
+
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	name              *string
+	post_count        *int
+	addpost_count     *int
+	question_count    *int
+	addquestion_count *int
+	clearedFields     map[string]struct{}
+	posts             map[int]struct{}
+	removedposts      map[int]struct{}
+	clearedposts      bool
+	questions         map[int]struct{}
+	removedquestions  map[int]struct{}
+	clearedquestions  bool
+	aliases           map[int]struct{}
+	removedaliases    map[int]struct{}
+	clearedaliases    bool
+	done              bool
+	oldValue          func(context.Context) (*Tag, error)
+	predicates        []predicate.Tag
+}
+
+var _ ent.Mutation = (*TagMutation)(nil)
+
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
+
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagID sets the ID field of the mutation.
+func withTagID(id int) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPostCount sets the "post_count" field.
+func (m *TagMutation) SetPostCount(i int) {
+	m.post_count = &i
+	m.addpost_count = nil
+}
+
+// PostCount returns the value of the "post_count" field in the mutation.
+func (m *TagMutation) PostCount() (r int, exists bool) {
+	v := m.post_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostCount returns the old "post_count" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldPostCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostCount: %w", err)
+	}
+	return oldValue.PostCount, nil
+}
+
+// AddPostCount adds i to the "post_count" field.
+func (m *TagMutation) AddPostCount(i int) {
+	if m.addpost_count != nil {
+		*m.addpost_count += i
+	} else {
+		m.addpost_count = &i
+	}
+}
+
+// AddedPostCount returns the value that was added to the "post_count" field in this mutation.
+func (m *TagMutation) AddedPostCount() (r int, exists bool) {
+	v := m.addpost_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPostCount resets all changes to the "post_count" field.
+func (m *TagMutation) ResetPostCount() {
+	m.post_count = nil
+	m.addpost_count = nil
+}
+
+// SetQuestionCount sets the "question_count" field.
+func (m *TagMutation) SetQuestionCount(i int) {
+	m.question_count = &i
+	m.addquestion_count = nil
+}
+
+// QuestionCount returns the value of the "question_count" field in the mutation.
+func (m *TagMutation) QuestionCount() (r int, exists bool) {
+	v := m.question_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionCount returns the old "question_count" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldQuestionCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionCount: %w", err)
+	}
+	return oldValue.QuestionCount, nil
+}
+
+// AddQuestionCount adds i to the "question_count" field.
+func (m *TagMutation) AddQuestionCount(i int) {
+	if m.addquestion_count != nil {
+		*m.addquestion_count += i
+	} else {
+		m.addquestion_count = &i
+	}
+}
+
+// AddedQuestionCount returns the value that was added to the "question_count" field in this mutation.
+func (m *TagMutation) AddedQuestionCount() (r int, exists bool) {
+	v := m.addquestion_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuestionCount resets all changes to the "question_count" field.
+func (m *TagMutation) ResetQuestionCount() {
+	m.question_count = nil
+	m.addquestion_count = nil
+}
+
+// AddPostIDs adds the "posts" edge to the PostTag entity by ids.
+func (m *TagMutation) AddPostIDs(ids ...int) {
+	if m.posts == nil {
+		m.posts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.posts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPosts clears the "posts" edge to the PostTag entity.
+func (m *TagMutation) ClearPosts() {
+	m.clearedposts = true
+}
+
+// PostsCleared reports if the "posts" edge to the PostTag entity was cleared.
+func (m *TagMutation) PostsCleared() bool {
+	return m.clearedposts
+}
+
+// RemovePostIDs removes the "posts" edge to the PostTag entity by IDs.
+func (m *TagMutation) RemovePostIDs(ids ...int) {
+	if m.removedposts == nil {
+		m.removedposts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.posts, ids[i])
+		m.removedposts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPosts returns the removed IDs of the "posts" edge to the PostTag entity.
+func (m *TagMutation) RemovedPostsIDs() (ids []int) {
+	for id := range m.removedposts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PostsIDs returns the "posts" edge IDs in the mutation.
+func (m *TagMutation) PostsIDs() (ids []int) {
+	for id := range m.posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPosts resets all changes to the "posts" edge.
+func (m *TagMutation) ResetPosts() {
+	m.posts = nil
+	m.clearedposts = false
+	m.removedposts = nil
+}
+
+// AddQuestionIDs adds the "questions" edge to the QuestionTag entity by ids.
+func (m *TagMutation) AddQuestionIDs(ids ...int) {
+	if m.questions == nil {
+		m.questions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.questions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuestions clears the "questions" edge to the QuestionTag entity.
+func (m *TagMutation) ClearQuestions() {
+	m.clearedquestions = true
+}
+
+// QuestionsCleared reports if the "questions" edge to the QuestionTag entity was cleared.
+func (m *TagMutation) QuestionsCleared() bool {
+	return m.clearedquestions
+}
+
+// RemoveQuestionIDs removes the "questions" edge to the QuestionTag entity by IDs.
+func (m *TagMutation) RemoveQuestionIDs(ids ...int) {
+	if m.removedquestions == nil {
+		m.removedquestions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.questions, ids[i])
+		m.removedquestions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuestions returns the removed IDs of the "questions" edge to the QuestionTag entity.
+func (m *TagMutation) RemovedQuestionsIDs() (ids []int) {
+	for id := range m.removedquestions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuestionsIDs returns the "questions" edge IDs in the mutation.
+func (m *TagMutation) QuestionsIDs() (ids []int) {
+	for id := range m.questions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuestions resets all changes to the "questions" edge.
+func (m *TagMutation) ResetQuestions() {
+	m.questions = nil
+	m.clearedquestions = false
+	m.removedquestions = nil
+}
+
+// AddAliasIDs adds the "aliases" edge to the TagAlias entity by ids.
+func (m *TagMutation) AddAliasIDs(ids ...int) {
+	if m.aliases == nil {
+		m.aliases = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.aliases[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAliases clears the "aliases" edge to the TagAlias entity.
+func (m *TagMutation) ClearAliases() {
+	m.clearedaliases = true
+}
+
+// AliasesCleared reports if the "aliases" edge to the TagAlias entity was cleared.
+func (m *TagMutation) AliasesCleared() bool {
+	return m.clearedaliases
+}
+
+// RemoveAliasIDs removes the "aliases" edge to the TagAlias entity by IDs.
+func (m *TagMutation) RemoveAliasIDs(ids ...int) {
+	if m.removedaliases == nil {
+		m.removedaliases = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.aliases, ids[i])
+		m.removedaliases[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAliases returns the removed IDs of the "aliases" edge to the TagAlias entity.
+func (m *TagMutation) RemovedAliasesIDs() (ids []int) {
+	for id := range m.removedaliases {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AliasesIDs returns the "aliases" edge IDs in the mutation.
+func (m *TagMutation) AliasesIDs() (ids []int) {
+	for id := range m.aliases {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAliases resets all changes to the "aliases" edge.
+func (m *TagMutation) ResetAliases() {
+	m.aliases = nil
+	m.clearedaliases = false
+	m.removedaliases = nil
+}
+
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
+	}
+	if m.post_count != nil {
+		fields = append(fields, tag.FieldPostCount)
+	}
+	if m.question_count != nil {
+		fields = append(fields, tag.FieldQuestionCount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldName:
+		return m.Name()
+	case tag.FieldPostCount:
+		return m.PostCount()
+	case tag.FieldQuestionCount:
+		return m.QuestionCount()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tag.FieldName:
+		return m.OldName(ctx)
+	case tag.FieldPostCount:
+		return m.OldPostCount(ctx)
+	case tag.FieldQuestionCount:
+		return m.OldQuestionCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tag.FieldPostCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostCount(v)
+		return nil
+	case tag.FieldQuestionCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TagMutation) AddedFields() []string {
+	var fields []string
+	if m.addpost_count != nil {
+		fields = append(fields, tag.FieldPostCount)
+	}
+	if m.addquestion_count != nil {
+		fields = append(fields, tag.FieldQuestionCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldPostCount:
+		return m.AddedPostCount()
+	case tag.FieldQuestionCount:
+		return m.AddedQuestionCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tag.FieldPostCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPostCount(v)
+		return nil
+	case tag.FieldQuestionCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuestionCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TagMutation) ResetField(name string) error {
+	switch name {
+	case tag.FieldName:
+		m.ResetName()
+		return nil
+	case tag.FieldPostCount:
+		m.ResetPostCount()
+		return nil
+	case tag.FieldQuestionCount:
+		m.ResetQuestionCount()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.posts != nil {
+		edges = append(edges, tag.EdgePosts)
+	}
+	if m.questions != nil {
+		edges = append(edges, tag.EdgeQuestions)
+	}
+	if m.aliases != nil {
+		edges = append(edges, tag.EdgeAliases)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.posts))
+		for id := range m.posts {
+			ids = append(ids, id)
+		}
+		return ids
+	case tag.EdgeQuestions:
+		ids := make([]ent.Value, 0, len(m.questions))
+		for id := range m.questions {
+			ids = append(ids, id)
+		}
+		return ids
+	case tag.EdgeAliases:
+		ids := make([]ent.Value, 0, len(m.aliases))
+		for id := range m.aliases {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedposts != nil {
+		edges = append(edges, tag.EdgePosts)
+	}
+	if m.removedquestions != nil {
+		edges = append(edges, tag.EdgeQuestions)
+	}
+	if m.removedaliases != nil {
+		edges = append(edges, tag.EdgeAliases)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.removedposts))
+		for id := range m.removedposts {
+			ids = append(ids, id)
+		}
+		return ids
+	case tag.EdgeQuestions:
+		ids := make([]ent.Value, 0, len(m.removedquestions))
+		for id := range m.removedquestions {
+			ids = append(ids, id)
+		}
+		return ids
+	case tag.EdgeAliases:
+		ids := make([]ent.Value, 0, len(m.removedaliases))
+		for id := range m.removedaliases {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedposts {
+		edges = append(edges, tag.EdgePosts)
+	}
+	if m.clearedquestions {
+		edges = append(edges, tag.EdgeQuestions)
+	}
+	if m.clearedaliases {
+		edges = append(edges, tag.EdgeAliases)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tag.EdgePosts:
+		return m.clearedposts
+	case tag.EdgeQuestions:
+		return m.clearedquestions
+	case tag.EdgeAliases:
+		return m.clearedaliases
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TagMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TagMutation) ResetEdge(name string) error {
+	switch name {
+	case tag.EdgePosts:
+		m.ResetPosts()
+		return nil
+	case tag.EdgeQuestions:
+		m.ResetQuestions()
+		return nil
+	case tag.EdgeAliases:
+		m.ResetAliases()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag edge %s", name)
+}
+
+// TagAliasMutation represents an operation that mutates the TagAlias nodes in the graph.
+type TagAliasMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	name          *string
+	clearedFields map[string]struct{}
+	tag           *int
+	clearedtag    bool
+	done          bool
+	oldValue      func(context.Context) (*TagAlias, error)
+	predicates    []predicate.TagAlias
+}
+
+var _ ent.Mutation = (*TagAliasMutation)(nil)
+
+// tagaliasOption allows management of the mutation configuration using functional options.
+type tagaliasOption func(*TagAliasMutation)
+
+// newTagAliasMutation creates new mutation for the TagAlias entity.
+func newTagAliasMutation(c config, op Op, opts ...tagaliasOption) *TagAliasMutation {
+	m := &TagAliasMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTagAlias,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagAliasID sets the ID field of the mutation.
+func withTagAliasID(id int) tagaliasOption {
+	return func(m *TagAliasMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TagAlias
+		)
+		m.oldValue = func(ctx context.Context) (*TagAlias, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TagAlias.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTagAlias sets the old TagAlias of the mutation.
+func withTagAlias(node *TagAlias) tagaliasOption {
+	return func(m *TagAliasMutation) {
+		m.oldValue = func(context.Context) (*TagAlias, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagAliasMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagAliasMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagAliasMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagAliasMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TagAlias.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *TagAliasMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TagAliasMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the TagAlias entity.
+// If the TagAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagAliasMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TagAliasMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *TagAliasMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TagAliasMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the TagAlias entity.
+// If the TagAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagAliasMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TagAliasMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *TagAliasMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TagAliasMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the TagAlias entity.
+// If the TagAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagAliasMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TagAliasMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTagID sets the "tag_id" field.
+func (m *TagAliasMutation) SetTagID(i int) {
+	m.tag = &i
+}
+
+// TagID returns the value of the "tag_id" field in the mutation.
+func (m *TagAliasMutation) TagID() (r int, exists bool) {
+	v := m.tag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagID returns the old "tag_id" field's value of the TagAlias entity.
+// If the TagAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagAliasMutation) OldTagID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagID: %w", err)
+	}
+	return oldValue.TagID, nil
+}
+
+// ResetTagID resets all changes to the "tag_id" field.
+func (m *TagAliasMutation) ResetTagID() {
+	m.tag = nil
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (m *TagAliasMutation) ClearTag() {
+	m.clearedtag = true
+	m.clearedFields[tagalias.FieldTagID] = struct{}{}
+}
+
+// TagCleared reports if the "tag" edge to the Tag entity was cleared.
+func (m *TagAliasMutation) TagCleared() bool {
+	return m.clearedtag
+}
+
+// TagIDs returns the "tag" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TagID instead. It exists only for internal usage by the builders.
+func (m *TagAliasMutation) TagIDs() (ids []int) {
+	if id := m.tag; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTag resets all changes to the "tag" edge.
+func (m *TagAliasMutation) ResetTag() {
+	m.tag = nil
+	m.clearedtag = false
+}
+
+// Where appends a list predicates to the TagAliasMutation builder.
+func (m *TagAliasMutation) Where(ps ...predicate.TagAlias) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TagAliasMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TagAliasMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TagAlias, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TagAliasMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TagAliasMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TagAlias).
+func (m *TagAliasMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TagAliasMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.create_time != nil {
+		fields = append(fields, tagalias.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, tagalias.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, tagalias.FieldName)
+	}
+	if m.tag != nil {
+		fields = append(fields, tagalias.FieldTagID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TagAliasMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tagalias.FieldCreateTime:
+		return m.CreateTime()
+	case tagalias.FieldUpdateTime:
+		return m.UpdateTime()
+	case tagalias.FieldName:
+		return m.Name()
+	case tagalias.FieldTagID:
+		return m.TagID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TagAliasMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tagalias.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case tagalias.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case tagalias.FieldName:
+		return m.OldName(ctx)
+	case tagalias.FieldTagID:
+		return m.OldTagID(ctx)
+	}
+	return nil, fmt.Errorf("unknown TagAlias field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagAliasMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tagalias.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case tagalias.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case tagalias.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tagalias.FieldTagID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TagAlias field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TagAliasMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TagAliasMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagAliasMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TagAlias numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TagAliasMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TagAliasMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TagAliasMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TagAlias nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TagAliasMutation) ResetField(name string) error {
+	switch name {
+	case tagalias.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case tagalias.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case tagalias.FieldName:
+		m.ResetName()
+		return nil
+	case tagalias.FieldTagID:
+		m.ResetTagID()
+		return nil
+	}
+	return fmt.Errorf("unknown TagAlias field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TagAliasMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.tag != nil {
+		edges = append(edges, tagalias.EdgeTag)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TagAliasMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tagalias.EdgeTag:
+		if id := m.tag; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TagAliasMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TagAliasMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TagAliasMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtag {
+		edges = append(edges, tagalias.EdgeTag)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TagAliasMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tagalias.EdgeTag:
+		return m.clearedtag
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TagAliasMutation) ClearEdge(name string) error {
+	switch name {
+	case tagalias.EdgeTag:
+		m.ClearTag()
+		return nil
+	}
+	return fmt.Errorf("unknown TagAlias unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TagAliasMutation) ResetEdge(name string) error {
+	switch name {
+	case tagalias.EdgeTag:
+		m.ResetTag()
+		return nil
+	}
+	return fmt.Errorf("unknown TagAlias edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *int
+	create_time               *time.Time
+	update_time               *time.Time
+	phone                     *string
+	nickname                  *string
+	password                  *string
+	avatar                    *string
+	role                      *user.Role
+	deletion_requested_at     *time.Time
+	deleted_at                *time.Time
+	follower_count            *int
+	addfollower_count         *int
+	following_count           *int
+	addfollowing_count        *int
+	clearedFields             map[string]struct{}
+	posts                     map[int]struct{}
+	removedposts              map[int]struct{}
+	clearedposts              bool
+	questions                 map[int]struct{}
+	removedquestions          map[int]struct{}
+	clearedquestions          bool
+	answers                   map[int]struct{}
+	removedanswers            map[int]struct{}
+	clearedanswers            bool
+	answer_votes              map[int]struct{}
+	removedanswer_votes       map[int]struct{}
+	clearedanswer_votes       bool
+	comments                  map[int]struct{}
+	removedcomments           map[int]struct{}
+	clearedcomments           bool
+	like_posts                map[int]struct{}
+	removedlike_posts         map[int]struct{}
+	clearedlike_posts         bool
+	like_questions            map[int]struct{}
+	removedlike_questions     map[int]struct{}
+	clearedlike_questions     bool
+	favorite_posts            map[int]struct{}
+	removedfavorite_posts     map[int]struct{}
+	clearedfavorite_posts     bool
+	favorite_questions        map[int]struct{}
+	removedfavorite_questions map[int]struct{}
+	clearedfavorite_questions bool
+	following                 map[int]struct{}
+	removedfollowing          map[int]struct{}
+	clearedfollowing          bool
+	followed_by               map[int]struct{}
+	removedfollowed_by        map[int]struct{}
+	clearedfollowed_by        bool
+	refresh_tokens            map[int]struct{}
+	removedrefresh_tokens     map[int]struct{}
+	clearedrefresh_tokens     bool
+	sessions                  map[int]struct{}
+	removedsessions           map[int]struct{}
+	clearedsessions           bool
+	done                      bool
+	oldValue                  func(context.Context) (*User, error)
+	predicates                []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *UserMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *UserMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *UserMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *UserMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *UserMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *UserMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetPhone sets the "phone" field.
+func (m *UserMutation) SetPhone(s string) {
+	m.phone = &s
+}
+
+// Phone returns the value of the "phone" field in the mutation.
+func (m *UserMutation) Phone() (r string, exists bool) {
+	v := m.phone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhone returns the old "phone" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPhone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
+	}
+	return oldValue.Phone, nil
+}
+
+// ResetPhone resets all changes to the "phone" field.
+func (m *UserMutation) ResetPhone() {
+	m.phone = nil
+}
+
+// SetNickname sets the "nickname" field.
+func (m *UserMutation) SetNickname(s string) {
+	m.nickname = &s
+}
+
+// Nickname returns the value of the "nickname" field in the mutation.
+func (m *UserMutation) Nickname() (r string, exists bool) {
+	v := m.nickname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNickname returns the old "nickname" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldNickname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNickname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+	}
+	return oldValue.Nickname, nil
+}
+
+// ClearNickname clears the value of the "nickname" field.
+func (m *UserMutation) ClearNickname() {
+	m.nickname = nil
+	m.clearedFields[user.FieldNickname] = struct{}{}
+}
+
+// NicknameCleared returns if the "nickname" field was cleared in this mutation.
+func (m *UserMutation) NicknameCleared() bool {
+	_, ok := m.clearedFields[user.FieldNickname]
+	return ok
+}
+
+// ResetNickname resets all changes to the "nickname" field.
+func (m *UserMutation) ResetNickname() {
+	m.nickname = nil
+	delete(m.clearedFields, user.FieldNickname)
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetAvatar sets the "avatar" field.
+func (m *UserMutation) SetAvatar(s string) {
+	m.avatar = &s
+}
+
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *UserMutation) Avatar() (r string, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatar returns the old "avatar" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (m *UserMutation) ClearAvatar() {
+	m.avatar = nil
+	m.clearedFields[user.FieldAvatar] = struct{}{}
+}
+
+// AvatarCleared returns if the "avatar" field was cleared in this mutation.
+func (m *UserMutation) AvatarCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatar]
+	return ok
+}
+
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *UserMutation) ResetAvatar() {
+	m.avatar = nil
+	delete(m.clearedFields, user.FieldAvatar)
+}
+
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(u user.Role) {
+	m.role = &u
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r user.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetDeletionRequestedAt sets the "deletion_requested_at" field.
+func (m *UserMutation) SetDeletionRequestedAt(t time.Time) {
+	m.deletion_requested_at = &t
+}
+
+// DeletionRequestedAt returns the value of the "deletion_requested_at" field in the mutation.
+func (m *UserMutation) DeletionRequestedAt() (r time.Time, exists bool) {
+	v := m.deletion_requested_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletionRequestedAt returns the old "deletion_requested_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDeletionRequestedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletionRequestedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletionRequestedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletionRequestedAt: %w", err)
+	}
+	return oldValue.DeletionRequestedAt, nil
+}
+
+// ClearDeletionRequestedAt clears the value of the "deletion_requested_at" field.
+func (m *UserMutation) ClearDeletionRequestedAt() {
+	m.deletion_requested_at = nil
+	m.clearedFields[user.FieldDeletionRequestedAt] = struct{}{}
+}
+
+// DeletionRequestedAtCleared returns if the "deletion_requested_at" field was cleared in this mutation.
+func (m *UserMutation) DeletionRequestedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldDeletionRequestedAt]
+	return ok
+}
+
+// ResetDeletionRequestedAt resets all changes to the "deletion_requested_at" field.
+func (m *UserMutation) ResetDeletionRequestedAt() {
+	m.deletion_requested_at = nil
+	delete(m.clearedFields, user.FieldDeletionRequestedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *UserMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *UserMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *UserMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[user.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *UserMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *UserMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, user.FieldDeletedAt)
+}
+
+// SetFollowerCount sets the "follower_count" field.
+func (m *UserMutation) SetFollowerCount(i int) {
+	m.follower_count = &i
+	m.addfollower_count = nil
+}
+
+// FollowerCount returns the value of the "follower_count" field in the mutation.
+func (m *UserMutation) FollowerCount() (r int, exists bool) {
+	v := m.follower_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFollowerCount returns the old "follower_count" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldFollowerCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFollowerCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFollowerCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFollowerCount: %w", err)
+	}
+	return oldValue.FollowerCount, nil
+}
+
+// AddFollowerCount adds i to the "follower_count" field.
+func (m *UserMutation) AddFollowerCount(i int) {
+	if m.addfollower_count != nil {
+		*m.addfollower_count += i
+	} else {
+		m.addfollower_count = &i
+	}
+}
+
+// AddedFollowerCount returns the value that was added to the "follower_count" field in this mutation.
+func (m *UserMutation) AddedFollowerCount() (r int, exists bool) {
+	v := m.addfollower_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFollowerCount resets all changes to the "follower_count" field.
+func (m *UserMutation) ResetFollowerCount() {
+	m.follower_count = nil
+	m.addfollower_count = nil
+}
+
+// SetFollowingCount sets the "following_count" field.
+func (m *UserMutation) SetFollowingCount(i int) {
+	m.following_count = &i
+	m.addfollowing_count = nil
+}
+
+// FollowingCount returns the value of the "following_count" field in the mutation.
+func (m *UserMutation) FollowingCount() (r int, exists bool) {
+	v := m.following_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFollowingCount returns the old "following_count" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldFollowingCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFollowingCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFollowingCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFollowingCount: %w", err)
+	}
+	return oldValue.FollowingCount, nil
+}
+
+// AddFollowingCount adds i to the "following_count" field.
+func (m *UserMutation) AddFollowingCount(i int) {
+	if m.addfollowing_count != nil {
+		*m.addfollowing_count += i
+	} else {
+		m.addfollowing_count = &i
+	}
+}
+
+// AddedFollowingCount returns the value that was added to the "following_count" field in this mutation.
+func (m *UserMutation) AddedFollowingCount() (r int, exists bool) {
+	v := m.addfollowing_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFollowingCount resets all changes to the "following_count" field.
+func (m *UserMutation) ResetFollowingCount() {
+	m.following_count = nil
+	m.addfollowing_count = nil
+}
+
+// AddPostIDs adds the "posts" edge to the Post entity by ids.
+func (m *UserMutation) AddPostIDs(ids ...int) {
+	if m.posts == nil {
+		m.posts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.posts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPosts clears the "posts" edge to the Post entity.
+func (m *UserMutation) ClearPosts() {
+	m.clearedposts = true
+}
+
+// PostsCleared reports if the "posts" edge to the Post entity was cleared.
+func (m *UserMutation) PostsCleared() bool {
+	return m.clearedposts
+}
+
+// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
+func (m *UserMutation) RemovePostIDs(ids ...int) {
+	if m.removedposts == nil {
+		m.removedposts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.posts, ids[i])
+		m.removedposts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
+func (m *UserMutation) RemovedPostsIDs() (ids []int) {
+	for id := range m.removedposts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PostsIDs returns the "posts" edge IDs in the mutation.
+func (m *UserMutation) PostsIDs() (ids []int) {
+	for id := range m.posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPosts resets all changes to the "posts" edge.
+func (m *UserMutation) ResetPosts() {
+	m.posts = nil
+	m.clearedposts = false
+	m.removedposts = nil
+}
+
+// AddQuestionIDs adds the "questions" edge to the Question entity by ids.
+func (m *UserMutation) AddQuestionIDs(ids ...int) {
+	if m.questions == nil {
+		m.questions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.questions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuestions clears the "questions" edge to the Question entity.
+func (m *UserMutation) ClearQuestions() {
+	m.clearedquestions = true
+}
+
+// QuestionsCleared reports if the "questions" edge to the Question entity was cleared.
+func (m *UserMutation) QuestionsCleared() bool {
+	return m.clearedquestions
+}
+
+// RemoveQuestionIDs removes the "questions" edge to the Question entity by IDs.
+func (m *UserMutation) RemoveQuestionIDs(ids ...int) {
+	if m.removedquestions == nil {
+		m.removedquestions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.questions, ids[i])
+		m.removedquestions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuestions returns the removed IDs of the "questions" edge to the Question entity.
+func (m *UserMutation) RemovedQuestionsIDs() (ids []int) {
+	for id := range m.removedquestions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuestionsIDs returns the "questions" edge IDs in the mutation.
+func (m *UserMutation) QuestionsIDs() (ids []int) {
+	for id := range m.questions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuestions resets all changes to the "questions" edge.
+func (m *UserMutation) ResetQuestions() {
+	m.questions = nil
+	m.clearedquestions = false
+	m.removedquestions = nil
+}
+
+// AddAnswerIDs adds the "answers" edge to the Answer entity by ids.
+func (m *UserMutation) AddAnswerIDs(ids ...int) {
+	if m.answers == nil {
+		m.answers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.answers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnswers clears the "answers" edge to the Answer entity.
+func (m *UserMutation) ClearAnswers() {
+	m.clearedanswers = true
+}
+
+// AnswersCleared reports if the "answers" edge to the Answer entity was cleared.
+func (m *UserMutation) AnswersCleared() bool {
+	return m.clearedanswers
+}
+
+// RemoveAnswerIDs removes the "answers" edge to the Answer entity by IDs.
+func (m *UserMutation) RemoveAnswerIDs(ids ...int) {
+	if m.removedanswers == nil {
+		m.removedanswers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.answers, ids[i])
+		m.removedanswers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnswers returns the removed IDs of the "answers" edge to the Answer entity.
+func (m *UserMutation) RemovedAnswersIDs() (ids []int) {
+	for id := range m.removedanswers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnswersIDs returns the "answers" edge IDs in the mutation.
+func (m *UserMutation) AnswersIDs() (ids []int) {
+	for id := range m.answers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnswers resets all changes to the "answers" edge.
+func (m *UserMutation) ResetAnswers() {
+	m.answers = nil
+	m.clearedanswers = false
+	m.removedanswers = nil
+}
+
+// AddAnswerVoteIDs adds the "answer_votes" edge to the AnswerVote entity by ids.
+func (m *UserMutation) AddAnswerVoteIDs(ids ...int) {
+	if m.answer_votes == nil {
+		m.answer_votes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.answer_votes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnswerVotes clears the "answer_votes" edge to the AnswerVote entity.
+func (m *UserMutation) ClearAnswerVotes() {
+	m.clearedanswer_votes = true
+}
+
+// AnswerVotesCleared reports if the "answer_votes" edge to the AnswerVote entity was cleared.
+func (m *UserMutation) AnswerVotesCleared() bool {
+	return m.clearedanswer_votes
+}
+
+// RemoveAnswerVoteIDs removes the "answer_votes" edge to the AnswerVote entity by IDs.
+func (m *UserMutation) RemoveAnswerVoteIDs(ids ...int) {
+	if m.removedanswer_votes == nil {
+		m.removedanswer_votes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.answer_votes, ids[i])
+		m.removedanswer_votes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnswerVotes returns the removed IDs of the "answer_votes" edge to the AnswerVote entity.
+func (m *UserMutation) RemovedAnswerVotesIDs() (ids []int) {
+	for id := range m.removedanswer_votes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnswerVotesIDs returns the "answer_votes" edge IDs in the mutation.
+func (m *UserMutation) AnswerVotesIDs() (ids []int) {
+	for id := range m.answer_votes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnswerVotes resets all changes to the "answer_votes" edge.
+func (m *UserMutation) ResetAnswerVotes() {
+	m.answer_votes = nil
+	m.clearedanswer_votes = false
+	m.removedanswer_votes = nil
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *UserMutation) AddCommentIDs(ids ...int) {
+	if m.comments == nil {
+		m.comments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *UserMutation) ClearComments() {
+	m.clearedcomments = true
+}
+
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *UserMutation) CommentsCleared() bool {
+	return m.clearedcomments
+}
+
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *UserMutation) RemoveCommentIDs(ids ...int) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *UserMutation) RemovedCommentsIDs() (ids []int) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *UserMutation) CommentsIDs() (ids []int) {
+	for id := range m.comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetComments resets all changes to the "comments" edge.
+func (m *UserMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// AddLikePostIDs adds the "like_posts" edge to the UserLikePost entity by ids.
+func (m *UserMutation) AddLikePostIDs(ids ...int) {
+	if m.like_posts == nil {
+		m.like_posts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.like_posts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLikePosts clears the "like_posts" edge to the UserLikePost entity.
+func (m *UserMutation) ClearLikePosts() {
+	m.clearedlike_posts = true
+}
+
+// LikePostsCleared reports if the "like_posts" edge to the UserLikePost entity was cleared.
+func (m *UserMutation) LikePostsCleared() bool {
+	return m.clearedlike_posts
+}
+
+// RemoveLikePostIDs removes the "like_posts" edge to the UserLikePost entity by IDs.
+func (m *UserMutation) RemoveLikePostIDs(ids ...int) {
+	if m.removedlike_posts == nil {
+		m.removedlike_posts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.like_posts, ids[i])
+		m.removedlike_posts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLikePosts returns the removed IDs of the "like_posts" edge to the UserLikePost entity.
+func (m *UserMutation) RemovedLikePostsIDs() (ids []int) {
+	for id := range m.removedlike_posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LikePostsIDs returns the "like_posts" edge IDs in the mutation.
+func (m *UserMutation) LikePostsIDs() (ids []int) {
+	for id := range m.like_posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLikePosts resets all changes to the "like_posts" edge.
+func (m *UserMutation) ResetLikePosts() {
+	m.like_posts = nil
+	m.clearedlike_posts = false
+	m.removedlike_posts = nil
+}
+
+// AddLikeQuestionIDs adds the "like_questions" edge to the UserLikeQuestion entity by ids.
+func (m *UserMutation) AddLikeQuestionIDs(ids ...int) {
+	if m.like_questions == nil {
+		m.like_questions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.like_questions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLikeQuestions clears the "like_questions" edge to the UserLikeQuestion entity.
+func (m *UserMutation) ClearLikeQuestions() {
+	m.clearedlike_questions = true
+}
+
+// LikeQuestionsCleared reports if the "like_questions" edge to the UserLikeQuestion entity was cleared.
+func (m *UserMutation) LikeQuestionsCleared() bool {
+	return m.clearedlike_questions
+}
+
+// RemoveLikeQuestionIDs removes the "like_questions" edge to the UserLikeQuestion entity by IDs.
+func (m *UserMutation) RemoveLikeQuestionIDs(ids ...int) {
+	if m.removedlike_questions == nil {
+		m.removedlike_questions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.like_questions, ids[i])
+		m.removedlike_questions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLikeQuestions returns the removed IDs of the "like_questions" edge to the UserLikeQuestion entity.
+func (m *UserMutation) RemovedLikeQuestionsIDs() (ids []int) {
+	for id := range m.removedlike_questions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LikeQuestionsIDs returns the "like_questions" edge IDs in the mutation.
+func (m *UserMutation) LikeQuestionsIDs() (ids []int) {
+	for id := range m.like_questions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLikeQuestions resets all changes to the "like_questions" edge.
+func (m *UserMutation) ResetLikeQuestions() {
+	m.like_questions = nil
+	m.clearedlike_questions = false
+	m.removedlike_questions = nil
+}
+
+// AddFavoritePostIDs adds the "favorite_posts" edge to the UserFavoritePost entity by ids.
+func (m *UserMutation) AddFavoritePostIDs(ids ...int) {
+	if m.favorite_posts == nil {
+		m.favorite_posts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.favorite_posts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFavoritePosts clears the "favorite_posts" edge to the UserFavoritePost entity.
+func (m *UserMutation) ClearFavoritePosts() {
+	m.clearedfavorite_posts = true
+}
+
+// FavoritePostsCleared reports if the "favorite_posts" edge to the UserFavoritePost entity was cleared.
+func (m *UserMutation) FavoritePostsCleared() bool {
+	return m.clearedfavorite_posts
+}
+
+// RemoveFavoritePostIDs removes the "favorite_posts" edge to the UserFavoritePost entity by IDs.
+func (m *UserMutation) RemoveFavoritePostIDs(ids ...int) {
+	if m.removedfavorite_posts == nil {
+		m.removedfavorite_posts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.favorite_posts, ids[i])
+		m.removedfavorite_posts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFavoritePosts returns the removed IDs of the "favorite_posts" edge to the UserFavoritePost entity.
+func (m *UserMutation) RemovedFavoritePostsIDs() (ids []int) {
+	for id := range m.removedfavorite_posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FavoritePostsIDs returns the "favorite_posts" edge IDs in the mutation.
+func (m *UserMutation) FavoritePostsIDs() (ids []int) {
+	for id := range m.favorite_posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFavoritePosts resets all changes to the "favorite_posts" edge.
+func (m *UserMutation) ResetFavoritePosts() {
+	m.favorite_posts = nil
+	m.clearedfavorite_posts = false
+	m.removedfavorite_posts = nil
+}
+
+// AddFavoriteQuestionIDs adds the "favorite_questions" edge to the UserFavoriteQuestion entity by ids.
+func (m *UserMutation) AddFavoriteQuestionIDs(ids ...int) {
+	if m.favorite_questions == nil {
+		m.favorite_questions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.favorite_questions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFavoriteQuestions clears the "favorite_questions" edge to the UserFavoriteQuestion entity.
+func (m *UserMutation) ClearFavoriteQuestions() {
+	m.clearedfavorite_questions = true
+}
+
+// FavoriteQuestionsCleared reports if the "favorite_questions" edge to the UserFavoriteQuestion entity was cleared.
+func (m *UserMutation) FavoriteQuestionsCleared() bool {
+	return m.clearedfavorite_questions
+}
+
+// RemoveFavoriteQuestionIDs removes the "favorite_questions" edge to the UserFavoriteQuestion entity by IDs.
+func (m *UserMutation) RemoveFavoriteQuestionIDs(ids ...int) {
+	if m.removedfavorite_questions == nil {
+		m.removedfavorite_questions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.favorite_questions, ids[i])
+		m.removedfavorite_questions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFavoriteQuestions returns the removed IDs of the "favorite_questions" edge to the UserFavoriteQuestion entity.
+func (m *UserMutation) RemovedFavoriteQuestionsIDs() (ids []int) {
+	for id := range m.removedfavorite_questions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FavoriteQuestionsIDs returns the "favorite_questions" edge IDs in the mutation.
+func (m *UserMutation) FavoriteQuestionsIDs() (ids []int) {
+	for id := range m.favorite_questions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFavoriteQuestions resets all changes to the "favorite_questions" edge.
+func (m *UserMutation) ResetFavoriteQuestions() {
+	m.favorite_questions = nil
+	m.clearedfavorite_questions = false
+	m.removedfavorite_questions = nil
+}
+
+// AddFollowingIDs adds the "following" edge to the Follow entity by ids.
+func (m *UserMutation) AddFollowingIDs(ids ...int) {
+	if m.following == nil {
+		m.following = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.following[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFollowing clears the "following" edge to the Follow entity.
+func (m *UserMutation) ClearFollowing() {
+	m.clearedfollowing = true
+}
+
+// FollowingCleared reports if the "following" edge to the Follow entity was cleared.
+func (m *UserMutation) FollowingCleared() bool {
+	return m.clearedfollowing
+}
+
+// RemoveFollowingIDs removes the "following" edge to the Follow entity by IDs.
+func (m *UserMutation) RemoveFollowingIDs(ids ...int) {
+	if m.removedfollowing == nil {
+		m.removedfollowing = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.following, ids[i])
+		m.removedfollowing[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFollowing returns the removed IDs of the "following" edge to the Follow entity.
+func (m *UserMutation) RemovedFollowingIDs() (ids []int) {
+	for id := range m.removedfollowing {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FollowingIDs returns the "following" edge IDs in the mutation.
+func (m *UserMutation) FollowingIDs() (ids []int) {
+	for id := range m.following {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFollowing resets all changes to the "following" edge.
+func (m *UserMutation) ResetFollowing() {
+	m.following = nil
+	m.clearedfollowing = false
+	m.removedfollowing = nil
+}
+
+// AddFollowedByIDs adds the "followed_by" edge to the Follow entity by ids.
+func (m *UserMutation) AddFollowedByIDs(ids ...int) {
+	if m.followed_by == nil {
+		m.followed_by = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.followed_by[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFollowedBy clears the "followed_by" edge to the Follow entity.
+func (m *UserMutation) ClearFollowedBy() {
+	m.clearedfollowed_by = true
+}
+
+// FollowedByCleared reports if the "followed_by" edge to the Follow entity was cleared.
+func (m *UserMutation) FollowedByCleared() bool {
+	return m.clearedfollowed_by
+}
+
+// RemoveFollowedByIDs removes the "followed_by" edge to the Follow entity by IDs.
+func (m *UserMutation) RemoveFollowedByIDs(ids ...int) {
+	if m.removedfollowed_by == nil {
+		m.removedfollowed_by = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.followed_by, ids[i])
+		m.removedfollowed_by[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFollowedBy returns the removed IDs of the "followed_by" edge to the Follow entity.
+func (m *UserMutation) RemovedFollowedByIDs() (ids []int) {
+	for id := range m.removedfollowed_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FollowedByIDs returns the "followed_by" edge IDs in the mutation.
+func (m *UserMutation) FollowedByIDs() (ids []int) {
+	for id := range m.followed_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFollowedBy resets all changes to the "followed_by" edge.
+func (m *UserMutation) ResetFollowedBy() {
+	m.followed_by = nil
+	m.clearedfollowed_by = false
+	m.removedfollowed_by = nil
+}
+
+// AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by ids.
+func (m *UserMutation) AddRefreshTokenIDs(ids ...int) {
+	if m.refresh_tokens == nil {
+		m.refresh_tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.refresh_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRefreshTokens clears the "refresh_tokens" edge to the RefreshToken entity.
+func (m *UserMutation) ClearRefreshTokens() {
+	m.clearedrefresh_tokens = true
+}
+
+// RefreshTokensCleared reports if the "refresh_tokens" edge to the RefreshToken entity was cleared.
+func (m *UserMutation) RefreshTokensCleared() bool {
+	return m.clearedrefresh_tokens
+}
+
+// RemoveRefreshTokenIDs removes the "refresh_tokens" edge to the RefreshToken entity by IDs.
+func (m *UserMutation) RemoveRefreshTokenIDs(ids ...int) {
+	if m.removedrefresh_tokens == nil {
+		m.removedrefresh_tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.refresh_tokens, ids[i])
+		m.removedrefresh_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRefreshTokens returns the removed IDs of the "refresh_tokens" edge to the RefreshToken entity.
+func (m *UserMutation) RemovedRefreshTokensIDs() (ids []int) {
+	for id := range m.removedrefresh_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RefreshTokensIDs returns the "refresh_tokens" edge IDs in the mutation.
+func (m *UserMutation) RefreshTokensIDs() (ids []int) {
+	for id := range m.refresh_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRefreshTokens resets all changes to the "refresh_tokens" edge.
+func (m *UserMutation) ResetRefreshTokens() {
+	m.refresh_tokens = nil
+	m.clearedrefresh_tokens = false
+	m.removedrefresh_tokens = nil
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
+func (m *UserMutation) AddSessionIDs(ids ...int) {
+	if m.sessions == nil {
+		m.sessions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *UserMutation) ClearSessions() {
+	m.clearedsessions = true
+}
+
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *UserMutation) SessionsCleared() bool {
+	return m.clearedsessions
+}
+
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *UserMutation) RemoveSessionIDs(ids ...int) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *UserMutation) RemovedSessionsIDs() (ids []int) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *UserMutation) SessionsIDs() (ids []int) {
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *UserMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.create_time != nil {
+		fields = append(fields, user.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, user.FieldUpdateTime)
+	}
+	if m.phone != nil {
+		fields = append(fields, user.FieldPhone)
+	}
+	if m.nickname != nil {
+		fields = append(fields, user.FieldNickname)
+	}
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.avatar != nil {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
+	if m.deletion_requested_at != nil {
+		fields = append(fields, user.FieldDeletionRequestedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, user.FieldDeletedAt)
+	}
+	if m.follower_count != nil {
+		fields = append(fields, user.FieldFollowerCount)
+	}
+	if m.following_count != nil {
+		fields = append(fields, user.FieldFollowingCount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldCreateTime:
+		return m.CreateTime()
+	case user.FieldUpdateTime:
+		return m.UpdateTime()
+	case user.FieldPhone:
+		return m.Phone()
+	case user.FieldNickname:
+		return m.Nickname()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldAvatar:
+		return m.Avatar()
+	case user.FieldRole:
+		return m.Role()
+	case user.FieldDeletionRequestedAt:
+		return m.DeletionRequestedAt()
+	case user.FieldDeletedAt:
+		return m.DeletedAt()
+	case user.FieldFollowerCount:
+		return m.FollowerCount()
+	case user.FieldFollowingCount:
+		return m.FollowingCount()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case user.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case user.FieldPhone:
+		return m.OldPhone(ctx)
+	case user.FieldNickname:
+		return m.OldNickname(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldAvatar:
+		return m.OldAvatar(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
+	case user.FieldDeletionRequestedAt:
+		return m.OldDeletionRequestedAt(ctx)
+	case user.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case user.FieldFollowerCount:
+		return m.OldFollowerCount(ctx)
+	case user.FieldFollowingCount:
+		return m.OldFollowingCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case user.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case user.FieldPhone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhone(v)
+		return nil
+	case user.FieldNickname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNickname(v)
+		return nil
+	case user.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case user.FieldAvatar:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatar(v)
+		return nil
+	case user.FieldRole:
+		v, ok := value.(user.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case user.FieldDeletionRequestedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletionRequestedAt(v)
+		return nil
+	case user.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case user.FieldFollowerCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFollowerCount(v)
+		return nil
+	case user.FieldFollowingCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFollowingCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.addfollower_count != nil {
+		fields = append(fields, user.FieldFollowerCount)
+	}
+	if m.addfollowing_count != nil {
+		fields = append(fields, user.FieldFollowingCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldFollowerCount:
+		return m.AddedFollowerCount()
+	case user.FieldFollowingCount:
+		return m.AddedFollowingCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldFollowerCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFollowerCount(v)
+		return nil
+	case user.FieldFollowingCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFollowingCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldNickname) {
+		fields = append(fields, user.FieldNickname)
+	}
+	if m.FieldCleared(user.FieldAvatar) {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.FieldCleared(user.FieldDeletionRequestedAt) {
+		fields = append(fields, user.FieldDeletionRequestedAt)
+	}
+	if m.FieldCleared(user.FieldDeletedAt) {
+		fields = append(fields, user.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldNickname:
+		m.ClearNickname()
+		return nil
+	case user.FieldAvatar:
+		m.ClearAvatar()
+		return nil
+	case user.FieldDeletionRequestedAt:
+		m.ClearDeletionRequestedAt()
+		return nil
+	case user.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case user.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case user.FieldPhone:
+		m.ResetPhone()
+		return nil
+	case user.FieldNickname:
+		m.ResetNickname()
+		return nil
+	case user.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case user.FieldAvatar:
+		m.ResetAvatar()
+		return nil
+	case user.FieldRole:
+		m.ResetRole()
+		return nil
+	case user.FieldDeletionRequestedAt:
+		m.ResetDeletionRequestedAt()
+		return nil
+	case user.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case user.FieldFollowerCount:
+		m.ResetFollowerCount()
+		return nil
+	case user.FieldFollowingCount:
+		m.ResetFollowingCount()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 13)
+	if m.posts != nil {
+		edges = append(edges, user.EdgePosts)
+	}
+	if m.questions != nil {
+		edges = append(edges, user.EdgeQuestions)
+	}
+	if m.answers != nil {
+		edges = append(edges, user.EdgeAnswers)
+	}
+	if m.answer_votes != nil {
+		edges = append(edges, user.EdgeAnswerVotes)
+	}
+	if m.comments != nil {
+		edges = append(edges, user.EdgeComments)
+	}
+	if m.like_posts != nil {
+		edges = append(edges, user.EdgeLikePosts)
+	}
+	if m.like_questions != nil {
+		edges = append(edges, user.EdgeLikeQuestions)
+	}
+	if m.favorite_posts != nil {
+		edges = append(edges, user.EdgeFavoritePosts)
+	}
+	if m.favorite_questions != nil {
+		edges = append(edges, user.EdgeFavoriteQuestions)
+	}
+	if m.following != nil {
+		edges = append(edges, user.EdgeFollowing)
+	}
+	if m.followed_by != nil {
+		edges = append(edges, user.EdgeFollowedBy)
+	}
+	if m.refresh_tokens != nil {
+		edges = append(edges, user.EdgeRefreshTokens)
+	}
+	if m.sessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.posts))
+		for id := range m.posts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeQuestions:
+		ids := make([]ent.Value, 0, len(m.questions))
+		for id := range m.questions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.answers))
+		for id := range m.answers {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAnswerVotes:
+		ids := make([]ent.Value, 0, len(m.answer_votes))
+		for id := range m.answer_votes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLikePosts:
+		ids := make([]ent.Value, 0, len(m.like_posts))
+		for id := range m.like_posts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLikeQuestions:
+		ids := make([]ent.Value, 0, len(m.like_questions))
+		for id := range m.like_questions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFavoritePosts:
+		ids := make([]ent.Value, 0, len(m.favorite_posts))
+		for id := range m.favorite_posts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFavoriteQuestions:
+		ids := make([]ent.Value, 0, len(m.favorite_questions))
+		for id := range m.favorite_questions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFollowing:
+		ids := make([]ent.Value, 0, len(m.following))
+		for id := range m.following {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFollowedBy:
+		ids := make([]ent.Value, 0, len(m.followed_by))
+		for id := range m.followed_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRefreshTokens:
+		ids := make([]ent.Value, 0, len(m.refresh_tokens))
+		for id := range m.refresh_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 13)
+	if m.removedposts != nil {
+		edges = append(edges, user.EdgePosts)
+	}
+	if m.removedquestions != nil {
+		edges = append(edges, user.EdgeQuestions)
+	}
+	if m.removedanswers != nil {
+		edges = append(edges, user.EdgeAnswers)
+	}
+	if m.removedanswer_votes != nil {
+		edges = append(edges, user.EdgeAnswerVotes)
+	}
+	if m.removedcomments != nil {
+		edges = append(edges, user.EdgeComments)
+	}
+	if m.removedlike_posts != nil {
+		edges = append(edges, user.EdgeLikePosts)
+	}
+	if m.removedlike_questions != nil {
+		edges = append(edges, user.EdgeLikeQuestions)
+	}
+	if m.removedfavorite_posts != nil {
+		edges = append(edges, user.EdgeFavoritePosts)
+	}
+	if m.removedfavorite_questions != nil {
+		edges = append(edges, user.EdgeFavoriteQuestions)
+	}
+	if m.removedfollowing != nil {
+		edges = append(edges, user.EdgeFollowing)
+	}
+	if m.removedfollowed_by != nil {
+		edges = append(edges, user.EdgeFollowedBy)
+	}
+	if m.removedrefresh_tokens != nil {
+		edges = append(edges, user.EdgeRefreshTokens)
+	}
+	if m.removedsessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.removedposts))
+		for id := range m.removedposts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeQuestions:
+		ids := make([]ent.Value, 0, len(m.removedquestions))
+		for id := range m.removedquestions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.removedanswers))
+		for id := range m.removedanswers {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAnswerVotes:
+		ids := make([]ent.Value, 0, len(m.removedanswer_votes))
+		for id := range m.removedanswer_votes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLikePosts:
+		ids := make([]ent.Value, 0, len(m.removedlike_posts))
+		for id := range m.removedlike_posts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLikeQuestions:
+		ids := make([]ent.Value, 0, len(m.removedlike_questions))
+		for id := range m.removedlike_questions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFavoritePosts:
+		ids := make([]ent.Value, 0, len(m.removedfavorite_posts))
+		for id := range m.removedfavorite_posts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFavoriteQuestions:
+		ids := make([]ent.Value, 0, len(m.removedfavorite_questions))
+		for id := range m.removedfavorite_questions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFollowing:
+		ids := make([]ent.Value, 0, len(m.removedfollowing))
+		for id := range m.removedfollowing {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFollowedBy:
+		ids := make([]ent.Value, 0, len(m.removedfollowed_by))
+		for id := range m.removedfollowed_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRefreshTokens:
+		ids := make([]ent.Value, 0, len(m.removedrefresh_tokens))
+		for id := range m.removedrefresh_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 13)
+	if m.clearedposts {
+		edges = append(edges, user.EdgePosts)
+	}
+	if m.clearedquestions {
+		edges = append(edges, user.EdgeQuestions)
+	}
+	if m.clearedanswers {
+		edges = append(edges, user.EdgeAnswers)
+	}
+	if m.clearedanswer_votes {
+		edges = append(edges, user.EdgeAnswerVotes)
+	}
+	if m.clearedcomments {
+		edges = append(edges, user.EdgeComments)
+	}
+	if m.clearedlike_posts {
+		edges = append(edges, user.EdgeLikePosts)
+	}
+	if m.clearedlike_questions {
+		edges = append(edges, user.EdgeLikeQuestions)
+	}
+	if m.clearedfavorite_posts {
+		edges = append(edges, user.EdgeFavoritePosts)
+	}
+	if m.clearedfavorite_questions {
+		edges = append(edges, user.EdgeFavoriteQuestions)
+	}
+	if m.clearedfollowing {
+		edges = append(edges, user.EdgeFollowing)
+	}
+	if m.clearedfollowed_by {
+		edges = append(edges, user.EdgeFollowedBy)
+	}
+	if m.clearedrefresh_tokens {
+		edges = append(edges, user.EdgeRefreshTokens)
+	}
+	if m.clearedsessions {
+		edges = append(edges, user.EdgeSessions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgePosts:
+		return m.clearedposts
+	case user.EdgeQuestions:
+		return m.clearedquestions
+	case user.EdgeAnswers:
+		return m.clearedanswers
+	case user.EdgeAnswerVotes:
+		return m.clearedanswer_votes
+	case user.EdgeComments:
+		return m.clearedcomments
+	case user.EdgeLikePosts:
+		return m.clearedlike_posts
+	case user.EdgeLikeQuestions:
+		return m.clearedlike_questions
+	case user.EdgeFavoritePosts:
+		return m.clearedfavorite_posts
+	case user.EdgeFavoriteQuestions:
+		return m.clearedfavorite_questions
+	case user.EdgeFollowing:
+		return m.clearedfollowing
+	case user.EdgeFollowedBy:
+		return m.clearedfollowed_by
+	case user.EdgeRefreshTokens:
+		return m.clearedrefresh_tokens
+	case user.EdgeSessions:
+		return m.clearedsessions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgePosts:
+		m.ResetPosts()
+		return nil
+	case user.EdgeQuestions:
+		m.ResetQuestions()
+		return nil
+	case user.EdgeAnswers:
+		m.ResetAnswers()
+		return nil
+	case user.EdgeAnswerVotes:
+		m.ResetAnswerVotes()
+		return nil
+	case user.EdgeComments:
+		m.ResetComments()
+		return nil
+	case user.EdgeLikePosts:
+		m.ResetLikePosts()
+		return nil
+	case user.EdgeLikeQuestions:
+		m.ResetLikeQuestions()
+		return nil
+	case user.EdgeFavoritePosts:
+		m.ResetFavoritePosts()
+		return nil
+	case user.EdgeFavoriteQuestions:
+		m.ResetFavoriteQuestions()
+		return nil
+	case user.EdgeFollowing:
+		m.ResetFollowing()
+		return nil
+	case user.EdgeFollowedBy:
+		m.ResetFollowedBy()
+		return nil
+	case user.EdgeRefreshTokens:
+		m.ResetRefreshTokens()
+		return nil
+	case user.EdgeSessions:
+		m.ResetSessions()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// FavoritePostsCleared reports if the "favorite_posts" edge to the UserFavoritePost entity was cleared.
-func (m *UserMutation) FavoritePostsCleared() bool {
-	return m.clearedfavorite_posts
+// UserFavoritePostMutation represents an operation that mutates the UserFavoritePost nodes in the graph.
+type UserFavoritePostMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	post          *int
+	clearedpost   bool
+	done          bool
+	oldValue      func(context.Context) (*UserFavoritePost, error)
+	predicates    []predicate.UserFavoritePost
 }
 
-// RemoveFavoritePostIDs removes the "favorite_posts" edge to the UserFavoritePost entity by IDs.
-func (m *UserMutation) RemoveFavoritePostIDs(ids ...int) {
-	if m.removedfavorite_posts == nil {
-		m.removedfavorite_posts = make(map[int]struct{})
+var _ ent.Mutation = (*UserFavoritePostMutation)(nil)
+
+// userfavoritepostOption allows management of the mutation configuration using functional options.
+type userfavoritepostOption func(*UserFavoritePostMutation)
+
+// newUserFavoritePostMutation creates new mutation for the UserFavoritePost entity.
+func newUserFavoritePostMutation(c config, op Op, opts ...userfavoritepostOption) *UserFavoritePostMutation {
+	m := &UserFavoritePostMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserFavoritePost,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		delete(m.favorite_posts, ids[i])
-		m.removedfavorite_posts[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedFavoritePosts returns the removed IDs of the "favorite_posts" edge to the UserFavoritePost entity.
-func (m *UserMutation) RemovedFavoritePostsIDs() (ids []int) {
-	for id := range m.removedfavorite_posts {
-		ids = append(ids, id)
+// withUserFavoritePostID sets the ID field of the mutation.
+func withUserFavoritePostID(id int) userfavoritepostOption {
+	return func(m *UserFavoritePostMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserFavoritePost
+		)
+		m.oldValue = func(ctx context.Context) (*UserFavoritePost, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserFavoritePost.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// FavoritePostsIDs returns the "favorite_posts" edge IDs in the mutation.
-func (m *UserMutation) FavoritePostsIDs() (ids []int) {
-	for id := range m.favorite_posts {
-		ids = append(ids, id)
+// withUserFavoritePost sets the old UserFavoritePost of the mutation.
+func withUserFavoritePost(node *UserFavoritePost) userfavoritepostOption {
+	return func(m *UserFavoritePostMutation) {
+		m.oldValue = func(context.Context) (*UserFavoritePost, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetFavoritePosts resets all changes to the "favorite_posts" edge.
-func (m *UserMutation) ResetFavoritePosts() {
-	m.favorite_posts = nil
-	m.clearedfavorite_posts = false
-	m.removedfavorite_posts = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserFavoritePostMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddFavoriteQuestionIDs adds the "favorite_questions" edge to the UserFavoriteQuestion entity by ids.
-func (m *UserMutation) AddFavoriteQuestionIDs(ids ...int) {
-	if m.favorite_questions == nil {
-		m.favorite_questions = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.favorite_questions[ids[i]] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserFavoritePostMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearFavoriteQuestions clears the "favorite_questions" edge to the UserFavoriteQuestion entity.
-func (m *UserMutation) ClearFavoriteQuestions() {
-	m.clearedfavorite_questions = true
-}
-
-// FavoriteQuestionsCleared reports if the "favorite_questions" edge to the UserFavoriteQuestion entity was cleared.
-func (m *UserMutation) FavoriteQuestionsCleared() bool {
-	return m.clearedfavorite_questions
-}
-
-// RemoveFavoriteQuestionIDs removes the "favorite_questions" edge to the UserFavoriteQuestion entity by IDs.
-func (m *UserMutation) RemoveFavoriteQuestionIDs(ids ...int) {
-	if m.removedfavorite_questions == nil {
-		m.removedfavorite_questions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.favorite_questions, ids[i])
-		m.removedfavorite_questions[ids[i]] = struct{}{}
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserFavoritePostMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
+	return *m.id, true
 }
 
-// RemovedFavoriteQuestions returns the removed IDs of the "favorite_questions" edge to the UserFavoriteQuestion entity.
-func (m *UserMutation) RemovedFavoriteQuestionsIDs() (ids []int) {
-	for id := range m.removedfavorite_questions {
-		ids = append(ids, id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserFavoritePostMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserFavoritePost.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// FavoriteQuestionsIDs returns the "favorite_questions" edge IDs in the mutation.
-func (m *UserMutation) FavoriteQuestionsIDs() (ids []int) {
-	for id := range m.favorite_questions {
-		ids = append(ids, id)
-	}
-	return
+// SetUserID sets the "user_id" field.
+func (m *UserFavoritePostMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// ResetFavoriteQuestions resets all changes to the "favorite_questions" edge.
-func (m *UserMutation) ResetFavoriteQuestions() {
-	m.favorite_questions = nil
-	m.clearedfavorite_questions = false
-	m.removedfavorite_questions = nil
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserFavoritePostMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddFollowingIDs adds the "following" edge to the Follow entity by ids.
-func (m *UserMutation) AddFollowingIDs(ids ...int) {
-	if m.following == nil {
-		m.following = make(map[int]struct{})
+// OldUserID returns the old "user_id" field's value of the UserFavoritePost entity.
+// If the UserFavoritePost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserFavoritePostMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.following[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
+	return oldValue.UserID, nil
 }
 
-// ClearFollowing clears the "following" edge to the Follow entity.
-func (m *UserMutation) ClearFollowing() {
-	m.clearedfollowing = true
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserFavoritePostMutation) ResetUserID() {
+	m.user = nil
 }
 
-// FollowingCleared reports if the "following" edge to the Follow entity was cleared.
-func (m *UserMutation) FollowingCleared() bool {
-	return m.clearedfollowing
+// SetPostID sets the "post_id" field.
+func (m *UserFavoritePostMutation) SetPostID(i int) {
+	m.post = &i
 }
 
-// RemoveFollowingIDs removes the "following" edge to the Follow entity by IDs.
-func (m *UserMutation) RemoveFollowingIDs(ids ...int) {
-	if m.removedfollowing == nil {
-		m.removedfollowing = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.following, ids[i])
-		m.removedfollowing[ids[i]] = struct{}{}
+// PostID returns the value of the "post_id" field in the mutation.
+func (m *UserFavoritePostMutation) PostID() (r int, exists bool) {
+	v := m.post
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedFollowing returns the removed IDs of the "following" edge to the Follow entity.
-func (m *UserMutation) RemovedFollowingIDs() (ids []int) {
-	for id := range m.removedfollowing {
-		ids = append(ids, id)
+// OldPostID returns the old "post_id" field's value of the UserFavoritePost entity.
+// If the UserFavoritePost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserFavoritePostMutation) OldPostID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostID: %w", err)
+	}
+	return oldValue.PostID, nil
 }
 
-// FollowingIDs returns the "following" edge IDs in the mutation.
-func (m *UserMutation) FollowingIDs() (ids []int) {
-	for id := range m.following {
-		ids = append(ids, id)
-	}
-	return
+// ResetPostID resets all changes to the "post_id" field.
+func (m *UserFavoritePostMutation) ResetPostID() {
+	m.post = nil
 }
 
-// ResetFollowing resets all changes to the "following" edge.
-func (m *UserMutation) ResetFollowing() {
-	m.following = nil
-	m.clearedfollowing = false
-	m.removedfollowing = nil
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserFavoritePostMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[userfavoritepost.FieldUserID] = struct{}{}
 }
 
-// AddFollowedByIDs adds the "followed_by" edge to the Follow entity by ids.
-func (m *UserMutation) AddFollowedByIDs(ids ...int) {
-	if m.followed_by == nil {
-		m.followed_by = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.followed_by[ids[i]] = struct{}{}
-	}
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserFavoritePostMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// ClearFollowedBy clears the "followed_by" edge to the Follow entity.
-func (m *UserMutation) ClearFollowedBy() {
-	m.clearedfollowed_by = true
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserFavoritePostMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// FollowedByCleared reports if the "followed_by" edge to the Follow entity was cleared.
-func (m *UserMutation) FollowedByCleared() bool {
-	return m.clearedfollowed_by
+// ResetUser resets all changes to the "user" edge.
+func (m *UserFavoritePostMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// RemoveFollowedByIDs removes the "followed_by" edge to the Follow entity by IDs.
-func (m *UserMutation) RemoveFollowedByIDs(ids ...int) {
-	if m.removedfollowed_by == nil {
-		m.removedfollowed_by = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.followed_by, ids[i])
-		m.removedfollowed_by[ids[i]] = struct{}{}
-	}
+// ClearPost clears the "post" edge to the Post entity.
+func (m *UserFavoritePostMutation) ClearPost() {
+	m.clearedpost = true
+	m.clearedFields[userfavoritepost.FieldPostID] = struct{}{}
 }
 
-// RemovedFollowedBy returns the removed IDs of the "followed_by" edge to the Follow entity.
-func (m *UserMutation) RemovedFollowedByIDs() (ids []int) {
-	for id := range m.removedfollowed_by {
-		ids = append(ids, id)
-	}
-	return
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *UserFavoritePostMutation) PostCleared() bool {
+	return m.clearedpost
 }
 
-// FollowedByIDs returns the "followed_by" edge IDs in the mutation.
-func (m *UserMutation) FollowedByIDs() (ids []int) {
-	for id := range m.followed_by {
-		ids = append(ids, id)
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *UserFavoritePostMutation) PostIDs() (ids []int) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetFollowedBy resets all changes to the "followed_by" edge.
-func (m *UserMutation) ResetFollowedBy() {
-	m.followed_by = nil
-	m.clearedfollowed_by = false
-	m.removedfollowed_by = nil
+// ResetPost resets all changes to the "post" edge.
+func (m *UserFavoritePostMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the UserFavoritePostMutation builder.
+func (m *UserFavoritePostMutation) Where(ps ...predicate.UserFavoritePost) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserFavoritePostMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *UserFavoritePostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserFavoritePost, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6656,42 +13330,30 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *UserFavoritePostMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *UserFavoritePostMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (UserFavoritePost).
+func (m *UserFavoritePostMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.create_time != nil {
-		fields = append(fields, user.FieldCreateTime)
-	}
-	if m.update_time != nil {
-		fields = append(fields, user.FieldUpdateTime)
-	}
-	if m.phone != nil {
-		fields = append(fields, user.FieldPhone)
-	}
-	if m.nickname != nil {
-		fields = append(fields, user.FieldNickname)
-	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+func (m *UserFavoritePostMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.user != nil {
+		fields = append(fields, userfavoritepost.FieldUserID)
 	}
-	if m.avatar != nil {
-		fields = append(fields, user.FieldAvatar)
+	if m.post != nil {
+		fields = append(fields, userfavoritepost.FieldPostID)
 	}
 	return fields
 }
@@ -6699,20 +13361,12 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *UserFavoritePostMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldCreateTime:
-		return m.CreateTime()
-	case user.FieldUpdateTime:
-		return m.UpdateTime()
-	case user.FieldPhone:
-		return m.Phone()
-	case user.FieldNickname:
-		return m.Nickname()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldAvatar:
-		return m.Avatar()
+	case userfavoritepost.FieldUserID:
+		return m.UserID()
+	case userfavoritepost.FieldPostID:
+		return m.PostID()
 	}
 	return nil, false
 }
@@ -6720,482 +13374,216 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserFavoritePostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case user.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case user.FieldPhone:
-		return m.OldPhone(ctx)
-	case user.FieldNickname:
-		return m.OldNickname(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldAvatar:
-		return m.OldAvatar(ctx)
+	case userfavoritepost.FieldUserID:
+		return m.OldUserID(ctx)
+	case userfavoritepost.FieldPostID:
+		return m.OldPostID(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown UserFavoritePost field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *UserFavoritePostMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case user.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
-	case user.FieldPhone:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPhone(v)
-		return nil
-	case user.FieldNickname:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNickname(v)
-		return nil
-	case user.FieldPassword:
-		v, ok := value.(string)
+	case userfavoritepost.FieldUserID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetUserID(v)
 		return nil
-	case user.FieldAvatar:
-		v, ok := value.(string)
+	case userfavoritepost.FieldPostID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAvatar(v)
+		m.SetPostID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserFavoritePost field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
-	return nil
+func (m *UserFavoritePostMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserFavoritePostMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *UserFavoritePostMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown UserFavoritePost numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(user.FieldNickname) {
-		fields = append(fields, user.FieldNickname)
-	}
-	if m.FieldCleared(user.FieldAvatar) {
-		fields = append(fields, user.FieldAvatar)
-	}
-	return fields
+func (m *UserFavoritePostMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *UserFavoritePostMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	switch name {
-	case user.FieldNickname:
-		m.ClearNickname()
-		return nil
-	case user.FieldAvatar:
-		m.ClearAvatar()
-		return nil
-	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *UserFavoritePostMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserFavoritePost nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
-	switch name {
-	case user.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case user.FieldUpdateTime:
-		m.ResetUpdateTime()
-		return nil
-	case user.FieldPhone:
-		m.ResetPhone()
-		return nil
-	case user.FieldNickname:
-		m.ResetNickname()
-		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
-		return nil
-	case user.FieldAvatar:
-		m.ResetAvatar()
-		return nil
-	}
-	return fmt.Errorf("unknown User field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 9)
-	if m.posts != nil {
-		edges = append(edges, user.EdgePosts)
-	}
-	if m.questions != nil {
-		edges = append(edges, user.EdgeQuestions)
-	}
-	if m.comments != nil {
-		edges = append(edges, user.EdgeComments)
-	}
-	if m.like_posts != nil {
-		edges = append(edges, user.EdgeLikePosts)
-	}
-	if m.like_questions != nil {
-		edges = append(edges, user.EdgeLikeQuestions)
-	}
-	if m.favorite_posts != nil {
-		edges = append(edges, user.EdgeFavoritePosts)
-	}
-	if m.favorite_questions != nil {
-		edges = append(edges, user.EdgeFavoriteQuestions)
+func (m *UserFavoritePostMutation) ResetField(name string) error {
+	switch name {
+	case userfavoritepost.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case userfavoritepost.FieldPostID:
+		m.ResetPostID()
+		return nil
 	}
-	if m.following != nil {
-		edges = append(edges, user.EdgeFollowing)
+	return fmt.Errorf("unknown UserFavoritePost field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserFavoritePostMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, userfavoritepost.EdgeUser)
 	}
-	if m.followed_by != nil {
-		edges = append(edges, user.EdgeFollowedBy)
+	if m.post != nil {
+		edges = append(edges, userfavoritepost.EdgePost)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *UserFavoritePostMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgePosts:
-		ids := make([]ent.Value, 0, len(m.posts))
-		for id := range m.posts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeQuestions:
-		ids := make([]ent.Value, 0, len(m.questions))
-		for id := range m.questions {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.comments))
-		for id := range m.comments {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeLikePosts:
-		ids := make([]ent.Value, 0, len(m.like_posts))
-		for id := range m.like_posts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeLikeQuestions:
-		ids := make([]ent.Value, 0, len(m.like_questions))
-		for id := range m.like_questions {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeFavoritePosts:
-		ids := make([]ent.Value, 0, len(m.favorite_posts))
-		for id := range m.favorite_posts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeFavoriteQuestions:
-		ids := make([]ent.Value, 0, len(m.favorite_questions))
-		for id := range m.favorite_questions {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeFollowing:
-		ids := make([]ent.Value, 0, len(m.following))
-		for id := range m.following {
-			ids = append(ids, id)
+	case userfavoritepost.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case user.EdgeFollowedBy:
-		ids := make([]ent.Value, 0, len(m.followed_by))
-		for id := range m.followed_by {
-			ids = append(ids, id)
+	case userfavoritepost.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 9)
-	if m.removedposts != nil {
-		edges = append(edges, user.EdgePosts)
-	}
-	if m.removedquestions != nil {
-		edges = append(edges, user.EdgeQuestions)
-	}
-	if m.removedcomments != nil {
-		edges = append(edges, user.EdgeComments)
-	}
-	if m.removedlike_posts != nil {
-		edges = append(edges, user.EdgeLikePosts)
-	}
-	if m.removedlike_questions != nil {
-		edges = append(edges, user.EdgeLikeQuestions)
-	}
-	if m.removedfavorite_posts != nil {
-		edges = append(edges, user.EdgeFavoritePosts)
-	}
-	if m.removedfavorite_questions != nil {
-		edges = append(edges, user.EdgeFavoriteQuestions)
-	}
-	if m.removedfollowing != nil {
-		edges = append(edges, user.EdgeFollowing)
-	}
-	if m.removedfollowed_by != nil {
-		edges = append(edges, user.EdgeFollowedBy)
-	}
+func (m *UserFavoritePostMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgePosts:
-		ids := make([]ent.Value, 0, len(m.removedposts))
-		for id := range m.removedposts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeQuestions:
-		ids := make([]ent.Value, 0, len(m.removedquestions))
-		for id := range m.removedquestions {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.removedcomments))
-		for id := range m.removedcomments {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeLikePosts:
-		ids := make([]ent.Value, 0, len(m.removedlike_posts))
-		for id := range m.removedlike_posts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeLikeQuestions:
-		ids := make([]ent.Value, 0, len(m.removedlike_questions))
-		for id := range m.removedlike_questions {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeFavoritePosts:
-		ids := make([]ent.Value, 0, len(m.removedfavorite_posts))
-		for id := range m.removedfavorite_posts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeFavoriteQuestions:
-		ids := make([]ent.Value, 0, len(m.removedfavorite_questions))
-		for id := range m.removedfavorite_questions {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeFollowing:
-		ids := make([]ent.Value, 0, len(m.removedfollowing))
-		for id := range m.removedfollowing {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeFollowedBy:
-		ids := make([]ent.Value, 0, len(m.removedfollowed_by))
-		for id := range m.removedfollowed_by {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserFavoritePostMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 9)
-	if m.clearedposts {
-		edges = append(edges, user.EdgePosts)
-	}
-	if m.clearedquestions {
-		edges = append(edges, user.EdgeQuestions)
-	}
-	if m.clearedcomments {
-		edges = append(edges, user.EdgeComments)
-	}
-	if m.clearedlike_posts {
-		edges = append(edges, user.EdgeLikePosts)
-	}
-	if m.clearedlike_questions {
-		edges = append(edges, user.EdgeLikeQuestions)
-	}
-	if m.clearedfavorite_posts {
-		edges = append(edges, user.EdgeFavoritePosts)
-	}
-	if m.clearedfavorite_questions {
-		edges = append(edges, user.EdgeFavoriteQuestions)
-	}
-	if m.clearedfollowing {
-		edges = append(edges, user.EdgeFollowing)
+func (m *UserFavoritePostMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, userfavoritepost.EdgeUser)
 	}
-	if m.clearedfollowed_by {
-		edges = append(edges, user.EdgeFollowedBy)
+	if m.clearedpost {
+		edges = append(edges, userfavoritepost.EdgePost)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *UserFavoritePostMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgePosts:
-		return m.clearedposts
-	case user.EdgeQuestions:
-		return m.clearedquestions
-	case user.EdgeComments:
-		return m.clearedcomments
-	case user.EdgeLikePosts:
-		return m.clearedlike_posts
-	case user.EdgeLikeQuestions:
-		return m.clearedlike_questions
-	case user.EdgeFavoritePosts:
-		return m.clearedfavorite_posts
-	case user.EdgeFavoriteQuestions:
-		return m.clearedfavorite_questions
-	case user.EdgeFollowing:
-		return m.clearedfollowing
-	case user.EdgeFollowedBy:
-		return m.clearedfollowed_by
+	case userfavoritepost.EdgeUser:
+		return m.cleareduser
+	case userfavoritepost.EdgePost:
+		return m.clearedpost
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *UserFavoritePostMutation) ClearEdge(name string) error {
 	switch name {
+	case userfavoritepost.EdgeUser:
+		m.ClearUser()
+		return nil
+	case userfavoritepost.EdgePost:
+		m.ClearPost()
+		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown UserFavoritePost unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *UserFavoritePostMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgePosts:
-		m.ResetPosts()
-		return nil
-	case user.EdgeQuestions:
-		m.ResetQuestions()
-		return nil
-	case user.EdgeComments:
-		m.ResetComments()
-		return nil
-	case user.EdgeLikePosts:
-		m.ResetLikePosts()
-		return nil
-	case user.EdgeLikeQuestions:
-		m.ResetLikeQuestions()
-		return nil
-	case user.EdgeFavoritePosts:
-		m.ResetFavoritePosts()
-		return nil
-	case user.EdgeFavoriteQuestions:
-		m.ResetFavoriteQuestions()
-		return nil
-	case user.EdgeFollowing:
-		m.ResetFollowing()
+	case userfavoritepost.EdgeUser:
+		m.ResetUser()
 		return nil
-	case user.EdgeFollowedBy:
-		m.ResetFollowedBy()
+	case userfavoritepost.EdgePost:
+		m.ResetPost()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown UserFavoritePost edge %s", name)
 }
 
-// UserFavoritePostMutation represents an operation that mutates the UserFavoritePost nodes in the graph.
-type UserFavoritePostMutation struct {
+// UserFavoriteQuestionMutation represents an operation that mutates the UserFavoriteQuestion nodes in the graph.
+type UserFavoriteQuestionMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	user_id       *int
-	adduser_id    *int
-	post_id       *int
-	addpost_id    *int
-	clearedFields map[string]struct{}
-	user          map[int]struct{}
-	removeduser   map[int]struct{}
-	cleareduser   bool
-	post          map[int]struct{}
-	removedpost   map[int]struct{}
-	clearedpost   bool
-	done          bool
-	oldValue      func(context.Context) (*UserFavoritePost, error)
-	predicates    []predicate.UserFavoritePost
+	op              Op
+	typ             string
+	id              *int
+	clearedFields   map[string]struct{}
+	user            *int
+	cleareduser     bool
+	question        *int
+	clearedquestion bool
+	done            bool
+	oldValue        func(context.Context) (*UserFavoriteQuestion, error)
+	predicates      []predicate.UserFavoriteQuestion
 }
 
-var _ ent.Mutation = (*UserFavoritePostMutation)(nil)
+var _ ent.Mutation = (*UserFavoriteQuestionMutation)(nil)
 
-// userfavoritepostOption allows management of the mutation configuration using functional options.
-type userfavoritepostOption func(*UserFavoritePostMutation)
+// userfavoritequestionOption allows management of the mutation configuration using functional options.
+type userfavoritequestionOption func(*UserFavoriteQuestionMutation)
 
-// newUserFavoritePostMutation creates new mutation for the UserFavoritePost entity.
-func newUserFavoritePostMutation(c config, op Op, opts ...userfavoritepostOption) *UserFavoritePostMutation {
-	m := &UserFavoritePostMutation{
+// newUserFavoriteQuestionMutation creates new mutation for the UserFavoriteQuestion entity.
+func newUserFavoriteQuestionMutation(c config, op Op, opts ...userfavoritequestionOption) *UserFavoriteQuestionMutation {
+	m := &UserFavoriteQuestionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUserFavoritePost,
+		typ:           TypeUserFavoriteQuestion,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7204,20 +13592,20 @@ func newUserFavoritePostMutation(c config, op Op, opts ...userfavoritepostOption
 	return m
 }
 
-// withUserFavoritePostID sets the ID field of the mutation.
-func withUserFavoritePostID(id int) userfavoritepostOption {
-	return func(m *UserFavoritePostMutation) {
+// withUserFavoriteQuestionID sets the ID field of the mutation.
+func withUserFavoriteQuestionID(id int) userfavoritequestionOption {
+	return func(m *UserFavoriteQuestionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *UserFavoritePost
+			value *UserFavoriteQuestion
 		)
-		m.oldValue = func(ctx context.Context) (*UserFavoritePost, error) {
+		m.oldValue = func(ctx context.Context) (*UserFavoriteQuestion, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().UserFavoritePost.Get(ctx, id)
+					value, err = m.Client().UserFavoriteQuestion.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7226,10 +13614,10 @@ func withUserFavoritePostID(id int) userfavoritepostOption {
 	}
 }
 
-// withUserFavoritePost sets the old UserFavoritePost of the mutation.
-func withUserFavoritePost(node *UserFavoritePost) userfavoritepostOption {
-	return func(m *UserFavoritePostMutation) {
-		m.oldValue = func(context.Context) (*UserFavoritePost, error) {
+// withUserFavoriteQuestion sets the old UserFavoriteQuestion of the mutation.
+func withUserFavoriteQuestion(node *UserFavoriteQuestion) userfavoritequestionOption {
+	return func(m *UserFavoriteQuestionMutation) {
+		m.oldValue = func(context.Context) (*UserFavoriteQuestion, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7238,7 +13626,7 @@ func withUserFavoritePost(node *UserFavoritePost) userfavoritepostOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserFavoritePostMutation) Client() *Client {
+func (m UserFavoriteQuestionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7246,7 +13634,7 @@ func (m UserFavoritePostMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserFavoritePostMutation) Tx() (*Tx, error) {
+func (m UserFavoriteQuestionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7257,7 +13645,7 @@ func (m UserFavoritePostMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserFavoritePostMutation) ID() (id int, exists bool) {
+func (m *UserFavoriteQuestionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7268,7 +13656,7 @@ func (m *UserFavoritePostMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserFavoritePostMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UserFavoriteQuestionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7277,31 +13665,30 @@ func (m *UserFavoritePostMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UserFavoritePost.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UserFavoriteQuestion.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUserID sets the "user_id" field.
-func (m *UserFavoritePostMutation) SetUserID(i int) {
-	m.user_id = &i
-	m.adduser_id = nil
+func (m *UserFavoriteQuestionMutation) SetUserID(i int) {
+	m.user = &i
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *UserFavoritePostMutation) UserID() (r int, exists bool) {
-	v := m.user_id
+func (m *UserFavoriteQuestionMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the UserFavoritePost entity.
-// If the UserFavoritePost object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the UserFavoriteQuestion entity.
+// If the UserFavoriteQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserFavoritePostMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *UserFavoriteQuestionMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -7315,203 +13702,110 @@ func (m *UserFavoritePostMutation) OldUserID(ctx context.Context) (v int, err er
 	return oldValue.UserID, nil
 }
 
-// AddUserID adds i to the "user_id" field.
-func (m *UserFavoritePostMutation) AddUserID(i int) {
-	if m.adduser_id != nil {
-		*m.adduser_id += i
-	} else {
-		m.adduser_id = &i
-	}
-}
-
-// AddedUserID returns the value that was added to the "user_id" field in this mutation.
-func (m *UserFavoritePostMutation) AddedUserID() (r int, exists bool) {
-	v := m.adduser_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
 // ResetUserID resets all changes to the "user_id" field.
-func (m *UserFavoritePostMutation) ResetUserID() {
-	m.user_id = nil
-	m.adduser_id = nil
+func (m *UserFavoriteQuestionMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetPostID sets the "post_id" field.
-func (m *UserFavoritePostMutation) SetPostID(i int) {
-	m.post_id = &i
-	m.addpost_id = nil
+// SetQuestionID sets the "question_id" field.
+func (m *UserFavoriteQuestionMutation) SetQuestionID(i int) {
+	m.question = &i
 }
 
-// PostID returns the value of the "post_id" field in the mutation.
-func (m *UserFavoritePostMutation) PostID() (r int, exists bool) {
-	v := m.post_id
+// QuestionID returns the value of the "question_id" field in the mutation.
+func (m *UserFavoriteQuestionMutation) QuestionID() (r int, exists bool) {
+	v := m.question
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPostID returns the old "post_id" field's value of the UserFavoritePost entity.
-// If the UserFavoritePost object wasn't provided to the builder, the object is fetched from the database.
+// OldQuestionID returns the old "question_id" field's value of the UserFavoriteQuestion entity.
+// If the UserFavoriteQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserFavoritePostMutation) OldPostID(ctx context.Context) (v int, err error) {
+func (m *UserFavoriteQuestionMutation) OldQuestionID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPostID is only allowed on UpdateOne operations")
+		return v, errors.New("OldQuestionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPostID requires an ID field in the mutation")
+		return v, errors.New("OldQuestionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPostID: %w", err)
-	}
-	return oldValue.PostID, nil
-}
-
-// AddPostID adds i to the "post_id" field.
-func (m *UserFavoritePostMutation) AddPostID(i int) {
-	if m.addpost_id != nil {
-		*m.addpost_id += i
-	} else {
-		m.addpost_id = &i
-	}
-}
-
-// AddedPostID returns the value that was added to the "post_id" field in this mutation.
-func (m *UserFavoritePostMutation) AddedPostID() (r int, exists bool) {
-	v := m.addpost_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldQuestionID: %w", err)
 	}
-	return *v, true
-}
-
-// ResetPostID resets all changes to the "post_id" field.
-func (m *UserFavoritePostMutation) ResetPostID() {
-	m.post_id = nil
-	m.addpost_id = nil
+	return oldValue.QuestionID, nil
 }
 
-// AddUserIDs adds the "user" edge to the User entity by ids.
-func (m *UserFavoritePostMutation) AddUserIDs(ids ...int) {
-	if m.user == nil {
-		m.user = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.user[ids[i]] = struct{}{}
-	}
+// ResetQuestionID resets all changes to the "question_id" field.
+func (m *UserFavoriteQuestionMutation) ResetQuestionID() {
+	m.question = nil
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *UserFavoritePostMutation) ClearUser() {
+func (m *UserFavoriteQuestionMutation) ClearUser() {
 	m.cleareduser = true
+	m.clearedFields[userfavoritequestion.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *UserFavoritePostMutation) UserCleared() bool {
+func (m *UserFavoriteQuestionMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
-// RemoveUserIDs removes the "user" edge to the User entity by IDs.
-func (m *UserFavoritePostMutation) RemoveUserIDs(ids ...int) {
-	if m.removeduser == nil {
-		m.removeduser = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.user, ids[i])
-		m.removeduser[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedUser returns the removed IDs of the "user" edge to the User entity.
-func (m *UserFavoritePostMutation) RemovedUserIDs() (ids []int) {
-	for id := range m.removeduser {
-		ids = append(ids, id)
-	}
-	return
-}
-
 // UserIDs returns the "user" edge IDs in the mutation.
-func (m *UserFavoritePostMutation) UserIDs() (ids []int) {
-	for id := range m.user {
-		ids = append(ids, id)
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserFavoriteQuestionMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *UserFavoritePostMutation) ResetUser() {
+func (m *UserFavoriteQuestionMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
-	m.removeduser = nil
-}
-
-// AddPostIDs adds the "post" edge to the Post entity by ids.
-func (m *UserFavoritePostMutation) AddPostIDs(ids ...int) {
-	if m.post == nil {
-		m.post = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.post[ids[i]] = struct{}{}
-	}
-}
-
-// ClearPost clears the "post" edge to the Post entity.
-func (m *UserFavoritePostMutation) ClearPost() {
-	m.clearedpost = true
-}
-
-// PostCleared reports if the "post" edge to the Post entity was cleared.
-func (m *UserFavoritePostMutation) PostCleared() bool {
-	return m.clearedpost
 }
 
-// RemovePostIDs removes the "post" edge to the Post entity by IDs.
-func (m *UserFavoritePostMutation) RemovePostIDs(ids ...int) {
-	if m.removedpost == nil {
-		m.removedpost = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.post, ids[i])
-		m.removedpost[ids[i]] = struct{}{}
-	}
+// ClearQuestion clears the "question" edge to the Question entity.
+func (m *UserFavoriteQuestionMutation) ClearQuestion() {
+	m.clearedquestion = true
+	m.clearedFields[userfavoritequestion.FieldQuestionID] = struct{}{}
 }
 
-// RemovedPost returns the removed IDs of the "post" edge to the Post entity.
-func (m *UserFavoritePostMutation) RemovedPostIDs() (ids []int) {
-	for id := range m.removedpost {
-		ids = append(ids, id)
-	}
-	return
+// QuestionCleared reports if the "question" edge to the Question entity was cleared.
+func (m *UserFavoriteQuestionMutation) QuestionCleared() bool {
+	return m.clearedquestion
 }
 
-// PostIDs returns the "post" edge IDs in the mutation.
-func (m *UserFavoritePostMutation) PostIDs() (ids []int) {
-	for id := range m.post {
-		ids = append(ids, id)
+// QuestionIDs returns the "question" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuestionID instead. It exists only for internal usage by the builders.
+func (m *UserFavoriteQuestionMutation) QuestionIDs() (ids []int) {
+	if id := m.question; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPost resets all changes to the "post" edge.
-func (m *UserFavoritePostMutation) ResetPost() {
-	m.post = nil
-	m.clearedpost = false
-	m.removedpost = nil
+// ResetQuestion resets all changes to the "question" edge.
+func (m *UserFavoriteQuestionMutation) ResetQuestion() {
+	m.question = nil
+	m.clearedquestion = false
 }
 
-// Where appends a list predicates to the UserFavoritePostMutation builder.
-func (m *UserFavoritePostMutation) Where(ps ...predicate.UserFavoritePost) {
+// Where appends a list predicates to the UserFavoriteQuestionMutation builder.
+func (m *UserFavoriteQuestionMutation) Where(ps ...predicate.UserFavoriteQuestion) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserFavoritePostMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserFavoriteQuestionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserFavoritePostMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UserFavoritePost, len(ps))
+func (m *UserFavoriteQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserFavoriteQuestion, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7519,30 +13813,30 @@ func (m *UserFavoritePostMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserFavoritePostMutation) Op() Op {
+func (m *UserFavoriteQuestionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserFavoritePostMutation) SetOp(op Op) {
+func (m *UserFavoriteQuestionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (UserFavoritePost).
-func (m *UserFavoritePostMutation) Type() string {
+// Type returns the node type of this mutation (UserFavoriteQuestion).
+func (m *UserFavoriteQuestionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserFavoritePostMutation) Fields() []string {
+func (m *UserFavoriteQuestionMutation) Fields() []string {
 	fields := make([]string, 0, 2)
-	if m.user_id != nil {
-		fields = append(fields, userfavoritepost.FieldUserID)
+	if m.user != nil {
+		fields = append(fields, userfavoritequestion.FieldUserID)
 	}
-	if m.post_id != nil {
-		fields = append(fields, userfavoritepost.FieldPostID)
+	if m.question != nil {
+		fields = append(fields, userfavoritequestion.FieldQuestionID)
 	}
 	return fields
 }
@@ -7550,12 +13844,12 @@ func (m *UserFavoritePostMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserFavoritePostMutation) Field(name string) (ent.Value, bool) {
+func (m *UserFavoriteQuestionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case userfavoritepost.FieldUserID:
+	case userfavoritequestion.FieldUserID:
 		return m.UserID()
-	case userfavoritepost.FieldPostID:
-		return m.PostID()
+	case userfavoritequestion.FieldQuestionID:
+		return m.QuestionID()
 	}
 	return nil, false
 }
@@ -7563,61 +13857,51 @@ func (m *UserFavoritePostMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserFavoritePostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserFavoriteQuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case userfavoritepost.FieldUserID:
+	case userfavoritequestion.FieldUserID:
 		return m.OldUserID(ctx)
-	case userfavoritepost.FieldPostID:
-		return m.OldPostID(ctx)
+	case userfavoritequestion.FieldQuestionID:
+		return m.OldQuestionID(ctx)
 	}
-	return nil, fmt.Errorf("unknown UserFavoritePost field %s", name)
+	return nil, fmt.Errorf("unknown UserFavoriteQuestion field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserFavoritePostMutation) SetField(name string, value ent.Value) error {
+func (m *UserFavoriteQuestionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case userfavoritepost.FieldUserID:
+	case userfavoritequestion.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case userfavoritepost.FieldPostID:
+	case userfavoritequestion.FieldQuestionID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPostID(v)
+		m.SetQuestionID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown UserFavoritePost field %s", name)
+	return fmt.Errorf("unknown UserFavoriteQuestion field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserFavoritePostMutation) AddedFields() []string {
-	var fields []string
-	if m.adduser_id != nil {
-		fields = append(fields, userfavoritepost.FieldUserID)
-	}
-	if m.addpost_id != nil {
-		fields = append(fields, userfavoritepost.FieldPostID)
-	}
+func (m *UserFavoriteQuestionMutation) AddedFields() []string {
+	var fields []string
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserFavoritePostMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserFavoriteQuestionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case userfavoritepost.FieldUserID:
-		return m.AddedUserID()
-	case userfavoritepost.FieldPostID:
-		return m.AddedPostID()
 	}
 	return nil, false
 }
@@ -7625,202 +13909,164 @@ func (m *UserFavoritePostMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserFavoritePostMutation) AddField(name string, value ent.Value) error {
+func (m *UserFavoriteQuestionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case userfavoritepost.FieldUserID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUserID(v)
-		return nil
-	case userfavoritepost.FieldPostID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPostID(v)
-		return nil
 	}
-	return fmt.Errorf("unknown UserFavoritePost numeric field %s", name)
+	return fmt.Errorf("unknown UserFavoriteQuestion numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserFavoritePostMutation) ClearedFields() []string {
+func (m *UserFavoriteQuestionMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserFavoritePostMutation) FieldCleared(name string) bool {
+func (m *UserFavoriteQuestionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserFavoritePostMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown UserFavoritePost nullable field %s", name)
+func (m *UserFavoriteQuestionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserFavoriteQuestion nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserFavoritePostMutation) ResetField(name string) error {
+func (m *UserFavoriteQuestionMutation) ResetField(name string) error {
 	switch name {
-	case userfavoritepost.FieldUserID:
+	case userfavoritequestion.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case userfavoritepost.FieldPostID:
-		m.ResetPostID()
+	case userfavoritequestion.FieldQuestionID:
+		m.ResetQuestionID()
 		return nil
 	}
-	return fmt.Errorf("unknown UserFavoritePost field %s", name)
+	return fmt.Errorf("unknown UserFavoriteQuestion field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserFavoritePostMutation) AddedEdges() []string {
+func (m *UserFavoriteQuestionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.user != nil {
-		edges = append(edges, userfavoritepost.EdgeUser)
+		edges = append(edges, userfavoritequestion.EdgeUser)
 	}
-	if m.post != nil {
-		edges = append(edges, userfavoritepost.EdgePost)
+	if m.question != nil {
+		edges = append(edges, userfavoritequestion.EdgeQuestion)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserFavoritePostMutation) AddedIDs(name string) []ent.Value {
+func (m *UserFavoriteQuestionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case userfavoritepost.EdgeUser:
-		ids := make([]ent.Value, 0, len(m.user))
-		for id := range m.user {
-			ids = append(ids, id)
+	case userfavoritequestion.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case userfavoritepost.EdgePost:
-		ids := make([]ent.Value, 0, len(m.post))
-		for id := range m.post {
-			ids = append(ids, id)
+	case userfavoritequestion.EdgeQuestion:
+		if id := m.question; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserFavoritePostMutation) RemovedEdges() []string {
+func (m *UserFavoriteQuestionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removeduser != nil {
-		edges = append(edges, userfavoritepost.EdgeUser)
-	}
-	if m.removedpost != nil {
-		edges = append(edges, userfavoritepost.EdgePost)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserFavoritePostMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case userfavoritepost.EdgeUser:
-		ids := make([]ent.Value, 0, len(m.removeduser))
-		for id := range m.removeduser {
-			ids = append(ids, id)
-		}
-		return ids
-	case userfavoritepost.EdgePost:
-		ids := make([]ent.Value, 0, len(m.removedpost))
-		for id := range m.removedpost {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserFavoriteQuestionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserFavoritePostMutation) ClearedEdges() []string {
+func (m *UserFavoriteQuestionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.cleareduser {
-		edges = append(edges, userfavoritepost.EdgeUser)
+		edges = append(edges, userfavoritequestion.EdgeUser)
 	}
-	if m.clearedpost {
-		edges = append(edges, userfavoritepost.EdgePost)
+	if m.clearedquestion {
+		edges = append(edges, userfavoritequestion.EdgeQuestion)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserFavoritePostMutation) EdgeCleared(name string) bool {
+func (m *UserFavoriteQuestionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case userfavoritepost.EdgeUser:
+	case userfavoritequestion.EdgeUser:
 		return m.cleareduser
-	case userfavoritepost.EdgePost:
-		return m.clearedpost
+	case userfavoritequestion.EdgeQuestion:
+		return m.clearedquestion
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserFavoritePostMutation) ClearEdge(name string) error {
+func (m *UserFavoriteQuestionMutation) ClearEdge(name string) error {
 	switch name {
+	case userfavoritequestion.EdgeUser:
+		m.ClearUser()
+		return nil
+	case userfavoritequestion.EdgeQuestion:
+		m.ClearQuestion()
+		return nil
 	}
-	return fmt.Errorf("unknown UserFavoritePost unique edge %s", name)
+	return fmt.Errorf("unknown UserFavoriteQuestion unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserFavoritePostMutation) ResetEdge(name string) error {
+func (m *UserFavoriteQuestionMutation) ResetEdge(name string) error {
 	switch name {
-	case userfavoritepost.EdgeUser:
+	case userfavoritequestion.EdgeUser:
 		m.ResetUser()
 		return nil
-	case userfavoritepost.EdgePost:
-		m.ResetPost()
+	case userfavoritequestion.EdgeQuestion:
+		m.ResetQuestion()
 		return nil
 	}
-	return fmt.Errorf("unknown UserFavoritePost edge %s", name)
+	return fmt.Errorf("unknown UserFavoriteQuestion edge %s", name)
 }
 
-// UserFavoriteQuestionMutation represents an operation that mutates the UserFavoriteQuestion nodes in the graph.
-type UserFavoriteQuestionMutation struct {
+// UserLikePostMutation represents an operation that mutates the UserLikePost nodes in the graph.
+type UserLikePostMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	user_id         *int
-	adduser_id      *int
-	question_id     *int
-	addquestion_id  *int
-	clearedFields   map[string]struct{}
-	user            map[int]struct{}
-	removeduser     map[int]struct{}
-	cleareduser     bool
-	question        map[int]struct{}
-	removedquestion map[int]struct{}
-	clearedquestion bool
-	done            bool
-	oldValue        func(context.Context) (*UserFavoriteQuestion, error)
-	predicates      []predicate.UserFavoriteQuestion
+	op            Op
+	typ           string
+	id            *int
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	post          *int
+	clearedpost   bool
+	done          bool
+	oldValue      func(context.Context) (*UserLikePost, error)
+	predicates    []predicate.UserLikePost
 }
 
-var _ ent.Mutation = (*UserFavoriteQuestionMutation)(nil)
+var _ ent.Mutation = (*UserLikePostMutation)(nil)
 
-// userfavoritequestionOption allows management of the mutation configuration using functional options.
-type userfavoritequestionOption func(*UserFavoriteQuestionMutation)
+// userlikepostOption allows management of the mutation configuration using functional options.
+type userlikepostOption func(*UserLikePostMutation)
 
-// newUserFavoriteQuestionMutation creates new mutation for the UserFavoriteQuestion entity.
-func newUserFavoriteQuestionMutation(c config, op Op, opts ...userfavoritequestionOption) *UserFavoriteQuestionMutation {
-	m := &UserFavoriteQuestionMutation{
+// newUserLikePostMutation creates new mutation for the UserLikePost entity.
+func newUserLikePostMutation(c config, op Op, opts ...userlikepostOption) *UserLikePostMutation {
+	m := &UserLikePostMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUserFavoriteQuestion,
+		typ:           TypeUserLikePost,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7829,20 +14075,20 @@ func newUserFavoriteQuestionMutation(c config, op Op, opts ...userfavoritequesti
 	return m
 }
 
-// withUserFavoriteQuestionID sets the ID field of the mutation.
-func withUserFavoriteQuestionID(id int) userfavoritequestionOption {
-	return func(m *UserFavoriteQuestionMutation) {
+// withUserLikePostID sets the ID field of the mutation.
+func withUserLikePostID(id int) userlikepostOption {
+	return func(m *UserLikePostMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *UserFavoriteQuestion
+			value *UserLikePost
 		)
-		m.oldValue = func(ctx context.Context) (*UserFavoriteQuestion, error) {
+		m.oldValue = func(ctx context.Context) (*UserLikePost, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().UserFavoriteQuestion.Get(ctx, id)
+					value, err = m.Client().UserLikePost.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7851,10 +14097,10 @@ func withUserFavoriteQuestionID(id int) userfavoritequestionOption {
 	}
 }
 
-// withUserFavoriteQuestion sets the old UserFavoriteQuestion of the mutation.
-func withUserFavoriteQuestion(node *UserFavoriteQuestion) userfavoritequestionOption {
-	return func(m *UserFavoriteQuestionMutation) {
-		m.oldValue = func(context.Context) (*UserFavoriteQuestion, error) {
+// withUserLikePost sets the old UserLikePost of the mutation.
+func withUserLikePost(node *UserLikePost) userlikepostOption {
+	return func(m *UserLikePostMutation) {
+		m.oldValue = func(context.Context) (*UserLikePost, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7863,7 +14109,7 @@ func withUserFavoriteQuestion(node *UserFavoriteQuestion) userfavoritequestionOp
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserFavoriteQuestionMutation) Client() *Client {
+func (m UserLikePostMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7871,7 +14117,7 @@ func (m UserFavoriteQuestionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserFavoriteQuestionMutation) Tx() (*Tx, error) {
+func (m UserLikePostMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7882,7 +14128,7 @@ func (m UserFavoriteQuestionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserFavoriteQuestionMutation) ID() (id int, exists bool) {
+func (m *UserLikePostMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7893,7 +14139,7 @@ func (m *UserFavoriteQuestionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserFavoriteQuestionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UserLikePostMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7902,31 +14148,30 @@ func (m *UserFavoriteQuestionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UserFavoriteQuestion.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UserLikePost.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUserID sets the "user_id" field.
-func (m *UserFavoriteQuestionMutation) SetUserID(i int) {
-	m.user_id = &i
-	m.adduser_id = nil
+func (m *UserLikePostMutation) SetUserID(i int) {
+	m.user = &i
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *UserFavoriteQuestionMutation) UserID() (r int, exists bool) {
-	v := m.user_id
+func (m *UserLikePostMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the UserFavoriteQuestion entity.
-// If the UserFavoriteQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the UserLikePost entity.
+// If the UserLikePost object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserFavoriteQuestionMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *UserLikePostMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -7940,203 +14185,110 @@ func (m *UserFavoriteQuestionMutation) OldUserID(ctx context.Context) (v int, er
 	return oldValue.UserID, nil
 }
 
-// AddUserID adds i to the "user_id" field.
-func (m *UserFavoriteQuestionMutation) AddUserID(i int) {
-	if m.adduser_id != nil {
-		*m.adduser_id += i
-	} else {
-		m.adduser_id = &i
-	}
-}
-
-// AddedUserID returns the value that was added to the "user_id" field in this mutation.
-func (m *UserFavoriteQuestionMutation) AddedUserID() (r int, exists bool) {
-	v := m.adduser_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
 // ResetUserID resets all changes to the "user_id" field.
-func (m *UserFavoriteQuestionMutation) ResetUserID() {
-	m.user_id = nil
-	m.adduser_id = nil
+func (m *UserLikePostMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetQuestionID sets the "question_id" field.
-func (m *UserFavoriteQuestionMutation) SetQuestionID(i int) {
-	m.question_id = &i
-	m.addquestion_id = nil
+// SetPostID sets the "post_id" field.
+func (m *UserLikePostMutation) SetPostID(i int) {
+	m.post = &i
 }
 
-// QuestionID returns the value of the "question_id" field in the mutation.
-func (m *UserFavoriteQuestionMutation) QuestionID() (r int, exists bool) {
-	v := m.question_id
+// PostID returns the value of the "post_id" field in the mutation.
+func (m *UserLikePostMutation) PostID() (r int, exists bool) {
+	v := m.post
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuestionID returns the old "question_id" field's value of the UserFavoriteQuestion entity.
-// If the UserFavoriteQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldPostID returns the old "post_id" field's value of the UserLikePost entity.
+// If the UserLikePost object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserFavoriteQuestionMutation) OldQuestionID(ctx context.Context) (v int, err error) {
+func (m *UserLikePostMutation) OldPostID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuestionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPostID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuestionID requires an ID field in the mutation")
+		return v, errors.New("OldPostID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuestionID: %w", err)
-	}
-	return oldValue.QuestionID, nil
-}
-
-// AddQuestionID adds i to the "question_id" field.
-func (m *UserFavoriteQuestionMutation) AddQuestionID(i int) {
-	if m.addquestion_id != nil {
-		*m.addquestion_id += i
-	} else {
-		m.addquestion_id = &i
-	}
-}
-
-// AddedQuestionID returns the value that was added to the "question_id" field in this mutation.
-func (m *UserFavoriteQuestionMutation) AddedQuestionID() (r int, exists bool) {
-	v := m.addquestion_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetQuestionID resets all changes to the "question_id" field.
-func (m *UserFavoriteQuestionMutation) ResetQuestionID() {
-	m.question_id = nil
-	m.addquestion_id = nil
-}
-
-// AddUserIDs adds the "user" edge to the User entity by ids.
-func (m *UserFavoriteQuestionMutation) AddUserIDs(ids ...int) {
-	if m.user == nil {
-		m.user = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.user[ids[i]] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldPostID: %w", err)
 	}
+	return oldValue.PostID, nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *UserFavoriteQuestionMutation) ClearUser() {
-	m.cleareduser = true
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *UserFavoriteQuestionMutation) UserCleared() bool {
-	return m.cleareduser
-}
-
-// RemoveUserIDs removes the "user" edge to the User entity by IDs.
-func (m *UserFavoriteQuestionMutation) RemoveUserIDs(ids ...int) {
-	if m.removeduser == nil {
-		m.removeduser = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.user, ids[i])
-		m.removeduser[ids[i]] = struct{}{}
-	}
+// ResetPostID resets all changes to the "post_id" field.
+func (m *UserLikePostMutation) ResetPostID() {
+	m.post = nil
 }
 
-// RemovedUser returns the removed IDs of the "user" edge to the User entity.
-func (m *UserFavoriteQuestionMutation) RemovedUserIDs() (ids []int) {
-	for id := range m.removeduser {
-		ids = append(ids, id)
-	}
-	return
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserLikePostMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[userlikepost.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserLikePostMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
 // UserIDs returns the "user" edge IDs in the mutation.
-func (m *UserFavoriteQuestionMutation) UserIDs() (ids []int) {
-	for id := range m.user {
-		ids = append(ids, id)
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserLikePostMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *UserFavoriteQuestionMutation) ResetUser() {
+func (m *UserLikePostMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
-	m.removeduser = nil
 }
 
-// AddQuestionIDs adds the "question" edge to the Question entity by ids.
-func (m *UserFavoriteQuestionMutation) AddQuestionIDs(ids ...int) {
-	if m.question == nil {
-		m.question = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.question[ids[i]] = struct{}{}
-	}
-}
-
-// ClearQuestion clears the "question" edge to the Question entity.
-func (m *UserFavoriteQuestionMutation) ClearQuestion() {
-	m.clearedquestion = true
-}
-
-// QuestionCleared reports if the "question" edge to the Question entity was cleared.
-func (m *UserFavoriteQuestionMutation) QuestionCleared() bool {
-	return m.clearedquestion
-}
-
-// RemoveQuestionIDs removes the "question" edge to the Question entity by IDs.
-func (m *UserFavoriteQuestionMutation) RemoveQuestionIDs(ids ...int) {
-	if m.removedquestion == nil {
-		m.removedquestion = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.question, ids[i])
-		m.removedquestion[ids[i]] = struct{}{}
-	}
+// ClearPost clears the "post" edge to the Post entity.
+func (m *UserLikePostMutation) ClearPost() {
+	m.clearedpost = true
+	m.clearedFields[userlikepost.FieldPostID] = struct{}{}
 }
 
-// RemovedQuestion returns the removed IDs of the "question" edge to the Question entity.
-func (m *UserFavoriteQuestionMutation) RemovedQuestionIDs() (ids []int) {
-	for id := range m.removedquestion {
-		ids = append(ids, id)
-	}
-	return
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *UserLikePostMutation) PostCleared() bool {
+	return m.clearedpost
 }
 
-// QuestionIDs returns the "question" edge IDs in the mutation.
-func (m *UserFavoriteQuestionMutation) QuestionIDs() (ids []int) {
-	for id := range m.question {
-		ids = append(ids, id)
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *UserLikePostMutation) PostIDs() (ids []int) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetQuestion resets all changes to the "question" edge.
-func (m *UserFavoriteQuestionMutation) ResetQuestion() {
-	m.question = nil
-	m.clearedquestion = false
-	m.removedquestion = nil
+// ResetPost resets all changes to the "post" edge.
+func (m *UserLikePostMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
 }
 
-// Where appends a list predicates to the UserFavoriteQuestionMutation builder.
-func (m *UserFavoriteQuestionMutation) Where(ps ...predicate.UserFavoriteQuestion) {
+// Where appends a list predicates to the UserLikePostMutation builder.
+func (m *UserLikePostMutation) Where(ps ...predicate.UserLikePost) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserFavoriteQuestionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserLikePostMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserFavoriteQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UserFavoriteQuestion, len(ps))
+func (m *UserLikePostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserLikePost, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8144,30 +14296,30 @@ func (m *UserFavoriteQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserFavoriteQuestionMutation) Op() Op {
+func (m *UserLikePostMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserFavoriteQuestionMutation) SetOp(op Op) {
+func (m *UserLikePostMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (UserFavoriteQuestion).
-func (m *UserFavoriteQuestionMutation) Type() string {
+// Type returns the node type of this mutation (UserLikePost).
+func (m *UserLikePostMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserFavoriteQuestionMutation) Fields() []string {
+func (m *UserLikePostMutation) Fields() []string {
 	fields := make([]string, 0, 2)
-	if m.user_id != nil {
-		fields = append(fields, userfavoritequestion.FieldUserID)
+	if m.user != nil {
+		fields = append(fields, userlikepost.FieldUserID)
 	}
-	if m.question_id != nil {
-		fields = append(fields, userfavoritequestion.FieldQuestionID)
+	if m.post != nil {
+		fields = append(fields, userlikepost.FieldPostID)
 	}
 	return fields
 }
@@ -8175,12 +14327,12 @@ func (m *UserFavoriteQuestionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserFavoriteQuestionMutation) Field(name string) (ent.Value, bool) {
+func (m *UserLikePostMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case userfavoritequestion.FieldUserID:
+	case userlikepost.FieldUserID:
 		return m.UserID()
-	case userfavoritequestion.FieldQuestionID:
-		return m.QuestionID()
+	case userlikepost.FieldPostID:
+		return m.PostID()
 	}
 	return nil, false
 }
@@ -8188,61 +14340,51 @@ func (m *UserFavoriteQuestionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserFavoriteQuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserLikePostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case userfavoritequestion.FieldUserID:
+	case userlikepost.FieldUserID:
 		return m.OldUserID(ctx)
-	case userfavoritequestion.FieldQuestionID:
-		return m.OldQuestionID(ctx)
+	case userlikepost.FieldPostID:
+		return m.OldPostID(ctx)
 	}
-	return nil, fmt.Errorf("unknown UserFavoriteQuestion field %s", name)
+	return nil, fmt.Errorf("unknown UserLikePost field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserFavoriteQuestionMutation) SetField(name string, value ent.Value) error {
+func (m *UserLikePostMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case userfavoritequestion.FieldUserID:
+	case userlikepost.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case userfavoritequestion.FieldQuestionID:
+	case userlikepost.FieldPostID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuestionID(v)
+		m.SetPostID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown UserFavoriteQuestion field %s", name)
+	return fmt.Errorf("unknown UserLikePost field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserFavoriteQuestionMutation) AddedFields() []string {
+func (m *UserLikePostMutation) AddedFields() []string {
 	var fields []string
-	if m.adduser_id != nil {
-		fields = append(fields, userfavoritequestion.FieldUserID)
-	}
-	if m.addquestion_id != nil {
-		fields = append(fields, userfavoritequestion.FieldQuestionID)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserFavoriteQuestionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserLikePostMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case userfavoritequestion.FieldUserID:
-		return m.AddedUserID()
-	case userfavoritequestion.FieldQuestionID:
-		return m.AddedQuestionID()
 	}
 	return nil, false
 }
@@ -8250,202 +14392,164 @@ func (m *UserFavoriteQuestionMutation) AddedField(name string) (ent.Value, bool)
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserFavoriteQuestionMutation) AddField(name string, value ent.Value) error {
+func (m *UserLikePostMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case userfavoritequestion.FieldUserID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUserID(v)
-		return nil
-	case userfavoritequestion.FieldQuestionID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddQuestionID(v)
-		return nil
 	}
-	return fmt.Errorf("unknown UserFavoriteQuestion numeric field %s", name)
+	return fmt.Errorf("unknown UserLikePost numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserFavoriteQuestionMutation) ClearedFields() []string {
+func (m *UserLikePostMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserFavoriteQuestionMutation) FieldCleared(name string) bool {
+func (m *UserLikePostMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserFavoriteQuestionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown UserFavoriteQuestion nullable field %s", name)
+func (m *UserLikePostMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserLikePost nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserFavoriteQuestionMutation) ResetField(name string) error {
+func (m *UserLikePostMutation) ResetField(name string) error {
 	switch name {
-	case userfavoritequestion.FieldUserID:
+	case userlikepost.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case userfavoritequestion.FieldQuestionID:
-		m.ResetQuestionID()
+	case userlikepost.FieldPostID:
+		m.ResetPostID()
 		return nil
 	}
-	return fmt.Errorf("unknown UserFavoriteQuestion field %s", name)
+	return fmt.Errorf("unknown UserLikePost field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserFavoriteQuestionMutation) AddedEdges() []string {
+func (m *UserLikePostMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.user != nil {
-		edges = append(edges, userfavoritequestion.EdgeUser)
+		edges = append(edges, userlikepost.EdgeUser)
 	}
-	if m.question != nil {
-		edges = append(edges, userfavoritequestion.EdgeQuestion)
+	if m.post != nil {
+		edges = append(edges, userlikepost.EdgePost)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserFavoriteQuestionMutation) AddedIDs(name string) []ent.Value {
+func (m *UserLikePostMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case userfavoritequestion.EdgeUser:
-		ids := make([]ent.Value, 0, len(m.user))
-		for id := range m.user {
-			ids = append(ids, id)
+	case userlikepost.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case userfavoritequestion.EdgeQuestion:
-		ids := make([]ent.Value, 0, len(m.question))
-		for id := range m.question {
-			ids = append(ids, id)
+	case userlikepost.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserFavoriteQuestionMutation) RemovedEdges() []string {
+func (m *UserLikePostMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removeduser != nil {
-		edges = append(edges, userfavoritequestion.EdgeUser)
-	}
-	if m.removedquestion != nil {
-		edges = append(edges, userfavoritequestion.EdgeQuestion)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserFavoriteQuestionMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case userfavoritequestion.EdgeUser:
-		ids := make([]ent.Value, 0, len(m.removeduser))
-		for id := range m.removeduser {
-			ids = append(ids, id)
-		}
-		return ids
-	case userfavoritequestion.EdgeQuestion:
-		ids := make([]ent.Value, 0, len(m.removedquestion))
-		for id := range m.removedquestion {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserLikePostMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserFavoriteQuestionMutation) ClearedEdges() []string {
+func (m *UserLikePostMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.cleareduser {
-		edges = append(edges, userfavoritequestion.EdgeUser)
+		edges = append(edges, userlikepost.EdgeUser)
 	}
-	if m.clearedquestion {
-		edges = append(edges, userfavoritequestion.EdgeQuestion)
+	if m.clearedpost {
+		edges = append(edges, userlikepost.EdgePost)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserFavoriteQuestionMutation) EdgeCleared(name string) bool {
+func (m *UserLikePostMutation) EdgeCleared(name string) bool {
 	switch name {
-	case userfavoritequestion.EdgeUser:
+	case userlikepost.EdgeUser:
 		return m.cleareduser
-	case userfavoritequestion.EdgeQuestion:
-		return m.clearedquestion
+	case userlikepost.EdgePost:
+		return m.clearedpost
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserFavoriteQuestionMutation) ClearEdge(name string) error {
+func (m *UserLikePostMutation) ClearEdge(name string) error {
 	switch name {
+	case userlikepost.EdgeUser:
+		m.ClearUser()
+		return nil
+	case userlikepost.EdgePost:
+		m.ClearPost()
+		return nil
 	}
-	return fmt.Errorf("unknown UserFavoriteQuestion unique edge %s", name)
+	return fmt.Errorf("unknown UserLikePost unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserFavoriteQuestionMutation) ResetEdge(name string) error {
+func (m *UserLikePostMutation) ResetEdge(name string) error {
 	switch name {
-	case userfavoritequestion.EdgeUser:
+	case userlikepost.EdgeUser:
 		m.ResetUser()
 		return nil
-	case userfavoritequestion.EdgeQuestion:
-		m.ResetQuestion()
+	case userlikepost.EdgePost:
+		m.ResetPost()
 		return nil
 	}
-	return fmt.Errorf("unknown UserFavoriteQuestion edge %s", name)
+	return fmt.Errorf("unknown UserLikePost edge %s", name)
 }
 
-// UserLikePostMutation represents an operation that mutates the UserLikePost nodes in the graph.
-type UserLikePostMutation struct {
+// UserLikeQuestionMutation represents an operation that mutates the UserLikeQuestion nodes in the graph.
+type UserLikeQuestionMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	user_id       *int
-	adduser_id    *int
-	post_id       *int
-	addpost_id    *int
-	clearedFields map[string]struct{}
-	user          map[int]struct{}
-	removeduser   map[int]struct{}
-	cleareduser   bool
-	post          map[int]struct{}
-	removedpost   map[int]struct{}
-	clearedpost   bool
-	done          bool
-	oldValue      func(context.Context) (*UserLikePost, error)
-	predicates    []predicate.UserLikePost
+	op              Op
+	typ             string
+	id              *int
+	clearedFields   map[string]struct{}
+	user            *int
+	cleareduser     bool
+	question        *int
+	clearedquestion bool
+	done            bool
+	oldValue        func(context.Context) (*UserLikeQuestion, error)
+	predicates      []predicate.UserLikeQuestion
 }
 
-var _ ent.Mutation = (*UserLikePostMutation)(nil)
-
-// userlikepostOption allows management of the mutation configuration using functional options.
-type userlikepostOption func(*UserLikePostMutation)
+var _ ent.Mutation = (*UserLikeQuestionMutation)(nil)
 
-// newUserLikePostMutation creates new mutation for the UserLikePost entity.
-func newUserLikePostMutation(c config, op Op, opts ...userlikepostOption) *UserLikePostMutation {
-	m := &UserLikePostMutation{
+// userlikequestionOption allows management of the mutation configuration using functional options.
+type userlikequestionOption func(*UserLikeQuestionMutation)
+
+// newUserLikeQuestionMutation creates new mutation for the UserLikeQuestion entity.
+func newUserLikeQuestionMutation(c config, op Op, opts ...userlikequestionOption) *UserLikeQuestionMutation {
+	m := &UserLikeQuestionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUserLikePost,
+		typ:           TypeUserLikeQuestion,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8454,20 +14558,20 @@ func newUserLikePostMutation(c config, op Op, opts ...userlikepostOption) *UserL
 	return m
 }
 
-// withUserLikePostID sets the ID field of the mutation.
-func withUserLikePostID(id int) userlikepostOption {
-	return func(m *UserLikePostMutation) {
+// withUserLikeQuestionID sets the ID field of the mutation.
+func withUserLikeQuestionID(id int) userlikequestionOption {
+	return func(m *UserLikeQuestionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *UserLikePost
+			value *UserLikeQuestion
 		)
-		m.oldValue = func(ctx context.Context) (*UserLikePost, error) {
+		m.oldValue = func(ctx context.Context) (*UserLikeQuestion, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().UserLikePost.Get(ctx, id)
+					value, err = m.Client().UserLikeQuestion.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8476,10 +14580,10 @@ func withUserLikePostID(id int) userlikepostOption {
 	}
 }
 
-// withUserLikePost sets the old UserLikePost of the mutation.
-func withUserLikePost(node *UserLikePost) userlikepostOption {
-	return func(m *UserLikePostMutation) {
-		m.oldValue = func(context.Context) (*UserLikePost, error) {
+// withUserLikeQuestion sets the old UserLikeQuestion of the mutation.
+func withUserLikeQuestion(node *UserLikeQuestion) userlikequestionOption {
+	return func(m *UserLikeQuestionMutation) {
+		m.oldValue = func(context.Context) (*UserLikeQuestion, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8488,7 +14592,7 @@ func withUserLikePost(node *UserLikePost) userlikepostOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserLikePostMutation) Client() *Client {
+func (m UserLikeQuestionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8496,7 +14600,7 @@ func (m UserLikePostMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserLikePostMutation) Tx() (*Tx, error) {
+func (m UserLikeQuestionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8507,7 +14611,7 @@ func (m UserLikePostMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserLikePostMutation) ID() (id int, exists bool) {
+func (m *UserLikeQuestionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8518,7 +14622,7 @@ func (m *UserLikePostMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserLikePostMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UserLikeQuestionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8527,31 +14631,30 @@ func (m *UserLikePostMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UserLikePost.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UserLikeQuestion.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUserID sets the "user_id" field.
-func (m *UserLikePostMutation) SetUserID(i int) {
-	m.user_id = &i
-	m.adduser_id = nil
+func (m *UserLikeQuestionMutation) SetUserID(i int) {
+	m.user = &i
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *UserLikePostMutation) UserID() (r int, exists bool) {
-	v := m.user_id
+func (m *UserLikeQuestionMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the UserLikePost entity.
-// If the UserLikePost object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the UserLikeQuestion entity.
+// If the UserLikeQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserLikePostMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *UserLikeQuestionMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -8565,203 +14668,110 @@ func (m *UserLikePostMutation) OldUserID(ctx context.Context) (v int, err error)
 	return oldValue.UserID, nil
 }
 
-// AddUserID adds i to the "user_id" field.
-func (m *UserLikePostMutation) AddUserID(i int) {
-	if m.adduser_id != nil {
-		*m.adduser_id += i
-	} else {
-		m.adduser_id = &i
-	}
-}
-
-// AddedUserID returns the value that was added to the "user_id" field in this mutation.
-func (m *UserLikePostMutation) AddedUserID() (r int, exists bool) {
-	v := m.adduser_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
 // ResetUserID resets all changes to the "user_id" field.
-func (m *UserLikePostMutation) ResetUserID() {
-	m.user_id = nil
-	m.adduser_id = nil
+func (m *UserLikeQuestionMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetPostID sets the "post_id" field.
-func (m *UserLikePostMutation) SetPostID(i int) {
-	m.post_id = &i
-	m.addpost_id = nil
+// SetQuestionID sets the "question_id" field.
+func (m *UserLikeQuestionMutation) SetQuestionID(i int) {
+	m.question = &i
 }
 
-// PostID returns the value of the "post_id" field in the mutation.
-func (m *UserLikePostMutation) PostID() (r int, exists bool) {
-	v := m.post_id
+// QuestionID returns the value of the "question_id" field in the mutation.
+func (m *UserLikeQuestionMutation) QuestionID() (r int, exists bool) {
+	v := m.question
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPostID returns the old "post_id" field's value of the UserLikePost entity.
-// If the UserLikePost object wasn't provided to the builder, the object is fetched from the database.
+// OldQuestionID returns the old "question_id" field's value of the UserLikeQuestion entity.
+// If the UserLikeQuestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserLikePostMutation) OldPostID(ctx context.Context) (v int, err error) {
+func (m *UserLikeQuestionMutation) OldQuestionID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPostID is only allowed on UpdateOne operations")
+		return v, errors.New("OldQuestionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPostID requires an ID field in the mutation")
+		return v, errors.New("OldQuestionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPostID: %w", err)
-	}
-	return oldValue.PostID, nil
-}
-
-// AddPostID adds i to the "post_id" field.
-func (m *UserLikePostMutation) AddPostID(i int) {
-	if m.addpost_id != nil {
-		*m.addpost_id += i
-	} else {
-		m.addpost_id = &i
-	}
-}
-
-// AddedPostID returns the value that was added to the "post_id" field in this mutation.
-func (m *UserLikePostMutation) AddedPostID() (r int, exists bool) {
-	v := m.addpost_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldQuestionID: %w", err)
 	}
-	return *v, true
-}
-
-// ResetPostID resets all changes to the "post_id" field.
-func (m *UserLikePostMutation) ResetPostID() {
-	m.post_id = nil
-	m.addpost_id = nil
+	return oldValue.QuestionID, nil
 }
 
-// AddUserIDs adds the "user" edge to the User entity by ids.
-func (m *UserLikePostMutation) AddUserIDs(ids ...int) {
-	if m.user == nil {
-		m.user = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.user[ids[i]] = struct{}{}
-	}
+// ResetQuestionID resets all changes to the "question_id" field.
+func (m *UserLikeQuestionMutation) ResetQuestionID() {
+	m.question = nil
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *UserLikePostMutation) ClearUser() {
+func (m *UserLikeQuestionMutation) ClearUser() {
 	m.cleareduser = true
+	m.clearedFields[userlikequestion.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *UserLikePostMutation) UserCleared() bool {
+func (m *UserLikeQuestionMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
-// RemoveUserIDs removes the "user" edge to the User entity by IDs.
-func (m *UserLikePostMutation) RemoveUserIDs(ids ...int) {
-	if m.removeduser == nil {
-		m.removeduser = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.user, ids[i])
-		m.removeduser[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedUser returns the removed IDs of the "user" edge to the User entity.
-func (m *UserLikePostMutation) RemovedUserIDs() (ids []int) {
-	for id := range m.removeduser {
-		ids = append(ids, id)
-	}
-	return
-}
-
 // UserIDs returns the "user" edge IDs in the mutation.
-func (m *UserLikePostMutation) UserIDs() (ids []int) {
-	for id := range m.user {
-		ids = append(ids, id)
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserLikeQuestionMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *UserLikePostMutation) ResetUser() {
+func (m *UserLikeQuestionMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
-	m.removeduser = nil
-}
-
-// AddPostIDs adds the "post" edge to the Post entity by ids.
-func (m *UserLikePostMutation) AddPostIDs(ids ...int) {
-	if m.post == nil {
-		m.post = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.post[ids[i]] = struct{}{}
-	}
-}
-
-// ClearPost clears the "post" edge to the Post entity.
-func (m *UserLikePostMutation) ClearPost() {
-	m.clearedpost = true
-}
-
-// PostCleared reports if the "post" edge to the Post entity was cleared.
-func (m *UserLikePostMutation) PostCleared() bool {
-	return m.clearedpost
 }
 
-// RemovePostIDs removes the "post" edge to the Post entity by IDs.
-func (m *UserLikePostMutation) RemovePostIDs(ids ...int) {
-	if m.removedpost == nil {
-		m.removedpost = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.post, ids[i])
-		m.removedpost[ids[i]] = struct{}{}
-	}
+// ClearQuestion clears the "question" edge to the Question entity.
+func (m *UserLikeQuestionMutation) ClearQuestion() {
+	m.clearedquestion = true
+	m.clearedFields[userlikequestion.FieldQuestionID] = struct{}{}
 }
 
-// RemovedPost returns the removed IDs of the "post" edge to the Post entity.
-func (m *UserLikePostMutation) RemovedPostIDs() (ids []int) {
-	for id := range m.removedpost {
-		ids = append(ids, id)
-	}
-	return
+// QuestionCleared reports if the "question" edge to the Question entity was cleared.
+func (m *UserLikeQuestionMutation) QuestionCleared() bool {
+	return m.clearedquestion
 }
 
-// PostIDs returns the "post" edge IDs in the mutation.
-func (m *UserLikePostMutation) PostIDs() (ids []int) {
-	for id := range m.post {
-		ids = append(ids, id)
+// QuestionIDs returns the "question" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuestionID instead. It exists only for internal usage by the builders.
+func (m *UserLikeQuestionMutation) QuestionIDs() (ids []int) {
+	if id := m.question; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPost resets all changes to the "post" edge.
-func (m *UserLikePostMutation) ResetPost() {
-	m.post = nil
-	m.clearedpost = false
-	m.removedpost = nil
+// ResetQuestion resets all changes to the "question" edge.
+func (m *UserLikeQuestionMutation) ResetQuestion() {
+	m.question = nil
+	m.clearedquestion = false
 }
 
-// Where appends a list predicates to the UserLikePostMutation builder.
-func (m *UserLikePostMutation) Where(ps ...predicate.UserLikePost) {
+// Where appends a list predicates to the UserLikeQuestionMutation builder.
+func (m *UserLikeQuestionMutation) Where(ps ...predicate.UserLikeQuestion) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserLikePostMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserLikeQuestionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserLikePostMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UserLikePost, len(ps))
+func (m *UserLikeQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserLikeQuestion, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8769,30 +14779,30 @@ func (m *UserLikePostMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserLikePostMutation) Op() Op {
+func (m *UserLikeQuestionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserLikePostMutation) SetOp(op Op) {
+func (m *UserLikeQuestionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (UserLikePost).
-func (m *UserLikePostMutation) Type() string {
+// Type returns the node type of this mutation (UserLikeQuestion).
+func (m *UserLikeQuestionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserLikePostMutation) Fields() []string {
+func (m *UserLikeQuestionMutation) Fields() []string {
 	fields := make([]string, 0, 2)
-	if m.user_id != nil {
-		fields = append(fields, userlikepost.FieldUserID)
+	if m.user != nil {
+		fields = append(fields, userlikequestion.FieldUserID)
 	}
-	if m.post_id != nil {
-		fields = append(fields, userlikepost.FieldPostID)
+	if m.question != nil {
+		fields = append(fields, userlikequestion.FieldQuestionID)
 	}
 	return fields
 }
@@ -8800,12 +14810,12 @@ func (m *UserLikePostMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserLikePostMutation) Field(name string) (ent.Value, bool) {
+func (m *UserLikeQuestionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case userlikepost.FieldUserID:
+	case userlikequestion.FieldUserID:
 		return m.UserID()
-	case userlikepost.FieldPostID:
-		return m.PostID()
+	case userlikequestion.FieldQuestionID:
+		return m.QuestionID()
 	}
 	return nil, false
 }
@@ -8813,61 +14823,51 @@ func (m *UserLikePostMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserLikePostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserLikeQuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case userlikepost.FieldUserID:
+	case userlikequestion.FieldUserID:
 		return m.OldUserID(ctx)
-	case userlikepost.FieldPostID:
-		return m.OldPostID(ctx)
+	case userlikequestion.FieldQuestionID:
+		return m.OldQuestionID(ctx)
 	}
-	return nil, fmt.Errorf("unknown UserLikePost field %s", name)
+	return nil, fmt.Errorf("unknown UserLikeQuestion field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserLikePostMutation) SetField(name string, value ent.Value) error {
+func (m *UserLikeQuestionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case userlikepost.FieldUserID:
+	case userlikequestion.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case userlikepost.FieldPostID:
+	case userlikequestion.FieldQuestionID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPostID(v)
+		m.SetQuestionID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown UserLikePost field %s", name)
+	return fmt.Errorf("unknown UserLikeQuestion field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserLikePostMutation) AddedFields() []string {
+func (m *UserLikeQuestionMutation) AddedFields() []string {
 	var fields []string
-	if m.adduser_id != nil {
-		fields = append(fields, userlikepost.FieldUserID)
-	}
-	if m.addpost_id != nil {
-		fields = append(fields, userlikepost.FieldPostID)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserLikePostMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserLikeQuestionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case userlikepost.FieldUserID:
-		return m.AddedUserID()
-	case userlikepost.FieldPostID:
-		return m.AddedPostID()
 	}
 	return nil, false
 }
@@ -8875,202 +14875,170 @@ func (m *UserLikePostMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserLikePostMutation) AddField(name string, value ent.Value) error {
+func (m *UserLikeQuestionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case userlikepost.FieldUserID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUserID(v)
-		return nil
-	case userlikepost.FieldPostID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPostID(v)
-		return nil
 	}
-	return fmt.Errorf("unknown UserLikePost numeric field %s", name)
+	return fmt.Errorf("unknown UserLikeQuestion numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserLikePostMutation) ClearedFields() []string {
+func (m *UserLikeQuestionMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserLikePostMutation) FieldCleared(name string) bool {
+func (m *UserLikeQuestionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserLikePostMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown UserLikePost nullable field %s", name)
+func (m *UserLikeQuestionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserLikeQuestion nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserLikePostMutation) ResetField(name string) error {
+func (m *UserLikeQuestionMutation) ResetField(name string) error {
 	switch name {
-	case userlikepost.FieldUserID:
+	case userlikequestion.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case userlikepost.FieldPostID:
-		m.ResetPostID()
+	case userlikequestion.FieldQuestionID:
+		m.ResetQuestionID()
 		return nil
 	}
-	return fmt.Errorf("unknown UserLikePost field %s", name)
+	return fmt.Errorf("unknown UserLikeQuestion field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserLikePostMutation) AddedEdges() []string {
+func (m *UserLikeQuestionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.user != nil {
-		edges = append(edges, userlikepost.EdgeUser)
+		edges = append(edges, userlikequestion.EdgeUser)
 	}
-	if m.post != nil {
-		edges = append(edges, userlikepost.EdgePost)
+	if m.question != nil {
+		edges = append(edges, userlikequestion.EdgeQuestion)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserLikePostMutation) AddedIDs(name string) []ent.Value {
+func (m *UserLikeQuestionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case userlikepost.EdgeUser:
-		ids := make([]ent.Value, 0, len(m.user))
-		for id := range m.user {
-			ids = append(ids, id)
+	case userlikequestion.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case userlikepost.EdgePost:
-		ids := make([]ent.Value, 0, len(m.post))
-		for id := range m.post {
-			ids = append(ids, id)
+	case userlikequestion.EdgeQuestion:
+		if id := m.question; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserLikePostMutation) RemovedEdges() []string {
+func (m *UserLikeQuestionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removeduser != nil {
-		edges = append(edges, userlikepost.EdgeUser)
-	}
-	if m.removedpost != nil {
-		edges = append(edges, userlikepost.EdgePost)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserLikePostMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case userlikepost.EdgeUser:
-		ids := make([]ent.Value, 0, len(m.removeduser))
-		for id := range m.removeduser {
-			ids = append(ids, id)
-		}
-		return ids
-	case userlikepost.EdgePost:
-		ids := make([]ent.Value, 0, len(m.removedpost))
-		for id := range m.removedpost {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserLikeQuestionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserLikePostMutation) ClearedEdges() []string {
+func (m *UserLikeQuestionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.cleareduser {
-		edges = append(edges, userlikepost.EdgeUser)
+		edges = append(edges, userlikequestion.EdgeUser)
 	}
-	if m.clearedpost {
-		edges = append(edges, userlikepost.EdgePost)
+	if m.clearedquestion {
+		edges = append(edges, userlikequestion.EdgeQuestion)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserLikePostMutation) EdgeCleared(name string) bool {
+func (m *UserLikeQuestionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case userlikepost.EdgeUser:
+	case userlikequestion.EdgeUser:
 		return m.cleareduser
-	case userlikepost.EdgePost:
-		return m.clearedpost
+	case userlikequestion.EdgeQuestion:
+		return m.clearedquestion
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserLikePostMutation) ClearEdge(name string) error {
+func (m *UserLikeQuestionMutation) ClearEdge(name string) error {
 	switch name {
+	case userlikequestion.EdgeUser:
+		m.ClearUser()
+		return nil
+	case userlikequestion.EdgeQuestion:
+		m.ClearQuestion()
+		return nil
 	}
-	return fmt.Errorf("unknown UserLikePost unique edge %s", name)
+	return fmt.Errorf("unknown UserLikeQuestion unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserLikePostMutation) ResetEdge(name string) error {
+func (m *UserLikeQuestionMutation) ResetEdge(name string) error {
 	switch name {
-	case userlikepost.EdgeUser:
+	case userlikequestion.EdgeUser:
 		m.ResetUser()
 		return nil
-	case userlikepost.EdgePost:
-		m.ResetPost()
+	case userlikequestion.EdgeQuestion:
+		m.ResetQuestion()
 		return nil
 	}
-	return fmt.Errorf("unknown UserLikePost edge %s", name)
+	return fmt.Errorf("unknown UserLikeQuestion edge %s", name)
 }
 
-// UserLikeQuestionMutation represents an operation that mutates the UserLikeQuestion nodes in the graph.
-type UserLikeQuestionMutation struct {
+// VerificationCodeMutation represents an operation that mutates the VerificationCode nodes in the graph.
+type VerificationCodeMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	user_id         *int
-	adduser_id      *int
-	question_id     *int
-	addquestion_id  *int
-	clearedFields   map[string]struct{}
-	user            map[int]struct{}
-	removeduser     map[int]struct{}
-	cleareduser     bool
-	question        map[int]struct{}
-	removedquestion map[int]struct{}
-	clearedquestion bool
-	done            bool
-	oldValue        func(context.Context) (*UserLikeQuestion, error)
-	predicates      []predicate.UserLikeQuestion
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	phone         *string
+	ip            *string
+	purpose       *verificationcode.Purpose
+	code_hash     *string
+	expires_at    *time.Time
+	attempts      *int
+	addattempts   *int
+	consumed_at   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*VerificationCode, error)
+	predicates    []predicate.VerificationCode
 }
 
-var _ ent.Mutation = (*UserLikeQuestionMutation)(nil)
+var _ ent.Mutation = (*VerificationCodeMutation)(nil)
 
-// userlikequestionOption allows management of the mutation configuration using functional options.
-type userlikequestionOption func(*UserLikeQuestionMutation)
+// verificationcodeOption allows management of the mutation configuration using functional options.
+type verificationcodeOption func(*VerificationCodeMutation)
 
-// newUserLikeQuestionMutation creates new mutation for the UserLikeQuestion entity.
-func newUserLikeQuestionMutation(c config, op Op, opts ...userlikequestionOption) *UserLikeQuestionMutation {
-	m := &UserLikeQuestionMutation{
+// newVerificationCodeMutation creates new mutation for the VerificationCode entity.
+func newVerificationCodeMutation(c config, op Op, opts ...verificationcodeOption) *VerificationCodeMutation {
+	m := &VerificationCodeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUserLikeQuestion,
+		typ:           TypeVerificationCode,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9079,20 +15047,20 @@ func newUserLikeQuestionMutation(c config, op Op, opts ...userlikequestionOption
 	return m
 }
 
-// withUserLikeQuestionID sets the ID field of the mutation.
-func withUserLikeQuestionID(id int) userlikequestionOption {
-	return func(m *UserLikeQuestionMutation) {
+// withVerificationCodeID sets the ID field of the mutation.
+func withVerificationCodeID(id int) verificationcodeOption {
+	return func(m *VerificationCodeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *UserLikeQuestion
+			value *VerificationCode
 		)
-		m.oldValue = func(ctx context.Context) (*UserLikeQuestion, error) {
+		m.oldValue = func(ctx context.Context) (*VerificationCode, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().UserLikeQuestion.Get(ctx, id)
+					value, err = m.Client().VerificationCode.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -9101,10 +15069,10 @@ func withUserLikeQuestionID(id int) userlikequestionOption {
 	}
 }
 
-// withUserLikeQuestion sets the old UserLikeQuestion of the mutation.
-func withUserLikeQuestion(node *UserLikeQuestion) userlikequestionOption {
-	return func(m *UserLikeQuestionMutation) {
-		m.oldValue = func(context.Context) (*UserLikeQuestion, error) {
+// withVerificationCode sets the old VerificationCode of the mutation.
+func withVerificationCode(node *VerificationCode) verificationcodeOption {
+	return func(m *VerificationCodeMutation) {
+		m.oldValue = func(context.Context) (*VerificationCode, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9113,7 +15081,7 @@ func withUserLikeQuestion(node *UserLikeQuestion) userlikequestionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserLikeQuestionMutation) Client() *Client {
+func (m VerificationCodeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9121,7 +15089,7 @@ func (m UserLikeQuestionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserLikeQuestionMutation) Tx() (*Tx, error) {
+func (m VerificationCodeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -9132,7 +15100,7 @@ func (m UserLikeQuestionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserLikeQuestionMutation) ID() (id int, exists bool) {
+func (m *VerificationCodeMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -9143,7 +15111,7 @@ func (m *UserLikeQuestionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserLikeQuestionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *VerificationCodeMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -9152,241 +15120,391 @@ func (m *UserLikeQuestionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UserLikeQuestion.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().VerificationCode.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *UserLikeQuestionMutation) SetUserID(i int) {
-	m.user_id = &i
-	m.adduser_id = nil
+// SetCreateTime sets the "create_time" field.
+func (m *VerificationCodeMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *UserLikeQuestionMutation) UserID() (r int, exists bool) {
-	v := m.user_id
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *VerificationCodeMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the UserLikeQuestion entity.
-// If the UserLikeQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the VerificationCode entity.
+// If the VerificationCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserLikeQuestionMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *VerificationCodeMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.CreateTime, nil
 }
 
-// AddUserID adds i to the "user_id" field.
-func (m *UserLikeQuestionMutation) AddUserID(i int) {
-	if m.adduser_id != nil {
-		*m.adduser_id += i
-	} else {
-		m.adduser_id = &i
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *VerificationCodeMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *VerificationCodeMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *VerificationCodeMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the VerificationCode entity.
+// If the VerificationCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationCodeMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *VerificationCodeMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetPhone sets the "phone" field.
+func (m *VerificationCodeMutation) SetPhone(s string) {
+	m.phone = &s
 }
 
-// AddedUserID returns the value that was added to the "user_id" field in this mutation.
-func (m *UserLikeQuestionMutation) AddedUserID() (r int, exists bool) {
-	v := m.adduser_id
+// Phone returns the value of the "phone" field in the mutation.
+func (m *VerificationCodeMutation) Phone() (r string, exists bool) {
+	v := m.phone
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *UserLikeQuestionMutation) ResetUserID() {
-	m.user_id = nil
-	m.adduser_id = nil
+// OldPhone returns the old "phone" field's value of the VerificationCode entity.
+// If the VerificationCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationCodeMutation) OldPhone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
+	}
+	return oldValue.Phone, nil
 }
 
-// SetQuestionID sets the "question_id" field.
-func (m *UserLikeQuestionMutation) SetQuestionID(i int) {
-	m.question_id = &i
-	m.addquestion_id = nil
+// ResetPhone resets all changes to the "phone" field.
+func (m *VerificationCodeMutation) ResetPhone() {
+	m.phone = nil
 }
 
-// QuestionID returns the value of the "question_id" field in the mutation.
-func (m *UserLikeQuestionMutation) QuestionID() (r int, exists bool) {
-	v := m.question_id
+// SetIP sets the "ip" field.
+func (m *VerificationCodeMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the value of the "ip" field in the mutation.
+func (m *VerificationCodeMutation) IP() (r string, exists bool) {
+	v := m.ip
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuestionID returns the old "question_id" field's value of the UserLikeQuestion entity.
-// If the UserLikeQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldIP returns the old "ip" field's value of the VerificationCode entity.
+// If the VerificationCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserLikeQuestionMutation) OldQuestionID(ctx context.Context) (v int, err error) {
+func (m *VerificationCodeMutation) OldIP(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuestionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuestionID requires an ID field in the mutation")
+		return v, errors.New("OldIP requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuestionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
 	}
-	return oldValue.QuestionID, nil
+	return oldValue.IP, nil
 }
 
-// AddQuestionID adds i to the "question_id" field.
-func (m *UserLikeQuestionMutation) AddQuestionID(i int) {
-	if m.addquestion_id != nil {
-		*m.addquestion_id += i
-	} else {
-		m.addquestion_id = &i
+// ClearIP clears the value of the "ip" field.
+func (m *VerificationCodeMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[verificationcode.FieldIP] = struct{}{}
+}
+
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *VerificationCodeMutation) IPCleared() bool {
+	_, ok := m.clearedFields[verificationcode.FieldIP]
+	return ok
+}
+
+// ResetIP resets all changes to the "ip" field.
+func (m *VerificationCodeMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, verificationcode.FieldIP)
+}
+
+// SetPurpose sets the "purpose" field.
+func (m *VerificationCodeMutation) SetPurpose(v verificationcode.Purpose) {
+	m.purpose = &v
+}
+
+// Purpose returns the value of the "purpose" field in the mutation.
+func (m *VerificationCodeMutation) Purpose() (r verificationcode.Purpose, exists bool) {
+	v := m.purpose
+	if v == nil {
+		return
 	}
+	return *v, true
+}
+
+// OldPurpose returns the old "purpose" field's value of the VerificationCode entity.
+// If the VerificationCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationCodeMutation) OldPurpose(ctx context.Context) (v verificationcode.Purpose, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurpose is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurpose requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurpose: %w", err)
+	}
+	return oldValue.Purpose, nil
+}
+
+// ResetPurpose resets all changes to the "purpose" field.
+func (m *VerificationCodeMutation) ResetPurpose() {
+	m.purpose = nil
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (m *VerificationCodeMutation) SetCodeHash(s string) {
+	m.code_hash = &s
 }
 
-// AddedQuestionID returns the value that was added to the "question_id" field in this mutation.
-func (m *UserLikeQuestionMutation) AddedQuestionID() (r int, exists bool) {
-	v := m.addquestion_id
+// CodeHash returns the value of the "code_hash" field in the mutation.
+func (m *VerificationCodeMutation) CodeHash() (r string, exists bool) {
+	v := m.code_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetQuestionID resets all changes to the "question_id" field.
-func (m *UserLikeQuestionMutation) ResetQuestionID() {
-	m.question_id = nil
-	m.addquestion_id = nil
+// OldCodeHash returns the old "code_hash" field's value of the VerificationCode entity.
+// If the VerificationCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationCodeMutation) OldCodeHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeHash: %w", err)
+	}
+	return oldValue.CodeHash, nil
+}
+
+// ResetCodeHash resets all changes to the "code_hash" field.
+func (m *VerificationCodeMutation) ResetCodeHash() {
+	m.code_hash = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *VerificationCodeMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// AddUserIDs adds the "user" edge to the User entity by ids.
-func (m *UserLikeQuestionMutation) AddUserIDs(ids ...int) {
-	if m.user == nil {
-		m.user = make(map[int]struct{})
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *VerificationCodeMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.user[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the VerificationCode entity.
+// If the VerificationCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationCodeMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
+	return oldValue.ExpiresAt, nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *UserLikeQuestionMutation) ClearUser() {
-	m.cleareduser = true
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *VerificationCodeMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *UserLikeQuestionMutation) UserCleared() bool {
-	return m.cleareduser
+// SetAttempts sets the "attempts" field.
+func (m *VerificationCodeMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// RemoveUserIDs removes the "user" edge to the User entity by IDs.
-func (m *UserLikeQuestionMutation) RemoveUserIDs(ids ...int) {
-	if m.removeduser == nil {
-		m.removeduser = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.user, ids[i])
-		m.removeduser[ids[i]] = struct{}{}
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *VerificationCodeMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedUser returns the removed IDs of the "user" edge to the User entity.
-func (m *UserLikeQuestionMutation) RemovedUserIDs() (ids []int) {
-	for id := range m.removeduser {
-		ids = append(ids, id)
+// OldAttempts returns the old "attempts" field's value of the VerificationCode entity.
+// If the VerificationCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationCodeMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-func (m *UserLikeQuestionMutation) UserIDs() (ids []int) {
-	for id := range m.user {
-		ids = append(ids, id)
+// AddAttempts adds i to the "attempts" field.
+func (m *VerificationCodeMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
 	}
-	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *UserLikeQuestionMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-	m.removeduser = nil
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *VerificationCodeMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddQuestionIDs adds the "question" edge to the Question entity by ids.
-func (m *UserLikeQuestionMutation) AddQuestionIDs(ids ...int) {
-	if m.question == nil {
-		m.question = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.question[ids[i]] = struct{}{}
-	}
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *VerificationCodeMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// ClearQuestion clears the "question" edge to the Question entity.
-func (m *UserLikeQuestionMutation) ClearQuestion() {
-	m.clearedquestion = true
+// SetConsumedAt sets the "consumed_at" field.
+func (m *VerificationCodeMutation) SetConsumedAt(t time.Time) {
+	m.consumed_at = &t
 }
 
-// QuestionCleared reports if the "question" edge to the Question entity was cleared.
-func (m *UserLikeQuestionMutation) QuestionCleared() bool {
-	return m.clearedquestion
+// ConsumedAt returns the value of the "consumed_at" field in the mutation.
+func (m *VerificationCodeMutation) ConsumedAt() (r time.Time, exists bool) {
+	v := m.consumed_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveQuestionIDs removes the "question" edge to the Question entity by IDs.
-func (m *UserLikeQuestionMutation) RemoveQuestionIDs(ids ...int) {
-	if m.removedquestion == nil {
-		m.removedquestion = make(map[int]struct{})
+// OldConsumedAt returns the old "consumed_at" field's value of the VerificationCode entity.
+// If the VerificationCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationCodeMutation) OldConsumedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsumedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.question, ids[i])
-		m.removedquestion[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsumedAt requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsumedAt: %w", err)
+	}
+	return oldValue.ConsumedAt, nil
 }
 
-// RemovedQuestion returns the removed IDs of the "question" edge to the Question entity.
-func (m *UserLikeQuestionMutation) RemovedQuestionIDs() (ids []int) {
-	for id := range m.removedquestion {
-		ids = append(ids, id)
-	}
-	return
+// ClearConsumedAt clears the value of the "consumed_at" field.
+func (m *VerificationCodeMutation) ClearConsumedAt() {
+	m.consumed_at = nil
+	m.clearedFields[verificationcode.FieldConsumedAt] = struct{}{}
 }
 
-// QuestionIDs returns the "question" edge IDs in the mutation.
-func (m *UserLikeQuestionMutation) QuestionIDs() (ids []int) {
-	for id := range m.question {
-		ids = append(ids, id)
-	}
-	return
+// ConsumedAtCleared returns if the "consumed_at" field was cleared in this mutation.
+func (m *VerificationCodeMutation) ConsumedAtCleared() bool {
+	_, ok := m.clearedFields[verificationcode.FieldConsumedAt]
+	return ok
 }
 
-// ResetQuestion resets all changes to the "question" edge.
-func (m *UserLikeQuestionMutation) ResetQuestion() {
-	m.question = nil
-	m.clearedquestion = false
-	m.removedquestion = nil
+// ResetConsumedAt resets all changes to the "consumed_at" field.
+func (m *VerificationCodeMutation) ResetConsumedAt() {
+	m.consumed_at = nil
+	delete(m.clearedFields, verificationcode.FieldConsumedAt)
 }
 
-// Where appends a list predicates to the UserLikeQuestionMutation builder.
-func (m *UserLikeQuestionMutation) Where(ps ...predicate.UserLikeQuestion) {
+// Where appends a list predicates to the VerificationCodeMutation builder.
+func (m *VerificationCodeMutation) Where(ps ...predicate.VerificationCode) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserLikeQuestionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the VerificationCodeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserLikeQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UserLikeQuestion, len(ps))
+func (m *VerificationCodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VerificationCode, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9394,30 +15512,51 @@ func (m *UserLikeQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserLikeQuestionMutation) Op() Op {
+func (m *VerificationCodeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserLikeQuestionMutation) SetOp(op Op) {
+func (m *VerificationCodeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (UserLikeQuestion).
-func (m *UserLikeQuestionMutation) Type() string {
+// Type returns the node type of this mutation (VerificationCode).
+func (m *VerificationCodeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserLikeQuestionMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.user_id != nil {
-		fields = append(fields, userlikequestion.FieldUserID)
+func (m *VerificationCodeMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_time != nil {
+		fields = append(fields, verificationcode.FieldCreateTime)
 	}
-	if m.question_id != nil {
-		fields = append(fields, userlikequestion.FieldQuestionID)
+	if m.update_time != nil {
+		fields = append(fields, verificationcode.FieldUpdateTime)
+	}
+	if m.phone != nil {
+		fields = append(fields, verificationcode.FieldPhone)
+	}
+	if m.ip != nil {
+		fields = append(fields, verificationcode.FieldIP)
+	}
+	if m.purpose != nil {
+		fields = append(fields, verificationcode.FieldPurpose)
+	}
+	if m.code_hash != nil {
+		fields = append(fields, verificationcode.FieldCodeHash)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, verificationcode.FieldExpiresAt)
+	}
+	if m.attempts != nil {
+		fields = append(fields, verificationcode.FieldAttempts)
+	}
+	if m.consumed_at != nil {
+		fields = append(fields, verificationcode.FieldConsumedAt)
 	}
 	return fields
 }
@@ -9425,12 +15564,26 @@ func (m *UserLikeQuestionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserLikeQuestionMutation) Field(name string) (ent.Value, bool) {
+func (m *VerificationCodeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case userlikequestion.FieldUserID:
-		return m.UserID()
-	case userlikequestion.FieldQuestionID:
-		return m.QuestionID()
+	case verificationcode.FieldCreateTime:
+		return m.CreateTime()
+	case verificationcode.FieldUpdateTime:
+		return m.UpdateTime()
+	case verificationcode.FieldPhone:
+		return m.Phone()
+	case verificationcode.FieldIP:
+		return m.IP()
+	case verificationcode.FieldPurpose:
+		return m.Purpose()
+	case verificationcode.FieldCodeHash:
+		return m.CodeHash()
+	case verificationcode.FieldExpiresAt:
+		return m.ExpiresAt()
+	case verificationcode.FieldAttempts:
+		return m.Attempts()
+	case verificationcode.FieldConsumedAt:
+		return m.ConsumedAt()
 	}
 	return nil, false
 }
@@ -9438,48 +15591,108 @@ func (m *UserLikeQuestionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserLikeQuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VerificationCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case userlikequestion.FieldUserID:
-		return m.OldUserID(ctx)
-	case userlikequestion.FieldQuestionID:
-		return m.OldQuestionID(ctx)
-	}
-	return nil, fmt.Errorf("unknown UserLikeQuestion field %s", name)
+	case verificationcode.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case verificationcode.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case verificationcode.FieldPhone:
+		return m.OldPhone(ctx)
+	case verificationcode.FieldIP:
+		return m.OldIP(ctx)
+	case verificationcode.FieldPurpose:
+		return m.OldPurpose(ctx)
+	case verificationcode.FieldCodeHash:
+		return m.OldCodeHash(ctx)
+	case verificationcode.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case verificationcode.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case verificationcode.FieldConsumedAt:
+		return m.OldConsumedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown VerificationCode field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserLikeQuestionMutation) SetField(name string, value ent.Value) error {
+func (m *VerificationCodeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case userlikequestion.FieldUserID:
-		v, ok := value.(int)
+	case verificationcode.FieldCreateTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetCreateTime(v)
 		return nil
-	case userlikequestion.FieldQuestionID:
+	case verificationcode.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case verificationcode.FieldPhone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhone(v)
+		return nil
+	case verificationcode.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case verificationcode.FieldPurpose:
+		v, ok := value.(verificationcode.Purpose)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurpose(v)
+		return nil
+	case verificationcode.FieldCodeHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeHash(v)
+		return nil
+	case verificationcode.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case verificationcode.FieldAttempts:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuestionID(v)
+		m.SetAttempts(v)
+		return nil
+	case verificationcode.FieldConsumedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsumedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown UserLikeQuestion field %s", name)
+	return fmt.Errorf("unknown VerificationCode field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserLikeQuestionMutation) AddedFields() []string {
+func (m *VerificationCodeMutation) AddedFields() []string {
 	var fields []string
-	if m.adduser_id != nil {
-		fields = append(fields, userlikequestion.FieldUserID)
-	}
-	if m.addquestion_id != nil {
-		fields = append(fields, userlikequestion.FieldQuestionID)
+	if m.addattempts != nil {
+		fields = append(fields, verificationcode.FieldAttempts)
 	}
 	return fields
 }
@@ -9487,12 +15700,10 @@ func (m *UserLikeQuestionMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserLikeQuestionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *VerificationCodeMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case userlikequestion.FieldUserID:
-		return m.AddedUserID()
-	case userlikequestion.FieldQuestionID:
-		return m.AddedQuestionID()
+	case verificationcode.FieldAttempts:
+		return m.AddedAttempts()
 	}
 	return nil, false
 }
@@ -9500,165 +15711,132 @@ func (m *UserLikeQuestionMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserLikeQuestionMutation) AddField(name string, value ent.Value) error {
+func (m *VerificationCodeMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case userlikequestion.FieldUserID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUserID(v)
-		return nil
-	case userlikequestion.FieldQuestionID:
+	case verificationcode.FieldAttempts:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddQuestionID(v)
+		m.AddAttempts(v)
 		return nil
 	}
-	return fmt.Errorf("unknown UserLikeQuestion numeric field %s", name)
+	return fmt.Errorf("unknown VerificationCode numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserLikeQuestionMutation) ClearedFields() []string {
-	return nil
+func (m *VerificationCodeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(verificationcode.FieldIP) {
+		fields = append(fields, verificationcode.FieldIP)
+	}
+	if m.FieldCleared(verificationcode.FieldConsumedAt) {
+		fields = append(fields, verificationcode.FieldConsumedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserLikeQuestionMutation) FieldCleared(name string) bool {
+func (m *VerificationCodeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserLikeQuestionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown UserLikeQuestion nullable field %s", name)
+func (m *VerificationCodeMutation) ClearField(name string) error {
+	switch name {
+	case verificationcode.FieldIP:
+		m.ClearIP()
+		return nil
+	case verificationcode.FieldConsumedAt:
+		m.ClearConsumedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown VerificationCode nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserLikeQuestionMutation) ResetField(name string) error {
+func (m *VerificationCodeMutation) ResetField(name string) error {
 	switch name {
-	case userlikequestion.FieldUserID:
-		m.ResetUserID()
+	case verificationcode.FieldCreateTime:
+		m.ResetCreateTime()
 		return nil
-	case userlikequestion.FieldQuestionID:
-		m.ResetQuestionID()
+	case verificationcode.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case verificationcode.FieldPhone:
+		m.ResetPhone()
+		return nil
+	case verificationcode.FieldIP:
+		m.ResetIP()
+		return nil
+	case verificationcode.FieldPurpose:
+		m.ResetPurpose()
+		return nil
+	case verificationcode.FieldCodeHash:
+		m.ResetCodeHash()
+		return nil
+	case verificationcode.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case verificationcode.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case verificationcode.FieldConsumedAt:
+		m.ResetConsumedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown UserLikeQuestion field %s", name)
+	return fmt.Errorf("unknown VerificationCode field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserLikeQuestionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, userlikequestion.EdgeUser)
-	}
-	if m.question != nil {
-		edges = append(edges, userlikequestion.EdgeQuestion)
-	}
+func (m *VerificationCodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserLikeQuestionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case userlikequestion.EdgeUser:
-		ids := make([]ent.Value, 0, len(m.user))
-		for id := range m.user {
-			ids = append(ids, id)
-		}
-		return ids
-	case userlikequestion.EdgeQuestion:
-		ids := make([]ent.Value, 0, len(m.question))
-		for id := range m.question {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *VerificationCodeMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserLikeQuestionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removeduser != nil {
-		edges = append(edges, userlikequestion.EdgeUser)
-	}
-	if m.removedquestion != nil {
-		edges = append(edges, userlikequestion.EdgeQuestion)
-	}
+func (m *VerificationCodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserLikeQuestionMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case userlikequestion.EdgeUser:
-		ids := make([]ent.Value, 0, len(m.removeduser))
-		for id := range m.removeduser {
-			ids = append(ids, id)
-		}
-		return ids
-	case userlikequestion.EdgeQuestion:
-		ids := make([]ent.Value, 0, len(m.removedquestion))
-		for id := range m.removedquestion {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *VerificationCodeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserLikeQuestionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, userlikequestion.EdgeUser)
-	}
-	if m.clearedquestion {
-		edges = append(edges, userlikequestion.EdgeQuestion)
-	}
+func (m *VerificationCodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserLikeQuestionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case userlikequestion.EdgeUser:
-		return m.cleareduser
-	case userlikequestion.EdgeQuestion:
-		return m.clearedquestion
-	}
+func (m *VerificationCodeMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserLikeQuestionMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown UserLikeQuestion unique edge %s", name)
+func (m *VerificationCodeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown VerificationCode unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserLikeQuestionMutation) ResetEdge(name string) error {
-	switch name {
-	case userlikequestion.EdgeUser:
-		m.ResetUser()
-		return nil
-	case userlikequestion.EdgeQuestion:
-		m.ResetQuestion()
-		return nil
-	}
-	return fmt.Errorf("unknown UserLikeQuestion edge %s", name)
+func (m *VerificationCodeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown VerificationCode edge %s", name)
 }
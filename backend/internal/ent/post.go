@@ -4,6 +4,8 @@ package ent
 
 import (
 	"backend/internal/ent/post"
+	"backend/internal/ent/user"
+	"backend/internal/pgvector"
 	"fmt"
 	"strings"
 	"time"
@@ -26,22 +28,25 @@ type Post struct {
 	// Content holds the value of the "content" field.
 	Content string `json:"content,omitempty"`
 	// Embedding holds the value of the "embedding" field.
-	Embedding []byte `json:"embedding,omitempty"`
+	Embedding pgvector.Vector `json:"embedding,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// LikeCount holds the value of the "like_count" field.
+	LikeCount int `json:"like_count,omitempty"`
+	// FavoriteCount holds the value of the "favorite_count" field.
+	FavoriteCount int `json:"favorite_count,omitempty"`
+	// CommentCount holds the value of the "comment_count" field.
+	CommentCount int `json:"comment_count,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PostQuery when eager-loading is set.
-	Edges                   PostEdges `json:"edges"`
-	comment_post            *int
-	post_tag_post           *int
-	user_posts              *int
-	user_favorite_post_post *int
-	user_like_post_post     *int
-	selectValues            sql.SelectValues
+	Edges        PostEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // PostEdges holds the relations/edges for other nodes in the graph.
 type PostEdges struct {
 	// User holds the value of the user edge.
-	User []*User `json:"user,omitempty"`
+	User *User `json:"user,omitempty"`
 	// Comments holds the value of the comments edge.
 	Comments []*Comment `json:"comments,omitempty"`
 	// Tags holds the value of the tags edge.
@@ -56,10 +61,12 @@ type PostEdges struct {
 }
 
 // UserOrErr returns the User value or an error if the edge
-// was not loaded in eager-loading.
-func (e PostEdges) UserOrErr() ([]*User, error) {
-	if e.loadedTypes[0] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
 		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "user"}
 }
@@ -106,23 +113,13 @@ func (*Post) scanValues(columns []string) ([]any, error) {
 	for i := range columns {
 		switch columns[i] {
 		case post.FieldEmbedding:
-			values[i] = new([]byte)
-		case post.FieldID:
+			values[i] = new(pgvector.Vector)
+		case post.FieldID, post.FieldUserID, post.FieldLikeCount, post.FieldFavoriteCount, post.FieldCommentCount:
 			values[i] = new(sql.NullInt64)
 		case post.FieldTitle, post.FieldContent:
 			values[i] = new(sql.NullString)
 		case post.FieldCreateTime, post.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
-		case post.ForeignKeys[0]: // comment_post
-			values[i] = new(sql.NullInt64)
-		case post.ForeignKeys[1]: // post_tag_post
-			values[i] = new(sql.NullInt64)
-		case post.ForeignKeys[2]: // user_posts
-			values[i] = new(sql.NullInt64)
-		case post.ForeignKeys[3]: // user_favorite_post_post
-			values[i] = new(sql.NullInt64)
-		case post.ForeignKeys[4]: // user_like_post_post
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -169,45 +166,34 @@ func (po *Post) assignValues(columns []string, values []any) error {
 				po.Content = value.String
 			}
 		case post.FieldEmbedding:
-			if value, ok := values[i].(*[]byte); !ok {
+			if value, ok := values[i].(*pgvector.Vector); !ok {
 				return fmt.Errorf("unexpected type %T for field embedding", values[i])
 			} else if value != nil {
 				po.Embedding = *value
 			}
-		case post.ForeignKeys[0]:
+		case post.FieldUserID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field comment_post", value)
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
 			} else if value.Valid {
-				po.comment_post = new(int)
-				*po.comment_post = int(value.Int64)
+				po.UserID = int(value.Int64)
 			}
-		case post.ForeignKeys[1]:
+		case post.FieldLikeCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field post_tag_post", value)
+				return fmt.Errorf("unexpected type %T for field like_count", values[i])
 			} else if value.Valid {
-				po.post_tag_post = new(int)
-				*po.post_tag_post = int(value.Int64)
+				po.LikeCount = int(value.Int64)
 			}
-		case post.ForeignKeys[2]:
+		case post.FieldFavoriteCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_posts", value)
+				return fmt.Errorf("unexpected type %T for field favorite_count", values[i])
 			} else if value.Valid {
-				po.user_posts = new(int)
-				*po.user_posts = int(value.Int64)
+				po.FavoriteCount = int(value.Int64)
 			}
-		case post.ForeignKeys[3]:
+		case post.FieldCommentCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_favorite_post_post", value)
+				return fmt.Errorf("unexpected type %T for field comment_count", values[i])
 			} else if value.Valid {
-				po.user_favorite_post_post = new(int)
-				*po.user_favorite_post_post = int(value.Int64)
-			}
-		case post.ForeignKeys[4]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_like_post_post", value)
-			} else if value.Valid {
-				po.user_like_post_post = new(int)
-				*po.user_like_post_post = int(value.Int64)
+				po.CommentCount = int(value.Int64)
 			}
 		default:
 			po.selectValues.Set(columns[i], values[i])
@@ -284,6 +270,18 @@ func (po *Post) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("embedding=")
 	builder.WriteString(fmt.Sprintf("%v", po.Embedding))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", po.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("like_count=")
+	builder.WriteString(fmt.Sprintf("%v", po.LikeCount))
+	builder.WriteString(", ")
+	builder.WriteString("favorite_count=")
+	builder.WriteString(fmt.Sprintf("%v", po.FavoriteCount))
+	builder.WriteString(", ")
+	builder.WriteString("comment_count=")
+	builder.WriteString(fmt.Sprintf("%v", po.CommentCount))
 	builder.WriteByte(')')
 	return builder.String()
 }
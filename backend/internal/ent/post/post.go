@@ -24,6 +24,14 @@ const (
 	FieldContent = "content"
 	// FieldEmbedding holds the string denoting the embedding field in the database.
 	FieldEmbedding = "embedding"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldLikeCount holds the string denoting the like_count field in the database.
+	FieldLikeCount = "like_count"
+	// FieldFavoriteCount holds the string denoting the favorite_count field in the database.
+	FieldFavoriteCount = "favorite_count"
+	// FieldCommentCount holds the string denoting the comment_count field in the database.
+	FieldCommentCount = "comment_count"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeComments holds the string denoting the comments edge name in mutations.
@@ -37,40 +45,40 @@ const (
 	// Table holds the table name of the post in the database.
 	Table = "posts"
 	// UserTable is the table that holds the user relation/edge.
-	UserTable = "users"
+	UserTable = "posts"
 	// UserInverseTable is the table name for the User entity.
 	// It exists in this package in order to avoid circular dependency with the "user" package.
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
-	UserColumn = "post_user"
+	UserColumn = "user_id"
 	// CommentsTable is the table that holds the comments relation/edge.
 	CommentsTable = "comments"
 	// CommentsInverseTable is the table name for the Comment entity.
 	// It exists in this package in order to avoid circular dependency with the "comment" package.
 	CommentsInverseTable = "comments"
 	// CommentsColumn is the table column denoting the comments relation/edge.
-	CommentsColumn = "post_comments"
+	CommentsColumn = "post_id"
 	// TagsTable is the table that holds the tags relation/edge.
 	TagsTable = "post_tags"
 	// TagsInverseTable is the table name for the PostTag entity.
 	// It exists in this package in order to avoid circular dependency with the "posttag" package.
 	TagsInverseTable = "post_tags"
 	// TagsColumn is the table column denoting the tags relation/edge.
-	TagsColumn = "post_tags"
+	TagsColumn = "post_id"
 	// LikesTable is the table that holds the likes relation/edge.
 	LikesTable = "user_like_posts"
 	// LikesInverseTable is the table name for the UserLikePost entity.
 	// It exists in this package in order to avoid circular dependency with the "userlikepost" package.
 	LikesInverseTable = "user_like_posts"
 	// LikesColumn is the table column denoting the likes relation/edge.
-	LikesColumn = "post_likes"
+	LikesColumn = "post_id"
 	// FavoritesTable is the table that holds the favorites relation/edge.
 	FavoritesTable = "user_favorite_posts"
 	// FavoritesInverseTable is the table name for the UserFavoritePost entity.
 	// It exists in this package in order to avoid circular dependency with the "userfavoritepost" package.
 	FavoritesInverseTable = "user_favorite_posts"
 	// FavoritesColumn is the table column denoting the favorites relation/edge.
-	FavoritesColumn = "post_favorites"
+	FavoritesColumn = "post_id"
 )
 
 // Columns holds all SQL columns for post fields.
@@ -81,16 +89,10 @@ var Columns = []string{
 	FieldTitle,
 	FieldContent,
 	FieldEmbedding,
-}
-
-// ForeignKeys holds the SQL foreign-keys that are owned by the "posts"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"comment_post",
-	"post_tag_post",
-	"user_posts",
-	"user_favorite_post_post",
-	"user_like_post_post",
+	FieldUserID,
+	FieldLikeCount,
+	FieldFavoriteCount,
+	FieldCommentCount,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -100,11 +102,6 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
@@ -117,6 +114,12 @@ var (
 	UpdateDefaultUpdateTime func() time.Time
 	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	TitleValidator func(string) error
+	// DefaultLikeCount holds the default value on creation for the "like_count" field.
+	DefaultLikeCount int
+	// DefaultFavoriteCount holds the default value on creation for the "favorite_count" field.
+	DefaultFavoriteCount int
+	// DefaultCommentCount holds the default value on creation for the "comment_count" field.
+	DefaultCommentCount int
 )
 
 // OrderOption defines the ordering options for the Post queries.
@@ -147,17 +150,35 @@ func ByContent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContent, opts...).ToFunc()
 }
 
-// ByUserCount orders the results by user count.
-func ByUserCount(opts ...sql.OrderTermOption) OrderOption {
-	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newUserStep(), opts...)
-	}
+// ByEmbedding orders the results by the embedding field.
+func ByEmbedding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbedding, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByLikeCount orders the results by the like_count field.
+func ByLikeCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLikeCount, opts...).ToFunc()
+}
+
+// ByFavoriteCount orders the results by the favorite_count field.
+func ByFavoriteCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFavoriteCount, opts...).ToFunc()
+}
+
+// ByCommentCount orders the results by the comment_count field.
+func ByCommentCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommentCount, opts...).ToFunc()
 }
 
-// ByUser orders the results by user terms.
-func ByUser(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newUserStep(), append([]sql.OrderTerm{term}, terms...)...)
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
 
@@ -220,7 +241,7 @@ func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(UserInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, UserTable, UserColumn),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
 func newCommentsStep() *sqlgraph.Step {
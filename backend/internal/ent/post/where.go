@@ -4,6 +4,7 @@ package post
 
 import (
 	"backend/internal/ent/predicate"
+	"backend/internal/pgvector"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -76,10 +77,30 @@ func Content(v string) predicate.Post {
 }
 
 // Embedding applies equality check predicate on the "embedding" field. It's identical to EmbeddingEQ.
-func Embedding(v []byte) predicate.Post {
+func Embedding(v pgvector.Vector) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldEmbedding, v))
 }
 
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldUserID, v))
+}
+
+// LikeCount applies equality check predicate on the "like_count" field. It's identical to LikeCountEQ.
+func LikeCount(v int) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldLikeCount, v))
+}
+
+// FavoriteCount applies equality check predicate on the "favorite_count" field. It's identical to FavoriteCountEQ.
+func FavoriteCount(v int) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldFavoriteCount, v))
+}
+
+// CommentCount applies equality check predicate on the "comment_count" field. It's identical to CommentCountEQ.
+func CommentCount(v int) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldCommentCount, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldCreateTime, v))
@@ -301,42 +322,42 @@ func ContentContainsFold(v string) predicate.Post {
 }
 
 // EmbeddingEQ applies the EQ predicate on the "embedding" field.
-func EmbeddingEQ(v []byte) predicate.Post {
+func EmbeddingEQ(v pgvector.Vector) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldEmbedding, v))
 }
 
 // EmbeddingNEQ applies the NEQ predicate on the "embedding" field.
-func EmbeddingNEQ(v []byte) predicate.Post {
+func EmbeddingNEQ(v pgvector.Vector) predicate.Post {
 	return predicate.Post(sql.FieldNEQ(FieldEmbedding, v))
 }
 
 // EmbeddingIn applies the In predicate on the "embedding" field.
-func EmbeddingIn(vs ...[]byte) predicate.Post {
+func EmbeddingIn(vs ...pgvector.Vector) predicate.Post {
 	return predicate.Post(sql.FieldIn(FieldEmbedding, vs...))
 }
 
 // EmbeddingNotIn applies the NotIn predicate on the "embedding" field.
-func EmbeddingNotIn(vs ...[]byte) predicate.Post {
+func EmbeddingNotIn(vs ...pgvector.Vector) predicate.Post {
 	return predicate.Post(sql.FieldNotIn(FieldEmbedding, vs...))
 }
 
 // EmbeddingGT applies the GT predicate on the "embedding" field.
-func EmbeddingGT(v []byte) predicate.Post {
+func EmbeddingGT(v pgvector.Vector) predicate.Post {
 	return predicate.Post(sql.FieldGT(FieldEmbedding, v))
 }
 
 // EmbeddingGTE applies the GTE predicate on the "embedding" field.
-func EmbeddingGTE(v []byte) predicate.Post {
+func EmbeddingGTE(v pgvector.Vector) predicate.Post {
 	return predicate.Post(sql.FieldGTE(FieldEmbedding, v))
 }
 
 // EmbeddingLT applies the LT predicate on the "embedding" field.
-func EmbeddingLT(v []byte) predicate.Post {
+func EmbeddingLT(v pgvector.Vector) predicate.Post {
 	return predicate.Post(sql.FieldLT(FieldEmbedding, v))
 }
 
 // EmbeddingLTE applies the LTE predicate on the "embedding" field.
-func EmbeddingLTE(v []byte) predicate.Post {
+func EmbeddingLTE(v pgvector.Vector) predicate.Post {
 	return predicate.Post(sql.FieldLTE(FieldEmbedding, v))
 }
 
@@ -350,12 +371,162 @@ func EmbeddingNotNil() predicate.Post {
 	return predicate.Post(sql.FieldNotNull(FieldEmbedding))
 }
 
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldUserID))
+}
+
+// LikeCountEQ applies the EQ predicate on the "like_count" field.
+func LikeCountEQ(v int) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldLikeCount, v))
+}
+
+// LikeCountNEQ applies the NEQ predicate on the "like_count" field.
+func LikeCountNEQ(v int) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldLikeCount, v))
+}
+
+// LikeCountIn applies the In predicate on the "like_count" field.
+func LikeCountIn(vs ...int) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldLikeCount, vs...))
+}
+
+// LikeCountNotIn applies the NotIn predicate on the "like_count" field.
+func LikeCountNotIn(vs ...int) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldLikeCount, vs...))
+}
+
+// LikeCountGT applies the GT predicate on the "like_count" field.
+func LikeCountGT(v int) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldLikeCount, v))
+}
+
+// LikeCountGTE applies the GTE predicate on the "like_count" field.
+func LikeCountGTE(v int) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldLikeCount, v))
+}
+
+// LikeCountLT applies the LT predicate on the "like_count" field.
+func LikeCountLT(v int) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldLikeCount, v))
+}
+
+// LikeCountLTE applies the LTE predicate on the "like_count" field.
+func LikeCountLTE(v int) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldLikeCount, v))
+}
+
+// FavoriteCountEQ applies the EQ predicate on the "favorite_count" field.
+func FavoriteCountEQ(v int) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldFavoriteCount, v))
+}
+
+// FavoriteCountNEQ applies the NEQ predicate on the "favorite_count" field.
+func FavoriteCountNEQ(v int) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldFavoriteCount, v))
+}
+
+// FavoriteCountIn applies the In predicate on the "favorite_count" field.
+func FavoriteCountIn(vs ...int) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldFavoriteCount, vs...))
+}
+
+// FavoriteCountNotIn applies the NotIn predicate on the "favorite_count" field.
+func FavoriteCountNotIn(vs ...int) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldFavoriteCount, vs...))
+}
+
+// FavoriteCountGT applies the GT predicate on the "favorite_count" field.
+func FavoriteCountGT(v int) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldFavoriteCount, v))
+}
+
+// FavoriteCountGTE applies the GTE predicate on the "favorite_count" field.
+func FavoriteCountGTE(v int) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldFavoriteCount, v))
+}
+
+// FavoriteCountLT applies the LT predicate on the "favorite_count" field.
+func FavoriteCountLT(v int) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldFavoriteCount, v))
+}
+
+// FavoriteCountLTE applies the LTE predicate on the "favorite_count" field.
+func FavoriteCountLTE(v int) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldFavoriteCount, v))
+}
+
+// CommentCountEQ applies the EQ predicate on the "comment_count" field.
+func CommentCountEQ(v int) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldCommentCount, v))
+}
+
+// CommentCountNEQ applies the NEQ predicate on the "comment_count" field.
+func CommentCountNEQ(v int) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldCommentCount, v))
+}
+
+// CommentCountIn applies the In predicate on the "comment_count" field.
+func CommentCountIn(vs ...int) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldCommentCount, vs...))
+}
+
+// CommentCountNotIn applies the NotIn predicate on the "comment_count" field.
+func CommentCountNotIn(vs ...int) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldCommentCount, vs...))
+}
+
+// CommentCountGT applies the GT predicate on the "comment_count" field.
+func CommentCountGT(v int) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldCommentCount, v))
+}
+
+// CommentCountGTE applies the GTE predicate on the "comment_count" field.
+func CommentCountGTE(v int) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldCommentCount, v))
+}
+
+// CommentCountLT applies the LT predicate on the "comment_count" field.
+func CommentCountLT(v int) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldCommentCount, v))
+}
+
+// CommentCountLTE applies the LTE predicate on the "comment_count" field.
+func CommentCountLTE(v int) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldCommentCount, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Post {
 	return predicate.Post(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, UserTable, UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
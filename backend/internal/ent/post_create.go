@@ -9,11 +9,13 @@ import (
 	"backend/internal/ent/user"
 	"backend/internal/ent/userfavoritepost"
 	"backend/internal/ent/userlikepost"
+	"backend/internal/pgvector"
 	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -23,6 +25,7 @@ type PostCreate struct {
 	config
 	mutation *PostMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreateTime sets the "create_time" field.
@@ -74,24 +77,70 @@ func (pc *PostCreate) SetNillableContent(s *string) *PostCreate {
 }
 
 // SetEmbedding sets the "embedding" field.
-func (pc *PostCreate) SetEmbedding(b []byte) *PostCreate {
-	pc.mutation.SetEmbedding(b)
+func (pc *PostCreate) SetEmbedding(pg pgvector.Vector) *PostCreate {
+	pc.mutation.SetEmbedding(pg)
 	return pc
 }
 
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (pc *PostCreate) AddUserIDs(ids ...int) *PostCreate {
-	pc.mutation.AddUserIDs(ids...)
+// SetUserID sets the "user_id" field.
+func (pc *PostCreate) SetUserID(i int) *PostCreate {
+	pc.mutation.SetUserID(i)
 	return pc
 }
 
-// AddUser adds the "user" edges to the User entity.
-func (pc *PostCreate) AddUser(u ...*User) *PostCreate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (pc *PostCreate) SetNillableUserID(i *int) *PostCreate {
+	if i != nil {
+		pc.SetUserID(*i)
+	}
+	return pc
+}
+
+// SetLikeCount sets the "like_count" field.
+func (pc *PostCreate) SetLikeCount(i int) *PostCreate {
+	pc.mutation.SetLikeCount(i)
+	return pc
+}
+
+// SetNillableLikeCount sets the "like_count" field if the given value is not nil.
+func (pc *PostCreate) SetNillableLikeCount(i *int) *PostCreate {
+	if i != nil {
+		pc.SetLikeCount(*i)
+	}
+	return pc
+}
+
+// SetFavoriteCount sets the "favorite_count" field.
+func (pc *PostCreate) SetFavoriteCount(i int) *PostCreate {
+	pc.mutation.SetFavoriteCount(i)
+	return pc
+}
+
+// SetNillableFavoriteCount sets the "favorite_count" field if the given value is not nil.
+func (pc *PostCreate) SetNillableFavoriteCount(i *int) *PostCreate {
+	if i != nil {
+		pc.SetFavoriteCount(*i)
+	}
+	return pc
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (pc *PostCreate) SetCommentCount(i int) *PostCreate {
+	pc.mutation.SetCommentCount(i)
+	return pc
+}
+
+// SetNillableCommentCount sets the "comment_count" field if the given value is not nil.
+func (pc *PostCreate) SetNillableCommentCount(i *int) *PostCreate {
+	if i != nil {
+		pc.SetCommentCount(*i)
 	}
-	return pc.AddUserIDs(ids...)
+	return pc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pc *PostCreate) SetUser(u *User) *PostCreate {
+	return pc.SetUserID(u.ID)
 }
 
 // AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
@@ -197,6 +246,18 @@ func (pc *PostCreate) defaults() {
 		v := post.DefaultUpdateTime()
 		pc.mutation.SetUpdateTime(v)
 	}
+	if _, ok := pc.mutation.LikeCount(); !ok {
+		v := post.DefaultLikeCount
+		pc.mutation.SetLikeCount(v)
+	}
+	if _, ok := pc.mutation.FavoriteCount(); !ok {
+		v := post.DefaultFavoriteCount
+		pc.mutation.SetFavoriteCount(v)
+	}
+	if _, ok := pc.mutation.CommentCount(); !ok {
+		v := post.DefaultCommentCount
+		pc.mutation.SetCommentCount(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -215,6 +276,15 @@ func (pc *PostCreate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Post.title": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.LikeCount(); !ok {
+		return &ValidationError{Name: "like_count", err: errors.New(`ent: missing required field "Post.like_count"`)}
+	}
+	if _, ok := pc.mutation.FavoriteCount(); !ok {
+		return &ValidationError{Name: "favorite_count", err: errors.New(`ent: missing required field "Post.favorite_count"`)}
+	}
+	if _, ok := pc.mutation.CommentCount(); !ok {
+		return &ValidationError{Name: "comment_count", err: errors.New(`ent: missing required field "Post.comment_count"`)}
+	}
 	return nil
 }
 
@@ -241,6 +311,7 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_node = &Post{config: pc.config}
 		_spec = sqlgraph.NewCreateSpec(post.Table, sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = pc.conflict
 	if value, ok := pc.mutation.CreateTime(); ok {
 		_spec.SetField(post.FieldCreateTime, field.TypeTime, value)
 		_node.CreateTime = value
@@ -258,13 +329,25 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_node.Content = value
 	}
 	if value, ok := pc.mutation.Embedding(); ok {
-		_spec.SetField(post.FieldEmbedding, field.TypeBytes, value)
+		_spec.SetField(post.FieldEmbedding, field.TypeOther, value)
 		_node.Embedding = value
 	}
+	if value, ok := pc.mutation.LikeCount(); ok {
+		_spec.SetField(post.FieldLikeCount, field.TypeInt, value)
+		_node.LikeCount = value
+	}
+	if value, ok := pc.mutation.FavoriteCount(); ok {
+		_spec.SetField(post.FieldFavoriteCount, field.TypeInt, value)
+		_node.FavoriteCount = value
+	}
+	if value, ok := pc.mutation.CommentCount(); ok {
+		_spec.SetField(post.FieldCommentCount, field.TypeInt, value)
+		_node.CommentCount = value
+	}
 	if nodes := pc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   post.UserTable,
 			Columns: []string{post.UserColumn},
 			Bidi:    false,
@@ -275,6 +358,7 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := pc.mutation.CommentsIDs(); len(nodes) > 0 {
@@ -344,11 +428,425 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Post.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (pc *PostCreate) OnConflict(opts ...sql.ConflictOption) *PostUpsertOne {
+	pc.conflict = opts
+	return &PostUpsertOne{
+		create: pc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pc *PostCreate) OnConflictColumns(columns ...string) *PostUpsertOne {
+	pc.conflict = append(pc.conflict, sql.ConflictColumns(columns...))
+	return &PostUpsertOne{
+		create: pc,
+	}
+}
+
+type (
+	// PostUpsertOne is the builder for "upsert"-ing
+	//  one Post node.
+	PostUpsertOne struct {
+		create *PostCreate
+	}
+
+	// PostUpsert is the "OnConflict" setter.
+	PostUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *PostUpsert) SetUpdateTime(v time.Time) *PostUpsert {
+	u.Set(post.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *PostUpsert) UpdateUpdateTime() *PostUpsert {
+	u.SetExcluded(post.FieldUpdateTime)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *PostUpsert) SetTitle(v string) *PostUpsert {
+	u.Set(post.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *PostUpsert) UpdateTitle() *PostUpsert {
+	u.SetExcluded(post.FieldTitle)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *PostUpsert) SetContent(v string) *PostUpsert {
+	u.Set(post.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *PostUpsert) UpdateContent() *PostUpsert {
+	u.SetExcluded(post.FieldContent)
+	return u
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *PostUpsert) ClearContent() *PostUpsert {
+	u.SetNull(post.FieldContent)
+	return u
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *PostUpsert) SetEmbedding(v pgvector.Vector) *PostUpsert {
+	u.Set(post.FieldEmbedding, v)
+	return u
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *PostUpsert) UpdateEmbedding() *PostUpsert {
+	u.SetExcluded(post.FieldEmbedding)
+	return u
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *PostUpsert) ClearEmbedding() *PostUpsert {
+	u.SetNull(post.FieldEmbedding)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PostUpsert) SetUserID(v int) *PostUpsert {
+	u.Set(post.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PostUpsert) UpdateUserID() *PostUpsert {
+	u.SetExcluded(post.FieldUserID)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *PostUpsert) ClearUserID() *PostUpsert {
+	u.SetNull(post.FieldUserID)
+	return u
+}
+
+// SetLikeCount sets the "like_count" field.
+func (u *PostUpsert) SetLikeCount(v int) *PostUpsert {
+	u.Set(post.FieldLikeCount, v)
+	return u
+}
+
+// UpdateLikeCount sets the "like_count" field to the value that was provided on create.
+func (u *PostUpsert) UpdateLikeCount() *PostUpsert {
+	u.SetExcluded(post.FieldLikeCount)
+	return u
+}
+
+// AddLikeCount adds v to the "like_count" field.
+func (u *PostUpsert) AddLikeCount(v int) *PostUpsert {
+	u.Add(post.FieldLikeCount, v)
+	return u
+}
+
+// SetFavoriteCount sets the "favorite_count" field.
+func (u *PostUpsert) SetFavoriteCount(v int) *PostUpsert {
+	u.Set(post.FieldFavoriteCount, v)
+	return u
+}
+
+// UpdateFavoriteCount sets the "favorite_count" field to the value that was provided on create.
+func (u *PostUpsert) UpdateFavoriteCount() *PostUpsert {
+	u.SetExcluded(post.FieldFavoriteCount)
+	return u
+}
+
+// AddFavoriteCount adds v to the "favorite_count" field.
+func (u *PostUpsert) AddFavoriteCount(v int) *PostUpsert {
+	u.Add(post.FieldFavoriteCount, v)
+	return u
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (u *PostUpsert) SetCommentCount(v int) *PostUpsert {
+	u.Set(post.FieldCommentCount, v)
+	return u
+}
+
+// UpdateCommentCount sets the "comment_count" field to the value that was provided on create.
+func (u *PostUpsert) UpdateCommentCount() *PostUpsert {
+	u.SetExcluded(post.FieldCommentCount)
+	return u
+}
+
+// AddCommentCount adds v to the "comment_count" field.
+func (u *PostUpsert) AddCommentCount(v int) *PostUpsert {
+	u.Add(post.FieldCommentCount, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PostUpsertOne) UpdateNewValues() *PostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(post.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PostUpsertOne) Ignore() *PostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostUpsertOne) DoNothing() *PostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostCreate.OnConflict
+// documentation for more info.
+func (u *PostUpsertOne) Update(set func(*PostUpsert)) *PostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *PostUpsertOne) SetUpdateTime(v time.Time) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateUpdateTime() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *PostUpsertOne) SetTitle(v string) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateTitle() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *PostUpsertOne) SetContent(v string) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateContent() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *PostUpsertOne) ClearContent() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *PostUpsertOne) SetEmbedding(v pgvector.Vector) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateEmbedding() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *PostUpsertOne) ClearEmbedding() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearEmbedding()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PostUpsertOne) SetUserID(v int) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateUserID() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *PostUpsertOne) ClearUserID() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetLikeCount sets the "like_count" field.
+func (u *PostUpsertOne) SetLikeCount(v int) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetLikeCount(v)
+	})
+}
+
+// AddLikeCount adds v to the "like_count" field.
+func (u *PostUpsertOne) AddLikeCount(v int) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.AddLikeCount(v)
+	})
+}
+
+// UpdateLikeCount sets the "like_count" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateLikeCount() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateLikeCount()
+	})
+}
+
+// SetFavoriteCount sets the "favorite_count" field.
+func (u *PostUpsertOne) SetFavoriteCount(v int) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetFavoriteCount(v)
+	})
+}
+
+// AddFavoriteCount adds v to the "favorite_count" field.
+func (u *PostUpsertOne) AddFavoriteCount(v int) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.AddFavoriteCount(v)
+	})
+}
+
+// UpdateFavoriteCount sets the "favorite_count" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateFavoriteCount() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateFavoriteCount()
+	})
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (u *PostUpsertOne) SetCommentCount(v int) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetCommentCount(v)
+	})
+}
+
+// AddCommentCount adds v to the "comment_count" field.
+func (u *PostUpsertOne) AddCommentCount(v int) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.AddCommentCount(v)
+	})
+}
+
+// UpdateCommentCount sets the "comment_count" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateCommentCount() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateCommentCount()
+	})
+}
+
+// Exec executes the query.
+func (u *PostUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PostUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PostUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PostCreateBulk is the builder for creating many Post entities in bulk.
 type PostCreateBulk struct {
 	config
 	err      error
 	builders []*PostCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Post entities in the database.
@@ -378,6 +876,7 @@ func (pcb *PostCreateBulk) Save(ctx context.Context) ([]*Post, error) {
 					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -431,3 +930,268 @@ func (pcb *PostCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Post.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (pcb *PostCreateBulk) OnConflict(opts ...sql.ConflictOption) *PostUpsertBulk {
+	pcb.conflict = opts
+	return &PostUpsertBulk{
+		create: pcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcb *PostCreateBulk) OnConflictColumns(columns ...string) *PostUpsertBulk {
+	pcb.conflict = append(pcb.conflict, sql.ConflictColumns(columns...))
+	return &PostUpsertBulk{
+		create: pcb,
+	}
+}
+
+// PostUpsertBulk is the builder for "upsert"-ing
+// a bulk of Post nodes.
+type PostUpsertBulk struct {
+	create *PostCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PostUpsertBulk) UpdateNewValues() *PostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(post.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PostUpsertBulk) Ignore() *PostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostUpsertBulk) DoNothing() *PostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostCreateBulk.OnConflict
+// documentation for more info.
+func (u *PostUpsertBulk) Update(set func(*PostUpsert)) *PostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *PostUpsertBulk) SetUpdateTime(v time.Time) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateUpdateTime() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *PostUpsertBulk) SetTitle(v string) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateTitle() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *PostUpsertBulk) SetContent(v string) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateContent() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *PostUpsertBulk) ClearContent() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *PostUpsertBulk) SetEmbedding(v pgvector.Vector) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateEmbedding() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *PostUpsertBulk) ClearEmbedding() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearEmbedding()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PostUpsertBulk) SetUserID(v int) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateUserID() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *PostUpsertBulk) ClearUserID() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetLikeCount sets the "like_count" field.
+func (u *PostUpsertBulk) SetLikeCount(v int) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetLikeCount(v)
+	})
+}
+
+// AddLikeCount adds v to the "like_count" field.
+func (u *PostUpsertBulk) AddLikeCount(v int) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.AddLikeCount(v)
+	})
+}
+
+// UpdateLikeCount sets the "like_count" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateLikeCount() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateLikeCount()
+	})
+}
+
+// SetFavoriteCount sets the "favorite_count" field.
+func (u *PostUpsertBulk) SetFavoriteCount(v int) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetFavoriteCount(v)
+	})
+}
+
+// AddFavoriteCount adds v to the "favorite_count" field.
+func (u *PostUpsertBulk) AddFavoriteCount(v int) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.AddFavoriteCount(v)
+	})
+}
+
+// UpdateFavoriteCount sets the "favorite_count" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateFavoriteCount() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateFavoriteCount()
+	})
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (u *PostUpsertBulk) SetCommentCount(v int) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetCommentCount(v)
+	})
+}
+
+// AddCommentCount adds v to the "comment_count" field.
+func (u *PostUpsertBulk) AddCommentCount(v int) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.AddCommentCount(v)
+	})
+}
+
+// UpdateCommentCount sets the "comment_count" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateCommentCount() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateCommentCount()
+	})
+}
+
+// Exec executes the query.
+func (u *PostUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PostCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
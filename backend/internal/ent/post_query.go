@@ -32,7 +32,7 @@ type PostQuery struct {
 	withTags      *PostTagQuery
 	withLikes     *UserLikePostQuery
 	withFavorites *UserFavoritePostQuery
-	withFKs       bool
+	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,7 +83,7 @@ func (pq *PostQuery) QueryUser() *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(post.Table, post.FieldID, selector),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, post.UserTable, post.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, post.UserTable, post.UserColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
 		return fromU, nil
@@ -514,7 +514,6 @@ func (pq *PostQuery) prepareQuery(ctx context.Context) error {
 func (pq *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, error) {
 	var (
 		nodes       = []*Post{}
-		withFKs     = pq.withFKs
 		_spec       = pq.querySpec()
 		loadedTypes = [5]bool{
 			pq.withUser != nil,
@@ -524,9 +523,6 @@ func (pq *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 			pq.withFavorites != nil,
 		}
 	)
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, post.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Post).scanValues(nil, columns)
 	}
@@ -536,6 +532,9 @@ func (pq *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -546,9 +545,8 @@ func (pq *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 		return nodes, nil
 	}
 	if query := pq.withUser; query != nil {
-		if err := pq.loadUser(ctx, query, nodes,
-			func(n *Post) { n.Edges.User = []*User{} },
-			func(n *Post, e *User) { n.Edges.User = append(n.Edges.User, e) }); err != nil {
+		if err := pq.loadUser(ctx, query, nodes, nil,
+			func(n *Post, e *User) { n.Edges.User = e }); err != nil {
 			return nil, err
 		}
 	}
@@ -584,33 +582,31 @@ func (pq *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 }
 
 func (pq *PostQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Post, init func(*Post), assign func(*Post, *User)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*Post)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Post)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	query.withFKs = true
-	query.Where(predicate.User(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(post.UserColumn), fks...))
-	}))
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.post_user
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "post_user" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "post_user" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
@@ -624,7 +620,9 @@ func (pq *PostQuery) loadComments(ctx context.Context, query *CommentQuery, node
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(comment.FieldPostID)
+	}
 	query.Where(predicate.Comment(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(post.CommentsColumn), fks...))
 	}))
@@ -633,13 +631,10 @@ func (pq *PostQuery) loadComments(ctx context.Context, query *CommentQuery, node
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.post_comments
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "post_comments" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.PostID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "post_comments" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "post_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -655,7 +650,9 @@ func (pq *PostQuery) loadTags(ctx context.Context, query *PostTagQuery, nodes []
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(posttag.FieldPostID)
+	}
 	query.Where(predicate.PostTag(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(post.TagsColumn), fks...))
 	}))
@@ -664,13 +661,10 @@ func (pq *PostQuery) loadTags(ctx context.Context, query *PostTagQuery, nodes []
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.post_tags
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "post_tags" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.PostID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "post_tags" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "post_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -686,7 +680,9 @@ func (pq *PostQuery) loadLikes(ctx context.Context, query *UserLikePostQuery, no
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(userlikepost.FieldPostID)
+	}
 	query.Where(predicate.UserLikePost(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(post.LikesColumn), fks...))
 	}))
@@ -695,13 +691,10 @@ func (pq *PostQuery) loadLikes(ctx context.Context, query *UserLikePostQuery, no
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.post_likes
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "post_likes" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.PostID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "post_likes" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "post_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -717,7 +710,9 @@ func (pq *PostQuery) loadFavorites(ctx context.Context, query *UserFavoritePostQ
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(userfavoritepost.FieldPostID)
+	}
 	query.Where(predicate.UserFavoritePost(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(post.FavoritesColumn), fks...))
 	}))
@@ -726,13 +721,10 @@ func (pq *PostQuery) loadFavorites(ctx context.Context, query *UserFavoritePostQ
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.post_favorites
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "post_favorites" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.PostID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "post_favorites" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "post_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -741,6 +733,9 @@ func (pq *PostQuery) loadFavorites(ctx context.Context, query *UserFavoritePostQ
 
 func (pq *PostQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
 	_spec.Node.Columns = pq.ctx.Fields
 	if len(pq.ctx.Fields) > 0 {
 		_spec.Unique = pq.ctx.Unique != nil && *pq.ctx.Unique
@@ -764,6 +759,9 @@ func (pq *PostQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if pq.withUser != nil {
+			_spec.Node.AddColumnOnce(post.FieldUserID)
+		}
 	}
 	if ps := pq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -803,6 +801,9 @@ func (pq *PostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if pq.ctx.Unique != nil && *pq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range pq.modifiers {
+		m(selector)
+	}
 	for _, p := range pq.predicates {
 		p(selector)
 	}
@@ -820,6 +821,12 @@ func (pq *PostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pq *PostQuery) Modify(modifiers ...func(s *sql.Selector)) *PostSelect {
+	pq.modifiers = append(pq.modifiers, modifiers...)
+	return pq.Select()
+}
+
 // PostGroupBy is the group-by builder for Post entities.
 type PostGroupBy struct {
 	selector
@@ -909,3 +916,9 @@ func (ps *PostSelect) sqlScan(ctx context.Context, root *PostQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ps *PostSelect) Modify(modifiers ...func(s *sql.Selector)) *PostSelect {
+	ps.modifiers = append(ps.modifiers, modifiers...)
+	return ps
+}
@@ -10,6 +10,7 @@ import (
 	"backend/internal/ent/user"
 	"backend/internal/ent/userfavoritepost"
 	"backend/internal/ent/userlikepost"
+	"backend/internal/pgvector"
 	"context"
 	"errors"
 	"fmt"
@@ -23,8 +24,9 @@ import (
 // PostUpdate is the builder for updating Post entities.
 type PostUpdate struct {
 	config
-	hooks    []Hook
-	mutation *PostMutation
+	hooks     []Hook
+	mutation  *PostMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the PostUpdate builder.
@@ -74,8 +76,8 @@ func (pu *PostUpdate) ClearContent() *PostUpdate {
 }
 
 // SetEmbedding sets the "embedding" field.
-func (pu *PostUpdate) SetEmbedding(b []byte) *PostUpdate {
-	pu.mutation.SetEmbedding(b)
+func (pu *PostUpdate) SetEmbedding(pg pgvector.Vector) *PostUpdate {
+	pu.mutation.SetEmbedding(pg)
 	return pu
 }
 
@@ -85,19 +87,92 @@ func (pu *PostUpdate) ClearEmbedding() *PostUpdate {
 	return pu
 }
 
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (pu *PostUpdate) AddUserIDs(ids ...int) *PostUpdate {
-	pu.mutation.AddUserIDs(ids...)
+// SetUserID sets the "user_id" field.
+func (pu *PostUpdate) SetUserID(i int) *PostUpdate {
+	pu.mutation.SetUserID(i)
 	return pu
 }
 
-// AddUser adds the "user" edges to the User entity.
-func (pu *PostUpdate) AddUser(u ...*User) *PostUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableUserID(i *int) *PostUpdate {
+	if i != nil {
+		pu.SetUserID(*i)
+	}
+	return pu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (pu *PostUpdate) ClearUserID() *PostUpdate {
+	pu.mutation.ClearUserID()
+	return pu
+}
+
+// SetLikeCount sets the "like_count" field.
+func (pu *PostUpdate) SetLikeCount(i int) *PostUpdate {
+	pu.mutation.ResetLikeCount()
+	pu.mutation.SetLikeCount(i)
+	return pu
+}
+
+// SetNillableLikeCount sets the "like_count" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableLikeCount(i *int) *PostUpdate {
+	if i != nil {
+		pu.SetLikeCount(*i)
+	}
+	return pu
+}
+
+// AddLikeCount adds i to the "like_count" field.
+func (pu *PostUpdate) AddLikeCount(i int) *PostUpdate {
+	pu.mutation.AddLikeCount(i)
+	return pu
+}
+
+// SetFavoriteCount sets the "favorite_count" field.
+func (pu *PostUpdate) SetFavoriteCount(i int) *PostUpdate {
+	pu.mutation.ResetFavoriteCount()
+	pu.mutation.SetFavoriteCount(i)
+	return pu
+}
+
+// SetNillableFavoriteCount sets the "favorite_count" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableFavoriteCount(i *int) *PostUpdate {
+	if i != nil {
+		pu.SetFavoriteCount(*i)
 	}
-	return pu.AddUserIDs(ids...)
+	return pu
+}
+
+// AddFavoriteCount adds i to the "favorite_count" field.
+func (pu *PostUpdate) AddFavoriteCount(i int) *PostUpdate {
+	pu.mutation.AddFavoriteCount(i)
+	return pu
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (pu *PostUpdate) SetCommentCount(i int) *PostUpdate {
+	pu.mutation.ResetCommentCount()
+	pu.mutation.SetCommentCount(i)
+	return pu
+}
+
+// SetNillableCommentCount sets the "comment_count" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableCommentCount(i *int) *PostUpdate {
+	if i != nil {
+		pu.SetCommentCount(*i)
+	}
+	return pu
+}
+
+// AddCommentCount adds i to the "comment_count" field.
+func (pu *PostUpdate) AddCommentCount(i int) *PostUpdate {
+	pu.mutation.AddCommentCount(i)
+	return pu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pu *PostUpdate) SetUser(u *User) *PostUpdate {
+	return pu.SetUserID(u.ID)
 }
 
 // AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
@@ -165,27 +240,12 @@ func (pu *PostUpdate) Mutation() *PostMutation {
 	return pu.mutation
 }
 
-// ClearUser clears all "user" edges to the User entity.
+// ClearUser clears the "user" edge to the User entity.
 func (pu *PostUpdate) ClearUser() *PostUpdate {
 	pu.mutation.ClearUser()
 	return pu
 }
 
-// RemoveUserIDs removes the "user" edge to User entities by IDs.
-func (pu *PostUpdate) RemoveUserIDs(ids ...int) *PostUpdate {
-	pu.mutation.RemoveUserIDs(ids...)
-	return pu
-}
-
-// RemoveUser removes "user" edges to User entities.
-func (pu *PostUpdate) RemoveUser(u ...*User) *PostUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return pu.RemoveUserIDs(ids...)
-}
-
 // ClearComments clears all "comments" edges to the Comment entity.
 func (pu *PostUpdate) ClearComments() *PostUpdate {
 	pu.mutation.ClearComments()
@@ -316,6 +376,12 @@ func (pu *PostUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pu *PostUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PostUpdate {
+	pu.modifiers = append(pu.modifiers, modifiers...)
+	return pu
+}
+
 func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := pu.check(); err != nil {
 		return n, err
@@ -341,28 +407,33 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		_spec.ClearField(post.FieldContent, field.TypeString)
 	}
 	if value, ok := pu.mutation.Embedding(); ok {
-		_spec.SetField(post.FieldEmbedding, field.TypeBytes, value)
+		_spec.SetField(post.FieldEmbedding, field.TypeOther, value)
 	}
 	if pu.mutation.EmbeddingCleared() {
-		_spec.ClearField(post.FieldEmbedding, field.TypeBytes)
+		_spec.ClearField(post.FieldEmbedding, field.TypeOther)
 	}
-	if pu.mutation.UserCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   post.UserTable,
-			Columns: []string{post.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	if value, ok := pu.mutation.LikeCount(); ok {
+		_spec.SetField(post.FieldLikeCount, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedLikeCount(); ok {
+		_spec.AddField(post.FieldLikeCount, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.FavoriteCount(); ok {
+		_spec.SetField(post.FieldFavoriteCount, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedFavoriteCount(); ok {
+		_spec.AddField(post.FieldFavoriteCount, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.CommentCount(); ok {
+		_spec.SetField(post.FieldCommentCount, field.TypeInt, value)
 	}
-	if nodes := pu.mutation.RemovedUserIDs(); len(nodes) > 0 && !pu.mutation.UserCleared() {
+	if value, ok := pu.mutation.AddedCommentCount(); ok {
+		_spec.AddField(post.FieldCommentCount, field.TypeInt, value)
+	}
+	if pu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   post.UserTable,
 			Columns: []string{post.UserColumn},
 			Bidi:    false,
@@ -370,15 +441,12 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := pu.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   post.UserTable,
 			Columns: []string{post.UserColumn},
 			Bidi:    false,
@@ -571,6 +639,7 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(pu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{post.Label}
@@ -586,9 +655,10 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // PostUpdateOne is the builder for updating a single Post entity.
 type PostUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PostMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *PostMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUpdateTime sets the "update_time" field.
@@ -632,8 +702,8 @@ func (puo *PostUpdateOne) ClearContent() *PostUpdateOne {
 }
 
 // SetEmbedding sets the "embedding" field.
-func (puo *PostUpdateOne) SetEmbedding(b []byte) *PostUpdateOne {
-	puo.mutation.SetEmbedding(b)
+func (puo *PostUpdateOne) SetEmbedding(pg pgvector.Vector) *PostUpdateOne {
+	puo.mutation.SetEmbedding(pg)
 	return puo
 }
 
@@ -643,19 +713,92 @@ func (puo *PostUpdateOne) ClearEmbedding() *PostUpdateOne {
 	return puo
 }
 
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (puo *PostUpdateOne) AddUserIDs(ids ...int) *PostUpdateOne {
-	puo.mutation.AddUserIDs(ids...)
+// SetUserID sets the "user_id" field.
+func (puo *PostUpdateOne) SetUserID(i int) *PostUpdateOne {
+	puo.mutation.SetUserID(i)
 	return puo
 }
 
-// AddUser adds the "user" edges to the User entity.
-func (puo *PostUpdateOne) AddUser(u ...*User) *PostUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableUserID(i *int) *PostUpdateOne {
+	if i != nil {
+		puo.SetUserID(*i)
+	}
+	return puo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (puo *PostUpdateOne) ClearUserID() *PostUpdateOne {
+	puo.mutation.ClearUserID()
+	return puo
+}
+
+// SetLikeCount sets the "like_count" field.
+func (puo *PostUpdateOne) SetLikeCount(i int) *PostUpdateOne {
+	puo.mutation.ResetLikeCount()
+	puo.mutation.SetLikeCount(i)
+	return puo
+}
+
+// SetNillableLikeCount sets the "like_count" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableLikeCount(i *int) *PostUpdateOne {
+	if i != nil {
+		puo.SetLikeCount(*i)
+	}
+	return puo
+}
+
+// AddLikeCount adds i to the "like_count" field.
+func (puo *PostUpdateOne) AddLikeCount(i int) *PostUpdateOne {
+	puo.mutation.AddLikeCount(i)
+	return puo
+}
+
+// SetFavoriteCount sets the "favorite_count" field.
+func (puo *PostUpdateOne) SetFavoriteCount(i int) *PostUpdateOne {
+	puo.mutation.ResetFavoriteCount()
+	puo.mutation.SetFavoriteCount(i)
+	return puo
+}
+
+// SetNillableFavoriteCount sets the "favorite_count" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableFavoriteCount(i *int) *PostUpdateOne {
+	if i != nil {
+		puo.SetFavoriteCount(*i)
 	}
-	return puo.AddUserIDs(ids...)
+	return puo
+}
+
+// AddFavoriteCount adds i to the "favorite_count" field.
+func (puo *PostUpdateOne) AddFavoriteCount(i int) *PostUpdateOne {
+	puo.mutation.AddFavoriteCount(i)
+	return puo
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (puo *PostUpdateOne) SetCommentCount(i int) *PostUpdateOne {
+	puo.mutation.ResetCommentCount()
+	puo.mutation.SetCommentCount(i)
+	return puo
+}
+
+// SetNillableCommentCount sets the "comment_count" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableCommentCount(i *int) *PostUpdateOne {
+	if i != nil {
+		puo.SetCommentCount(*i)
+	}
+	return puo
+}
+
+// AddCommentCount adds i to the "comment_count" field.
+func (puo *PostUpdateOne) AddCommentCount(i int) *PostUpdateOne {
+	puo.mutation.AddCommentCount(i)
+	return puo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (puo *PostUpdateOne) SetUser(u *User) *PostUpdateOne {
+	return puo.SetUserID(u.ID)
 }
 
 // AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
@@ -723,27 +866,12 @@ func (puo *PostUpdateOne) Mutation() *PostMutation {
 	return puo.mutation
 }
 
-// ClearUser clears all "user" edges to the User entity.
+// ClearUser clears the "user" edge to the User entity.
 func (puo *PostUpdateOne) ClearUser() *PostUpdateOne {
 	puo.mutation.ClearUser()
 	return puo
 }
 
-// RemoveUserIDs removes the "user" edge to User entities by IDs.
-func (puo *PostUpdateOne) RemoveUserIDs(ids ...int) *PostUpdateOne {
-	puo.mutation.RemoveUserIDs(ids...)
-	return puo
-}
-
-// RemoveUser removes "user" edges to User entities.
-func (puo *PostUpdateOne) RemoveUser(u ...*User) *PostUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return puo.RemoveUserIDs(ids...)
-}
-
 // ClearComments clears all "comments" edges to the Comment entity.
 func (puo *PostUpdateOne) ClearComments() *PostUpdateOne {
 	puo.mutation.ClearComments()
@@ -887,6 +1015,12 @@ func (puo *PostUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (puo *PostUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PostUpdateOne {
+	puo.modifiers = append(puo.modifiers, modifiers...)
+	return puo
+}
+
 func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
 	if err := puo.check(); err != nil {
 		return _node, err
@@ -929,28 +1063,33 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 		_spec.ClearField(post.FieldContent, field.TypeString)
 	}
 	if value, ok := puo.mutation.Embedding(); ok {
-		_spec.SetField(post.FieldEmbedding, field.TypeBytes, value)
+		_spec.SetField(post.FieldEmbedding, field.TypeOther, value)
 	}
 	if puo.mutation.EmbeddingCleared() {
-		_spec.ClearField(post.FieldEmbedding, field.TypeBytes)
+		_spec.ClearField(post.FieldEmbedding, field.TypeOther)
 	}
-	if puo.mutation.UserCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   post.UserTable,
-			Columns: []string{post.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	if value, ok := puo.mutation.LikeCount(); ok {
+		_spec.SetField(post.FieldLikeCount, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedLikeCount(); ok {
+		_spec.AddField(post.FieldLikeCount, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.FavoriteCount(); ok {
+		_spec.SetField(post.FieldFavoriteCount, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedFavoriteCount(); ok {
+		_spec.AddField(post.FieldFavoriteCount, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.CommentCount(); ok {
+		_spec.SetField(post.FieldCommentCount, field.TypeInt, value)
 	}
-	if nodes := puo.mutation.RemovedUserIDs(); len(nodes) > 0 && !puo.mutation.UserCleared() {
+	if value, ok := puo.mutation.AddedCommentCount(); ok {
+		_spec.AddField(post.FieldCommentCount, field.TypeInt, value)
+	}
+	if puo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   post.UserTable,
 			Columns: []string{post.UserColumn},
 			Bidi:    false,
@@ -958,15 +1097,12 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := puo.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   post.UserTable,
 			Columns: []string{post.UserColumn},
 			Bidi:    false,
@@ -1159,6 +1295,7 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(puo.modifiers...)
 	_node = &Post{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -3,7 +3,9 @@
 package ent
 
 import (
+	"backend/internal/ent/post"
 	"backend/internal/ent/posttag"
+	"backend/internal/ent/tag"
 	"fmt"
 	"strings"
 
@@ -23,36 +25,38 @@ type PostTag struct {
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PostTagQuery when eager-loading is set.
 	Edges        PostTagEdges `json:"edges"`
-	post_tags    *int
-	tag_posts    *int
 	selectValues sql.SelectValues
 }
 
 // PostTagEdges holds the relations/edges for other nodes in the graph.
 type PostTagEdges struct {
 	// Post holds the value of the post edge.
-	Post []*Post `json:"post,omitempty"`
+	Post *Post `json:"post,omitempty"`
 	// Tag holds the value of the tag edge.
-	Tag []*Tag `json:"tag,omitempty"`
+	Tag *Tag `json:"tag,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [2]bool
 }
 
 // PostOrErr returns the Post value or an error if the edge
-// was not loaded in eager-loading.
-func (e PostTagEdges) PostOrErr() ([]*Post, error) {
-	if e.loadedTypes[0] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostTagEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
 		return e.Post, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: post.Label}
 	}
 	return nil, &NotLoadedError{edge: "post"}
 }
 
 // TagOrErr returns the Tag value or an error if the edge
-// was not loaded in eager-loading.
-func (e PostTagEdges) TagOrErr() ([]*Tag, error) {
-	if e.loadedTypes[1] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostTagEdges) TagOrErr() (*Tag, error) {
+	if e.Tag != nil {
 		return e.Tag, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: tag.Label}
 	}
 	return nil, &NotLoadedError{edge: "tag"}
 }
@@ -64,10 +68,6 @@ func (*PostTag) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case posttag.FieldID, posttag.FieldPostID, posttag.FieldTagID:
 			values[i] = new(sql.NullInt64)
-		case posttag.ForeignKeys[0]: // post_tags
-			values[i] = new(sql.NullInt64)
-		case posttag.ForeignKeys[1]: // tag_posts
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -101,20 +101,6 @@ func (pt *PostTag) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pt.TagID = int(value.Int64)
 			}
-		case posttag.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field post_tags", value)
-			} else if value.Valid {
-				pt.post_tags = new(int)
-				*pt.post_tags = int(value.Int64)
-			}
-		case posttag.ForeignKeys[1]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field tag_posts", value)
-			} else if value.Valid {
-				pt.tag_posts = new(int)
-				*pt.tag_posts = int(value.Int64)
-			}
 		default:
 			pt.selectValues.Set(columns[i], values[i])
 		}
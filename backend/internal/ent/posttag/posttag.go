@@ -3,6 +3,7 @@
 package posttag
 
 import (
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -23,19 +24,19 @@ const (
 	// Table holds the table name of the posttag in the database.
 	Table = "post_tags"
 	// PostTable is the table that holds the post relation/edge.
-	PostTable = "posts"
+	PostTable = "post_tags"
 	// PostInverseTable is the table name for the Post entity.
 	// It exists in this package in order to avoid circular dependency with the "post" package.
 	PostInverseTable = "posts"
 	// PostColumn is the table column denoting the post relation/edge.
-	PostColumn = "post_tag_post"
+	PostColumn = "post_id"
 	// TagTable is the table that holds the tag relation/edge.
-	TagTable = "tags"
+	TagTable = "post_tags"
 	// TagInverseTable is the table name for the Tag entity.
 	// It exists in this package in order to avoid circular dependency with the "tag" package.
 	TagInverseTable = "tags"
 	// TagColumn is the table column denoting the tag relation/edge.
-	TagColumn = "post_tag_tag"
+	TagColumn = "tag_id"
 )
 
 // Columns holds all SQL columns for posttag fields.
@@ -45,13 +46,6 @@ var Columns = []string{
 	FieldTagID,
 }
 
-// ForeignKeys holds the SQL foreign-keys that are owned by the "post_tags"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"post_tags",
-	"tag_posts",
-}
-
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -59,14 +53,18 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "backend/internal/ent/runtime"
+var (
+	Hooks [1]ent.Hook
+)
+
 // OrderOption defines the ordering options for the PostTag queries.
 type OrderOption func(*sql.Selector)
 
@@ -85,44 +83,30 @@ func ByTagID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTagID, opts...).ToFunc()
 }
 
-// ByPostCount orders the results by post count.
-func ByPostCount(opts ...sql.OrderTermOption) OrderOption {
-	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newPostStep(), opts...)
-	}
-}
-
-// ByPost orders the results by post terms.
-func ByPost(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
-	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newPostStep(), append([]sql.OrderTerm{term}, terms...)...)
-	}
-}
-
-// ByTagCount orders the results by tag count.
-func ByTagCount(opts ...sql.OrderTermOption) OrderOption {
+// ByPostField orders the results by post field.
+func ByPostField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newTagStep(), opts...)
+		sqlgraph.OrderByNeighborTerms(s, newPostStep(), sql.OrderByField(field, opts...))
 	}
 }
 
-// ByTag orders the results by tag terms.
-func ByTag(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+// ByTagField orders the results by tag field.
+func ByTagField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newTagStep(), append([]sql.OrderTerm{term}, terms...)...)
+		sqlgraph.OrderByNeighborTerms(s, newTagStep(), sql.OrderByField(field, opts...))
 	}
 }
 func newPostStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(PostInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, PostTable, PostColumn),
+		sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
 	)
 }
 func newTagStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(TagInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, TagTable, TagColumn),
+		sqlgraph.Edge(sqlgraph.M2O, true, TagTable, TagColumn),
 	)
 }
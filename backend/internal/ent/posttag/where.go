@@ -84,26 +84,6 @@ func PostIDNotIn(vs ...int) predicate.PostTag {
 	return predicate.PostTag(sql.FieldNotIn(FieldPostID, vs...))
 }
 
-// PostIDGT applies the GT predicate on the "post_id" field.
-func PostIDGT(v int) predicate.PostTag {
-	return predicate.PostTag(sql.FieldGT(FieldPostID, v))
-}
-
-// PostIDGTE applies the GTE predicate on the "post_id" field.
-func PostIDGTE(v int) predicate.PostTag {
-	return predicate.PostTag(sql.FieldGTE(FieldPostID, v))
-}
-
-// PostIDLT applies the LT predicate on the "post_id" field.
-func PostIDLT(v int) predicate.PostTag {
-	return predicate.PostTag(sql.FieldLT(FieldPostID, v))
-}
-
-// PostIDLTE applies the LTE predicate on the "post_id" field.
-func PostIDLTE(v int) predicate.PostTag {
-	return predicate.PostTag(sql.FieldLTE(FieldPostID, v))
-}
-
 // TagIDEQ applies the EQ predicate on the "tag_id" field.
 func TagIDEQ(v int) predicate.PostTag {
 	return predicate.PostTag(sql.FieldEQ(FieldTagID, v))
@@ -124,32 +104,12 @@ func TagIDNotIn(vs ...int) predicate.PostTag {
 	return predicate.PostTag(sql.FieldNotIn(FieldTagID, vs...))
 }
 
-// TagIDGT applies the GT predicate on the "tag_id" field.
-func TagIDGT(v int) predicate.PostTag {
-	return predicate.PostTag(sql.FieldGT(FieldTagID, v))
-}
-
-// TagIDGTE applies the GTE predicate on the "tag_id" field.
-func TagIDGTE(v int) predicate.PostTag {
-	return predicate.PostTag(sql.FieldGTE(FieldTagID, v))
-}
-
-// TagIDLT applies the LT predicate on the "tag_id" field.
-func TagIDLT(v int) predicate.PostTag {
-	return predicate.PostTag(sql.FieldLT(FieldTagID, v))
-}
-
-// TagIDLTE applies the LTE predicate on the "tag_id" field.
-func TagIDLTE(v int) predicate.PostTag {
-	return predicate.PostTag(sql.FieldLTE(FieldTagID, v))
-}
-
 // HasPost applies the HasEdge predicate on the "post" edge.
 func HasPost() predicate.PostTag {
 	return predicate.PostTag(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, PostTable, PostColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -172,7 +132,7 @@ func HasTag() predicate.PostTag {
 	return predicate.PostTag(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, TagTable, TagColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, TagTable, TagColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +20,7 @@ type PostTagCreate struct {
 	config
 	mutation *PostTagMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetPostID sets the "post_id" field.
@@ -33,34 +35,14 @@ func (ptc *PostTagCreate) SetTagID(i int) *PostTagCreate {
 	return ptc
 }
 
-// AddPostIDs adds the "post" edge to the Post entity by IDs.
-func (ptc *PostTagCreate) AddPostIDs(ids ...int) *PostTagCreate {
-	ptc.mutation.AddPostIDs(ids...)
-	return ptc
-}
-
-// AddPost adds the "post" edges to the Post entity.
-func (ptc *PostTagCreate) AddPost(p ...*Post) *PostTagCreate {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
-	}
-	return ptc.AddPostIDs(ids...)
-}
-
-// AddTagIDs adds the "tag" edge to the Tag entity by IDs.
-func (ptc *PostTagCreate) AddTagIDs(ids ...int) *PostTagCreate {
-	ptc.mutation.AddTagIDs(ids...)
-	return ptc
+// SetPost sets the "post" edge to the Post entity.
+func (ptc *PostTagCreate) SetPost(p *Post) *PostTagCreate {
+	return ptc.SetPostID(p.ID)
 }
 
-// AddTag adds the "tag" edges to the Tag entity.
-func (ptc *PostTagCreate) AddTag(t ...*Tag) *PostTagCreate {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
-	}
-	return ptc.AddTagIDs(ids...)
+// SetTag sets the "tag" edge to the Tag entity.
+func (ptc *PostTagCreate) SetTag(t *Tag) *PostTagCreate {
+	return ptc.SetTagID(t.ID)
 }
 
 // Mutation returns the PostTagMutation object of the builder.
@@ -103,6 +85,12 @@ func (ptc *PostTagCreate) check() error {
 	if _, ok := ptc.mutation.TagID(); !ok {
 		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "PostTag.tag_id"`)}
 	}
+	if _, ok := ptc.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "PostTag.post"`)}
+	}
+	if _, ok := ptc.mutation.TagID(); !ok {
+		return &ValidationError{Name: "tag", err: errors.New(`ent: missing required edge "PostTag.tag"`)}
+	}
 	return nil
 }
 
@@ -129,18 +117,11 @@ func (ptc *PostTagCreate) createSpec() (*PostTag, *sqlgraph.CreateSpec) {
 		_node = &PostTag{config: ptc.config}
 		_spec = sqlgraph.NewCreateSpec(posttag.Table, sqlgraph.NewFieldSpec(posttag.FieldID, field.TypeInt))
 	)
-	if value, ok := ptc.mutation.PostID(); ok {
-		_spec.SetField(posttag.FieldPostID, field.TypeInt, value)
-		_node.PostID = value
-	}
-	if value, ok := ptc.mutation.TagID(); ok {
-		_spec.SetField(posttag.FieldTagID, field.TypeInt, value)
-		_node.TagID = value
-	}
+	_spec.OnConflict = ptc.conflict
 	if nodes := ptc.mutation.PostIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   posttag.PostTable,
 			Columns: []string{posttag.PostColumn},
 			Bidi:    false,
@@ -151,12 +132,13 @@ func (ptc *PostTagCreate) createSpec() (*PostTag, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.PostID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := ptc.mutation.TagIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   posttag.TagTable,
 			Columns: []string{posttag.TagColumn},
 			Bidi:    false,
@@ -167,16 +149,192 @@ func (ptc *PostTagCreate) createSpec() (*PostTag, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.TagID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostTag.Create().
+//		SetPostID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostTagUpsert) {
+//			SetPostID(v+v).
+//		}).
+//		Exec(ctx)
+func (ptc *PostTagCreate) OnConflict(opts ...sql.ConflictOption) *PostTagUpsertOne {
+	ptc.conflict = opts
+	return &PostTagUpsertOne{
+		create: ptc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostTag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ptc *PostTagCreate) OnConflictColumns(columns ...string) *PostTagUpsertOne {
+	ptc.conflict = append(ptc.conflict, sql.ConflictColumns(columns...))
+	return &PostTagUpsertOne{
+		create: ptc,
+	}
+}
+
+type (
+	// PostTagUpsertOne is the builder for "upsert"-ing
+	//  one PostTag node.
+	PostTagUpsertOne struct {
+		create *PostTagCreate
+	}
+
+	// PostTagUpsert is the "OnConflict" setter.
+	PostTagUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPostID sets the "post_id" field.
+func (u *PostTagUpsert) SetPostID(v int) *PostTagUpsert {
+	u.Set(posttag.FieldPostID, v)
+	return u
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *PostTagUpsert) UpdatePostID() *PostTagUpsert {
+	u.SetExcluded(posttag.FieldPostID)
+	return u
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *PostTagUpsert) SetTagID(v int) *PostTagUpsert {
+	u.Set(posttag.FieldTagID, v)
+	return u
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *PostTagUpsert) UpdateTagID() *PostTagUpsert {
+	u.SetExcluded(posttag.FieldTagID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PostTag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PostTagUpsertOne) UpdateNewValues() *PostTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostTag.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PostTagUpsertOne) Ignore() *PostTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostTagUpsertOne) DoNothing() *PostTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostTagCreate.OnConflict
+// documentation for more info.
+func (u *PostTagUpsertOne) Update(set func(*PostTagUpsert)) *PostTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostTagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPostID sets the "post_id" field.
+func (u *PostTagUpsertOne) SetPostID(v int) *PostTagUpsertOne {
+	return u.Update(func(s *PostTagUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *PostTagUpsertOne) UpdatePostID() *PostTagUpsertOne {
+	return u.Update(func(s *PostTagUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *PostTagUpsertOne) SetTagID(v int) *PostTagUpsertOne {
+	return u.Update(func(s *PostTagUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *PostTagUpsertOne) UpdateTagID() *PostTagUpsertOne {
+	return u.Update(func(s *PostTagUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// Exec executes the query.
+func (u *PostTagUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostTagCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostTagUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PostTagUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PostTagUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PostTagCreateBulk is the builder for creating many PostTag entities in bulk.
 type PostTagCreateBulk struct {
 	config
 	err      error
 	builders []*PostTagCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the PostTag entities in the database.
@@ -205,6 +363,7 @@ func (ptcb *PostTagCreateBulk) Save(ctx context.Context) ([]*PostTag, error) {
 					_, err = mutators[i+1].Mutate(root, ptcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ptcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ptcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -258,3 +417,135 @@ func (ptcb *PostTagCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostTag.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostTagUpsert) {
+//			SetPostID(v+v).
+//		}).
+//		Exec(ctx)
+func (ptcb *PostTagCreateBulk) OnConflict(opts ...sql.ConflictOption) *PostTagUpsertBulk {
+	ptcb.conflict = opts
+	return &PostTagUpsertBulk{
+		create: ptcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostTag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ptcb *PostTagCreateBulk) OnConflictColumns(columns ...string) *PostTagUpsertBulk {
+	ptcb.conflict = append(ptcb.conflict, sql.ConflictColumns(columns...))
+	return &PostTagUpsertBulk{
+		create: ptcb,
+	}
+}
+
+// PostTagUpsertBulk is the builder for "upsert"-ing
+// a bulk of PostTag nodes.
+type PostTagUpsertBulk struct {
+	create *PostTagCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PostTag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PostTagUpsertBulk) UpdateNewValues() *PostTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostTag.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PostTagUpsertBulk) Ignore() *PostTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostTagUpsertBulk) DoNothing() *PostTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostTagCreateBulk.OnConflict
+// documentation for more info.
+func (u *PostTagUpsertBulk) Update(set func(*PostTagUpsert)) *PostTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostTagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPostID sets the "post_id" field.
+func (u *PostTagUpsertBulk) SetPostID(v int) *PostTagUpsertBulk {
+	return u.Update(func(s *PostTagUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *PostTagUpsertBulk) UpdatePostID() *PostTagUpsertBulk {
+	return u.Update(func(s *PostTagUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *PostTagUpsertBulk) SetTagID(v int) *PostTagUpsertBulk {
+	return u.Update(func(s *PostTagUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *PostTagUpsertBulk) UpdateTagID() *PostTagUpsertBulk {
+	return u.Update(func(s *PostTagUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// Exec executes the query.
+func (u *PostTagUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PostTagCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostTagCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostTagUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"backend/internal/ent/predicate"
 	"backend/internal/ent/tag"
 	"context"
-	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -26,7 +25,7 @@ type PostTagQuery struct {
 	predicates []predicate.PostTag
 	withPost   *PostQuery
 	withTag    *TagQuery
-	withFKs    bool
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -77,7 +76,7 @@ func (ptq *PostTagQuery) QueryPost() *PostQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(posttag.Table, posttag.FieldID, selector),
 			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, posttag.PostTable, posttag.PostColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, posttag.PostTable, posttag.PostColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(ptq.driver.Dialect(), step)
 		return fromU, nil
@@ -99,7 +98,7 @@ func (ptq *PostTagQuery) QueryTag() *TagQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(posttag.Table, posttag.FieldID, selector),
 			sqlgraph.To(tag.Table, tag.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, posttag.TagTable, posttag.TagColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, posttag.TagTable, posttag.TagColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(ptq.driver.Dialect(), step)
 		return fromU, nil
@@ -406,16 +405,12 @@ func (ptq *PostTagQuery) prepareQuery(ctx context.Context) error {
 func (ptq *PostTagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PostTag, error) {
 	var (
 		nodes       = []*PostTag{}
-		withFKs     = ptq.withFKs
 		_spec       = ptq.querySpec()
 		loadedTypes = [2]bool{
 			ptq.withPost != nil,
 			ptq.withTag != nil,
 		}
 	)
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, posttag.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*PostTag).scanValues(nil, columns)
 	}
@@ -425,6 +420,9 @@ func (ptq *PostTagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pos
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(ptq.modifiers) > 0 {
+		_spec.Modifiers = ptq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -435,16 +433,14 @@ func (ptq *PostTagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pos
 		return nodes, nil
 	}
 	if query := ptq.withPost; query != nil {
-		if err := ptq.loadPost(ctx, query, nodes,
-			func(n *PostTag) { n.Edges.Post = []*Post{} },
-			func(n *PostTag, e *Post) { n.Edges.Post = append(n.Edges.Post, e) }); err != nil {
+		if err := ptq.loadPost(ctx, query, nodes, nil,
+			func(n *PostTag, e *Post) { n.Edges.Post = e }); err != nil {
 			return nil, err
 		}
 	}
 	if query := ptq.withTag; query != nil {
-		if err := ptq.loadTag(ctx, query, nodes,
-			func(n *PostTag) { n.Edges.Tag = []*Tag{} },
-			func(n *PostTag, e *Tag) { n.Edges.Tag = append(n.Edges.Tag, e) }); err != nil {
+		if err := ptq.loadTag(ctx, query, nodes, nil,
+			func(n *PostTag, e *Tag) { n.Edges.Tag = e }); err != nil {
 			return nil, err
 		}
 	}
@@ -452,70 +448,69 @@ func (ptq *PostTagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pos
 }
 
 func (ptq *PostTagQuery) loadPost(ctx context.Context, query *PostQuery, nodes []*PostTag, init func(*PostTag), assign func(*PostTag, *Post)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*PostTag)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PostTag)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].PostID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
 	}
-	query.withFKs = true
-	query.Where(predicate.Post(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(posttag.PostColumn), fks...))
-	}))
+	query.Where(post.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.post_tag_post
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "post_tag_post" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "post_tag_post" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "post_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
 func (ptq *PostTagQuery) loadTag(ctx context.Context, query *TagQuery, nodes []*PostTag, init func(*PostTag), assign func(*PostTag, *Tag)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*PostTag)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PostTag)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].TagID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	query.withFKs = true
-	query.Where(predicate.Tag(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(posttag.TagColumn), fks...))
-	}))
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tag.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.post_tag_tag
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "post_tag_tag" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "post_tag_tag" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "tag_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
 
 func (ptq *PostTagQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ptq.querySpec()
+	if len(ptq.modifiers) > 0 {
+		_spec.Modifiers = ptq.modifiers
+	}
 	_spec.Node.Columns = ptq.ctx.Fields
 	if len(ptq.ctx.Fields) > 0 {
 		_spec.Unique = ptq.ctx.Unique != nil && *ptq.ctx.Unique
@@ -539,6 +534,12 @@ func (ptq *PostTagQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if ptq.withPost != nil {
+			_spec.Node.AddColumnOnce(posttag.FieldPostID)
+		}
+		if ptq.withTag != nil {
+			_spec.Node.AddColumnOnce(posttag.FieldTagID)
+		}
 	}
 	if ps := ptq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -578,6 +579,9 @@ func (ptq *PostTagQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if ptq.ctx.Unique != nil && *ptq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range ptq.modifiers {
+		m(selector)
+	}
 	for _, p := range ptq.predicates {
 		p(selector)
 	}
@@ -595,6 +599,12 @@ func (ptq *PostTagQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ptq *PostTagQuery) Modify(modifiers ...func(s *sql.Selector)) *PostTagSelect {
+	ptq.modifiers = append(ptq.modifiers, modifiers...)
+	return ptq.Select()
+}
+
 // PostTagGroupBy is the group-by builder for PostTag entities.
 type PostTagGroupBy struct {
 	selector
@@ -684,3 +694,9 @@ func (pts *PostTagSelect) sqlScan(ctx context.Context, root *PostTagQuery, v any
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pts *PostTagSelect) Modify(modifiers ...func(s *sql.Selector)) *PostTagSelect {
+	pts.modifiers = append(pts.modifiers, modifiers...)
+	return pts
+}
@@ -19,8 +19,9 @@ import (
 // PostTagUpdate is the builder for updating PostTag entities.
 type PostTagUpdate struct {
 	config
-	hooks    []Hook
-	mutation *PostTagMutation
+	hooks     []Hook
+	mutation  *PostTagMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the PostTagUpdate builder.
@@ -31,7 +32,6 @@ func (ptu *PostTagUpdate) Where(ps ...predicate.PostTag) *PostTagUpdate {
 
 // SetPostID sets the "post_id" field.
 func (ptu *PostTagUpdate) SetPostID(i int) *PostTagUpdate {
-	ptu.mutation.ResetPostID()
 	ptu.mutation.SetPostID(i)
 	return ptu
 }
@@ -44,15 +44,8 @@ func (ptu *PostTagUpdate) SetNillablePostID(i *int) *PostTagUpdate {
 	return ptu
 }
 
-// AddPostID adds i to the "post_id" field.
-func (ptu *PostTagUpdate) AddPostID(i int) *PostTagUpdate {
-	ptu.mutation.AddPostID(i)
-	return ptu
-}
-
 // SetTagID sets the "tag_id" field.
 func (ptu *PostTagUpdate) SetTagID(i int) *PostTagUpdate {
-	ptu.mutation.ResetTagID()
 	ptu.mutation.SetTagID(i)
 	return ptu
 }
@@ -65,40 +58,14 @@ func (ptu *PostTagUpdate) SetNillableTagID(i *int) *PostTagUpdate {
 	return ptu
 }
 
-// AddTagID adds i to the "tag_id" field.
-func (ptu *PostTagUpdate) AddTagID(i int) *PostTagUpdate {
-	ptu.mutation.AddTagID(i)
-	return ptu
-}
-
-// AddPostIDs adds the "post" edge to the Post entity by IDs.
-func (ptu *PostTagUpdate) AddPostIDs(ids ...int) *PostTagUpdate {
-	ptu.mutation.AddPostIDs(ids...)
-	return ptu
-}
-
-// AddPost adds the "post" edges to the Post entity.
-func (ptu *PostTagUpdate) AddPost(p ...*Post) *PostTagUpdate {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
-	}
-	return ptu.AddPostIDs(ids...)
-}
-
-// AddTagIDs adds the "tag" edge to the Tag entity by IDs.
-func (ptu *PostTagUpdate) AddTagIDs(ids ...int) *PostTagUpdate {
-	ptu.mutation.AddTagIDs(ids...)
-	return ptu
+// SetPost sets the "post" edge to the Post entity.
+func (ptu *PostTagUpdate) SetPost(p *Post) *PostTagUpdate {
+	return ptu.SetPostID(p.ID)
 }
 
-// AddTag adds the "tag" edges to the Tag entity.
-func (ptu *PostTagUpdate) AddTag(t ...*Tag) *PostTagUpdate {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
-	}
-	return ptu.AddTagIDs(ids...)
+// SetTag sets the "tag" edge to the Tag entity.
+func (ptu *PostTagUpdate) SetTag(t *Tag) *PostTagUpdate {
+	return ptu.SetTagID(t.ID)
 }
 
 // Mutation returns the PostTagMutation object of the builder.
@@ -106,48 +73,18 @@ func (ptu *PostTagUpdate) Mutation() *PostTagMutation {
 	return ptu.mutation
 }
 
-// ClearPost clears all "post" edges to the Post entity.
+// ClearPost clears the "post" edge to the Post entity.
 func (ptu *PostTagUpdate) ClearPost() *PostTagUpdate {
 	ptu.mutation.ClearPost()
 	return ptu
 }
 
-// RemovePostIDs removes the "post" edge to Post entities by IDs.
-func (ptu *PostTagUpdate) RemovePostIDs(ids ...int) *PostTagUpdate {
-	ptu.mutation.RemovePostIDs(ids...)
-	return ptu
-}
-
-// RemovePost removes "post" edges to Post entities.
-func (ptu *PostTagUpdate) RemovePost(p ...*Post) *PostTagUpdate {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
-	}
-	return ptu.RemovePostIDs(ids...)
-}
-
-// ClearTag clears all "tag" edges to the Tag entity.
+// ClearTag clears the "tag" edge to the Tag entity.
 func (ptu *PostTagUpdate) ClearTag() *PostTagUpdate {
 	ptu.mutation.ClearTag()
 	return ptu
 }
 
-// RemoveTagIDs removes the "tag" edge to Tag entities by IDs.
-func (ptu *PostTagUpdate) RemoveTagIDs(ids ...int) *PostTagUpdate {
-	ptu.mutation.RemoveTagIDs(ids...)
-	return ptu
-}
-
-// RemoveTag removes "tag" edges to Tag entities.
-func (ptu *PostTagUpdate) RemoveTag(t ...*Tag) *PostTagUpdate {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
-	}
-	return ptu.RemoveTagIDs(ids...)
-}
-
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ptu *PostTagUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, ptu.sqlSave, ptu.mutation, ptu.hooks)
@@ -175,7 +112,27 @@ func (ptu *PostTagUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ptu *PostTagUpdate) check() error {
+	if _, ok := ptu.mutation.PostID(); ptu.mutation.PostCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PostTag.post"`)
+	}
+	if _, ok := ptu.mutation.TagID(); ptu.mutation.TagCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PostTag.tag"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ptu *PostTagUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PostTagUpdate {
+	ptu.modifiers = append(ptu.modifiers, modifiers...)
+	return ptu
+}
+
 func (ptu *PostTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ptu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(posttag.Table, posttag.Columns, sqlgraph.NewFieldSpec(posttag.FieldID, field.TypeInt))
 	if ps := ptu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -184,35 +141,10 @@ func (ptu *PostTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
-	if value, ok := ptu.mutation.PostID(); ok {
-		_spec.SetField(posttag.FieldPostID, field.TypeInt, value)
-	}
-	if value, ok := ptu.mutation.AddedPostID(); ok {
-		_spec.AddField(posttag.FieldPostID, field.TypeInt, value)
-	}
-	if value, ok := ptu.mutation.TagID(); ok {
-		_spec.SetField(posttag.FieldTagID, field.TypeInt, value)
-	}
-	if value, ok := ptu.mutation.AddedTagID(); ok {
-		_spec.AddField(posttag.FieldTagID, field.TypeInt, value)
-	}
 	if ptu.mutation.PostCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   posttag.PostTable,
-			Columns: []string{posttag.PostColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ptu.mutation.RemovedPostIDs(); len(nodes) > 0 && !ptu.mutation.PostCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   posttag.PostTable,
 			Columns: []string{posttag.PostColumn},
 			Bidi:    false,
@@ -220,15 +152,12 @@ func (ptu *PostTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ptu.mutation.PostIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   posttag.PostTable,
 			Columns: []string{posttag.PostColumn},
 			Bidi:    false,
@@ -243,21 +172,8 @@ func (ptu *PostTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if ptu.mutation.TagCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   posttag.TagTable,
-			Columns: []string{posttag.TagColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ptu.mutation.RemovedTagIDs(); len(nodes) > 0 && !ptu.mutation.TagCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   posttag.TagTable,
 			Columns: []string{posttag.TagColumn},
 			Bidi:    false,
@@ -265,15 +181,12 @@ func (ptu *PostTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ptu.mutation.TagIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   posttag.TagTable,
 			Columns: []string{posttag.TagColumn},
 			Bidi:    false,
@@ -286,6 +199,7 @@ func (ptu *PostTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(ptu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, ptu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{posttag.Label}
@@ -301,14 +215,14 @@ func (ptu *PostTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // PostTagUpdateOne is the builder for updating a single PostTag entity.
 type PostTagUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *PostTagMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *PostTagMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetPostID sets the "post_id" field.
 func (ptuo *PostTagUpdateOne) SetPostID(i int) *PostTagUpdateOne {
-	ptuo.mutation.ResetPostID()
 	ptuo.mutation.SetPostID(i)
 	return ptuo
 }
@@ -321,15 +235,8 @@ func (ptuo *PostTagUpdateOne) SetNillablePostID(i *int) *PostTagUpdateOne {
 	return ptuo
 }
 
-// AddPostID adds i to the "post_id" field.
-func (ptuo *PostTagUpdateOne) AddPostID(i int) *PostTagUpdateOne {
-	ptuo.mutation.AddPostID(i)
-	return ptuo
-}
-
 // SetTagID sets the "tag_id" field.
 func (ptuo *PostTagUpdateOne) SetTagID(i int) *PostTagUpdateOne {
-	ptuo.mutation.ResetTagID()
 	ptuo.mutation.SetTagID(i)
 	return ptuo
 }
@@ -342,40 +249,14 @@ func (ptuo *PostTagUpdateOne) SetNillableTagID(i *int) *PostTagUpdateOne {
 	return ptuo
 }
 
-// AddTagID adds i to the "tag_id" field.
-func (ptuo *PostTagUpdateOne) AddTagID(i int) *PostTagUpdateOne {
-	ptuo.mutation.AddTagID(i)
-	return ptuo
-}
-
-// AddPostIDs adds the "post" edge to the Post entity by IDs.
-func (ptuo *PostTagUpdateOne) AddPostIDs(ids ...int) *PostTagUpdateOne {
-	ptuo.mutation.AddPostIDs(ids...)
-	return ptuo
-}
-
-// AddPost adds the "post" edges to the Post entity.
-func (ptuo *PostTagUpdateOne) AddPost(p ...*Post) *PostTagUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
-	}
-	return ptuo.AddPostIDs(ids...)
-}
-
-// AddTagIDs adds the "tag" edge to the Tag entity by IDs.
-func (ptuo *PostTagUpdateOne) AddTagIDs(ids ...int) *PostTagUpdateOne {
-	ptuo.mutation.AddTagIDs(ids...)
-	return ptuo
+// SetPost sets the "post" edge to the Post entity.
+func (ptuo *PostTagUpdateOne) SetPost(p *Post) *PostTagUpdateOne {
+	return ptuo.SetPostID(p.ID)
 }
 
-// AddTag adds the "tag" edges to the Tag entity.
-func (ptuo *PostTagUpdateOne) AddTag(t ...*Tag) *PostTagUpdateOne {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
-	}
-	return ptuo.AddTagIDs(ids...)
+// SetTag sets the "tag" edge to the Tag entity.
+func (ptuo *PostTagUpdateOne) SetTag(t *Tag) *PostTagUpdateOne {
+	return ptuo.SetTagID(t.ID)
 }
 
 // Mutation returns the PostTagMutation object of the builder.
@@ -383,48 +264,18 @@ func (ptuo *PostTagUpdateOne) Mutation() *PostTagMutation {
 	return ptuo.mutation
 }
 
-// ClearPost clears all "post" edges to the Post entity.
+// ClearPost clears the "post" edge to the Post entity.
 func (ptuo *PostTagUpdateOne) ClearPost() *PostTagUpdateOne {
 	ptuo.mutation.ClearPost()
 	return ptuo
 }
 
-// RemovePostIDs removes the "post" edge to Post entities by IDs.
-func (ptuo *PostTagUpdateOne) RemovePostIDs(ids ...int) *PostTagUpdateOne {
-	ptuo.mutation.RemovePostIDs(ids...)
-	return ptuo
-}
-
-// RemovePost removes "post" edges to Post entities.
-func (ptuo *PostTagUpdateOne) RemovePost(p ...*Post) *PostTagUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
-	}
-	return ptuo.RemovePostIDs(ids...)
-}
-
-// ClearTag clears all "tag" edges to the Tag entity.
+// ClearTag clears the "tag" edge to the Tag entity.
 func (ptuo *PostTagUpdateOne) ClearTag() *PostTagUpdateOne {
 	ptuo.mutation.ClearTag()
 	return ptuo
 }
 
-// RemoveTagIDs removes the "tag" edge to Tag entities by IDs.
-func (ptuo *PostTagUpdateOne) RemoveTagIDs(ids ...int) *PostTagUpdateOne {
-	ptuo.mutation.RemoveTagIDs(ids...)
-	return ptuo
-}
-
-// RemoveTag removes "tag" edges to Tag entities.
-func (ptuo *PostTagUpdateOne) RemoveTag(t ...*Tag) *PostTagUpdateOne {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
-	}
-	return ptuo.RemoveTagIDs(ids...)
-}
-
 // Where appends a list predicates to the PostTagUpdate builder.
 func (ptuo *PostTagUpdateOne) Where(ps ...predicate.PostTag) *PostTagUpdateOne {
 	ptuo.mutation.Where(ps...)
@@ -465,7 +316,27 @@ func (ptuo *PostTagUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ptuo *PostTagUpdateOne) check() error {
+	if _, ok := ptuo.mutation.PostID(); ptuo.mutation.PostCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PostTag.post"`)
+	}
+	if _, ok := ptuo.mutation.TagID(); ptuo.mutation.TagCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PostTag.tag"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ptuo *PostTagUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PostTagUpdateOne {
+	ptuo.modifiers = append(ptuo.modifiers, modifiers...)
+	return ptuo
+}
+
 func (ptuo *PostTagUpdateOne) sqlSave(ctx context.Context) (_node *PostTag, err error) {
+	if err := ptuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(posttag.Table, posttag.Columns, sqlgraph.NewFieldSpec(posttag.FieldID, field.TypeInt))
 	id, ok := ptuo.mutation.ID()
 	if !ok {
@@ -491,35 +362,10 @@ func (ptuo *PostTagUpdateOne) sqlSave(ctx context.Context) (_node *PostTag, err
 			}
 		}
 	}
-	if value, ok := ptuo.mutation.PostID(); ok {
-		_spec.SetField(posttag.FieldPostID, field.TypeInt, value)
-	}
-	if value, ok := ptuo.mutation.AddedPostID(); ok {
-		_spec.AddField(posttag.FieldPostID, field.TypeInt, value)
-	}
-	if value, ok := ptuo.mutation.TagID(); ok {
-		_spec.SetField(posttag.FieldTagID, field.TypeInt, value)
-	}
-	if value, ok := ptuo.mutation.AddedTagID(); ok {
-		_spec.AddField(posttag.FieldTagID, field.TypeInt, value)
-	}
 	if ptuo.mutation.PostCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   posttag.PostTable,
-			Columns: []string{posttag.PostColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ptuo.mutation.RemovedPostIDs(); len(nodes) > 0 && !ptuo.mutation.PostCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   posttag.PostTable,
 			Columns: []string{posttag.PostColumn},
 			Bidi:    false,
@@ -527,15 +373,12 @@ func (ptuo *PostTagUpdateOne) sqlSave(ctx context.Context) (_node *PostTag, err
 				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ptuo.mutation.PostIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   posttag.PostTable,
 			Columns: []string{posttag.PostColumn},
 			Bidi:    false,
@@ -550,21 +393,8 @@ func (ptuo *PostTagUpdateOne) sqlSave(ctx context.Context) (_node *PostTag, err
 	}
 	if ptuo.mutation.TagCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   posttag.TagTable,
-			Columns: []string{posttag.TagColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ptuo.mutation.RemovedTagIDs(); len(nodes) > 0 && !ptuo.mutation.TagCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   posttag.TagTable,
 			Columns: []string{posttag.TagColumn},
 			Bidi:    false,
@@ -572,15 +402,12 @@ func (ptuo *PostTagUpdateOne) sqlSave(ctx context.Context) (_node *PostTag, err
 				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ptuo.mutation.TagIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   posttag.TagTable,
 			Columns: []string{posttag.TagColumn},
 			Bidi:    false,
@@ -593,6 +420,7 @@ func (ptuo *PostTagUpdateOne) sqlSave(ctx context.Context) (_node *PostTag, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(ptuo.modifiers...)
 	_node = &PostTag{config: ptuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -6,12 +6,21 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Answer is the predicate function for answer builders.
+type Answer func(*sql.Selector)
+
+// AnswerVote is the predicate function for answervote builders.
+type AnswerVote func(*sql.Selector)
+
 // Comment is the predicate function for comment builders.
 type Comment func(*sql.Selector)
 
 // Follow is the predicate function for follow builders.
 type Follow func(*sql.Selector)
 
+// LoginAttempt is the predicate function for loginattempt builders.
+type LoginAttempt func(*sql.Selector)
+
 // Post is the predicate function for post builders.
 type Post func(*sql.Selector)
 
@@ -24,9 +33,18 @@ type Question func(*sql.Selector)
 // QuestionTag is the predicate function for questiontag builders.
 type QuestionTag func(*sql.Selector)
 
+// RefreshToken is the predicate function for refreshtoken builders.
+type RefreshToken func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
 // Tag is the predicate function for tag builders.
 type Tag func(*sql.Selector)
 
+// TagAlias is the predicate function for tagalias builders.
+type TagAlias func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
@@ -41,3 +59,6 @@ type UserLikePost func(*sql.Selector)
 
 // UserLikeQuestion is the predicate function for userlikequestion builders.
 type UserLikeQuestion func(*sql.Selector)
+
+// VerificationCode is the predicate function for verificationcode builders.
+type VerificationCode func(*sql.Selector)
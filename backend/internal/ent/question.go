@@ -3,7 +3,10 @@
 package ent
 
 import (
+	"backend/internal/ent/answer"
 	"backend/internal/ent/question"
+	"backend/internal/ent/user"
+	"backend/internal/pgvector"
 	"fmt"
 	"strings"
 	"time"
@@ -23,41 +26,56 @@ type Question struct {
 	UpdateTime time.Time `json:"update_time,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
 	// Embedding holds the value of the "embedding" field.
-	Embedding []byte `json:"embedding,omitempty"`
+	Embedding pgvector.Vector `json:"embedding,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// AcceptedAnswerID holds the value of the "accepted_answer_id" field.
+	AcceptedAnswerID *int `json:"accepted_answer_id,omitempty"`
+	// LikeCount holds the value of the "like_count" field.
+	LikeCount int `json:"like_count,omitempty"`
+	// FavoriteCount holds the value of the "favorite_count" field.
+	FavoriteCount int `json:"favorite_count,omitempty"`
+	// CommentCount holds the value of the "comment_count" field.
+	CommentCount int `json:"comment_count,omitempty"`
+	// AnswerCount holds the value of the "answer_count" field.
+	AnswerCount int `json:"answer_count,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the QuestionQuery when eager-loading is set.
-	Edges                           QuestionEdges `json:"edges"`
-	comment_question                *int
-	question_tag_question           *int
-	user_questions                  *int
-	user_favorite_question_question *int
-	user_like_question_question     *int
-	selectValues                    sql.SelectValues
+	Edges        QuestionEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // QuestionEdges holds the relations/edges for other nodes in the graph.
 type QuestionEdges struct {
 	// User holds the value of the user edge.
-	User []*User `json:"user,omitempty"`
+	User *User `json:"user,omitempty"`
 	// Comments holds the value of the comments edge.
 	Comments []*Comment `json:"comments,omitempty"`
+	// Answers holds the value of the answers edge.
+	Answers []*Answer `json:"answers,omitempty"`
 	// Tags holds the value of the tags edge.
 	Tags []*QuestionTag `json:"tags,omitempty"`
 	// Likes holds the value of the likes edge.
 	Likes []*UserLikeQuestion `json:"likes,omitempty"`
 	// Favorites holds the value of the favorites edge.
 	Favorites []*UserFavoriteQuestion `json:"favorites,omitempty"`
+	// AcceptedAnswer holds the value of the accepted_answer edge.
+	AcceptedAnswer *Answer `json:"accepted_answer,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [7]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
-// was not loaded in eager-loading.
-func (e QuestionEdges) UserOrErr() ([]*User, error) {
-	if e.loadedTypes[0] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
 		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "user"}
 }
@@ -71,10 +89,19 @@ func (e QuestionEdges) CommentsOrErr() ([]*Comment, error) {
 	return nil, &NotLoadedError{edge: "comments"}
 }
 
+// AnswersOrErr returns the Answers value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuestionEdges) AnswersOrErr() ([]*Answer, error) {
+	if e.loadedTypes[2] {
+		return e.Answers, nil
+	}
+	return nil, &NotLoadedError{edge: "answers"}
+}
+
 // TagsOrErr returns the Tags value or an error if the edge
 // was not loaded in eager-loading.
 func (e QuestionEdges) TagsOrErr() ([]*QuestionTag, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.Tags, nil
 	}
 	return nil, &NotLoadedError{edge: "tags"}
@@ -83,7 +110,7 @@ func (e QuestionEdges) TagsOrErr() ([]*QuestionTag, error) {
 // LikesOrErr returns the Likes value or an error if the edge
 // was not loaded in eager-loading.
 func (e QuestionEdges) LikesOrErr() ([]*UserLikeQuestion, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.Likes, nil
 	}
 	return nil, &NotLoadedError{edge: "likes"}
@@ -92,35 +119,36 @@ func (e QuestionEdges) LikesOrErr() ([]*UserLikeQuestion, error) {
 // FavoritesOrErr returns the Favorites value or an error if the edge
 // was not loaded in eager-loading.
 func (e QuestionEdges) FavoritesOrErr() ([]*UserFavoriteQuestion, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.Favorites, nil
 	}
 	return nil, &NotLoadedError{edge: "favorites"}
 }
 
+// AcceptedAnswerOrErr returns the AcceptedAnswer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionEdges) AcceptedAnswerOrErr() (*Answer, error) {
+	if e.AcceptedAnswer != nil {
+		return e.AcceptedAnswer, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: answer.Label}
+	}
+	return nil, &NotLoadedError{edge: "accepted_answer"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Question) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
 		case question.FieldEmbedding:
-			values[i] = new([]byte)
-		case question.FieldID:
+			values[i] = new(pgvector.Vector)
+		case question.FieldID, question.FieldUserID, question.FieldAcceptedAnswerID, question.FieldLikeCount, question.FieldFavoriteCount, question.FieldCommentCount, question.FieldAnswerCount:
 			values[i] = new(sql.NullInt64)
-		case question.FieldTitle:
+		case question.FieldTitle, question.FieldBody:
 			values[i] = new(sql.NullString)
 		case question.FieldCreateTime, question.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
-		case question.ForeignKeys[0]: // comment_question
-			values[i] = new(sql.NullInt64)
-		case question.ForeignKeys[1]: // question_tag_question
-			values[i] = new(sql.NullInt64)
-		case question.ForeignKeys[2]: // user_questions
-			values[i] = new(sql.NullInt64)
-		case question.ForeignKeys[3]: // user_favorite_question_question
-			values[i] = new(sql.NullInt64)
-		case question.ForeignKeys[4]: // user_like_question_question
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -160,46 +188,54 @@ func (q *Question) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				q.Title = value.String
 			}
+		case question.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				q.Body = value.String
+			}
 		case question.FieldEmbedding:
-			if value, ok := values[i].(*[]byte); !ok {
+			if value, ok := values[i].(*pgvector.Vector); !ok {
 				return fmt.Errorf("unexpected type %T for field embedding", values[i])
 			} else if value != nil {
 				q.Embedding = *value
 			}
-		case question.ForeignKeys[0]:
+		case question.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				q.UserID = int(value.Int64)
+			}
+		case question.FieldAcceptedAnswerID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field comment_question", value)
+				return fmt.Errorf("unexpected type %T for field accepted_answer_id", values[i])
 			} else if value.Valid {
-				q.comment_question = new(int)
-				*q.comment_question = int(value.Int64)
+				q.AcceptedAnswerID = new(int)
+				*q.AcceptedAnswerID = int(value.Int64)
 			}
-		case question.ForeignKeys[1]:
+		case question.FieldLikeCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field question_tag_question", value)
+				return fmt.Errorf("unexpected type %T for field like_count", values[i])
 			} else if value.Valid {
-				q.question_tag_question = new(int)
-				*q.question_tag_question = int(value.Int64)
+				q.LikeCount = int(value.Int64)
 			}
-		case question.ForeignKeys[2]:
+		case question.FieldFavoriteCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_questions", value)
+				return fmt.Errorf("unexpected type %T for field favorite_count", values[i])
 			} else if value.Valid {
-				q.user_questions = new(int)
-				*q.user_questions = int(value.Int64)
+				q.FavoriteCount = int(value.Int64)
 			}
-		case question.ForeignKeys[3]:
+		case question.FieldCommentCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_favorite_question_question", value)
+				return fmt.Errorf("unexpected type %T for field comment_count", values[i])
 			} else if value.Valid {
-				q.user_favorite_question_question = new(int)
-				*q.user_favorite_question_question = int(value.Int64)
+				q.CommentCount = int(value.Int64)
 			}
-		case question.ForeignKeys[4]:
+		case question.FieldAnswerCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_like_question_question", value)
+				return fmt.Errorf("unexpected type %T for field answer_count", values[i])
 			} else if value.Valid {
-				q.user_like_question_question = new(int)
-				*q.user_like_question_question = int(value.Int64)
+				q.AnswerCount = int(value.Int64)
 			}
 		default:
 			q.selectValues.Set(columns[i], values[i])
@@ -224,6 +260,11 @@ func (q *Question) QueryComments() *CommentQuery {
 	return NewQuestionClient(q.config).QueryComments(q)
 }
 
+// QueryAnswers queries the "answers" edge of the Question entity.
+func (q *Question) QueryAnswers() *AnswerQuery {
+	return NewQuestionClient(q.config).QueryAnswers(q)
+}
+
 // QueryTags queries the "tags" edge of the Question entity.
 func (q *Question) QueryTags() *QuestionTagQuery {
 	return NewQuestionClient(q.config).QueryTags(q)
@@ -239,6 +280,11 @@ func (q *Question) QueryFavorites() *UserFavoriteQuestionQuery {
 	return NewQuestionClient(q.config).QueryFavorites(q)
 }
 
+// QueryAcceptedAnswer queries the "accepted_answer" edge of the Question entity.
+func (q *Question) QueryAcceptedAnswer() *AnswerQuery {
+	return NewQuestionClient(q.config).QueryAcceptedAnswer(q)
+}
+
 // Update returns a builder for updating this Question.
 // Note that you need to call Question.Unwrap() before calling this method if this Question
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -271,8 +317,31 @@ func (q *Question) String() string {
 	builder.WriteString("title=")
 	builder.WriteString(q.Title)
 	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(q.Body)
+	builder.WriteString(", ")
 	builder.WriteString("embedding=")
 	builder.WriteString(fmt.Sprintf("%v", q.Embedding))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", q.UserID))
+	builder.WriteString(", ")
+	if v := q.AcceptedAnswerID; v != nil {
+		builder.WriteString("accepted_answer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("like_count=")
+	builder.WriteString(fmt.Sprintf("%v", q.LikeCount))
+	builder.WriteString(", ")
+	builder.WriteString("favorite_count=")
+	builder.WriteString(fmt.Sprintf("%v", q.FavoriteCount))
+	builder.WriteString(", ")
+	builder.WriteString("comment_count=")
+	builder.WriteString(fmt.Sprintf("%v", q.CommentCount))
+	builder.WriteString(", ")
+	builder.WriteString("answer_count=")
+	builder.WriteString(fmt.Sprintf("%v", q.AnswerCount))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -20,55 +20,87 @@ const (
 	FieldUpdateTime = "update_time"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
+	// FieldBody holds the string denoting the body field in the database.
+	FieldBody = "body"
 	// FieldEmbedding holds the string denoting the embedding field in the database.
 	FieldEmbedding = "embedding"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAcceptedAnswerID holds the string denoting the accepted_answer_id field in the database.
+	FieldAcceptedAnswerID = "accepted_answer_id"
+	// FieldLikeCount holds the string denoting the like_count field in the database.
+	FieldLikeCount = "like_count"
+	// FieldFavoriteCount holds the string denoting the favorite_count field in the database.
+	FieldFavoriteCount = "favorite_count"
+	// FieldCommentCount holds the string denoting the comment_count field in the database.
+	FieldCommentCount = "comment_count"
+	// FieldAnswerCount holds the string denoting the answer_count field in the database.
+	FieldAnswerCount = "answer_count"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeComments holds the string denoting the comments edge name in mutations.
 	EdgeComments = "comments"
+	// EdgeAnswers holds the string denoting the answers edge name in mutations.
+	EdgeAnswers = "answers"
 	// EdgeTags holds the string denoting the tags edge name in mutations.
 	EdgeTags = "tags"
 	// EdgeLikes holds the string denoting the likes edge name in mutations.
 	EdgeLikes = "likes"
 	// EdgeFavorites holds the string denoting the favorites edge name in mutations.
 	EdgeFavorites = "favorites"
+	// EdgeAcceptedAnswer holds the string denoting the accepted_answer edge name in mutations.
+	EdgeAcceptedAnswer = "accepted_answer"
 	// Table holds the table name of the question in the database.
 	Table = "questions"
 	// UserTable is the table that holds the user relation/edge.
-	UserTable = "users"
+	UserTable = "questions"
 	// UserInverseTable is the table name for the User entity.
 	// It exists in this package in order to avoid circular dependency with the "user" package.
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
-	UserColumn = "question_user"
+	UserColumn = "user_id"
 	// CommentsTable is the table that holds the comments relation/edge.
 	CommentsTable = "comments"
 	// CommentsInverseTable is the table name for the Comment entity.
 	// It exists in this package in order to avoid circular dependency with the "comment" package.
 	CommentsInverseTable = "comments"
 	// CommentsColumn is the table column denoting the comments relation/edge.
-	CommentsColumn = "question_comments"
+	CommentsColumn = "question_id"
+	// AnswersTable is the table that holds the answers relation/edge.
+	AnswersTable = "answers"
+	// AnswersInverseTable is the table name for the Answer entity.
+	// It exists in this package in order to avoid circular dependency with the "answer" package.
+	AnswersInverseTable = "answers"
+	// AnswersColumn is the table column denoting the answers relation/edge.
+	AnswersColumn = "question_id"
 	// TagsTable is the table that holds the tags relation/edge.
 	TagsTable = "question_tags"
 	// TagsInverseTable is the table name for the QuestionTag entity.
 	// It exists in this package in order to avoid circular dependency with the "questiontag" package.
 	TagsInverseTable = "question_tags"
 	// TagsColumn is the table column denoting the tags relation/edge.
-	TagsColumn = "question_tags"
+	TagsColumn = "question_id"
 	// LikesTable is the table that holds the likes relation/edge.
 	LikesTable = "user_like_questions"
 	// LikesInverseTable is the table name for the UserLikeQuestion entity.
 	// It exists in this package in order to avoid circular dependency with the "userlikequestion" package.
 	LikesInverseTable = "user_like_questions"
 	// LikesColumn is the table column denoting the likes relation/edge.
-	LikesColumn = "question_likes"
+	LikesColumn = "question_id"
 	// FavoritesTable is the table that holds the favorites relation/edge.
 	FavoritesTable = "user_favorite_questions"
 	// FavoritesInverseTable is the table name for the UserFavoriteQuestion entity.
 	// It exists in this package in order to avoid circular dependency with the "userfavoritequestion" package.
 	FavoritesInverseTable = "user_favorite_questions"
 	// FavoritesColumn is the table column denoting the favorites relation/edge.
-	FavoritesColumn = "question_favorites"
+	FavoritesColumn = "question_id"
+	// AcceptedAnswerTable is the table that holds the accepted_answer relation/edge.
+	AcceptedAnswerTable = "questions"
+	// AcceptedAnswerInverseTable is the table name for the Answer entity.
+	// It exists in this package in order to avoid circular dependency with the "answer" package.
+	AcceptedAnswerInverseTable = "answers"
+	// AcceptedAnswerColumn is the table column denoting the accepted_answer relation/edge.
+	AcceptedAnswerColumn = "accepted_answer_id"
 )
 
 // Columns holds all SQL columns for question fields.
@@ -77,17 +109,14 @@ var Columns = []string{
 	FieldCreateTime,
 	FieldUpdateTime,
 	FieldTitle,
+	FieldBody,
 	FieldEmbedding,
-}
-
-// ForeignKeys holds the SQL foreign-keys that are owned by the "questions"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"comment_question",
-	"question_tag_question",
-	"user_questions",
-	"user_favorite_question_question",
-	"user_like_question_question",
+	FieldUserID,
+	FieldAcceptedAnswerID,
+	FieldLikeCount,
+	FieldFavoriteCount,
+	FieldCommentCount,
+	FieldAnswerCount,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -97,11 +126,6 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
@@ -114,6 +138,14 @@ var (
 	UpdateDefaultUpdateTime func() time.Time
 	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	TitleValidator func(string) error
+	// DefaultLikeCount holds the default value on creation for the "like_count" field.
+	DefaultLikeCount int
+	// DefaultFavoriteCount holds the default value on creation for the "favorite_count" field.
+	DefaultFavoriteCount int
+	// DefaultCommentCount holds the default value on creation for the "comment_count" field.
+	DefaultCommentCount int
+	// DefaultAnswerCount holds the default value on creation for the "answer_count" field.
+	DefaultAnswerCount int
 )
 
 // OrderOption defines the ordering options for the Question queries.
@@ -139,17 +171,50 @@ func ByTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTitle, opts...).ToFunc()
 }
 
-// ByUserCount orders the results by user count.
-func ByUserCount(opts ...sql.OrderTermOption) OrderOption {
-	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newUserStep(), opts...)
-	}
+// ByBody orders the results by the body field.
+func ByBody(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBody, opts...).ToFunc()
+}
+
+// ByEmbedding orders the results by the embedding field.
+func ByEmbedding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbedding, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByAcceptedAnswerID orders the results by the accepted_answer_id field.
+func ByAcceptedAnswerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcceptedAnswerID, opts...).ToFunc()
+}
+
+// ByLikeCount orders the results by the like_count field.
+func ByLikeCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLikeCount, opts...).ToFunc()
+}
+
+// ByFavoriteCount orders the results by the favorite_count field.
+func ByFavoriteCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFavoriteCount, opts...).ToFunc()
+}
+
+// ByCommentCount orders the results by the comment_count field.
+func ByCommentCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommentCount, opts...).ToFunc()
+}
+
+// ByAnswerCount orders the results by the answer_count field.
+func ByAnswerCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswerCount, opts...).ToFunc()
 }
 
-// ByUser orders the results by user terms.
-func ByUser(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newUserStep(), append([]sql.OrderTerm{term}, terms...)...)
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
 
@@ -167,6 +232,20 @@ func ByComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByAnswersCount orders the results by answers count.
+func ByAnswersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAnswersStep(), opts...)
+	}
+}
+
+// ByAnswers orders the results by answers terms.
+func ByAnswers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAnswersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByTagsCount orders the results by tags count.
 func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -208,11 +287,18 @@ func ByFavorites(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newFavoritesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAcceptedAnswerField orders the results by accepted_answer field.
+func ByAcceptedAnswerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAcceptedAnswerStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(UserInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, UserTable, UserColumn),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
 func newCommentsStep() *sqlgraph.Step {
@@ -222,6 +308,13 @@ func newCommentsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
 	)
 }
+func newAnswersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AnswersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AnswersTable, AnswersColumn),
+	)
+}
 func newTagsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -243,3 +336,10 @@ func newFavoritesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, FavoritesTable, FavoritesColumn),
 	)
 }
+func newAcceptedAnswerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AcceptedAnswerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, AcceptedAnswerTable, AcceptedAnswerColumn),
+	)
+}
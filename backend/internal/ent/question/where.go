@@ -4,6 +4,7 @@ package question
 
 import (
 	"backend/internal/ent/predicate"
+	"backend/internal/pgvector"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -70,11 +71,46 @@ func Title(v string) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldTitle, v))
 }
 
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldBody, v))
+}
+
 // Embedding applies equality check predicate on the "embedding" field. It's identical to EmbeddingEQ.
-func Embedding(v []byte) predicate.Question {
+func Embedding(v pgvector.Vector) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldEmbedding, v))
 }
 
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldUserID, v))
+}
+
+// AcceptedAnswerID applies equality check predicate on the "accepted_answer_id" field. It's identical to AcceptedAnswerIDEQ.
+func AcceptedAnswerID(v int) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldAcceptedAnswerID, v))
+}
+
+// LikeCount applies equality check predicate on the "like_count" field. It's identical to LikeCountEQ.
+func LikeCount(v int) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldLikeCount, v))
+}
+
+// FavoriteCount applies equality check predicate on the "favorite_count" field. It's identical to FavoriteCountEQ.
+func FavoriteCount(v int) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldFavoriteCount, v))
+}
+
+// CommentCount applies equality check predicate on the "comment_count" field. It's identical to CommentCountEQ.
+func CommentCount(v int) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldCommentCount, v))
+}
+
+// AnswerCount applies equality check predicate on the "answer_count" field. It's identical to AnswerCountEQ.
+func AnswerCount(v int) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldAnswerCount, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldCreateTime, v))
@@ -220,43 +256,118 @@ func TitleContainsFold(v string) predicate.Question {
 	return predicate.Question(sql.FieldContainsFold(FieldTitle, v))
 }
 
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.Question {
+	return predicate.Question(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.Question {
+	return predicate.Question(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.Question {
+	return predicate.Question(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyIsNil applies the IsNil predicate on the "body" field.
+func BodyIsNil() predicate.Question {
+	return predicate.Question(sql.FieldIsNull(FieldBody))
+}
+
+// BodyNotNil applies the NotNil predicate on the "body" field.
+func BodyNotNil() predicate.Question {
+	return predicate.Question(sql.FieldNotNull(FieldBody))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.Question {
+	return predicate.Question(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.Question {
+	return predicate.Question(sql.FieldContainsFold(FieldBody, v))
+}
+
 // EmbeddingEQ applies the EQ predicate on the "embedding" field.
-func EmbeddingEQ(v []byte) predicate.Question {
+func EmbeddingEQ(v pgvector.Vector) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldEmbedding, v))
 }
 
 // EmbeddingNEQ applies the NEQ predicate on the "embedding" field.
-func EmbeddingNEQ(v []byte) predicate.Question {
+func EmbeddingNEQ(v pgvector.Vector) predicate.Question {
 	return predicate.Question(sql.FieldNEQ(FieldEmbedding, v))
 }
 
 // EmbeddingIn applies the In predicate on the "embedding" field.
-func EmbeddingIn(vs ...[]byte) predicate.Question {
+func EmbeddingIn(vs ...pgvector.Vector) predicate.Question {
 	return predicate.Question(sql.FieldIn(FieldEmbedding, vs...))
 }
 
 // EmbeddingNotIn applies the NotIn predicate on the "embedding" field.
-func EmbeddingNotIn(vs ...[]byte) predicate.Question {
+func EmbeddingNotIn(vs ...pgvector.Vector) predicate.Question {
 	return predicate.Question(sql.FieldNotIn(FieldEmbedding, vs...))
 }
 
 // EmbeddingGT applies the GT predicate on the "embedding" field.
-func EmbeddingGT(v []byte) predicate.Question {
+func EmbeddingGT(v pgvector.Vector) predicate.Question {
 	return predicate.Question(sql.FieldGT(FieldEmbedding, v))
 }
 
 // EmbeddingGTE applies the GTE predicate on the "embedding" field.
-func EmbeddingGTE(v []byte) predicate.Question {
+func EmbeddingGTE(v pgvector.Vector) predicate.Question {
 	return predicate.Question(sql.FieldGTE(FieldEmbedding, v))
 }
 
 // EmbeddingLT applies the LT predicate on the "embedding" field.
-func EmbeddingLT(v []byte) predicate.Question {
+func EmbeddingLT(v pgvector.Vector) predicate.Question {
 	return predicate.Question(sql.FieldLT(FieldEmbedding, v))
 }
 
 // EmbeddingLTE applies the LTE predicate on the "embedding" field.
-func EmbeddingLTE(v []byte) predicate.Question {
+func EmbeddingLTE(v pgvector.Vector) predicate.Question {
 	return predicate.Question(sql.FieldLTE(FieldEmbedding, v))
 }
 
@@ -270,12 +381,232 @@ func EmbeddingNotNil() predicate.Question {
 	return predicate.Question(sql.FieldNotNull(FieldEmbedding))
 }
 
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.Question {
+	return predicate.Question(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.Question {
+	return predicate.Question(sql.FieldNotNull(FieldUserID))
+}
+
+// AcceptedAnswerIDEQ applies the EQ predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDEQ(v int) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldAcceptedAnswerID, v))
+}
+
+// AcceptedAnswerIDNEQ applies the NEQ predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDNEQ(v int) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldAcceptedAnswerID, v))
+}
+
+// AcceptedAnswerIDIn applies the In predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDIn(vs ...int) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldAcceptedAnswerID, vs...))
+}
+
+// AcceptedAnswerIDNotIn applies the NotIn predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDNotIn(vs ...int) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldAcceptedAnswerID, vs...))
+}
+
+// AcceptedAnswerIDIsNil applies the IsNil predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDIsNil() predicate.Question {
+	return predicate.Question(sql.FieldIsNull(FieldAcceptedAnswerID))
+}
+
+// AcceptedAnswerIDNotNil applies the NotNil predicate on the "accepted_answer_id" field.
+func AcceptedAnswerIDNotNil() predicate.Question {
+	return predicate.Question(sql.FieldNotNull(FieldAcceptedAnswerID))
+}
+
+// LikeCountEQ applies the EQ predicate on the "like_count" field.
+func LikeCountEQ(v int) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldLikeCount, v))
+}
+
+// LikeCountNEQ applies the NEQ predicate on the "like_count" field.
+func LikeCountNEQ(v int) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldLikeCount, v))
+}
+
+// LikeCountIn applies the In predicate on the "like_count" field.
+func LikeCountIn(vs ...int) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldLikeCount, vs...))
+}
+
+// LikeCountNotIn applies the NotIn predicate on the "like_count" field.
+func LikeCountNotIn(vs ...int) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldLikeCount, vs...))
+}
+
+// LikeCountGT applies the GT predicate on the "like_count" field.
+func LikeCountGT(v int) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldLikeCount, v))
+}
+
+// LikeCountGTE applies the GTE predicate on the "like_count" field.
+func LikeCountGTE(v int) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldLikeCount, v))
+}
+
+// LikeCountLT applies the LT predicate on the "like_count" field.
+func LikeCountLT(v int) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldLikeCount, v))
+}
+
+// LikeCountLTE applies the LTE predicate on the "like_count" field.
+func LikeCountLTE(v int) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldLikeCount, v))
+}
+
+// FavoriteCountEQ applies the EQ predicate on the "favorite_count" field.
+func FavoriteCountEQ(v int) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldFavoriteCount, v))
+}
+
+// FavoriteCountNEQ applies the NEQ predicate on the "favorite_count" field.
+func FavoriteCountNEQ(v int) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldFavoriteCount, v))
+}
+
+// FavoriteCountIn applies the In predicate on the "favorite_count" field.
+func FavoriteCountIn(vs ...int) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldFavoriteCount, vs...))
+}
+
+// FavoriteCountNotIn applies the NotIn predicate on the "favorite_count" field.
+func FavoriteCountNotIn(vs ...int) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldFavoriteCount, vs...))
+}
+
+// FavoriteCountGT applies the GT predicate on the "favorite_count" field.
+func FavoriteCountGT(v int) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldFavoriteCount, v))
+}
+
+// FavoriteCountGTE applies the GTE predicate on the "favorite_count" field.
+func FavoriteCountGTE(v int) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldFavoriteCount, v))
+}
+
+// FavoriteCountLT applies the LT predicate on the "favorite_count" field.
+func FavoriteCountLT(v int) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldFavoriteCount, v))
+}
+
+// FavoriteCountLTE applies the LTE predicate on the "favorite_count" field.
+func FavoriteCountLTE(v int) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldFavoriteCount, v))
+}
+
+// CommentCountEQ applies the EQ predicate on the "comment_count" field.
+func CommentCountEQ(v int) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldCommentCount, v))
+}
+
+// CommentCountNEQ applies the NEQ predicate on the "comment_count" field.
+func CommentCountNEQ(v int) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldCommentCount, v))
+}
+
+// CommentCountIn applies the In predicate on the "comment_count" field.
+func CommentCountIn(vs ...int) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldCommentCount, vs...))
+}
+
+// CommentCountNotIn applies the NotIn predicate on the "comment_count" field.
+func CommentCountNotIn(vs ...int) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldCommentCount, vs...))
+}
+
+// CommentCountGT applies the GT predicate on the "comment_count" field.
+func CommentCountGT(v int) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldCommentCount, v))
+}
+
+// CommentCountGTE applies the GTE predicate on the "comment_count" field.
+func CommentCountGTE(v int) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldCommentCount, v))
+}
+
+// CommentCountLT applies the LT predicate on the "comment_count" field.
+func CommentCountLT(v int) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldCommentCount, v))
+}
+
+// CommentCountLTE applies the LTE predicate on the "comment_count" field.
+func CommentCountLTE(v int) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldCommentCount, v))
+}
+
+// AnswerCountEQ applies the EQ predicate on the "answer_count" field.
+func AnswerCountEQ(v int) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldAnswerCount, v))
+}
+
+// AnswerCountNEQ applies the NEQ predicate on the "answer_count" field.
+func AnswerCountNEQ(v int) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldAnswerCount, v))
+}
+
+// AnswerCountIn applies the In predicate on the "answer_count" field.
+func AnswerCountIn(vs ...int) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldAnswerCount, vs...))
+}
+
+// AnswerCountNotIn applies the NotIn predicate on the "answer_count" field.
+func AnswerCountNotIn(vs ...int) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldAnswerCount, vs...))
+}
+
+// AnswerCountGT applies the GT predicate on the "answer_count" field.
+func AnswerCountGT(v int) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldAnswerCount, v))
+}
+
+// AnswerCountGTE applies the GTE predicate on the "answer_count" field.
+func AnswerCountGTE(v int) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldAnswerCount, v))
+}
+
+// AnswerCountLT applies the LT predicate on the "answer_count" field.
+func AnswerCountLT(v int) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldAnswerCount, v))
+}
+
+// AnswerCountLTE applies the LTE predicate on the "answer_count" field.
+func AnswerCountLTE(v int) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldAnswerCount, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Question {
 	return predicate.Question(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, UserTable, UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -316,6 +647,29 @@ func HasCommentsWith(preds ...predicate.Comment) predicate.Question {
 	})
 }
 
+// HasAnswers applies the HasEdge predicate on the "answers" edge.
+func HasAnswers() predicate.Question {
+	return predicate.Question(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AnswersTable, AnswersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAnswersWith applies the HasEdge predicate on the "answers" edge with a given conditions (other predicates).
+func HasAnswersWith(preds ...predicate.Answer) predicate.Question {
+	return predicate.Question(func(s *sql.Selector) {
+		step := newAnswersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasTags applies the HasEdge predicate on the "tags" edge.
 func HasTags() predicate.Question {
 	return predicate.Question(func(s *sql.Selector) {
@@ -385,6 +739,29 @@ func HasFavoritesWith(preds ...predicate.UserFavoriteQuestion) predicate.Questio
 	})
 }
 
+// HasAcceptedAnswer applies the HasEdge predicate on the "accepted_answer" edge.
+func HasAcceptedAnswer() predicate.Question {
+	return predicate.Question(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AcceptedAnswerTable, AcceptedAnswerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAcceptedAnswerWith applies the HasEdge predicate on the "accepted_answer" edge with a given conditions (other predicates).
+func HasAcceptedAnswerWith(preds ...predicate.Answer) predicate.Question {
+	return predicate.Question(func(s *sql.Selector) {
+		step := newAcceptedAnswerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Question) predicate.Question {
 	return predicate.Question(sql.AndPredicates(predicates...))
@@ -3,17 +3,20 @@
 package ent
 
 import (
+	"backend/internal/ent/answer"
 	"backend/internal/ent/comment"
 	"backend/internal/ent/question"
 	"backend/internal/ent/questiontag"
 	"backend/internal/ent/user"
 	"backend/internal/ent/userfavoritequestion"
 	"backend/internal/ent/userlikequestion"
+	"backend/internal/pgvector"
 	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -23,6 +26,7 @@ type QuestionCreate struct {
 	config
 	mutation *QuestionMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreateTime sets the "create_time" field.
@@ -59,25 +63,113 @@ func (qc *QuestionCreate) SetTitle(s string) *QuestionCreate {
 	return qc
 }
 
+// SetBody sets the "body" field.
+func (qc *QuestionCreate) SetBody(s string) *QuestionCreate {
+	qc.mutation.SetBody(s)
+	return qc
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (qc *QuestionCreate) SetNillableBody(s *string) *QuestionCreate {
+	if s != nil {
+		qc.SetBody(*s)
+	}
+	return qc
+}
+
 // SetEmbedding sets the "embedding" field.
-func (qc *QuestionCreate) SetEmbedding(b []byte) *QuestionCreate {
-	qc.mutation.SetEmbedding(b)
+func (qc *QuestionCreate) SetEmbedding(pg pgvector.Vector) *QuestionCreate {
+	qc.mutation.SetEmbedding(pg)
 	return qc
 }
 
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (qc *QuestionCreate) AddUserIDs(ids ...int) *QuestionCreate {
-	qc.mutation.AddUserIDs(ids...)
+// SetUserID sets the "user_id" field.
+func (qc *QuestionCreate) SetUserID(i int) *QuestionCreate {
+	qc.mutation.SetUserID(i)
 	return qc
 }
 
-// AddUser adds the "user" edges to the User entity.
-func (qc *QuestionCreate) AddUser(u ...*User) *QuestionCreate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (qc *QuestionCreate) SetNillableUserID(i *int) *QuestionCreate {
+	if i != nil {
+		qc.SetUserID(*i)
+	}
+	return qc
+}
+
+// SetAcceptedAnswerID sets the "accepted_answer_id" field.
+func (qc *QuestionCreate) SetAcceptedAnswerID(i int) *QuestionCreate {
+	qc.mutation.SetAcceptedAnswerID(i)
+	return qc
+}
+
+// SetNillableAcceptedAnswerID sets the "accepted_answer_id" field if the given value is not nil.
+func (qc *QuestionCreate) SetNillableAcceptedAnswerID(i *int) *QuestionCreate {
+	if i != nil {
+		qc.SetAcceptedAnswerID(*i)
 	}
-	return qc.AddUserIDs(ids...)
+	return qc
+}
+
+// SetLikeCount sets the "like_count" field.
+func (qc *QuestionCreate) SetLikeCount(i int) *QuestionCreate {
+	qc.mutation.SetLikeCount(i)
+	return qc
+}
+
+// SetNillableLikeCount sets the "like_count" field if the given value is not nil.
+func (qc *QuestionCreate) SetNillableLikeCount(i *int) *QuestionCreate {
+	if i != nil {
+		qc.SetLikeCount(*i)
+	}
+	return qc
+}
+
+// SetFavoriteCount sets the "favorite_count" field.
+func (qc *QuestionCreate) SetFavoriteCount(i int) *QuestionCreate {
+	qc.mutation.SetFavoriteCount(i)
+	return qc
+}
+
+// SetNillableFavoriteCount sets the "favorite_count" field if the given value is not nil.
+func (qc *QuestionCreate) SetNillableFavoriteCount(i *int) *QuestionCreate {
+	if i != nil {
+		qc.SetFavoriteCount(*i)
+	}
+	return qc
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (qc *QuestionCreate) SetCommentCount(i int) *QuestionCreate {
+	qc.mutation.SetCommentCount(i)
+	return qc
+}
+
+// SetNillableCommentCount sets the "comment_count" field if the given value is not nil.
+func (qc *QuestionCreate) SetNillableCommentCount(i *int) *QuestionCreate {
+	if i != nil {
+		qc.SetCommentCount(*i)
+	}
+	return qc
+}
+
+// SetAnswerCount sets the "answer_count" field.
+func (qc *QuestionCreate) SetAnswerCount(i int) *QuestionCreate {
+	qc.mutation.SetAnswerCount(i)
+	return qc
+}
+
+// SetNillableAnswerCount sets the "answer_count" field if the given value is not nil.
+func (qc *QuestionCreate) SetNillableAnswerCount(i *int) *QuestionCreate {
+	if i != nil {
+		qc.SetAnswerCount(*i)
+	}
+	return qc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (qc *QuestionCreate) SetUser(u *User) *QuestionCreate {
+	return qc.SetUserID(u.ID)
 }
 
 // AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
@@ -95,6 +187,21 @@ func (qc *QuestionCreate) AddComments(c ...*Comment) *QuestionCreate {
 	return qc.AddCommentIDs(ids...)
 }
 
+// AddAnswerIDs adds the "answers" edge to the Answer entity by IDs.
+func (qc *QuestionCreate) AddAnswerIDs(ids ...int) *QuestionCreate {
+	qc.mutation.AddAnswerIDs(ids...)
+	return qc
+}
+
+// AddAnswers adds the "answers" edges to the Answer entity.
+func (qc *QuestionCreate) AddAnswers(a ...*Answer) *QuestionCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return qc.AddAnswerIDs(ids...)
+}
+
 // AddTagIDs adds the "tags" edge to the QuestionTag entity by IDs.
 func (qc *QuestionCreate) AddTagIDs(ids ...int) *QuestionCreate {
 	qc.mutation.AddTagIDs(ids...)
@@ -140,6 +247,11 @@ func (qc *QuestionCreate) AddFavorites(u ...*UserFavoriteQuestion) *QuestionCrea
 	return qc.AddFavoriteIDs(ids...)
 }
 
+// SetAcceptedAnswer sets the "accepted_answer" edge to the Answer entity.
+func (qc *QuestionCreate) SetAcceptedAnswer(a *Answer) *QuestionCreate {
+	return qc.SetAcceptedAnswerID(a.ID)
+}
+
 // Mutation returns the QuestionMutation object of the builder.
 func (qc *QuestionCreate) Mutation() *QuestionMutation {
 	return qc.mutation
@@ -183,6 +295,22 @@ func (qc *QuestionCreate) defaults() {
 		v := question.DefaultUpdateTime()
 		qc.mutation.SetUpdateTime(v)
 	}
+	if _, ok := qc.mutation.LikeCount(); !ok {
+		v := question.DefaultLikeCount
+		qc.mutation.SetLikeCount(v)
+	}
+	if _, ok := qc.mutation.FavoriteCount(); !ok {
+		v := question.DefaultFavoriteCount
+		qc.mutation.SetFavoriteCount(v)
+	}
+	if _, ok := qc.mutation.CommentCount(); !ok {
+		v := question.DefaultCommentCount
+		qc.mutation.SetCommentCount(v)
+	}
+	if _, ok := qc.mutation.AnswerCount(); !ok {
+		v := question.DefaultAnswerCount
+		qc.mutation.SetAnswerCount(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -201,6 +329,18 @@ func (qc *QuestionCreate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Question.title": %w`, err)}
 		}
 	}
+	if _, ok := qc.mutation.LikeCount(); !ok {
+		return &ValidationError{Name: "like_count", err: errors.New(`ent: missing required field "Question.like_count"`)}
+	}
+	if _, ok := qc.mutation.FavoriteCount(); !ok {
+		return &ValidationError{Name: "favorite_count", err: errors.New(`ent: missing required field "Question.favorite_count"`)}
+	}
+	if _, ok := qc.mutation.CommentCount(); !ok {
+		return &ValidationError{Name: "comment_count", err: errors.New(`ent: missing required field "Question.comment_count"`)}
+	}
+	if _, ok := qc.mutation.AnswerCount(); !ok {
+		return &ValidationError{Name: "answer_count", err: errors.New(`ent: missing required field "Question.answer_count"`)}
+	}
 	return nil
 }
 
@@ -227,6 +367,7 @@ func (qc *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 		_node = &Question{config: qc.config}
 		_spec = sqlgraph.NewCreateSpec(question.Table, sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = qc.conflict
 	if value, ok := qc.mutation.CreateTime(); ok {
 		_spec.SetField(question.FieldCreateTime, field.TypeTime, value)
 		_node.CreateTime = value
@@ -239,14 +380,34 @@ func (qc *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 		_spec.SetField(question.FieldTitle, field.TypeString, value)
 		_node.Title = value
 	}
+	if value, ok := qc.mutation.Body(); ok {
+		_spec.SetField(question.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
 	if value, ok := qc.mutation.Embedding(); ok {
-		_spec.SetField(question.FieldEmbedding, field.TypeBytes, value)
+		_spec.SetField(question.FieldEmbedding, field.TypeOther, value)
 		_node.Embedding = value
 	}
+	if value, ok := qc.mutation.LikeCount(); ok {
+		_spec.SetField(question.FieldLikeCount, field.TypeInt, value)
+		_node.LikeCount = value
+	}
+	if value, ok := qc.mutation.FavoriteCount(); ok {
+		_spec.SetField(question.FieldFavoriteCount, field.TypeInt, value)
+		_node.FavoriteCount = value
+	}
+	if value, ok := qc.mutation.CommentCount(); ok {
+		_spec.SetField(question.FieldCommentCount, field.TypeInt, value)
+		_node.CommentCount = value
+	}
+	if value, ok := qc.mutation.AnswerCount(); ok {
+		_spec.SetField(question.FieldAnswerCount, field.TypeInt, value)
+		_node.AnswerCount = value
+	}
 	if nodes := qc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   question.UserTable,
 			Columns: []string{question.UserColumn},
 			Bidi:    false,
@@ -257,6 +418,7 @@ func (qc *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := qc.mutation.CommentsIDs(); len(nodes) > 0 {
@@ -275,6 +437,22 @@ func (qc *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := qc.mutation.AnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.AnswersTable,
+			Columns: []string{question.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := qc.mutation.TagsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -323,14 +501,523 @@ func (qc *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := qc.mutation.AcceptedAnswerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   question.AcceptedAnswerTable,
+			Columns: []string{question.AcceptedAnswerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AcceptedAnswerID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Question.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (qc *QuestionCreate) OnConflict(opts ...sql.ConflictOption) *QuestionUpsertOne {
+	qc.conflict = opts
+	return &QuestionUpsertOne{
+		create: qc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qc *QuestionCreate) OnConflictColumns(columns ...string) *QuestionUpsertOne {
+	qc.conflict = append(qc.conflict, sql.ConflictColumns(columns...))
+	return &QuestionUpsertOne{
+		create: qc,
+	}
+}
+
+type (
+	// QuestionUpsertOne is the builder for "upsert"-ing
+	//  one Question node.
+	QuestionUpsertOne struct {
+		create *QuestionCreate
+	}
+
+	// QuestionUpsert is the "OnConflict" setter.
+	QuestionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *QuestionUpsert) SetUpdateTime(v time.Time) *QuestionUpsert {
+	u.Set(question.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateUpdateTime() *QuestionUpsert {
+	u.SetExcluded(question.FieldUpdateTime)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *QuestionUpsert) SetTitle(v string) *QuestionUpsert {
+	u.Set(question.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateTitle() *QuestionUpsert {
+	u.SetExcluded(question.FieldTitle)
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *QuestionUpsert) SetBody(v string) *QuestionUpsert {
+	u.Set(question.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateBody() *QuestionUpsert {
+	u.SetExcluded(question.FieldBody)
+	return u
+}
+
+// ClearBody clears the value of the "body" field.
+func (u *QuestionUpsert) ClearBody() *QuestionUpsert {
+	u.SetNull(question.FieldBody)
+	return u
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *QuestionUpsert) SetEmbedding(v pgvector.Vector) *QuestionUpsert {
+	u.Set(question.FieldEmbedding, v)
+	return u
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateEmbedding() *QuestionUpsert {
+	u.SetExcluded(question.FieldEmbedding)
+	return u
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *QuestionUpsert) ClearEmbedding() *QuestionUpsert {
+	u.SetNull(question.FieldEmbedding)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *QuestionUpsert) SetUserID(v int) *QuestionUpsert {
+	u.Set(question.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateUserID() *QuestionUpsert {
+	u.SetExcluded(question.FieldUserID)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *QuestionUpsert) ClearUserID() *QuestionUpsert {
+	u.SetNull(question.FieldUserID)
+	return u
+}
+
+// SetAcceptedAnswerID sets the "accepted_answer_id" field.
+func (u *QuestionUpsert) SetAcceptedAnswerID(v int) *QuestionUpsert {
+	u.Set(question.FieldAcceptedAnswerID, v)
+	return u
+}
+
+// UpdateAcceptedAnswerID sets the "accepted_answer_id" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateAcceptedAnswerID() *QuestionUpsert {
+	u.SetExcluded(question.FieldAcceptedAnswerID)
+	return u
+}
+
+// ClearAcceptedAnswerID clears the value of the "accepted_answer_id" field.
+func (u *QuestionUpsert) ClearAcceptedAnswerID() *QuestionUpsert {
+	u.SetNull(question.FieldAcceptedAnswerID)
+	return u
+}
+
+// SetLikeCount sets the "like_count" field.
+func (u *QuestionUpsert) SetLikeCount(v int) *QuestionUpsert {
+	u.Set(question.FieldLikeCount, v)
+	return u
+}
+
+// UpdateLikeCount sets the "like_count" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateLikeCount() *QuestionUpsert {
+	u.SetExcluded(question.FieldLikeCount)
+	return u
+}
+
+// AddLikeCount adds v to the "like_count" field.
+func (u *QuestionUpsert) AddLikeCount(v int) *QuestionUpsert {
+	u.Add(question.FieldLikeCount, v)
+	return u
+}
+
+// SetFavoriteCount sets the "favorite_count" field.
+func (u *QuestionUpsert) SetFavoriteCount(v int) *QuestionUpsert {
+	u.Set(question.FieldFavoriteCount, v)
+	return u
+}
+
+// UpdateFavoriteCount sets the "favorite_count" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateFavoriteCount() *QuestionUpsert {
+	u.SetExcluded(question.FieldFavoriteCount)
+	return u
+}
+
+// AddFavoriteCount adds v to the "favorite_count" field.
+func (u *QuestionUpsert) AddFavoriteCount(v int) *QuestionUpsert {
+	u.Add(question.FieldFavoriteCount, v)
+	return u
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (u *QuestionUpsert) SetCommentCount(v int) *QuestionUpsert {
+	u.Set(question.FieldCommentCount, v)
+	return u
+}
+
+// UpdateCommentCount sets the "comment_count" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateCommentCount() *QuestionUpsert {
+	u.SetExcluded(question.FieldCommentCount)
+	return u
+}
+
+// AddCommentCount adds v to the "comment_count" field.
+func (u *QuestionUpsert) AddCommentCount(v int) *QuestionUpsert {
+	u.Add(question.FieldCommentCount, v)
+	return u
+}
+
+// SetAnswerCount sets the "answer_count" field.
+func (u *QuestionUpsert) SetAnswerCount(v int) *QuestionUpsert {
+	u.Set(question.FieldAnswerCount, v)
+	return u
+}
+
+// UpdateAnswerCount sets the "answer_count" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateAnswerCount() *QuestionUpsert {
+	u.SetExcluded(question.FieldAnswerCount)
+	return u
+}
+
+// AddAnswerCount adds v to the "answer_count" field.
+func (u *QuestionUpsert) AddAnswerCount(v int) *QuestionUpsert {
+	u.Add(question.FieldAnswerCount, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *QuestionUpsertOne) UpdateNewValues() *QuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(question.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *QuestionUpsertOne) Ignore() *QuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionUpsertOne) DoNothing() *QuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionCreate.OnConflict
+// documentation for more info.
+func (u *QuestionUpsertOne) Update(set func(*QuestionUpsert)) *QuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *QuestionUpsertOne) SetUpdateTime(v time.Time) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateUpdateTime() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *QuestionUpsertOne) SetTitle(v string) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateTitle() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *QuestionUpsertOne) SetBody(v string) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateBody() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// ClearBody clears the value of the "body" field.
+func (u *QuestionUpsertOne) ClearBody() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearBody()
+	})
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *QuestionUpsertOne) SetEmbedding(v pgvector.Vector) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateEmbedding() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *QuestionUpsertOne) ClearEmbedding() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearEmbedding()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *QuestionUpsertOne) SetUserID(v int) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateUserID() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *QuestionUpsertOne) ClearUserID() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetAcceptedAnswerID sets the "accepted_answer_id" field.
+func (u *QuestionUpsertOne) SetAcceptedAnswerID(v int) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetAcceptedAnswerID(v)
+	})
+}
+
+// UpdateAcceptedAnswerID sets the "accepted_answer_id" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateAcceptedAnswerID() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateAcceptedAnswerID()
+	})
+}
+
+// ClearAcceptedAnswerID clears the value of the "accepted_answer_id" field.
+func (u *QuestionUpsertOne) ClearAcceptedAnswerID() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearAcceptedAnswerID()
+	})
+}
+
+// SetLikeCount sets the "like_count" field.
+func (u *QuestionUpsertOne) SetLikeCount(v int) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetLikeCount(v)
+	})
+}
+
+// AddLikeCount adds v to the "like_count" field.
+func (u *QuestionUpsertOne) AddLikeCount(v int) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.AddLikeCount(v)
+	})
+}
+
+// UpdateLikeCount sets the "like_count" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateLikeCount() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateLikeCount()
+	})
+}
+
+// SetFavoriteCount sets the "favorite_count" field.
+func (u *QuestionUpsertOne) SetFavoriteCount(v int) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetFavoriteCount(v)
+	})
+}
+
+// AddFavoriteCount adds v to the "favorite_count" field.
+func (u *QuestionUpsertOne) AddFavoriteCount(v int) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.AddFavoriteCount(v)
+	})
+}
+
+// UpdateFavoriteCount sets the "favorite_count" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateFavoriteCount() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateFavoriteCount()
+	})
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (u *QuestionUpsertOne) SetCommentCount(v int) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetCommentCount(v)
+	})
+}
+
+// AddCommentCount adds v to the "comment_count" field.
+func (u *QuestionUpsertOne) AddCommentCount(v int) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.AddCommentCount(v)
+	})
+}
+
+// UpdateCommentCount sets the "comment_count" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateCommentCount() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateCommentCount()
+	})
+}
+
+// SetAnswerCount sets the "answer_count" field.
+func (u *QuestionUpsertOne) SetAnswerCount(v int) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetAnswerCount(v)
+	})
+}
+
+// AddAnswerCount adds v to the "answer_count" field.
+func (u *QuestionUpsertOne) AddAnswerCount(v int) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.AddAnswerCount(v)
+	})
+}
+
+// UpdateAnswerCount sets the "answer_count" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateAnswerCount() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateAnswerCount()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *QuestionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *QuestionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // QuestionCreateBulk is the builder for creating many Question entities in bulk.
 type QuestionCreateBulk struct {
 	config
 	err      error
 	builders []*QuestionCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Question entities in the database.
@@ -360,6 +1047,7 @@ func (qcb *QuestionCreateBulk) Save(ctx context.Context) ([]*Question, error) {
 					_, err = mutators[i+1].Mutate(root, qcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = qcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, qcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -413,3 +1101,310 @@ func (qcb *QuestionCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Question.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (qcb *QuestionCreateBulk) OnConflict(opts ...sql.ConflictOption) *QuestionUpsertBulk {
+	qcb.conflict = opts
+	return &QuestionUpsertBulk{
+		create: qcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qcb *QuestionCreateBulk) OnConflictColumns(columns ...string) *QuestionUpsertBulk {
+	qcb.conflict = append(qcb.conflict, sql.ConflictColumns(columns...))
+	return &QuestionUpsertBulk{
+		create: qcb,
+	}
+}
+
+// QuestionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Question nodes.
+type QuestionUpsertBulk struct {
+	create *QuestionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *QuestionUpsertBulk) UpdateNewValues() *QuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(question.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *QuestionUpsertBulk) Ignore() *QuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionUpsertBulk) DoNothing() *QuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionCreateBulk.OnConflict
+// documentation for more info.
+func (u *QuestionUpsertBulk) Update(set func(*QuestionUpsert)) *QuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *QuestionUpsertBulk) SetUpdateTime(v time.Time) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateUpdateTime() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *QuestionUpsertBulk) SetTitle(v string) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateTitle() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *QuestionUpsertBulk) SetBody(v string) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateBody() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// ClearBody clears the value of the "body" field.
+func (u *QuestionUpsertBulk) ClearBody() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearBody()
+	})
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *QuestionUpsertBulk) SetEmbedding(v pgvector.Vector) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateEmbedding() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *QuestionUpsertBulk) ClearEmbedding() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearEmbedding()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *QuestionUpsertBulk) SetUserID(v int) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateUserID() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *QuestionUpsertBulk) ClearUserID() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetAcceptedAnswerID sets the "accepted_answer_id" field.
+func (u *QuestionUpsertBulk) SetAcceptedAnswerID(v int) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetAcceptedAnswerID(v)
+	})
+}
+
+// UpdateAcceptedAnswerID sets the "accepted_answer_id" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateAcceptedAnswerID() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateAcceptedAnswerID()
+	})
+}
+
+// ClearAcceptedAnswerID clears the value of the "accepted_answer_id" field.
+func (u *QuestionUpsertBulk) ClearAcceptedAnswerID() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.ClearAcceptedAnswerID()
+	})
+}
+
+// SetLikeCount sets the "like_count" field.
+func (u *QuestionUpsertBulk) SetLikeCount(v int) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetLikeCount(v)
+	})
+}
+
+// AddLikeCount adds v to the "like_count" field.
+func (u *QuestionUpsertBulk) AddLikeCount(v int) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.AddLikeCount(v)
+	})
+}
+
+// UpdateLikeCount sets the "like_count" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateLikeCount() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateLikeCount()
+	})
+}
+
+// SetFavoriteCount sets the "favorite_count" field.
+func (u *QuestionUpsertBulk) SetFavoriteCount(v int) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetFavoriteCount(v)
+	})
+}
+
+// AddFavoriteCount adds v to the "favorite_count" field.
+func (u *QuestionUpsertBulk) AddFavoriteCount(v int) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.AddFavoriteCount(v)
+	})
+}
+
+// UpdateFavoriteCount sets the "favorite_count" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateFavoriteCount() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateFavoriteCount()
+	})
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (u *QuestionUpsertBulk) SetCommentCount(v int) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetCommentCount(v)
+	})
+}
+
+// AddCommentCount adds v to the "comment_count" field.
+func (u *QuestionUpsertBulk) AddCommentCount(v int) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.AddCommentCount(v)
+	})
+}
+
+// UpdateCommentCount sets the "comment_count" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateCommentCount() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateCommentCount()
+	})
+}
+
+// SetAnswerCount sets the "answer_count" field.
+func (u *QuestionUpsertBulk) SetAnswerCount(v int) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetAnswerCount(v)
+	})
+}
+
+// AddAnswerCount adds v to the "answer_count" field.
+func (u *QuestionUpsertBulk) AddAnswerCount(v int) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.AddAnswerCount(v)
+	})
+}
+
+// UpdateAnswerCount sets the "answer_count" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateAnswerCount() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateAnswerCount()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the QuestionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
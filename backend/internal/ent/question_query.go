@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"backend/internal/ent/answer"
 	"backend/internal/ent/comment"
 	"backend/internal/ent/predicate"
 	"backend/internal/ent/question"
@@ -23,16 +24,18 @@ import (
 // QuestionQuery is the builder for querying Question entities.
 type QuestionQuery struct {
 	config
-	ctx           *QueryContext
-	order         []question.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.Question
-	withUser      *UserQuery
-	withComments  *CommentQuery
-	withTags      *QuestionTagQuery
-	withLikes     *UserLikeQuestionQuery
-	withFavorites *UserFavoriteQuestionQuery
-	withFKs       bool
+	ctx                *QueryContext
+	order              []question.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Question
+	withUser           *UserQuery
+	withComments       *CommentQuery
+	withAnswers        *AnswerQuery
+	withTags           *QuestionTagQuery
+	withLikes          *UserLikeQuestionQuery
+	withFavorites      *UserFavoriteQuestionQuery
+	withAcceptedAnswer *AnswerQuery
+	modifiers          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,7 +86,7 @@ func (qq *QuestionQuery) QueryUser() *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(question.Table, question.FieldID, selector),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, question.UserTable, question.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, question.UserTable, question.UserColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(qq.driver.Dialect(), step)
 		return fromU, nil
@@ -113,6 +116,28 @@ func (qq *QuestionQuery) QueryComments() *CommentQuery {
 	return query
 }
 
+// QueryAnswers chains the current query on the "answers" edge.
+func (qq *QuestionQuery) QueryAnswers() *AnswerQuery {
+	query := (&AnswerClient{config: qq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(question.Table, question.FieldID, selector),
+			sqlgraph.To(answer.Table, answer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, question.AnswersTable, question.AnswersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryTags chains the current query on the "tags" edge.
 func (qq *QuestionQuery) QueryTags() *QuestionTagQuery {
 	query := (&QuestionTagClient{config: qq.config}).Query()
@@ -179,6 +204,28 @@ func (qq *QuestionQuery) QueryFavorites() *UserFavoriteQuestionQuery {
 	return query
 }
 
+// QueryAcceptedAnswer chains the current query on the "accepted_answer" edge.
+func (qq *QuestionQuery) QueryAcceptedAnswer() *AnswerQuery {
+	query := (&AnswerClient{config: qq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(question.Table, question.FieldID, selector),
+			sqlgraph.To(answer.Table, answer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, question.AcceptedAnswerTable, question.AcceptedAnswerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Question entity from the query.
 // Returns a *NotFoundError when no Question was found.
 func (qq *QuestionQuery) First(ctx context.Context) (*Question, error) {
@@ -366,16 +413,18 @@ func (qq *QuestionQuery) Clone() *QuestionQuery {
 		return nil
 	}
 	return &QuestionQuery{
-		config:        qq.config,
-		ctx:           qq.ctx.Clone(),
-		order:         append([]question.OrderOption{}, qq.order...),
-		inters:        append([]Interceptor{}, qq.inters...),
-		predicates:    append([]predicate.Question{}, qq.predicates...),
-		withUser:      qq.withUser.Clone(),
-		withComments:  qq.withComments.Clone(),
-		withTags:      qq.withTags.Clone(),
-		withLikes:     qq.withLikes.Clone(),
-		withFavorites: qq.withFavorites.Clone(),
+		config:             qq.config,
+		ctx:                qq.ctx.Clone(),
+		order:              append([]question.OrderOption{}, qq.order...),
+		inters:             append([]Interceptor{}, qq.inters...),
+		predicates:         append([]predicate.Question{}, qq.predicates...),
+		withUser:           qq.withUser.Clone(),
+		withComments:       qq.withComments.Clone(),
+		withAnswers:        qq.withAnswers.Clone(),
+		withTags:           qq.withTags.Clone(),
+		withLikes:          qq.withLikes.Clone(),
+		withFavorites:      qq.withFavorites.Clone(),
+		withAcceptedAnswer: qq.withAcceptedAnswer.Clone(),
 		// clone intermediate query.
 		sql:  qq.sql.Clone(),
 		path: qq.path,
@@ -404,6 +453,17 @@ func (qq *QuestionQuery) WithComments(opts ...func(*CommentQuery)) *QuestionQuer
 	return qq
 }
 
+// WithAnswers tells the query-builder to eager-load the nodes that are connected to
+// the "answers" edge. The optional arguments are used to configure the query builder of the edge.
+func (qq *QuestionQuery) WithAnswers(opts ...func(*AnswerQuery)) *QuestionQuery {
+	query := (&AnswerClient{config: qq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qq.withAnswers = query
+	return qq
+}
+
 // WithTags tells the query-builder to eager-load the nodes that are connected to
 // the "tags" edge. The optional arguments are used to configure the query builder of the edge.
 func (qq *QuestionQuery) WithTags(opts ...func(*QuestionTagQuery)) *QuestionQuery {
@@ -437,6 +497,17 @@ func (qq *QuestionQuery) WithFavorites(opts ...func(*UserFavoriteQuestionQuery))
 	return qq
 }
 
+// WithAcceptedAnswer tells the query-builder to eager-load the nodes that are connected to
+// the "accepted_answer" edge. The optional arguments are used to configure the query builder of the edge.
+func (qq *QuestionQuery) WithAcceptedAnswer(opts ...func(*AnswerQuery)) *QuestionQuery {
+	query := (&AnswerClient{config: qq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qq.withAcceptedAnswer = query
+	return qq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -514,19 +585,17 @@ func (qq *QuestionQuery) prepareQuery(ctx context.Context) error {
 func (qq *QuestionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Question, error) {
 	var (
 		nodes       = []*Question{}
-		withFKs     = qq.withFKs
 		_spec       = qq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [7]bool{
 			qq.withUser != nil,
 			qq.withComments != nil,
+			qq.withAnswers != nil,
 			qq.withTags != nil,
 			qq.withLikes != nil,
 			qq.withFavorites != nil,
+			qq.withAcceptedAnswer != nil,
 		}
 	)
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, question.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Question).scanValues(nil, columns)
 	}
@@ -536,6 +605,9 @@ func (qq *QuestionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Que
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(qq.modifiers) > 0 {
+		_spec.Modifiers = qq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -546,9 +618,8 @@ func (qq *QuestionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Que
 		return nodes, nil
 	}
 	if query := qq.withUser; query != nil {
-		if err := qq.loadUser(ctx, query, nodes,
-			func(n *Question) { n.Edges.User = []*User{} },
-			func(n *Question, e *User) { n.Edges.User = append(n.Edges.User, e) }); err != nil {
+		if err := qq.loadUser(ctx, query, nodes, nil,
+			func(n *Question, e *User) { n.Edges.User = e }); err != nil {
 			return nil, err
 		}
 	}
@@ -559,6 +630,13 @@ func (qq *QuestionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Que
 			return nil, err
 		}
 	}
+	if query := qq.withAnswers; query != nil {
+		if err := qq.loadAnswers(ctx, query, nodes,
+			func(n *Question) { n.Edges.Answers = []*Answer{} },
+			func(n *Question, e *Answer) { n.Edges.Answers = append(n.Edges.Answers, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := qq.withTags; query != nil {
 		if err := qq.loadTags(ctx, query, nodes,
 			func(n *Question) { n.Edges.Tags = []*QuestionTag{} },
@@ -580,10 +658,45 @@ func (qq *QuestionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Que
 			return nil, err
 		}
 	}
+	if query := qq.withAcceptedAnswer; query != nil {
+		if err := qq.loadAcceptedAnswer(ctx, query, nodes, nil,
+			func(n *Question, e *Answer) { n.Edges.AcceptedAnswer = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
 func (qq *QuestionQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Question, init func(*Question), assign func(*Question, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Question)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (qq *QuestionQuery) loadComments(ctx context.Context, query *CommentQuery, nodes []*Question, init func(*Question), assign func(*Question, *Comment)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Question)
 	for i := range nodes {
@@ -593,28 +706,27 @@ func (qq *QuestionQuery) loadUser(ctx context.Context, query *UserQuery, nodes [
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.User(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(question.UserColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(comment.FieldQuestionID)
+	}
+	query.Where(predicate.Comment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(question.CommentsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.question_user
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "question_user" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.QuestionID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "question_user" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "question_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (qq *QuestionQuery) loadComments(ctx context.Context, query *CommentQuery, nodes []*Question, init func(*Question), assign func(*Question, *Comment)) error {
+func (qq *QuestionQuery) loadAnswers(ctx context.Context, query *AnswerQuery, nodes []*Question, init func(*Question), assign func(*Question, *Answer)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Question)
 	for i := range nodes {
@@ -624,22 +736,21 @@ func (qq *QuestionQuery) loadComments(ctx context.Context, query *CommentQuery,
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.Comment(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(question.CommentsColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(answer.FieldQuestionID)
+	}
+	query.Where(predicate.Answer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(question.AnswersColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.question_comments
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "question_comments" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.QuestionID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "question_comments" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "question_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -655,7 +766,9 @@ func (qq *QuestionQuery) loadTags(ctx context.Context, query *QuestionTagQuery,
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(questiontag.FieldQuestionID)
+	}
 	query.Where(predicate.QuestionTag(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(question.TagsColumn), fks...))
 	}))
@@ -664,13 +777,10 @@ func (qq *QuestionQuery) loadTags(ctx context.Context, query *QuestionTagQuery,
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.question_tags
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "question_tags" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.QuestionID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "question_tags" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "question_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -686,7 +796,9 @@ func (qq *QuestionQuery) loadLikes(ctx context.Context, query *UserLikeQuestionQ
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(userlikequestion.FieldQuestionID)
+	}
 	query.Where(predicate.UserLikeQuestion(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(question.LikesColumn), fks...))
 	}))
@@ -695,13 +807,10 @@ func (qq *QuestionQuery) loadLikes(ctx context.Context, query *UserLikeQuestionQ
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.question_likes
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "question_likes" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.QuestionID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "question_likes" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "question_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -717,7 +826,9 @@ func (qq *QuestionQuery) loadFavorites(ctx context.Context, query *UserFavoriteQ
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(userfavoritequestion.FieldQuestionID)
+	}
 	query.Where(predicate.UserFavoriteQuestion(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(question.FavoritesColumn), fks...))
 	}))
@@ -726,21 +837,53 @@ func (qq *QuestionQuery) loadFavorites(ctx context.Context, query *UserFavoriteQ
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.question_favorites
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "question_favorites" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.QuestionID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "question_favorites" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "question_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
+func (qq *QuestionQuery) loadAcceptedAnswer(ctx context.Context, query *AnswerQuery, nodes []*Question, init func(*Question), assign func(*Question, *Answer)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Question)
+	for i := range nodes {
+		if nodes[i].AcceptedAnswerID == nil {
+			continue
+		}
+		fk := *nodes[i].AcceptedAnswerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(answer.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "accepted_answer_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (qq *QuestionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := qq.querySpec()
+	if len(qq.modifiers) > 0 {
+		_spec.Modifiers = qq.modifiers
+	}
 	_spec.Node.Columns = qq.ctx.Fields
 	if len(qq.ctx.Fields) > 0 {
 		_spec.Unique = qq.ctx.Unique != nil && *qq.ctx.Unique
@@ -764,6 +907,12 @@ func (qq *QuestionQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if qq.withUser != nil {
+			_spec.Node.AddColumnOnce(question.FieldUserID)
+		}
+		if qq.withAcceptedAnswer != nil {
+			_spec.Node.AddColumnOnce(question.FieldAcceptedAnswerID)
+		}
 	}
 	if ps := qq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -803,6 +952,9 @@ func (qq *QuestionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if qq.ctx.Unique != nil && *qq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range qq.modifiers {
+		m(selector)
+	}
 	for _, p := range qq.predicates {
 		p(selector)
 	}
@@ -820,6 +972,12 @@ func (qq *QuestionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (qq *QuestionQuery) Modify(modifiers ...func(s *sql.Selector)) *QuestionSelect {
+	qq.modifiers = append(qq.modifiers, modifiers...)
+	return qq.Select()
+}
+
 // QuestionGroupBy is the group-by builder for Question entities.
 type QuestionGroupBy struct {
 	selector
@@ -909,3 +1067,9 @@ func (qs *QuestionSelect) sqlScan(ctx context.Context, root *QuestionQuery, v an
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (qs *QuestionSelect) Modify(modifiers ...func(s *sql.Selector)) *QuestionSelect {
+	qs.modifiers = append(qs.modifiers, modifiers...)
+	return qs
+}
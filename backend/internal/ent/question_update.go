@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"backend/internal/ent/answer"
 	"backend/internal/ent/comment"
 	"backend/internal/ent/predicate"
 	"backend/internal/ent/question"
@@ -10,6 +11,7 @@ import (
 	"backend/internal/ent/user"
 	"backend/internal/ent/userfavoritequestion"
 	"backend/internal/ent/userlikequestion"
+	"backend/internal/pgvector"
 	"context"
 	"errors"
 	"fmt"
@@ -23,8 +25,9 @@ import (
 // QuestionUpdate is the builder for updating Question entities.
 type QuestionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *QuestionMutation
+	hooks     []Hook
+	mutation  *QuestionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the QuestionUpdate builder.
@@ -53,9 +56,29 @@ func (qu *QuestionUpdate) SetNillableTitle(s *string) *QuestionUpdate {
 	return qu
 }
 
+// SetBody sets the "body" field.
+func (qu *QuestionUpdate) SetBody(s string) *QuestionUpdate {
+	qu.mutation.SetBody(s)
+	return qu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillableBody(s *string) *QuestionUpdate {
+	if s != nil {
+		qu.SetBody(*s)
+	}
+	return qu
+}
+
+// ClearBody clears the value of the "body" field.
+func (qu *QuestionUpdate) ClearBody() *QuestionUpdate {
+	qu.mutation.ClearBody()
+	return qu
+}
+
 // SetEmbedding sets the "embedding" field.
-func (qu *QuestionUpdate) SetEmbedding(b []byte) *QuestionUpdate {
-	qu.mutation.SetEmbedding(b)
+func (qu *QuestionUpdate) SetEmbedding(pg pgvector.Vector) *QuestionUpdate {
+	qu.mutation.SetEmbedding(pg)
 	return qu
 }
 
@@ -65,19 +88,133 @@ func (qu *QuestionUpdate) ClearEmbedding() *QuestionUpdate {
 	return qu
 }
 
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (qu *QuestionUpdate) AddUserIDs(ids ...int) *QuestionUpdate {
-	qu.mutation.AddUserIDs(ids...)
+// SetUserID sets the "user_id" field.
+func (qu *QuestionUpdate) SetUserID(i int) *QuestionUpdate {
+	qu.mutation.SetUserID(i)
 	return qu
 }
 
-// AddUser adds the "user" edges to the User entity.
-func (qu *QuestionUpdate) AddUser(u ...*User) *QuestionUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillableUserID(i *int) *QuestionUpdate {
+	if i != nil {
+		qu.SetUserID(*i)
+	}
+	return qu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (qu *QuestionUpdate) ClearUserID() *QuestionUpdate {
+	qu.mutation.ClearUserID()
+	return qu
+}
+
+// SetAcceptedAnswerID sets the "accepted_answer_id" field.
+func (qu *QuestionUpdate) SetAcceptedAnswerID(i int) *QuestionUpdate {
+	qu.mutation.SetAcceptedAnswerID(i)
+	return qu
+}
+
+// SetNillableAcceptedAnswerID sets the "accepted_answer_id" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillableAcceptedAnswerID(i *int) *QuestionUpdate {
+	if i != nil {
+		qu.SetAcceptedAnswerID(*i)
+	}
+	return qu
+}
+
+// ClearAcceptedAnswerID clears the value of the "accepted_answer_id" field.
+func (qu *QuestionUpdate) ClearAcceptedAnswerID() *QuestionUpdate {
+	qu.mutation.ClearAcceptedAnswerID()
+	return qu
+}
+
+// SetLikeCount sets the "like_count" field.
+func (qu *QuestionUpdate) SetLikeCount(i int) *QuestionUpdate {
+	qu.mutation.ResetLikeCount()
+	qu.mutation.SetLikeCount(i)
+	return qu
+}
+
+// SetNillableLikeCount sets the "like_count" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillableLikeCount(i *int) *QuestionUpdate {
+	if i != nil {
+		qu.SetLikeCount(*i)
 	}
-	return qu.AddUserIDs(ids...)
+	return qu
+}
+
+// AddLikeCount adds i to the "like_count" field.
+func (qu *QuestionUpdate) AddLikeCount(i int) *QuestionUpdate {
+	qu.mutation.AddLikeCount(i)
+	return qu
+}
+
+// SetFavoriteCount sets the "favorite_count" field.
+func (qu *QuestionUpdate) SetFavoriteCount(i int) *QuestionUpdate {
+	qu.mutation.ResetFavoriteCount()
+	qu.mutation.SetFavoriteCount(i)
+	return qu
+}
+
+// SetNillableFavoriteCount sets the "favorite_count" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillableFavoriteCount(i *int) *QuestionUpdate {
+	if i != nil {
+		qu.SetFavoriteCount(*i)
+	}
+	return qu
+}
+
+// AddFavoriteCount adds i to the "favorite_count" field.
+func (qu *QuestionUpdate) AddFavoriteCount(i int) *QuestionUpdate {
+	qu.mutation.AddFavoriteCount(i)
+	return qu
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (qu *QuestionUpdate) SetCommentCount(i int) *QuestionUpdate {
+	qu.mutation.ResetCommentCount()
+	qu.mutation.SetCommentCount(i)
+	return qu
+}
+
+// SetNillableCommentCount sets the "comment_count" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillableCommentCount(i *int) *QuestionUpdate {
+	if i != nil {
+		qu.SetCommentCount(*i)
+	}
+	return qu
+}
+
+// AddCommentCount adds i to the "comment_count" field.
+func (qu *QuestionUpdate) AddCommentCount(i int) *QuestionUpdate {
+	qu.mutation.AddCommentCount(i)
+	return qu
+}
+
+// SetAnswerCount sets the "answer_count" field.
+func (qu *QuestionUpdate) SetAnswerCount(i int) *QuestionUpdate {
+	qu.mutation.ResetAnswerCount()
+	qu.mutation.SetAnswerCount(i)
+	return qu
+}
+
+// SetNillableAnswerCount sets the "answer_count" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillableAnswerCount(i *int) *QuestionUpdate {
+	if i != nil {
+		qu.SetAnswerCount(*i)
+	}
+	return qu
+}
+
+// AddAnswerCount adds i to the "answer_count" field.
+func (qu *QuestionUpdate) AddAnswerCount(i int) *QuestionUpdate {
+	qu.mutation.AddAnswerCount(i)
+	return qu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (qu *QuestionUpdate) SetUser(u *User) *QuestionUpdate {
+	return qu.SetUserID(u.ID)
 }
 
 // AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
@@ -95,6 +232,21 @@ func (qu *QuestionUpdate) AddComments(c ...*Comment) *QuestionUpdate {
 	return qu.AddCommentIDs(ids...)
 }
 
+// AddAnswerIDs adds the "answers" edge to the Answer entity by IDs.
+func (qu *QuestionUpdate) AddAnswerIDs(ids ...int) *QuestionUpdate {
+	qu.mutation.AddAnswerIDs(ids...)
+	return qu
+}
+
+// AddAnswers adds the "answers" edges to the Answer entity.
+func (qu *QuestionUpdate) AddAnswers(a ...*Answer) *QuestionUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return qu.AddAnswerIDs(ids...)
+}
+
 // AddTagIDs adds the "tags" edge to the QuestionTag entity by IDs.
 func (qu *QuestionUpdate) AddTagIDs(ids ...int) *QuestionUpdate {
 	qu.mutation.AddTagIDs(ids...)
@@ -140,32 +292,22 @@ func (qu *QuestionUpdate) AddFavorites(u ...*UserFavoriteQuestion) *QuestionUpda
 	return qu.AddFavoriteIDs(ids...)
 }
 
+// SetAcceptedAnswer sets the "accepted_answer" edge to the Answer entity.
+func (qu *QuestionUpdate) SetAcceptedAnswer(a *Answer) *QuestionUpdate {
+	return qu.SetAcceptedAnswerID(a.ID)
+}
+
 // Mutation returns the QuestionMutation object of the builder.
 func (qu *QuestionUpdate) Mutation() *QuestionMutation {
 	return qu.mutation
 }
 
-// ClearUser clears all "user" edges to the User entity.
+// ClearUser clears the "user" edge to the User entity.
 func (qu *QuestionUpdate) ClearUser() *QuestionUpdate {
 	qu.mutation.ClearUser()
 	return qu
 }
 
-// RemoveUserIDs removes the "user" edge to User entities by IDs.
-func (qu *QuestionUpdate) RemoveUserIDs(ids ...int) *QuestionUpdate {
-	qu.mutation.RemoveUserIDs(ids...)
-	return qu
-}
-
-// RemoveUser removes "user" edges to User entities.
-func (qu *QuestionUpdate) RemoveUser(u ...*User) *QuestionUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return qu.RemoveUserIDs(ids...)
-}
-
 // ClearComments clears all "comments" edges to the Comment entity.
 func (qu *QuestionUpdate) ClearComments() *QuestionUpdate {
 	qu.mutation.ClearComments()
@@ -187,6 +329,27 @@ func (qu *QuestionUpdate) RemoveComments(c ...*Comment) *QuestionUpdate {
 	return qu.RemoveCommentIDs(ids...)
 }
 
+// ClearAnswers clears all "answers" edges to the Answer entity.
+func (qu *QuestionUpdate) ClearAnswers() *QuestionUpdate {
+	qu.mutation.ClearAnswers()
+	return qu
+}
+
+// RemoveAnswerIDs removes the "answers" edge to Answer entities by IDs.
+func (qu *QuestionUpdate) RemoveAnswerIDs(ids ...int) *QuestionUpdate {
+	qu.mutation.RemoveAnswerIDs(ids...)
+	return qu
+}
+
+// RemoveAnswers removes "answers" edges to Answer entities.
+func (qu *QuestionUpdate) RemoveAnswers(a ...*Answer) *QuestionUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return qu.RemoveAnswerIDs(ids...)
+}
+
 // ClearTags clears all "tags" edges to the QuestionTag entity.
 func (qu *QuestionUpdate) ClearTags() *QuestionUpdate {
 	qu.mutation.ClearTags()
@@ -250,6 +413,12 @@ func (qu *QuestionUpdate) RemoveFavorites(u ...*UserFavoriteQuestion) *QuestionU
 	return qu.RemoveFavoriteIDs(ids...)
 }
 
+// ClearAcceptedAnswer clears the "accepted_answer" edge to the Answer entity.
+func (qu *QuestionUpdate) ClearAcceptedAnswer() *QuestionUpdate {
+	qu.mutation.ClearAcceptedAnswer()
+	return qu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (qu *QuestionUpdate) Save(ctx context.Context) (int, error) {
 	qu.defaults()
@@ -296,6 +465,12 @@ func (qu *QuestionUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (qu *QuestionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuestionUpdate {
+	qu.modifiers = append(qu.modifiers, modifiers...)
+	return qu
+}
+
 func (qu *QuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := qu.check(); err != nil {
 		return n, err
@@ -314,16 +489,46 @@ func (qu *QuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := qu.mutation.Title(); ok {
 		_spec.SetField(question.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := qu.mutation.Body(); ok {
+		_spec.SetField(question.FieldBody, field.TypeString, value)
+	}
+	if qu.mutation.BodyCleared() {
+		_spec.ClearField(question.FieldBody, field.TypeString)
+	}
 	if value, ok := qu.mutation.Embedding(); ok {
-		_spec.SetField(question.FieldEmbedding, field.TypeBytes, value)
+		_spec.SetField(question.FieldEmbedding, field.TypeOther, value)
 	}
 	if qu.mutation.EmbeddingCleared() {
-		_spec.ClearField(question.FieldEmbedding, field.TypeBytes)
+		_spec.ClearField(question.FieldEmbedding, field.TypeOther)
+	}
+	if value, ok := qu.mutation.LikeCount(); ok {
+		_spec.SetField(question.FieldLikeCount, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedLikeCount(); ok {
+		_spec.AddField(question.FieldLikeCount, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.FavoriteCount(); ok {
+		_spec.SetField(question.FieldFavoriteCount, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedFavoriteCount(); ok {
+		_spec.AddField(question.FieldFavoriteCount, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.CommentCount(); ok {
+		_spec.SetField(question.FieldCommentCount, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedCommentCount(); ok {
+		_spec.AddField(question.FieldCommentCount, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AnswerCount(); ok {
+		_spec.SetField(question.FieldAnswerCount, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedAnswerCount(); ok {
+		_spec.AddField(question.FieldAnswerCount, field.TypeInt, value)
 	}
 	if qu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   question.UserTable,
 			Columns: []string{question.UserColumn},
 			Bidi:    false,
@@ -333,10 +538,10 @@ func (qu *QuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := qu.mutation.RemovedUserIDs(); len(nodes) > 0 && !qu.mutation.UserCleared() {
+	if nodes := qu.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   question.UserTable,
 			Columns: []string{question.UserColumn},
 			Bidi:    false,
@@ -347,25 +552,38 @@ func (qu *QuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.CommentsTable,
+			Columns: []string{question.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := qu.mutation.UserIDs(); len(nodes) > 0 {
+	if nodes := qu.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !qu.mutation.CommentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   question.UserTable,
-			Columns: []string{question.UserColumn},
+			Table:   question.CommentsTable,
+			Columns: []string{question.CommentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if qu.mutation.CommentsCleared() {
+	if nodes := qu.mutation.CommentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
@@ -376,17 +594,33 @@ func (qu *QuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qu.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.AnswersTable,
+			Columns: []string{question.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := qu.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !qu.mutation.CommentsCleared() {
+	if nodes := qu.mutation.RemovedAnswersIDs(); len(nodes) > 0 && !qu.mutation.AnswersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   question.CommentsTable,
-			Columns: []string{question.CommentsColumn},
+			Table:   question.AnswersTable,
+			Columns: []string{question.AnswersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -394,15 +628,15 @@ func (qu *QuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := qu.mutation.CommentsIDs(); len(nodes) > 0 {
+	if nodes := qu.mutation.AnswersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   question.CommentsTable,
-			Columns: []string{question.CommentsColumn},
+			Table:   question.AnswersTable,
+			Columns: []string{question.AnswersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -545,6 +779,36 @@ func (qu *QuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if qu.mutation.AcceptedAnswerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   question.AcceptedAnswerTable,
+			Columns: []string{question.AcceptedAnswerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.AcceptedAnswerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   question.AcceptedAnswerTable,
+			Columns: []string{question.AcceptedAnswerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(qu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, qu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{question.Label}
@@ -560,9 +824,10 @@ func (qu *QuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // QuestionUpdateOne is the builder for updating a single Question entity.
 type QuestionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *QuestionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *QuestionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUpdateTime sets the "update_time" field.
@@ -585,9 +850,29 @@ func (quo *QuestionUpdateOne) SetNillableTitle(s *string) *QuestionUpdateOne {
 	return quo
 }
 
+// SetBody sets the "body" field.
+func (quo *QuestionUpdateOne) SetBody(s string) *QuestionUpdateOne {
+	quo.mutation.SetBody(s)
+	return quo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillableBody(s *string) *QuestionUpdateOne {
+	if s != nil {
+		quo.SetBody(*s)
+	}
+	return quo
+}
+
+// ClearBody clears the value of the "body" field.
+func (quo *QuestionUpdateOne) ClearBody() *QuestionUpdateOne {
+	quo.mutation.ClearBody()
+	return quo
+}
+
 // SetEmbedding sets the "embedding" field.
-func (quo *QuestionUpdateOne) SetEmbedding(b []byte) *QuestionUpdateOne {
-	quo.mutation.SetEmbedding(b)
+func (quo *QuestionUpdateOne) SetEmbedding(pg pgvector.Vector) *QuestionUpdateOne {
+	quo.mutation.SetEmbedding(pg)
 	return quo
 }
 
@@ -597,19 +882,133 @@ func (quo *QuestionUpdateOne) ClearEmbedding() *QuestionUpdateOne {
 	return quo
 }
 
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (quo *QuestionUpdateOne) AddUserIDs(ids ...int) *QuestionUpdateOne {
-	quo.mutation.AddUserIDs(ids...)
+// SetUserID sets the "user_id" field.
+func (quo *QuestionUpdateOne) SetUserID(i int) *QuestionUpdateOne {
+	quo.mutation.SetUserID(i)
 	return quo
 }
 
-// AddUser adds the "user" edges to the User entity.
-func (quo *QuestionUpdateOne) AddUser(u ...*User) *QuestionUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillableUserID(i *int) *QuestionUpdateOne {
+	if i != nil {
+		quo.SetUserID(*i)
+	}
+	return quo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (quo *QuestionUpdateOne) ClearUserID() *QuestionUpdateOne {
+	quo.mutation.ClearUserID()
+	return quo
+}
+
+// SetAcceptedAnswerID sets the "accepted_answer_id" field.
+func (quo *QuestionUpdateOne) SetAcceptedAnswerID(i int) *QuestionUpdateOne {
+	quo.mutation.SetAcceptedAnswerID(i)
+	return quo
+}
+
+// SetNillableAcceptedAnswerID sets the "accepted_answer_id" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillableAcceptedAnswerID(i *int) *QuestionUpdateOne {
+	if i != nil {
+		quo.SetAcceptedAnswerID(*i)
+	}
+	return quo
+}
+
+// ClearAcceptedAnswerID clears the value of the "accepted_answer_id" field.
+func (quo *QuestionUpdateOne) ClearAcceptedAnswerID() *QuestionUpdateOne {
+	quo.mutation.ClearAcceptedAnswerID()
+	return quo
+}
+
+// SetLikeCount sets the "like_count" field.
+func (quo *QuestionUpdateOne) SetLikeCount(i int) *QuestionUpdateOne {
+	quo.mutation.ResetLikeCount()
+	quo.mutation.SetLikeCount(i)
+	return quo
+}
+
+// SetNillableLikeCount sets the "like_count" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillableLikeCount(i *int) *QuestionUpdateOne {
+	if i != nil {
+		quo.SetLikeCount(*i)
 	}
-	return quo.AddUserIDs(ids...)
+	return quo
+}
+
+// AddLikeCount adds i to the "like_count" field.
+func (quo *QuestionUpdateOne) AddLikeCount(i int) *QuestionUpdateOne {
+	quo.mutation.AddLikeCount(i)
+	return quo
+}
+
+// SetFavoriteCount sets the "favorite_count" field.
+func (quo *QuestionUpdateOne) SetFavoriteCount(i int) *QuestionUpdateOne {
+	quo.mutation.ResetFavoriteCount()
+	quo.mutation.SetFavoriteCount(i)
+	return quo
+}
+
+// SetNillableFavoriteCount sets the "favorite_count" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillableFavoriteCount(i *int) *QuestionUpdateOne {
+	if i != nil {
+		quo.SetFavoriteCount(*i)
+	}
+	return quo
+}
+
+// AddFavoriteCount adds i to the "favorite_count" field.
+func (quo *QuestionUpdateOne) AddFavoriteCount(i int) *QuestionUpdateOne {
+	quo.mutation.AddFavoriteCount(i)
+	return quo
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (quo *QuestionUpdateOne) SetCommentCount(i int) *QuestionUpdateOne {
+	quo.mutation.ResetCommentCount()
+	quo.mutation.SetCommentCount(i)
+	return quo
+}
+
+// SetNillableCommentCount sets the "comment_count" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillableCommentCount(i *int) *QuestionUpdateOne {
+	if i != nil {
+		quo.SetCommentCount(*i)
+	}
+	return quo
+}
+
+// AddCommentCount adds i to the "comment_count" field.
+func (quo *QuestionUpdateOne) AddCommentCount(i int) *QuestionUpdateOne {
+	quo.mutation.AddCommentCount(i)
+	return quo
+}
+
+// SetAnswerCount sets the "answer_count" field.
+func (quo *QuestionUpdateOne) SetAnswerCount(i int) *QuestionUpdateOne {
+	quo.mutation.ResetAnswerCount()
+	quo.mutation.SetAnswerCount(i)
+	return quo
+}
+
+// SetNillableAnswerCount sets the "answer_count" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillableAnswerCount(i *int) *QuestionUpdateOne {
+	if i != nil {
+		quo.SetAnswerCount(*i)
+	}
+	return quo
+}
+
+// AddAnswerCount adds i to the "answer_count" field.
+func (quo *QuestionUpdateOne) AddAnswerCount(i int) *QuestionUpdateOne {
+	quo.mutation.AddAnswerCount(i)
+	return quo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (quo *QuestionUpdateOne) SetUser(u *User) *QuestionUpdateOne {
+	return quo.SetUserID(u.ID)
 }
 
 // AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
@@ -627,6 +1026,21 @@ func (quo *QuestionUpdateOne) AddComments(c ...*Comment) *QuestionUpdateOne {
 	return quo.AddCommentIDs(ids...)
 }
 
+// AddAnswerIDs adds the "answers" edge to the Answer entity by IDs.
+func (quo *QuestionUpdateOne) AddAnswerIDs(ids ...int) *QuestionUpdateOne {
+	quo.mutation.AddAnswerIDs(ids...)
+	return quo
+}
+
+// AddAnswers adds the "answers" edges to the Answer entity.
+func (quo *QuestionUpdateOne) AddAnswers(a ...*Answer) *QuestionUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return quo.AddAnswerIDs(ids...)
+}
+
 // AddTagIDs adds the "tags" edge to the QuestionTag entity by IDs.
 func (quo *QuestionUpdateOne) AddTagIDs(ids ...int) *QuestionUpdateOne {
 	quo.mutation.AddTagIDs(ids...)
@@ -672,32 +1086,22 @@ func (quo *QuestionUpdateOne) AddFavorites(u ...*UserFavoriteQuestion) *Question
 	return quo.AddFavoriteIDs(ids...)
 }
 
+// SetAcceptedAnswer sets the "accepted_answer" edge to the Answer entity.
+func (quo *QuestionUpdateOne) SetAcceptedAnswer(a *Answer) *QuestionUpdateOne {
+	return quo.SetAcceptedAnswerID(a.ID)
+}
+
 // Mutation returns the QuestionMutation object of the builder.
 func (quo *QuestionUpdateOne) Mutation() *QuestionMutation {
 	return quo.mutation
 }
 
-// ClearUser clears all "user" edges to the User entity.
+// ClearUser clears the "user" edge to the User entity.
 func (quo *QuestionUpdateOne) ClearUser() *QuestionUpdateOne {
 	quo.mutation.ClearUser()
 	return quo
 }
 
-// RemoveUserIDs removes the "user" edge to User entities by IDs.
-func (quo *QuestionUpdateOne) RemoveUserIDs(ids ...int) *QuestionUpdateOne {
-	quo.mutation.RemoveUserIDs(ids...)
-	return quo
-}
-
-// RemoveUser removes "user" edges to User entities.
-func (quo *QuestionUpdateOne) RemoveUser(u ...*User) *QuestionUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return quo.RemoveUserIDs(ids...)
-}
-
 // ClearComments clears all "comments" edges to the Comment entity.
 func (quo *QuestionUpdateOne) ClearComments() *QuestionUpdateOne {
 	quo.mutation.ClearComments()
@@ -719,6 +1123,27 @@ func (quo *QuestionUpdateOne) RemoveComments(c ...*Comment) *QuestionUpdateOne {
 	return quo.RemoveCommentIDs(ids...)
 }
 
+// ClearAnswers clears all "answers" edges to the Answer entity.
+func (quo *QuestionUpdateOne) ClearAnswers() *QuestionUpdateOne {
+	quo.mutation.ClearAnswers()
+	return quo
+}
+
+// RemoveAnswerIDs removes the "answers" edge to Answer entities by IDs.
+func (quo *QuestionUpdateOne) RemoveAnswerIDs(ids ...int) *QuestionUpdateOne {
+	quo.mutation.RemoveAnswerIDs(ids...)
+	return quo
+}
+
+// RemoveAnswers removes "answers" edges to Answer entities.
+func (quo *QuestionUpdateOne) RemoveAnswers(a ...*Answer) *QuestionUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return quo.RemoveAnswerIDs(ids...)
+}
+
 // ClearTags clears all "tags" edges to the QuestionTag entity.
 func (quo *QuestionUpdateOne) ClearTags() *QuestionUpdateOne {
 	quo.mutation.ClearTags()
@@ -782,6 +1207,12 @@ func (quo *QuestionUpdateOne) RemoveFavorites(u ...*UserFavoriteQuestion) *Quest
 	return quo.RemoveFavoriteIDs(ids...)
 }
 
+// ClearAcceptedAnswer clears the "accepted_answer" edge to the Answer entity.
+func (quo *QuestionUpdateOne) ClearAcceptedAnswer() *QuestionUpdateOne {
+	quo.mutation.ClearAcceptedAnswer()
+	return quo
+}
+
 // Where appends a list predicates to the QuestionUpdate builder.
 func (quo *QuestionUpdateOne) Where(ps ...predicate.Question) *QuestionUpdateOne {
 	quo.mutation.Where(ps...)
@@ -841,6 +1272,12 @@ func (quo *QuestionUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (quo *QuestionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuestionUpdateOne {
+	quo.modifiers = append(quo.modifiers, modifiers...)
+	return quo
+}
+
 func (quo *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err error) {
 	if err := quo.check(); err != nil {
 		return _node, err
@@ -876,16 +1313,46 @@ func (quo *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err
 	if value, ok := quo.mutation.Title(); ok {
 		_spec.SetField(question.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := quo.mutation.Body(); ok {
+		_spec.SetField(question.FieldBody, field.TypeString, value)
+	}
+	if quo.mutation.BodyCleared() {
+		_spec.ClearField(question.FieldBody, field.TypeString)
+	}
 	if value, ok := quo.mutation.Embedding(); ok {
-		_spec.SetField(question.FieldEmbedding, field.TypeBytes, value)
+		_spec.SetField(question.FieldEmbedding, field.TypeOther, value)
 	}
 	if quo.mutation.EmbeddingCleared() {
-		_spec.ClearField(question.FieldEmbedding, field.TypeBytes)
+		_spec.ClearField(question.FieldEmbedding, field.TypeOther)
+	}
+	if value, ok := quo.mutation.LikeCount(); ok {
+		_spec.SetField(question.FieldLikeCount, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedLikeCount(); ok {
+		_spec.AddField(question.FieldLikeCount, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.FavoriteCount(); ok {
+		_spec.SetField(question.FieldFavoriteCount, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedFavoriteCount(); ok {
+		_spec.AddField(question.FieldFavoriteCount, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.CommentCount(); ok {
+		_spec.SetField(question.FieldCommentCount, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedCommentCount(); ok {
+		_spec.AddField(question.FieldCommentCount, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AnswerCount(); ok {
+		_spec.SetField(question.FieldAnswerCount, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedAnswerCount(); ok {
+		_spec.AddField(question.FieldAnswerCount, field.TypeInt, value)
 	}
 	if quo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   question.UserTable,
 			Columns: []string{question.UserColumn},
 			Bidi:    false,
@@ -895,10 +1362,10 @@ func (quo *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := quo.mutation.RemovedUserIDs(); len(nodes) > 0 && !quo.mutation.UserCleared() {
+	if nodes := quo.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   question.UserTable,
 			Columns: []string{question.UserColumn},
 			Bidi:    false,
@@ -909,25 +1376,38 @@ func (quo *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if quo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.CommentsTable,
+			Columns: []string{question.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := quo.mutation.UserIDs(); len(nodes) > 0 {
+	if nodes := quo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !quo.mutation.CommentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   question.UserTable,
-			Columns: []string{question.UserColumn},
+			Table:   question.CommentsTable,
+			Columns: []string{question.CommentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if quo.mutation.CommentsCleared() {
+	if nodes := quo.mutation.CommentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
@@ -938,17 +1418,33 @@ func (quo *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err
 				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if quo.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.AnswersTable,
+			Columns: []string{question.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := quo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !quo.mutation.CommentsCleared() {
+	if nodes := quo.mutation.RemovedAnswersIDs(); len(nodes) > 0 && !quo.mutation.AnswersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   question.CommentsTable,
-			Columns: []string{question.CommentsColumn},
+			Table:   question.AnswersTable,
+			Columns: []string{question.AnswersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -956,15 +1452,15 @@ func (quo *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := quo.mutation.CommentsIDs(); len(nodes) > 0 {
+	if nodes := quo.mutation.AnswersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   question.CommentsTable,
-			Columns: []string{question.CommentsColumn},
+			Table:   question.AnswersTable,
+			Columns: []string{question.AnswersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1107,6 +1603,36 @@ func (quo *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if quo.mutation.AcceptedAnswerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   question.AcceptedAnswerTable,
+			Columns: []string{question.AcceptedAnswerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.AcceptedAnswerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   question.AcceptedAnswerTable,
+			Columns: []string{question.AcceptedAnswerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(answer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(quo.modifiers...)
 	_node = &Question{config: quo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
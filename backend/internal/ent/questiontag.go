@@ -3,7 +3,9 @@
 package ent
 
 import (
+	"backend/internal/ent/question"
 	"backend/internal/ent/questiontag"
+	"backend/internal/ent/tag"
 	"fmt"
 	"strings"
 
@@ -22,37 +24,39 @@ type QuestionTag struct {
 	TagID int `json:"tag_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the QuestionTagQuery when eager-loading is set.
-	Edges         QuestionTagEdges `json:"edges"`
-	question_tags *int
-	tag_questions *int
-	selectValues  sql.SelectValues
+	Edges        QuestionTagEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // QuestionTagEdges holds the relations/edges for other nodes in the graph.
 type QuestionTagEdges struct {
 	// Question holds the value of the question edge.
-	Question []*Question `json:"question,omitempty"`
+	Question *Question `json:"question,omitempty"`
 	// Tag holds the value of the tag edge.
-	Tag []*Tag `json:"tag,omitempty"`
+	Tag *Tag `json:"tag,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [2]bool
 }
 
 // QuestionOrErr returns the Question value or an error if the edge
-// was not loaded in eager-loading.
-func (e QuestionTagEdges) QuestionOrErr() ([]*Question, error) {
-	if e.loadedTypes[0] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionTagEdges) QuestionOrErr() (*Question, error) {
+	if e.Question != nil {
 		return e.Question, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: question.Label}
 	}
 	return nil, &NotLoadedError{edge: "question"}
 }
 
 // TagOrErr returns the Tag value or an error if the edge
-// was not loaded in eager-loading.
-func (e QuestionTagEdges) TagOrErr() ([]*Tag, error) {
-	if e.loadedTypes[1] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionTagEdges) TagOrErr() (*Tag, error) {
+	if e.Tag != nil {
 		return e.Tag, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: tag.Label}
 	}
 	return nil, &NotLoadedError{edge: "tag"}
 }
@@ -64,10 +68,6 @@ func (*QuestionTag) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case questiontag.FieldID, questiontag.FieldQuestionID, questiontag.FieldTagID:
 			values[i] = new(sql.NullInt64)
-		case questiontag.ForeignKeys[0]: // question_tags
-			values[i] = new(sql.NullInt64)
-		case questiontag.ForeignKeys[1]: // tag_questions
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -101,20 +101,6 @@ func (qt *QuestionTag) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				qt.TagID = int(value.Int64)
 			}
-		case questiontag.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field question_tags", value)
-			} else if value.Valid {
-				qt.question_tags = new(int)
-				*qt.question_tags = int(value.Int64)
-			}
-		case questiontag.ForeignKeys[1]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field tag_questions", value)
-			} else if value.Valid {
-				qt.tag_questions = new(int)
-				*qt.tag_questions = int(value.Int64)
-			}
 		default:
 			qt.selectValues.Set(columns[i], values[i])
 		}
@@ -3,6 +3,7 @@
 package questiontag
 
 import (
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -23,19 +24,19 @@ const (
 	// Table holds the table name of the questiontag in the database.
 	Table = "question_tags"
 	// QuestionTable is the table that holds the question relation/edge.
-	QuestionTable = "questions"
+	QuestionTable = "question_tags"
 	// QuestionInverseTable is the table name for the Question entity.
 	// It exists in this package in order to avoid circular dependency with the "question" package.
 	QuestionInverseTable = "questions"
 	// QuestionColumn is the table column denoting the question relation/edge.
-	QuestionColumn = "question_tag_question"
+	QuestionColumn = "question_id"
 	// TagTable is the table that holds the tag relation/edge.
-	TagTable = "tags"
+	TagTable = "question_tags"
 	// TagInverseTable is the table name for the Tag entity.
 	// It exists in this package in order to avoid circular dependency with the "tag" package.
 	TagInverseTable = "tags"
 	// TagColumn is the table column denoting the tag relation/edge.
-	TagColumn = "question_tag_tag"
+	TagColumn = "tag_id"
 )
 
 // Columns holds all SQL columns for questiontag fields.
@@ -45,13 +46,6 @@ var Columns = []string{
 	FieldTagID,
 }
 
-// ForeignKeys holds the SQL foreign-keys that are owned by the "question_tags"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"question_tags",
-	"tag_questions",
-}
-
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -59,14 +53,18 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "backend/internal/ent/runtime"
+var (
+	Hooks [1]ent.Hook
+)
+
 // OrderOption defines the ordering options for the QuestionTag queries.
 type OrderOption func(*sql.Selector)
 
@@ -85,44 +83,30 @@ func ByTagID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTagID, opts...).ToFunc()
 }
 
-// ByQuestionCount orders the results by question count.
-func ByQuestionCount(opts ...sql.OrderTermOption) OrderOption {
-	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newQuestionStep(), opts...)
-	}
-}
-
-// ByQuestion orders the results by question terms.
-func ByQuestion(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
-	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newQuestionStep(), append([]sql.OrderTerm{term}, terms...)...)
-	}
-}
-
-// ByTagCount orders the results by tag count.
-func ByTagCount(opts ...sql.OrderTermOption) OrderOption {
+// ByQuestionField orders the results by question field.
+func ByQuestionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newTagStep(), opts...)
+		sqlgraph.OrderByNeighborTerms(s, newQuestionStep(), sql.OrderByField(field, opts...))
 	}
 }
 
-// ByTag orders the results by tag terms.
-func ByTag(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+// ByTagField orders the results by tag field.
+func ByTagField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newTagStep(), append([]sql.OrderTerm{term}, terms...)...)
+		sqlgraph.OrderByNeighborTerms(s, newTagStep(), sql.OrderByField(field, opts...))
 	}
 }
 func newQuestionStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(QuestionInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, QuestionTable, QuestionColumn),
+		sqlgraph.Edge(sqlgraph.M2O, true, QuestionTable, QuestionColumn),
 	)
 }
 func newTagStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(TagInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, TagTable, TagColumn),
+		sqlgraph.Edge(sqlgraph.M2O, true, TagTable, TagColumn),
 	)
 }
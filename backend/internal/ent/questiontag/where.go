@@ -84,26 +84,6 @@ func QuestionIDNotIn(vs ...int) predicate.QuestionTag {
 	return predicate.QuestionTag(sql.FieldNotIn(FieldQuestionID, vs...))
 }
 
-// QuestionIDGT applies the GT predicate on the "question_id" field.
-func QuestionIDGT(v int) predicate.QuestionTag {
-	return predicate.QuestionTag(sql.FieldGT(FieldQuestionID, v))
-}
-
-// QuestionIDGTE applies the GTE predicate on the "question_id" field.
-func QuestionIDGTE(v int) predicate.QuestionTag {
-	return predicate.QuestionTag(sql.FieldGTE(FieldQuestionID, v))
-}
-
-// QuestionIDLT applies the LT predicate on the "question_id" field.
-func QuestionIDLT(v int) predicate.QuestionTag {
-	return predicate.QuestionTag(sql.FieldLT(FieldQuestionID, v))
-}
-
-// QuestionIDLTE applies the LTE predicate on the "question_id" field.
-func QuestionIDLTE(v int) predicate.QuestionTag {
-	return predicate.QuestionTag(sql.FieldLTE(FieldQuestionID, v))
-}
-
 // TagIDEQ applies the EQ predicate on the "tag_id" field.
 func TagIDEQ(v int) predicate.QuestionTag {
 	return predicate.QuestionTag(sql.FieldEQ(FieldTagID, v))
@@ -124,32 +104,12 @@ func TagIDNotIn(vs ...int) predicate.QuestionTag {
 	return predicate.QuestionTag(sql.FieldNotIn(FieldTagID, vs...))
 }
 
-// TagIDGT applies the GT predicate on the "tag_id" field.
-func TagIDGT(v int) predicate.QuestionTag {
-	return predicate.QuestionTag(sql.FieldGT(FieldTagID, v))
-}
-
-// TagIDGTE applies the GTE predicate on the "tag_id" field.
-func TagIDGTE(v int) predicate.QuestionTag {
-	return predicate.QuestionTag(sql.FieldGTE(FieldTagID, v))
-}
-
-// TagIDLT applies the LT predicate on the "tag_id" field.
-func TagIDLT(v int) predicate.QuestionTag {
-	return predicate.QuestionTag(sql.FieldLT(FieldTagID, v))
-}
-
-// TagIDLTE applies the LTE predicate on the "tag_id" field.
-func TagIDLTE(v int) predicate.QuestionTag {
-	return predicate.QuestionTag(sql.FieldLTE(FieldTagID, v))
-}
-
 // HasQuestion applies the HasEdge predicate on the "question" edge.
 func HasQuestion() predicate.QuestionTag {
 	return predicate.QuestionTag(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, QuestionTable, QuestionColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, QuestionTable, QuestionColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -172,7 +132,7 @@ func HasTag() predicate.QuestionTag {
 	return predicate.QuestionTag(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, TagTable, TagColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, TagTable, TagColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +20,7 @@ type QuestionTagCreate struct {
 	config
 	mutation *QuestionTagMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetQuestionID sets the "question_id" field.
@@ -33,34 +35,14 @@ func (qtc *QuestionTagCreate) SetTagID(i int) *QuestionTagCreate {
 	return qtc
 }
 
-// AddQuestionIDs adds the "question" edge to the Question entity by IDs.
-func (qtc *QuestionTagCreate) AddQuestionIDs(ids ...int) *QuestionTagCreate {
-	qtc.mutation.AddQuestionIDs(ids...)
-	return qtc
-}
-
-// AddQuestion adds the "question" edges to the Question entity.
-func (qtc *QuestionTagCreate) AddQuestion(q ...*Question) *QuestionTagCreate {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
-	}
-	return qtc.AddQuestionIDs(ids...)
-}
-
-// AddTagIDs adds the "tag" edge to the Tag entity by IDs.
-func (qtc *QuestionTagCreate) AddTagIDs(ids ...int) *QuestionTagCreate {
-	qtc.mutation.AddTagIDs(ids...)
-	return qtc
+// SetQuestion sets the "question" edge to the Question entity.
+func (qtc *QuestionTagCreate) SetQuestion(q *Question) *QuestionTagCreate {
+	return qtc.SetQuestionID(q.ID)
 }
 
-// AddTag adds the "tag" edges to the Tag entity.
-func (qtc *QuestionTagCreate) AddTag(t ...*Tag) *QuestionTagCreate {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
-	}
-	return qtc.AddTagIDs(ids...)
+// SetTag sets the "tag" edge to the Tag entity.
+func (qtc *QuestionTagCreate) SetTag(t *Tag) *QuestionTagCreate {
+	return qtc.SetTagID(t.ID)
 }
 
 // Mutation returns the QuestionTagMutation object of the builder.
@@ -103,6 +85,12 @@ func (qtc *QuestionTagCreate) check() error {
 	if _, ok := qtc.mutation.TagID(); !ok {
 		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "QuestionTag.tag_id"`)}
 	}
+	if _, ok := qtc.mutation.QuestionID(); !ok {
+		return &ValidationError{Name: "question", err: errors.New(`ent: missing required edge "QuestionTag.question"`)}
+	}
+	if _, ok := qtc.mutation.TagID(); !ok {
+		return &ValidationError{Name: "tag", err: errors.New(`ent: missing required edge "QuestionTag.tag"`)}
+	}
 	return nil
 }
 
@@ -129,18 +117,11 @@ func (qtc *QuestionTagCreate) createSpec() (*QuestionTag, *sqlgraph.CreateSpec)
 		_node = &QuestionTag{config: qtc.config}
 		_spec = sqlgraph.NewCreateSpec(questiontag.Table, sqlgraph.NewFieldSpec(questiontag.FieldID, field.TypeInt))
 	)
-	if value, ok := qtc.mutation.QuestionID(); ok {
-		_spec.SetField(questiontag.FieldQuestionID, field.TypeInt, value)
-		_node.QuestionID = value
-	}
-	if value, ok := qtc.mutation.TagID(); ok {
-		_spec.SetField(questiontag.FieldTagID, field.TypeInt, value)
-		_node.TagID = value
-	}
+	_spec.OnConflict = qtc.conflict
 	if nodes := qtc.mutation.QuestionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   questiontag.QuestionTable,
 			Columns: []string{questiontag.QuestionColumn},
 			Bidi:    false,
@@ -151,12 +132,13 @@ func (qtc *QuestionTagCreate) createSpec() (*QuestionTag, *sqlgraph.CreateSpec)
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.QuestionID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := qtc.mutation.TagIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   questiontag.TagTable,
 			Columns: []string{questiontag.TagColumn},
 			Bidi:    false,
@@ -167,16 +149,192 @@ func (qtc *QuestionTagCreate) createSpec() (*QuestionTag, *sqlgraph.CreateSpec)
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.TagID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.QuestionTag.Create().
+//		SetQuestionID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionTagUpsert) {
+//			SetQuestionID(v+v).
+//		}).
+//		Exec(ctx)
+func (qtc *QuestionTagCreate) OnConflict(opts ...sql.ConflictOption) *QuestionTagUpsertOne {
+	qtc.conflict = opts
+	return &QuestionTagUpsertOne{
+		create: qtc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.QuestionTag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qtc *QuestionTagCreate) OnConflictColumns(columns ...string) *QuestionTagUpsertOne {
+	qtc.conflict = append(qtc.conflict, sql.ConflictColumns(columns...))
+	return &QuestionTagUpsertOne{
+		create: qtc,
+	}
+}
+
+type (
+	// QuestionTagUpsertOne is the builder for "upsert"-ing
+	//  one QuestionTag node.
+	QuestionTagUpsertOne struct {
+		create *QuestionTagCreate
+	}
+
+	// QuestionTagUpsert is the "OnConflict" setter.
+	QuestionTagUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetQuestionID sets the "question_id" field.
+func (u *QuestionTagUpsert) SetQuestionID(v int) *QuestionTagUpsert {
+	u.Set(questiontag.FieldQuestionID, v)
+	return u
+}
+
+// UpdateQuestionID sets the "question_id" field to the value that was provided on create.
+func (u *QuestionTagUpsert) UpdateQuestionID() *QuestionTagUpsert {
+	u.SetExcluded(questiontag.FieldQuestionID)
+	return u
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *QuestionTagUpsert) SetTagID(v int) *QuestionTagUpsert {
+	u.Set(questiontag.FieldTagID, v)
+	return u
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *QuestionTagUpsert) UpdateTagID() *QuestionTagUpsert {
+	u.SetExcluded(questiontag.FieldTagID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.QuestionTag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *QuestionTagUpsertOne) UpdateNewValues() *QuestionTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.QuestionTag.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *QuestionTagUpsertOne) Ignore() *QuestionTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionTagUpsertOne) DoNothing() *QuestionTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionTagCreate.OnConflict
+// documentation for more info.
+func (u *QuestionTagUpsertOne) Update(set func(*QuestionTagUpsert)) *QuestionTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionTagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetQuestionID sets the "question_id" field.
+func (u *QuestionTagUpsertOne) SetQuestionID(v int) *QuestionTagUpsertOne {
+	return u.Update(func(s *QuestionTagUpsert) {
+		s.SetQuestionID(v)
+	})
+}
+
+// UpdateQuestionID sets the "question_id" field to the value that was provided on create.
+func (u *QuestionTagUpsertOne) UpdateQuestionID() *QuestionTagUpsertOne {
+	return u.Update(func(s *QuestionTagUpsert) {
+		s.UpdateQuestionID()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *QuestionTagUpsertOne) SetTagID(v int) *QuestionTagUpsertOne {
+	return u.Update(func(s *QuestionTagUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *QuestionTagUpsertOne) UpdateTagID() *QuestionTagUpsertOne {
+	return u.Update(func(s *QuestionTagUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionTagUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionTagCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionTagUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *QuestionTagUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *QuestionTagUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // QuestionTagCreateBulk is the builder for creating many QuestionTag entities in bulk.
 type QuestionTagCreateBulk struct {
 	config
 	err      error
 	builders []*QuestionTagCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the QuestionTag entities in the database.
@@ -205,6 +363,7 @@ func (qtcb *QuestionTagCreateBulk) Save(ctx context.Context) ([]*QuestionTag, er
 					_, err = mutators[i+1].Mutate(root, qtcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = qtcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, qtcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -258,3 +417,135 @@ func (qtcb *QuestionTagCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.QuestionTag.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionTagUpsert) {
+//			SetQuestionID(v+v).
+//		}).
+//		Exec(ctx)
+func (qtcb *QuestionTagCreateBulk) OnConflict(opts ...sql.ConflictOption) *QuestionTagUpsertBulk {
+	qtcb.conflict = opts
+	return &QuestionTagUpsertBulk{
+		create: qtcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.QuestionTag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qtcb *QuestionTagCreateBulk) OnConflictColumns(columns ...string) *QuestionTagUpsertBulk {
+	qtcb.conflict = append(qtcb.conflict, sql.ConflictColumns(columns...))
+	return &QuestionTagUpsertBulk{
+		create: qtcb,
+	}
+}
+
+// QuestionTagUpsertBulk is the builder for "upsert"-ing
+// a bulk of QuestionTag nodes.
+type QuestionTagUpsertBulk struct {
+	create *QuestionTagCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.QuestionTag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *QuestionTagUpsertBulk) UpdateNewValues() *QuestionTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.QuestionTag.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *QuestionTagUpsertBulk) Ignore() *QuestionTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionTagUpsertBulk) DoNothing() *QuestionTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionTagCreateBulk.OnConflict
+// documentation for more info.
+func (u *QuestionTagUpsertBulk) Update(set func(*QuestionTagUpsert)) *QuestionTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionTagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetQuestionID sets the "question_id" field.
+func (u *QuestionTagUpsertBulk) SetQuestionID(v int) *QuestionTagUpsertBulk {
+	return u.Update(func(s *QuestionTagUpsert) {
+		s.SetQuestionID(v)
+	})
+}
+
+// UpdateQuestionID sets the "question_id" field to the value that was provided on create.
+func (u *QuestionTagUpsertBulk) UpdateQuestionID() *QuestionTagUpsertBulk {
+	return u.Update(func(s *QuestionTagUpsert) {
+		s.UpdateQuestionID()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *QuestionTagUpsertBulk) SetTagID(v int) *QuestionTagUpsertBulk {
+	return u.Update(func(s *QuestionTagUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *QuestionTagUpsertBulk) UpdateTagID() *QuestionTagUpsertBulk {
+	return u.Update(func(s *QuestionTagUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionTagUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the QuestionTagCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionTagCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionTagUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
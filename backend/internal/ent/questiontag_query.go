@@ -8,7 +8,6 @@ import (
 	"backend/internal/ent/questiontag"
 	"backend/internal/ent/tag"
 	"context"
-	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -26,7 +25,7 @@ type QuestionTagQuery struct {
 	predicates   []predicate.QuestionTag
 	withQuestion *QuestionQuery
 	withTag      *TagQuery
-	withFKs      bool
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -77,7 +76,7 @@ func (qtq *QuestionTagQuery) QueryQuestion() *QuestionQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(questiontag.Table, questiontag.FieldID, selector),
 			sqlgraph.To(question.Table, question.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, questiontag.QuestionTable, questiontag.QuestionColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, questiontag.QuestionTable, questiontag.QuestionColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(qtq.driver.Dialect(), step)
 		return fromU, nil
@@ -99,7 +98,7 @@ func (qtq *QuestionTagQuery) QueryTag() *TagQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(questiontag.Table, questiontag.FieldID, selector),
 			sqlgraph.To(tag.Table, tag.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, questiontag.TagTable, questiontag.TagColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, questiontag.TagTable, questiontag.TagColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(qtq.driver.Dialect(), step)
 		return fromU, nil
@@ -406,16 +405,12 @@ func (qtq *QuestionTagQuery) prepareQuery(ctx context.Context) error {
 func (qtq *QuestionTagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*QuestionTag, error) {
 	var (
 		nodes       = []*QuestionTag{}
-		withFKs     = qtq.withFKs
 		_spec       = qtq.querySpec()
 		loadedTypes = [2]bool{
 			qtq.withQuestion != nil,
 			qtq.withTag != nil,
 		}
 	)
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, questiontag.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*QuestionTag).scanValues(nil, columns)
 	}
@@ -425,6 +420,9 @@ func (qtq *QuestionTagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(qtq.modifiers) > 0 {
+		_spec.Modifiers = qtq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -435,16 +433,14 @@ func (qtq *QuestionTagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		return nodes, nil
 	}
 	if query := qtq.withQuestion; query != nil {
-		if err := qtq.loadQuestion(ctx, query, nodes,
-			func(n *QuestionTag) { n.Edges.Question = []*Question{} },
-			func(n *QuestionTag, e *Question) { n.Edges.Question = append(n.Edges.Question, e) }); err != nil {
+		if err := qtq.loadQuestion(ctx, query, nodes, nil,
+			func(n *QuestionTag, e *Question) { n.Edges.Question = e }); err != nil {
 			return nil, err
 		}
 	}
 	if query := qtq.withTag; query != nil {
-		if err := qtq.loadTag(ctx, query, nodes,
-			func(n *QuestionTag) { n.Edges.Tag = []*Tag{} },
-			func(n *QuestionTag, e *Tag) { n.Edges.Tag = append(n.Edges.Tag, e) }); err != nil {
+		if err := qtq.loadTag(ctx, query, nodes, nil,
+			func(n *QuestionTag, e *Tag) { n.Edges.Tag = e }); err != nil {
 			return nil, err
 		}
 	}
@@ -452,70 +448,69 @@ func (qtq *QuestionTagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 }
 
 func (qtq *QuestionTagQuery) loadQuestion(ctx context.Context, query *QuestionQuery, nodes []*QuestionTag, init func(*QuestionTag), assign func(*QuestionTag, *Question)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*QuestionTag)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*QuestionTag)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].QuestionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
 	}
-	query.withFKs = true
-	query.Where(predicate.Question(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(questiontag.QuestionColumn), fks...))
-	}))
+	query.Where(question.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.question_tag_question
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "question_tag_question" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "question_tag_question" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "question_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
 func (qtq *QuestionTagQuery) loadTag(ctx context.Context, query *TagQuery, nodes []*QuestionTag, init func(*QuestionTag), assign func(*QuestionTag, *Tag)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*QuestionTag)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*QuestionTag)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].TagID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	query.withFKs = true
-	query.Where(predicate.Tag(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(questiontag.TagColumn), fks...))
-	}))
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tag.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.question_tag_tag
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "question_tag_tag" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "question_tag_tag" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "tag_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
 
 func (qtq *QuestionTagQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := qtq.querySpec()
+	if len(qtq.modifiers) > 0 {
+		_spec.Modifiers = qtq.modifiers
+	}
 	_spec.Node.Columns = qtq.ctx.Fields
 	if len(qtq.ctx.Fields) > 0 {
 		_spec.Unique = qtq.ctx.Unique != nil && *qtq.ctx.Unique
@@ -539,6 +534,12 @@ func (qtq *QuestionTagQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if qtq.withQuestion != nil {
+			_spec.Node.AddColumnOnce(questiontag.FieldQuestionID)
+		}
+		if qtq.withTag != nil {
+			_spec.Node.AddColumnOnce(questiontag.FieldTagID)
+		}
 	}
 	if ps := qtq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -578,6 +579,9 @@ func (qtq *QuestionTagQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if qtq.ctx.Unique != nil && *qtq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range qtq.modifiers {
+		m(selector)
+	}
 	for _, p := range qtq.predicates {
 		p(selector)
 	}
@@ -595,6 +599,12 @@ func (qtq *QuestionTagQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (qtq *QuestionTagQuery) Modify(modifiers ...func(s *sql.Selector)) *QuestionTagSelect {
+	qtq.modifiers = append(qtq.modifiers, modifiers...)
+	return qtq.Select()
+}
+
 // QuestionTagGroupBy is the group-by builder for QuestionTag entities.
 type QuestionTagGroupBy struct {
 	selector
@@ -684,3 +694,9 @@ func (qts *QuestionTagSelect) sqlScan(ctx context.Context, root *QuestionTagQuer
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (qts *QuestionTagSelect) Modify(modifiers ...func(s *sql.Selector)) *QuestionTagSelect {
+	qts.modifiers = append(qts.modifiers, modifiers...)
+	return qts
+}
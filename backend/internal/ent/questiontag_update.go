@@ -19,8 +19,9 @@ import (
 // QuestionTagUpdate is the builder for updating QuestionTag entities.
 type QuestionTagUpdate struct {
 	config
-	hooks    []Hook
-	mutation *QuestionTagMutation
+	hooks     []Hook
+	mutation  *QuestionTagMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the QuestionTagUpdate builder.
@@ -31,7 +32,6 @@ func (qtu *QuestionTagUpdate) Where(ps ...predicate.QuestionTag) *QuestionTagUpd
 
 // SetQuestionID sets the "question_id" field.
 func (qtu *QuestionTagUpdate) SetQuestionID(i int) *QuestionTagUpdate {
-	qtu.mutation.ResetQuestionID()
 	qtu.mutation.SetQuestionID(i)
 	return qtu
 }
@@ -44,15 +44,8 @@ func (qtu *QuestionTagUpdate) SetNillableQuestionID(i *int) *QuestionTagUpdate {
 	return qtu
 }
 
-// AddQuestionID adds i to the "question_id" field.
-func (qtu *QuestionTagUpdate) AddQuestionID(i int) *QuestionTagUpdate {
-	qtu.mutation.AddQuestionID(i)
-	return qtu
-}
-
 // SetTagID sets the "tag_id" field.
 func (qtu *QuestionTagUpdate) SetTagID(i int) *QuestionTagUpdate {
-	qtu.mutation.ResetTagID()
 	qtu.mutation.SetTagID(i)
 	return qtu
 }
@@ -65,40 +58,14 @@ func (qtu *QuestionTagUpdate) SetNillableTagID(i *int) *QuestionTagUpdate {
 	return qtu
 }
 
-// AddTagID adds i to the "tag_id" field.
-func (qtu *QuestionTagUpdate) AddTagID(i int) *QuestionTagUpdate {
-	qtu.mutation.AddTagID(i)
-	return qtu
-}
-
-// AddQuestionIDs adds the "question" edge to the Question entity by IDs.
-func (qtu *QuestionTagUpdate) AddQuestionIDs(ids ...int) *QuestionTagUpdate {
-	qtu.mutation.AddQuestionIDs(ids...)
-	return qtu
-}
-
-// AddQuestion adds the "question" edges to the Question entity.
-func (qtu *QuestionTagUpdate) AddQuestion(q ...*Question) *QuestionTagUpdate {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
-	}
-	return qtu.AddQuestionIDs(ids...)
-}
-
-// AddTagIDs adds the "tag" edge to the Tag entity by IDs.
-func (qtu *QuestionTagUpdate) AddTagIDs(ids ...int) *QuestionTagUpdate {
-	qtu.mutation.AddTagIDs(ids...)
-	return qtu
+// SetQuestion sets the "question" edge to the Question entity.
+func (qtu *QuestionTagUpdate) SetQuestion(q *Question) *QuestionTagUpdate {
+	return qtu.SetQuestionID(q.ID)
 }
 
-// AddTag adds the "tag" edges to the Tag entity.
-func (qtu *QuestionTagUpdate) AddTag(t ...*Tag) *QuestionTagUpdate {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
-	}
-	return qtu.AddTagIDs(ids...)
+// SetTag sets the "tag" edge to the Tag entity.
+func (qtu *QuestionTagUpdate) SetTag(t *Tag) *QuestionTagUpdate {
+	return qtu.SetTagID(t.ID)
 }
 
 // Mutation returns the QuestionTagMutation object of the builder.
@@ -106,48 +73,18 @@ func (qtu *QuestionTagUpdate) Mutation() *QuestionTagMutation {
 	return qtu.mutation
 }
 
-// ClearQuestion clears all "question" edges to the Question entity.
+// ClearQuestion clears the "question" edge to the Question entity.
 func (qtu *QuestionTagUpdate) ClearQuestion() *QuestionTagUpdate {
 	qtu.mutation.ClearQuestion()
 	return qtu
 }
 
-// RemoveQuestionIDs removes the "question" edge to Question entities by IDs.
-func (qtu *QuestionTagUpdate) RemoveQuestionIDs(ids ...int) *QuestionTagUpdate {
-	qtu.mutation.RemoveQuestionIDs(ids...)
-	return qtu
-}
-
-// RemoveQuestion removes "question" edges to Question entities.
-func (qtu *QuestionTagUpdate) RemoveQuestion(q ...*Question) *QuestionTagUpdate {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
-	}
-	return qtu.RemoveQuestionIDs(ids...)
-}
-
-// ClearTag clears all "tag" edges to the Tag entity.
+// ClearTag clears the "tag" edge to the Tag entity.
 func (qtu *QuestionTagUpdate) ClearTag() *QuestionTagUpdate {
 	qtu.mutation.ClearTag()
 	return qtu
 }
 
-// RemoveTagIDs removes the "tag" edge to Tag entities by IDs.
-func (qtu *QuestionTagUpdate) RemoveTagIDs(ids ...int) *QuestionTagUpdate {
-	qtu.mutation.RemoveTagIDs(ids...)
-	return qtu
-}
-
-// RemoveTag removes "tag" edges to Tag entities.
-func (qtu *QuestionTagUpdate) RemoveTag(t ...*Tag) *QuestionTagUpdate {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
-	}
-	return qtu.RemoveTagIDs(ids...)
-}
-
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (qtu *QuestionTagUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, qtu.sqlSave, qtu.mutation, qtu.hooks)
@@ -175,7 +112,27 @@ func (qtu *QuestionTagUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (qtu *QuestionTagUpdate) check() error {
+	if _, ok := qtu.mutation.QuestionID(); qtu.mutation.QuestionCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "QuestionTag.question"`)
+	}
+	if _, ok := qtu.mutation.TagID(); qtu.mutation.TagCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "QuestionTag.tag"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (qtu *QuestionTagUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuestionTagUpdate {
+	qtu.modifiers = append(qtu.modifiers, modifiers...)
+	return qtu
+}
+
 func (qtu *QuestionTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := qtu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(questiontag.Table, questiontag.Columns, sqlgraph.NewFieldSpec(questiontag.FieldID, field.TypeInt))
 	if ps := qtu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -184,35 +141,10 @@ func (qtu *QuestionTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
-	if value, ok := qtu.mutation.QuestionID(); ok {
-		_spec.SetField(questiontag.FieldQuestionID, field.TypeInt, value)
-	}
-	if value, ok := qtu.mutation.AddedQuestionID(); ok {
-		_spec.AddField(questiontag.FieldQuestionID, field.TypeInt, value)
-	}
-	if value, ok := qtu.mutation.TagID(); ok {
-		_spec.SetField(questiontag.FieldTagID, field.TypeInt, value)
-	}
-	if value, ok := qtu.mutation.AddedTagID(); ok {
-		_spec.AddField(questiontag.FieldTagID, field.TypeInt, value)
-	}
 	if qtu.mutation.QuestionCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   questiontag.QuestionTable,
-			Columns: []string{questiontag.QuestionColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := qtu.mutation.RemovedQuestionIDs(); len(nodes) > 0 && !qtu.mutation.QuestionCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   questiontag.QuestionTable,
 			Columns: []string{questiontag.QuestionColumn},
 			Bidi:    false,
@@ -220,15 +152,12 @@ func (qtu *QuestionTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := qtu.mutation.QuestionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   questiontag.QuestionTable,
 			Columns: []string{questiontag.QuestionColumn},
 			Bidi:    false,
@@ -243,21 +172,8 @@ func (qtu *QuestionTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if qtu.mutation.TagCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   questiontag.TagTable,
-			Columns: []string{questiontag.TagColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := qtu.mutation.RemovedTagIDs(); len(nodes) > 0 && !qtu.mutation.TagCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   questiontag.TagTable,
 			Columns: []string{questiontag.TagColumn},
 			Bidi:    false,
@@ -265,15 +181,12 @@ func (qtu *QuestionTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := qtu.mutation.TagIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   questiontag.TagTable,
 			Columns: []string{questiontag.TagColumn},
 			Bidi:    false,
@@ -286,6 +199,7 @@ func (qtu *QuestionTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(qtu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, qtu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{questiontag.Label}
@@ -301,14 +215,14 @@ func (qtu *QuestionTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // QuestionTagUpdateOne is the builder for updating a single QuestionTag entity.
 type QuestionTagUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *QuestionTagMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *QuestionTagMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetQuestionID sets the "question_id" field.
 func (qtuo *QuestionTagUpdateOne) SetQuestionID(i int) *QuestionTagUpdateOne {
-	qtuo.mutation.ResetQuestionID()
 	qtuo.mutation.SetQuestionID(i)
 	return qtuo
 }
@@ -321,15 +235,8 @@ func (qtuo *QuestionTagUpdateOne) SetNillableQuestionID(i *int) *QuestionTagUpda
 	return qtuo
 }
 
-// AddQuestionID adds i to the "question_id" field.
-func (qtuo *QuestionTagUpdateOne) AddQuestionID(i int) *QuestionTagUpdateOne {
-	qtuo.mutation.AddQuestionID(i)
-	return qtuo
-}
-
 // SetTagID sets the "tag_id" field.
 func (qtuo *QuestionTagUpdateOne) SetTagID(i int) *QuestionTagUpdateOne {
-	qtuo.mutation.ResetTagID()
 	qtuo.mutation.SetTagID(i)
 	return qtuo
 }
@@ -342,40 +249,14 @@ func (qtuo *QuestionTagUpdateOne) SetNillableTagID(i *int) *QuestionTagUpdateOne
 	return qtuo
 }
 
-// AddTagID adds i to the "tag_id" field.
-func (qtuo *QuestionTagUpdateOne) AddTagID(i int) *QuestionTagUpdateOne {
-	qtuo.mutation.AddTagID(i)
-	return qtuo
-}
-
-// AddQuestionIDs adds the "question" edge to the Question entity by IDs.
-func (qtuo *QuestionTagUpdateOne) AddQuestionIDs(ids ...int) *QuestionTagUpdateOne {
-	qtuo.mutation.AddQuestionIDs(ids...)
-	return qtuo
-}
-
-// AddQuestion adds the "question" edges to the Question entity.
-func (qtuo *QuestionTagUpdateOne) AddQuestion(q ...*Question) *QuestionTagUpdateOne {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
-	}
-	return qtuo.AddQuestionIDs(ids...)
-}
-
-// AddTagIDs adds the "tag" edge to the Tag entity by IDs.
-func (qtuo *QuestionTagUpdateOne) AddTagIDs(ids ...int) *QuestionTagUpdateOne {
-	qtuo.mutation.AddTagIDs(ids...)
-	return qtuo
+// SetQuestion sets the "question" edge to the Question entity.
+func (qtuo *QuestionTagUpdateOne) SetQuestion(q *Question) *QuestionTagUpdateOne {
+	return qtuo.SetQuestionID(q.ID)
 }
 
-// AddTag adds the "tag" edges to the Tag entity.
-func (qtuo *QuestionTagUpdateOne) AddTag(t ...*Tag) *QuestionTagUpdateOne {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
-	}
-	return qtuo.AddTagIDs(ids...)
+// SetTag sets the "tag" edge to the Tag entity.
+func (qtuo *QuestionTagUpdateOne) SetTag(t *Tag) *QuestionTagUpdateOne {
+	return qtuo.SetTagID(t.ID)
 }
 
 // Mutation returns the QuestionTagMutation object of the builder.
@@ -383,48 +264,18 @@ func (qtuo *QuestionTagUpdateOne) Mutation() *QuestionTagMutation {
 	return qtuo.mutation
 }
 
-// ClearQuestion clears all "question" edges to the Question entity.
+// ClearQuestion clears the "question" edge to the Question entity.
 func (qtuo *QuestionTagUpdateOne) ClearQuestion() *QuestionTagUpdateOne {
 	qtuo.mutation.ClearQuestion()
 	return qtuo
 }
 
-// RemoveQuestionIDs removes the "question" edge to Question entities by IDs.
-func (qtuo *QuestionTagUpdateOne) RemoveQuestionIDs(ids ...int) *QuestionTagUpdateOne {
-	qtuo.mutation.RemoveQuestionIDs(ids...)
-	return qtuo
-}
-
-// RemoveQuestion removes "question" edges to Question entities.
-func (qtuo *QuestionTagUpdateOne) RemoveQuestion(q ...*Question) *QuestionTagUpdateOne {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
-	}
-	return qtuo.RemoveQuestionIDs(ids...)
-}
-
-// ClearTag clears all "tag" edges to the Tag entity.
+// ClearTag clears the "tag" edge to the Tag entity.
 func (qtuo *QuestionTagUpdateOne) ClearTag() *QuestionTagUpdateOne {
 	qtuo.mutation.ClearTag()
 	return qtuo
 }
 
-// RemoveTagIDs removes the "tag" edge to Tag entities by IDs.
-func (qtuo *QuestionTagUpdateOne) RemoveTagIDs(ids ...int) *QuestionTagUpdateOne {
-	qtuo.mutation.RemoveTagIDs(ids...)
-	return qtuo
-}
-
-// RemoveTag removes "tag" edges to Tag entities.
-func (qtuo *QuestionTagUpdateOne) RemoveTag(t ...*Tag) *QuestionTagUpdateOne {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
-	}
-	return qtuo.RemoveTagIDs(ids...)
-}
-
 // Where appends a list predicates to the QuestionTagUpdate builder.
 func (qtuo *QuestionTagUpdateOne) Where(ps ...predicate.QuestionTag) *QuestionTagUpdateOne {
 	qtuo.mutation.Where(ps...)
@@ -465,7 +316,27 @@ func (qtuo *QuestionTagUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (qtuo *QuestionTagUpdateOne) check() error {
+	if _, ok := qtuo.mutation.QuestionID(); qtuo.mutation.QuestionCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "QuestionTag.question"`)
+	}
+	if _, ok := qtuo.mutation.TagID(); qtuo.mutation.TagCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "QuestionTag.tag"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (qtuo *QuestionTagUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuestionTagUpdateOne {
+	qtuo.modifiers = append(qtuo.modifiers, modifiers...)
+	return qtuo
+}
+
 func (qtuo *QuestionTagUpdateOne) sqlSave(ctx context.Context) (_node *QuestionTag, err error) {
+	if err := qtuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(questiontag.Table, questiontag.Columns, sqlgraph.NewFieldSpec(questiontag.FieldID, field.TypeInt))
 	id, ok := qtuo.mutation.ID()
 	if !ok {
@@ -491,35 +362,10 @@ func (qtuo *QuestionTagUpdateOne) sqlSave(ctx context.Context) (_node *QuestionT
 			}
 		}
 	}
-	if value, ok := qtuo.mutation.QuestionID(); ok {
-		_spec.SetField(questiontag.FieldQuestionID, field.TypeInt, value)
-	}
-	if value, ok := qtuo.mutation.AddedQuestionID(); ok {
-		_spec.AddField(questiontag.FieldQuestionID, field.TypeInt, value)
-	}
-	if value, ok := qtuo.mutation.TagID(); ok {
-		_spec.SetField(questiontag.FieldTagID, field.TypeInt, value)
-	}
-	if value, ok := qtuo.mutation.AddedTagID(); ok {
-		_spec.AddField(questiontag.FieldTagID, field.TypeInt, value)
-	}
 	if qtuo.mutation.QuestionCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   questiontag.QuestionTable,
-			Columns: []string{questiontag.QuestionColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := qtuo.mutation.RemovedQuestionIDs(); len(nodes) > 0 && !qtuo.mutation.QuestionCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   questiontag.QuestionTable,
 			Columns: []string{questiontag.QuestionColumn},
 			Bidi:    false,
@@ -527,15 +373,12 @@ func (qtuo *QuestionTagUpdateOne) sqlSave(ctx context.Context) (_node *QuestionT
 				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := qtuo.mutation.QuestionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   questiontag.QuestionTable,
 			Columns: []string{questiontag.QuestionColumn},
 			Bidi:    false,
@@ -550,21 +393,8 @@ func (qtuo *QuestionTagUpdateOne) sqlSave(ctx context.Context) (_node *QuestionT
 	}
 	if qtuo.mutation.TagCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   questiontag.TagTable,
-			Columns: []string{questiontag.TagColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := qtuo.mutation.RemovedTagIDs(); len(nodes) > 0 && !qtuo.mutation.TagCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   questiontag.TagTable,
 			Columns: []string{questiontag.TagColumn},
 			Bidi:    false,
@@ -572,15 +402,12 @@ func (qtuo *QuestionTagUpdateOne) sqlSave(ctx context.Context) (_node *QuestionT
 				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := qtuo.mutation.TagIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   questiontag.TagTable,
 			Columns: []string{questiontag.TagColumn},
 			Bidi:    false,
@@ -593,6 +420,7 @@ func (qtuo *QuestionTagUpdateOne) sqlSave(ctx context.Context) (_node *QuestionT
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(qtuo.modifiers...)
 	_node = &QuestionTag{config: qtuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
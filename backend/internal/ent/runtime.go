@@ -2,124 +2,4 @@
 
 package ent
 
-import (
-	"backend/internal/ent/comment"
-	"backend/internal/ent/follow"
-	"backend/internal/ent/post"
-	"backend/internal/ent/question"
-	"backend/internal/ent/schema"
-	"backend/internal/ent/tag"
-	"backend/internal/ent/user"
-	"time"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	commentMixin := schema.Comment{}.Mixin()
-	commentMixinFields0 := commentMixin[0].Fields()
-	_ = commentMixinFields0
-	commentFields := schema.Comment{}.Fields()
-	_ = commentFields
-	// commentDescCreateTime is the schema descriptor for create_time field.
-	commentDescCreateTime := commentMixinFields0[0].Descriptor()
-	// comment.DefaultCreateTime holds the default value on creation for the create_time field.
-	comment.DefaultCreateTime = commentDescCreateTime.Default.(func() time.Time)
-	// commentDescUpdateTime is the schema descriptor for update_time field.
-	commentDescUpdateTime := commentMixinFields0[1].Descriptor()
-	// comment.DefaultUpdateTime holds the default value on creation for the update_time field.
-	comment.DefaultUpdateTime = commentDescUpdateTime.Default.(func() time.Time)
-	// comment.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
-	comment.UpdateDefaultUpdateTime = commentDescUpdateTime.UpdateDefault.(func() time.Time)
-	followMixin := schema.Follow{}.Mixin()
-	followMixinFields0 := followMixin[0].Fields()
-	_ = followMixinFields0
-	followFields := schema.Follow{}.Fields()
-	_ = followFields
-	// followDescCreateTime is the schema descriptor for create_time field.
-	followDescCreateTime := followMixinFields0[0].Descriptor()
-	// follow.DefaultCreateTime holds the default value on creation for the create_time field.
-	follow.DefaultCreateTime = followDescCreateTime.Default.(func() time.Time)
-	// followDescUpdateTime is the schema descriptor for update_time field.
-	followDescUpdateTime := followMixinFields0[1].Descriptor()
-	// follow.DefaultUpdateTime holds the default value on creation for the update_time field.
-	follow.DefaultUpdateTime = followDescUpdateTime.Default.(func() time.Time)
-	// follow.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
-	follow.UpdateDefaultUpdateTime = followDescUpdateTime.UpdateDefault.(func() time.Time)
-	postMixin := schema.Post{}.Mixin()
-	postMixinFields0 := postMixin[0].Fields()
-	_ = postMixinFields0
-	postFields := schema.Post{}.Fields()
-	_ = postFields
-	// postDescCreateTime is the schema descriptor for create_time field.
-	postDescCreateTime := postMixinFields0[0].Descriptor()
-	// post.DefaultCreateTime holds the default value on creation for the create_time field.
-	post.DefaultCreateTime = postDescCreateTime.Default.(func() time.Time)
-	// postDescUpdateTime is the schema descriptor for update_time field.
-	postDescUpdateTime := postMixinFields0[1].Descriptor()
-	// post.DefaultUpdateTime holds the default value on creation for the update_time field.
-	post.DefaultUpdateTime = postDescUpdateTime.Default.(func() time.Time)
-	// post.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
-	post.UpdateDefaultUpdateTime = postDescUpdateTime.UpdateDefault.(func() time.Time)
-	// postDescTitle is the schema descriptor for title field.
-	postDescTitle := postFields[0].Descriptor()
-	// post.TitleValidator is a validator for the "title" field. It is called by the builders before save.
-	post.TitleValidator = postDescTitle.Validators[0].(func(string) error)
-	questionMixin := schema.Question{}.Mixin()
-	questionMixinFields0 := questionMixin[0].Fields()
-	_ = questionMixinFields0
-	questionFields := schema.Question{}.Fields()
-	_ = questionFields
-	// questionDescCreateTime is the schema descriptor for create_time field.
-	questionDescCreateTime := questionMixinFields0[0].Descriptor()
-	// question.DefaultCreateTime holds the default value on creation for the create_time field.
-	question.DefaultCreateTime = questionDescCreateTime.Default.(func() time.Time)
-	// questionDescUpdateTime is the schema descriptor for update_time field.
-	questionDescUpdateTime := questionMixinFields0[1].Descriptor()
-	// question.DefaultUpdateTime holds the default value on creation for the update_time field.
-	question.DefaultUpdateTime = questionDescUpdateTime.Default.(func() time.Time)
-	// question.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
-	question.UpdateDefaultUpdateTime = questionDescUpdateTime.UpdateDefault.(func() time.Time)
-	// questionDescTitle is the schema descriptor for title field.
-	questionDescTitle := questionFields[0].Descriptor()
-	// question.TitleValidator is a validator for the "title" field. It is called by the builders before save.
-	question.TitleValidator = questionDescTitle.Validators[0].(func(string) error)
-	tagFields := schema.Tag{}.Fields()
-	_ = tagFields
-	// tagDescName is the schema descriptor for name field.
-	tagDescName := tagFields[0].Descriptor()
-	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
-	userMixin := schema.User{}.Mixin()
-	userMixinFields0 := userMixin[0].Fields()
-	_ = userMixinFields0
-	userFields := schema.User{}.Fields()
-	_ = userFields
-	// userDescCreateTime is the schema descriptor for create_time field.
-	userDescCreateTime := userMixinFields0[0].Descriptor()
-	// user.DefaultCreateTime holds the default value on creation for the create_time field.
-	user.DefaultCreateTime = userDescCreateTime.Default.(func() time.Time)
-	// userDescUpdateTime is the schema descriptor for update_time field.
-	userDescUpdateTime := userMixinFields0[1].Descriptor()
-	// user.DefaultUpdateTime holds the default value on creation for the update_time field.
-	user.DefaultUpdateTime = userDescUpdateTime.Default.(func() time.Time)
-	// user.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
-	user.UpdateDefaultUpdateTime = userDescUpdateTime.UpdateDefault.(func() time.Time)
-	// userDescPhone is the schema descriptor for phone field.
-	userDescPhone := userFields[0].Descriptor()
-	// user.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
-	user.PhoneValidator = userDescPhone.Validators[0].(func(string) error)
-	// userDescNickname is the schema descriptor for nickname field.
-	userDescNickname := userFields[1].Descriptor()
-	// user.NicknameValidator is a validator for the "nickname" field. It is called by the builders before save.
-	user.NicknameValidator = userDescNickname.Validators[0].(func(string) error)
-	// userDescPassword is the schema descriptor for password field.
-	userDescPassword := userFields[2].Descriptor()
-	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
-	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
-	// userDescAvatar is the schema descriptor for avatar field.
-	userDescAvatar := userFields[3].Descriptor()
-	// user.AvatarValidator is a validator for the "avatar" field. It is called by the builders before save.
-	user.AvatarValidator = userDescAvatar.Validators[0].(func(string) error)
-}
+// The schema-stitching logic is generated in backend/internal/ent/runtime/runtime.go
@@ -2,7 +2,353 @@
 
 package runtime
 
-// The schema-stitching logic is generated in backend/internal/ent/runtime.go
+import (
+	"backend/internal/ent/answer"
+	"backend/internal/ent/answervote"
+	"backend/internal/ent/comment"
+	"backend/internal/ent/follow"
+	"backend/internal/ent/loginattempt"
+	"backend/internal/ent/post"
+	"backend/internal/ent/posttag"
+	"backend/internal/ent/question"
+	"backend/internal/ent/questiontag"
+	"backend/internal/ent/refreshtoken"
+	"backend/internal/ent/schema"
+	"backend/internal/ent/session"
+	"backend/internal/ent/tag"
+	"backend/internal/ent/tagalias"
+	"backend/internal/ent/user"
+	"backend/internal/ent/userfavoritepost"
+	"backend/internal/ent/userfavoritequestion"
+	"backend/internal/ent/userlikepost"
+	"backend/internal/ent/userlikequestion"
+	"backend/internal/ent/verificationcode"
+	"time"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	answerMixin := schema.Answer{}.Mixin()
+	answerHooks := schema.Answer{}.Hooks()
+	answer.Hooks[0] = answerHooks[0]
+	answerMixinFields0 := answerMixin[0].Fields()
+	_ = answerMixinFields0
+	answerFields := schema.Answer{}.Fields()
+	_ = answerFields
+	// answerDescCreateTime is the schema descriptor for create_time field.
+	answerDescCreateTime := answerMixinFields0[0].Descriptor()
+	// answer.DefaultCreateTime holds the default value on creation for the create_time field.
+	answer.DefaultCreateTime = answerDescCreateTime.Default.(func() time.Time)
+	// answerDescUpdateTime is the schema descriptor for update_time field.
+	answerDescUpdateTime := answerMixinFields0[1].Descriptor()
+	// answer.DefaultUpdateTime holds the default value on creation for the update_time field.
+	answer.DefaultUpdateTime = answerDescUpdateTime.Default.(func() time.Time)
+	// answer.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	answer.UpdateDefaultUpdateTime = answerDescUpdateTime.UpdateDefault.(func() time.Time)
+	// answerDescVoteScore is the schema descriptor for vote_score field.
+	answerDescVoteScore := answerFields[3].Descriptor()
+	// answer.DefaultVoteScore holds the default value on creation for the vote_score field.
+	answer.DefaultVoteScore = answerDescVoteScore.Default.(int)
+	answervoteMixin := schema.AnswerVote{}.Mixin()
+	answervoteMixinFields0 := answervoteMixin[0].Fields()
+	_ = answervoteMixinFields0
+	answervoteFields := schema.AnswerVote{}.Fields()
+	_ = answervoteFields
+	// answervoteDescCreateTime is the schema descriptor for create_time field.
+	answervoteDescCreateTime := answervoteMixinFields0[0].Descriptor()
+	// answervote.DefaultCreateTime holds the default value on creation for the create_time field.
+	answervote.DefaultCreateTime = answervoteDescCreateTime.Default.(func() time.Time)
+	// answervoteDescUpdateTime is the schema descriptor for update_time field.
+	answervoteDescUpdateTime := answervoteMixinFields0[1].Descriptor()
+	// answervote.DefaultUpdateTime holds the default value on creation for the update_time field.
+	answervote.DefaultUpdateTime = answervoteDescUpdateTime.Default.(func() time.Time)
+	// answervote.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	answervote.UpdateDefaultUpdateTime = answervoteDescUpdateTime.UpdateDefault.(func() time.Time)
+	commentMixin := schema.Comment{}.Mixin()
+	commentHooks := schema.Comment{}.Hooks()
+	comment.Hooks[0] = commentHooks[0]
+	commentMixinFields0 := commentMixin[0].Fields()
+	_ = commentMixinFields0
+	commentFields := schema.Comment{}.Fields()
+	_ = commentFields
+	// commentDescCreateTime is the schema descriptor for create_time field.
+	commentDescCreateTime := commentMixinFields0[0].Descriptor()
+	// comment.DefaultCreateTime holds the default value on creation for the create_time field.
+	comment.DefaultCreateTime = commentDescCreateTime.Default.(func() time.Time)
+	// commentDescUpdateTime is the schema descriptor for update_time field.
+	commentDescUpdateTime := commentMixinFields0[1].Descriptor()
+	// comment.DefaultUpdateTime holds the default value on creation for the update_time field.
+	comment.DefaultUpdateTime = commentDescUpdateTime.Default.(func() time.Time)
+	// comment.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	comment.UpdateDefaultUpdateTime = commentDescUpdateTime.UpdateDefault.(func() time.Time)
+	// commentDescDepth is the schema descriptor for depth field.
+	commentDescDepth := commentFields[6].Descriptor()
+	// comment.DefaultDepth holds the default value on creation for the depth field.
+	comment.DefaultDepth = commentDescDepth.Default.(int)
+	followMixin := schema.Follow{}.Mixin()
+	followHooks := schema.Follow{}.Hooks()
+	follow.Hooks[0] = followHooks[0]
+	followMixinFields0 := followMixin[0].Fields()
+	_ = followMixinFields0
+	followFields := schema.Follow{}.Fields()
+	_ = followFields
+	// followDescCreateTime is the schema descriptor for create_time field.
+	followDescCreateTime := followMixinFields0[0].Descriptor()
+	// follow.DefaultCreateTime holds the default value on creation for the create_time field.
+	follow.DefaultCreateTime = followDescCreateTime.Default.(func() time.Time)
+	// followDescUpdateTime is the schema descriptor for update_time field.
+	followDescUpdateTime := followMixinFields0[1].Descriptor()
+	// follow.DefaultUpdateTime holds the default value on creation for the update_time field.
+	follow.DefaultUpdateTime = followDescUpdateTime.Default.(func() time.Time)
+	// follow.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	follow.UpdateDefaultUpdateTime = followDescUpdateTime.UpdateDefault.(func() time.Time)
+	loginattemptMixin := schema.LoginAttempt{}.Mixin()
+	loginattemptMixinFields0 := loginattemptMixin[0].Fields()
+	_ = loginattemptMixinFields0
+	loginattemptFields := schema.LoginAttempt{}.Fields()
+	_ = loginattemptFields
+	// loginattemptDescCreateTime is the schema descriptor for create_time field.
+	loginattemptDescCreateTime := loginattemptMixinFields0[0].Descriptor()
+	// loginattempt.DefaultCreateTime holds the default value on creation for the create_time field.
+	loginattempt.DefaultCreateTime = loginattemptDescCreateTime.Default.(func() time.Time)
+	// loginattemptDescKey is the schema descriptor for key field.
+	loginattemptDescKey := loginattemptFields[0].Descriptor()
+	// loginattempt.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	loginattempt.KeyValidator = loginattemptDescKey.Validators[0].(func(string) error)
+	postMixin := schema.Post{}.Mixin()
+	postMixinFields0 := postMixin[0].Fields()
+	_ = postMixinFields0
+	postFields := schema.Post{}.Fields()
+	_ = postFields
+	// postDescCreateTime is the schema descriptor for create_time field.
+	postDescCreateTime := postMixinFields0[0].Descriptor()
+	// post.DefaultCreateTime holds the default value on creation for the create_time field.
+	post.DefaultCreateTime = postDescCreateTime.Default.(func() time.Time)
+	// postDescUpdateTime is the schema descriptor for update_time field.
+	postDescUpdateTime := postMixinFields0[1].Descriptor()
+	// post.DefaultUpdateTime holds the default value on creation for the update_time field.
+	post.DefaultUpdateTime = postDescUpdateTime.Default.(func() time.Time)
+	// post.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	post.UpdateDefaultUpdateTime = postDescUpdateTime.UpdateDefault.(func() time.Time)
+	// postDescTitle is the schema descriptor for title field.
+	postDescTitle := postFields[0].Descriptor()
+	// post.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	post.TitleValidator = postDescTitle.Validators[0].(func(string) error)
+	// postDescLikeCount is the schema descriptor for like_count field.
+	postDescLikeCount := postFields[4].Descriptor()
+	// post.DefaultLikeCount holds the default value on creation for the like_count field.
+	post.DefaultLikeCount = postDescLikeCount.Default.(int)
+	// postDescFavoriteCount is the schema descriptor for favorite_count field.
+	postDescFavoriteCount := postFields[5].Descriptor()
+	// post.DefaultFavoriteCount holds the default value on creation for the favorite_count field.
+	post.DefaultFavoriteCount = postDescFavoriteCount.Default.(int)
+	// postDescCommentCount is the schema descriptor for comment_count field.
+	postDescCommentCount := postFields[6].Descriptor()
+	// post.DefaultCommentCount holds the default value on creation for the comment_count field.
+	post.DefaultCommentCount = postDescCommentCount.Default.(int)
+	posttagHooks := schema.PostTag{}.Hooks()
+	posttag.Hooks[0] = posttagHooks[0]
+	questionMixin := schema.Question{}.Mixin()
+	questionMixinFields0 := questionMixin[0].Fields()
+	_ = questionMixinFields0
+	questionFields := schema.Question{}.Fields()
+	_ = questionFields
+	// questionDescCreateTime is the schema descriptor for create_time field.
+	questionDescCreateTime := questionMixinFields0[0].Descriptor()
+	// question.DefaultCreateTime holds the default value on creation for the create_time field.
+	question.DefaultCreateTime = questionDescCreateTime.Default.(func() time.Time)
+	// questionDescUpdateTime is the schema descriptor for update_time field.
+	questionDescUpdateTime := questionMixinFields0[1].Descriptor()
+	// question.DefaultUpdateTime holds the default value on creation for the update_time field.
+	question.DefaultUpdateTime = questionDescUpdateTime.Default.(func() time.Time)
+	// question.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	question.UpdateDefaultUpdateTime = questionDescUpdateTime.UpdateDefault.(func() time.Time)
+	// questionDescTitle is the schema descriptor for title field.
+	questionDescTitle := questionFields[0].Descriptor()
+	// question.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	question.TitleValidator = questionDescTitle.Validators[0].(func(string) error)
+	// questionDescLikeCount is the schema descriptor for like_count field.
+	questionDescLikeCount := questionFields[5].Descriptor()
+	// question.DefaultLikeCount holds the default value on creation for the like_count field.
+	question.DefaultLikeCount = questionDescLikeCount.Default.(int)
+	// questionDescFavoriteCount is the schema descriptor for favorite_count field.
+	questionDescFavoriteCount := questionFields[6].Descriptor()
+	// question.DefaultFavoriteCount holds the default value on creation for the favorite_count field.
+	question.DefaultFavoriteCount = questionDescFavoriteCount.Default.(int)
+	// questionDescCommentCount is the schema descriptor for comment_count field.
+	questionDescCommentCount := questionFields[7].Descriptor()
+	// question.DefaultCommentCount holds the default value on creation for the comment_count field.
+	question.DefaultCommentCount = questionDescCommentCount.Default.(int)
+	// questionDescAnswerCount is the schema descriptor for answer_count field.
+	questionDescAnswerCount := questionFields[8].Descriptor()
+	// question.DefaultAnswerCount holds the default value on creation for the answer_count field.
+	question.DefaultAnswerCount = questionDescAnswerCount.Default.(int)
+	questiontagHooks := schema.QuestionTag{}.Hooks()
+	questiontag.Hooks[0] = questiontagHooks[0]
+	refreshtokenMixin := schema.RefreshToken{}.Mixin()
+	refreshtokenMixinFields0 := refreshtokenMixin[0].Fields()
+	_ = refreshtokenMixinFields0
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescCreateTime is the schema descriptor for create_time field.
+	refreshtokenDescCreateTime := refreshtokenMixinFields0[0].Descriptor()
+	// refreshtoken.DefaultCreateTime holds the default value on creation for the create_time field.
+	refreshtoken.DefaultCreateTime = refreshtokenDescCreateTime.Default.(func() time.Time)
+	// refreshtokenDescUpdateTime is the schema descriptor for update_time field.
+	refreshtokenDescUpdateTime := refreshtokenMixinFields0[1].Descriptor()
+	// refreshtoken.DefaultUpdateTime holds the default value on creation for the update_time field.
+	refreshtoken.DefaultUpdateTime = refreshtokenDescUpdateTime.Default.(func() time.Time)
+	// refreshtoken.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	refreshtoken.UpdateDefaultUpdateTime = refreshtokenDescUpdateTime.UpdateDefault.(func() time.Time)
+	// refreshtokenDescJti is the schema descriptor for jti field.
+	refreshtokenDescJti := refreshtokenFields[0].Descriptor()
+	// refreshtoken.JtiValidator is a validator for the "jti" field. It is called by the builders before save.
+	refreshtoken.JtiValidator = refreshtokenDescJti.Validators[0].(func(string) error)
+	sessionMixin := schema.Session{}.Mixin()
+	sessionMixinFields0 := sessionMixin[0].Fields()
+	_ = sessionMixinFields0
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescCreateTime is the schema descriptor for create_time field.
+	sessionDescCreateTime := sessionMixinFields0[0].Descriptor()
+	// session.DefaultCreateTime holds the default value on creation for the create_time field.
+	session.DefaultCreateTime = sessionDescCreateTime.Default.(func() time.Time)
+	// sessionDescUpdateTime is the schema descriptor for update_time field.
+	sessionDescUpdateTime := sessionMixinFields0[1].Descriptor()
+	// session.DefaultUpdateTime holds the default value on creation for the update_time field.
+	session.DefaultUpdateTime = sessionDescUpdateTime.Default.(func() time.Time)
+	// session.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	session.UpdateDefaultUpdateTime = sessionDescUpdateTime.UpdateDefault.(func() time.Time)
+	// sessionDescDevice is the schema descriptor for device field.
+	sessionDescDevice := sessionFields[1].Descriptor()
+	// session.DeviceValidator is a validator for the "device" field. It is called by the builders before save.
+	session.DeviceValidator = sessionDescDevice.Validators[0].(func(string) error)
+	// sessionDescUserAgent is the schema descriptor for user_agent field.
+	sessionDescUserAgent := sessionFields[2].Descriptor()
+	// session.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	session.UserAgentValidator = sessionDescUserAgent.Validators[0].(func(string) error)
+	// sessionDescIP is the schema descriptor for ip field.
+	sessionDescIP := sessionFields[3].Descriptor()
+	// session.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	session.IPValidator = sessionDescIP.Validators[0].(func(string) error)
+	// sessionDescLastRefreshTime is the schema descriptor for last_refresh_time field.
+	sessionDescLastRefreshTime := sessionFields[4].Descriptor()
+	// session.DefaultLastRefreshTime holds the default value on creation for the last_refresh_time field.
+	session.DefaultLastRefreshTime = sessionDescLastRefreshTime.Default.(func() time.Time)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[0].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
+	// tagDescPostCount is the schema descriptor for post_count field.
+	tagDescPostCount := tagFields[1].Descriptor()
+	// tag.DefaultPostCount holds the default value on creation for the post_count field.
+	tag.DefaultPostCount = tagDescPostCount.Default.(int)
+	// tagDescQuestionCount is the schema descriptor for question_count field.
+	tagDescQuestionCount := tagFields[2].Descriptor()
+	// tag.DefaultQuestionCount holds the default value on creation for the question_count field.
+	tag.DefaultQuestionCount = tagDescQuestionCount.Default.(int)
+	tagaliasMixin := schema.TagAlias{}.Mixin()
+	tagaliasMixinFields0 := tagaliasMixin[0].Fields()
+	_ = tagaliasMixinFields0
+	tagaliasFields := schema.TagAlias{}.Fields()
+	_ = tagaliasFields
+	// tagaliasDescCreateTime is the schema descriptor for create_time field.
+	tagaliasDescCreateTime := tagaliasMixinFields0[0].Descriptor()
+	// tagalias.DefaultCreateTime holds the default value on creation for the create_time field.
+	tagalias.DefaultCreateTime = tagaliasDescCreateTime.Default.(func() time.Time)
+	// tagaliasDescUpdateTime is the schema descriptor for update_time field.
+	tagaliasDescUpdateTime := tagaliasMixinFields0[1].Descriptor()
+	// tagalias.DefaultUpdateTime holds the default value on creation for the update_time field.
+	tagalias.DefaultUpdateTime = tagaliasDescUpdateTime.Default.(func() time.Time)
+	// tagalias.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	tagalias.UpdateDefaultUpdateTime = tagaliasDescUpdateTime.UpdateDefault.(func() time.Time)
+	// tagaliasDescName is the schema descriptor for name field.
+	tagaliasDescName := tagaliasFields[0].Descriptor()
+	// tagalias.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tagalias.NameValidator = tagaliasDescName.Validators[0].(func(string) error)
+	userMixin := schema.User{}.Mixin()
+	userMixinFields0 := userMixin[0].Fields()
+	_ = userMixinFields0
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescCreateTime is the schema descriptor for create_time field.
+	userDescCreateTime := userMixinFields0[0].Descriptor()
+	// user.DefaultCreateTime holds the default value on creation for the create_time field.
+	user.DefaultCreateTime = userDescCreateTime.Default.(func() time.Time)
+	// userDescUpdateTime is the schema descriptor for update_time field.
+	userDescUpdateTime := userMixinFields0[1].Descriptor()
+	// user.DefaultUpdateTime holds the default value on creation for the update_time field.
+	user.DefaultUpdateTime = userDescUpdateTime.Default.(func() time.Time)
+	// user.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	user.UpdateDefaultUpdateTime = userDescUpdateTime.UpdateDefault.(func() time.Time)
+	// userDescPhone is the schema descriptor for phone field.
+	userDescPhone := userFields[0].Descriptor()
+	// user.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	user.PhoneValidator = userDescPhone.Validators[0].(func(string) error)
+	// userDescNickname is the schema descriptor for nickname field.
+	userDescNickname := userFields[1].Descriptor()
+	// user.NicknameValidator is a validator for the "nickname" field. It is called by the builders before save.
+	user.NicknameValidator = userDescNickname.Validators[0].(func(string) error)
+	// userDescPassword is the schema descriptor for password field.
+	userDescPassword := userFields[2].Descriptor()
+	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
+	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
+	// userDescAvatar is the schema descriptor for avatar field.
+	userDescAvatar := userFields[3].Descriptor()
+	// user.AvatarValidator is a validator for the "avatar" field. It is called by the builders before save.
+	user.AvatarValidator = userDescAvatar.Validators[0].(func(string) error)
+	// userDescFollowerCount is the schema descriptor for follower_count field.
+	userDescFollowerCount := userFields[7].Descriptor()
+	// user.DefaultFollowerCount holds the default value on creation for the follower_count field.
+	user.DefaultFollowerCount = userDescFollowerCount.Default.(int)
+	// userDescFollowingCount is the schema descriptor for following_count field.
+	userDescFollowingCount := userFields[8].Descriptor()
+	// user.DefaultFollowingCount holds the default value on creation for the following_count field.
+	user.DefaultFollowingCount = userDescFollowingCount.Default.(int)
+	userfavoritepostHooks := schema.UserFavoritePost{}.Hooks()
+	userfavoritepost.Hooks[0] = userfavoritepostHooks[0]
+	userfavoritequestionHooks := schema.UserFavoriteQuestion{}.Hooks()
+	userfavoritequestion.Hooks[0] = userfavoritequestionHooks[0]
+	userlikepostHooks := schema.UserLikePost{}.Hooks()
+	userlikepost.Hooks[0] = userlikepostHooks[0]
+	userlikequestionHooks := schema.UserLikeQuestion{}.Hooks()
+	userlikequestion.Hooks[0] = userlikequestionHooks[0]
+	verificationcodeMixin := schema.VerificationCode{}.Mixin()
+	verificationcodeMixinFields0 := verificationcodeMixin[0].Fields()
+	_ = verificationcodeMixinFields0
+	verificationcodeFields := schema.VerificationCode{}.Fields()
+	_ = verificationcodeFields
+	// verificationcodeDescCreateTime is the schema descriptor for create_time field.
+	verificationcodeDescCreateTime := verificationcodeMixinFields0[0].Descriptor()
+	// verificationcode.DefaultCreateTime holds the default value on creation for the create_time field.
+	verificationcode.DefaultCreateTime = verificationcodeDescCreateTime.Default.(func() time.Time)
+	// verificationcodeDescUpdateTime is the schema descriptor for update_time field.
+	verificationcodeDescUpdateTime := verificationcodeMixinFields0[1].Descriptor()
+	// verificationcode.DefaultUpdateTime holds the default value on creation for the update_time field.
+	verificationcode.DefaultUpdateTime = verificationcodeDescUpdateTime.Default.(func() time.Time)
+	// verificationcode.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	verificationcode.UpdateDefaultUpdateTime = verificationcodeDescUpdateTime.UpdateDefault.(func() time.Time)
+	// verificationcodeDescPhone is the schema descriptor for phone field.
+	verificationcodeDescPhone := verificationcodeFields[0].Descriptor()
+	// verificationcode.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	verificationcode.PhoneValidator = verificationcodeDescPhone.Validators[0].(func(string) error)
+	// verificationcodeDescIP is the schema descriptor for ip field.
+	verificationcodeDescIP := verificationcodeFields[1].Descriptor()
+	// verificationcode.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	verificationcode.IPValidator = verificationcodeDescIP.Validators[0].(func(string) error)
+	// verificationcodeDescCodeHash is the schema descriptor for code_hash field.
+	verificationcodeDescCodeHash := verificationcodeFields[3].Descriptor()
+	// verificationcode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	verificationcode.CodeHashValidator = verificationcodeDescCodeHash.Validators[0].(func(string) error)
+	// verificationcodeDescAttempts is the schema descriptor for attempts field.
+	verificationcodeDescAttempts := verificationcodeFields[5].Descriptor()
+	// verificationcode.DefaultAttempts holds the default value on creation for the attempts field.
+	verificationcode.DefaultAttempts = verificationcodeDescAttempts.Default.(int)
+}
 
 const (
 	Version = "v0.13.1"                                         // Version of ent codegen.
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+
+	"backend/internal/ent/hook"
+)
+
+// Answer holds the schema definition for the Answer entity.
+type Answer struct {
+	ent.Schema
+}
+
+func (Answer) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("content"),
+		field.Int("question_id"),
+		field.Int("user_id"),
+		// 赞成票减反对票，随 answer_votes 同步更新，用于按票数排序
+		field.Int("vote_score").Default(0),
+	}
+}
+
+func (Answer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("question", Question.Type).Ref("answers").Field("question_id").Unique().Required(),
+		edge.From("user", User.Type).Ref("answers").Field("user_id").Unique().Required(),
+		edge.To("votes", AnswerVote.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("comments", Comment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Answer) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+func (Answer) Indexes() []ent.Index {
+	return []ent.Index{
+		// 回答列表按票数或时间做游标分页
+		index.Fields("question_id", "vote_score", "id"),
+		index.Fields("question_id", "create_time", "id"),
+		index.Fields("user_id"),
+	}
+}
+
+func (Answer) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.Counters(hook.QuestionAnswers),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// AnswerVote holds the schema definition for the AnswerVote entity.
+type AnswerVote struct {
+	ent.Schema
+}
+
+func (AnswerVote) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("answer_id"),
+		field.Int("user_id"),
+		// 1 为赞成，-1 为反对，取消投票直接删除记录
+		field.Int("value"),
+	}
+}
+
+func (AnswerVote) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("answer", Answer.Type).Ref("votes").Field("answer_id").Unique().Required(),
+		edge.From("user", User.Type).Ref("answer_votes").Field("user_id").Unique().Required(),
+	}
+}
+
+func (AnswerVote) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+func (AnswerVote) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每人每个回答只有一票
+		index.Fields("answer_id", "user_id").Unique(),
+		index.Fields("user_id"),
+	}
+}
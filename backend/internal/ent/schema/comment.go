@@ -2,10 +2,13 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
+
+	"backend/internal/ent/hook"
 )
 
 // Comment holds the schema definition for the Comment entity.
@@ -19,17 +22,31 @@ func (Comment) Fields() []ent.Field {
 		field.Int("user_id"),
 		field.Int("post_id").Optional(),
 		field.Int("question_id").Optional(),
+		field.Int("answer_id").Optional(),
 		field.Int("parent_id").Optional(),
+		// depth 从 1 开始，一级评论为 1
+		field.Int("depth").Default(1),
+		// 超过最大层数的回复会挂到上一层，这里记下实际回复的用户
+		field.Int("reply_to_user_id").Optional(),
+		// 删除有回复的评论时只抹去内容，保留楼层结构；这样的墓碑不计入文章、问题的评论数
+		field.Time("deleted_at").Optional().Nillable(),
 	}
 }
 
 func (Comment) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("user", User.Type).Required(),
-		edge.To("post", Post.Type),
-		edge.To("question", Question.Type),
-		edge.To("parent", Comment.Type),
-		edge.To("replies", Comment.Type),
+		edge.From("user", User.Type).Ref("comments").Field("user_id").Unique().Required(),
+		edge.From("post", Post.Type).Ref("comments").Field("post_id").Unique(),
+		edge.From("question", Question.Type).Ref("comments").Field("question_id").Unique(),
+		edge.From("answer", Answer.Type).Ref("comments").Field("answer_id").Unique(),
+		edge.To("reply_to_user", User.Type).
+			Field("reply_to_user_id").
+			Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("replies", Comment.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
 	}
 }
 
@@ -43,7 +60,14 @@ func (Comment) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("post_id"),
 		index.Fields("question_id"),
+		index.Fields("answer_id"),
 		index.Fields("user_id"),
-		index.Fields("parent_id"),
+		index.Fields("parent_id", "create_time", "id"),
+	}
+}
+
+func (Comment) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.Counters(hook.PostComments, hook.QuestionComments),
 	}
 }
@@ -2,10 +2,14 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
+
+	"backend/internal/ent/hook"
 )
 
 // Follow holds the schema definition for the Follow entity.
@@ -22,8 +26,8 @@ func (Follow) Fields() []ent.Field {
 
 func (Follow) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("follower", User.Type),
-		edge.To("following", User.Type),
+		edge.From("follower", User.Type).Ref("following").Field("follower_id").Unique().Required(),
+		edge.From("following", User.Type).Ref("followed_by").Field("following_id").Unique().Required(),
 	}
 }
 
@@ -35,7 +39,24 @@ func (Follow) Mixin() []ent.Mixin {
 
 func (Follow) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("follower_id"),
-		index.Fields("following_id"),
+		// 同一对用户只能关注一次；关注列表按 (create_time, id) 倒序分页
+		index.Fields("follower_id", "following_id").Unique(),
+		index.Fields("follower_id", "create_time", "id"),
+		index.Fields("following_id", "create_time", "id"),
+	}
+}
+
+func (Follow) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// 不能关注自己，服务层也会先行校验
+		entsql.Checks(map[string]string{
+			"follow_not_self": "follower_id <> following_id",
+		}),
+	}
+}
+
+func (Follow) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.Counters(hook.UserFollowers, hook.UserFollowings),
 	}
 }
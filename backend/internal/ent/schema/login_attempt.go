@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// LoginAttempt holds the schema definition for the LoginAttempt entity.
+// 每行是一次失败的登录，key 形如 "phone:138..." 或 "ip:1.2.3.4"，供多实例共享限流计数。
+type LoginAttempt struct {
+	ent.Schema
+}
+
+func (LoginAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").MaxLen(128).Immutable(),
+	}
+}
+
+func (LoginAttempt) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+func (LoginAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key", "create_time"),
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+
+	"backend/internal/ent/hook"
 )
 
 // PostTag holds the schema definition for the PostTag entity.
@@ -21,14 +23,21 @@ func (PostTag) Fields() []ent.Field {
 
 func (PostTag) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("post", Post.Type),
-		edge.To("tag", Tag.Type),
+		edge.From("post", Post.Type).Ref("tags").Field("post_id").Unique().Required(),
+		edge.From("tag", Tag.Type).Ref("posts").Field("tag_id").Unique().Required(),
 	}
 }
 
 func (PostTag) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("tag_id"),
-		index.Fields("post_id"),
+		// 同一内容上的同一标签只能关联一次
+		index.Fields("post_id", "tag_id").Unique(),
+	}
+}
+
+func (PostTag) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.Counters(hook.TagPosts),
 	}
 }
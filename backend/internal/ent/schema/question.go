@@ -2,10 +2,13 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
+
+	"backend/internal/pgvector"
 )
 
 // Question holds the schema definition for the Question entity.
@@ -16,17 +19,43 @@ type Question struct {
 func (Question) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("title").MaxLen(255),
-		field.Bytes("embedding").Optional(),
+		// 问题描述，补充标题之外的背景信息，可以不填
+		field.Text("body").Optional(),
+		// 标题和正文的 embedding，尚未生成时为空
+		field.Other("embedding", pgvector.Vector{}).
+			SchemaType(pgvector.SchemaType(pgvector.Dimensions)).
+			Optional(),
+		// 清除账号时由服务层删除作者的问题；可空只为兼容没有作者的历史数据
+		field.Int("user_id").Optional(),
+		// 提问者采纳的回答，未采纳时为空
+		field.Int("accepted_answer_id").Optional().Nillable(),
+		// 以下计数由关系表上的 hook 维护，可用 cmd/reconcile-counters 对账
+		field.Int("like_count").Default(0),
+		field.Int("favorite_count").Default(0),
+		field.Int("comment_count").Default(0),
+		field.Int("answer_count").Default(0),
 	}
 }
 
 func (Question) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("user", User.Type),
-		edge.To("comments", Comment.Type),
-		edge.To("tags", QuestionTag.Type),
-		edge.To("likes", UserLikeQuestion.Type),
-		edge.To("favorites", UserFavoriteQuestion.Type),
+		edge.From("user", User.Type).Ref("questions").Field("user_id").Unique(),
+		// 服务层删除问题时会先删掉这些关联以维护计数，级联只是兜底
+		edge.To("comments", Comment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("answers", Answer.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tags", QuestionTag.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("likes", UserLikeQuestion.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("favorites", UserFavoriteQuestion.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// 被采纳的回答删除后问题回到未采纳状态
+		edge.To("accepted_answer", Answer.Type).
+			Field("accepted_answer_id").
+			Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
 	}
 }
 
@@ -38,6 +67,20 @@ func (Question) Mixin() []ent.Mixin {
 
 func (Question) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("title"),
+		// 搜索建议用 LIKE 'prefix%' 匹配标题开头，需要 pattern_ops 才能走索引
+		index.Fields("title").Annotations(entsql.OpClass("varchar_pattern_ops")),
+		index.Fields("user_id"),
+		// 首页信息流按 (create_time, id) 倒序做游标分页
+		index.Fields("create_time", "id"),
+		// 语义检索按余弦距离取最近邻。数据量大、建索引内存紧张时可改用 ivfflat
+		index.Fields("embedding").
+			Annotations(entsql.IndexType("hnsw"), entsql.OpClass("vector_cosine_ops")),
+		// 关键词搜索对标题和描述做 ILIKE 子串匹配，需要 pg_trgm 的 GIN 索引；不足三个字的关键词用不上索引，服务层只让它们匹配标题
+		index.Fields("title").
+			Annotations(entsql.IndexType("GIN"), entsql.OpClass("gin_trgm_ops")).
+			StorageKey("question_title_trgm"),
+		index.Fields("body").
+			Annotations(entsql.IndexType("GIN"), entsql.OpClass("gin_trgm_ops")).
+			StorageKey("question_body_trgm"),
 	}
 }
@@ -2,9 +2,11 @@ package schema
 
 import (
 	"entgo.io/ent"
-	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+
+	"backend/internal/ent/hook"
 )
 
 // QuestionTag holds the schema definition for the QuestionTag entity.
@@ -21,14 +23,21 @@ func (QuestionTag) Fields() []ent.Field {
 
 func (QuestionTag) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("question", Question.Type),
-		edge.To("tag", Tag.Type),
+		edge.From("question", Question.Type).Ref("tags").Field("question_id").Unique().Required(),
+		edge.From("tag", Tag.Type).Ref("questions").Field("tag_id").Unique().Required(),
 	}
 }
 
 func (QuestionTag) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("tag_id"),
-		index.Fields("question_id"),
+		// 同一内容上的同一标签只能关联一次
+		index.Fields("question_id", "tag_id").Unique(),
+	}
+}
+
+func (QuestionTag) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.Counters(hook.TagQuestions),
 	}
 }
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// RefreshToken holds the schema definition for the RefreshToken entity.
+// 每次刷新都会签发新 token 并作废旧 token，同一次登录派生出的 token 归属同一个 Session，
+// 旧 token 被重放时整个 Session 一起吊销。
+type RefreshToken struct {
+	ent.Schema
+}
+
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("jti").MaxLen(64).Unique().Immutable(),
+		field.Int("session_id").Immutable(),
+		field.Int("user_id").Immutable(),
+		field.Time("expires_at").Immutable(),
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("revoked_at").Optional().Nillable(),
+	}
+}
+
+func (RefreshToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("refresh_tokens").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("session", Session.Type).
+			Ref("refresh_tokens").
+			Field("session_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (RefreshToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+func (RefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("session_id"),
+		index.Fields("user_id"),
+	}
+}
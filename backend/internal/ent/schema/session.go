@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Session holds the schema definition for the Session entity.
+// 一次登录对应一个 Session，它名下的 refresh token 逐次轮换，吊销 Session 即踢下该设备。
+type Session struct {
+	ent.Schema
+}
+
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").Immutable(),
+		field.String("device").MaxLen(100).Optional(),
+		field.String("user_agent").MaxLen(512).Optional(),
+		field.String("ip").MaxLen(64).Optional(),
+		field.Time("last_refresh_time").Default(time.Now),
+		field.Time("revoked_at").Optional().Nillable(),
+	}
+}
+
+func (Session) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("sessions").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.To("refresh_tokens", RefreshToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Session) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
@@ -2,8 +2,9 @@ package schema
 
 import (
 	"entgo.io/ent"
-	"entgo.io/ent/schema/field"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 )
 
@@ -14,19 +15,28 @@ type Tag struct {
 
 func (Tag) Fields() []ent.Field {
 	return []ent.Field{
+		// 规范化后的名称，写入前统一经过 service 层的 NormalizeTagName
 		field.String("name").MaxLen(255).Unique(),
+		// 以下计数由关系表上的 hook 维护，可用 cmd/reconcile-counters 对账
+		field.Int("post_count").Default(0),
+		field.Int("question_count").Default(0),
 	}
 }
 
 func (Tag) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("posts", PostTag.Type),
-		edge.To("questions", QuestionTag.Type),
+		edge.To("posts", PostTag.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("questions", QuestionTag.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("aliases", TagAlias.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
 func (Tag) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("name"),
+		// 自动补全用 LIKE 'prefix%' 查询，需要 pattern_ops 才能走索引
+		index.Fields("name").Annotations(entsql.OpClass("varchar_pattern_ops")),
 	}
 }
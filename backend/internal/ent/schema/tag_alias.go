@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// TagAlias holds the schema definition for the TagAlias entity.
+// 标签被合并后旧名称保留为别名，之后再用旧名称打标签会落到合并后的标签上。
+type TagAlias struct {
+	ent.Schema
+}
+
+func (TagAlias) Fields() []ent.Field {
+	return []ent.Field{
+		// 与 Tag.name 一样是规范化后的名称，两张表中的名称互不重复
+		field.String("name").MaxLen(255).Unique(),
+		field.Int("tag_id"),
+	}
+}
+
+func (TagAlias) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tag", Tag.Type).Ref("aliases").Field("tag_id").Unique().Required(),
+	}
+}
+
+func (TagAlias) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+func (TagAlias) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tag_id"),
+		index.Fields("name").Annotations(entsql.OpClass("varchar_pattern_ops")),
+	}
+}
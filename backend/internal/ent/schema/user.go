@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -20,21 +21,49 @@ func (User) Fields() []ent.Field {
 		field.String("nickname").MaxLen(50).Optional(),
 		field.String("password").MaxLen(255),
 		field.String("avatar").MaxLen(255).Optional(),
+		field.Enum("role").Values("user", "moderator", "admin").Default("user"),
+		// 申请注销的时间，宽限期内重新登录即撤销注销
+		field.Time("deletion_requested_at").Optional().Nillable(),
+		// 账号数据被清除的时间，之后该行只作为匿名作者保留
+		field.Time("deleted_at").Optional().Nillable(),
+		// 粉丝数和关注数由 follows 上的 hook 维护，可用 cmd/reconcile-counters 对账
+		field.Int("follower_count").Default(0),
+		field.Int("following_count").Default(0),
 	}
 }
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("posts", Post.Type),
-		edge.To("questions", Question.Type),
-		edge.To("comments", Comment.Type),
-		edge.To("like_posts", UserLikePost.Type),
-		edge.To("like_questions", UserLikeQuestion.Type),
-		edge.To("favorite_posts", UserFavoritePost.Type),
-		edge.To("favorite_questions", UserFavoriteQuestion.Type),
-		edge.To("following", Follow.Type),
-		edge.To("followed_by", Follow.Type),
+		// 清除账号只匿名化用户行、由服务层删除其内容，用户行不会被物理删除。
+		// 以下 ON DELETE 只在手工删除用户行时兜底：文章、问题的作者置空，其余随用户删除
+		edge.To("posts", Post.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("questions", Question.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("answers", Answer.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("answer_votes", AnswerVote.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("comments", Comment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("like_posts", UserLikePost.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("like_questions", UserLikeQuestion.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("favorite_posts", UserFavoritePost.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("favorite_questions", UserFavoriteQuestion.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// following 是此人发出的关注，followed_by 是别人对此人的关注
+		edge.To("following", Follow.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("followed_by", Follow.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("refresh_tokens", RefreshToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("sessions", Session.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
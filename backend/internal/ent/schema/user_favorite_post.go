@@ -2,9 +2,11 @@ package schema
 
 import (
 	"entgo.io/ent"
-	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+
+	"backend/internal/ent/hook"
 )
 
 // UserFavoritePost holds the schema definition for the UserFavoritePost entity.
@@ -21,14 +23,21 @@ func (UserFavoritePost) Fields() []ent.Field {
 
 func (UserFavoritePost) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("user", User.Type),
-		edge.To("post", Post.Type),
+		edge.From("user", User.Type).Ref("favorite_posts").Field("user_id").Unique().Required(),
+		edge.From("post", Post.Type).Ref("favorites").Field("post_id").Unique().Required(),
 	}
 }
 
 func (UserFavoritePost) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("post_id"),
-		index.Fields("user_id"),
+		// 同一用户对同一文章只能收藏一次，并发重复提交由唯一索引兜底
+		index.Fields("user_id", "post_id").Unique(),
+	}
+}
+
+func (UserFavoritePost) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.Counters(hook.PostFavorites),
 	}
 }
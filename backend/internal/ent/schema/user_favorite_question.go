@@ -2,9 +2,11 @@ package schema
 
 import (
 	"entgo.io/ent"
-	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+
+	"backend/internal/ent/hook"
 )
 
 // UserFavoriteQuestion holds the schema definition for the UserFavoriteQuestion entity.
@@ -21,14 +23,21 @@ func (UserFavoriteQuestion) Fields() []ent.Field {
 
 func (UserFavoriteQuestion) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("user", User.Type),
-		edge.To("question", Question.Type),
+		edge.From("user", User.Type).Ref("favorite_questions").Field("user_id").Unique().Required(),
+		edge.From("question", Question.Type).Ref("favorites").Field("question_id").Unique().Required(),
 	}
 }
 
 func (UserFavoriteQuestion) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("question_id"),
-		index.Fields("user_id"),
+		// 同一用户对同一问题只能收藏一次，并发重复提交由唯一索引兜底
+		index.Fields("user_id", "question_id").Unique(),
+	}
+}
+
+func (UserFavoriteQuestion) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.Counters(hook.QuestionFavorites),
 	}
 }
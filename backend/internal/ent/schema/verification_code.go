@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// VerificationCode holds the schema definition for the VerificationCode entity.
+// 只保存验证码的哈希，明文只经由短信发给用户。
+type VerificationCode struct {
+	ent.Schema
+}
+
+func (VerificationCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("phone").MaxLen(11).Immutable(),
+		// ip 是请求下发验证码的客户端地址，用于按 IP 限制发送频率
+		field.String("ip").MaxLen(64).Optional().Immutable(),
+		field.Enum("purpose").Values("login", "reset_password").Immutable(),
+		field.String("code_hash").MaxLen(64).Immutable().Sensitive(),
+		field.Time("expires_at").Immutable(),
+		field.Int("attempts").Default(0),
+		field.Time("consumed_at").Optional().Nillable(),
+	}
+}
+
+func (VerificationCode) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+func (VerificationCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("phone", "purpose", "create_time"),
+		index.Fields("ip", "create_time"),
+	}
+}
@@ -18,12 +18,14 @@ type Tag struct {
 	ID int `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// PostCount holds the value of the "post_count" field.
+	PostCount int `json:"post_count,omitempty"`
+	// QuestionCount holds the value of the "question_count" field.
+	QuestionCount int `json:"question_count,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TagQuery when eager-loading is set.
-	Edges            TagEdges `json:"edges"`
-	post_tag_tag     *int
-	question_tag_tag *int
-	selectValues     sql.SelectValues
+	Edges        TagEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // TagEdges holds the relations/edges for other nodes in the graph.
@@ -32,9 +34,11 @@ type TagEdges struct {
 	Posts []*PostTag `json:"posts,omitempty"`
 	// Questions holds the value of the questions edge.
 	Questions []*QuestionTag `json:"questions,omitempty"`
+	// Aliases holds the value of the aliases edge.
+	Aliases []*TagAlias `json:"aliases,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // PostsOrErr returns the Posts value or an error if the edge
@@ -55,19 +59,24 @@ func (e TagEdges) QuestionsOrErr() ([]*QuestionTag, error) {
 	return nil, &NotLoadedError{edge: "questions"}
 }
 
+// AliasesOrErr returns the Aliases value or an error if the edge
+// was not loaded in eager-loading.
+func (e TagEdges) AliasesOrErr() ([]*TagAlias, error) {
+	if e.loadedTypes[2] {
+		return e.Aliases, nil
+	}
+	return nil, &NotLoadedError{edge: "aliases"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Tag) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case tag.FieldID:
+		case tag.FieldID, tag.FieldPostCount, tag.FieldQuestionCount:
 			values[i] = new(sql.NullInt64)
 		case tag.FieldName:
 			values[i] = new(sql.NullString)
-		case tag.ForeignKeys[0]: // post_tag_tag
-			values[i] = new(sql.NullInt64)
-		case tag.ForeignKeys[1]: // question_tag_tag
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -95,19 +104,17 @@ func (t *Tag) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.Name = value.String
 			}
-		case tag.ForeignKeys[0]:
+		case tag.FieldPostCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field post_tag_tag", value)
+				return fmt.Errorf("unexpected type %T for field post_count", values[i])
 			} else if value.Valid {
-				t.post_tag_tag = new(int)
-				*t.post_tag_tag = int(value.Int64)
+				t.PostCount = int(value.Int64)
 			}
-		case tag.ForeignKeys[1]:
+		case tag.FieldQuestionCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field question_tag_tag", value)
+				return fmt.Errorf("unexpected type %T for field question_count", values[i])
 			} else if value.Valid {
-				t.question_tag_tag = new(int)
-				*t.question_tag_tag = int(value.Int64)
+				t.QuestionCount = int(value.Int64)
 			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
@@ -132,6 +139,11 @@ func (t *Tag) QueryQuestions() *QuestionTagQuery {
 	return NewTagClient(t.config).QueryQuestions(t)
 }
 
+// QueryAliases queries the "aliases" edge of the Tag entity.
+func (t *Tag) QueryAliases() *TagAliasQuery {
+	return NewTagClient(t.config).QueryAliases(t)
+}
+
 // Update returns a builder for updating this Tag.
 // Note that you need to call Tag.Unwrap() before calling this method if this Tag
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -157,6 +169,12 @@ func (t *Tag) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v, ", t.ID))
 	builder.WriteString("name=")
 	builder.WriteString(t.Name)
+	builder.WriteString(", ")
+	builder.WriteString("post_count=")
+	builder.WriteString(fmt.Sprintf("%v", t.PostCount))
+	builder.WriteString(", ")
+	builder.WriteString("question_count=")
+	builder.WriteString(fmt.Sprintf("%v", t.QuestionCount))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -14,10 +14,16 @@ const (
 	FieldID = "id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldPostCount holds the string denoting the post_count field in the database.
+	FieldPostCount = "post_count"
+	// FieldQuestionCount holds the string denoting the question_count field in the database.
+	FieldQuestionCount = "question_count"
 	// EdgePosts holds the string denoting the posts edge name in mutations.
 	EdgePosts = "posts"
 	// EdgeQuestions holds the string denoting the questions edge name in mutations.
 	EdgeQuestions = "questions"
+	// EdgeAliases holds the string denoting the aliases edge name in mutations.
+	EdgeAliases = "aliases"
 	// Table holds the table name of the tag in the database.
 	Table = "tags"
 	// PostsTable is the table that holds the posts relation/edge.
@@ -26,27 +32,29 @@ const (
 	// It exists in this package in order to avoid circular dependency with the "posttag" package.
 	PostsInverseTable = "post_tags"
 	// PostsColumn is the table column denoting the posts relation/edge.
-	PostsColumn = "tag_posts"
+	PostsColumn = "tag_id"
 	// QuestionsTable is the table that holds the questions relation/edge.
 	QuestionsTable = "question_tags"
 	// QuestionsInverseTable is the table name for the QuestionTag entity.
 	// It exists in this package in order to avoid circular dependency with the "questiontag" package.
 	QuestionsInverseTable = "question_tags"
 	// QuestionsColumn is the table column denoting the questions relation/edge.
-	QuestionsColumn = "tag_questions"
+	QuestionsColumn = "tag_id"
+	// AliasesTable is the table that holds the aliases relation/edge.
+	AliasesTable = "tag_alias"
+	// AliasesInverseTable is the table name for the TagAlias entity.
+	// It exists in this package in order to avoid circular dependency with the "tagalias" package.
+	AliasesInverseTable = "tag_alias"
+	// AliasesColumn is the table column denoting the aliases relation/edge.
+	AliasesColumn = "tag_id"
 )
 
 // Columns holds all SQL columns for tag fields.
 var Columns = []string{
 	FieldID,
 	FieldName,
-}
-
-// ForeignKeys holds the SQL foreign-keys that are owned by the "tags"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"post_tag_tag",
-	"question_tag_tag",
+	FieldPostCount,
+	FieldQuestionCount,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -56,17 +64,16 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultPostCount holds the default value on creation for the "post_count" field.
+	DefaultPostCount int
+	// DefaultQuestionCount holds the default value on creation for the "question_count" field.
+	DefaultQuestionCount int
 )
 
 // OrderOption defines the ordering options for the Tag queries.
@@ -82,6 +89,16 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByPostCount orders the results by the post_count field.
+func ByPostCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPostCount, opts...).ToFunc()
+}
+
+// ByQuestionCount orders the results by the question_count field.
+func ByQuestionCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionCount, opts...).ToFunc()
+}
+
 // ByPostsCount orders the results by posts count.
 func ByPostsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -109,6 +126,20 @@ func ByQuestions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newQuestionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAliasesCount orders the results by aliases count.
+func ByAliasesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAliasesStep(), opts...)
+	}
+}
+
+// ByAliases orders the results by aliases terms.
+func ByAliases(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAliasesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPostsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -123,3 +154,10 @@ func newQuestionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, QuestionsTable, QuestionsColumn),
 	)
 }
+func newAliasesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AliasesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AliasesTable, AliasesColumn),
+	)
+}
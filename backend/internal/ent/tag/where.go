@@ -59,6 +59,16 @@ func Name(v string) predicate.Tag {
 	return predicate.Tag(sql.FieldEQ(FieldName, v))
 }
 
+// PostCount applies equality check predicate on the "post_count" field. It's identical to PostCountEQ.
+func PostCount(v int) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldPostCount, v))
+}
+
+// QuestionCount applies equality check predicate on the "question_count" field. It's identical to QuestionCountEQ.
+func QuestionCount(v int) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldQuestionCount, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Tag {
 	return predicate.Tag(sql.FieldEQ(FieldName, v))
@@ -124,6 +134,86 @@ func NameContainsFold(v string) predicate.Tag {
 	return predicate.Tag(sql.FieldContainsFold(FieldName, v))
 }
 
+// PostCountEQ applies the EQ predicate on the "post_count" field.
+func PostCountEQ(v int) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldPostCount, v))
+}
+
+// PostCountNEQ applies the NEQ predicate on the "post_count" field.
+func PostCountNEQ(v int) predicate.Tag {
+	return predicate.Tag(sql.FieldNEQ(FieldPostCount, v))
+}
+
+// PostCountIn applies the In predicate on the "post_count" field.
+func PostCountIn(vs ...int) predicate.Tag {
+	return predicate.Tag(sql.FieldIn(FieldPostCount, vs...))
+}
+
+// PostCountNotIn applies the NotIn predicate on the "post_count" field.
+func PostCountNotIn(vs ...int) predicate.Tag {
+	return predicate.Tag(sql.FieldNotIn(FieldPostCount, vs...))
+}
+
+// PostCountGT applies the GT predicate on the "post_count" field.
+func PostCountGT(v int) predicate.Tag {
+	return predicate.Tag(sql.FieldGT(FieldPostCount, v))
+}
+
+// PostCountGTE applies the GTE predicate on the "post_count" field.
+func PostCountGTE(v int) predicate.Tag {
+	return predicate.Tag(sql.FieldGTE(FieldPostCount, v))
+}
+
+// PostCountLT applies the LT predicate on the "post_count" field.
+func PostCountLT(v int) predicate.Tag {
+	return predicate.Tag(sql.FieldLT(FieldPostCount, v))
+}
+
+// PostCountLTE applies the LTE predicate on the "post_count" field.
+func PostCountLTE(v int) predicate.Tag {
+	return predicate.Tag(sql.FieldLTE(FieldPostCount, v))
+}
+
+// QuestionCountEQ applies the EQ predicate on the "question_count" field.
+func QuestionCountEQ(v int) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldQuestionCount, v))
+}
+
+// QuestionCountNEQ applies the NEQ predicate on the "question_count" field.
+func QuestionCountNEQ(v int) predicate.Tag {
+	return predicate.Tag(sql.FieldNEQ(FieldQuestionCount, v))
+}
+
+// QuestionCountIn applies the In predicate on the "question_count" field.
+func QuestionCountIn(vs ...int) predicate.Tag {
+	return predicate.Tag(sql.FieldIn(FieldQuestionCount, vs...))
+}
+
+// QuestionCountNotIn applies the NotIn predicate on the "question_count" field.
+func QuestionCountNotIn(vs ...int) predicate.Tag {
+	return predicate.Tag(sql.FieldNotIn(FieldQuestionCount, vs...))
+}
+
+// QuestionCountGT applies the GT predicate on the "question_count" field.
+func QuestionCountGT(v int) predicate.Tag {
+	return predicate.Tag(sql.FieldGT(FieldQuestionCount, v))
+}
+
+// QuestionCountGTE applies the GTE predicate on the "question_count" field.
+func QuestionCountGTE(v int) predicate.Tag {
+	return predicate.Tag(sql.FieldGTE(FieldQuestionCount, v))
+}
+
+// QuestionCountLT applies the LT predicate on the "question_count" field.
+func QuestionCountLT(v int) predicate.Tag {
+	return predicate.Tag(sql.FieldLT(FieldQuestionCount, v))
+}
+
+// QuestionCountLTE applies the LTE predicate on the "question_count" field.
+func QuestionCountLTE(v int) predicate.Tag {
+	return predicate.Tag(sql.FieldLTE(FieldQuestionCount, v))
+}
+
 // HasPosts applies the HasEdge predicate on the "posts" edge.
 func HasPosts() predicate.Tag {
 	return predicate.Tag(func(s *sql.Selector) {
@@ -170,6 +260,29 @@ func HasQuestionsWith(preds ...predicate.QuestionTag) predicate.Tag {
 	})
 }
 
+// HasAliases applies the HasEdge predicate on the "aliases" edge.
+func HasAliases() predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AliasesTable, AliasesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAliasesWith applies the HasEdge predicate on the "aliases" edge with a given conditions (other predicates).
+func HasAliasesWith(preds ...predicate.TagAlias) predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := newAliasesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Tag) predicate.Tag {
 	return predicate.Tag(sql.AndPredicates(predicates...))
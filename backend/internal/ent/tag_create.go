@@ -6,10 +6,12 @@ import (
 	"backend/internal/ent/posttag"
 	"backend/internal/ent/questiontag"
 	"backend/internal/ent/tag"
+	"backend/internal/ent/tagalias"
 	"context"
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +21,7 @@ type TagCreate struct {
 	config
 	mutation *TagMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetName sets the "name" field.
@@ -27,6 +30,34 @@ func (tc *TagCreate) SetName(s string) *TagCreate {
 	return tc
 }
 
+// SetPostCount sets the "post_count" field.
+func (tc *TagCreate) SetPostCount(i int) *TagCreate {
+	tc.mutation.SetPostCount(i)
+	return tc
+}
+
+// SetNillablePostCount sets the "post_count" field if the given value is not nil.
+func (tc *TagCreate) SetNillablePostCount(i *int) *TagCreate {
+	if i != nil {
+		tc.SetPostCount(*i)
+	}
+	return tc
+}
+
+// SetQuestionCount sets the "question_count" field.
+func (tc *TagCreate) SetQuestionCount(i int) *TagCreate {
+	tc.mutation.SetQuestionCount(i)
+	return tc
+}
+
+// SetNillableQuestionCount sets the "question_count" field if the given value is not nil.
+func (tc *TagCreate) SetNillableQuestionCount(i *int) *TagCreate {
+	if i != nil {
+		tc.SetQuestionCount(*i)
+	}
+	return tc
+}
+
 // AddPostIDs adds the "posts" edge to the PostTag entity by IDs.
 func (tc *TagCreate) AddPostIDs(ids ...int) *TagCreate {
 	tc.mutation.AddPostIDs(ids...)
@@ -57,6 +88,21 @@ func (tc *TagCreate) AddQuestions(q ...*QuestionTag) *TagCreate {
 	return tc.AddQuestionIDs(ids...)
 }
 
+// AddAliasIDs adds the "aliases" edge to the TagAlias entity by IDs.
+func (tc *TagCreate) AddAliasIDs(ids ...int) *TagCreate {
+	tc.mutation.AddAliasIDs(ids...)
+	return tc
+}
+
+// AddAliases adds the "aliases" edges to the TagAlias entity.
+func (tc *TagCreate) AddAliases(t ...*TagAlias) *TagCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddAliasIDs(ids...)
+}
+
 // Mutation returns the TagMutation object of the builder.
 func (tc *TagCreate) Mutation() *TagMutation {
 	return tc.mutation
@@ -64,6 +110,7 @@ func (tc *TagCreate) Mutation() *TagMutation {
 
 // Save creates the Tag in the database.
 func (tc *TagCreate) Save(ctx context.Context) (*Tag, error) {
+	tc.defaults()
 	return withHooks(ctx, tc.sqlSave, tc.mutation, tc.hooks)
 }
 
@@ -89,6 +136,18 @@ func (tc *TagCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (tc *TagCreate) defaults() {
+	if _, ok := tc.mutation.PostCount(); !ok {
+		v := tag.DefaultPostCount
+		tc.mutation.SetPostCount(v)
+	}
+	if _, ok := tc.mutation.QuestionCount(); !ok {
+		v := tag.DefaultQuestionCount
+		tc.mutation.SetQuestionCount(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (tc *TagCreate) check() error {
 	if _, ok := tc.mutation.Name(); !ok {
@@ -99,6 +158,12 @@ func (tc *TagCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tag.name": %w`, err)}
 		}
 	}
+	if _, ok := tc.mutation.PostCount(); !ok {
+		return &ValidationError{Name: "post_count", err: errors.New(`ent: missing required field "Tag.post_count"`)}
+	}
+	if _, ok := tc.mutation.QuestionCount(); !ok {
+		return &ValidationError{Name: "question_count", err: errors.New(`ent: missing required field "Tag.question_count"`)}
+	}
 	return nil
 }
 
@@ -125,10 +190,19 @@ func (tc *TagCreate) createSpec() (*Tag, *sqlgraph.CreateSpec) {
 		_node = &Tag{config: tc.config}
 		_spec = sqlgraph.NewCreateSpec(tag.Table, sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = tc.conflict
 	if value, ok := tc.mutation.Name(); ok {
 		_spec.SetField(tag.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := tc.mutation.PostCount(); ok {
+		_spec.SetField(tag.FieldPostCount, field.TypeInt, value)
+		_node.PostCount = value
+	}
+	if value, ok := tc.mutation.QuestionCount(); ok {
+		_spec.SetField(tag.FieldQuestionCount, field.TypeInt, value)
+		_node.QuestionCount = value
+	}
 	if nodes := tc.mutation.PostsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -161,14 +235,257 @@ func (tc *TagCreate) createSpec() (*Tag, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.AliasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Tag.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TagUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (tc *TagCreate) OnConflict(opts ...sql.ConflictOption) *TagUpsertOne {
+	tc.conflict = opts
+	return &TagUpsertOne{
+		create: tc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Tag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tc *TagCreate) OnConflictColumns(columns ...string) *TagUpsertOne {
+	tc.conflict = append(tc.conflict, sql.ConflictColumns(columns...))
+	return &TagUpsertOne{
+		create: tc,
+	}
+}
+
+type (
+	// TagUpsertOne is the builder for "upsert"-ing
+	//  one Tag node.
+	TagUpsertOne struct {
+		create *TagCreate
+	}
+
+	// TagUpsert is the "OnConflict" setter.
+	TagUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *TagUpsert) SetName(v string) *TagUpsert {
+	u.Set(tag.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagUpsert) UpdateName() *TagUpsert {
+	u.SetExcluded(tag.FieldName)
+	return u
+}
+
+// SetPostCount sets the "post_count" field.
+func (u *TagUpsert) SetPostCount(v int) *TagUpsert {
+	u.Set(tag.FieldPostCount, v)
+	return u
+}
+
+// UpdatePostCount sets the "post_count" field to the value that was provided on create.
+func (u *TagUpsert) UpdatePostCount() *TagUpsert {
+	u.SetExcluded(tag.FieldPostCount)
+	return u
+}
+
+// AddPostCount adds v to the "post_count" field.
+func (u *TagUpsert) AddPostCount(v int) *TagUpsert {
+	u.Add(tag.FieldPostCount, v)
+	return u
+}
+
+// SetQuestionCount sets the "question_count" field.
+func (u *TagUpsert) SetQuestionCount(v int) *TagUpsert {
+	u.Set(tag.FieldQuestionCount, v)
+	return u
+}
+
+// UpdateQuestionCount sets the "question_count" field to the value that was provided on create.
+func (u *TagUpsert) UpdateQuestionCount() *TagUpsert {
+	u.SetExcluded(tag.FieldQuestionCount)
+	return u
+}
+
+// AddQuestionCount adds v to the "question_count" field.
+func (u *TagUpsert) AddQuestionCount(v int) *TagUpsert {
+	u.Add(tag.FieldQuestionCount, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Tag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TagUpsertOne) UpdateNewValues() *TagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Tag.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TagUpsertOne) Ignore() *TagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TagUpsertOne) DoNothing() *TagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TagCreate.OnConflict
+// documentation for more info.
+func (u *TagUpsertOne) Update(set func(*TagUpsert)) *TagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TagUpsertOne) SetName(v string) *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagUpsertOne) UpdateName() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPostCount sets the "post_count" field.
+func (u *TagUpsertOne) SetPostCount(v int) *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.SetPostCount(v)
+	})
+}
+
+// AddPostCount adds v to the "post_count" field.
+func (u *TagUpsertOne) AddPostCount(v int) *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.AddPostCount(v)
+	})
+}
+
+// UpdatePostCount sets the "post_count" field to the value that was provided on create.
+func (u *TagUpsertOne) UpdatePostCount() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdatePostCount()
+	})
+}
+
+// SetQuestionCount sets the "question_count" field.
+func (u *TagUpsertOne) SetQuestionCount(v int) *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.SetQuestionCount(v)
+	})
+}
+
+// AddQuestionCount adds v to the "question_count" field.
+func (u *TagUpsertOne) AddQuestionCount(v int) *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.AddQuestionCount(v)
+	})
+}
+
+// UpdateQuestionCount sets the "question_count" field to the value that was provided on create.
+func (u *TagUpsertOne) UpdateQuestionCount() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateQuestionCount()
+	})
+}
+
+// Exec executes the query.
+func (u *TagUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TagCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TagUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TagUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TagUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // TagCreateBulk is the builder for creating many Tag entities in bulk.
 type TagCreateBulk struct {
 	config
 	err      error
 	builders []*TagCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Tag entities in the database.
@@ -182,6 +499,7 @@ func (tcb *TagCreateBulk) Save(ctx context.Context) ([]*Tag, error) {
 	for i := range tcb.builders {
 		func(i int, root context.Context) {
 			builder := tcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*TagMutation)
 				if !ok {
@@ -197,6 +515,7 @@ func (tcb *TagCreateBulk) Save(ctx context.Context) ([]*Tag, error) {
 					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -250,3 +569,163 @@ func (tcb *TagCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Tag.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TagUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (tcb *TagCreateBulk) OnConflict(opts ...sql.ConflictOption) *TagUpsertBulk {
+	tcb.conflict = opts
+	return &TagUpsertBulk{
+		create: tcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Tag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tcb *TagCreateBulk) OnConflictColumns(columns ...string) *TagUpsertBulk {
+	tcb.conflict = append(tcb.conflict, sql.ConflictColumns(columns...))
+	return &TagUpsertBulk{
+		create: tcb,
+	}
+}
+
+// TagUpsertBulk is the builder for "upsert"-ing
+// a bulk of Tag nodes.
+type TagUpsertBulk struct {
+	create *TagCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Tag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TagUpsertBulk) UpdateNewValues() *TagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Tag.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TagUpsertBulk) Ignore() *TagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TagUpsertBulk) DoNothing() *TagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TagCreateBulk.OnConflict
+// documentation for more info.
+func (u *TagUpsertBulk) Update(set func(*TagUpsert)) *TagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TagUpsertBulk) SetName(v string) *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagUpsertBulk) UpdateName() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPostCount sets the "post_count" field.
+func (u *TagUpsertBulk) SetPostCount(v int) *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.SetPostCount(v)
+	})
+}
+
+// AddPostCount adds v to the "post_count" field.
+func (u *TagUpsertBulk) AddPostCount(v int) *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.AddPostCount(v)
+	})
+}
+
+// UpdatePostCount sets the "post_count" field to the value that was provided on create.
+func (u *TagUpsertBulk) UpdatePostCount() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdatePostCount()
+	})
+}
+
+// SetQuestionCount sets the "question_count" field.
+func (u *TagUpsertBulk) SetQuestionCount(v int) *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.SetQuestionCount(v)
+	})
+}
+
+// AddQuestionCount adds v to the "question_count" field.
+func (u *TagUpsertBulk) AddQuestionCount(v int) *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.AddQuestionCount(v)
+	})
+}
+
+// UpdateQuestionCount sets the "question_count" field to the value that was provided on create.
+func (u *TagUpsertBulk) UpdateQuestionCount() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateQuestionCount()
+	})
+}
+
+// Exec executes the query.
+func (u *TagUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TagCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TagCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TagUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"backend/internal/ent/predicate"
 	"backend/internal/ent/questiontag"
 	"backend/internal/ent/tag"
+	"backend/internal/ent/tagalias"
 	"context"
 	"database/sql/driver"
 	"fmt"
@@ -26,7 +27,8 @@ type TagQuery struct {
 	predicates    []predicate.Tag
 	withPosts     *PostTagQuery
 	withQuestions *QuestionTagQuery
-	withFKs       bool
+	withAliases   *TagAliasQuery
+	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +109,28 @@ func (tq *TagQuery) QueryQuestions() *QuestionTagQuery {
 	return query
 }
 
+// QueryAliases chains the current query on the "aliases" edge.
+func (tq *TagQuery) QueryAliases() *TagAliasQuery {
+	query := (&TagAliasClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, selector),
+			sqlgraph.To(tagalias.Table, tagalias.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tag.AliasesTable, tag.AliasesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Tag entity from the query.
 // Returns a *NotFoundError when no Tag was found.
 func (tq *TagQuery) First(ctx context.Context) (*Tag, error) {
@@ -301,6 +325,7 @@ func (tq *TagQuery) Clone() *TagQuery {
 		predicates:    append([]predicate.Tag{}, tq.predicates...),
 		withPosts:     tq.withPosts.Clone(),
 		withQuestions: tq.withQuestions.Clone(),
+		withAliases:   tq.withAliases.Clone(),
 		// clone intermediate query.
 		sql:  tq.sql.Clone(),
 		path: tq.path,
@@ -329,6 +354,17 @@ func (tq *TagQuery) WithQuestions(opts ...func(*QuestionTagQuery)) *TagQuery {
 	return tq
 }
 
+// WithAliases tells the query-builder to eager-load the nodes that are connected to
+// the "aliases" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TagQuery) WithAliases(opts ...func(*TagAliasQuery)) *TagQuery {
+	query := (&TagAliasClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withAliases = query
+	return tq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -406,16 +442,13 @@ func (tq *TagQuery) prepareQuery(ctx context.Context) error {
 func (tq *TagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tag, error) {
 	var (
 		nodes       = []*Tag{}
-		withFKs     = tq.withFKs
 		_spec       = tq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			tq.withPosts != nil,
 			tq.withQuestions != nil,
+			tq.withAliases != nil,
 		}
 	)
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, tag.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Tag).scanValues(nil, columns)
 	}
@@ -425,6 +458,9 @@ func (tq *TagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tag, err
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(tq.modifiers) > 0 {
+		_spec.Modifiers = tq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -448,6 +484,13 @@ func (tq *TagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tag, err
 			return nil, err
 		}
 	}
+	if query := tq.withAliases; query != nil {
+		if err := tq.loadAliases(ctx, query, nodes,
+			func(n *Tag) { n.Edges.Aliases = []*TagAlias{} },
+			func(n *Tag, e *TagAlias) { n.Edges.Aliases = append(n.Edges.Aliases, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -461,7 +504,9 @@ func (tq *TagQuery) loadPosts(ctx context.Context, query *PostTagQuery, nodes []
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(posttag.FieldTagID)
+	}
 	query.Where(predicate.PostTag(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(tag.PostsColumn), fks...))
 	}))
@@ -470,13 +515,10 @@ func (tq *TagQuery) loadPosts(ctx context.Context, query *PostTagQuery, nodes []
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.tag_posts
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "tag_posts" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.TagID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "tag_posts" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "tag_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -492,7 +534,9 @@ func (tq *TagQuery) loadQuestions(ctx context.Context, query *QuestionTagQuery,
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(questiontag.FieldTagID)
+	}
 	query.Where(predicate.QuestionTag(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(tag.QuestionsColumn), fks...))
 	}))
@@ -501,13 +545,40 @@ func (tq *TagQuery) loadQuestions(ctx context.Context, query *QuestionTagQuery,
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.tag_questions
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "tag_questions" is nil for node %v`, n.ID)
+		fk := n.TagID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tag_id" returned %v for node %v`, fk, n.ID)
 		}
-		node, ok := nodeids[*fk]
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TagQuery) loadAliases(ctx context.Context, query *TagAliasQuery, nodes []*Tag, init func(*Tag), assign func(*Tag, *TagAlias)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tag)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tagalias.FieldTagID)
+	}
+	query.Where(predicate.TagAlias(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tag.AliasesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TagID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "tag_questions" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "tag_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -516,6 +587,9 @@ func (tq *TagQuery) loadQuestions(ctx context.Context, query *QuestionTagQuery,
 
 func (tq *TagQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
+	if len(tq.modifiers) > 0 {
+		_spec.Modifiers = tq.modifiers
+	}
 	_spec.Node.Columns = tq.ctx.Fields
 	if len(tq.ctx.Fields) > 0 {
 		_spec.Unique = tq.ctx.Unique != nil && *tq.ctx.Unique
@@ -578,6 +652,9 @@ func (tq *TagQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if tq.ctx.Unique != nil && *tq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range tq.modifiers {
+		m(selector)
+	}
 	for _, p := range tq.predicates {
 		p(selector)
 	}
@@ -595,6 +672,12 @@ func (tq *TagQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tq *TagQuery) Modify(modifiers ...func(s *sql.Selector)) *TagSelect {
+	tq.modifiers = append(tq.modifiers, modifiers...)
+	return tq.Select()
+}
+
 // TagGroupBy is the group-by builder for Tag entities.
 type TagGroupBy struct {
 	selector
@@ -684,3 +767,9 @@ func (ts *TagSelect) sqlScan(ctx context.Context, root *TagQuery, v any) error {
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ts *TagSelect) Modify(modifiers ...func(s *sql.Selector)) *TagSelect {
+	ts.modifiers = append(ts.modifiers, modifiers...)
+	return ts
+}
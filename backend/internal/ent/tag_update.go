@@ -7,6 +7,7 @@ import (
 	"backend/internal/ent/predicate"
 	"backend/internal/ent/questiontag"
 	"backend/internal/ent/tag"
+	"backend/internal/ent/tagalias"
 	"context"
 	"errors"
 	"fmt"
@@ -19,8 +20,9 @@ import (
 // TagUpdate is the builder for updating Tag entities.
 type TagUpdate struct {
 	config
-	hooks    []Hook
-	mutation *TagMutation
+	hooks     []Hook
+	mutation  *TagMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the TagUpdate builder.
@@ -43,6 +45,48 @@ func (tu *TagUpdate) SetNillableName(s *string) *TagUpdate {
 	return tu
 }
 
+// SetPostCount sets the "post_count" field.
+func (tu *TagUpdate) SetPostCount(i int) *TagUpdate {
+	tu.mutation.ResetPostCount()
+	tu.mutation.SetPostCount(i)
+	return tu
+}
+
+// SetNillablePostCount sets the "post_count" field if the given value is not nil.
+func (tu *TagUpdate) SetNillablePostCount(i *int) *TagUpdate {
+	if i != nil {
+		tu.SetPostCount(*i)
+	}
+	return tu
+}
+
+// AddPostCount adds i to the "post_count" field.
+func (tu *TagUpdate) AddPostCount(i int) *TagUpdate {
+	tu.mutation.AddPostCount(i)
+	return tu
+}
+
+// SetQuestionCount sets the "question_count" field.
+func (tu *TagUpdate) SetQuestionCount(i int) *TagUpdate {
+	tu.mutation.ResetQuestionCount()
+	tu.mutation.SetQuestionCount(i)
+	return tu
+}
+
+// SetNillableQuestionCount sets the "question_count" field if the given value is not nil.
+func (tu *TagUpdate) SetNillableQuestionCount(i *int) *TagUpdate {
+	if i != nil {
+		tu.SetQuestionCount(*i)
+	}
+	return tu
+}
+
+// AddQuestionCount adds i to the "question_count" field.
+func (tu *TagUpdate) AddQuestionCount(i int) *TagUpdate {
+	tu.mutation.AddQuestionCount(i)
+	return tu
+}
+
 // AddPostIDs adds the "posts" edge to the PostTag entity by IDs.
 func (tu *TagUpdate) AddPostIDs(ids ...int) *TagUpdate {
 	tu.mutation.AddPostIDs(ids...)
@@ -73,6 +117,21 @@ func (tu *TagUpdate) AddQuestions(q ...*QuestionTag) *TagUpdate {
 	return tu.AddQuestionIDs(ids...)
 }
 
+// AddAliasIDs adds the "aliases" edge to the TagAlias entity by IDs.
+func (tu *TagUpdate) AddAliasIDs(ids ...int) *TagUpdate {
+	tu.mutation.AddAliasIDs(ids...)
+	return tu
+}
+
+// AddAliases adds the "aliases" edges to the TagAlias entity.
+func (tu *TagUpdate) AddAliases(t ...*TagAlias) *TagUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddAliasIDs(ids...)
+}
+
 // Mutation returns the TagMutation object of the builder.
 func (tu *TagUpdate) Mutation() *TagMutation {
 	return tu.mutation
@@ -120,6 +179,27 @@ func (tu *TagUpdate) RemoveQuestions(q ...*QuestionTag) *TagUpdate {
 	return tu.RemoveQuestionIDs(ids...)
 }
 
+// ClearAliases clears all "aliases" edges to the TagAlias entity.
+func (tu *TagUpdate) ClearAliases() *TagUpdate {
+	tu.mutation.ClearAliases()
+	return tu
+}
+
+// RemoveAliasIDs removes the "aliases" edge to TagAlias entities by IDs.
+func (tu *TagUpdate) RemoveAliasIDs(ids ...int) *TagUpdate {
+	tu.mutation.RemoveAliasIDs(ids...)
+	return tu
+}
+
+// RemoveAliases removes "aliases" edges to TagAlias entities.
+func (tu *TagUpdate) RemoveAliases(t ...*TagAlias) *TagUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveAliasIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TagUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
@@ -157,6 +237,12 @@ func (tu *TagUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tu *TagUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TagUpdate {
+	tu.modifiers = append(tu.modifiers, modifiers...)
+	return tu
+}
+
 func (tu *TagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := tu.check(); err != nil {
 		return n, err
@@ -172,6 +258,18 @@ func (tu *TagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tu.mutation.Name(); ok {
 		_spec.SetField(tag.FieldName, field.TypeString, value)
 	}
+	if value, ok := tu.mutation.PostCount(); ok {
+		_spec.SetField(tag.FieldPostCount, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedPostCount(); ok {
+		_spec.AddField(tag.FieldPostCount, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.QuestionCount(); ok {
+		_spec.SetField(tag.FieldQuestionCount, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedQuestionCount(); ok {
+		_spec.AddField(tag.FieldQuestionCount, field.TypeInt, value)
+	}
 	if tu.mutation.PostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -262,6 +360,52 @@ func (tu *TagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.AliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedAliasesIDs(); len(nodes) > 0 && !tu.mutation.AliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.AliasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(tu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{tag.Label}
@@ -277,9 +421,10 @@ func (tu *TagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // TagUpdateOne is the builder for updating a single Tag entity.
 type TagUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TagMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *TagMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -296,6 +441,48 @@ func (tuo *TagUpdateOne) SetNillableName(s *string) *TagUpdateOne {
 	return tuo
 }
 
+// SetPostCount sets the "post_count" field.
+func (tuo *TagUpdateOne) SetPostCount(i int) *TagUpdateOne {
+	tuo.mutation.ResetPostCount()
+	tuo.mutation.SetPostCount(i)
+	return tuo
+}
+
+// SetNillablePostCount sets the "post_count" field if the given value is not nil.
+func (tuo *TagUpdateOne) SetNillablePostCount(i *int) *TagUpdateOne {
+	if i != nil {
+		tuo.SetPostCount(*i)
+	}
+	return tuo
+}
+
+// AddPostCount adds i to the "post_count" field.
+func (tuo *TagUpdateOne) AddPostCount(i int) *TagUpdateOne {
+	tuo.mutation.AddPostCount(i)
+	return tuo
+}
+
+// SetQuestionCount sets the "question_count" field.
+func (tuo *TagUpdateOne) SetQuestionCount(i int) *TagUpdateOne {
+	tuo.mutation.ResetQuestionCount()
+	tuo.mutation.SetQuestionCount(i)
+	return tuo
+}
+
+// SetNillableQuestionCount sets the "question_count" field if the given value is not nil.
+func (tuo *TagUpdateOne) SetNillableQuestionCount(i *int) *TagUpdateOne {
+	if i != nil {
+		tuo.SetQuestionCount(*i)
+	}
+	return tuo
+}
+
+// AddQuestionCount adds i to the "question_count" field.
+func (tuo *TagUpdateOne) AddQuestionCount(i int) *TagUpdateOne {
+	tuo.mutation.AddQuestionCount(i)
+	return tuo
+}
+
 // AddPostIDs adds the "posts" edge to the PostTag entity by IDs.
 func (tuo *TagUpdateOne) AddPostIDs(ids ...int) *TagUpdateOne {
 	tuo.mutation.AddPostIDs(ids...)
@@ -326,6 +513,21 @@ func (tuo *TagUpdateOne) AddQuestions(q ...*QuestionTag) *TagUpdateOne {
 	return tuo.AddQuestionIDs(ids...)
 }
 
+// AddAliasIDs adds the "aliases" edge to the TagAlias entity by IDs.
+func (tuo *TagUpdateOne) AddAliasIDs(ids ...int) *TagUpdateOne {
+	tuo.mutation.AddAliasIDs(ids...)
+	return tuo
+}
+
+// AddAliases adds the "aliases" edges to the TagAlias entity.
+func (tuo *TagUpdateOne) AddAliases(t ...*TagAlias) *TagUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddAliasIDs(ids...)
+}
+
 // Mutation returns the TagMutation object of the builder.
 func (tuo *TagUpdateOne) Mutation() *TagMutation {
 	return tuo.mutation
@@ -373,6 +575,27 @@ func (tuo *TagUpdateOne) RemoveQuestions(q ...*QuestionTag) *TagUpdateOne {
 	return tuo.RemoveQuestionIDs(ids...)
 }
 
+// ClearAliases clears all "aliases" edges to the TagAlias entity.
+func (tuo *TagUpdateOne) ClearAliases() *TagUpdateOne {
+	tuo.mutation.ClearAliases()
+	return tuo
+}
+
+// RemoveAliasIDs removes the "aliases" edge to TagAlias entities by IDs.
+func (tuo *TagUpdateOne) RemoveAliasIDs(ids ...int) *TagUpdateOne {
+	tuo.mutation.RemoveAliasIDs(ids...)
+	return tuo
+}
+
+// RemoveAliases removes "aliases" edges to TagAlias entities.
+func (tuo *TagUpdateOne) RemoveAliases(t ...*TagAlias) *TagUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveAliasIDs(ids...)
+}
+
 // Where appends a list predicates to the TagUpdate builder.
 func (tuo *TagUpdateOne) Where(ps ...predicate.Tag) *TagUpdateOne {
 	tuo.mutation.Where(ps...)
@@ -423,6 +646,12 @@ func (tuo *TagUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tuo *TagUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TagUpdateOne {
+	tuo.modifiers = append(tuo.modifiers, modifiers...)
+	return tuo
+}
+
 func (tuo *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
 	if err := tuo.check(); err != nil {
 		return _node, err
@@ -455,6 +684,18 @@ func (tuo *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
 	if value, ok := tuo.mutation.Name(); ok {
 		_spec.SetField(tag.FieldName, field.TypeString, value)
 	}
+	if value, ok := tuo.mutation.PostCount(); ok {
+		_spec.SetField(tag.FieldPostCount, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedPostCount(); ok {
+		_spec.AddField(tag.FieldPostCount, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.QuestionCount(); ok {
+		_spec.SetField(tag.FieldQuestionCount, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedQuestionCount(); ok {
+		_spec.AddField(tag.FieldQuestionCount, field.TypeInt, value)
+	}
 	if tuo.mutation.PostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -545,6 +786,52 @@ func (tuo *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.AliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedAliasesIDs(); len(nodes) > 0 && !tuo.mutation.AliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.AliasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(tuo.modifiers...)
 	_node = &Tag{config: tuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/ent/predicate"
+	"backend/internal/ent/tagalias"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TagAliasDelete is the builder for deleting a TagAlias entity.
+type TagAliasDelete struct {
+	config
+	hooks    []Hook
+	mutation *TagAliasMutation
+}
+
+// Where appends a list predicates to the TagAliasDelete builder.
+func (tad *TagAliasDelete) Where(ps ...predicate.TagAlias) *TagAliasDelete {
+	tad.mutation.Where(ps...)
+	return tad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tad *TagAliasDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tad.sqlExec, tad.mutation, tad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tad *TagAliasDelete) ExecX(ctx context.Context) int {
+	n, err := tad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tad *TagAliasDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tagalias.Table, sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt))
+	if ps := tad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tad.mutation.done = true
+	return affected, err
+}
+
+// TagAliasDeleteOne is the builder for deleting a single TagAlias entity.
+type TagAliasDeleteOne struct {
+	tad *TagAliasDelete
+}
+
+// Where appends a list predicates to the TagAliasDelete builder.
+func (tado *TagAliasDeleteOne) Where(ps ...predicate.TagAlias) *TagAliasDeleteOne {
+	tado.tad.mutation.Where(ps...)
+	return tado
+}
+
+// Exec executes the deletion query.
+func (tado *TagAliasDeleteOne) Exec(ctx context.Context) error {
+	n, err := tado.tad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tagalias.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tado *TagAliasDeleteOne) ExecX(ctx context.Context) {
+	if err := tado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,10 +14,16 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Answer is the client for interacting with the Answer builders.
+	Answer *AnswerClient
+	// AnswerVote is the client for interacting with the AnswerVote builders.
+	AnswerVote *AnswerVoteClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
 	// Follow is the client for interacting with the Follow builders.
 	Follow *FollowClient
+	// LoginAttempt is the client for interacting with the LoginAttempt builders.
+	LoginAttempt *LoginAttemptClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
 	// PostTag is the client for interacting with the PostTag builders.
@@ -24,8 +32,14 @@ type Tx struct {
 	Question *QuestionClient
 	// QuestionTag is the client for interacting with the QuestionTag builders.
 	QuestionTag *QuestionTagClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
+	// TagAlias is the client for interacting with the TagAlias builders.
+	TagAlias *TagAliasClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserFavoritePost is the client for interacting with the UserFavoritePost builders.
@@ -36,6 +50,8 @@ type Tx struct {
 	UserLikePost *UserLikePostClient
 	// UserLikeQuestion is the client for interacting with the UserLikeQuestion builders.
 	UserLikeQuestion *UserLikeQuestionClient
+	// VerificationCode is the client for interacting with the VerificationCode builders.
+	VerificationCode *VerificationCodeClient
 
 	// lazily loaded.
 	client     *Client
@@ -167,18 +183,25 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Answer = NewAnswerClient(tx.config)
+	tx.AnswerVote = NewAnswerVoteClient(tx.config)
 	tx.Comment = NewCommentClient(tx.config)
 	tx.Follow = NewFollowClient(tx.config)
+	tx.LoginAttempt = NewLoginAttemptClient(tx.config)
 	tx.Post = NewPostClient(tx.config)
 	tx.PostTag = NewPostTagClient(tx.config)
 	tx.Question = NewQuestionClient(tx.config)
 	tx.QuestionTag = NewQuestionTagClient(tx.config)
+	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
 	tx.Tag = NewTagClient(tx.config)
+	tx.TagAlias = NewTagAliasClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserFavoritePost = NewUserFavoritePostClient(tx.config)
 	tx.UserFavoriteQuestion = NewUserFavoriteQuestionClient(tx.config)
 	tx.UserLikePost = NewUserLikePostClient(tx.config)
 	tx.UserLikeQuestion = NewUserLikeQuestionClient(tx.config)
+	tx.VerificationCode = NewVerificationCodeClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -188,7 +211,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Comment.QueryXXX(), the query will be executed
+// applies a query, for example: Answer.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -241,3 +264,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
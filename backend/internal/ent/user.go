@@ -29,19 +29,20 @@ type User struct {
 	Password string `json:"password,omitempty"`
 	// Avatar holds the value of the "avatar" field.
 	Avatar string `json:"avatar,omitempty"`
+	// Role holds the value of the "role" field.
+	Role user.Role `json:"role,omitempty"`
+	// DeletionRequestedAt holds the value of the "deletion_requested_at" field.
+	DeletionRequestedAt *time.Time `json:"deletion_requested_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// FollowerCount holds the value of the "follower_count" field.
+	FollowerCount int `json:"follower_count,omitempty"`
+	// FollowingCount holds the value of the "following_count" field.
+	FollowingCount int `json:"following_count,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
-	Edges                       UserEdges `json:"edges"`
-	comment_user                *int
-	follow_follower             *int
-	follow_following            *int
-	post_user                   *int
-	question_user               *int
-	user_favorite_post_user     *int
-	user_favorite_question_user *int
-	user_like_post_user         *int
-	user_like_question_user     *int
-	selectValues                sql.SelectValues
+	Edges        UserEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // UserEdges holds the relations/edges for other nodes in the graph.
@@ -50,6 +51,10 @@ type UserEdges struct {
 	Posts []*Post `json:"posts,omitempty"`
 	// Questions holds the value of the questions edge.
 	Questions []*Question `json:"questions,omitempty"`
+	// Answers holds the value of the answers edge.
+	Answers []*Answer `json:"answers,omitempty"`
+	// AnswerVotes holds the value of the answer_votes edge.
+	AnswerVotes []*AnswerVote `json:"answer_votes,omitempty"`
 	// Comments holds the value of the comments edge.
 	Comments []*Comment `json:"comments,omitempty"`
 	// LikePosts holds the value of the like_posts edge.
@@ -64,9 +69,13 @@ type UserEdges struct {
 	Following []*Follow `json:"following,omitempty"`
 	// FollowedBy holds the value of the followed_by edge.
 	FollowedBy []*Follow `json:"followed_by,omitempty"`
+	// RefreshTokens holds the value of the refresh_tokens edge.
+	RefreshTokens []*RefreshToken `json:"refresh_tokens,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [9]bool
+	loadedTypes [13]bool
 }
 
 // PostsOrErr returns the Posts value or an error if the edge
@@ -87,10 +96,28 @@ func (e UserEdges) QuestionsOrErr() ([]*Question, error) {
 	return nil, &NotLoadedError{edge: "questions"}
 }
 
+// AnswersOrErr returns the Answers value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AnswersOrErr() ([]*Answer, error) {
+	if e.loadedTypes[2] {
+		return e.Answers, nil
+	}
+	return nil, &NotLoadedError{edge: "answers"}
+}
+
+// AnswerVotesOrErr returns the AnswerVotes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AnswerVotesOrErr() ([]*AnswerVote, error) {
+	if e.loadedTypes[3] {
+		return e.AnswerVotes, nil
+	}
+	return nil, &NotLoadedError{edge: "answer_votes"}
+}
+
 // CommentsOrErr returns the Comments value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) CommentsOrErr() ([]*Comment, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[4] {
 		return e.Comments, nil
 	}
 	return nil, &NotLoadedError{edge: "comments"}
@@ -99,7 +126,7 @@ func (e UserEdges) CommentsOrErr() ([]*Comment, error) {
 // LikePostsOrErr returns the LikePosts value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) LikePostsOrErr() ([]*UserLikePost, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[5] {
 		return e.LikePosts, nil
 	}
 	return nil, &NotLoadedError{edge: "like_posts"}
@@ -108,7 +135,7 @@ func (e UserEdges) LikePostsOrErr() ([]*UserLikePost, error) {
 // LikeQuestionsOrErr returns the LikeQuestions value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) LikeQuestionsOrErr() ([]*UserLikeQuestion, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[6] {
 		return e.LikeQuestions, nil
 	}
 	return nil, &NotLoadedError{edge: "like_questions"}
@@ -117,7 +144,7 @@ func (e UserEdges) LikeQuestionsOrErr() ([]*UserLikeQuestion, error) {
 // FavoritePostsOrErr returns the FavoritePosts value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) FavoritePostsOrErr() ([]*UserFavoritePost, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[7] {
 		return e.FavoritePosts, nil
 	}
 	return nil, &NotLoadedError{edge: "favorite_posts"}
@@ -126,7 +153,7 @@ func (e UserEdges) FavoritePostsOrErr() ([]*UserFavoritePost, error) {
 // FavoriteQuestionsOrErr returns the FavoriteQuestions value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) FavoriteQuestionsOrErr() ([]*UserFavoriteQuestion, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[8] {
 		return e.FavoriteQuestions, nil
 	}
 	return nil, &NotLoadedError{edge: "favorite_questions"}
@@ -135,7 +162,7 @@ func (e UserEdges) FavoriteQuestionsOrErr() ([]*UserFavoriteQuestion, error) {
 // FollowingOrErr returns the Following value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) FollowingOrErr() ([]*Follow, error) {
-	if e.loadedTypes[7] {
+	if e.loadedTypes[9] {
 		return e.Following, nil
 	}
 	return nil, &NotLoadedError{edge: "following"}
@@ -144,41 +171,41 @@ func (e UserEdges) FollowingOrErr() ([]*Follow, error) {
 // FollowedByOrErr returns the FollowedBy value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) FollowedByOrErr() ([]*Follow, error) {
-	if e.loadedTypes[8] {
+	if e.loadedTypes[10] {
 		return e.FollowedBy, nil
 	}
 	return nil, &NotLoadedError{edge: "followed_by"}
 }
 
+// RefreshTokensOrErr returns the RefreshTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RefreshTokensOrErr() ([]*RefreshToken, error) {
+	if e.loadedTypes[11] {
+		return e.RefreshTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "refresh_tokens"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[12] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID:
+		case user.FieldID, user.FieldFollowerCount, user.FieldFollowingCount:
 			values[i] = new(sql.NullInt64)
-		case user.FieldPhone, user.FieldNickname, user.FieldPassword, user.FieldAvatar:
+		case user.FieldPhone, user.FieldNickname, user.FieldPassword, user.FieldAvatar, user.FieldRole:
 			values[i] = new(sql.NullString)
-		case user.FieldCreateTime, user.FieldUpdateTime:
+		case user.FieldCreateTime, user.FieldUpdateTime, user.FieldDeletionRequestedAt, user.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
-		case user.ForeignKeys[0]: // comment_user
-			values[i] = new(sql.NullInt64)
-		case user.ForeignKeys[1]: // follow_follower
-			values[i] = new(sql.NullInt64)
-		case user.ForeignKeys[2]: // follow_following
-			values[i] = new(sql.NullInt64)
-		case user.ForeignKeys[3]: // post_user
-			values[i] = new(sql.NullInt64)
-		case user.ForeignKeys[4]: // question_user
-			values[i] = new(sql.NullInt64)
-		case user.ForeignKeys[5]: // user_favorite_post_user
-			values[i] = new(sql.NullInt64)
-		case user.ForeignKeys[6]: // user_favorite_question_user
-			values[i] = new(sql.NullInt64)
-		case user.ForeignKeys[7]: // user_like_post_user
-			values[i] = new(sql.NullInt64)
-		case user.ForeignKeys[8]: // user_like_question_user
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -236,68 +263,37 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Avatar = value.String
 			}
-		case user.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field comment_user", value)
-			} else if value.Valid {
-				u.comment_user = new(int)
-				*u.comment_user = int(value.Int64)
-			}
-		case user.ForeignKeys[1]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field follow_follower", value)
-			} else if value.Valid {
-				u.follow_follower = new(int)
-				*u.follow_follower = int(value.Int64)
-			}
-		case user.ForeignKeys[2]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field follow_following", value)
-			} else if value.Valid {
-				u.follow_following = new(int)
-				*u.follow_following = int(value.Int64)
-			}
-		case user.ForeignKeys[3]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field post_user", value)
-			} else if value.Valid {
-				u.post_user = new(int)
-				*u.post_user = int(value.Int64)
-			}
-		case user.ForeignKeys[4]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field question_user", value)
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
 			} else if value.Valid {
-				u.question_user = new(int)
-				*u.question_user = int(value.Int64)
+				u.Role = user.Role(value.String)
 			}
-		case user.ForeignKeys[5]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_favorite_post_user", value)
+		case user.FieldDeletionRequestedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_requested_at", values[i])
 			} else if value.Valid {
-				u.user_favorite_post_user = new(int)
-				*u.user_favorite_post_user = int(value.Int64)
+				u.DeletionRequestedAt = new(time.Time)
+				*u.DeletionRequestedAt = value.Time
 			}
-		case user.ForeignKeys[6]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_favorite_question_user", value)
+		case user.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
 			} else if value.Valid {
-				u.user_favorite_question_user = new(int)
-				*u.user_favorite_question_user = int(value.Int64)
+				u.DeletedAt = new(time.Time)
+				*u.DeletedAt = value.Time
 			}
-		case user.ForeignKeys[7]:
+		case user.FieldFollowerCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_like_post_user", value)
+				return fmt.Errorf("unexpected type %T for field follower_count", values[i])
 			} else if value.Valid {
-				u.user_like_post_user = new(int)
-				*u.user_like_post_user = int(value.Int64)
+				u.FollowerCount = int(value.Int64)
 			}
-		case user.ForeignKeys[8]:
+		case user.FieldFollowingCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_like_question_user", value)
+				return fmt.Errorf("unexpected type %T for field following_count", values[i])
 			} else if value.Valid {
-				u.user_like_question_user = new(int)
-				*u.user_like_question_user = int(value.Int64)
+				u.FollowingCount = int(value.Int64)
 			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
@@ -322,6 +318,16 @@ func (u *User) QueryQuestions() *QuestionQuery {
 	return NewUserClient(u.config).QueryQuestions(u)
 }
 
+// QueryAnswers queries the "answers" edge of the User entity.
+func (u *User) QueryAnswers() *AnswerQuery {
+	return NewUserClient(u.config).QueryAnswers(u)
+}
+
+// QueryAnswerVotes queries the "answer_votes" edge of the User entity.
+func (u *User) QueryAnswerVotes() *AnswerVoteQuery {
+	return NewUserClient(u.config).QueryAnswerVotes(u)
+}
+
 // QueryComments queries the "comments" edge of the User entity.
 func (u *User) QueryComments() *CommentQuery {
 	return NewUserClient(u.config).QueryComments(u)
@@ -357,6 +363,16 @@ func (u *User) QueryFollowedBy() *FollowQuery {
 	return NewUserClient(u.config).QueryFollowedBy(u)
 }
 
+// QueryRefreshTokens queries the "refresh_tokens" edge of the User entity.
+func (u *User) QueryRefreshTokens() *RefreshTokenQuery {
+	return NewUserClient(u.config).QueryRefreshTokens(u)
+}
+
+// QuerySessions queries the "sessions" edge of the User entity.
+func (u *User) QuerySessions() *SessionQuery {
+	return NewUserClient(u.config).QuerySessions(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -397,6 +413,25 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("avatar=")
 	builder.WriteString(u.Avatar)
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", u.Role))
+	builder.WriteString(", ")
+	if v := u.DeletionRequestedAt; v != nil {
+		builder.WriteString("deletion_requested_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("follower_count=")
+	builder.WriteString(fmt.Sprintf("%v", u.FollowerCount))
+	builder.WriteString(", ")
+	builder.WriteString("following_count=")
+	builder.WriteString(fmt.Sprintf("%v", u.FollowingCount))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package user
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -26,10 +27,24 @@ const (
 	FieldPassword = "password"
 	// FieldAvatar holds the string denoting the avatar field in the database.
 	FieldAvatar = "avatar"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldDeletionRequestedAt holds the string denoting the deletion_requested_at field in the database.
+	FieldDeletionRequestedAt = "deletion_requested_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldFollowerCount holds the string denoting the follower_count field in the database.
+	FieldFollowerCount = "follower_count"
+	// FieldFollowingCount holds the string denoting the following_count field in the database.
+	FieldFollowingCount = "following_count"
 	// EdgePosts holds the string denoting the posts edge name in mutations.
 	EdgePosts = "posts"
 	// EdgeQuestions holds the string denoting the questions edge name in mutations.
 	EdgeQuestions = "questions"
+	// EdgeAnswers holds the string denoting the answers edge name in mutations.
+	EdgeAnswers = "answers"
+	// EdgeAnswerVotes holds the string denoting the answer_votes edge name in mutations.
+	EdgeAnswerVotes = "answer_votes"
 	// EdgeComments holds the string denoting the comments edge name in mutations.
 	EdgeComments = "comments"
 	// EdgeLikePosts holds the string denoting the like_posts edge name in mutations.
@@ -44,6 +59,10 @@ const (
 	EdgeFollowing = "following"
 	// EdgeFollowedBy holds the string denoting the followed_by edge name in mutations.
 	EdgeFollowedBy = "followed_by"
+	// EdgeRefreshTokens holds the string denoting the refresh_tokens edge name in mutations.
+	EdgeRefreshTokens = "refresh_tokens"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// PostsTable is the table that holds the posts relation/edge.
@@ -52,63 +71,91 @@ const (
 	// It exists in this package in order to avoid circular dependency with the "post" package.
 	PostsInverseTable = "posts"
 	// PostsColumn is the table column denoting the posts relation/edge.
-	PostsColumn = "user_posts"
+	PostsColumn = "user_id"
 	// QuestionsTable is the table that holds the questions relation/edge.
 	QuestionsTable = "questions"
 	// QuestionsInverseTable is the table name for the Question entity.
 	// It exists in this package in order to avoid circular dependency with the "question" package.
 	QuestionsInverseTable = "questions"
 	// QuestionsColumn is the table column denoting the questions relation/edge.
-	QuestionsColumn = "user_questions"
+	QuestionsColumn = "user_id"
+	// AnswersTable is the table that holds the answers relation/edge.
+	AnswersTable = "answers"
+	// AnswersInverseTable is the table name for the Answer entity.
+	// It exists in this package in order to avoid circular dependency with the "answer" package.
+	AnswersInverseTable = "answers"
+	// AnswersColumn is the table column denoting the answers relation/edge.
+	AnswersColumn = "user_id"
+	// AnswerVotesTable is the table that holds the answer_votes relation/edge.
+	AnswerVotesTable = "answer_votes"
+	// AnswerVotesInverseTable is the table name for the AnswerVote entity.
+	// It exists in this package in order to avoid circular dependency with the "answervote" package.
+	AnswerVotesInverseTable = "answer_votes"
+	// AnswerVotesColumn is the table column denoting the answer_votes relation/edge.
+	AnswerVotesColumn = "user_id"
 	// CommentsTable is the table that holds the comments relation/edge.
 	CommentsTable = "comments"
 	// CommentsInverseTable is the table name for the Comment entity.
 	// It exists in this package in order to avoid circular dependency with the "comment" package.
 	CommentsInverseTable = "comments"
 	// CommentsColumn is the table column denoting the comments relation/edge.
-	CommentsColumn = "user_comments"
+	CommentsColumn = "user_id"
 	// LikePostsTable is the table that holds the like_posts relation/edge.
 	LikePostsTable = "user_like_posts"
 	// LikePostsInverseTable is the table name for the UserLikePost entity.
 	// It exists in this package in order to avoid circular dependency with the "userlikepost" package.
 	LikePostsInverseTable = "user_like_posts"
 	// LikePostsColumn is the table column denoting the like_posts relation/edge.
-	LikePostsColumn = "user_like_posts"
+	LikePostsColumn = "user_id"
 	// LikeQuestionsTable is the table that holds the like_questions relation/edge.
 	LikeQuestionsTable = "user_like_questions"
 	// LikeQuestionsInverseTable is the table name for the UserLikeQuestion entity.
 	// It exists in this package in order to avoid circular dependency with the "userlikequestion" package.
 	LikeQuestionsInverseTable = "user_like_questions"
 	// LikeQuestionsColumn is the table column denoting the like_questions relation/edge.
-	LikeQuestionsColumn = "user_like_questions"
+	LikeQuestionsColumn = "user_id"
 	// FavoritePostsTable is the table that holds the favorite_posts relation/edge.
 	FavoritePostsTable = "user_favorite_posts"
 	// FavoritePostsInverseTable is the table name for the UserFavoritePost entity.
 	// It exists in this package in order to avoid circular dependency with the "userfavoritepost" package.
 	FavoritePostsInverseTable = "user_favorite_posts"
 	// FavoritePostsColumn is the table column denoting the favorite_posts relation/edge.
-	FavoritePostsColumn = "user_favorite_posts"
+	FavoritePostsColumn = "user_id"
 	// FavoriteQuestionsTable is the table that holds the favorite_questions relation/edge.
 	FavoriteQuestionsTable = "user_favorite_questions"
 	// FavoriteQuestionsInverseTable is the table name for the UserFavoriteQuestion entity.
 	// It exists in this package in order to avoid circular dependency with the "userfavoritequestion" package.
 	FavoriteQuestionsInverseTable = "user_favorite_questions"
 	// FavoriteQuestionsColumn is the table column denoting the favorite_questions relation/edge.
-	FavoriteQuestionsColumn = "user_favorite_questions"
+	FavoriteQuestionsColumn = "user_id"
 	// FollowingTable is the table that holds the following relation/edge.
 	FollowingTable = "follows"
 	// FollowingInverseTable is the table name for the Follow entity.
 	// It exists in this package in order to avoid circular dependency with the "follow" package.
 	FollowingInverseTable = "follows"
 	// FollowingColumn is the table column denoting the following relation/edge.
-	FollowingColumn = "user_following"
+	FollowingColumn = "follower_id"
 	// FollowedByTable is the table that holds the followed_by relation/edge.
 	FollowedByTable = "follows"
 	// FollowedByInverseTable is the table name for the Follow entity.
 	// It exists in this package in order to avoid circular dependency with the "follow" package.
 	FollowedByInverseTable = "follows"
 	// FollowedByColumn is the table column denoting the followed_by relation/edge.
-	FollowedByColumn = "user_followed_by"
+	FollowedByColumn = "following_id"
+	// RefreshTokensTable is the table that holds the refresh_tokens relation/edge.
+	RefreshTokensTable = "refresh_tokens"
+	// RefreshTokensInverseTable is the table name for the RefreshToken entity.
+	// It exists in this package in order to avoid circular dependency with the "refreshtoken" package.
+	RefreshTokensInverseTable = "refresh_tokens"
+	// RefreshTokensColumn is the table column denoting the refresh_tokens relation/edge.
+	RefreshTokensColumn = "user_id"
+	// SessionsTable is the table that holds the sessions relation/edge.
+	SessionsTable = "sessions"
+	// SessionsInverseTable is the table name for the Session entity.
+	// It exists in this package in order to avoid circular dependency with the "session" package.
+	SessionsInverseTable = "sessions"
+	// SessionsColumn is the table column denoting the sessions relation/edge.
+	SessionsColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -120,20 +167,11 @@ var Columns = []string{
 	FieldNickname,
 	FieldPassword,
 	FieldAvatar,
-}
-
-// ForeignKeys holds the SQL foreign-keys that are owned by the "users"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"comment_user",
-	"follow_follower",
-	"follow_following",
-	"post_user",
-	"question_user",
-	"user_favorite_post_user",
-	"user_favorite_question_user",
-	"user_like_post_user",
-	"user_like_question_user",
+	FieldRole,
+	FieldDeletionRequestedAt,
+	FieldDeletedAt,
+	FieldFollowerCount,
+	FieldFollowingCount,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -143,11 +181,6 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
@@ -166,8 +199,39 @@ var (
 	PasswordValidator func(string) error
 	// AvatarValidator is a validator for the "avatar" field. It is called by the builders before save.
 	AvatarValidator func(string) error
+	// DefaultFollowerCount holds the default value on creation for the "follower_count" field.
+	DefaultFollowerCount int
+	// DefaultFollowingCount holds the default value on creation for the "following_count" field.
+	DefaultFollowingCount int
+)
+
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleUser is the default value of the Role enum.
+const DefaultRole = RoleUser
+
+// Role values.
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
 )
 
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleUser, RoleModerator, RoleAdmin:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for role field: %q", r)
+	}
+}
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -206,6 +270,31 @@ func ByAvatar(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAvatar, opts...).ToFunc()
 }
 
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// ByDeletionRequestedAt orders the results by the deletion_requested_at field.
+func ByDeletionRequestedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionRequestedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByFollowerCount orders the results by the follower_count field.
+func ByFollowerCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFollowerCount, opts...).ToFunc()
+}
+
+// ByFollowingCountField orders the results by the following_count field.
+func ByFollowingCountField(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFollowingCount, opts...).ToFunc()
+}
+
 // ByPostsCount orders the results by posts count.
 func ByPostsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -234,6 +323,34 @@ func ByQuestions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByAnswersCount orders the results by answers count.
+func ByAnswersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAnswersStep(), opts...)
+	}
+}
+
+// ByAnswers orders the results by answers terms.
+func ByAnswers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAnswersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAnswerVotesCount orders the results by answer_votes count.
+func ByAnswerVotesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAnswerVotesStep(), opts...)
+	}
+}
+
+// ByAnswerVotes orders the results by answer_votes terms.
+func ByAnswerVotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAnswerVotesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByCommentsCount orders the results by comments count.
 func ByCommentsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -331,6 +448,34 @@ func ByFollowedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newFollowedByStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRefreshTokensCount orders the results by refresh_tokens count.
+func ByRefreshTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRefreshTokensStep(), opts...)
+	}
+}
+
+// ByRefreshTokens orders the results by refresh_tokens terms.
+func ByRefreshTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRefreshTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySessionsCount orders the results by sessions count.
+func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSessionsStep(), opts...)
+	}
+}
+
+// BySessions orders the results by sessions terms.
+func BySessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPostsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -345,6 +490,20 @@ func newQuestionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, QuestionsTable, QuestionsColumn),
 	)
 }
+func newAnswersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AnswersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AnswersTable, AnswersColumn),
+	)
+}
+func newAnswerVotesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AnswerVotesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AnswerVotesTable, AnswerVotesColumn),
+	)
+}
 func newCommentsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -394,3 +553,17 @@ func newFollowedByStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, FollowedByTable, FollowedByColumn),
 	)
 }
+func newRefreshTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RefreshTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RefreshTokensTable, RefreshTokensColumn),
+	)
+}
+func newSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+	)
+}
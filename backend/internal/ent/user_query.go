@@ -3,11 +3,15 @@
 package ent
 
 import (
+	"backend/internal/ent/answer"
+	"backend/internal/ent/answervote"
 	"backend/internal/ent/comment"
 	"backend/internal/ent/follow"
 	"backend/internal/ent/post"
 	"backend/internal/ent/predicate"
 	"backend/internal/ent/question"
+	"backend/internal/ent/refreshtoken"
+	"backend/internal/ent/session"
 	"backend/internal/ent/user"
 	"backend/internal/ent/userfavoritepost"
 	"backend/internal/ent/userfavoritequestion"
@@ -32,6 +36,8 @@ type UserQuery struct {
 	predicates            []predicate.User
 	withPosts             *PostQuery
 	withQuestions         *QuestionQuery
+	withAnswers           *AnswerQuery
+	withAnswerVotes       *AnswerVoteQuery
 	withComments          *CommentQuery
 	withLikePosts         *UserLikePostQuery
 	withLikeQuestions     *UserLikeQuestionQuery
@@ -39,7 +45,9 @@ type UserQuery struct {
 	withFavoriteQuestions *UserFavoriteQuestionQuery
 	withFollowing         *FollowQuery
 	withFollowedBy        *FollowQuery
-	withFKs               bool
+	withRefreshTokens     *RefreshTokenQuery
+	withSessions          *SessionQuery
+	modifiers             []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -120,6 +128,50 @@ func (uq *UserQuery) QueryQuestions() *QuestionQuery {
 	return query
 }
 
+// QueryAnswers chains the current query on the "answers" edge.
+func (uq *UserQuery) QueryAnswers() *AnswerQuery {
+	query := (&AnswerClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(answer.Table, answer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AnswersTable, user.AnswersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAnswerVotes chains the current query on the "answer_votes" edge.
+func (uq *UserQuery) QueryAnswerVotes() *AnswerVoteQuery {
+	query := (&AnswerVoteClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(answervote.Table, answervote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AnswerVotesTable, user.AnswerVotesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryComments chains the current query on the "comments" edge.
 func (uq *UserQuery) QueryComments() *CommentQuery {
 	query := (&CommentClient{config: uq.config}).Query()
@@ -274,6 +326,50 @@ func (uq *UserQuery) QueryFollowedBy() *FollowQuery {
 	return query
 }
 
+// QueryRefreshTokens chains the current query on the "refresh_tokens" edge.
+func (uq *UserQuery) QueryRefreshTokens() *RefreshTokenQuery {
+	query := (&RefreshTokenClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(refreshtoken.Table, refreshtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RefreshTokensTable, user.RefreshTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySessions chains the current query on the "sessions" edge.
+func (uq *UserQuery) QuerySessions() *SessionQuery {
+	query := (&SessionClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SessionsTable, user.SessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -468,6 +564,8 @@ func (uq *UserQuery) Clone() *UserQuery {
 		predicates:            append([]predicate.User{}, uq.predicates...),
 		withPosts:             uq.withPosts.Clone(),
 		withQuestions:         uq.withQuestions.Clone(),
+		withAnswers:           uq.withAnswers.Clone(),
+		withAnswerVotes:       uq.withAnswerVotes.Clone(),
 		withComments:          uq.withComments.Clone(),
 		withLikePosts:         uq.withLikePosts.Clone(),
 		withLikeQuestions:     uq.withLikeQuestions.Clone(),
@@ -475,6 +573,8 @@ func (uq *UserQuery) Clone() *UserQuery {
 		withFavoriteQuestions: uq.withFavoriteQuestions.Clone(),
 		withFollowing:         uq.withFollowing.Clone(),
 		withFollowedBy:        uq.withFollowedBy.Clone(),
+		withRefreshTokens:     uq.withRefreshTokens.Clone(),
+		withSessions:          uq.withSessions.Clone(),
 		// clone intermediate query.
 		sql:  uq.sql.Clone(),
 		path: uq.path,
@@ -503,6 +603,28 @@ func (uq *UserQuery) WithQuestions(opts ...func(*QuestionQuery)) *UserQuery {
 	return uq
 }
 
+// WithAnswers tells the query-builder to eager-load the nodes that are connected to
+// the "answers" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithAnswers(opts ...func(*AnswerQuery)) *UserQuery {
+	query := (&AnswerClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAnswers = query
+	return uq
+}
+
+// WithAnswerVotes tells the query-builder to eager-load the nodes that are connected to
+// the "answer_votes" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithAnswerVotes(opts ...func(*AnswerVoteQuery)) *UserQuery {
+	query := (&AnswerVoteClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAnswerVotes = query
+	return uq
+}
+
 // WithComments tells the query-builder to eager-load the nodes that are connected to
 // the "comments" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithComments(opts ...func(*CommentQuery)) *UserQuery {
@@ -580,6 +702,28 @@ func (uq *UserQuery) WithFollowedBy(opts ...func(*FollowQuery)) *UserQuery {
 	return uq
 }
 
+// WithRefreshTokens tells the query-builder to eager-load the nodes that are connected to
+// the "refresh_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithRefreshTokens(opts ...func(*RefreshTokenQuery)) *UserQuery {
+	query := (&RefreshTokenClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withRefreshTokens = query
+	return uq
+}
+
+// WithSessions tells the query-builder to eager-load the nodes that are connected to
+// the "sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithSessions(opts ...func(*SessionQuery)) *UserQuery {
+	query := (&SessionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withSessions = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -657,11 +801,12 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, error) {
 	var (
 		nodes       = []*User{}
-		withFKs     = uq.withFKs
 		_spec       = uq.querySpec()
-		loadedTypes = [9]bool{
+		loadedTypes = [13]bool{
 			uq.withPosts != nil,
 			uq.withQuestions != nil,
+			uq.withAnswers != nil,
+			uq.withAnswerVotes != nil,
 			uq.withComments != nil,
 			uq.withLikePosts != nil,
 			uq.withLikeQuestions != nil,
@@ -669,11 +814,10 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			uq.withFavoriteQuestions != nil,
 			uq.withFollowing != nil,
 			uq.withFollowedBy != nil,
+			uq.withRefreshTokens != nil,
+			uq.withSessions != nil,
 		}
 	)
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, user.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*User).scanValues(nil, columns)
 	}
@@ -683,6 +827,9 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -706,6 +853,20 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withAnswers; query != nil {
+		if err := uq.loadAnswers(ctx, query, nodes,
+			func(n *User) { n.Edges.Answers = []*Answer{} },
+			func(n *User, e *Answer) { n.Edges.Answers = append(n.Edges.Answers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withAnswerVotes; query != nil {
+		if err := uq.loadAnswerVotes(ctx, query, nodes,
+			func(n *User) { n.Edges.AnswerVotes = []*AnswerVote{} },
+			func(n *User, e *AnswerVote) { n.Edges.AnswerVotes = append(n.Edges.AnswerVotes, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := uq.withComments; query != nil {
 		if err := uq.loadComments(ctx, query, nodes,
 			func(n *User) { n.Edges.Comments = []*Comment{} },
@@ -757,6 +918,20 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withRefreshTokens; query != nil {
+		if err := uq.loadRefreshTokens(ctx, query, nodes,
+			func(n *User) { n.Edges.RefreshTokens = []*RefreshToken{} },
+			func(n *User, e *RefreshToken) { n.Edges.RefreshTokens = append(n.Edges.RefreshTokens, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withSessions; query != nil {
+		if err := uq.loadSessions(ctx, query, nodes,
+			func(n *User) { n.Edges.Sessions = []*Session{} },
+			func(n *User, e *Session) { n.Edges.Sessions = append(n.Edges.Sessions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -770,7 +945,9 @@ func (uq *UserQuery) loadPosts(ctx context.Context, query *PostQuery, nodes []*U
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(post.FieldUserID)
+	}
 	query.Where(predicate.Post(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(user.PostsColumn), fks...))
 	}))
@@ -779,13 +956,10 @@ func (uq *UserQuery) loadPosts(ctx context.Context, query *PostQuery, nodes []*U
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_posts
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_posts" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_posts" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -801,7 +975,9 @@ func (uq *UserQuery) loadQuestions(ctx context.Context, query *QuestionQuery, no
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(question.FieldUserID)
+	}
 	query.Where(predicate.Question(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(user.QuestionsColumn), fks...))
 	}))
@@ -810,13 +986,70 @@ func (uq *UserQuery) loadQuestions(ctx context.Context, query *QuestionQuery, no
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_questions
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_questions" is nil for node %v`, n.ID)
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadAnswers(ctx context.Context, query *AnswerQuery, nodes []*User, init func(*User), assign func(*User, *Answer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(answer.FieldUserID)
+	}
+	query.Where(predicate.Answer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.AnswersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadAnswerVotes(ctx context.Context, query *AnswerVoteQuery, nodes []*User, init func(*User), assign func(*User, *AnswerVote)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
-		node, ok := nodeids[*fk]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(answervote.FieldUserID)
+	}
+	query.Where(predicate.AnswerVote(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.AnswerVotesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_questions" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -832,7 +1065,9 @@ func (uq *UserQuery) loadComments(ctx context.Context, query *CommentQuery, node
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(comment.FieldUserID)
+	}
 	query.Where(predicate.Comment(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(user.CommentsColumn), fks...))
 	}))
@@ -841,13 +1076,10 @@ func (uq *UserQuery) loadComments(ctx context.Context, query *CommentQuery, node
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_comments
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_comments" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_comments" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -863,7 +1095,9 @@ func (uq *UserQuery) loadLikePosts(ctx context.Context, query *UserLikePostQuery
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(userlikepost.FieldUserID)
+	}
 	query.Where(predicate.UserLikePost(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(user.LikePostsColumn), fks...))
 	}))
@@ -872,13 +1106,10 @@ func (uq *UserQuery) loadLikePosts(ctx context.Context, query *UserLikePostQuery
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_like_posts
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_like_posts" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_like_posts" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -894,7 +1125,9 @@ func (uq *UserQuery) loadLikeQuestions(ctx context.Context, query *UserLikeQuest
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(userlikequestion.FieldUserID)
+	}
 	query.Where(predicate.UserLikeQuestion(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(user.LikeQuestionsColumn), fks...))
 	}))
@@ -903,13 +1136,10 @@ func (uq *UserQuery) loadLikeQuestions(ctx context.Context, query *UserLikeQuest
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_like_questions
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_like_questions" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_like_questions" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -925,7 +1155,9 @@ func (uq *UserQuery) loadFavoritePosts(ctx context.Context, query *UserFavoriteP
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(userfavoritepost.FieldUserID)
+	}
 	query.Where(predicate.UserFavoritePost(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(user.FavoritePostsColumn), fks...))
 	}))
@@ -934,13 +1166,10 @@ func (uq *UserQuery) loadFavoritePosts(ctx context.Context, query *UserFavoriteP
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_favorite_posts
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_favorite_posts" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_favorite_posts" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -956,7 +1185,9 @@ func (uq *UserQuery) loadFavoriteQuestions(ctx context.Context, query *UserFavor
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(userfavoritequestion.FieldUserID)
+	}
 	query.Where(predicate.UserFavoriteQuestion(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(user.FavoriteQuestionsColumn), fks...))
 	}))
@@ -965,13 +1196,10 @@ func (uq *UserQuery) loadFavoriteQuestions(ctx context.Context, query *UserFavor
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_favorite_questions
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_favorite_questions" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_favorite_questions" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -987,7 +1215,9 @@ func (uq *UserQuery) loadFollowing(ctx context.Context, query *FollowQuery, node
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(follow.FieldFollowerID)
+	}
 	query.Where(predicate.Follow(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(user.FollowingColumn), fks...))
 	}))
@@ -996,13 +1226,10 @@ func (uq *UserQuery) loadFollowing(ctx context.Context, query *FollowQuery, node
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_following
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_following" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.FollowerID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_following" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "follower_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -1018,7 +1245,9 @@ func (uq *UserQuery) loadFollowedBy(ctx context.Context, query *FollowQuery, nod
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(follow.FieldFollowingID)
+	}
 	query.Where(predicate.Follow(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(user.FollowedByColumn), fks...))
 	}))
@@ -1027,13 +1256,70 @@ func (uq *UserQuery) loadFollowedBy(ctx context.Context, query *FollowQuery, nod
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_followed_by
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_followed_by" is nil for node %v`, n.ID)
+		fk := n.FollowingID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "following_id" returned %v for node %v`, fk, n.ID)
 		}
-		node, ok := nodeids[*fk]
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadRefreshTokens(ctx context.Context, query *RefreshTokenQuery, nodes []*User, init func(*User), assign func(*User, *RefreshToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(refreshtoken.FieldUserID)
+	}
+	query.Where(predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.RefreshTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_followed_by" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadSessions(ctx context.Context, query *SessionQuery, nodes []*User, init func(*User), assign func(*User, *Session)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(session.FieldUserID)
+	}
+	query.Where(predicate.Session(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -1042,6 +1328,9 @@ func (uq *UserQuery) loadFollowedBy(ctx context.Context, query *FollowQuery, nod
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
 	_spec.Node.Columns = uq.ctx.Fields
 	if len(uq.ctx.Fields) > 0 {
 		_spec.Unique = uq.ctx.Unique != nil && *uq.ctx.Unique
@@ -1104,6 +1393,9 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if uq.ctx.Unique != nil && *uq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range uq.modifiers {
+		m(selector)
+	}
 	for _, p := range uq.predicates {
 		p(selector)
 	}
@@ -1121,6 +1413,12 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (uq *UserQuery) Modify(modifiers ...func(s *sql.Selector)) *UserSelect {
+	uq.modifiers = append(uq.modifiers, modifiers...)
+	return uq.Select()
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
@@ -1210,3 +1508,9 @@ func (us *UserSelect) sqlScan(ctx context.Context, root *UserQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (us *UserSelect) Modify(modifiers ...func(s *sql.Selector)) *UserSelect {
+	us.modifiers = append(us.modifiers, modifiers...)
+	return us
+}
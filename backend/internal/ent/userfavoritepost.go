@@ -3,6 +3,8 @@
 package ent
 
 import (
+	"backend/internal/ent/post"
+	"backend/internal/ent/user"
 	"backend/internal/ent/userfavoritepost"
 	"fmt"
 	"strings"
@@ -22,37 +24,39 @@ type UserFavoritePost struct {
 	PostID int `json:"post_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserFavoritePostQuery when eager-loading is set.
-	Edges               UserFavoritePostEdges `json:"edges"`
-	post_favorites      *int
-	user_favorite_posts *int
-	selectValues        sql.SelectValues
+	Edges        UserFavoritePostEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // UserFavoritePostEdges holds the relations/edges for other nodes in the graph.
 type UserFavoritePostEdges struct {
 	// User holds the value of the user edge.
-	User []*User `json:"user,omitempty"`
+	User *User `json:"user,omitempty"`
 	// Post holds the value of the post edge.
-	Post []*Post `json:"post,omitempty"`
+	Post *Post `json:"post,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [2]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
-// was not loaded in eager-loading.
-func (e UserFavoritePostEdges) UserOrErr() ([]*User, error) {
-	if e.loadedTypes[0] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserFavoritePostEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
 		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "user"}
 }
 
 // PostOrErr returns the Post value or an error if the edge
-// was not loaded in eager-loading.
-func (e UserFavoritePostEdges) PostOrErr() ([]*Post, error) {
-	if e.loadedTypes[1] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserFavoritePostEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
 		return e.Post, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: post.Label}
 	}
 	return nil, &NotLoadedError{edge: "post"}
 }
@@ -64,10 +68,6 @@ func (*UserFavoritePost) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case userfavoritepost.FieldID, userfavoritepost.FieldUserID, userfavoritepost.FieldPostID:
 			values[i] = new(sql.NullInt64)
-		case userfavoritepost.ForeignKeys[0]: // post_favorites
-			values[i] = new(sql.NullInt64)
-		case userfavoritepost.ForeignKeys[1]: // user_favorite_posts
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -101,20 +101,6 @@ func (ufp *UserFavoritePost) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				ufp.PostID = int(value.Int64)
 			}
-		case userfavoritepost.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field post_favorites", value)
-			} else if value.Valid {
-				ufp.post_favorites = new(int)
-				*ufp.post_favorites = int(value.Int64)
-			}
-		case userfavoritepost.ForeignKeys[1]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_favorite_posts", value)
-			} else if value.Valid {
-				ufp.user_favorite_posts = new(int)
-				*ufp.user_favorite_posts = int(value.Int64)
-			}
 		default:
 			ufp.selectValues.Set(columns[i], values[i])
 		}
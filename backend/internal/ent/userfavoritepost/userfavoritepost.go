@@ -3,6 +3,7 @@
 package userfavoritepost
 
 import (
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -23,19 +24,19 @@ const (
 	// Table holds the table name of the userfavoritepost in the database.
 	Table = "user_favorite_posts"
 	// UserTable is the table that holds the user relation/edge.
-	UserTable = "users"
+	UserTable = "user_favorite_posts"
 	// UserInverseTable is the table name for the User entity.
 	// It exists in this package in order to avoid circular dependency with the "user" package.
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
-	UserColumn = "user_favorite_post_user"
+	UserColumn = "user_id"
 	// PostTable is the table that holds the post relation/edge.
-	PostTable = "posts"
+	PostTable = "user_favorite_posts"
 	// PostInverseTable is the table name for the Post entity.
 	// It exists in this package in order to avoid circular dependency with the "post" package.
 	PostInverseTable = "posts"
 	// PostColumn is the table column denoting the post relation/edge.
-	PostColumn = "user_favorite_post_post"
+	PostColumn = "post_id"
 )
 
 // Columns holds all SQL columns for userfavoritepost fields.
@@ -45,13 +46,6 @@ var Columns = []string{
 	FieldPostID,
 }
 
-// ForeignKeys holds the SQL foreign-keys that are owned by the "user_favorite_posts"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"post_favorites",
-	"user_favorite_posts",
-}
-
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -59,14 +53,18 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "backend/internal/ent/runtime"
+var (
+	Hooks [1]ent.Hook
+)
+
 // OrderOption defines the ordering options for the UserFavoritePost queries.
 type OrderOption func(*sql.Selector)
 
@@ -85,44 +83,30 @@ func ByPostID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPostID, opts...).ToFunc()
 }
 
-// ByUserCount orders the results by user count.
-func ByUserCount(opts ...sql.OrderTermOption) OrderOption {
-	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newUserStep(), opts...)
-	}
-}
-
-// ByUser orders the results by user terms.
-func ByUser(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
-	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newUserStep(), append([]sql.OrderTerm{term}, terms...)...)
-	}
-}
-
-// ByPostCount orders the results by post count.
-func ByPostCount(opts ...sql.OrderTermOption) OrderOption {
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newPostStep(), opts...)
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
 
-// ByPost orders the results by post terms.
-func ByPost(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+// ByPostField orders the results by post field.
+func ByPostField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newPostStep(), append([]sql.OrderTerm{term}, terms...)...)
+		sqlgraph.OrderByNeighborTerms(s, newPostStep(), sql.OrderByField(field, opts...))
 	}
 }
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(UserInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, UserTable, UserColumn),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
 func newPostStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(PostInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, PostTable, PostColumn),
+		sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
 	)
 }
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +20,7 @@ type UserFavoritePostCreate struct {
 	config
 	mutation *UserFavoritePostMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user_id" field.
@@ -33,34 +35,14 @@ func (ufpc *UserFavoritePostCreate) SetPostID(i int) *UserFavoritePostCreate {
 	return ufpc
 }
 
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (ufpc *UserFavoritePostCreate) AddUserIDs(ids ...int) *UserFavoritePostCreate {
-	ufpc.mutation.AddUserIDs(ids...)
-	return ufpc
-}
-
-// AddUser adds the "user" edges to the User entity.
-func (ufpc *UserFavoritePostCreate) AddUser(u ...*User) *UserFavoritePostCreate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ufpc.AddUserIDs(ids...)
-}
-
-// AddPostIDs adds the "post" edge to the Post entity by IDs.
-func (ufpc *UserFavoritePostCreate) AddPostIDs(ids ...int) *UserFavoritePostCreate {
-	ufpc.mutation.AddPostIDs(ids...)
-	return ufpc
+// SetUser sets the "user" edge to the User entity.
+func (ufpc *UserFavoritePostCreate) SetUser(u *User) *UserFavoritePostCreate {
+	return ufpc.SetUserID(u.ID)
 }
 
-// AddPost adds the "post" edges to the Post entity.
-func (ufpc *UserFavoritePostCreate) AddPost(p ...*Post) *UserFavoritePostCreate {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
-	}
-	return ufpc.AddPostIDs(ids...)
+// SetPost sets the "post" edge to the Post entity.
+func (ufpc *UserFavoritePostCreate) SetPost(p *Post) *UserFavoritePostCreate {
+	return ufpc.SetPostID(p.ID)
 }
 
 // Mutation returns the UserFavoritePostMutation object of the builder.
@@ -103,6 +85,12 @@ func (ufpc *UserFavoritePostCreate) check() error {
 	if _, ok := ufpc.mutation.PostID(); !ok {
 		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "UserFavoritePost.post_id"`)}
 	}
+	if _, ok := ufpc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserFavoritePost.user"`)}
+	}
+	if _, ok := ufpc.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "UserFavoritePost.post"`)}
+	}
 	return nil
 }
 
@@ -129,18 +117,11 @@ func (ufpc *UserFavoritePostCreate) createSpec() (*UserFavoritePost, *sqlgraph.C
 		_node = &UserFavoritePost{config: ufpc.config}
 		_spec = sqlgraph.NewCreateSpec(userfavoritepost.Table, sqlgraph.NewFieldSpec(userfavoritepost.FieldID, field.TypeInt))
 	)
-	if value, ok := ufpc.mutation.UserID(); ok {
-		_spec.SetField(userfavoritepost.FieldUserID, field.TypeInt, value)
-		_node.UserID = value
-	}
-	if value, ok := ufpc.mutation.PostID(); ok {
-		_spec.SetField(userfavoritepost.FieldPostID, field.TypeInt, value)
-		_node.PostID = value
-	}
+	_spec.OnConflict = ufpc.conflict
 	if nodes := ufpc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritepost.UserTable,
 			Columns: []string{userfavoritepost.UserColumn},
 			Bidi:    false,
@@ -151,12 +132,13 @@ func (ufpc *UserFavoritePostCreate) createSpec() (*UserFavoritePost, *sqlgraph.C
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := ufpc.mutation.PostIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritepost.PostTable,
 			Columns: []string{userfavoritepost.PostColumn},
 			Bidi:    false,
@@ -167,16 +149,192 @@ func (ufpc *UserFavoritePostCreate) createSpec() (*UserFavoritePost, *sqlgraph.C
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.PostID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserFavoritePost.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserFavoritePostUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (ufpc *UserFavoritePostCreate) OnConflict(opts ...sql.ConflictOption) *UserFavoritePostUpsertOne {
+	ufpc.conflict = opts
+	return &UserFavoritePostUpsertOne{
+		create: ufpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserFavoritePost.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ufpc *UserFavoritePostCreate) OnConflictColumns(columns ...string) *UserFavoritePostUpsertOne {
+	ufpc.conflict = append(ufpc.conflict, sql.ConflictColumns(columns...))
+	return &UserFavoritePostUpsertOne{
+		create: ufpc,
+	}
+}
+
+type (
+	// UserFavoritePostUpsertOne is the builder for "upsert"-ing
+	//  one UserFavoritePost node.
+	UserFavoritePostUpsertOne struct {
+		create *UserFavoritePostCreate
+	}
+
+	// UserFavoritePostUpsert is the "OnConflict" setter.
+	UserFavoritePostUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *UserFavoritePostUpsert) SetUserID(v int) *UserFavoritePostUpsert {
+	u.Set(userfavoritepost.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserFavoritePostUpsert) UpdateUserID() *UserFavoritePostUpsert {
+	u.SetExcluded(userfavoritepost.FieldUserID)
+	return u
+}
+
+// SetPostID sets the "post_id" field.
+func (u *UserFavoritePostUpsert) SetPostID(v int) *UserFavoritePostUpsert {
+	u.Set(userfavoritepost.FieldPostID, v)
+	return u
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *UserFavoritePostUpsert) UpdatePostID() *UserFavoritePostUpsert {
+	u.SetExcluded(userfavoritepost.FieldPostID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.UserFavoritePost.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserFavoritePostUpsertOne) UpdateNewValues() *UserFavoritePostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserFavoritePost.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserFavoritePostUpsertOne) Ignore() *UserFavoritePostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserFavoritePostUpsertOne) DoNothing() *UserFavoritePostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserFavoritePostCreate.OnConflict
+// documentation for more info.
+func (u *UserFavoritePostUpsertOne) Update(set func(*UserFavoritePostUpsert)) *UserFavoritePostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserFavoritePostUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserFavoritePostUpsertOne) SetUserID(v int) *UserFavoritePostUpsertOne {
+	return u.Update(func(s *UserFavoritePostUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserFavoritePostUpsertOne) UpdateUserID() *UserFavoritePostUpsertOne {
+	return u.Update(func(s *UserFavoritePostUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPostID sets the "post_id" field.
+func (u *UserFavoritePostUpsertOne) SetPostID(v int) *UserFavoritePostUpsertOne {
+	return u.Update(func(s *UserFavoritePostUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *UserFavoritePostUpsertOne) UpdatePostID() *UserFavoritePostUpsertOne {
+	return u.Update(func(s *UserFavoritePostUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// Exec executes the query.
+func (u *UserFavoritePostUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserFavoritePostCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserFavoritePostUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserFavoritePostUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserFavoritePostUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // UserFavoritePostCreateBulk is the builder for creating many UserFavoritePost entities in bulk.
 type UserFavoritePostCreateBulk struct {
 	config
 	err      error
 	builders []*UserFavoritePostCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the UserFavoritePost entities in the database.
@@ -205,6 +363,7 @@ func (ufpcb *UserFavoritePostCreateBulk) Save(ctx context.Context) ([]*UserFavor
 					_, err = mutators[i+1].Mutate(root, ufpcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ufpcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ufpcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -258,3 +417,135 @@ func (ufpcb *UserFavoritePostCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserFavoritePost.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserFavoritePostUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (ufpcb *UserFavoritePostCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserFavoritePostUpsertBulk {
+	ufpcb.conflict = opts
+	return &UserFavoritePostUpsertBulk{
+		create: ufpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserFavoritePost.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ufpcb *UserFavoritePostCreateBulk) OnConflictColumns(columns ...string) *UserFavoritePostUpsertBulk {
+	ufpcb.conflict = append(ufpcb.conflict, sql.ConflictColumns(columns...))
+	return &UserFavoritePostUpsertBulk{
+		create: ufpcb,
+	}
+}
+
+// UserFavoritePostUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserFavoritePost nodes.
+type UserFavoritePostUpsertBulk struct {
+	create *UserFavoritePostCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserFavoritePost.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserFavoritePostUpsertBulk) UpdateNewValues() *UserFavoritePostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserFavoritePost.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserFavoritePostUpsertBulk) Ignore() *UserFavoritePostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserFavoritePostUpsertBulk) DoNothing() *UserFavoritePostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserFavoritePostCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserFavoritePostUpsertBulk) Update(set func(*UserFavoritePostUpsert)) *UserFavoritePostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserFavoritePostUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserFavoritePostUpsertBulk) SetUserID(v int) *UserFavoritePostUpsertBulk {
+	return u.Update(func(s *UserFavoritePostUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserFavoritePostUpsertBulk) UpdateUserID() *UserFavoritePostUpsertBulk {
+	return u.Update(func(s *UserFavoritePostUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPostID sets the "post_id" field.
+func (u *UserFavoritePostUpsertBulk) SetPostID(v int) *UserFavoritePostUpsertBulk {
+	return u.Update(func(s *UserFavoritePostUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *UserFavoritePostUpsertBulk) UpdatePostID() *UserFavoritePostUpsertBulk {
+	return u.Update(func(s *UserFavoritePostUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// Exec executes the query.
+func (u *UserFavoritePostUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserFavoritePostCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserFavoritePostCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserFavoritePostUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
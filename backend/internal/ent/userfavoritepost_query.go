@@ -8,7 +8,6 @@ import (
 	"backend/internal/ent/user"
 	"backend/internal/ent/userfavoritepost"
 	"context"
-	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -26,7 +25,7 @@ type UserFavoritePostQuery struct {
 	predicates []predicate.UserFavoritePost
 	withUser   *UserQuery
 	withPost   *PostQuery
-	withFKs    bool
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -77,7 +76,7 @@ func (ufpq *UserFavoritePostQuery) QueryUser() *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(userfavoritepost.Table, userfavoritepost.FieldID, selector),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, userfavoritepost.UserTable, userfavoritepost.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, userfavoritepost.UserTable, userfavoritepost.UserColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(ufpq.driver.Dialect(), step)
 		return fromU, nil
@@ -99,7 +98,7 @@ func (ufpq *UserFavoritePostQuery) QueryPost() *PostQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(userfavoritepost.Table, userfavoritepost.FieldID, selector),
 			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, userfavoritepost.PostTable, userfavoritepost.PostColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, userfavoritepost.PostTable, userfavoritepost.PostColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(ufpq.driver.Dialect(), step)
 		return fromU, nil
@@ -406,16 +405,12 @@ func (ufpq *UserFavoritePostQuery) prepareQuery(ctx context.Context) error {
 func (ufpq *UserFavoritePostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserFavoritePost, error) {
 	var (
 		nodes       = []*UserFavoritePost{}
-		withFKs     = ufpq.withFKs
 		_spec       = ufpq.querySpec()
 		loadedTypes = [2]bool{
 			ufpq.withUser != nil,
 			ufpq.withPost != nil,
 		}
 	)
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, userfavoritepost.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*UserFavoritePost).scanValues(nil, columns)
 	}
@@ -425,6 +420,9 @@ func (ufpq *UserFavoritePostQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(ufpq.modifiers) > 0 {
+		_spec.Modifiers = ufpq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -435,16 +433,14 @@ func (ufpq *UserFavoritePostQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 		return nodes, nil
 	}
 	if query := ufpq.withUser; query != nil {
-		if err := ufpq.loadUser(ctx, query, nodes,
-			func(n *UserFavoritePost) { n.Edges.User = []*User{} },
-			func(n *UserFavoritePost, e *User) { n.Edges.User = append(n.Edges.User, e) }); err != nil {
+		if err := ufpq.loadUser(ctx, query, nodes, nil,
+			func(n *UserFavoritePost, e *User) { n.Edges.User = e }); err != nil {
 			return nil, err
 		}
 	}
 	if query := ufpq.withPost; query != nil {
-		if err := ufpq.loadPost(ctx, query, nodes,
-			func(n *UserFavoritePost) { n.Edges.Post = []*Post{} },
-			func(n *UserFavoritePost, e *Post) { n.Edges.Post = append(n.Edges.Post, e) }); err != nil {
+		if err := ufpq.loadPost(ctx, query, nodes, nil,
+			func(n *UserFavoritePost, e *Post) { n.Edges.Post = e }); err != nil {
 			return nil, err
 		}
 	}
@@ -452,70 +448,69 @@ func (ufpq *UserFavoritePostQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 }
 
 func (ufpq *UserFavoritePostQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*UserFavoritePost, init func(*UserFavoritePost), assign func(*UserFavoritePost, *User)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*UserFavoritePost)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*UserFavoritePost)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
 	}
-	query.withFKs = true
-	query.Where(predicate.User(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(userfavoritepost.UserColumn), fks...))
-	}))
+	query.Where(user.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_favorite_post_user
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_favorite_post_user" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_favorite_post_user" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
 func (ufpq *UserFavoritePostQuery) loadPost(ctx context.Context, query *PostQuery, nodes []*UserFavoritePost, init func(*UserFavoritePost), assign func(*UserFavoritePost, *Post)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*UserFavoritePost)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*UserFavoritePost)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].PostID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	query.withFKs = true
-	query.Where(predicate.Post(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(userfavoritepost.PostColumn), fks...))
-	}))
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(post.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_favorite_post_post
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_favorite_post_post" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_favorite_post_post" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "post_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
 
 func (ufpq *UserFavoritePostQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ufpq.querySpec()
+	if len(ufpq.modifiers) > 0 {
+		_spec.Modifiers = ufpq.modifiers
+	}
 	_spec.Node.Columns = ufpq.ctx.Fields
 	if len(ufpq.ctx.Fields) > 0 {
 		_spec.Unique = ufpq.ctx.Unique != nil && *ufpq.ctx.Unique
@@ -539,6 +534,12 @@ func (ufpq *UserFavoritePostQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if ufpq.withUser != nil {
+			_spec.Node.AddColumnOnce(userfavoritepost.FieldUserID)
+		}
+		if ufpq.withPost != nil {
+			_spec.Node.AddColumnOnce(userfavoritepost.FieldPostID)
+		}
 	}
 	if ps := ufpq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -578,6 +579,9 @@ func (ufpq *UserFavoritePostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if ufpq.ctx.Unique != nil && *ufpq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range ufpq.modifiers {
+		m(selector)
+	}
 	for _, p := range ufpq.predicates {
 		p(selector)
 	}
@@ -595,6 +599,12 @@ func (ufpq *UserFavoritePostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ufpq *UserFavoritePostQuery) Modify(modifiers ...func(s *sql.Selector)) *UserFavoritePostSelect {
+	ufpq.modifiers = append(ufpq.modifiers, modifiers...)
+	return ufpq.Select()
+}
+
 // UserFavoritePostGroupBy is the group-by builder for UserFavoritePost entities.
 type UserFavoritePostGroupBy struct {
 	selector
@@ -684,3 +694,9 @@ func (ufps *UserFavoritePostSelect) sqlScan(ctx context.Context, root *UserFavor
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ufps *UserFavoritePostSelect) Modify(modifiers ...func(s *sql.Selector)) *UserFavoritePostSelect {
+	ufps.modifiers = append(ufps.modifiers, modifiers...)
+	return ufps
+}
@@ -19,8 +19,9 @@ import (
 // UserFavoritePostUpdate is the builder for updating UserFavoritePost entities.
 type UserFavoritePostUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserFavoritePostMutation
+	hooks     []Hook
+	mutation  *UserFavoritePostMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserFavoritePostUpdate builder.
@@ -31,7 +32,6 @@ func (ufpu *UserFavoritePostUpdate) Where(ps ...predicate.UserFavoritePost) *Use
 
 // SetUserID sets the "user_id" field.
 func (ufpu *UserFavoritePostUpdate) SetUserID(i int) *UserFavoritePostUpdate {
-	ufpu.mutation.ResetUserID()
 	ufpu.mutation.SetUserID(i)
 	return ufpu
 }
@@ -44,15 +44,8 @@ func (ufpu *UserFavoritePostUpdate) SetNillableUserID(i *int) *UserFavoritePostU
 	return ufpu
 }
 
-// AddUserID adds i to the "user_id" field.
-func (ufpu *UserFavoritePostUpdate) AddUserID(i int) *UserFavoritePostUpdate {
-	ufpu.mutation.AddUserID(i)
-	return ufpu
-}
-
 // SetPostID sets the "post_id" field.
 func (ufpu *UserFavoritePostUpdate) SetPostID(i int) *UserFavoritePostUpdate {
-	ufpu.mutation.ResetPostID()
 	ufpu.mutation.SetPostID(i)
 	return ufpu
 }
@@ -65,40 +58,14 @@ func (ufpu *UserFavoritePostUpdate) SetNillablePostID(i *int) *UserFavoritePostU
 	return ufpu
 }
 
-// AddPostID adds i to the "post_id" field.
-func (ufpu *UserFavoritePostUpdate) AddPostID(i int) *UserFavoritePostUpdate {
-	ufpu.mutation.AddPostID(i)
-	return ufpu
-}
-
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (ufpu *UserFavoritePostUpdate) AddUserIDs(ids ...int) *UserFavoritePostUpdate {
-	ufpu.mutation.AddUserIDs(ids...)
-	return ufpu
-}
-
-// AddUser adds the "user" edges to the User entity.
-func (ufpu *UserFavoritePostUpdate) AddUser(u ...*User) *UserFavoritePostUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ufpu.AddUserIDs(ids...)
-}
-
-// AddPostIDs adds the "post" edge to the Post entity by IDs.
-func (ufpu *UserFavoritePostUpdate) AddPostIDs(ids ...int) *UserFavoritePostUpdate {
-	ufpu.mutation.AddPostIDs(ids...)
-	return ufpu
+// SetUser sets the "user" edge to the User entity.
+func (ufpu *UserFavoritePostUpdate) SetUser(u *User) *UserFavoritePostUpdate {
+	return ufpu.SetUserID(u.ID)
 }
 
-// AddPost adds the "post" edges to the Post entity.
-func (ufpu *UserFavoritePostUpdate) AddPost(p ...*Post) *UserFavoritePostUpdate {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
-	}
-	return ufpu.AddPostIDs(ids...)
+// SetPost sets the "post" edge to the Post entity.
+func (ufpu *UserFavoritePostUpdate) SetPost(p *Post) *UserFavoritePostUpdate {
+	return ufpu.SetPostID(p.ID)
 }
 
 // Mutation returns the UserFavoritePostMutation object of the builder.
@@ -106,48 +73,18 @@ func (ufpu *UserFavoritePostUpdate) Mutation() *UserFavoritePostMutation {
 	return ufpu.mutation
 }
 
-// ClearUser clears all "user" edges to the User entity.
+// ClearUser clears the "user" edge to the User entity.
 func (ufpu *UserFavoritePostUpdate) ClearUser() *UserFavoritePostUpdate {
 	ufpu.mutation.ClearUser()
 	return ufpu
 }
 
-// RemoveUserIDs removes the "user" edge to User entities by IDs.
-func (ufpu *UserFavoritePostUpdate) RemoveUserIDs(ids ...int) *UserFavoritePostUpdate {
-	ufpu.mutation.RemoveUserIDs(ids...)
-	return ufpu
-}
-
-// RemoveUser removes "user" edges to User entities.
-func (ufpu *UserFavoritePostUpdate) RemoveUser(u ...*User) *UserFavoritePostUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ufpu.RemoveUserIDs(ids...)
-}
-
-// ClearPost clears all "post" edges to the Post entity.
+// ClearPost clears the "post" edge to the Post entity.
 func (ufpu *UserFavoritePostUpdate) ClearPost() *UserFavoritePostUpdate {
 	ufpu.mutation.ClearPost()
 	return ufpu
 }
 
-// RemovePostIDs removes the "post" edge to Post entities by IDs.
-func (ufpu *UserFavoritePostUpdate) RemovePostIDs(ids ...int) *UserFavoritePostUpdate {
-	ufpu.mutation.RemovePostIDs(ids...)
-	return ufpu
-}
-
-// RemovePost removes "post" edges to Post entities.
-func (ufpu *UserFavoritePostUpdate) RemovePost(p ...*Post) *UserFavoritePostUpdate {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
-	}
-	return ufpu.RemovePostIDs(ids...)
-}
-
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ufpu *UserFavoritePostUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, ufpu.sqlSave, ufpu.mutation, ufpu.hooks)
@@ -175,7 +112,27 @@ func (ufpu *UserFavoritePostUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ufpu *UserFavoritePostUpdate) check() error {
+	if _, ok := ufpu.mutation.UserID(); ufpu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserFavoritePost.user"`)
+	}
+	if _, ok := ufpu.mutation.PostID(); ufpu.mutation.PostCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserFavoritePost.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ufpu *UserFavoritePostUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserFavoritePostUpdate {
+	ufpu.modifiers = append(ufpu.modifiers, modifiers...)
+	return ufpu
+}
+
 func (ufpu *UserFavoritePostUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ufpu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(userfavoritepost.Table, userfavoritepost.Columns, sqlgraph.NewFieldSpec(userfavoritepost.FieldID, field.TypeInt))
 	if ps := ufpu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -184,35 +141,10 @@ func (ufpu *UserFavoritePostUpdate) sqlSave(ctx context.Context) (n int, err err
 			}
 		}
 	}
-	if value, ok := ufpu.mutation.UserID(); ok {
-		_spec.SetField(userfavoritepost.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := ufpu.mutation.AddedUserID(); ok {
-		_spec.AddField(userfavoritepost.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := ufpu.mutation.PostID(); ok {
-		_spec.SetField(userfavoritepost.FieldPostID, field.TypeInt, value)
-	}
-	if value, ok := ufpu.mutation.AddedPostID(); ok {
-		_spec.AddField(userfavoritepost.FieldPostID, field.TypeInt, value)
-	}
 	if ufpu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   userfavoritepost.UserTable,
-			Columns: []string{userfavoritepost.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ufpu.mutation.RemovedUserIDs(); len(nodes) > 0 && !ufpu.mutation.UserCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritepost.UserTable,
 			Columns: []string{userfavoritepost.UserColumn},
 			Bidi:    false,
@@ -220,15 +152,12 @@ func (ufpu *UserFavoritePostUpdate) sqlSave(ctx context.Context) (n int, err err
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ufpu.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritepost.UserTable,
 			Columns: []string{userfavoritepost.UserColumn},
 			Bidi:    false,
@@ -243,21 +172,8 @@ func (ufpu *UserFavoritePostUpdate) sqlSave(ctx context.Context) (n int, err err
 	}
 	if ufpu.mutation.PostCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   userfavoritepost.PostTable,
-			Columns: []string{userfavoritepost.PostColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ufpu.mutation.RemovedPostIDs(); len(nodes) > 0 && !ufpu.mutation.PostCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritepost.PostTable,
 			Columns: []string{userfavoritepost.PostColumn},
 			Bidi:    false,
@@ -265,15 +181,12 @@ func (ufpu *UserFavoritePostUpdate) sqlSave(ctx context.Context) (n int, err err
 				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ufpu.mutation.PostIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritepost.PostTable,
 			Columns: []string{userfavoritepost.PostColumn},
 			Bidi:    false,
@@ -286,6 +199,7 @@ func (ufpu *UserFavoritePostUpdate) sqlSave(ctx context.Context) (n int, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(ufpu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, ufpu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{userfavoritepost.Label}
@@ -301,14 +215,14 @@ func (ufpu *UserFavoritePostUpdate) sqlSave(ctx context.Context) (n int, err err
 // UserFavoritePostUpdateOne is the builder for updating a single UserFavoritePost entity.
 type UserFavoritePostUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserFavoritePostMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *UserFavoritePostMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
 func (ufpuo *UserFavoritePostUpdateOne) SetUserID(i int) *UserFavoritePostUpdateOne {
-	ufpuo.mutation.ResetUserID()
 	ufpuo.mutation.SetUserID(i)
 	return ufpuo
 }
@@ -321,15 +235,8 @@ func (ufpuo *UserFavoritePostUpdateOne) SetNillableUserID(i *int) *UserFavoriteP
 	return ufpuo
 }
 
-// AddUserID adds i to the "user_id" field.
-func (ufpuo *UserFavoritePostUpdateOne) AddUserID(i int) *UserFavoritePostUpdateOne {
-	ufpuo.mutation.AddUserID(i)
-	return ufpuo
-}
-
 // SetPostID sets the "post_id" field.
 func (ufpuo *UserFavoritePostUpdateOne) SetPostID(i int) *UserFavoritePostUpdateOne {
-	ufpuo.mutation.ResetPostID()
 	ufpuo.mutation.SetPostID(i)
 	return ufpuo
 }
@@ -342,40 +249,14 @@ func (ufpuo *UserFavoritePostUpdateOne) SetNillablePostID(i *int) *UserFavoriteP
 	return ufpuo
 }
 
-// AddPostID adds i to the "post_id" field.
-func (ufpuo *UserFavoritePostUpdateOne) AddPostID(i int) *UserFavoritePostUpdateOne {
-	ufpuo.mutation.AddPostID(i)
-	return ufpuo
-}
-
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (ufpuo *UserFavoritePostUpdateOne) AddUserIDs(ids ...int) *UserFavoritePostUpdateOne {
-	ufpuo.mutation.AddUserIDs(ids...)
-	return ufpuo
-}
-
-// AddUser adds the "user" edges to the User entity.
-func (ufpuo *UserFavoritePostUpdateOne) AddUser(u ...*User) *UserFavoritePostUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ufpuo.AddUserIDs(ids...)
-}
-
-// AddPostIDs adds the "post" edge to the Post entity by IDs.
-func (ufpuo *UserFavoritePostUpdateOne) AddPostIDs(ids ...int) *UserFavoritePostUpdateOne {
-	ufpuo.mutation.AddPostIDs(ids...)
-	return ufpuo
+// SetUser sets the "user" edge to the User entity.
+func (ufpuo *UserFavoritePostUpdateOne) SetUser(u *User) *UserFavoritePostUpdateOne {
+	return ufpuo.SetUserID(u.ID)
 }
 
-// AddPost adds the "post" edges to the Post entity.
-func (ufpuo *UserFavoritePostUpdateOne) AddPost(p ...*Post) *UserFavoritePostUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
-	}
-	return ufpuo.AddPostIDs(ids...)
+// SetPost sets the "post" edge to the Post entity.
+func (ufpuo *UserFavoritePostUpdateOne) SetPost(p *Post) *UserFavoritePostUpdateOne {
+	return ufpuo.SetPostID(p.ID)
 }
 
 // Mutation returns the UserFavoritePostMutation object of the builder.
@@ -383,48 +264,18 @@ func (ufpuo *UserFavoritePostUpdateOne) Mutation() *UserFavoritePostMutation {
 	return ufpuo.mutation
 }
 
-// ClearUser clears all "user" edges to the User entity.
+// ClearUser clears the "user" edge to the User entity.
 func (ufpuo *UserFavoritePostUpdateOne) ClearUser() *UserFavoritePostUpdateOne {
 	ufpuo.mutation.ClearUser()
 	return ufpuo
 }
 
-// RemoveUserIDs removes the "user" edge to User entities by IDs.
-func (ufpuo *UserFavoritePostUpdateOne) RemoveUserIDs(ids ...int) *UserFavoritePostUpdateOne {
-	ufpuo.mutation.RemoveUserIDs(ids...)
-	return ufpuo
-}
-
-// RemoveUser removes "user" edges to User entities.
-func (ufpuo *UserFavoritePostUpdateOne) RemoveUser(u ...*User) *UserFavoritePostUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ufpuo.RemoveUserIDs(ids...)
-}
-
-// ClearPost clears all "post" edges to the Post entity.
+// ClearPost clears the "post" edge to the Post entity.
 func (ufpuo *UserFavoritePostUpdateOne) ClearPost() *UserFavoritePostUpdateOne {
 	ufpuo.mutation.ClearPost()
 	return ufpuo
 }
 
-// RemovePostIDs removes the "post" edge to Post entities by IDs.
-func (ufpuo *UserFavoritePostUpdateOne) RemovePostIDs(ids ...int) *UserFavoritePostUpdateOne {
-	ufpuo.mutation.RemovePostIDs(ids...)
-	return ufpuo
-}
-
-// RemovePost removes "post" edges to Post entities.
-func (ufpuo *UserFavoritePostUpdateOne) RemovePost(p ...*Post) *UserFavoritePostUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
-	}
-	return ufpuo.RemovePostIDs(ids...)
-}
-
 // Where appends a list predicates to the UserFavoritePostUpdate builder.
 func (ufpuo *UserFavoritePostUpdateOne) Where(ps ...predicate.UserFavoritePost) *UserFavoritePostUpdateOne {
 	ufpuo.mutation.Where(ps...)
@@ -465,7 +316,27 @@ func (ufpuo *UserFavoritePostUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ufpuo *UserFavoritePostUpdateOne) check() error {
+	if _, ok := ufpuo.mutation.UserID(); ufpuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserFavoritePost.user"`)
+	}
+	if _, ok := ufpuo.mutation.PostID(); ufpuo.mutation.PostCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserFavoritePost.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ufpuo *UserFavoritePostUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserFavoritePostUpdateOne {
+	ufpuo.modifiers = append(ufpuo.modifiers, modifiers...)
+	return ufpuo
+}
+
 func (ufpuo *UserFavoritePostUpdateOne) sqlSave(ctx context.Context) (_node *UserFavoritePost, err error) {
+	if err := ufpuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(userfavoritepost.Table, userfavoritepost.Columns, sqlgraph.NewFieldSpec(userfavoritepost.FieldID, field.TypeInt))
 	id, ok := ufpuo.mutation.ID()
 	if !ok {
@@ -491,35 +362,10 @@ func (ufpuo *UserFavoritePostUpdateOne) sqlSave(ctx context.Context) (_node *Use
 			}
 		}
 	}
-	if value, ok := ufpuo.mutation.UserID(); ok {
-		_spec.SetField(userfavoritepost.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := ufpuo.mutation.AddedUserID(); ok {
-		_spec.AddField(userfavoritepost.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := ufpuo.mutation.PostID(); ok {
-		_spec.SetField(userfavoritepost.FieldPostID, field.TypeInt, value)
-	}
-	if value, ok := ufpuo.mutation.AddedPostID(); ok {
-		_spec.AddField(userfavoritepost.FieldPostID, field.TypeInt, value)
-	}
 	if ufpuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   userfavoritepost.UserTable,
-			Columns: []string{userfavoritepost.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ufpuo.mutation.RemovedUserIDs(); len(nodes) > 0 && !ufpuo.mutation.UserCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritepost.UserTable,
 			Columns: []string{userfavoritepost.UserColumn},
 			Bidi:    false,
@@ -527,15 +373,12 @@ func (ufpuo *UserFavoritePostUpdateOne) sqlSave(ctx context.Context) (_node *Use
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ufpuo.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritepost.UserTable,
 			Columns: []string{userfavoritepost.UserColumn},
 			Bidi:    false,
@@ -550,21 +393,8 @@ func (ufpuo *UserFavoritePostUpdateOne) sqlSave(ctx context.Context) (_node *Use
 	}
 	if ufpuo.mutation.PostCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   userfavoritepost.PostTable,
-			Columns: []string{userfavoritepost.PostColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ufpuo.mutation.RemovedPostIDs(); len(nodes) > 0 && !ufpuo.mutation.PostCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritepost.PostTable,
 			Columns: []string{userfavoritepost.PostColumn},
 			Bidi:    false,
@@ -572,15 +402,12 @@ func (ufpuo *UserFavoritePostUpdateOne) sqlSave(ctx context.Context) (_node *Use
 				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ufpuo.mutation.PostIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritepost.PostTable,
 			Columns: []string{userfavoritepost.PostColumn},
 			Bidi:    false,
@@ -593,6 +420,7 @@ func (ufpuo *UserFavoritePostUpdateOne) sqlSave(ctx context.Context) (_node *Use
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(ufpuo.modifiers...)
 	_node = &UserFavoritePost{config: ufpuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -3,6 +3,8 @@
 package ent
 
 import (
+	"backend/internal/ent/question"
+	"backend/internal/ent/user"
 	"backend/internal/ent/userfavoritequestion"
 	"fmt"
 	"strings"
@@ -22,37 +24,39 @@ type UserFavoriteQuestion struct {
 	QuestionID int `json:"question_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserFavoriteQuestionQuery when eager-loading is set.
-	Edges                   UserFavoriteQuestionEdges `json:"edges"`
-	question_favorites      *int
-	user_favorite_questions *int
-	selectValues            sql.SelectValues
+	Edges        UserFavoriteQuestionEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // UserFavoriteQuestionEdges holds the relations/edges for other nodes in the graph.
 type UserFavoriteQuestionEdges struct {
 	// User holds the value of the user edge.
-	User []*User `json:"user,omitempty"`
+	User *User `json:"user,omitempty"`
 	// Question holds the value of the question edge.
-	Question []*Question `json:"question,omitempty"`
+	Question *Question `json:"question,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [2]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
-// was not loaded in eager-loading.
-func (e UserFavoriteQuestionEdges) UserOrErr() ([]*User, error) {
-	if e.loadedTypes[0] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserFavoriteQuestionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
 		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "user"}
 }
 
 // QuestionOrErr returns the Question value or an error if the edge
-// was not loaded in eager-loading.
-func (e UserFavoriteQuestionEdges) QuestionOrErr() ([]*Question, error) {
-	if e.loadedTypes[1] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserFavoriteQuestionEdges) QuestionOrErr() (*Question, error) {
+	if e.Question != nil {
 		return e.Question, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: question.Label}
 	}
 	return nil, &NotLoadedError{edge: "question"}
 }
@@ -64,10 +68,6 @@ func (*UserFavoriteQuestion) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case userfavoritequestion.FieldID, userfavoritequestion.FieldUserID, userfavoritequestion.FieldQuestionID:
 			values[i] = new(sql.NullInt64)
-		case userfavoritequestion.ForeignKeys[0]: // question_favorites
-			values[i] = new(sql.NullInt64)
-		case userfavoritequestion.ForeignKeys[1]: // user_favorite_questions
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -101,20 +101,6 @@ func (ufq *UserFavoriteQuestion) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				ufq.QuestionID = int(value.Int64)
 			}
-		case userfavoritequestion.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field question_favorites", value)
-			} else if value.Valid {
-				ufq.question_favorites = new(int)
-				*ufq.question_favorites = int(value.Int64)
-			}
-		case userfavoritequestion.ForeignKeys[1]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_favorite_questions", value)
-			} else if value.Valid {
-				ufq.user_favorite_questions = new(int)
-				*ufq.user_favorite_questions = int(value.Int64)
-			}
 		default:
 			ufq.selectValues.Set(columns[i], values[i])
 		}
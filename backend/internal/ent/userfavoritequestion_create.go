@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +20,7 @@ type UserFavoriteQuestionCreate struct {
 	config
 	mutation *UserFavoriteQuestionMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user_id" field.
@@ -33,34 +35,14 @@ func (ufqc *UserFavoriteQuestionCreate) SetQuestionID(i int) *UserFavoriteQuesti
 	return ufqc
 }
 
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (ufqc *UserFavoriteQuestionCreate) AddUserIDs(ids ...int) *UserFavoriteQuestionCreate {
-	ufqc.mutation.AddUserIDs(ids...)
-	return ufqc
-}
-
-// AddUser adds the "user" edges to the User entity.
-func (ufqc *UserFavoriteQuestionCreate) AddUser(u ...*User) *UserFavoriteQuestionCreate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ufqc.AddUserIDs(ids...)
-}
-
-// AddQuestionIDs adds the "question" edge to the Question entity by IDs.
-func (ufqc *UserFavoriteQuestionCreate) AddQuestionIDs(ids ...int) *UserFavoriteQuestionCreate {
-	ufqc.mutation.AddQuestionIDs(ids...)
-	return ufqc
+// SetUser sets the "user" edge to the User entity.
+func (ufqc *UserFavoriteQuestionCreate) SetUser(u *User) *UserFavoriteQuestionCreate {
+	return ufqc.SetUserID(u.ID)
 }
 
-// AddQuestion adds the "question" edges to the Question entity.
-func (ufqc *UserFavoriteQuestionCreate) AddQuestion(q ...*Question) *UserFavoriteQuestionCreate {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
-	}
-	return ufqc.AddQuestionIDs(ids...)
+// SetQuestion sets the "question" edge to the Question entity.
+func (ufqc *UserFavoriteQuestionCreate) SetQuestion(q *Question) *UserFavoriteQuestionCreate {
+	return ufqc.SetQuestionID(q.ID)
 }
 
 // Mutation returns the UserFavoriteQuestionMutation object of the builder.
@@ -103,6 +85,12 @@ func (ufqc *UserFavoriteQuestionCreate) check() error {
 	if _, ok := ufqc.mutation.QuestionID(); !ok {
 		return &ValidationError{Name: "question_id", err: errors.New(`ent: missing required field "UserFavoriteQuestion.question_id"`)}
 	}
+	if _, ok := ufqc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserFavoriteQuestion.user"`)}
+	}
+	if _, ok := ufqc.mutation.QuestionID(); !ok {
+		return &ValidationError{Name: "question", err: errors.New(`ent: missing required edge "UserFavoriteQuestion.question"`)}
+	}
 	return nil
 }
 
@@ -129,18 +117,11 @@ func (ufqc *UserFavoriteQuestionCreate) createSpec() (*UserFavoriteQuestion, *sq
 		_node = &UserFavoriteQuestion{config: ufqc.config}
 		_spec = sqlgraph.NewCreateSpec(userfavoritequestion.Table, sqlgraph.NewFieldSpec(userfavoritequestion.FieldID, field.TypeInt))
 	)
-	if value, ok := ufqc.mutation.UserID(); ok {
-		_spec.SetField(userfavoritequestion.FieldUserID, field.TypeInt, value)
-		_node.UserID = value
-	}
-	if value, ok := ufqc.mutation.QuestionID(); ok {
-		_spec.SetField(userfavoritequestion.FieldQuestionID, field.TypeInt, value)
-		_node.QuestionID = value
-	}
+	_spec.OnConflict = ufqc.conflict
 	if nodes := ufqc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritequestion.UserTable,
 			Columns: []string{userfavoritequestion.UserColumn},
 			Bidi:    false,
@@ -151,12 +132,13 @@ func (ufqc *UserFavoriteQuestionCreate) createSpec() (*UserFavoriteQuestion, *sq
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := ufqc.mutation.QuestionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritequestion.QuestionTable,
 			Columns: []string{userfavoritequestion.QuestionColumn},
 			Bidi:    false,
@@ -167,16 +149,192 @@ func (ufqc *UserFavoriteQuestionCreate) createSpec() (*UserFavoriteQuestion, *sq
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.QuestionID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserFavoriteQuestion.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserFavoriteQuestionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (ufqc *UserFavoriteQuestionCreate) OnConflict(opts ...sql.ConflictOption) *UserFavoriteQuestionUpsertOne {
+	ufqc.conflict = opts
+	return &UserFavoriteQuestionUpsertOne{
+		create: ufqc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserFavoriteQuestion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ufqc *UserFavoriteQuestionCreate) OnConflictColumns(columns ...string) *UserFavoriteQuestionUpsertOne {
+	ufqc.conflict = append(ufqc.conflict, sql.ConflictColumns(columns...))
+	return &UserFavoriteQuestionUpsertOne{
+		create: ufqc,
+	}
+}
+
+type (
+	// UserFavoriteQuestionUpsertOne is the builder for "upsert"-ing
+	//  one UserFavoriteQuestion node.
+	UserFavoriteQuestionUpsertOne struct {
+		create *UserFavoriteQuestionCreate
+	}
+
+	// UserFavoriteQuestionUpsert is the "OnConflict" setter.
+	UserFavoriteQuestionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *UserFavoriteQuestionUpsert) SetUserID(v int) *UserFavoriteQuestionUpsert {
+	u.Set(userfavoritequestion.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserFavoriteQuestionUpsert) UpdateUserID() *UserFavoriteQuestionUpsert {
+	u.SetExcluded(userfavoritequestion.FieldUserID)
+	return u
+}
+
+// SetQuestionID sets the "question_id" field.
+func (u *UserFavoriteQuestionUpsert) SetQuestionID(v int) *UserFavoriteQuestionUpsert {
+	u.Set(userfavoritequestion.FieldQuestionID, v)
+	return u
+}
+
+// UpdateQuestionID sets the "question_id" field to the value that was provided on create.
+func (u *UserFavoriteQuestionUpsert) UpdateQuestionID() *UserFavoriteQuestionUpsert {
+	u.SetExcluded(userfavoritequestion.FieldQuestionID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.UserFavoriteQuestion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserFavoriteQuestionUpsertOne) UpdateNewValues() *UserFavoriteQuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserFavoriteQuestion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserFavoriteQuestionUpsertOne) Ignore() *UserFavoriteQuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserFavoriteQuestionUpsertOne) DoNothing() *UserFavoriteQuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserFavoriteQuestionCreate.OnConflict
+// documentation for more info.
+func (u *UserFavoriteQuestionUpsertOne) Update(set func(*UserFavoriteQuestionUpsert)) *UserFavoriteQuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserFavoriteQuestionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserFavoriteQuestionUpsertOne) SetUserID(v int) *UserFavoriteQuestionUpsertOne {
+	return u.Update(func(s *UserFavoriteQuestionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserFavoriteQuestionUpsertOne) UpdateUserID() *UserFavoriteQuestionUpsertOne {
+	return u.Update(func(s *UserFavoriteQuestionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetQuestionID sets the "question_id" field.
+func (u *UserFavoriteQuestionUpsertOne) SetQuestionID(v int) *UserFavoriteQuestionUpsertOne {
+	return u.Update(func(s *UserFavoriteQuestionUpsert) {
+		s.SetQuestionID(v)
+	})
+}
+
+// UpdateQuestionID sets the "question_id" field to the value that was provided on create.
+func (u *UserFavoriteQuestionUpsertOne) UpdateQuestionID() *UserFavoriteQuestionUpsertOne {
+	return u.Update(func(s *UserFavoriteQuestionUpsert) {
+		s.UpdateQuestionID()
+	})
+}
+
+// Exec executes the query.
+func (u *UserFavoriteQuestionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserFavoriteQuestionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserFavoriteQuestionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserFavoriteQuestionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserFavoriteQuestionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // UserFavoriteQuestionCreateBulk is the builder for creating many UserFavoriteQuestion entities in bulk.
 type UserFavoriteQuestionCreateBulk struct {
 	config
 	err      error
 	builders []*UserFavoriteQuestionCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the UserFavoriteQuestion entities in the database.
@@ -205,6 +363,7 @@ func (ufqcb *UserFavoriteQuestionCreateBulk) Save(ctx context.Context) ([]*UserF
 					_, err = mutators[i+1].Mutate(root, ufqcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ufqcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ufqcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -258,3 +417,135 @@ func (ufqcb *UserFavoriteQuestionCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserFavoriteQuestion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserFavoriteQuestionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (ufqcb *UserFavoriteQuestionCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserFavoriteQuestionUpsertBulk {
+	ufqcb.conflict = opts
+	return &UserFavoriteQuestionUpsertBulk{
+		create: ufqcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserFavoriteQuestion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ufqcb *UserFavoriteQuestionCreateBulk) OnConflictColumns(columns ...string) *UserFavoriteQuestionUpsertBulk {
+	ufqcb.conflict = append(ufqcb.conflict, sql.ConflictColumns(columns...))
+	return &UserFavoriteQuestionUpsertBulk{
+		create: ufqcb,
+	}
+}
+
+// UserFavoriteQuestionUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserFavoriteQuestion nodes.
+type UserFavoriteQuestionUpsertBulk struct {
+	create *UserFavoriteQuestionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserFavoriteQuestion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserFavoriteQuestionUpsertBulk) UpdateNewValues() *UserFavoriteQuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserFavoriteQuestion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserFavoriteQuestionUpsertBulk) Ignore() *UserFavoriteQuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserFavoriteQuestionUpsertBulk) DoNothing() *UserFavoriteQuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserFavoriteQuestionCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserFavoriteQuestionUpsertBulk) Update(set func(*UserFavoriteQuestionUpsert)) *UserFavoriteQuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserFavoriteQuestionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserFavoriteQuestionUpsertBulk) SetUserID(v int) *UserFavoriteQuestionUpsertBulk {
+	return u.Update(func(s *UserFavoriteQuestionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserFavoriteQuestionUpsertBulk) UpdateUserID() *UserFavoriteQuestionUpsertBulk {
+	return u.Update(func(s *UserFavoriteQuestionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetQuestionID sets the "question_id" field.
+func (u *UserFavoriteQuestionUpsertBulk) SetQuestionID(v int) *UserFavoriteQuestionUpsertBulk {
+	return u.Update(func(s *UserFavoriteQuestionUpsert) {
+		s.SetQuestionID(v)
+	})
+}
+
+// UpdateQuestionID sets the "question_id" field to the value that was provided on create.
+func (u *UserFavoriteQuestionUpsertBulk) UpdateQuestionID() *UserFavoriteQuestionUpsertBulk {
+	return u.Update(func(s *UserFavoriteQuestionUpsert) {
+		s.UpdateQuestionID()
+	})
+}
+
+// Exec executes the query.
+func (u *UserFavoriteQuestionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserFavoriteQuestionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserFavoriteQuestionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserFavoriteQuestionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
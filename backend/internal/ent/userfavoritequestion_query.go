@@ -8,7 +8,6 @@ import (
 	"backend/internal/ent/user"
 	"backend/internal/ent/userfavoritequestion"
 	"context"
-	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -26,7 +25,7 @@ type UserFavoriteQuestionQuery struct {
 	predicates   []predicate.UserFavoriteQuestion
 	withUser     *UserQuery
 	withQuestion *QuestionQuery
-	withFKs      bool
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -77,7 +76,7 @@ func (ufqq *UserFavoriteQuestionQuery) QueryUser() *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(userfavoritequestion.Table, userfavoritequestion.FieldID, selector),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, userfavoritequestion.UserTable, userfavoritequestion.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, userfavoritequestion.UserTable, userfavoritequestion.UserColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(ufqq.driver.Dialect(), step)
 		return fromU, nil
@@ -99,7 +98,7 @@ func (ufqq *UserFavoriteQuestionQuery) QueryQuestion() *QuestionQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(userfavoritequestion.Table, userfavoritequestion.FieldID, selector),
 			sqlgraph.To(question.Table, question.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, userfavoritequestion.QuestionTable, userfavoritequestion.QuestionColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, userfavoritequestion.QuestionTable, userfavoritequestion.QuestionColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(ufqq.driver.Dialect(), step)
 		return fromU, nil
@@ -406,16 +405,12 @@ func (ufqq *UserFavoriteQuestionQuery) prepareQuery(ctx context.Context) error {
 func (ufqq *UserFavoriteQuestionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserFavoriteQuestion, error) {
 	var (
 		nodes       = []*UserFavoriteQuestion{}
-		withFKs     = ufqq.withFKs
 		_spec       = ufqq.querySpec()
 		loadedTypes = [2]bool{
 			ufqq.withUser != nil,
 			ufqq.withQuestion != nil,
 		}
 	)
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, userfavoritequestion.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*UserFavoriteQuestion).scanValues(nil, columns)
 	}
@@ -425,6 +420,9 @@ func (ufqq *UserFavoriteQuestionQuery) sqlAll(ctx context.Context, hooks ...quer
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(ufqq.modifiers) > 0 {
+		_spec.Modifiers = ufqq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -435,16 +433,14 @@ func (ufqq *UserFavoriteQuestionQuery) sqlAll(ctx context.Context, hooks ...quer
 		return nodes, nil
 	}
 	if query := ufqq.withUser; query != nil {
-		if err := ufqq.loadUser(ctx, query, nodes,
-			func(n *UserFavoriteQuestion) { n.Edges.User = []*User{} },
-			func(n *UserFavoriteQuestion, e *User) { n.Edges.User = append(n.Edges.User, e) }); err != nil {
+		if err := ufqq.loadUser(ctx, query, nodes, nil,
+			func(n *UserFavoriteQuestion, e *User) { n.Edges.User = e }); err != nil {
 			return nil, err
 		}
 	}
 	if query := ufqq.withQuestion; query != nil {
-		if err := ufqq.loadQuestion(ctx, query, nodes,
-			func(n *UserFavoriteQuestion) { n.Edges.Question = []*Question{} },
-			func(n *UserFavoriteQuestion, e *Question) { n.Edges.Question = append(n.Edges.Question, e) }); err != nil {
+		if err := ufqq.loadQuestion(ctx, query, nodes, nil,
+			func(n *UserFavoriteQuestion, e *Question) { n.Edges.Question = e }); err != nil {
 			return nil, err
 		}
 	}
@@ -452,70 +448,69 @@ func (ufqq *UserFavoriteQuestionQuery) sqlAll(ctx context.Context, hooks ...quer
 }
 
 func (ufqq *UserFavoriteQuestionQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*UserFavoriteQuestion, init func(*UserFavoriteQuestion), assign func(*UserFavoriteQuestion, *User)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*UserFavoriteQuestion)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*UserFavoriteQuestion)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
 	}
-	query.withFKs = true
-	query.Where(predicate.User(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(userfavoritequestion.UserColumn), fks...))
-	}))
+	query.Where(user.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_favorite_question_user
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_favorite_question_user" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_favorite_question_user" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
 func (ufqq *UserFavoriteQuestionQuery) loadQuestion(ctx context.Context, query *QuestionQuery, nodes []*UserFavoriteQuestion, init func(*UserFavoriteQuestion), assign func(*UserFavoriteQuestion, *Question)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*UserFavoriteQuestion)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*UserFavoriteQuestion)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].QuestionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	query.withFKs = true
-	query.Where(predicate.Question(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(userfavoritequestion.QuestionColumn), fks...))
-	}))
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(question.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_favorite_question_question
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_favorite_question_question" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_favorite_question_question" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "question_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
 
 func (ufqq *UserFavoriteQuestionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ufqq.querySpec()
+	if len(ufqq.modifiers) > 0 {
+		_spec.Modifiers = ufqq.modifiers
+	}
 	_spec.Node.Columns = ufqq.ctx.Fields
 	if len(ufqq.ctx.Fields) > 0 {
 		_spec.Unique = ufqq.ctx.Unique != nil && *ufqq.ctx.Unique
@@ -539,6 +534,12 @@ func (ufqq *UserFavoriteQuestionQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if ufqq.withUser != nil {
+			_spec.Node.AddColumnOnce(userfavoritequestion.FieldUserID)
+		}
+		if ufqq.withQuestion != nil {
+			_spec.Node.AddColumnOnce(userfavoritequestion.FieldQuestionID)
+		}
 	}
 	if ps := ufqq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -578,6 +579,9 @@ func (ufqq *UserFavoriteQuestionQuery) sqlQuery(ctx context.Context) *sql.Select
 	if ufqq.ctx.Unique != nil && *ufqq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range ufqq.modifiers {
+		m(selector)
+	}
 	for _, p := range ufqq.predicates {
 		p(selector)
 	}
@@ -595,6 +599,12 @@ func (ufqq *UserFavoriteQuestionQuery) sqlQuery(ctx context.Context) *sql.Select
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ufqq *UserFavoriteQuestionQuery) Modify(modifiers ...func(s *sql.Selector)) *UserFavoriteQuestionSelect {
+	ufqq.modifiers = append(ufqq.modifiers, modifiers...)
+	return ufqq.Select()
+}
+
 // UserFavoriteQuestionGroupBy is the group-by builder for UserFavoriteQuestion entities.
 type UserFavoriteQuestionGroupBy struct {
 	selector
@@ -684,3 +694,9 @@ func (ufqs *UserFavoriteQuestionSelect) sqlScan(ctx context.Context, root *UserF
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ufqs *UserFavoriteQuestionSelect) Modify(modifiers ...func(s *sql.Selector)) *UserFavoriteQuestionSelect {
+	ufqs.modifiers = append(ufqs.modifiers, modifiers...)
+	return ufqs
+}
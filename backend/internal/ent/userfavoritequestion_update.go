@@ -19,8 +19,9 @@ import (
 // UserFavoriteQuestionUpdate is the builder for updating UserFavoriteQuestion entities.
 type UserFavoriteQuestionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserFavoriteQuestionMutation
+	hooks     []Hook
+	mutation  *UserFavoriteQuestionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserFavoriteQuestionUpdate builder.
@@ -31,7 +32,6 @@ func (ufqu *UserFavoriteQuestionUpdate) Where(ps ...predicate.UserFavoriteQuesti
 
 // SetUserID sets the "user_id" field.
 func (ufqu *UserFavoriteQuestionUpdate) SetUserID(i int) *UserFavoriteQuestionUpdate {
-	ufqu.mutation.ResetUserID()
 	ufqu.mutation.SetUserID(i)
 	return ufqu
 }
@@ -44,15 +44,8 @@ func (ufqu *UserFavoriteQuestionUpdate) SetNillableUserID(i *int) *UserFavoriteQ
 	return ufqu
 }
 
-// AddUserID adds i to the "user_id" field.
-func (ufqu *UserFavoriteQuestionUpdate) AddUserID(i int) *UserFavoriteQuestionUpdate {
-	ufqu.mutation.AddUserID(i)
-	return ufqu
-}
-
 // SetQuestionID sets the "question_id" field.
 func (ufqu *UserFavoriteQuestionUpdate) SetQuestionID(i int) *UserFavoriteQuestionUpdate {
-	ufqu.mutation.ResetQuestionID()
 	ufqu.mutation.SetQuestionID(i)
 	return ufqu
 }
@@ -65,40 +58,14 @@ func (ufqu *UserFavoriteQuestionUpdate) SetNillableQuestionID(i *int) *UserFavor
 	return ufqu
 }
 
-// AddQuestionID adds i to the "question_id" field.
-func (ufqu *UserFavoriteQuestionUpdate) AddQuestionID(i int) *UserFavoriteQuestionUpdate {
-	ufqu.mutation.AddQuestionID(i)
-	return ufqu
-}
-
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (ufqu *UserFavoriteQuestionUpdate) AddUserIDs(ids ...int) *UserFavoriteQuestionUpdate {
-	ufqu.mutation.AddUserIDs(ids...)
-	return ufqu
-}
-
-// AddUser adds the "user" edges to the User entity.
-func (ufqu *UserFavoriteQuestionUpdate) AddUser(u ...*User) *UserFavoriteQuestionUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ufqu.AddUserIDs(ids...)
-}
-
-// AddQuestionIDs adds the "question" edge to the Question entity by IDs.
-func (ufqu *UserFavoriteQuestionUpdate) AddQuestionIDs(ids ...int) *UserFavoriteQuestionUpdate {
-	ufqu.mutation.AddQuestionIDs(ids...)
-	return ufqu
+// SetUser sets the "user" edge to the User entity.
+func (ufqu *UserFavoriteQuestionUpdate) SetUser(u *User) *UserFavoriteQuestionUpdate {
+	return ufqu.SetUserID(u.ID)
 }
 
-// AddQuestion adds the "question" edges to the Question entity.
-func (ufqu *UserFavoriteQuestionUpdate) AddQuestion(q ...*Question) *UserFavoriteQuestionUpdate {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
-	}
-	return ufqu.AddQuestionIDs(ids...)
+// SetQuestion sets the "question" edge to the Question entity.
+func (ufqu *UserFavoriteQuestionUpdate) SetQuestion(q *Question) *UserFavoriteQuestionUpdate {
+	return ufqu.SetQuestionID(q.ID)
 }
 
 // Mutation returns the UserFavoriteQuestionMutation object of the builder.
@@ -106,48 +73,18 @@ func (ufqu *UserFavoriteQuestionUpdate) Mutation() *UserFavoriteQuestionMutation
 	return ufqu.mutation
 }
 
-// ClearUser clears all "user" edges to the User entity.
+// ClearUser clears the "user" edge to the User entity.
 func (ufqu *UserFavoriteQuestionUpdate) ClearUser() *UserFavoriteQuestionUpdate {
 	ufqu.mutation.ClearUser()
 	return ufqu
 }
 
-// RemoveUserIDs removes the "user" edge to User entities by IDs.
-func (ufqu *UserFavoriteQuestionUpdate) RemoveUserIDs(ids ...int) *UserFavoriteQuestionUpdate {
-	ufqu.mutation.RemoveUserIDs(ids...)
-	return ufqu
-}
-
-// RemoveUser removes "user" edges to User entities.
-func (ufqu *UserFavoriteQuestionUpdate) RemoveUser(u ...*User) *UserFavoriteQuestionUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ufqu.RemoveUserIDs(ids...)
-}
-
-// ClearQuestion clears all "question" edges to the Question entity.
+// ClearQuestion clears the "question" edge to the Question entity.
 func (ufqu *UserFavoriteQuestionUpdate) ClearQuestion() *UserFavoriteQuestionUpdate {
 	ufqu.mutation.ClearQuestion()
 	return ufqu
 }
 
-// RemoveQuestionIDs removes the "question" edge to Question entities by IDs.
-func (ufqu *UserFavoriteQuestionUpdate) RemoveQuestionIDs(ids ...int) *UserFavoriteQuestionUpdate {
-	ufqu.mutation.RemoveQuestionIDs(ids...)
-	return ufqu
-}
-
-// RemoveQuestion removes "question" edges to Question entities.
-func (ufqu *UserFavoriteQuestionUpdate) RemoveQuestion(q ...*Question) *UserFavoriteQuestionUpdate {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
-	}
-	return ufqu.RemoveQuestionIDs(ids...)
-}
-
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ufqu *UserFavoriteQuestionUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, ufqu.sqlSave, ufqu.mutation, ufqu.hooks)
@@ -175,7 +112,27 @@ func (ufqu *UserFavoriteQuestionUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ufqu *UserFavoriteQuestionUpdate) check() error {
+	if _, ok := ufqu.mutation.UserID(); ufqu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserFavoriteQuestion.user"`)
+	}
+	if _, ok := ufqu.mutation.QuestionID(); ufqu.mutation.QuestionCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserFavoriteQuestion.question"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ufqu *UserFavoriteQuestionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserFavoriteQuestionUpdate {
+	ufqu.modifiers = append(ufqu.modifiers, modifiers...)
+	return ufqu
+}
+
 func (ufqu *UserFavoriteQuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ufqu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(userfavoritequestion.Table, userfavoritequestion.Columns, sqlgraph.NewFieldSpec(userfavoritequestion.FieldID, field.TypeInt))
 	if ps := ufqu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -184,35 +141,10 @@ func (ufqu *UserFavoriteQuestionUpdate) sqlSave(ctx context.Context) (n int, err
 			}
 		}
 	}
-	if value, ok := ufqu.mutation.UserID(); ok {
-		_spec.SetField(userfavoritequestion.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := ufqu.mutation.AddedUserID(); ok {
-		_spec.AddField(userfavoritequestion.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := ufqu.mutation.QuestionID(); ok {
-		_spec.SetField(userfavoritequestion.FieldQuestionID, field.TypeInt, value)
-	}
-	if value, ok := ufqu.mutation.AddedQuestionID(); ok {
-		_spec.AddField(userfavoritequestion.FieldQuestionID, field.TypeInt, value)
-	}
 	if ufqu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   userfavoritequestion.UserTable,
-			Columns: []string{userfavoritequestion.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ufqu.mutation.RemovedUserIDs(); len(nodes) > 0 && !ufqu.mutation.UserCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritequestion.UserTable,
 			Columns: []string{userfavoritequestion.UserColumn},
 			Bidi:    false,
@@ -220,15 +152,12 @@ func (ufqu *UserFavoriteQuestionUpdate) sqlSave(ctx context.Context) (n int, err
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ufqu.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritequestion.UserTable,
 			Columns: []string{userfavoritequestion.UserColumn},
 			Bidi:    false,
@@ -243,21 +172,8 @@ func (ufqu *UserFavoriteQuestionUpdate) sqlSave(ctx context.Context) (n int, err
 	}
 	if ufqu.mutation.QuestionCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   userfavoritequestion.QuestionTable,
-			Columns: []string{userfavoritequestion.QuestionColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ufqu.mutation.RemovedQuestionIDs(); len(nodes) > 0 && !ufqu.mutation.QuestionCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritequestion.QuestionTable,
 			Columns: []string{userfavoritequestion.QuestionColumn},
 			Bidi:    false,
@@ -265,15 +181,12 @@ func (ufqu *UserFavoriteQuestionUpdate) sqlSave(ctx context.Context) (n int, err
 				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ufqu.mutation.QuestionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritequestion.QuestionTable,
 			Columns: []string{userfavoritequestion.QuestionColumn},
 			Bidi:    false,
@@ -286,6 +199,7 @@ func (ufqu *UserFavoriteQuestionUpdate) sqlSave(ctx context.Context) (n int, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(ufqu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, ufqu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{userfavoritequestion.Label}
@@ -301,14 +215,14 @@ func (ufqu *UserFavoriteQuestionUpdate) sqlSave(ctx context.Context) (n int, err
 // UserFavoriteQuestionUpdateOne is the builder for updating a single UserFavoriteQuestion entity.
 type UserFavoriteQuestionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserFavoriteQuestionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *UserFavoriteQuestionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
 func (ufquo *UserFavoriteQuestionUpdateOne) SetUserID(i int) *UserFavoriteQuestionUpdateOne {
-	ufquo.mutation.ResetUserID()
 	ufquo.mutation.SetUserID(i)
 	return ufquo
 }
@@ -321,15 +235,8 @@ func (ufquo *UserFavoriteQuestionUpdateOne) SetNillableUserID(i *int) *UserFavor
 	return ufquo
 }
 
-// AddUserID adds i to the "user_id" field.
-func (ufquo *UserFavoriteQuestionUpdateOne) AddUserID(i int) *UserFavoriteQuestionUpdateOne {
-	ufquo.mutation.AddUserID(i)
-	return ufquo
-}
-
 // SetQuestionID sets the "question_id" field.
 func (ufquo *UserFavoriteQuestionUpdateOne) SetQuestionID(i int) *UserFavoriteQuestionUpdateOne {
-	ufquo.mutation.ResetQuestionID()
 	ufquo.mutation.SetQuestionID(i)
 	return ufquo
 }
@@ -342,40 +249,14 @@ func (ufquo *UserFavoriteQuestionUpdateOne) SetNillableQuestionID(i *int) *UserF
 	return ufquo
 }
 
-// AddQuestionID adds i to the "question_id" field.
-func (ufquo *UserFavoriteQuestionUpdateOne) AddQuestionID(i int) *UserFavoriteQuestionUpdateOne {
-	ufquo.mutation.AddQuestionID(i)
-	return ufquo
-}
-
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (ufquo *UserFavoriteQuestionUpdateOne) AddUserIDs(ids ...int) *UserFavoriteQuestionUpdateOne {
-	ufquo.mutation.AddUserIDs(ids...)
-	return ufquo
-}
-
-// AddUser adds the "user" edges to the User entity.
-func (ufquo *UserFavoriteQuestionUpdateOne) AddUser(u ...*User) *UserFavoriteQuestionUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ufquo.AddUserIDs(ids...)
-}
-
-// AddQuestionIDs adds the "question" edge to the Question entity by IDs.
-func (ufquo *UserFavoriteQuestionUpdateOne) AddQuestionIDs(ids ...int) *UserFavoriteQuestionUpdateOne {
-	ufquo.mutation.AddQuestionIDs(ids...)
-	return ufquo
+// SetUser sets the "user" edge to the User entity.
+func (ufquo *UserFavoriteQuestionUpdateOne) SetUser(u *User) *UserFavoriteQuestionUpdateOne {
+	return ufquo.SetUserID(u.ID)
 }
 
-// AddQuestion adds the "question" edges to the Question entity.
-func (ufquo *UserFavoriteQuestionUpdateOne) AddQuestion(q ...*Question) *UserFavoriteQuestionUpdateOne {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
-	}
-	return ufquo.AddQuestionIDs(ids...)
+// SetQuestion sets the "question" edge to the Question entity.
+func (ufquo *UserFavoriteQuestionUpdateOne) SetQuestion(q *Question) *UserFavoriteQuestionUpdateOne {
+	return ufquo.SetQuestionID(q.ID)
 }
 
 // Mutation returns the UserFavoriteQuestionMutation object of the builder.
@@ -383,48 +264,18 @@ func (ufquo *UserFavoriteQuestionUpdateOne) Mutation() *UserFavoriteQuestionMuta
 	return ufquo.mutation
 }
 
-// ClearUser clears all "user" edges to the User entity.
+// ClearUser clears the "user" edge to the User entity.
 func (ufquo *UserFavoriteQuestionUpdateOne) ClearUser() *UserFavoriteQuestionUpdateOne {
 	ufquo.mutation.ClearUser()
 	return ufquo
 }
 
-// RemoveUserIDs removes the "user" edge to User entities by IDs.
-func (ufquo *UserFavoriteQuestionUpdateOne) RemoveUserIDs(ids ...int) *UserFavoriteQuestionUpdateOne {
-	ufquo.mutation.RemoveUserIDs(ids...)
-	return ufquo
-}
-
-// RemoveUser removes "user" edges to User entities.
-func (ufquo *UserFavoriteQuestionUpdateOne) RemoveUser(u ...*User) *UserFavoriteQuestionUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ufquo.RemoveUserIDs(ids...)
-}
-
-// ClearQuestion clears all "question" edges to the Question entity.
+// ClearQuestion clears the "question" edge to the Question entity.
 func (ufquo *UserFavoriteQuestionUpdateOne) ClearQuestion() *UserFavoriteQuestionUpdateOne {
 	ufquo.mutation.ClearQuestion()
 	return ufquo
 }
 
-// RemoveQuestionIDs removes the "question" edge to Question entities by IDs.
-func (ufquo *UserFavoriteQuestionUpdateOne) RemoveQuestionIDs(ids ...int) *UserFavoriteQuestionUpdateOne {
-	ufquo.mutation.RemoveQuestionIDs(ids...)
-	return ufquo
-}
-
-// RemoveQuestion removes "question" edges to Question entities.
-func (ufquo *UserFavoriteQuestionUpdateOne) RemoveQuestion(q ...*Question) *UserFavoriteQuestionUpdateOne {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
-	}
-	return ufquo.RemoveQuestionIDs(ids...)
-}
-
 // Where appends a list predicates to the UserFavoriteQuestionUpdate builder.
 func (ufquo *UserFavoriteQuestionUpdateOne) Where(ps ...predicate.UserFavoriteQuestion) *UserFavoriteQuestionUpdateOne {
 	ufquo.mutation.Where(ps...)
@@ -465,7 +316,27 @@ func (ufquo *UserFavoriteQuestionUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ufquo *UserFavoriteQuestionUpdateOne) check() error {
+	if _, ok := ufquo.mutation.UserID(); ufquo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserFavoriteQuestion.user"`)
+	}
+	if _, ok := ufquo.mutation.QuestionID(); ufquo.mutation.QuestionCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserFavoriteQuestion.question"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ufquo *UserFavoriteQuestionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserFavoriteQuestionUpdateOne {
+	ufquo.modifiers = append(ufquo.modifiers, modifiers...)
+	return ufquo
+}
+
 func (ufquo *UserFavoriteQuestionUpdateOne) sqlSave(ctx context.Context) (_node *UserFavoriteQuestion, err error) {
+	if err := ufquo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(userfavoritequestion.Table, userfavoritequestion.Columns, sqlgraph.NewFieldSpec(userfavoritequestion.FieldID, field.TypeInt))
 	id, ok := ufquo.mutation.ID()
 	if !ok {
@@ -491,35 +362,10 @@ func (ufquo *UserFavoriteQuestionUpdateOne) sqlSave(ctx context.Context) (_node
 			}
 		}
 	}
-	if value, ok := ufquo.mutation.UserID(); ok {
-		_spec.SetField(userfavoritequestion.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := ufquo.mutation.AddedUserID(); ok {
-		_spec.AddField(userfavoritequestion.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := ufquo.mutation.QuestionID(); ok {
-		_spec.SetField(userfavoritequestion.FieldQuestionID, field.TypeInt, value)
-	}
-	if value, ok := ufquo.mutation.AddedQuestionID(); ok {
-		_spec.AddField(userfavoritequestion.FieldQuestionID, field.TypeInt, value)
-	}
 	if ufquo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   userfavoritequestion.UserTable,
-			Columns: []string{userfavoritequestion.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ufquo.mutation.RemovedUserIDs(); len(nodes) > 0 && !ufquo.mutation.UserCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritequestion.UserTable,
 			Columns: []string{userfavoritequestion.UserColumn},
 			Bidi:    false,
@@ -527,15 +373,12 @@ func (ufquo *UserFavoriteQuestionUpdateOne) sqlSave(ctx context.Context) (_node
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ufquo.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritequestion.UserTable,
 			Columns: []string{userfavoritequestion.UserColumn},
 			Bidi:    false,
@@ -550,21 +393,8 @@ func (ufquo *UserFavoriteQuestionUpdateOne) sqlSave(ctx context.Context) (_node
 	}
 	if ufquo.mutation.QuestionCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   userfavoritequestion.QuestionTable,
-			Columns: []string{userfavoritequestion.QuestionColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ufquo.mutation.RemovedQuestionIDs(); len(nodes) > 0 && !ufquo.mutation.QuestionCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritequestion.QuestionTable,
 			Columns: []string{userfavoritequestion.QuestionColumn},
 			Bidi:    false,
@@ -572,15 +402,12 @@ func (ufquo *UserFavoriteQuestionUpdateOne) sqlSave(ctx context.Context) (_node
 				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ufquo.mutation.QuestionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userfavoritequestion.QuestionTable,
 			Columns: []string{userfavoritequestion.QuestionColumn},
 			Bidi:    false,
@@ -593,6 +420,7 @@ func (ufquo *UserFavoriteQuestionUpdateOne) sqlSave(ctx context.Context) (_node
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(ufquo.modifiers...)
 	_node = &UserFavoriteQuestion{config: ufquo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
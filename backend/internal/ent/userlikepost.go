@@ -3,6 +3,8 @@
 package ent
 
 import (
+	"backend/internal/ent/post"
+	"backend/internal/ent/user"
 	"backend/internal/ent/userlikepost"
 	"fmt"
 	"strings"
@@ -22,37 +24,39 @@ type UserLikePost struct {
 	PostID int `json:"post_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserLikePostQuery when eager-loading is set.
-	Edges           UserLikePostEdges `json:"edges"`
-	post_likes      *int
-	user_like_posts *int
-	selectValues    sql.SelectValues
+	Edges        UserLikePostEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // UserLikePostEdges holds the relations/edges for other nodes in the graph.
 type UserLikePostEdges struct {
 	// User holds the value of the user edge.
-	User []*User `json:"user,omitempty"`
+	User *User `json:"user,omitempty"`
 	// Post holds the value of the post edge.
-	Post []*Post `json:"post,omitempty"`
+	Post *Post `json:"post,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [2]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
-// was not loaded in eager-loading.
-func (e UserLikePostEdges) UserOrErr() ([]*User, error) {
-	if e.loadedTypes[0] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserLikePostEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
 		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "user"}
 }
 
 // PostOrErr returns the Post value or an error if the edge
-// was not loaded in eager-loading.
-func (e UserLikePostEdges) PostOrErr() ([]*Post, error) {
-	if e.loadedTypes[1] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserLikePostEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
 		return e.Post, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: post.Label}
 	}
 	return nil, &NotLoadedError{edge: "post"}
 }
@@ -64,10 +68,6 @@ func (*UserLikePost) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case userlikepost.FieldID, userlikepost.FieldUserID, userlikepost.FieldPostID:
 			values[i] = new(sql.NullInt64)
-		case userlikepost.ForeignKeys[0]: // post_likes
-			values[i] = new(sql.NullInt64)
-		case userlikepost.ForeignKeys[1]: // user_like_posts
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -101,20 +101,6 @@ func (ulp *UserLikePost) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ulp.PostID = int(value.Int64)
 			}
-		case userlikepost.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field post_likes", value)
-			} else if value.Valid {
-				ulp.post_likes = new(int)
-				*ulp.post_likes = int(value.Int64)
-			}
-		case userlikepost.ForeignKeys[1]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_like_posts", value)
-			} else if value.Valid {
-				ulp.user_like_posts = new(int)
-				*ulp.user_like_posts = int(value.Int64)
-			}
 		default:
 			ulp.selectValues.Set(columns[i], values[i])
 		}
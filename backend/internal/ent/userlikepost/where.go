@@ -84,26 +84,6 @@ func UserIDNotIn(vs ...int) predicate.UserLikePost {
 	return predicate.UserLikePost(sql.FieldNotIn(FieldUserID, vs...))
 }
 
-// UserIDGT applies the GT predicate on the "user_id" field.
-func UserIDGT(v int) predicate.UserLikePost {
-	return predicate.UserLikePost(sql.FieldGT(FieldUserID, v))
-}
-
-// UserIDGTE applies the GTE predicate on the "user_id" field.
-func UserIDGTE(v int) predicate.UserLikePost {
-	return predicate.UserLikePost(sql.FieldGTE(FieldUserID, v))
-}
-
-// UserIDLT applies the LT predicate on the "user_id" field.
-func UserIDLT(v int) predicate.UserLikePost {
-	return predicate.UserLikePost(sql.FieldLT(FieldUserID, v))
-}
-
-// UserIDLTE applies the LTE predicate on the "user_id" field.
-func UserIDLTE(v int) predicate.UserLikePost {
-	return predicate.UserLikePost(sql.FieldLTE(FieldUserID, v))
-}
-
 // PostIDEQ applies the EQ predicate on the "post_id" field.
 func PostIDEQ(v int) predicate.UserLikePost {
 	return predicate.UserLikePost(sql.FieldEQ(FieldPostID, v))
@@ -124,32 +104,12 @@ func PostIDNotIn(vs ...int) predicate.UserLikePost {
 	return predicate.UserLikePost(sql.FieldNotIn(FieldPostID, vs...))
 }
 
-// PostIDGT applies the GT predicate on the "post_id" field.
-func PostIDGT(v int) predicate.UserLikePost {
-	return predicate.UserLikePost(sql.FieldGT(FieldPostID, v))
-}
-
-// PostIDGTE applies the GTE predicate on the "post_id" field.
-func PostIDGTE(v int) predicate.UserLikePost {
-	return predicate.UserLikePost(sql.FieldGTE(FieldPostID, v))
-}
-
-// PostIDLT applies the LT predicate on the "post_id" field.
-func PostIDLT(v int) predicate.UserLikePost {
-	return predicate.UserLikePost(sql.FieldLT(FieldPostID, v))
-}
-
-// PostIDLTE applies the LTE predicate on the "post_id" field.
-func PostIDLTE(v int) predicate.UserLikePost {
-	return predicate.UserLikePost(sql.FieldLTE(FieldPostID, v))
-}
-
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.UserLikePost {
 	return predicate.UserLikePost(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, UserTable, UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -172,7 +132,7 @@ func HasPost() predicate.UserLikePost {
 	return predicate.UserLikePost(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, PostTable, PostColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
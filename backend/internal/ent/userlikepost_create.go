@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +20,7 @@ type UserLikePostCreate struct {
 	config
 	mutation *UserLikePostMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user_id" field.
@@ -33,34 +35,14 @@ func (ulpc *UserLikePostCreate) SetPostID(i int) *UserLikePostCreate {
 	return ulpc
 }
 
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (ulpc *UserLikePostCreate) AddUserIDs(ids ...int) *UserLikePostCreate {
-	ulpc.mutation.AddUserIDs(ids...)
-	return ulpc
-}
-
-// AddUser adds the "user" edges to the User entity.
-func (ulpc *UserLikePostCreate) AddUser(u ...*User) *UserLikePostCreate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ulpc.AddUserIDs(ids...)
-}
-
-// AddPostIDs adds the "post" edge to the Post entity by IDs.
-func (ulpc *UserLikePostCreate) AddPostIDs(ids ...int) *UserLikePostCreate {
-	ulpc.mutation.AddPostIDs(ids...)
-	return ulpc
+// SetUser sets the "user" edge to the User entity.
+func (ulpc *UserLikePostCreate) SetUser(u *User) *UserLikePostCreate {
+	return ulpc.SetUserID(u.ID)
 }
 
-// AddPost adds the "post" edges to the Post entity.
-func (ulpc *UserLikePostCreate) AddPost(p ...*Post) *UserLikePostCreate {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
-	}
-	return ulpc.AddPostIDs(ids...)
+// SetPost sets the "post" edge to the Post entity.
+func (ulpc *UserLikePostCreate) SetPost(p *Post) *UserLikePostCreate {
+	return ulpc.SetPostID(p.ID)
 }
 
 // Mutation returns the UserLikePostMutation object of the builder.
@@ -103,6 +85,12 @@ func (ulpc *UserLikePostCreate) check() error {
 	if _, ok := ulpc.mutation.PostID(); !ok {
 		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "UserLikePost.post_id"`)}
 	}
+	if _, ok := ulpc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserLikePost.user"`)}
+	}
+	if _, ok := ulpc.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "UserLikePost.post"`)}
+	}
 	return nil
 }
 
@@ -129,18 +117,11 @@ func (ulpc *UserLikePostCreate) createSpec() (*UserLikePost, *sqlgraph.CreateSpe
 		_node = &UserLikePost{config: ulpc.config}
 		_spec = sqlgraph.NewCreateSpec(userlikepost.Table, sqlgraph.NewFieldSpec(userlikepost.FieldID, field.TypeInt))
 	)
-	if value, ok := ulpc.mutation.UserID(); ok {
-		_spec.SetField(userlikepost.FieldUserID, field.TypeInt, value)
-		_node.UserID = value
-	}
-	if value, ok := ulpc.mutation.PostID(); ok {
-		_spec.SetField(userlikepost.FieldPostID, field.TypeInt, value)
-		_node.PostID = value
-	}
+	_spec.OnConflict = ulpc.conflict
 	if nodes := ulpc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikepost.UserTable,
 			Columns: []string{userlikepost.UserColumn},
 			Bidi:    false,
@@ -151,12 +132,13 @@ func (ulpc *UserLikePostCreate) createSpec() (*UserLikePost, *sqlgraph.CreateSpe
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := ulpc.mutation.PostIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikepost.PostTable,
 			Columns: []string{userlikepost.PostColumn},
 			Bidi:    false,
@@ -167,16 +149,192 @@ func (ulpc *UserLikePostCreate) createSpec() (*UserLikePost, *sqlgraph.CreateSpe
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.PostID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserLikePost.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserLikePostUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (ulpc *UserLikePostCreate) OnConflict(opts ...sql.ConflictOption) *UserLikePostUpsertOne {
+	ulpc.conflict = opts
+	return &UserLikePostUpsertOne{
+		create: ulpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserLikePost.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ulpc *UserLikePostCreate) OnConflictColumns(columns ...string) *UserLikePostUpsertOne {
+	ulpc.conflict = append(ulpc.conflict, sql.ConflictColumns(columns...))
+	return &UserLikePostUpsertOne{
+		create: ulpc,
+	}
+}
+
+type (
+	// UserLikePostUpsertOne is the builder for "upsert"-ing
+	//  one UserLikePost node.
+	UserLikePostUpsertOne struct {
+		create *UserLikePostCreate
+	}
+
+	// UserLikePostUpsert is the "OnConflict" setter.
+	UserLikePostUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *UserLikePostUpsert) SetUserID(v int) *UserLikePostUpsert {
+	u.Set(userlikepost.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserLikePostUpsert) UpdateUserID() *UserLikePostUpsert {
+	u.SetExcluded(userlikepost.FieldUserID)
+	return u
+}
+
+// SetPostID sets the "post_id" field.
+func (u *UserLikePostUpsert) SetPostID(v int) *UserLikePostUpsert {
+	u.Set(userlikepost.FieldPostID, v)
+	return u
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *UserLikePostUpsert) UpdatePostID() *UserLikePostUpsert {
+	u.SetExcluded(userlikepost.FieldPostID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.UserLikePost.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserLikePostUpsertOne) UpdateNewValues() *UserLikePostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserLikePost.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserLikePostUpsertOne) Ignore() *UserLikePostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserLikePostUpsertOne) DoNothing() *UserLikePostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserLikePostCreate.OnConflict
+// documentation for more info.
+func (u *UserLikePostUpsertOne) Update(set func(*UserLikePostUpsert)) *UserLikePostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserLikePostUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserLikePostUpsertOne) SetUserID(v int) *UserLikePostUpsertOne {
+	return u.Update(func(s *UserLikePostUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserLikePostUpsertOne) UpdateUserID() *UserLikePostUpsertOne {
+	return u.Update(func(s *UserLikePostUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPostID sets the "post_id" field.
+func (u *UserLikePostUpsertOne) SetPostID(v int) *UserLikePostUpsertOne {
+	return u.Update(func(s *UserLikePostUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *UserLikePostUpsertOne) UpdatePostID() *UserLikePostUpsertOne {
+	return u.Update(func(s *UserLikePostUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// Exec executes the query.
+func (u *UserLikePostUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserLikePostCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserLikePostUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserLikePostUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserLikePostUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // UserLikePostCreateBulk is the builder for creating many UserLikePost entities in bulk.
 type UserLikePostCreateBulk struct {
 	config
 	err      error
 	builders []*UserLikePostCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the UserLikePost entities in the database.
@@ -205,6 +363,7 @@ func (ulpcb *UserLikePostCreateBulk) Save(ctx context.Context) ([]*UserLikePost,
 					_, err = mutators[i+1].Mutate(root, ulpcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ulpcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ulpcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -258,3 +417,135 @@ func (ulpcb *UserLikePostCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserLikePost.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserLikePostUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (ulpcb *UserLikePostCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserLikePostUpsertBulk {
+	ulpcb.conflict = opts
+	return &UserLikePostUpsertBulk{
+		create: ulpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserLikePost.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ulpcb *UserLikePostCreateBulk) OnConflictColumns(columns ...string) *UserLikePostUpsertBulk {
+	ulpcb.conflict = append(ulpcb.conflict, sql.ConflictColumns(columns...))
+	return &UserLikePostUpsertBulk{
+		create: ulpcb,
+	}
+}
+
+// UserLikePostUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserLikePost nodes.
+type UserLikePostUpsertBulk struct {
+	create *UserLikePostCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserLikePost.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserLikePostUpsertBulk) UpdateNewValues() *UserLikePostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserLikePost.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserLikePostUpsertBulk) Ignore() *UserLikePostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserLikePostUpsertBulk) DoNothing() *UserLikePostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserLikePostCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserLikePostUpsertBulk) Update(set func(*UserLikePostUpsert)) *UserLikePostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserLikePostUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserLikePostUpsertBulk) SetUserID(v int) *UserLikePostUpsertBulk {
+	return u.Update(func(s *UserLikePostUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserLikePostUpsertBulk) UpdateUserID() *UserLikePostUpsertBulk {
+	return u.Update(func(s *UserLikePostUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPostID sets the "post_id" field.
+func (u *UserLikePostUpsertBulk) SetPostID(v int) *UserLikePostUpsertBulk {
+	return u.Update(func(s *UserLikePostUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *UserLikePostUpsertBulk) UpdatePostID() *UserLikePostUpsertBulk {
+	return u.Update(func(s *UserLikePostUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// Exec executes the query.
+func (u *UserLikePostUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserLikePostCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserLikePostCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserLikePostUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"backend/internal/ent/user"
 	"backend/internal/ent/userlikepost"
 	"context"
-	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -26,7 +25,7 @@ type UserLikePostQuery struct {
 	predicates []predicate.UserLikePost
 	withUser   *UserQuery
 	withPost   *PostQuery
-	withFKs    bool
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -77,7 +76,7 @@ func (ulpq *UserLikePostQuery) QueryUser() *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(userlikepost.Table, userlikepost.FieldID, selector),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, userlikepost.UserTable, userlikepost.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, userlikepost.UserTable, userlikepost.UserColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(ulpq.driver.Dialect(), step)
 		return fromU, nil
@@ -99,7 +98,7 @@ func (ulpq *UserLikePostQuery) QueryPost() *PostQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(userlikepost.Table, userlikepost.FieldID, selector),
 			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, userlikepost.PostTable, userlikepost.PostColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, userlikepost.PostTable, userlikepost.PostColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(ulpq.driver.Dialect(), step)
 		return fromU, nil
@@ -406,16 +405,12 @@ func (ulpq *UserLikePostQuery) prepareQuery(ctx context.Context) error {
 func (ulpq *UserLikePostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserLikePost, error) {
 	var (
 		nodes       = []*UserLikePost{}
-		withFKs     = ulpq.withFKs
 		_spec       = ulpq.querySpec()
 		loadedTypes = [2]bool{
 			ulpq.withUser != nil,
 			ulpq.withPost != nil,
 		}
 	)
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, userlikepost.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*UserLikePost).scanValues(nil, columns)
 	}
@@ -425,6 +420,9 @@ func (ulpq *UserLikePostQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(ulpq.modifiers) > 0 {
+		_spec.Modifiers = ulpq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -435,16 +433,14 @@ func (ulpq *UserLikePostQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		return nodes, nil
 	}
 	if query := ulpq.withUser; query != nil {
-		if err := ulpq.loadUser(ctx, query, nodes,
-			func(n *UserLikePost) { n.Edges.User = []*User{} },
-			func(n *UserLikePost, e *User) { n.Edges.User = append(n.Edges.User, e) }); err != nil {
+		if err := ulpq.loadUser(ctx, query, nodes, nil,
+			func(n *UserLikePost, e *User) { n.Edges.User = e }); err != nil {
 			return nil, err
 		}
 	}
 	if query := ulpq.withPost; query != nil {
-		if err := ulpq.loadPost(ctx, query, nodes,
-			func(n *UserLikePost) { n.Edges.Post = []*Post{} },
-			func(n *UserLikePost, e *Post) { n.Edges.Post = append(n.Edges.Post, e) }); err != nil {
+		if err := ulpq.loadPost(ctx, query, nodes, nil,
+			func(n *UserLikePost, e *Post) { n.Edges.Post = e }); err != nil {
 			return nil, err
 		}
 	}
@@ -452,70 +448,69 @@ func (ulpq *UserLikePostQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 }
 
 func (ulpq *UserLikePostQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*UserLikePost, init func(*UserLikePost), assign func(*UserLikePost, *User)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*UserLikePost)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*UserLikePost)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
 	}
-	query.withFKs = true
-	query.Where(predicate.User(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(userlikepost.UserColumn), fks...))
-	}))
+	query.Where(user.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_like_post_user
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_like_post_user" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_like_post_user" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
 func (ulpq *UserLikePostQuery) loadPost(ctx context.Context, query *PostQuery, nodes []*UserLikePost, init func(*UserLikePost), assign func(*UserLikePost, *Post)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*UserLikePost)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*UserLikePost)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].PostID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	query.withFKs = true
-	query.Where(predicate.Post(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(userlikepost.PostColumn), fks...))
-	}))
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(post.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_like_post_post
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_like_post_post" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_like_post_post" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "post_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
 
 func (ulpq *UserLikePostQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ulpq.querySpec()
+	if len(ulpq.modifiers) > 0 {
+		_spec.Modifiers = ulpq.modifiers
+	}
 	_spec.Node.Columns = ulpq.ctx.Fields
 	if len(ulpq.ctx.Fields) > 0 {
 		_spec.Unique = ulpq.ctx.Unique != nil && *ulpq.ctx.Unique
@@ -539,6 +534,12 @@ func (ulpq *UserLikePostQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if ulpq.withUser != nil {
+			_spec.Node.AddColumnOnce(userlikepost.FieldUserID)
+		}
+		if ulpq.withPost != nil {
+			_spec.Node.AddColumnOnce(userlikepost.FieldPostID)
+		}
 	}
 	if ps := ulpq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -578,6 +579,9 @@ func (ulpq *UserLikePostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if ulpq.ctx.Unique != nil && *ulpq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range ulpq.modifiers {
+		m(selector)
+	}
 	for _, p := range ulpq.predicates {
 		p(selector)
 	}
@@ -595,6 +599,12 @@ func (ulpq *UserLikePostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ulpq *UserLikePostQuery) Modify(modifiers ...func(s *sql.Selector)) *UserLikePostSelect {
+	ulpq.modifiers = append(ulpq.modifiers, modifiers...)
+	return ulpq.Select()
+}
+
 // UserLikePostGroupBy is the group-by builder for UserLikePost entities.
 type UserLikePostGroupBy struct {
 	selector
@@ -684,3 +694,9 @@ func (ulps *UserLikePostSelect) sqlScan(ctx context.Context, root *UserLikePostQ
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ulps *UserLikePostSelect) Modify(modifiers ...func(s *sql.Selector)) *UserLikePostSelect {
+	ulps.modifiers = append(ulps.modifiers, modifiers...)
+	return ulps
+}
@@ -19,8 +19,9 @@ import (
 // UserLikePostUpdate is the builder for updating UserLikePost entities.
 type UserLikePostUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserLikePostMutation
+	hooks     []Hook
+	mutation  *UserLikePostMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserLikePostUpdate builder.
@@ -31,7 +32,6 @@ func (ulpu *UserLikePostUpdate) Where(ps ...predicate.UserLikePost) *UserLikePos
 
 // SetUserID sets the "user_id" field.
 func (ulpu *UserLikePostUpdate) SetUserID(i int) *UserLikePostUpdate {
-	ulpu.mutation.ResetUserID()
 	ulpu.mutation.SetUserID(i)
 	return ulpu
 }
@@ -44,15 +44,8 @@ func (ulpu *UserLikePostUpdate) SetNillableUserID(i *int) *UserLikePostUpdate {
 	return ulpu
 }
 
-// AddUserID adds i to the "user_id" field.
-func (ulpu *UserLikePostUpdate) AddUserID(i int) *UserLikePostUpdate {
-	ulpu.mutation.AddUserID(i)
-	return ulpu
-}
-
 // SetPostID sets the "post_id" field.
 func (ulpu *UserLikePostUpdate) SetPostID(i int) *UserLikePostUpdate {
-	ulpu.mutation.ResetPostID()
 	ulpu.mutation.SetPostID(i)
 	return ulpu
 }
@@ -65,40 +58,14 @@ func (ulpu *UserLikePostUpdate) SetNillablePostID(i *int) *UserLikePostUpdate {
 	return ulpu
 }
 
-// AddPostID adds i to the "post_id" field.
-func (ulpu *UserLikePostUpdate) AddPostID(i int) *UserLikePostUpdate {
-	ulpu.mutation.AddPostID(i)
-	return ulpu
-}
-
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (ulpu *UserLikePostUpdate) AddUserIDs(ids ...int) *UserLikePostUpdate {
-	ulpu.mutation.AddUserIDs(ids...)
-	return ulpu
-}
-
-// AddUser adds the "user" edges to the User entity.
-func (ulpu *UserLikePostUpdate) AddUser(u ...*User) *UserLikePostUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ulpu.AddUserIDs(ids...)
-}
-
-// AddPostIDs adds the "post" edge to the Post entity by IDs.
-func (ulpu *UserLikePostUpdate) AddPostIDs(ids ...int) *UserLikePostUpdate {
-	ulpu.mutation.AddPostIDs(ids...)
-	return ulpu
+// SetUser sets the "user" edge to the User entity.
+func (ulpu *UserLikePostUpdate) SetUser(u *User) *UserLikePostUpdate {
+	return ulpu.SetUserID(u.ID)
 }
 
-// AddPost adds the "post" edges to the Post entity.
-func (ulpu *UserLikePostUpdate) AddPost(p ...*Post) *UserLikePostUpdate {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
-	}
-	return ulpu.AddPostIDs(ids...)
+// SetPost sets the "post" edge to the Post entity.
+func (ulpu *UserLikePostUpdate) SetPost(p *Post) *UserLikePostUpdate {
+	return ulpu.SetPostID(p.ID)
 }
 
 // Mutation returns the UserLikePostMutation object of the builder.
@@ -106,48 +73,18 @@ func (ulpu *UserLikePostUpdate) Mutation() *UserLikePostMutation {
 	return ulpu.mutation
 }
 
-// ClearUser clears all "user" edges to the User entity.
+// ClearUser clears the "user" edge to the User entity.
 func (ulpu *UserLikePostUpdate) ClearUser() *UserLikePostUpdate {
 	ulpu.mutation.ClearUser()
 	return ulpu
 }
 
-// RemoveUserIDs removes the "user" edge to User entities by IDs.
-func (ulpu *UserLikePostUpdate) RemoveUserIDs(ids ...int) *UserLikePostUpdate {
-	ulpu.mutation.RemoveUserIDs(ids...)
-	return ulpu
-}
-
-// RemoveUser removes "user" edges to User entities.
-func (ulpu *UserLikePostUpdate) RemoveUser(u ...*User) *UserLikePostUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ulpu.RemoveUserIDs(ids...)
-}
-
-// ClearPost clears all "post" edges to the Post entity.
+// ClearPost clears the "post" edge to the Post entity.
 func (ulpu *UserLikePostUpdate) ClearPost() *UserLikePostUpdate {
 	ulpu.mutation.ClearPost()
 	return ulpu
 }
 
-// RemovePostIDs removes the "post" edge to Post entities by IDs.
-func (ulpu *UserLikePostUpdate) RemovePostIDs(ids ...int) *UserLikePostUpdate {
-	ulpu.mutation.RemovePostIDs(ids...)
-	return ulpu
-}
-
-// RemovePost removes "post" edges to Post entities.
-func (ulpu *UserLikePostUpdate) RemovePost(p ...*Post) *UserLikePostUpdate {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
-	}
-	return ulpu.RemovePostIDs(ids...)
-}
-
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ulpu *UserLikePostUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, ulpu.sqlSave, ulpu.mutation, ulpu.hooks)
@@ -175,7 +112,27 @@ func (ulpu *UserLikePostUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ulpu *UserLikePostUpdate) check() error {
+	if _, ok := ulpu.mutation.UserID(); ulpu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserLikePost.user"`)
+	}
+	if _, ok := ulpu.mutation.PostID(); ulpu.mutation.PostCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserLikePost.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ulpu *UserLikePostUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserLikePostUpdate {
+	ulpu.modifiers = append(ulpu.modifiers, modifiers...)
+	return ulpu
+}
+
 func (ulpu *UserLikePostUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ulpu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(userlikepost.Table, userlikepost.Columns, sqlgraph.NewFieldSpec(userlikepost.FieldID, field.TypeInt))
 	if ps := ulpu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -184,35 +141,10 @@ func (ulpu *UserLikePostUpdate) sqlSave(ctx context.Context) (n int, err error)
 			}
 		}
 	}
-	if value, ok := ulpu.mutation.UserID(); ok {
-		_spec.SetField(userlikepost.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := ulpu.mutation.AddedUserID(); ok {
-		_spec.AddField(userlikepost.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := ulpu.mutation.PostID(); ok {
-		_spec.SetField(userlikepost.FieldPostID, field.TypeInt, value)
-	}
-	if value, ok := ulpu.mutation.AddedPostID(); ok {
-		_spec.AddField(userlikepost.FieldPostID, field.TypeInt, value)
-	}
 	if ulpu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   userlikepost.UserTable,
-			Columns: []string{userlikepost.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ulpu.mutation.RemovedUserIDs(); len(nodes) > 0 && !ulpu.mutation.UserCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikepost.UserTable,
 			Columns: []string{userlikepost.UserColumn},
 			Bidi:    false,
@@ -220,15 +152,12 @@ func (ulpu *UserLikePostUpdate) sqlSave(ctx context.Context) (n int, err error)
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ulpu.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikepost.UserTable,
 			Columns: []string{userlikepost.UserColumn},
 			Bidi:    false,
@@ -243,21 +172,8 @@ func (ulpu *UserLikePostUpdate) sqlSave(ctx context.Context) (n int, err error)
 	}
 	if ulpu.mutation.PostCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   userlikepost.PostTable,
-			Columns: []string{userlikepost.PostColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ulpu.mutation.RemovedPostIDs(); len(nodes) > 0 && !ulpu.mutation.PostCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikepost.PostTable,
 			Columns: []string{userlikepost.PostColumn},
 			Bidi:    false,
@@ -265,15 +181,12 @@ func (ulpu *UserLikePostUpdate) sqlSave(ctx context.Context) (n int, err error)
 				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ulpu.mutation.PostIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikepost.PostTable,
 			Columns: []string{userlikepost.PostColumn},
 			Bidi:    false,
@@ -286,6 +199,7 @@ func (ulpu *UserLikePostUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(ulpu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, ulpu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{userlikepost.Label}
@@ -301,14 +215,14 @@ func (ulpu *UserLikePostUpdate) sqlSave(ctx context.Context) (n int, err error)
 // UserLikePostUpdateOne is the builder for updating a single UserLikePost entity.
 type UserLikePostUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserLikePostMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *UserLikePostMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
 func (ulpuo *UserLikePostUpdateOne) SetUserID(i int) *UserLikePostUpdateOne {
-	ulpuo.mutation.ResetUserID()
 	ulpuo.mutation.SetUserID(i)
 	return ulpuo
 }
@@ -321,15 +235,8 @@ func (ulpuo *UserLikePostUpdateOne) SetNillableUserID(i *int) *UserLikePostUpdat
 	return ulpuo
 }
 
-// AddUserID adds i to the "user_id" field.
-func (ulpuo *UserLikePostUpdateOne) AddUserID(i int) *UserLikePostUpdateOne {
-	ulpuo.mutation.AddUserID(i)
-	return ulpuo
-}
-
 // SetPostID sets the "post_id" field.
 func (ulpuo *UserLikePostUpdateOne) SetPostID(i int) *UserLikePostUpdateOne {
-	ulpuo.mutation.ResetPostID()
 	ulpuo.mutation.SetPostID(i)
 	return ulpuo
 }
@@ -342,40 +249,14 @@ func (ulpuo *UserLikePostUpdateOne) SetNillablePostID(i *int) *UserLikePostUpdat
 	return ulpuo
 }
 
-// AddPostID adds i to the "post_id" field.
-func (ulpuo *UserLikePostUpdateOne) AddPostID(i int) *UserLikePostUpdateOne {
-	ulpuo.mutation.AddPostID(i)
-	return ulpuo
-}
-
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (ulpuo *UserLikePostUpdateOne) AddUserIDs(ids ...int) *UserLikePostUpdateOne {
-	ulpuo.mutation.AddUserIDs(ids...)
-	return ulpuo
-}
-
-// AddUser adds the "user" edges to the User entity.
-func (ulpuo *UserLikePostUpdateOne) AddUser(u ...*User) *UserLikePostUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ulpuo.AddUserIDs(ids...)
-}
-
-// AddPostIDs adds the "post" edge to the Post entity by IDs.
-func (ulpuo *UserLikePostUpdateOne) AddPostIDs(ids ...int) *UserLikePostUpdateOne {
-	ulpuo.mutation.AddPostIDs(ids...)
-	return ulpuo
+// SetUser sets the "user" edge to the User entity.
+func (ulpuo *UserLikePostUpdateOne) SetUser(u *User) *UserLikePostUpdateOne {
+	return ulpuo.SetUserID(u.ID)
 }
 
-// AddPost adds the "post" edges to the Post entity.
-func (ulpuo *UserLikePostUpdateOne) AddPost(p ...*Post) *UserLikePostUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
-	}
-	return ulpuo.AddPostIDs(ids...)
+// SetPost sets the "post" edge to the Post entity.
+func (ulpuo *UserLikePostUpdateOne) SetPost(p *Post) *UserLikePostUpdateOne {
+	return ulpuo.SetPostID(p.ID)
 }
 
 // Mutation returns the UserLikePostMutation object of the builder.
@@ -383,48 +264,18 @@ func (ulpuo *UserLikePostUpdateOne) Mutation() *UserLikePostMutation {
 	return ulpuo.mutation
 }
 
-// ClearUser clears all "user" edges to the User entity.
+// ClearUser clears the "user" edge to the User entity.
 func (ulpuo *UserLikePostUpdateOne) ClearUser() *UserLikePostUpdateOne {
 	ulpuo.mutation.ClearUser()
 	return ulpuo
 }
 
-// RemoveUserIDs removes the "user" edge to User entities by IDs.
-func (ulpuo *UserLikePostUpdateOne) RemoveUserIDs(ids ...int) *UserLikePostUpdateOne {
-	ulpuo.mutation.RemoveUserIDs(ids...)
-	return ulpuo
-}
-
-// RemoveUser removes "user" edges to User entities.
-func (ulpuo *UserLikePostUpdateOne) RemoveUser(u ...*User) *UserLikePostUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ulpuo.RemoveUserIDs(ids...)
-}
-
-// ClearPost clears all "post" edges to the Post entity.
+// ClearPost clears the "post" edge to the Post entity.
 func (ulpuo *UserLikePostUpdateOne) ClearPost() *UserLikePostUpdateOne {
 	ulpuo.mutation.ClearPost()
 	return ulpuo
 }
 
-// RemovePostIDs removes the "post" edge to Post entities by IDs.
-func (ulpuo *UserLikePostUpdateOne) RemovePostIDs(ids ...int) *UserLikePostUpdateOne {
-	ulpuo.mutation.RemovePostIDs(ids...)
-	return ulpuo
-}
-
-// RemovePost removes "post" edges to Post entities.
-func (ulpuo *UserLikePostUpdateOne) RemovePost(p ...*Post) *UserLikePostUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
-	}
-	return ulpuo.RemovePostIDs(ids...)
-}
-
 // Where appends a list predicates to the UserLikePostUpdate builder.
 func (ulpuo *UserLikePostUpdateOne) Where(ps ...predicate.UserLikePost) *UserLikePostUpdateOne {
 	ulpuo.mutation.Where(ps...)
@@ -465,7 +316,27 @@ func (ulpuo *UserLikePostUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ulpuo *UserLikePostUpdateOne) check() error {
+	if _, ok := ulpuo.mutation.UserID(); ulpuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserLikePost.user"`)
+	}
+	if _, ok := ulpuo.mutation.PostID(); ulpuo.mutation.PostCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserLikePost.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ulpuo *UserLikePostUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserLikePostUpdateOne {
+	ulpuo.modifiers = append(ulpuo.modifiers, modifiers...)
+	return ulpuo
+}
+
 func (ulpuo *UserLikePostUpdateOne) sqlSave(ctx context.Context) (_node *UserLikePost, err error) {
+	if err := ulpuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(userlikepost.Table, userlikepost.Columns, sqlgraph.NewFieldSpec(userlikepost.FieldID, field.TypeInt))
 	id, ok := ulpuo.mutation.ID()
 	if !ok {
@@ -491,35 +362,10 @@ func (ulpuo *UserLikePostUpdateOne) sqlSave(ctx context.Context) (_node *UserLik
 			}
 		}
 	}
-	if value, ok := ulpuo.mutation.UserID(); ok {
-		_spec.SetField(userlikepost.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := ulpuo.mutation.AddedUserID(); ok {
-		_spec.AddField(userlikepost.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := ulpuo.mutation.PostID(); ok {
-		_spec.SetField(userlikepost.FieldPostID, field.TypeInt, value)
-	}
-	if value, ok := ulpuo.mutation.AddedPostID(); ok {
-		_spec.AddField(userlikepost.FieldPostID, field.TypeInt, value)
-	}
 	if ulpuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   userlikepost.UserTable,
-			Columns: []string{userlikepost.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ulpuo.mutation.RemovedUserIDs(); len(nodes) > 0 && !ulpuo.mutation.UserCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikepost.UserTable,
 			Columns: []string{userlikepost.UserColumn},
 			Bidi:    false,
@@ -527,15 +373,12 @@ func (ulpuo *UserLikePostUpdateOne) sqlSave(ctx context.Context) (_node *UserLik
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ulpuo.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikepost.UserTable,
 			Columns: []string{userlikepost.UserColumn},
 			Bidi:    false,
@@ -550,21 +393,8 @@ func (ulpuo *UserLikePostUpdateOne) sqlSave(ctx context.Context) (_node *UserLik
 	}
 	if ulpuo.mutation.PostCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   userlikepost.PostTable,
-			Columns: []string{userlikepost.PostColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ulpuo.mutation.RemovedPostIDs(); len(nodes) > 0 && !ulpuo.mutation.PostCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikepost.PostTable,
 			Columns: []string{userlikepost.PostColumn},
 			Bidi:    false,
@@ -572,15 +402,12 @@ func (ulpuo *UserLikePostUpdateOne) sqlSave(ctx context.Context) (_node *UserLik
 				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ulpuo.mutation.PostIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikepost.PostTable,
 			Columns: []string{userlikepost.PostColumn},
 			Bidi:    false,
@@ -593,6 +420,7 @@ func (ulpuo *UserLikePostUpdateOne) sqlSave(ctx context.Context) (_node *UserLik
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(ulpuo.modifiers...)
 	_node = &UserLikePost{config: ulpuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -3,6 +3,8 @@
 package ent
 
 import (
+	"backend/internal/ent/question"
+	"backend/internal/ent/user"
 	"backend/internal/ent/userlikequestion"
 	"fmt"
 	"strings"
@@ -22,37 +24,39 @@ type UserLikeQuestion struct {
 	QuestionID int `json:"question_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserLikeQuestionQuery when eager-loading is set.
-	Edges               UserLikeQuestionEdges `json:"edges"`
-	question_likes      *int
-	user_like_questions *int
-	selectValues        sql.SelectValues
+	Edges        UserLikeQuestionEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // UserLikeQuestionEdges holds the relations/edges for other nodes in the graph.
 type UserLikeQuestionEdges struct {
 	// User holds the value of the user edge.
-	User []*User `json:"user,omitempty"`
+	User *User `json:"user,omitempty"`
 	// Question holds the value of the question edge.
-	Question []*Question `json:"question,omitempty"`
+	Question *Question `json:"question,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [2]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
-// was not loaded in eager-loading.
-func (e UserLikeQuestionEdges) UserOrErr() ([]*User, error) {
-	if e.loadedTypes[0] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserLikeQuestionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
 		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "user"}
 }
 
 // QuestionOrErr returns the Question value or an error if the edge
-// was not loaded in eager-loading.
-func (e UserLikeQuestionEdges) QuestionOrErr() ([]*Question, error) {
-	if e.loadedTypes[1] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserLikeQuestionEdges) QuestionOrErr() (*Question, error) {
+	if e.Question != nil {
 		return e.Question, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: question.Label}
 	}
 	return nil, &NotLoadedError{edge: "question"}
 }
@@ -64,10 +68,6 @@ func (*UserLikeQuestion) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case userlikequestion.FieldID, userlikequestion.FieldUserID, userlikequestion.FieldQuestionID:
 			values[i] = new(sql.NullInt64)
-		case userlikequestion.ForeignKeys[0]: // question_likes
-			values[i] = new(sql.NullInt64)
-		case userlikequestion.ForeignKeys[1]: // user_like_questions
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -101,20 +101,6 @@ func (ulq *UserLikeQuestion) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				ulq.QuestionID = int(value.Int64)
 			}
-		case userlikequestion.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field question_likes", value)
-			} else if value.Valid {
-				ulq.question_likes = new(int)
-				*ulq.question_likes = int(value.Int64)
-			}
-		case userlikequestion.ForeignKeys[1]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_like_questions", value)
-			} else if value.Valid {
-				ulq.user_like_questions = new(int)
-				*ulq.user_like_questions = int(value.Int64)
-			}
 		default:
 			ulq.selectValues.Set(columns[i], values[i])
 		}
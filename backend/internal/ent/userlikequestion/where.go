@@ -84,26 +84,6 @@ func UserIDNotIn(vs ...int) predicate.UserLikeQuestion {
 	return predicate.UserLikeQuestion(sql.FieldNotIn(FieldUserID, vs...))
 }
 
-// UserIDGT applies the GT predicate on the "user_id" field.
-func UserIDGT(v int) predicate.UserLikeQuestion {
-	return predicate.UserLikeQuestion(sql.FieldGT(FieldUserID, v))
-}
-
-// UserIDGTE applies the GTE predicate on the "user_id" field.
-func UserIDGTE(v int) predicate.UserLikeQuestion {
-	return predicate.UserLikeQuestion(sql.FieldGTE(FieldUserID, v))
-}
-
-// UserIDLT applies the LT predicate on the "user_id" field.
-func UserIDLT(v int) predicate.UserLikeQuestion {
-	return predicate.UserLikeQuestion(sql.FieldLT(FieldUserID, v))
-}
-
-// UserIDLTE applies the LTE predicate on the "user_id" field.
-func UserIDLTE(v int) predicate.UserLikeQuestion {
-	return predicate.UserLikeQuestion(sql.FieldLTE(FieldUserID, v))
-}
-
 // QuestionIDEQ applies the EQ predicate on the "question_id" field.
 func QuestionIDEQ(v int) predicate.UserLikeQuestion {
 	return predicate.UserLikeQuestion(sql.FieldEQ(FieldQuestionID, v))
@@ -124,32 +104,12 @@ func QuestionIDNotIn(vs ...int) predicate.UserLikeQuestion {
 	return predicate.UserLikeQuestion(sql.FieldNotIn(FieldQuestionID, vs...))
 }
 
-// QuestionIDGT applies the GT predicate on the "question_id" field.
-func QuestionIDGT(v int) predicate.UserLikeQuestion {
-	return predicate.UserLikeQuestion(sql.FieldGT(FieldQuestionID, v))
-}
-
-// QuestionIDGTE applies the GTE predicate on the "question_id" field.
-func QuestionIDGTE(v int) predicate.UserLikeQuestion {
-	return predicate.UserLikeQuestion(sql.FieldGTE(FieldQuestionID, v))
-}
-
-// QuestionIDLT applies the LT predicate on the "question_id" field.
-func QuestionIDLT(v int) predicate.UserLikeQuestion {
-	return predicate.UserLikeQuestion(sql.FieldLT(FieldQuestionID, v))
-}
-
-// QuestionIDLTE applies the LTE predicate on the "question_id" field.
-func QuestionIDLTE(v int) predicate.UserLikeQuestion {
-	return predicate.UserLikeQuestion(sql.FieldLTE(FieldQuestionID, v))
-}
-
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.UserLikeQuestion {
 	return predicate.UserLikeQuestion(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, UserTable, UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -172,7 +132,7 @@ func HasQuestion() predicate.UserLikeQuestion {
 	return predicate.UserLikeQuestion(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, QuestionTable, QuestionColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, QuestionTable, QuestionColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
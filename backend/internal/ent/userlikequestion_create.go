@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +20,7 @@ type UserLikeQuestionCreate struct {
 	config
 	mutation *UserLikeQuestionMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user_id" field.
@@ -33,34 +35,14 @@ func (ulqc *UserLikeQuestionCreate) SetQuestionID(i int) *UserLikeQuestionCreate
 	return ulqc
 }
 
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (ulqc *UserLikeQuestionCreate) AddUserIDs(ids ...int) *UserLikeQuestionCreate {
-	ulqc.mutation.AddUserIDs(ids...)
-	return ulqc
-}
-
-// AddUser adds the "user" edges to the User entity.
-func (ulqc *UserLikeQuestionCreate) AddUser(u ...*User) *UserLikeQuestionCreate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ulqc.AddUserIDs(ids...)
-}
-
-// AddQuestionIDs adds the "question" edge to the Question entity by IDs.
-func (ulqc *UserLikeQuestionCreate) AddQuestionIDs(ids ...int) *UserLikeQuestionCreate {
-	ulqc.mutation.AddQuestionIDs(ids...)
-	return ulqc
+// SetUser sets the "user" edge to the User entity.
+func (ulqc *UserLikeQuestionCreate) SetUser(u *User) *UserLikeQuestionCreate {
+	return ulqc.SetUserID(u.ID)
 }
 
-// AddQuestion adds the "question" edges to the Question entity.
-func (ulqc *UserLikeQuestionCreate) AddQuestion(q ...*Question) *UserLikeQuestionCreate {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
-	}
-	return ulqc.AddQuestionIDs(ids...)
+// SetQuestion sets the "question" edge to the Question entity.
+func (ulqc *UserLikeQuestionCreate) SetQuestion(q *Question) *UserLikeQuestionCreate {
+	return ulqc.SetQuestionID(q.ID)
 }
 
 // Mutation returns the UserLikeQuestionMutation object of the builder.
@@ -103,6 +85,12 @@ func (ulqc *UserLikeQuestionCreate) check() error {
 	if _, ok := ulqc.mutation.QuestionID(); !ok {
 		return &ValidationError{Name: "question_id", err: errors.New(`ent: missing required field "UserLikeQuestion.question_id"`)}
 	}
+	if _, ok := ulqc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserLikeQuestion.user"`)}
+	}
+	if _, ok := ulqc.mutation.QuestionID(); !ok {
+		return &ValidationError{Name: "question", err: errors.New(`ent: missing required edge "UserLikeQuestion.question"`)}
+	}
 	return nil
 }
 
@@ -129,18 +117,11 @@ func (ulqc *UserLikeQuestionCreate) createSpec() (*UserLikeQuestion, *sqlgraph.C
 		_node = &UserLikeQuestion{config: ulqc.config}
 		_spec = sqlgraph.NewCreateSpec(userlikequestion.Table, sqlgraph.NewFieldSpec(userlikequestion.FieldID, field.TypeInt))
 	)
-	if value, ok := ulqc.mutation.UserID(); ok {
-		_spec.SetField(userlikequestion.FieldUserID, field.TypeInt, value)
-		_node.UserID = value
-	}
-	if value, ok := ulqc.mutation.QuestionID(); ok {
-		_spec.SetField(userlikequestion.FieldQuestionID, field.TypeInt, value)
-		_node.QuestionID = value
-	}
+	_spec.OnConflict = ulqc.conflict
 	if nodes := ulqc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikequestion.UserTable,
 			Columns: []string{userlikequestion.UserColumn},
 			Bidi:    false,
@@ -151,12 +132,13 @@ func (ulqc *UserLikeQuestionCreate) createSpec() (*UserLikeQuestion, *sqlgraph.C
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := ulqc.mutation.QuestionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikequestion.QuestionTable,
 			Columns: []string{userlikequestion.QuestionColumn},
 			Bidi:    false,
@@ -167,16 +149,192 @@ func (ulqc *UserLikeQuestionCreate) createSpec() (*UserLikeQuestion, *sqlgraph.C
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.QuestionID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserLikeQuestion.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserLikeQuestionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (ulqc *UserLikeQuestionCreate) OnConflict(opts ...sql.ConflictOption) *UserLikeQuestionUpsertOne {
+	ulqc.conflict = opts
+	return &UserLikeQuestionUpsertOne{
+		create: ulqc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserLikeQuestion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ulqc *UserLikeQuestionCreate) OnConflictColumns(columns ...string) *UserLikeQuestionUpsertOne {
+	ulqc.conflict = append(ulqc.conflict, sql.ConflictColumns(columns...))
+	return &UserLikeQuestionUpsertOne{
+		create: ulqc,
+	}
+}
+
+type (
+	// UserLikeQuestionUpsertOne is the builder for "upsert"-ing
+	//  one UserLikeQuestion node.
+	UserLikeQuestionUpsertOne struct {
+		create *UserLikeQuestionCreate
+	}
+
+	// UserLikeQuestionUpsert is the "OnConflict" setter.
+	UserLikeQuestionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *UserLikeQuestionUpsert) SetUserID(v int) *UserLikeQuestionUpsert {
+	u.Set(userlikequestion.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserLikeQuestionUpsert) UpdateUserID() *UserLikeQuestionUpsert {
+	u.SetExcluded(userlikequestion.FieldUserID)
+	return u
+}
+
+// SetQuestionID sets the "question_id" field.
+func (u *UserLikeQuestionUpsert) SetQuestionID(v int) *UserLikeQuestionUpsert {
+	u.Set(userlikequestion.FieldQuestionID, v)
+	return u
+}
+
+// UpdateQuestionID sets the "question_id" field to the value that was provided on create.
+func (u *UserLikeQuestionUpsert) UpdateQuestionID() *UserLikeQuestionUpsert {
+	u.SetExcluded(userlikequestion.FieldQuestionID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.UserLikeQuestion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserLikeQuestionUpsertOne) UpdateNewValues() *UserLikeQuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserLikeQuestion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserLikeQuestionUpsertOne) Ignore() *UserLikeQuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserLikeQuestionUpsertOne) DoNothing() *UserLikeQuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserLikeQuestionCreate.OnConflict
+// documentation for more info.
+func (u *UserLikeQuestionUpsertOne) Update(set func(*UserLikeQuestionUpsert)) *UserLikeQuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserLikeQuestionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserLikeQuestionUpsertOne) SetUserID(v int) *UserLikeQuestionUpsertOne {
+	return u.Update(func(s *UserLikeQuestionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserLikeQuestionUpsertOne) UpdateUserID() *UserLikeQuestionUpsertOne {
+	return u.Update(func(s *UserLikeQuestionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetQuestionID sets the "question_id" field.
+func (u *UserLikeQuestionUpsertOne) SetQuestionID(v int) *UserLikeQuestionUpsertOne {
+	return u.Update(func(s *UserLikeQuestionUpsert) {
+		s.SetQuestionID(v)
+	})
+}
+
+// UpdateQuestionID sets the "question_id" field to the value that was provided on create.
+func (u *UserLikeQuestionUpsertOne) UpdateQuestionID() *UserLikeQuestionUpsertOne {
+	return u.Update(func(s *UserLikeQuestionUpsert) {
+		s.UpdateQuestionID()
+	})
+}
+
+// Exec executes the query.
+func (u *UserLikeQuestionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserLikeQuestionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserLikeQuestionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserLikeQuestionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserLikeQuestionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // UserLikeQuestionCreateBulk is the builder for creating many UserLikeQuestion entities in bulk.
 type UserLikeQuestionCreateBulk struct {
 	config
 	err      error
 	builders []*UserLikeQuestionCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the UserLikeQuestion entities in the database.
@@ -205,6 +363,7 @@ func (ulqcb *UserLikeQuestionCreateBulk) Save(ctx context.Context) ([]*UserLikeQ
 					_, err = mutators[i+1].Mutate(root, ulqcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ulqcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ulqcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -258,3 +417,135 @@ func (ulqcb *UserLikeQuestionCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserLikeQuestion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserLikeQuestionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (ulqcb *UserLikeQuestionCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserLikeQuestionUpsertBulk {
+	ulqcb.conflict = opts
+	return &UserLikeQuestionUpsertBulk{
+		create: ulqcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserLikeQuestion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ulqcb *UserLikeQuestionCreateBulk) OnConflictColumns(columns ...string) *UserLikeQuestionUpsertBulk {
+	ulqcb.conflict = append(ulqcb.conflict, sql.ConflictColumns(columns...))
+	return &UserLikeQuestionUpsertBulk{
+		create: ulqcb,
+	}
+}
+
+// UserLikeQuestionUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserLikeQuestion nodes.
+type UserLikeQuestionUpsertBulk struct {
+	create *UserLikeQuestionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserLikeQuestion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserLikeQuestionUpsertBulk) UpdateNewValues() *UserLikeQuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserLikeQuestion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserLikeQuestionUpsertBulk) Ignore() *UserLikeQuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserLikeQuestionUpsertBulk) DoNothing() *UserLikeQuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserLikeQuestionCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserLikeQuestionUpsertBulk) Update(set func(*UserLikeQuestionUpsert)) *UserLikeQuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserLikeQuestionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserLikeQuestionUpsertBulk) SetUserID(v int) *UserLikeQuestionUpsertBulk {
+	return u.Update(func(s *UserLikeQuestionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserLikeQuestionUpsertBulk) UpdateUserID() *UserLikeQuestionUpsertBulk {
+	return u.Update(func(s *UserLikeQuestionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetQuestionID sets the "question_id" field.
+func (u *UserLikeQuestionUpsertBulk) SetQuestionID(v int) *UserLikeQuestionUpsertBulk {
+	return u.Update(func(s *UserLikeQuestionUpsert) {
+		s.SetQuestionID(v)
+	})
+}
+
+// UpdateQuestionID sets the "question_id" field to the value that was provided on create.
+func (u *UserLikeQuestionUpsertBulk) UpdateQuestionID() *UserLikeQuestionUpsertBulk {
+	return u.Update(func(s *UserLikeQuestionUpsert) {
+		s.UpdateQuestionID()
+	})
+}
+
+// Exec executes the query.
+func (u *UserLikeQuestionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserLikeQuestionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserLikeQuestionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserLikeQuestionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
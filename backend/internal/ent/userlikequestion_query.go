@@ -8,7 +8,6 @@ import (
 	"backend/internal/ent/user"
 	"backend/internal/ent/userlikequestion"
 	"context"
-	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -26,7 +25,7 @@ type UserLikeQuestionQuery struct {
 	predicates   []predicate.UserLikeQuestion
 	withUser     *UserQuery
 	withQuestion *QuestionQuery
-	withFKs      bool
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -77,7 +76,7 @@ func (ulqq *UserLikeQuestionQuery) QueryUser() *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(userlikequestion.Table, userlikequestion.FieldID, selector),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, userlikequestion.UserTable, userlikequestion.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, userlikequestion.UserTable, userlikequestion.UserColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(ulqq.driver.Dialect(), step)
 		return fromU, nil
@@ -99,7 +98,7 @@ func (ulqq *UserLikeQuestionQuery) QueryQuestion() *QuestionQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(userlikequestion.Table, userlikequestion.FieldID, selector),
 			sqlgraph.To(question.Table, question.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, userlikequestion.QuestionTable, userlikequestion.QuestionColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, userlikequestion.QuestionTable, userlikequestion.QuestionColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(ulqq.driver.Dialect(), step)
 		return fromU, nil
@@ -406,16 +405,12 @@ func (ulqq *UserLikeQuestionQuery) prepareQuery(ctx context.Context) error {
 func (ulqq *UserLikeQuestionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserLikeQuestion, error) {
 	var (
 		nodes       = []*UserLikeQuestion{}
-		withFKs     = ulqq.withFKs
 		_spec       = ulqq.querySpec()
 		loadedTypes = [2]bool{
 			ulqq.withUser != nil,
 			ulqq.withQuestion != nil,
 		}
 	)
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, userlikequestion.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*UserLikeQuestion).scanValues(nil, columns)
 	}
@@ -425,6 +420,9 @@ func (ulqq *UserLikeQuestionQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(ulqq.modifiers) > 0 {
+		_spec.Modifiers = ulqq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -435,16 +433,14 @@ func (ulqq *UserLikeQuestionQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 		return nodes, nil
 	}
 	if query := ulqq.withUser; query != nil {
-		if err := ulqq.loadUser(ctx, query, nodes,
-			func(n *UserLikeQuestion) { n.Edges.User = []*User{} },
-			func(n *UserLikeQuestion, e *User) { n.Edges.User = append(n.Edges.User, e) }); err != nil {
+		if err := ulqq.loadUser(ctx, query, nodes, nil,
+			func(n *UserLikeQuestion, e *User) { n.Edges.User = e }); err != nil {
 			return nil, err
 		}
 	}
 	if query := ulqq.withQuestion; query != nil {
-		if err := ulqq.loadQuestion(ctx, query, nodes,
-			func(n *UserLikeQuestion) { n.Edges.Question = []*Question{} },
-			func(n *UserLikeQuestion, e *Question) { n.Edges.Question = append(n.Edges.Question, e) }); err != nil {
+		if err := ulqq.loadQuestion(ctx, query, nodes, nil,
+			func(n *UserLikeQuestion, e *Question) { n.Edges.Question = e }); err != nil {
 			return nil, err
 		}
 	}
@@ -452,70 +448,69 @@ func (ulqq *UserLikeQuestionQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 }
 
 func (ulqq *UserLikeQuestionQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*UserLikeQuestion, init func(*UserLikeQuestion), assign func(*UserLikeQuestion, *User)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*UserLikeQuestion)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*UserLikeQuestion)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
 	}
-	query.withFKs = true
-	query.Where(predicate.User(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(userlikequestion.UserColumn), fks...))
-	}))
+	query.Where(user.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_like_question_user
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_like_question_user" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_like_question_user" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
 func (ulqq *UserLikeQuestionQuery) loadQuestion(ctx context.Context, query *QuestionQuery, nodes []*UserLikeQuestion, init func(*UserLikeQuestion), assign func(*UserLikeQuestion, *Question)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*UserLikeQuestion)
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*UserLikeQuestion)
 	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+		fk := nodes[i].QuestionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
 		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	query.withFKs = true
-	query.Where(predicate.Question(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(userlikequestion.QuestionColumn), fks...))
-	}))
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(question.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_like_question_question
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_like_question_question" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_like_question_question" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "question_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
 
 func (ulqq *UserLikeQuestionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ulqq.querySpec()
+	if len(ulqq.modifiers) > 0 {
+		_spec.Modifiers = ulqq.modifiers
+	}
 	_spec.Node.Columns = ulqq.ctx.Fields
 	if len(ulqq.ctx.Fields) > 0 {
 		_spec.Unique = ulqq.ctx.Unique != nil && *ulqq.ctx.Unique
@@ -539,6 +534,12 @@ func (ulqq *UserLikeQuestionQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if ulqq.withUser != nil {
+			_spec.Node.AddColumnOnce(userlikequestion.FieldUserID)
+		}
+		if ulqq.withQuestion != nil {
+			_spec.Node.AddColumnOnce(userlikequestion.FieldQuestionID)
+		}
 	}
 	if ps := ulqq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -578,6 +579,9 @@ func (ulqq *UserLikeQuestionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if ulqq.ctx.Unique != nil && *ulqq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range ulqq.modifiers {
+		m(selector)
+	}
 	for _, p := range ulqq.predicates {
 		p(selector)
 	}
@@ -595,6 +599,12 @@ func (ulqq *UserLikeQuestionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ulqq *UserLikeQuestionQuery) Modify(modifiers ...func(s *sql.Selector)) *UserLikeQuestionSelect {
+	ulqq.modifiers = append(ulqq.modifiers, modifiers...)
+	return ulqq.Select()
+}
+
 // UserLikeQuestionGroupBy is the group-by builder for UserLikeQuestion entities.
 type UserLikeQuestionGroupBy struct {
 	selector
@@ -684,3 +694,9 @@ func (ulqs *UserLikeQuestionSelect) sqlScan(ctx context.Context, root *UserLikeQ
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ulqs *UserLikeQuestionSelect) Modify(modifiers ...func(s *sql.Selector)) *UserLikeQuestionSelect {
+	ulqs.modifiers = append(ulqs.modifiers, modifiers...)
+	return ulqs
+}
@@ -19,8 +19,9 @@ import (
 // UserLikeQuestionUpdate is the builder for updating UserLikeQuestion entities.
 type UserLikeQuestionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserLikeQuestionMutation
+	hooks     []Hook
+	mutation  *UserLikeQuestionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserLikeQuestionUpdate builder.
@@ -31,7 +32,6 @@ func (ulqu *UserLikeQuestionUpdate) Where(ps ...predicate.UserLikeQuestion) *Use
 
 // SetUserID sets the "user_id" field.
 func (ulqu *UserLikeQuestionUpdate) SetUserID(i int) *UserLikeQuestionUpdate {
-	ulqu.mutation.ResetUserID()
 	ulqu.mutation.SetUserID(i)
 	return ulqu
 }
@@ -44,15 +44,8 @@ func (ulqu *UserLikeQuestionUpdate) SetNillableUserID(i *int) *UserLikeQuestionU
 	return ulqu
 }
 
-// AddUserID adds i to the "user_id" field.
-func (ulqu *UserLikeQuestionUpdate) AddUserID(i int) *UserLikeQuestionUpdate {
-	ulqu.mutation.AddUserID(i)
-	return ulqu
-}
-
 // SetQuestionID sets the "question_id" field.
 func (ulqu *UserLikeQuestionUpdate) SetQuestionID(i int) *UserLikeQuestionUpdate {
-	ulqu.mutation.ResetQuestionID()
 	ulqu.mutation.SetQuestionID(i)
 	return ulqu
 }
@@ -65,40 +58,14 @@ func (ulqu *UserLikeQuestionUpdate) SetNillableQuestionID(i *int) *UserLikeQuest
 	return ulqu
 }
 
-// AddQuestionID adds i to the "question_id" field.
-func (ulqu *UserLikeQuestionUpdate) AddQuestionID(i int) *UserLikeQuestionUpdate {
-	ulqu.mutation.AddQuestionID(i)
-	return ulqu
-}
-
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (ulqu *UserLikeQuestionUpdate) AddUserIDs(ids ...int) *UserLikeQuestionUpdate {
-	ulqu.mutation.AddUserIDs(ids...)
-	return ulqu
-}
-
-// AddUser adds the "user" edges to the User entity.
-func (ulqu *UserLikeQuestionUpdate) AddUser(u ...*User) *UserLikeQuestionUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ulqu.AddUserIDs(ids...)
-}
-
-// AddQuestionIDs adds the "question" edge to the Question entity by IDs.
-func (ulqu *UserLikeQuestionUpdate) AddQuestionIDs(ids ...int) *UserLikeQuestionUpdate {
-	ulqu.mutation.AddQuestionIDs(ids...)
-	return ulqu
+// SetUser sets the "user" edge to the User entity.
+func (ulqu *UserLikeQuestionUpdate) SetUser(u *User) *UserLikeQuestionUpdate {
+	return ulqu.SetUserID(u.ID)
 }
 
-// AddQuestion adds the "question" edges to the Question entity.
-func (ulqu *UserLikeQuestionUpdate) AddQuestion(q ...*Question) *UserLikeQuestionUpdate {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
-	}
-	return ulqu.AddQuestionIDs(ids...)
+// SetQuestion sets the "question" edge to the Question entity.
+func (ulqu *UserLikeQuestionUpdate) SetQuestion(q *Question) *UserLikeQuestionUpdate {
+	return ulqu.SetQuestionID(q.ID)
 }
 
 // Mutation returns the UserLikeQuestionMutation object of the builder.
@@ -106,48 +73,18 @@ func (ulqu *UserLikeQuestionUpdate) Mutation() *UserLikeQuestionMutation {
 	return ulqu.mutation
 }
 
-// ClearUser clears all "user" edges to the User entity.
+// ClearUser clears the "user" edge to the User entity.
 func (ulqu *UserLikeQuestionUpdate) ClearUser() *UserLikeQuestionUpdate {
 	ulqu.mutation.ClearUser()
 	return ulqu
 }
 
-// RemoveUserIDs removes the "user" edge to User entities by IDs.
-func (ulqu *UserLikeQuestionUpdate) RemoveUserIDs(ids ...int) *UserLikeQuestionUpdate {
-	ulqu.mutation.RemoveUserIDs(ids...)
-	return ulqu
-}
-
-// RemoveUser removes "user" edges to User entities.
-func (ulqu *UserLikeQuestionUpdate) RemoveUser(u ...*User) *UserLikeQuestionUpdate {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ulqu.RemoveUserIDs(ids...)
-}
-
-// ClearQuestion clears all "question" edges to the Question entity.
+// ClearQuestion clears the "question" edge to the Question entity.
 func (ulqu *UserLikeQuestionUpdate) ClearQuestion() *UserLikeQuestionUpdate {
 	ulqu.mutation.ClearQuestion()
 	return ulqu
 }
 
-// RemoveQuestionIDs removes the "question" edge to Question entities by IDs.
-func (ulqu *UserLikeQuestionUpdate) RemoveQuestionIDs(ids ...int) *UserLikeQuestionUpdate {
-	ulqu.mutation.RemoveQuestionIDs(ids...)
-	return ulqu
-}
-
-// RemoveQuestion removes "question" edges to Question entities.
-func (ulqu *UserLikeQuestionUpdate) RemoveQuestion(q ...*Question) *UserLikeQuestionUpdate {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
-	}
-	return ulqu.RemoveQuestionIDs(ids...)
-}
-
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ulqu *UserLikeQuestionUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, ulqu.sqlSave, ulqu.mutation, ulqu.hooks)
@@ -175,7 +112,27 @@ func (ulqu *UserLikeQuestionUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ulqu *UserLikeQuestionUpdate) check() error {
+	if _, ok := ulqu.mutation.UserID(); ulqu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserLikeQuestion.user"`)
+	}
+	if _, ok := ulqu.mutation.QuestionID(); ulqu.mutation.QuestionCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserLikeQuestion.question"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ulqu *UserLikeQuestionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserLikeQuestionUpdate {
+	ulqu.modifiers = append(ulqu.modifiers, modifiers...)
+	return ulqu
+}
+
 func (ulqu *UserLikeQuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ulqu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(userlikequestion.Table, userlikequestion.Columns, sqlgraph.NewFieldSpec(userlikequestion.FieldID, field.TypeInt))
 	if ps := ulqu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -184,35 +141,10 @@ func (ulqu *UserLikeQuestionUpdate) sqlSave(ctx context.Context) (n int, err err
 			}
 		}
 	}
-	if value, ok := ulqu.mutation.UserID(); ok {
-		_spec.SetField(userlikequestion.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := ulqu.mutation.AddedUserID(); ok {
-		_spec.AddField(userlikequestion.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := ulqu.mutation.QuestionID(); ok {
-		_spec.SetField(userlikequestion.FieldQuestionID, field.TypeInt, value)
-	}
-	if value, ok := ulqu.mutation.AddedQuestionID(); ok {
-		_spec.AddField(userlikequestion.FieldQuestionID, field.TypeInt, value)
-	}
 	if ulqu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   userlikequestion.UserTable,
-			Columns: []string{userlikequestion.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ulqu.mutation.RemovedUserIDs(); len(nodes) > 0 && !ulqu.mutation.UserCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikequestion.UserTable,
 			Columns: []string{userlikequestion.UserColumn},
 			Bidi:    false,
@@ -220,15 +152,12 @@ func (ulqu *UserLikeQuestionUpdate) sqlSave(ctx context.Context) (n int, err err
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ulqu.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikequestion.UserTable,
 			Columns: []string{userlikequestion.UserColumn},
 			Bidi:    false,
@@ -243,21 +172,8 @@ func (ulqu *UserLikeQuestionUpdate) sqlSave(ctx context.Context) (n int, err err
 	}
 	if ulqu.mutation.QuestionCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   userlikequestion.QuestionTable,
-			Columns: []string{userlikequestion.QuestionColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ulqu.mutation.RemovedQuestionIDs(); len(nodes) > 0 && !ulqu.mutation.QuestionCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikequestion.QuestionTable,
 			Columns: []string{userlikequestion.QuestionColumn},
 			Bidi:    false,
@@ -265,15 +181,12 @@ func (ulqu *UserLikeQuestionUpdate) sqlSave(ctx context.Context) (n int, err err
 				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ulqu.mutation.QuestionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikequestion.QuestionTable,
 			Columns: []string{userlikequestion.QuestionColumn},
 			Bidi:    false,
@@ -286,6 +199,7 @@ func (ulqu *UserLikeQuestionUpdate) sqlSave(ctx context.Context) (n int, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(ulqu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, ulqu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{userlikequestion.Label}
@@ -301,14 +215,14 @@ func (ulqu *UserLikeQuestionUpdate) sqlSave(ctx context.Context) (n int, err err
 // UserLikeQuestionUpdateOne is the builder for updating a single UserLikeQuestion entity.
 type UserLikeQuestionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserLikeQuestionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *UserLikeQuestionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
 func (ulquo *UserLikeQuestionUpdateOne) SetUserID(i int) *UserLikeQuestionUpdateOne {
-	ulquo.mutation.ResetUserID()
 	ulquo.mutation.SetUserID(i)
 	return ulquo
 }
@@ -321,15 +235,8 @@ func (ulquo *UserLikeQuestionUpdateOne) SetNillableUserID(i *int) *UserLikeQuest
 	return ulquo
 }
 
-// AddUserID adds i to the "user_id" field.
-func (ulquo *UserLikeQuestionUpdateOne) AddUserID(i int) *UserLikeQuestionUpdateOne {
-	ulquo.mutation.AddUserID(i)
-	return ulquo
-}
-
 // SetQuestionID sets the "question_id" field.
 func (ulquo *UserLikeQuestionUpdateOne) SetQuestionID(i int) *UserLikeQuestionUpdateOne {
-	ulquo.mutation.ResetQuestionID()
 	ulquo.mutation.SetQuestionID(i)
 	return ulquo
 }
@@ -342,40 +249,14 @@ func (ulquo *UserLikeQuestionUpdateOne) SetNillableQuestionID(i *int) *UserLikeQ
 	return ulquo
 }
 
-// AddQuestionID adds i to the "question_id" field.
-func (ulquo *UserLikeQuestionUpdateOne) AddQuestionID(i int) *UserLikeQuestionUpdateOne {
-	ulquo.mutation.AddQuestionID(i)
-	return ulquo
-}
-
-// AddUserIDs adds the "user" edge to the User entity by IDs.
-func (ulquo *UserLikeQuestionUpdateOne) AddUserIDs(ids ...int) *UserLikeQuestionUpdateOne {
-	ulquo.mutation.AddUserIDs(ids...)
-	return ulquo
-}
-
-// AddUser adds the "user" edges to the User entity.
-func (ulquo *UserLikeQuestionUpdateOne) AddUser(u ...*User) *UserLikeQuestionUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ulquo.AddUserIDs(ids...)
-}
-
-// AddQuestionIDs adds the "question" edge to the Question entity by IDs.
-func (ulquo *UserLikeQuestionUpdateOne) AddQuestionIDs(ids ...int) *UserLikeQuestionUpdateOne {
-	ulquo.mutation.AddQuestionIDs(ids...)
-	return ulquo
+// SetUser sets the "user" edge to the User entity.
+func (ulquo *UserLikeQuestionUpdateOne) SetUser(u *User) *UserLikeQuestionUpdateOne {
+	return ulquo.SetUserID(u.ID)
 }
 
-// AddQuestion adds the "question" edges to the Question entity.
-func (ulquo *UserLikeQuestionUpdateOne) AddQuestion(q ...*Question) *UserLikeQuestionUpdateOne {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
-	}
-	return ulquo.AddQuestionIDs(ids...)
+// SetQuestion sets the "question" edge to the Question entity.
+func (ulquo *UserLikeQuestionUpdateOne) SetQuestion(q *Question) *UserLikeQuestionUpdateOne {
+	return ulquo.SetQuestionID(q.ID)
 }
 
 // Mutation returns the UserLikeQuestionMutation object of the builder.
@@ -383,48 +264,18 @@ func (ulquo *UserLikeQuestionUpdateOne) Mutation() *UserLikeQuestionMutation {
 	return ulquo.mutation
 }
 
-// ClearUser clears all "user" edges to the User entity.
+// ClearUser clears the "user" edge to the User entity.
 func (ulquo *UserLikeQuestionUpdateOne) ClearUser() *UserLikeQuestionUpdateOne {
 	ulquo.mutation.ClearUser()
 	return ulquo
 }
 
-// RemoveUserIDs removes the "user" edge to User entities by IDs.
-func (ulquo *UserLikeQuestionUpdateOne) RemoveUserIDs(ids ...int) *UserLikeQuestionUpdateOne {
-	ulquo.mutation.RemoveUserIDs(ids...)
-	return ulquo
-}
-
-// RemoveUser removes "user" edges to User entities.
-func (ulquo *UserLikeQuestionUpdateOne) RemoveUser(u ...*User) *UserLikeQuestionUpdateOne {
-	ids := make([]int, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
-	}
-	return ulquo.RemoveUserIDs(ids...)
-}
-
-// ClearQuestion clears all "question" edges to the Question entity.
+// ClearQuestion clears the "question" edge to the Question entity.
 func (ulquo *UserLikeQuestionUpdateOne) ClearQuestion() *UserLikeQuestionUpdateOne {
 	ulquo.mutation.ClearQuestion()
 	return ulquo
 }
 
-// RemoveQuestionIDs removes the "question" edge to Question entities by IDs.
-func (ulquo *UserLikeQuestionUpdateOne) RemoveQuestionIDs(ids ...int) *UserLikeQuestionUpdateOne {
-	ulquo.mutation.RemoveQuestionIDs(ids...)
-	return ulquo
-}
-
-// RemoveQuestion removes "question" edges to Question entities.
-func (ulquo *UserLikeQuestionUpdateOne) RemoveQuestion(q ...*Question) *UserLikeQuestionUpdateOne {
-	ids := make([]int, len(q))
-	for i := range q {
-		ids[i] = q[i].ID
-	}
-	return ulquo.RemoveQuestionIDs(ids...)
-}
-
 // Where appends a list predicates to the UserLikeQuestionUpdate builder.
 func (ulquo *UserLikeQuestionUpdateOne) Where(ps ...predicate.UserLikeQuestion) *UserLikeQuestionUpdateOne {
 	ulquo.mutation.Where(ps...)
@@ -465,7 +316,27 @@ func (ulquo *UserLikeQuestionUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ulquo *UserLikeQuestionUpdateOne) check() error {
+	if _, ok := ulquo.mutation.UserID(); ulquo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserLikeQuestion.user"`)
+	}
+	if _, ok := ulquo.mutation.QuestionID(); ulquo.mutation.QuestionCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserLikeQuestion.question"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ulquo *UserLikeQuestionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserLikeQuestionUpdateOne {
+	ulquo.modifiers = append(ulquo.modifiers, modifiers...)
+	return ulquo
+}
+
 func (ulquo *UserLikeQuestionUpdateOne) sqlSave(ctx context.Context) (_node *UserLikeQuestion, err error) {
+	if err := ulquo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(userlikequestion.Table, userlikequestion.Columns, sqlgraph.NewFieldSpec(userlikequestion.FieldID, field.TypeInt))
 	id, ok := ulquo.mutation.ID()
 	if !ok {
@@ -491,35 +362,10 @@ func (ulquo *UserLikeQuestionUpdateOne) sqlSave(ctx context.Context) (_node *Use
 			}
 		}
 	}
-	if value, ok := ulquo.mutation.UserID(); ok {
-		_spec.SetField(userlikequestion.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := ulquo.mutation.AddedUserID(); ok {
-		_spec.AddField(userlikequestion.FieldUserID, field.TypeInt, value)
-	}
-	if value, ok := ulquo.mutation.QuestionID(); ok {
-		_spec.SetField(userlikequestion.FieldQuestionID, field.TypeInt, value)
-	}
-	if value, ok := ulquo.mutation.AddedQuestionID(); ok {
-		_spec.AddField(userlikequestion.FieldQuestionID, field.TypeInt, value)
-	}
 	if ulquo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   userlikequestion.UserTable,
-			Columns: []string{userlikequestion.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ulquo.mutation.RemovedUserIDs(); len(nodes) > 0 && !ulquo.mutation.UserCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikequestion.UserTable,
 			Columns: []string{userlikequestion.UserColumn},
 			Bidi:    false,
@@ -527,15 +373,12 @@ func (ulquo *UserLikeQuestionUpdateOne) sqlSave(ctx context.Context) (_node *Use
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ulquo.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikequestion.UserTable,
 			Columns: []string{userlikequestion.UserColumn},
 			Bidi:    false,
@@ -550,21 +393,8 @@ func (ulquo *UserLikeQuestionUpdateOne) sqlSave(ctx context.Context) (_node *Use
 	}
 	if ulquo.mutation.QuestionCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   userlikequestion.QuestionTable,
-			Columns: []string{userlikequestion.QuestionColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ulquo.mutation.RemovedQuestionIDs(); len(nodes) > 0 && !ulquo.mutation.QuestionCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikequestion.QuestionTable,
 			Columns: []string{userlikequestion.QuestionColumn},
 			Bidi:    false,
@@ -572,15 +402,12 @@ func (ulquo *UserLikeQuestionUpdateOne) sqlSave(ctx context.Context) (_node *Use
 				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
 			},
 		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := ulquo.mutation.QuestionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
 			Table:   userlikequestion.QuestionTable,
 			Columns: []string{userlikequestion.QuestionColumn},
 			Bidi:    false,
@@ -593,6 +420,7 @@ func (ulquo *UserLikeQuestionUpdateOne) sqlSave(ctx context.Context) (_node *Use
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(ulquo.modifiers...)
 	_node = &UserLikeQuestion{config: ulquo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
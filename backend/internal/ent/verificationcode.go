@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/ent/verificationcode"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// VerificationCode is the model entity for the VerificationCode schema.
+type VerificationCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Phone holds the value of the "phone" field.
+	Phone string `json:"phone,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// Purpose holds the value of the "purpose" field.
+	Purpose verificationcode.Purpose `json:"purpose,omitempty"`
+	// CodeHash holds the value of the "code_hash" field.
+	CodeHash string `json:"-"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// ConsumedAt holds the value of the "consumed_at" field.
+	ConsumedAt   *time.Time `json:"consumed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VerificationCode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case verificationcode.FieldID, verificationcode.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case verificationcode.FieldPhone, verificationcode.FieldIP, verificationcode.FieldPurpose, verificationcode.FieldCodeHash:
+			values[i] = new(sql.NullString)
+		case verificationcode.FieldCreateTime, verificationcode.FieldUpdateTime, verificationcode.FieldExpiresAt, verificationcode.FieldConsumedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VerificationCode fields.
+func (vc *VerificationCode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case verificationcode.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			vc.ID = int(value.Int64)
+		case verificationcode.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				vc.CreateTime = value.Time
+			}
+		case verificationcode.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				vc.UpdateTime = value.Time
+			}
+		case verificationcode.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				vc.Phone = value.String
+			}
+		case verificationcode.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				vc.IP = value.String
+			}
+		case verificationcode.FieldPurpose:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field purpose", values[i])
+			} else if value.Valid {
+				vc.Purpose = verificationcode.Purpose(value.String)
+			}
+		case verificationcode.FieldCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_hash", values[i])
+			} else if value.Valid {
+				vc.CodeHash = value.String
+			}
+		case verificationcode.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				vc.ExpiresAt = value.Time
+			}
+		case verificationcode.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				vc.Attempts = int(value.Int64)
+			}
+		case verificationcode.FieldConsumedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field consumed_at", values[i])
+			} else if value.Valid {
+				vc.ConsumedAt = new(time.Time)
+				*vc.ConsumedAt = value.Time
+			}
+		default:
+			vc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VerificationCode.
+// This includes values selected through modifiers, order, etc.
+func (vc *VerificationCode) Value(name string) (ent.Value, error) {
+	return vc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this VerificationCode.
+// Note that you need to call VerificationCode.Unwrap() before calling this method if this VerificationCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (vc *VerificationCode) Update() *VerificationCodeUpdateOne {
+	return NewVerificationCodeClient(vc.config).UpdateOne(vc)
+}
+
+// Unwrap unwraps the VerificationCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (vc *VerificationCode) Unwrap() *VerificationCode {
+	_tx, ok := vc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VerificationCode is not a transactional entity")
+	}
+	vc.config.driver = _tx.drv
+	return vc
+}
+
+// String implements the fmt.Stringer.
+func (vc *VerificationCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("VerificationCode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", vc.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(vc.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(vc.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(vc.Phone)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(vc.IP)
+	builder.WriteString(", ")
+	builder.WriteString("purpose=")
+	builder.WriteString(fmt.Sprintf("%v", vc.Purpose))
+	builder.WriteString(", ")
+	builder.WriteString("code_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(vc.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", vc.Attempts))
+	builder.WriteString(", ")
+	if v := vc.ConsumedAt; v != nil {
+		builder.WriteString("consumed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VerificationCodes is a parsable slice of VerificationCode.
+type VerificationCodes []*VerificationCode
@@ -0,0 +1,793 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/ent/verificationcode"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VerificationCodeCreate is the builder for creating a VerificationCode entity.
+type VerificationCodeCreate struct {
+	config
+	mutation *VerificationCodeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (vcc *VerificationCodeCreate) SetCreateTime(t time.Time) *VerificationCodeCreate {
+	vcc.mutation.SetCreateTime(t)
+	return vcc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (vcc *VerificationCodeCreate) SetNillableCreateTime(t *time.Time) *VerificationCodeCreate {
+	if t != nil {
+		vcc.SetCreateTime(*t)
+	}
+	return vcc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (vcc *VerificationCodeCreate) SetUpdateTime(t time.Time) *VerificationCodeCreate {
+	vcc.mutation.SetUpdateTime(t)
+	return vcc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (vcc *VerificationCodeCreate) SetNillableUpdateTime(t *time.Time) *VerificationCodeCreate {
+	if t != nil {
+		vcc.SetUpdateTime(*t)
+	}
+	return vcc
+}
+
+// SetPhone sets the "phone" field.
+func (vcc *VerificationCodeCreate) SetPhone(s string) *VerificationCodeCreate {
+	vcc.mutation.SetPhone(s)
+	return vcc
+}
+
+// SetIP sets the "ip" field.
+func (vcc *VerificationCodeCreate) SetIP(s string) *VerificationCodeCreate {
+	vcc.mutation.SetIP(s)
+	return vcc
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (vcc *VerificationCodeCreate) SetNillableIP(s *string) *VerificationCodeCreate {
+	if s != nil {
+		vcc.SetIP(*s)
+	}
+	return vcc
+}
+
+// SetPurpose sets the "purpose" field.
+func (vcc *VerificationCodeCreate) SetPurpose(v verificationcode.Purpose) *VerificationCodeCreate {
+	vcc.mutation.SetPurpose(v)
+	return vcc
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (vcc *VerificationCodeCreate) SetCodeHash(s string) *VerificationCodeCreate {
+	vcc.mutation.SetCodeHash(s)
+	return vcc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (vcc *VerificationCodeCreate) SetExpiresAt(t time.Time) *VerificationCodeCreate {
+	vcc.mutation.SetExpiresAt(t)
+	return vcc
+}
+
+// SetAttempts sets the "attempts" field.
+func (vcc *VerificationCodeCreate) SetAttempts(i int) *VerificationCodeCreate {
+	vcc.mutation.SetAttempts(i)
+	return vcc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (vcc *VerificationCodeCreate) SetNillableAttempts(i *int) *VerificationCodeCreate {
+	if i != nil {
+		vcc.SetAttempts(*i)
+	}
+	return vcc
+}
+
+// SetConsumedAt sets the "consumed_at" field.
+func (vcc *VerificationCodeCreate) SetConsumedAt(t time.Time) *VerificationCodeCreate {
+	vcc.mutation.SetConsumedAt(t)
+	return vcc
+}
+
+// SetNillableConsumedAt sets the "consumed_at" field if the given value is not nil.
+func (vcc *VerificationCodeCreate) SetNillableConsumedAt(t *time.Time) *VerificationCodeCreate {
+	if t != nil {
+		vcc.SetConsumedAt(*t)
+	}
+	return vcc
+}
+
+// Mutation returns the VerificationCodeMutation object of the builder.
+func (vcc *VerificationCodeCreate) Mutation() *VerificationCodeMutation {
+	return vcc.mutation
+}
+
+// Save creates the VerificationCode in the database.
+func (vcc *VerificationCodeCreate) Save(ctx context.Context) (*VerificationCode, error) {
+	vcc.defaults()
+	return withHooks(ctx, vcc.sqlSave, vcc.mutation, vcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vcc *VerificationCodeCreate) SaveX(ctx context.Context) *VerificationCode {
+	v, err := vcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vcc *VerificationCodeCreate) Exec(ctx context.Context) error {
+	_, err := vcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcc *VerificationCodeCreate) ExecX(ctx context.Context) {
+	if err := vcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vcc *VerificationCodeCreate) defaults() {
+	if _, ok := vcc.mutation.CreateTime(); !ok {
+		v := verificationcode.DefaultCreateTime()
+		vcc.mutation.SetCreateTime(v)
+	}
+	if _, ok := vcc.mutation.UpdateTime(); !ok {
+		v := verificationcode.DefaultUpdateTime()
+		vcc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := vcc.mutation.Attempts(); !ok {
+		v := verificationcode.DefaultAttempts
+		vcc.mutation.SetAttempts(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vcc *VerificationCodeCreate) check() error {
+	if _, ok := vcc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "VerificationCode.create_time"`)}
+	}
+	if _, ok := vcc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "VerificationCode.update_time"`)}
+	}
+	if _, ok := vcc.mutation.Phone(); !ok {
+		return &ValidationError{Name: "phone", err: errors.New(`ent: missing required field "VerificationCode.phone"`)}
+	}
+	if v, ok := vcc.mutation.Phone(); ok {
+		if err := verificationcode.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.phone": %w`, err)}
+		}
+	}
+	if v, ok := vcc.mutation.IP(); ok {
+		if err := verificationcode.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.ip": %w`, err)}
+		}
+	}
+	if _, ok := vcc.mutation.Purpose(); !ok {
+		return &ValidationError{Name: "purpose", err: errors.New(`ent: missing required field "VerificationCode.purpose"`)}
+	}
+	if v, ok := vcc.mutation.Purpose(); ok {
+		if err := verificationcode.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.purpose": %w`, err)}
+		}
+	}
+	if _, ok := vcc.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "VerificationCode.code_hash"`)}
+	}
+	if v, ok := vcc.mutation.CodeHash(); ok {
+		if err := verificationcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.code_hash": %w`, err)}
+		}
+	}
+	if _, ok := vcc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "VerificationCode.expires_at"`)}
+	}
+	if _, ok := vcc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "VerificationCode.attempts"`)}
+	}
+	return nil
+}
+
+func (vcc *VerificationCodeCreate) sqlSave(ctx context.Context) (*VerificationCode, error) {
+	if err := vcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	vcc.mutation.id = &_node.ID
+	vcc.mutation.done = true
+	return _node, nil
+}
+
+func (vcc *VerificationCodeCreate) createSpec() (*VerificationCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VerificationCode{config: vcc.config}
+		_spec = sqlgraph.NewCreateSpec(verificationcode.Table, sqlgraph.NewFieldSpec(verificationcode.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = vcc.conflict
+	if value, ok := vcc.mutation.CreateTime(); ok {
+		_spec.SetField(verificationcode.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := vcc.mutation.UpdateTime(); ok {
+		_spec.SetField(verificationcode.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := vcc.mutation.Phone(); ok {
+		_spec.SetField(verificationcode.FieldPhone, field.TypeString, value)
+		_node.Phone = value
+	}
+	if value, ok := vcc.mutation.IP(); ok {
+		_spec.SetField(verificationcode.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := vcc.mutation.Purpose(); ok {
+		_spec.SetField(verificationcode.FieldPurpose, field.TypeEnum, value)
+		_node.Purpose = value
+	}
+	if value, ok := vcc.mutation.CodeHash(); ok {
+		_spec.SetField(verificationcode.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := vcc.mutation.ExpiresAt(); ok {
+		_spec.SetField(verificationcode.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := vcc.mutation.Attempts(); ok {
+		_spec.SetField(verificationcode.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := vcc.mutation.ConsumedAt(); ok {
+		_spec.SetField(verificationcode.FieldConsumedAt, field.TypeTime, value)
+		_node.ConsumedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VerificationCode.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VerificationCodeUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (vcc *VerificationCodeCreate) OnConflict(opts ...sql.ConflictOption) *VerificationCodeUpsertOne {
+	vcc.conflict = opts
+	return &VerificationCodeUpsertOne{
+		create: vcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VerificationCode.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vcc *VerificationCodeCreate) OnConflictColumns(columns ...string) *VerificationCodeUpsertOne {
+	vcc.conflict = append(vcc.conflict, sql.ConflictColumns(columns...))
+	return &VerificationCodeUpsertOne{
+		create: vcc,
+	}
+}
+
+type (
+	// VerificationCodeUpsertOne is the builder for "upsert"-ing
+	//  one VerificationCode node.
+	VerificationCodeUpsertOne struct {
+		create *VerificationCodeCreate
+	}
+
+	// VerificationCodeUpsert is the "OnConflict" setter.
+	VerificationCodeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *VerificationCodeUpsert) SetUpdateTime(v time.Time) *VerificationCodeUpsert {
+	u.Set(verificationcode.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *VerificationCodeUpsert) UpdateUpdateTime() *VerificationCodeUpsert {
+	u.SetExcluded(verificationcode.FieldUpdateTime)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *VerificationCodeUpsert) SetAttempts(v int) *VerificationCodeUpsert {
+	u.Set(verificationcode.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *VerificationCodeUpsert) UpdateAttempts() *VerificationCodeUpsert {
+	u.SetExcluded(verificationcode.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *VerificationCodeUpsert) AddAttempts(v int) *VerificationCodeUpsert {
+	u.Add(verificationcode.FieldAttempts, v)
+	return u
+}
+
+// SetConsumedAt sets the "consumed_at" field.
+func (u *VerificationCodeUpsert) SetConsumedAt(v time.Time) *VerificationCodeUpsert {
+	u.Set(verificationcode.FieldConsumedAt, v)
+	return u
+}
+
+// UpdateConsumedAt sets the "consumed_at" field to the value that was provided on create.
+func (u *VerificationCodeUpsert) UpdateConsumedAt() *VerificationCodeUpsert {
+	u.SetExcluded(verificationcode.FieldConsumedAt)
+	return u
+}
+
+// ClearConsumedAt clears the value of the "consumed_at" field.
+func (u *VerificationCodeUpsert) ClearConsumedAt() *VerificationCodeUpsert {
+	u.SetNull(verificationcode.FieldConsumedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.VerificationCode.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *VerificationCodeUpsertOne) UpdateNewValues() *VerificationCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(verificationcode.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.Phone(); exists {
+			s.SetIgnore(verificationcode.FieldPhone)
+		}
+		if _, exists := u.create.mutation.IP(); exists {
+			s.SetIgnore(verificationcode.FieldIP)
+		}
+		if _, exists := u.create.mutation.Purpose(); exists {
+			s.SetIgnore(verificationcode.FieldPurpose)
+		}
+		if _, exists := u.create.mutation.CodeHash(); exists {
+			s.SetIgnore(verificationcode.FieldCodeHash)
+		}
+		if _, exists := u.create.mutation.ExpiresAt(); exists {
+			s.SetIgnore(verificationcode.FieldExpiresAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VerificationCode.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *VerificationCodeUpsertOne) Ignore() *VerificationCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VerificationCodeUpsertOne) DoNothing() *VerificationCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VerificationCodeCreate.OnConflict
+// documentation for more info.
+func (u *VerificationCodeUpsertOne) Update(set func(*VerificationCodeUpsert)) *VerificationCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VerificationCodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *VerificationCodeUpsertOne) SetUpdateTime(v time.Time) *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *VerificationCodeUpsertOne) UpdateUpdateTime() *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *VerificationCodeUpsertOne) SetAttempts(v int) *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *VerificationCodeUpsertOne) AddAttempts(v int) *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *VerificationCodeUpsertOne) UpdateAttempts() *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetConsumedAt sets the "consumed_at" field.
+func (u *VerificationCodeUpsertOne) SetConsumedAt(v time.Time) *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetConsumedAt(v)
+	})
+}
+
+// UpdateConsumedAt sets the "consumed_at" field to the value that was provided on create.
+func (u *VerificationCodeUpsertOne) UpdateConsumedAt() *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdateConsumedAt()
+	})
+}
+
+// ClearConsumedAt clears the value of the "consumed_at" field.
+func (u *VerificationCodeUpsertOne) ClearConsumedAt() *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.ClearConsumedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *VerificationCodeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VerificationCodeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VerificationCodeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *VerificationCodeUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *VerificationCodeUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// VerificationCodeCreateBulk is the builder for creating many VerificationCode entities in bulk.
+type VerificationCodeCreateBulk struct {
+	config
+	err      error
+	builders []*VerificationCodeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the VerificationCode entities in the database.
+func (vccb *VerificationCodeCreateBulk) Save(ctx context.Context) ([]*VerificationCode, error) {
+	if vccb.err != nil {
+		return nil, vccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(vccb.builders))
+	nodes := make([]*VerificationCode, len(vccb.builders))
+	mutators := make([]Mutator, len(vccb.builders))
+	for i := range vccb.builders {
+		func(i int, root context.Context) {
+			builder := vccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VerificationCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = vccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vccb *VerificationCodeCreateBulk) SaveX(ctx context.Context) []*VerificationCode {
+	v, err := vccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vccb *VerificationCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := vccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vccb *VerificationCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := vccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VerificationCode.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VerificationCodeUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (vccb *VerificationCodeCreateBulk) OnConflict(opts ...sql.ConflictOption) *VerificationCodeUpsertBulk {
+	vccb.conflict = opts
+	return &VerificationCodeUpsertBulk{
+		create: vccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VerificationCode.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vccb *VerificationCodeCreateBulk) OnConflictColumns(columns ...string) *VerificationCodeUpsertBulk {
+	vccb.conflict = append(vccb.conflict, sql.ConflictColumns(columns...))
+	return &VerificationCodeUpsertBulk{
+		create: vccb,
+	}
+}
+
+// VerificationCodeUpsertBulk is the builder for "upsert"-ing
+// a bulk of VerificationCode nodes.
+type VerificationCodeUpsertBulk struct {
+	create *VerificationCodeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.VerificationCode.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *VerificationCodeUpsertBulk) UpdateNewValues() *VerificationCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(verificationcode.FieldCreateTime)
+			}
+			if _, exists := b.mutation.Phone(); exists {
+				s.SetIgnore(verificationcode.FieldPhone)
+			}
+			if _, exists := b.mutation.IP(); exists {
+				s.SetIgnore(verificationcode.FieldIP)
+			}
+			if _, exists := b.mutation.Purpose(); exists {
+				s.SetIgnore(verificationcode.FieldPurpose)
+			}
+			if _, exists := b.mutation.CodeHash(); exists {
+				s.SetIgnore(verificationcode.FieldCodeHash)
+			}
+			if _, exists := b.mutation.ExpiresAt(); exists {
+				s.SetIgnore(verificationcode.FieldExpiresAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VerificationCode.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *VerificationCodeUpsertBulk) Ignore() *VerificationCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VerificationCodeUpsertBulk) DoNothing() *VerificationCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VerificationCodeCreateBulk.OnConflict
+// documentation for more info.
+func (u *VerificationCodeUpsertBulk) Update(set func(*VerificationCodeUpsert)) *VerificationCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VerificationCodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *VerificationCodeUpsertBulk) SetUpdateTime(v time.Time) *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *VerificationCodeUpsertBulk) UpdateUpdateTime() *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *VerificationCodeUpsertBulk) SetAttempts(v int) *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *VerificationCodeUpsertBulk) AddAttempts(v int) *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *VerificationCodeUpsertBulk) UpdateAttempts() *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetConsumedAt sets the "consumed_at" field.
+func (u *VerificationCodeUpsertBulk) SetConsumedAt(v time.Time) *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetConsumedAt(v)
+	})
+}
+
+// UpdateConsumedAt sets the "consumed_at" field to the value that was provided on create.
+func (u *VerificationCodeUpsertBulk) UpdateConsumedAt() *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdateConsumedAt()
+	})
+}
+
+// ClearConsumedAt clears the value of the "consumed_at" field.
+func (u *VerificationCodeUpsertBulk) ClearConsumedAt() *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.ClearConsumedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *VerificationCodeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the VerificationCodeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VerificationCodeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VerificationCodeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/ent/predicate"
+	"backend/internal/ent/verificationcode"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VerificationCodeUpdate is the builder for updating VerificationCode entities.
+type VerificationCodeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *VerificationCodeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the VerificationCodeUpdate builder.
+func (vcu *VerificationCodeUpdate) Where(ps ...predicate.VerificationCode) *VerificationCodeUpdate {
+	vcu.mutation.Where(ps...)
+	return vcu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (vcu *VerificationCodeUpdate) SetUpdateTime(t time.Time) *VerificationCodeUpdate {
+	vcu.mutation.SetUpdateTime(t)
+	return vcu
+}
+
+// SetAttempts sets the "attempts" field.
+func (vcu *VerificationCodeUpdate) SetAttempts(i int) *VerificationCodeUpdate {
+	vcu.mutation.ResetAttempts()
+	vcu.mutation.SetAttempts(i)
+	return vcu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (vcu *VerificationCodeUpdate) SetNillableAttempts(i *int) *VerificationCodeUpdate {
+	if i != nil {
+		vcu.SetAttempts(*i)
+	}
+	return vcu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (vcu *VerificationCodeUpdate) AddAttempts(i int) *VerificationCodeUpdate {
+	vcu.mutation.AddAttempts(i)
+	return vcu
+}
+
+// SetConsumedAt sets the "consumed_at" field.
+func (vcu *VerificationCodeUpdate) SetConsumedAt(t time.Time) *VerificationCodeUpdate {
+	vcu.mutation.SetConsumedAt(t)
+	return vcu
+}
+
+// SetNillableConsumedAt sets the "consumed_at" field if the given value is not nil.
+func (vcu *VerificationCodeUpdate) SetNillableConsumedAt(t *time.Time) *VerificationCodeUpdate {
+	if t != nil {
+		vcu.SetConsumedAt(*t)
+	}
+	return vcu
+}
+
+// ClearConsumedAt clears the value of the "consumed_at" field.
+func (vcu *VerificationCodeUpdate) ClearConsumedAt() *VerificationCodeUpdate {
+	vcu.mutation.ClearConsumedAt()
+	return vcu
+}
+
+// Mutation returns the VerificationCodeMutation object of the builder.
+func (vcu *VerificationCodeUpdate) Mutation() *VerificationCodeMutation {
+	return vcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vcu *VerificationCodeUpdate) Save(ctx context.Context) (int, error) {
+	vcu.defaults()
+	return withHooks(ctx, vcu.sqlSave, vcu.mutation, vcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vcu *VerificationCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := vcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vcu *VerificationCodeUpdate) Exec(ctx context.Context) error {
+	_, err := vcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcu *VerificationCodeUpdate) ExecX(ctx context.Context) {
+	if err := vcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vcu *VerificationCodeUpdate) defaults() {
+	if _, ok := vcu.mutation.UpdateTime(); !ok {
+		v := verificationcode.UpdateDefaultUpdateTime()
+		vcu.mutation.SetUpdateTime(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (vcu *VerificationCodeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *VerificationCodeUpdate {
+	vcu.modifiers = append(vcu.modifiers, modifiers...)
+	return vcu
+}
+
+func (vcu *VerificationCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(verificationcode.Table, verificationcode.Columns, sqlgraph.NewFieldSpec(verificationcode.FieldID, field.TypeInt))
+	if ps := vcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vcu.mutation.UpdateTime(); ok {
+		_spec.SetField(verificationcode.FieldUpdateTime, field.TypeTime, value)
+	}
+	if vcu.mutation.IPCleared() {
+		_spec.ClearField(verificationcode.FieldIP, field.TypeString)
+	}
+	if value, ok := vcu.mutation.Attempts(); ok {
+		_spec.SetField(verificationcode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := vcu.mutation.AddedAttempts(); ok {
+		_spec.AddField(verificationcode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := vcu.mutation.ConsumedAt(); ok {
+		_spec.SetField(verificationcode.FieldConsumedAt, field.TypeTime, value)
+	}
+	if vcu.mutation.ConsumedAtCleared() {
+		_spec.ClearField(verificationcode.FieldConsumedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(vcu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, vcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{verificationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vcu.mutation.done = true
+	return n, nil
+}
+
+// VerificationCodeUpdateOne is the builder for updating a single VerificationCode entity.
+type VerificationCodeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *VerificationCodeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (vcuo *VerificationCodeUpdateOne) SetUpdateTime(t time.Time) *VerificationCodeUpdateOne {
+	vcuo.mutation.SetUpdateTime(t)
+	return vcuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (vcuo *VerificationCodeUpdateOne) SetAttempts(i int) *VerificationCodeUpdateOne {
+	vcuo.mutation.ResetAttempts()
+	vcuo.mutation.SetAttempts(i)
+	return vcuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (vcuo *VerificationCodeUpdateOne) SetNillableAttempts(i *int) *VerificationCodeUpdateOne {
+	if i != nil {
+		vcuo.SetAttempts(*i)
+	}
+	return vcuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (vcuo *VerificationCodeUpdateOne) AddAttempts(i int) *VerificationCodeUpdateOne {
+	vcuo.mutation.AddAttempts(i)
+	return vcuo
+}
+
+// SetConsumedAt sets the "consumed_at" field.
+func (vcuo *VerificationCodeUpdateOne) SetConsumedAt(t time.Time) *VerificationCodeUpdateOne {
+	vcuo.mutation.SetConsumedAt(t)
+	return vcuo
+}
+
+// SetNillableConsumedAt sets the "consumed_at" field if the given value is not nil.
+func (vcuo *VerificationCodeUpdateOne) SetNillableConsumedAt(t *time.Time) *VerificationCodeUpdateOne {
+	if t != nil {
+		vcuo.SetConsumedAt(*t)
+	}
+	return vcuo
+}
+
+// ClearConsumedAt clears the value of the "consumed_at" field.
+func (vcuo *VerificationCodeUpdateOne) ClearConsumedAt() *VerificationCodeUpdateOne {
+	vcuo.mutation.ClearConsumedAt()
+	return vcuo
+}
+
+// Mutation returns the VerificationCodeMutation object of the builder.
+func (vcuo *VerificationCodeUpdateOne) Mutation() *VerificationCodeMutation {
+	return vcuo.mutation
+}
+
+// Where appends a list predicates to the VerificationCodeUpdate builder.
+func (vcuo *VerificationCodeUpdateOne) Where(ps ...predicate.VerificationCode) *VerificationCodeUpdateOne {
+	vcuo.mutation.Where(ps...)
+	return vcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vcuo *VerificationCodeUpdateOne) Select(field string, fields ...string) *VerificationCodeUpdateOne {
+	vcuo.fields = append([]string{field}, fields...)
+	return vcuo
+}
+
+// Save executes the query and returns the updated VerificationCode entity.
+func (vcuo *VerificationCodeUpdateOne) Save(ctx context.Context) (*VerificationCode, error) {
+	vcuo.defaults()
+	return withHooks(ctx, vcuo.sqlSave, vcuo.mutation, vcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vcuo *VerificationCodeUpdateOne) SaveX(ctx context.Context) *VerificationCode {
+	node, err := vcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vcuo *VerificationCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := vcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcuo *VerificationCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := vcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vcuo *VerificationCodeUpdateOne) defaults() {
+	if _, ok := vcuo.mutation.UpdateTime(); !ok {
+		v := verificationcode.UpdateDefaultUpdateTime()
+		vcuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (vcuo *VerificationCodeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *VerificationCodeUpdateOne {
+	vcuo.modifiers = append(vcuo.modifiers, modifiers...)
+	return vcuo
+}
+
+func (vcuo *VerificationCodeUpdateOne) sqlSave(ctx context.Context) (_node *VerificationCode, err error) {
+	_spec := sqlgraph.NewUpdateSpec(verificationcode.Table, verificationcode.Columns, sqlgraph.NewFieldSpec(verificationcode.FieldID, field.TypeInt))
+	id, ok := vcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VerificationCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, verificationcode.FieldID)
+		for _, f := range fields {
+			if !verificationcode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != verificationcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vcuo.mutation.UpdateTime(); ok {
+		_spec.SetField(verificationcode.FieldUpdateTime, field.TypeTime, value)
+	}
+	if vcuo.mutation.IPCleared() {
+		_spec.ClearField(verificationcode.FieldIP, field.TypeString)
+	}
+	if value, ok := vcuo.mutation.Attempts(); ok {
+		_spec.SetField(verificationcode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := vcuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(verificationcode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := vcuo.mutation.ConsumedAt(); ok {
+		_spec.SetField(verificationcode.FieldConsumedAt, field.TypeTime, value)
+	}
+	if vcuo.mutation.ConsumedAtCleared() {
+		_spec.ClearField(verificationcode.FieldConsumedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(vcuo.modifiers...)
+	_node = &VerificationCode{config: vcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{verificationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	vcuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"context"
+
+	"backend/internal/dto"
+	"backend/internal/middleware"
+	"backend/internal/rbac"
+	"backend/internal/service"
+	"backend/pkg/response"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type AnswerHandler struct {
+	answerService *service.AnswerService
+}
+
+func NewAnswerHandler(answerService *service.AnswerService) *AnswerHandler {
+	return &AnswerHandler{answerService: answerService}
+}
+
+func (h *AnswerHandler) Create(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+	questionID, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateAnswerRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.answerService.Create(ctx, userID, questionID, req.Content)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *AnswerHandler) List(ctx context.Context, c *app.RequestContext) {
+	viewerID, _ := middleware.CurrentUserID(c)
+	questionID, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.AnswerListQuery
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.answerService.List(ctx, viewerID, questionID, req.Sort, req.Cursor, req.Limit)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *AnswerHandler) Detail(ctx context.Context, c *app.RequestContext) {
+	viewerID, _ := middleware.CurrentUserID(c)
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.answerService.Get(ctx, viewerID, id)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *AnswerHandler) Update(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateAnswerRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	canModerate := middleware.HasPermission(c, rbac.PermContentModerate)
+	result, err := h.answerService.Update(ctx, userID, canModerate, id, req.Content)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *AnswerHandler) Delete(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	canModerate := middleware.HasPermission(c, rbac.PermContentModerate)
+	if err := h.answerService.Delete(ctx, userID, canModerate, id); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(nil))
+}
+
+func (h *AnswerHandler) Vote(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.VoteAnswerRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.answerService.Vote(ctx, userID, id, req.Value)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *AnswerHandler) Accept(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+	questionID, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.AcceptAnswerRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.answerService.Accept(ctx, userID, questionID, req.AnswerID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *AnswerHandler) Unaccept(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+	questionID, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.answerService.Unaccept(ctx, userID, questionID); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(nil))
+}
@@ -2,8 +2,10 @@ package handler
 
 import (
 	"context"
+	"strconv"
 
 	"backend/internal/dto"
+	"backend/internal/middleware"
 	"backend/internal/service"
 	"backend/pkg/errors"
 	"backend/pkg/response"
@@ -12,10 +14,14 @@ import (
 
 type AuthHandler struct {
 	authService *service.AuthService
+	codeService *service.VerificationService
 }
 
-func NewAuthHandler(authService *service.AuthService) *AuthHandler {
-	return &AuthHandler{authService: authService}
+func NewAuthHandler(authService *service.AuthService, codeService *service.VerificationService) *AuthHandler {
+	return &AuthHandler{
+		authService: authService,
+		codeService: codeService,
+	}
 }
 
 func (h *AuthHandler) Login(ctx context.Context, c *app.RequestContext) {
@@ -25,13 +31,9 @@ func (h *AuthHandler) Login(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	result, err := h.authService.Login(ctx, req.Phone, req.Password)
+	result, err := h.authService.Login(ctx, req.Phone, req.Password, clientInfo(c, req.Device))
 	if err != nil {
-		if err == errors.ErrInvalidCredentials {
-			c.JSON(401, response.Unauthorized(err.Error()))
-			return
-		}
-		c.JSON(500, response.InternalServerError(err.Error()))
+		writeError(c, err)
 		return
 	}
 
@@ -47,7 +49,7 @@ func (h *AuthHandler) RefreshToken(ctx context.Context, c *app.RequestContext) {
 
 	result, err := h.authService.RefreshToken(ctx, req.RefreshToken)
 	if err != nil {
-		if err == errors.ErrTokenExpired {
+		if err == errors.ErrTokenExpired || err == errors.ErrTokenReused {
 			c.JSON(401, response.Unauthorized(err.Error()))
 			return
 		}
@@ -57,3 +59,107 @@ func (h *AuthHandler) RefreshToken(ctx context.Context, c *app.RequestContext) {
 
 	c.JSON(200, response.Success(result))
 }
+
+func (h *AuthHandler) SendCode(ctx context.Context, c *app.RequestContext) {
+	var req dto.SendCodeRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	if err := h.codeService.SendCode(ctx, req.Phone, req.Purpose, c.ClientIP()); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(nil))
+}
+
+func (h *AuthHandler) LoginWithCode(ctx context.Context, c *app.RequestContext) {
+	var req dto.CodeLoginRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.authService.LoginWithCode(ctx, req.Phone, req.Code, clientInfo(c, req.Device))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *AuthHandler) ResetPassword(ctx context.Context, c *app.RequestContext) {
+	var req dto.ResetPasswordRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	if err := h.authService.ResetPassword(ctx, req.Phone, req.Code, req.Password); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(nil))
+}
+
+func (h *AuthHandler) Logout(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+	sessionID, _ := middleware.CurrentSessionID(c)
+
+	if err := h.authService.Logout(ctx, userID, sessionID); err != nil {
+		if err == errors.ErrNotFound {
+			c.JSON(404, response.NotFound(err.Error()))
+			return
+		}
+		c.JSON(500, response.InternalServerError(err.Error()))
+		return
+	}
+
+	c.JSON(200, response.Success(nil))
+}
+
+func (h *AuthHandler) ListSessions(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+	sessionID, _ := middleware.CurrentSessionID(c)
+
+	sessions, err := h.authService.ListSessions(ctx, userID, sessionID)
+	if err != nil {
+		c.JSON(500, response.InternalServerError(err.Error()))
+		return
+	}
+
+	c.JSON(200, response.Success(sessions))
+}
+
+func (h *AuthHandler) RevokeSession(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+
+	targetID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, response.BadRequest("invalid session id"))
+		return
+	}
+
+	if err := h.authService.RevokeSession(ctx, userID, targetID); err != nil {
+		if err == errors.ErrNotFound {
+			c.JSON(404, response.NotFound(err.Error()))
+			return
+		}
+		c.JSON(500, response.InternalServerError(err.Error()))
+		return
+	}
+
+	c.JSON(200, response.Success(nil))
+}
+
+func clientInfo(c *app.RequestContext, device string) *service.ClientInfo {
+	return &service.ClientInfo{
+		Device:    device,
+		UserAgent: string(c.UserAgent()),
+		IP:        c.ClientIP(),
+	}
+}
@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"context"
+
+	"backend/internal/dto"
+	"backend/internal/middleware"
+	"backend/internal/rbac"
+	"backend/internal/service"
+	"backend/pkg/response"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type CommentHandler struct {
+	commentService *service.CommentService
+}
+
+func NewCommentHandler(commentService *service.CommentService) *CommentHandler {
+	return &CommentHandler{commentService: commentService}
+}
+
+func (h *CommentHandler) ListPostComments(ctx context.Context, c *app.RequestContext) {
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+	h.list(ctx, c, service.CommentTarget{PostID: id})
+}
+
+func (h *CommentHandler) ListQuestionComments(ctx context.Context, c *app.RequestContext) {
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+	h.list(ctx, c, service.CommentTarget{QuestionID: id})
+}
+
+func (h *CommentHandler) CreatePostComment(ctx context.Context, c *app.RequestContext) {
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+	h.create(ctx, c, service.CommentTarget{PostID: id})
+}
+
+func (h *CommentHandler) CreateQuestionComment(ctx context.Context, c *app.RequestContext) {
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+	h.create(ctx, c, service.CommentTarget{QuestionID: id})
+}
+
+func (h *CommentHandler) ListAnswerComments(ctx context.Context, c *app.RequestContext) {
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+	h.list(ctx, c, service.CommentTarget{AnswerID: id})
+}
+
+func (h *CommentHandler) CreateAnswerComment(ctx context.Context, c *app.RequestContext) {
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+	h.create(ctx, c, service.CommentTarget{AnswerID: id})
+}
+
+func (h *CommentHandler) ListReplies(ctx context.Context, c *app.RequestContext) {
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.FeedQuery
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.commentService.ListReplies(ctx, id, req.Cursor, req.Limit)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *CommentHandler) Update(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateCommentRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	canModerate := middleware.HasPermission(c, rbac.PermContentModerate)
+	result, err := h.commentService.Update(ctx, userID, canModerate, id, req.Content)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *CommentHandler) Delete(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	canModerate := middleware.HasPermission(c, rbac.PermContentModerate)
+	if err := h.commentService.Delete(ctx, userID, canModerate, id); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(nil))
+}
+
+func (h *CommentHandler) list(ctx context.Context, c *app.RequestContext, target service.CommentTarget) {
+	var req dto.CommentListQuery
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	replies := -1
+	if req.Replies != nil {
+		replies = *req.Replies
+	}
+	result, err := h.commentService.ListTopLevel(ctx, target, req.Cursor, req.Limit, replies)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *CommentHandler) create(ctx context.Context, c *app.RequestContext, target service.CommentTarget) {
+	userID, _ := middleware.CurrentUserID(c)
+
+	var req dto.CreateCommentRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.commentService.Create(ctx, userID, target, req.ParentID, req.Content)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
@@ -0,0 +1,16 @@
+package handler
+
+import (
+	"backend/pkg/errors"
+	"backend/pkg/response"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// writeError 把 service 层返回的 AppError 按其 Code 映射为响应，其余错误一律按 500 处理。
+func writeError(c *app.RequestContext, err error) {
+	if appErr, ok := err.(*errors.AppError); ok {
+		c.JSON(appErr.Code, response.Error(appErr.Code, appErr.Message))
+		return
+	}
+	c.JSON(500, response.InternalServerError(err.Error()))
+}
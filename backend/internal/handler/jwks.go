@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+
+	"backend/pkg/jwt"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type JWKSHandler struct {
+	jwtMgr *jwt.Manager
+}
+
+func NewJWKSHandler(jwtMgr *jwt.Manager) *JWKSHandler {
+	return &JWKSHandler{jwtMgr: jwtMgr}
+}
+
+// Keys 按 RFC 7517 输出验签公钥，供其他服务（如 AI 网关）离线校验 Quibli 的 token。
+// 这是标准协议端点，不套用统一的 response 包装。
+func (h *JWKSHandler) Keys(ctx context.Context, c *app.RequestContext) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(200, h.jwtMgr.JWKS())
+}
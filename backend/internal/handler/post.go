@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"backend/internal/dto"
+	"backend/internal/middleware"
+	"backend/internal/rbac"
+	"backend/internal/service"
+	"backend/pkg/response"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type PostHandler struct {
+	postService *service.PostService
+}
+
+func NewPostHandler(postService *service.PostService) *PostHandler {
+	return &PostHandler{postService: postService}
+}
+
+func (h *PostHandler) Publish(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+
+	var req dto.CreatePostRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.postService.Publish(ctx, userID, &req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *PostHandler) List(ctx context.Context, c *app.RequestContext) {
+	viewerID, _ := middleware.CurrentUserID(c)
+	var req dto.FeedQuery
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.postService.List(ctx, viewerID, req.Cursor, req.Limit)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *PostHandler) Detail(ctx context.Context, c *app.RequestContext) {
+	viewerID, _ := middleware.CurrentUserID(c)
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.postService.Get(ctx, viewerID, id)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *PostHandler) Update(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdatePostRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	canModerate := middleware.HasPermission(c, rbac.PermContentModerate)
+	result, err := h.postService.Update(ctx, userID, canModerate, id, &req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *PostHandler) Delete(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	canModerate := middleware.HasPermission(c, rbac.PermContentModerate)
+	if err := h.postService.Delete(ctx, userID, canModerate, id); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(nil))
+}
+
+// pathID 解析路径参数 :id，非法时直接写入 400 响应。
+func pathID(c *app.RequestContext) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		c.JSON(400, response.BadRequest("invalid id"))
+		return 0, false
+	}
+	return id, true
+}
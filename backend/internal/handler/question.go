@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"context"
+
+	"backend/internal/dto"
+	"backend/internal/middleware"
+	"backend/internal/rbac"
+	"backend/internal/service"
+	"backend/pkg/response"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type QuestionHandler struct {
+	questionService *service.QuestionService
+}
+
+func NewQuestionHandler(questionService *service.QuestionService) *QuestionHandler {
+	return &QuestionHandler{questionService: questionService}
+}
+
+func (h *QuestionHandler) Publish(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+
+	var req dto.CreateQuestionRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.questionService.Publish(ctx, userID, &req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *QuestionHandler) List(ctx context.Context, c *app.RequestContext) {
+	viewerID, _ := middleware.CurrentUserID(c)
+	var req dto.QuestionFeedQuery
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.questionService.List(ctx, viewerID, req.Cursor, req.Limit, req.Filter)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *QuestionHandler) Detail(ctx context.Context, c *app.RequestContext) {
+	viewerID, _ := middleware.CurrentUserID(c)
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.questionService.Get(ctx, viewerID, id)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *QuestionHandler) Update(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateQuestionRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	canModerate := middleware.HasPermission(c, rbac.PermContentModerate)
+	result, err := h.questionService.Update(ctx, userID, canModerate, id, &req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *QuestionHandler) Delete(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	canModerate := middleware.HasPermission(c, rbac.PermContentModerate)
+	if err := h.questionService.Delete(ctx, userID, canModerate, id); err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(nil))
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+
+	"backend/internal/dto"
+	"backend/internal/middleware"
+	"backend/internal/service"
+	"backend/pkg/response"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type SearchHandler struct {
+	searchService *service.SearchService
+}
+
+func NewSearchHandler(searchService *service.SearchService) *SearchHandler {
+	return &SearchHandler{searchService: searchService}
+}
+
+// Search 按关键词搜索文章或问题，返回的条目与信息流相同。
+func (h *SearchHandler) Search(ctx context.Context, c *app.RequestContext) {
+	viewerID, _ := middleware.CurrentUserID(c)
+	var req dto.SearchQuery
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	var (
+		result any
+		err    error
+	)
+	if req.Type == "question" {
+		result, err = h.searchService.SearchQuestions(ctx, viewerID, req.Keyword, req.Mode, req.Page, req.Limit)
+	} else {
+		result, err = h.searchService.SearchPosts(ctx, viewerID, req.Keyword, req.Mode, req.Page, req.Limit)
+	}
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+// Suggestions 返回输入框下方的搜索建议，是去重后的标题和标签名。
+func (h *SearchHandler) Suggestions(ctx context.Context, c *app.RequestContext) {
+	var req dto.SuggestQuery
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	titles, err := h.searchService.Suggest(ctx, req.Keyword, req.Limit)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(titles))
+}
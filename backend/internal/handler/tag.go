@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"context"
+
+	"backend/internal/dto"
+	"backend/internal/middleware"
+	"backend/internal/service"
+	"backend/pkg/response"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type TagHandler struct {
+	tagService *service.TagService
+}
+
+func NewTagHandler(tagService *service.TagService) *TagHandler {
+	return &TagHandler{tagService: tagService}
+}
+
+// Suggest 按前缀补全标签名，别名也参与匹配。
+func (h *TagHandler) Suggest(ctx context.Context, c *app.RequestContext) {
+	var req dto.TagSuggestQuery
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.tagService.Suggest(ctx, req.Q, req.Limit)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *TagHandler) Trending(ctx context.Context, c *app.RequestContext) {
+	var req dto.TrendingTagsQuery
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.tagService.Trending(ctx, req.Days, req.Limit)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+// Lookup 按名称查找标签，文章、问题上展示的标签名可以直接用来跳转标签页。
+func (h *TagHandler) Lookup(ctx context.Context, c *app.RequestContext) {
+	var req dto.TagLookupQuery
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.tagService.Lookup(ctx, req.Name)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *TagHandler) Detail(ctx context.Context, c *app.RequestContext) {
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.tagService.Get(ctx, id)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *TagHandler) Posts(ctx context.Context, c *app.RequestContext) {
+	viewerID, _ := middleware.CurrentUserID(c)
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.FeedQuery
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.tagService.Posts(ctx, viewerID, id, req.Cursor, req.Limit)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *TagHandler) Questions(ctx context.Context, c *app.RequestContext) {
+	viewerID, _ := middleware.CurrentUserID(c)
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.FeedQuery
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.tagService.Questions(ctx, viewerID, id, req.Cursor, req.Limit)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+// Merge 把路径中的标签合并进另一个标签，需要 tag:manage 权限。
+func (h *TagHandler) Merge(ctx context.Context, c *app.RequestContext) {
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.MergeTagRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.tagService.Merge(ctx, id, req.TargetID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/dto"
+	"backend/internal/middleware"
+	"backend/internal/service"
+	"backend/pkg/errors"
+	"backend/pkg/response"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type UserHandler struct {
+	userService *service.UserService
+}
+
+func NewUserHandler(userService *service.UserService) *UserHandler {
+	return &UserHandler{userService: userService}
+}
+
+func (h *UserHandler) Register(ctx context.Context, c *app.RequestContext) {
+	var req dto.RegisterRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.userService.Register(ctx, &req)
+	if err != nil {
+		if err == errors.ErrPhoneRegistered {
+			c.JSON(409, response.Conflict(err.Error()))
+			return
+		}
+		c.JSON(500, response.InternalServerError(err.Error()))
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+// DeleteAccount 申请注销当前账号，宽限期内重新登录即可撤销。
+func (h *UserHandler) DeleteAccount(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+
+	var req dto.DeleteAccountRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.userService.RequestDeletion(ctx, userID, req.Password)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+// ExportData 以 ZIP 附件形式下载当前用户的全部个人数据。
+func (h *UserHandler) ExportData(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+
+	data, err := h.userService.ExportData(ctx, userID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="quibli-export-%d.zip"`, userID))
+	c.Data(200, "application/zip", data)
+}
+
+func (h *UserHandler) LikePost(ctx context.Context, c *app.RequestContext) {
+	h.postInteraction(ctx, c, h.userService.ToggleLikePost)
+}
+
+func (h *UserHandler) FavoritePost(ctx context.Context, c *app.RequestContext) {
+	h.postInteraction(ctx, c, h.userService.ToggleFavoritePost)
+}
+
+func (h *UserHandler) LikeQuestion(ctx context.Context, c *app.RequestContext) {
+	h.questionInteraction(ctx, c, h.userService.ToggleLikeQuestion)
+}
+
+func (h *UserHandler) FavoriteQuestion(ctx context.Context, c *app.RequestContext) {
+	h.questionInteraction(ctx, c, h.userService.ToggleFavoriteQuestion)
+}
+
+type toggleFunc func(ctx context.Context, userID, targetID int, want *bool) (*service.ToggleResult, error)
+
+func (h *UserHandler) postInteraction(ctx context.Context, c *app.RequestContext, toggle toggleFunc) {
+	userID, _ := middleware.CurrentUserID(c)
+
+	var req dto.PostInteractionRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := toggle(ctx, userID, req.PostID, req.Status)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *UserHandler) questionInteraction(ctx context.Context, c *app.RequestContext, toggle toggleFunc) {
+	userID, _ := middleware.CurrentUserID(c)
+
+	var req dto.QuestionInteractionRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := toggle(ctx, userID, req.QuestionID, req.Status)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *UserHandler) Follow(ctx context.Context, c *app.RequestContext) {
+	userID, _ := middleware.CurrentUserID(c)
+
+	var req dto.FollowRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.userService.ToggleFollow(ctx, userID, req.TargetFollowID, req.Status)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
+
+func (h *UserHandler) Following(ctx context.Context, c *app.RequestContext) {
+	h.followList(ctx, c, h.userService.ListFollowing)
+}
+
+func (h *UserHandler) Followers(ctx context.Context, c *app.RequestContext) {
+	h.followList(ctx, c, h.userService.ListFollowers)
+}
+
+type followListFunc func(ctx context.Context, viewerID, userID int, cursor string, limit int) (*service.FollowPage, error)
+
+func (h *UserHandler) followList(ctx context.Context, c *app.RequestContext, list followListFunc) {
+	viewerID, _ := middleware.CurrentUserID(c)
+	userID, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.FeedQuery
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(400, response.BadRequest(err.Error()))
+		return
+	}
+
+	result, err := list(ctx, viewerID, userID, req.Cursor, req.Limit)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(200, response.Success(result))
+}
@@ -0,0 +1,120 @@
+package loginguard
+
+import (
+	"context"
+	"time"
+
+	"backend/pkg/errors"
+)
+
+const (
+	window = 15 * time.Minute
+
+	// 同一手机号在窗口内失败这么多次后锁定，直到最早的失败滑出窗口
+	phoneLockThreshold = 5
+	// 同一 IP 可能对应 NAT 后的多个用户，阈值放宽
+	ipLockThreshold = 20
+
+	// 失败超过 freeAttempts 次后每次登录前都要等待，时长逐次翻倍
+	freeAttempts = 2
+	baseDelay    = 250 * time.Millisecond
+	maxDelay     = 4 * time.Second
+)
+
+// Guard 按手机号和 IP 两个维度统计滑动窗口内的登录失败次数，
+// 据此施加递增延迟，超过阈值后临时锁定。
+type Guard struct {
+	store AttemptStore
+	now   func() time.Time
+}
+
+func New(store AttemptStore) *Guard {
+	return &Guard{store: store, now: time.Now}
+}
+
+// Attempt 是 Check 预先记下的一次登录，由 Succeed 或 Release 收尾；登录失败时什么都不用做。
+type Attempt struct {
+	phone string
+	ip    string
+	at    time.Time
+}
+
+// Check 在校验密码前调用：先原子地把这次登录记为一次失败，再按之前的失败次数等待相应时长，
+// 已锁定时返回 ErrLoginLocked。计数和记录在同一步完成，并发的登录请求无法同时通过阈值检查。
+func (g *Guard) Check(ctx context.Context, phone, ip string) (*Attempt, error) {
+	// 数据库只保存到微秒，截断后 Remove 才能按时间找回这条记录
+	a := &Attempt{phone: phone, ip: ip, at: g.now().Truncate(time.Microsecond)}
+	since := a.at.Add(-window)
+
+	phoneFailures, ok, err := g.store.Reserve(ctx, phoneKey(phone), a.at, since, phoneLockThreshold)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	if !ok {
+		return nil, errors.ErrLoginLocked
+	}
+	ipFailures := 0
+	if ip != "" {
+		ipFailures, ok, err = g.store.Reserve(ctx, ipKey(ip), a.at, since, ipLockThreshold)
+		if err != nil || !ok {
+			_ = g.store.Remove(ctx, phoneKey(phone), a.at)
+			if err != nil {
+				return nil, errors.ErrInternalServer
+			}
+			return nil, errors.ErrLoginLocked
+		}
+	}
+
+	delay := backoff(phoneFailures)
+	if d := backoff(ipFailures / 4); d > delay {
+		delay = d
+	}
+	if delay == 0 {
+		return a, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return a, nil
+	case <-ctx.Done():
+		g.Release(context.WithoutCancel(ctx), a)
+		return nil, ctx.Err()
+	}
+}
+
+// Succeed 登录成功后清空该手机号的失败计数；IP 维度只撤销这一次的记录，避免攻击者用自己的账号重置计数。
+func (g *Guard) Succeed(ctx context.Context, a *Attempt) {
+	_ = g.store.Clear(ctx, phoneKey(a.phone))
+	if a.ip != "" {
+		_ = g.store.Remove(ctx, ipKey(a.ip), a.at)
+	}
+}
+
+// Release 撤销 Check 记下的失败，用于没能完成密码校验的请求（如数据库出错）。
+func (g *Guard) Release(ctx context.Context, a *Attempt) {
+	_ = g.store.Remove(ctx, phoneKey(a.phone), a.at)
+	if a.ip != "" {
+		_ = g.store.Remove(ctx, ipKey(a.ip), a.at)
+	}
+}
+
+func backoff(failures int) time.Duration {
+	if failures <= freeAttempts {
+		return 0
+	}
+	delay := baseDelay << (failures - freeAttempts - 1)
+	if delay > maxDelay || delay <= 0 {
+		return maxDelay
+	}
+	return delay
+}
+
+func phoneKey(phone string) string {
+	return "phone:" + phone
+}
+
+func ipKey(ip string) string {
+	return "ip:" + ip
+}
@@ -0,0 +1,93 @@
+package loginguard
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"backend/pkg/errors"
+)
+
+// checkConcurrently 并发地对每组 phone、ip 调用 Check，全部按登录失败处理，返回通过检查的次数。
+func checkConcurrently(t *testing.T, g *Guard, logins [][2]string) int {
+	t.Helper()
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		passed int
+	)
+	for _, login := range logins {
+		wg.Add(1)
+		go func(phone, ip string) {
+			defer wg.Done()
+			_, err := g.Check(context.Background(), phone, ip)
+			if err != nil && err != errors.ErrLoginLocked {
+				t.Errorf("Check(%q, %q) = %v", phone, ip, err)
+				return
+			}
+			if err == nil {
+				mu.Lock()
+				passed++
+				mu.Unlock()
+			}
+		}(login[0], login[1])
+	}
+	wg.Wait()
+	return passed
+}
+
+func TestConcurrentLoginsLockPhone(t *testing.T) {
+	g := New(NewMemoryStore())
+
+	logins := make([][2]string, 3*phoneLockThreshold)
+	for i := range logins {
+		logins[i] = [2]string{"13800000000", fmt.Sprintf("10.0.0.%d", i)}
+	}
+	if passed := checkConcurrently(t, g, logins); passed != phoneLockThreshold {
+		t.Fatalf("%d concurrent logins passed Check, want %d", passed, phoneLockThreshold)
+	}
+	if _, err := g.Check(context.Background(), "13800000000", "10.0.1.1"); err != errors.ErrLoginLocked {
+		t.Fatalf("Check after lock = %v, want ErrLoginLocked", err)
+	}
+}
+
+func TestConcurrentLoginsLockIP(t *testing.T) {
+	g := New(NewMemoryStore())
+
+	logins := make([][2]string, 2*ipLockThreshold)
+	for i := range logins {
+		logins[i] = [2]string{fmt.Sprintf("138%08d", i), "10.0.0.1"}
+	}
+	if passed := checkConcurrently(t, g, logins); passed != ipLockThreshold {
+		t.Fatalf("%d concurrent logins passed Check, want %d", passed, ipLockThreshold)
+	}
+	if _, err := g.Check(context.Background(), "13900000000", "10.0.0.1"); err != errors.ErrLoginLocked {
+		t.Fatalf("Check after lock = %v, want ErrLoginLocked", err)
+	}
+}
+
+func TestSucceedKeepsOtherIPFailures(t *testing.T) {
+	g := New(NewMemoryStore())
+	ctx := context.Background()
+
+	for i := 0; i < ipLockThreshold-1; i++ {
+		if _, err := g.Check(ctx, fmt.Sprintf("138%08d", i), "10.0.0.1"); err != nil {
+			t.Fatalf("Check #%d = %v", i, err)
+		}
+	}
+	a, err := g.Check(ctx, "13900000000", "10.0.0.1")
+	if err != nil {
+		t.Fatalf("last Check = %v", err)
+	}
+	g.Succeed(ctx, a)
+
+	// 成功的那次撤销后还剩一个名额，再失败一次就锁定
+	if _, err := g.Check(ctx, "13900000001", "10.0.0.1"); err != nil {
+		t.Fatalf("Check after Succeed = %v", err)
+	}
+	if _, err := g.Check(ctx, "13900000002", "10.0.0.1"); err != errors.ErrLoginLocked {
+		t.Fatalf("Check over threshold = %v, want ErrLoginLocked", err)
+	}
+}
@@ -0,0 +1,160 @@
+package loginguard
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"backend/internal/ent"
+	"backend/internal/ent/loginattempt"
+)
+
+// retention 是失败记录的最长保留时间，超过后即使没有登录成功也会被清理。
+const retention = 24 * time.Hour
+
+// AttemptStore 保存登录失败记录。Reserve 统计 since 之后的失败次数（滑动窗口），
+// 少于 limit 时追加一条 at 的记录并返回追加前的次数，统计和追加必须是原子的；达到 limit 时 ok 为 false。
+type AttemptStore interface {
+	Reserve(ctx context.Context, key string, at, since time.Time, limit int) (n int, ok bool, err error)
+	// Remove 删除一条时间为 at 的记录，没有时什么都不做
+	Remove(ctx context.Context, key string, at time.Time) error
+	Clear(ctx context.Context, key string) error
+}
+
+// MemoryStore 把失败记录放在进程内存里，只适合单实例部署或本地开发。
+type MemoryStore struct {
+	mu       sync.Mutex
+	attempts map[string][]time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{attempts: make(map[string][]time.Time)}
+}
+
+func (s *MemoryStore) Reserve(_ context.Context, key string, at, since time.Time, limit int) (int, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	times := prune(s.attempts[key], at.Add(-retention))
+	n := len(prune(times, since))
+	if n >= limit {
+		s.attempts[key] = times
+		return n, false, nil
+	}
+	// 并发请求拿到的 at 不一定按加锁顺序递增，插入到对应位置以保持升序
+	i := sort.Search(len(times), func(i int) bool { return times[i].After(at) })
+	s.attempts[key] = slices.Insert(times, i, at)
+	return n, true, nil
+}
+
+func (s *MemoryStore) Remove(_ context.Context, key string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	times := s.attempts[key]
+	for i, t := range times {
+		if t.Equal(at) {
+			s.attempts[key] = slices.Delete(times, i, i+1)
+			break
+		}
+	}
+	if len(s.attempts[key]) == 0 {
+		delete(s.attempts, key)
+	}
+	return nil
+}
+
+func (s *MemoryStore) Clear(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.attempts, key)
+	return nil
+}
+
+// prune 丢弃 since 之前的记录，times 按时间升序排列。
+func prune(times []time.Time, since time.Time) []time.Time {
+	i := 0
+	for i < len(times) && !times[i].After(since) {
+		i++
+	}
+	return times[i:]
+}
+
+// EntStore 把失败记录写进 Postgres，多个实例共享同一份计数，锁定在实例间同样生效。
+type EntStore struct {
+	client *ent.Client
+}
+
+func NewEntStore(client *ent.Client) *EntStore {
+	return &EntStore{client: client}
+}
+
+func (s *EntStore) Reserve(ctx context.Context, key string, at, since time.Time, limit int) (int, bool, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	// 同一 key 的请求在这里排队，统计和写入之间不会插进别的请求
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", "login:"+key); err != nil {
+		return 0, false, rollback(tx, err)
+	}
+	// 顺手清理该 key 过期的记录，避免表无限增长
+	if _, err := tx.LoginAttempt.Delete().
+		Where(
+			loginattempt.KeyEQ(key),
+			loginattempt.CreateTimeLT(at.Add(-retention)),
+		).
+		Exec(ctx); err != nil {
+		return 0, false, rollback(tx, err)
+	}
+	n, err := tx.LoginAttempt.Query().
+		Where(
+			loginattempt.KeyEQ(key),
+			loginattempt.CreateTimeGT(since),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, false, rollback(tx, err)
+	}
+	if n >= limit {
+		return n, false, tx.Commit()
+	}
+	if err := tx.LoginAttempt.Create().
+		SetKey(key).
+		SetCreateTime(at).
+		Exec(ctx); err != nil {
+		return 0, false, rollback(tx, err)
+	}
+	return n, true, tx.Commit()
+}
+
+func (s *EntStore) Remove(ctx context.Context, key string, at time.Time) error {
+	id, err := s.client.LoginAttempt.Query().
+		Where(
+			loginattempt.KeyEQ(key),
+			loginattempt.CreateTimeEQ(at),
+		).
+		FirstID(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return s.client.LoginAttempt.DeleteOneID(id).Exec(ctx)
+}
+
+func (s *EntStore) Clear(ctx context.Context, key string) error {
+	_, err := s.client.LoginAttempt.Delete().
+		Where(loginattempt.KeyEQ(key)).
+		Exec(ctx)
+	return err
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	_ = tx.Rollback()
+	return err
+}
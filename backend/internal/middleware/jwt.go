@@ -2,45 +2,105 @@ package middleware
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
-	"backend/internal/config"
 	"backend/pkg/errors"
 	"backend/pkg/jwt"
+	"backend/pkg/response"
 	"github.com/cloudwego/hertz/pkg/app"
 )
 
 const (
-	UserIDKey   = "user_id"
+	UserIDKey    = "user_id"
 	UserPhoneKey = "user_phone"
+	SessionIDKey = "session_id"
+	RoleKey      = "user_role"
+	PermsKey     = "user_permissions"
 )
 
-func JWT() app.HandlerFunc {
+// SessionChecker 判断 access token 所属的会话是否仍然有效。
+type SessionChecker interface {
+	IsSessionActive(ctx context.Context, userID, sessionID int) (bool, error)
+}
+
+// JWT 要求请求携带有效的 access token，否则直接返回 401。
+func JWT(jwtMgr *jwt.Manager, sessions SessionChecker) app.HandlerFunc {
 	return func(ctx context.Context, c *app.RequestContext) {
-		authHeader := c.GetHeader("Authorization")
-		if authHeader == "" {
-			c.JSON(401, errors.ErrUnauthorized)
-			c.Abort()
+		tokenString, ok := bearerToken(c)
+		if !ok {
+			c.AbortWithStatusJSON(401, response.Unauthorized(errors.ErrUnauthorized.Error()))
 			return
 		}
 
-		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-		if tokenString == authHeader {
-			c.JSON(401, errors.ErrUnauthorized)
-			c.Abort()
+		if err := authenticate(ctx, c, jwtMgr, sessions, tokenString); err != nil {
+			if err == errors.ErrInternalServer {
+				c.AbortWithStatusJSON(500, response.InternalServerError(err.Error()))
+				return
+			}
+			c.AbortWithStatusJSON(401, response.Unauthorized(err.Error()))
 			return
 		}
+		c.Next(ctx)
+	}
+}
 
-		jwtMgr := jwt.NewManager(config.AppConfig.JWTSecret)
-		claims, err := jwtMgr.ValidateToken(tokenString)
-		if err != nil {
-			c.JSON(401, errors.ErrTokenExpired)
-			c.Abort()
-			return
+// OptionalJWT 用于公开接口：带了有效 token 就注入当前用户以便个性化，
+// 没带或 token 无效都按游客处理，不会拦截请求。
+func OptionalJWT(jwtMgr *jwt.Manager, sessions SessionChecker) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		if tokenString, ok := bearerToken(c); ok {
+			_ = authenticate(ctx, c, jwtMgr, sessions, tokenString)
 		}
-
-		c.Set(UserIDKey, claims.UserID)
-		c.Set(UserPhoneKey, claims.Phone)
 		c.Next(ctx)
 	}
 }
+
+// CurrentUserID 返回鉴权中间件注入的当前用户 ID，游客返回 false。
+func CurrentUserID(c *app.RequestContext) (int, bool) {
+	userID := c.GetInt(UserIDKey)
+	return userID, userID != 0
+}
+
+// CurrentSessionID 返回当前 access token 所属的会话 ID，游客返回 false。
+func CurrentSessionID(c *app.RequestContext) (int, bool) {
+	sessionID := c.GetInt(SessionIDKey)
+	return sessionID, sessionID != 0
+}
+
+func bearerToken(c *app.RequestContext) (string, bool) {
+	authHeader := string(c.GetHeader("Authorization"))
+	tokenString := strings.TrimPrefix(authHeader, "Bearer ")
+	if authHeader == "" || tokenString == authHeader || tokenString == "" {
+		return "", false
+	}
+	return tokenString, true
+}
+
+func authenticate(ctx context.Context, c *app.RequestContext, jwtMgr *jwt.Manager, sessions SessionChecker, tokenString string) error {
+	claims, err := jwtMgr.ValidateAccessToken(tokenString)
+	if err != nil {
+		return errors.ErrTokenInvalid
+	}
+
+	userID, err := strconv.Atoi(claims.UserID)
+	if err != nil {
+		return errors.ErrUnauthorized
+	}
+
+	// 会话被登出或踢下线后，尚未过期的 access token 也要立即失效
+	active, err := sessions.IsSessionActive(ctx, userID, claims.SessionID)
+	if err != nil {
+		return errors.ErrInternalServer
+	}
+	if !active {
+		return errors.ErrTokenInvalid
+	}
+
+	c.Set(UserIDKey, userID)
+	c.Set(UserPhoneKey, claims.Phone)
+	c.Set(SessionIDKey, claims.SessionID)
+	c.Set(RoleKey, claims.Role)
+	c.Set(PermsKey, claims.Permissions)
+	return nil
+}
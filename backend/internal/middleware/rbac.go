@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"context"
+
+	"backend/pkg/errors"
+	"backend/pkg/response"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// RequireRole 要求当前用户是 roles 之一，必须挂在 JWT 之后。
+func RequireRole(roles ...string) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		role := CurrentRole(c)
+		for _, r := range roles {
+			if role == r {
+				c.Next(ctx)
+				return
+			}
+		}
+		abortForbidden(c)
+	}
+}
+
+// RequirePermission 要求当前用户拥有 perm 权限，必须挂在 JWT 之后。
+func RequirePermission(perm string) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		if !HasPermission(c, perm) {
+			abortForbidden(c)
+			return
+		}
+		c.Next(ctx)
+	}
+}
+
+// CurrentRole 返回当前用户的角色，游客返回空字符串。
+func CurrentRole(c *app.RequestContext) string {
+	return c.GetString(RoleKey)
+}
+
+// HasPermission 报告当前用户的 token 是否携带 perm 权限，供 handler 做细粒度判断。
+func HasPermission(c *app.RequestContext, perm string) bool {
+	for _, p := range c.GetStringSlice(PermsKey) {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+func abortForbidden(c *app.RequestContext) {
+	if _, ok := CurrentUserID(c); !ok {
+		c.AbortWithStatusJSON(401, response.Unauthorized(errors.ErrUnauthorized.Error()))
+		return
+	}
+	c.AbortWithStatusJSON(403, response.Forbidden(errors.ErrForbidden.Error()))
+}
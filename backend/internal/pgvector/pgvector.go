@@ -0,0 +1,115 @@
+// Package pgvector 提供 pgvector 扩展 vector 类型在 ent 中的字段类型，以及按余弦距离筛选、排序的查询修饰器。
+package pgvector
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Dimensions 是文章、问题 embedding 的维度，需与 embedding 模型的输出一致。
+// 修改后要重新生成 ent 代码，并清空、重建已有的 embedding。
+const Dimensions = 1536
+
+// SchemaType 返回 ent 字段在 PostgreSQL 中的列类型 vector(dim)。
+func SchemaType(dim int) map[string]string {
+	return map[string]string{dialect.Postgres: fmt.Sprintf("vector(%d)", dim)}
+}
+
+// Vector 对应 vector 列，nil 表示 NULL。
+type Vector []float32
+
+// Value 把向量编码成 pgvector 的文本格式 [1,2,3]。
+func (v Vector) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var b strings.Builder
+	b.Grow(len(v) * 10)
+	b.WriteByte('[')
+	for i, x := range v {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.FormatFloat(float64(x), 'f', -1, 32))
+	}
+	b.WriteByte(']')
+	return b.String(), nil
+}
+
+// Scan 解析 pgvector 的文本格式。
+func (v *Vector) Scan(src any) error {
+	var s string
+	switch src := src.(type) {
+	case nil:
+		*v = nil
+		return nil
+	case []byte:
+		s = string(src)
+	case string:
+		s = src
+	default:
+		return fmt.Errorf("pgvector: cannot scan %T into Vector", src)
+	}
+
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '[' || s[len(s)-1] != ']' {
+		return fmt.Errorf("pgvector: invalid vector %q", s)
+	}
+	s = s[1 : len(s)-1]
+	if s == "" {
+		*v = Vector{}
+		return nil
+	}
+	parts := strings.Split(s, ",")
+	out := make(Vector, len(parts))
+	for i, p := range parts {
+		x, err := strconv.ParseFloat(strings.TrimSpace(p), 32)
+		if err != nil {
+			return fmt.Errorf("pgvector: invalid element %q: %w", p, err)
+		}
+		out[i] = float32(x)
+	}
+	*v = out
+	return nil
+}
+
+// cosineDistance 写出 column <=> v，结果在 [0, 2] 之间，越小越相似。
+func cosineDistance(s *sql.Selector, column string, v Vector) func(*sql.Builder) {
+	return func(b *sql.Builder) {
+		b.Ident(s.C(column)).WriteString(" <=> ").Arg(v).WriteString("::vector")
+	}
+}
+
+// OrderByCosineDistance 按与 v 的余弦距离升序排列，最相似的在前。
+// 只有 ORDER BY 距离再加 LIMIT 的查询才会用到 HNSW/IVFFlat 索引。
+//
+//	client.Post.Query().
+//		Where(post.EmbeddingNotNil()).
+//		Order(post.OrderOption(pgvector.OrderByCosineDistance(post.FieldEmbedding, v))).
+//		Limit(10)
+func OrderByCosineDistance(column string, v Vector) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.OrderExpr(sql.ExprFunc(cosineDistance(s, column, v)))
+	}
+}
+
+// CosineDistanceLT 筛选与 v 的余弦距离小于 max 的记录，配合 predicate.Post 等类型转换使用。
+func CosineDistanceLT(column string, v Vector, max float64) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Wrap(cosineDistance(s, column, v)).WriteString(" < ").Arg(max)
+		}))
+	}
+}
+
+// SelectCosineDistance 把与 v 的余弦距离作为 as 列追加到查询结果中，配合 Modify 和 Scan 使用。
+func SelectCosineDistance(column string, v Vector, as string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.AppendSelectExprAs(sql.ExprFunc(cosineDistance(s, column, v)), as)
+	}
+}
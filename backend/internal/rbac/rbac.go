@@ -0,0 +1,56 @@
+package rbac
+
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+const (
+	// PermContentModerate 编辑、删除他人发布的文章、问题和评论
+	PermContentModerate = "content:moderate"
+	// PermTagManage 合并、重命名标签
+	PermTagManage = "tag:manage"
+	// PermUserManage 封禁、注销其他用户
+	PermUserManage = "user:manage"
+	// PermRoleGrant 授予或收回角色
+	PermRoleGrant = "role:grant"
+)
+
+var rolePermissions = map[string][]string{
+	RoleUser: {},
+	RoleModerator: {
+		PermContentModerate,
+		PermTagManage,
+	},
+	RoleAdmin: {
+		PermContentModerate,
+		PermTagManage,
+		PermUserManage,
+		PermRoleGrant,
+	},
+}
+
+// ValidRole 报告 role 是否是已定义的角色。
+func ValidRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+// Permissions 返回角色拥有的权限列表，未知角色没有任何权限。
+func Permissions(role string) []string {
+	perms := rolePermissions[role]
+	out := make([]string, len(perms))
+	copy(out, perms)
+	return out
+}
+
+// HasPermission 报告角色是否拥有 perm。
+func HasPermission(role, perm string) bool {
+	for _, p := range rolePermissions[role] {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package router
+
+import (
+	"backend/internal/handler"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+func RegisterAnswerRoutes(r *server.Hertz, answerHandler *handler.AnswerHandler, requireAuth, optionalAuth app.HandlerFunc) {
+	// 登录用户可以看到自己的投票
+	r.GET("/api/questions/:id/answers", optionalAuth, answerHandler.List)
+	r.GET("/api/answers/:id", optionalAuth, answerHandler.Detail)
+
+	r.POST("/api/questions/:id/answers", requireAuth, answerHandler.Create)
+	r.PUT("/api/questions/:id/accepted-answer", requireAuth, answerHandler.Accept)
+	r.DELETE("/api/questions/:id/accepted-answer", requireAuth, answerHandler.Unaccept)
+
+	answerGroup := r.Group("/api/answers", requireAuth)
+	{
+		answerGroup.PUT("/:id", answerHandler.Update)
+		answerGroup.DELETE("/:id", answerHandler.Delete)
+		answerGroup.PUT("/:id/vote", answerHandler.Vote)
+	}
+}
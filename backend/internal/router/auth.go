@@ -3,13 +3,24 @@ package router
 import (
 	"backend/internal/handler"
 
+	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/app/server"
 )
 
-func RegisterAuthRoutes(r *server.Hertz, authHandler *handler.AuthHandler) {
+func RegisterAuthRoutes(r *server.Hertz, authHandler *handler.AuthHandler, requireAuth app.HandlerFunc) {
 	authGroup := r.Group("/api/auth")
 	{
 		authGroup.POST("/login", authHandler.Login)
 		authGroup.POST("/refresh", authHandler.RefreshToken)
+		authGroup.POST("/sms/send", authHandler.SendCode)
+		authGroup.POST("/sms/login", authHandler.LoginWithCode)
+		authGroup.POST("/password/reset", authHandler.ResetPassword)
+	}
+
+	sessionGroup := r.Group("/api/auth", requireAuth)
+	{
+		sessionGroup.POST("/logout", authHandler.Logout)
+		sessionGroup.GET("/sessions", authHandler.ListSessions)
+		sessionGroup.DELETE("/sessions/:id", authHandler.RevokeSession)
 	}
 }
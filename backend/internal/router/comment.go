@@ -0,0 +1,25 @@
+package router
+
+import (
+	"backend/internal/handler"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+func RegisterCommentRoutes(r *server.Hertz, commentHandler *handler.CommentHandler, requireAuth app.HandlerFunc) {
+	r.GET("/api/posts/:id/comments", commentHandler.ListPostComments)
+	r.GET("/api/questions/:id/comments", commentHandler.ListQuestionComments)
+	r.GET("/api/answers/:id/comments", commentHandler.ListAnswerComments)
+	r.GET("/api/comments/:id/replies", commentHandler.ListReplies)
+
+	r.POST("/api/posts/:id/comments", requireAuth, commentHandler.CreatePostComment)
+	r.POST("/api/questions/:id/comments", requireAuth, commentHandler.CreateQuestionComment)
+	r.POST("/api/answers/:id/comments", requireAuth, commentHandler.CreateAnswerComment)
+
+	commentGroup := r.Group("/api/comments", requireAuth)
+	{
+		commentGroup.PUT("/:id", commentHandler.Update)
+		commentGroup.DELETE("/:id", commentHandler.Delete)
+	}
+}
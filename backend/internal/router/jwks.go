@@ -0,0 +1,11 @@
+package router
+
+import (
+	"backend/internal/handler"
+
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+func RegisterJWKSRoutes(r *server.Hertz, jwksHandler *handler.JWKSHandler) {
+	r.GET("/.well-known/jwks.json", jwksHandler.Keys)
+}
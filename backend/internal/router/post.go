@@ -0,0 +1,23 @@
+package router
+
+import (
+	"backend/internal/handler"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+func RegisterPostRoutes(r *server.Hertz, postHandler *handler.PostHandler, requireAuth, optionalAuth app.HandlerFunc) {
+	postGroup := r.Group("/api/posts", optionalAuth)
+	{
+		postGroup.GET("", postHandler.List)
+		postGroup.GET("/:id", postHandler.Detail)
+	}
+
+	authorGroup := r.Group("/api/posts", requireAuth)
+	{
+		authorGroup.POST("/publish", postHandler.Publish)
+		authorGroup.PUT("/:id", postHandler.Update)
+		authorGroup.DELETE("/:id", postHandler.Delete)
+	}
+}
@@ -0,0 +1,23 @@
+package router
+
+import (
+	"backend/internal/handler"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+func RegisterQuestionRoutes(r *server.Hertz, questionHandler *handler.QuestionHandler, requireAuth, optionalAuth app.HandlerFunc) {
+	questionGroup := r.Group("/api/questions", optionalAuth)
+	{
+		questionGroup.GET("", questionHandler.List)
+		questionGroup.GET("/:id", questionHandler.Detail)
+	}
+
+	authorGroup := r.Group("/api/questions", requireAuth)
+	{
+		authorGroup.POST("/publish", questionHandler.Publish)
+		authorGroup.PUT("/:id", questionHandler.Update)
+		authorGroup.DELETE("/:id", questionHandler.Delete)
+	}
+}
@@ -3,19 +3,66 @@ package router
 import (
 	"backend/internal/handler"
 
+	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/app/server"
 )
 
 type Router struct {
-	authHandler *handler.AuthHandler
+	authHandler     *handler.AuthHandler
+	userHandler     *handler.UserHandler
+	jwksHandler     *handler.JWKSHandler
+	postHandler     *handler.PostHandler
+	questionHandler *handler.QuestionHandler
+	commentHandler  *handler.CommentHandler
+	answerHandler   *handler.AnswerHandler
+	timelineHandler *handler.TimelineHandler
+	tagHandler      *handler.TagHandler
+	searchHandler   *handler.SearchHandler
+
+	// requireAuth 用于必须登录的路由组，optionalAuth 用于登录后可个性化的公开路由
+	requireAuth  app.HandlerFunc
+	optionalAuth app.HandlerFunc
 }
 
-func NewRouter(authHandler *handler.AuthHandler) *Router {
+func NewRouter(
+	authHandler *handler.AuthHandler,
+	userHandler *handler.UserHandler,
+	jwksHandler *handler.JWKSHandler,
+	postHandler *handler.PostHandler,
+	questionHandler *handler.QuestionHandler,
+	commentHandler *handler.CommentHandler,
+	answerHandler *handler.AnswerHandler,
+	timelineHandler *handler.TimelineHandler,
+	tagHandler *handler.TagHandler,
+	searchHandler *handler.SearchHandler,
+	requireAuth app.HandlerFunc,
+	optionalAuth app.HandlerFunc,
+) *Router {
 	return &Router{
-		authHandler: authHandler,
+		authHandler:     authHandler,
+		userHandler:     userHandler,
+		jwksHandler:     jwksHandler,
+		postHandler:     postHandler,
+		questionHandler: questionHandler,
+		commentHandler:  commentHandler,
+		answerHandler:   answerHandler,
+		timelineHandler: timelineHandler,
+		tagHandler:      tagHandler,
+		searchHandler:   searchHandler,
+		requireAuth:     requireAuth,
+		optionalAuth:    optionalAuth,
 	}
 }
 
 func (r *Router) Register(h *server.Hertz) {
-	RegisterAuthRoutes(h, r.authHandler)
+	RegisterAuthRoutes(h, r.authHandler, r.requireAuth)
+	RegisterUserRoutes(h, r.userHandler, r.requireAuth, r.optionalAuth)
+	RegisterJWKSRoutes(h, r.jwksHandler)
+	RegisterPostRoutes(h, r.postHandler, r.requireAuth, r.optionalAuth)
+	RegisterQuestionRoutes(h, r.questionHandler, r.requireAuth, r.optionalAuth)
+	RegisterCommentRoutes(h, r.commentHandler, r.requireAuth)
+	RegisterAnswerRoutes(h, r.answerHandler, r.requireAuth, r.optionalAuth)
+	RegisterTimelineRoutes(h, r.timelineHandler, r.requireAuth)
+	RegisterTagRoutes(h, r.tagHandler, r.requireAuth, r.optionalAuth)
+	RegisterSearchRoutes(h, r.searchHandler, r.optionalAuth)
 }
@@ -0,0 +1,16 @@
+package router
+
+import (
+	"backend/internal/handler"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+func RegisterSearchRoutes(r *server.Hertz, searchHandler *handler.SearchHandler, optionalAuth app.HandlerFunc) {
+	aiGroup := r.Group("/api/ai")
+	{
+		aiGroup.GET("/search", optionalAuth, searchHandler.Search)
+		aiGroup.GET("/getSearchSuggestions", searchHandler.Suggestions)
+	}
+}
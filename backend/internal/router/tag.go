@@ -0,0 +1,27 @@
+package router
+
+import (
+	"backend/internal/handler"
+	"backend/internal/middleware"
+	"backend/internal/rbac"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+func RegisterTagRoutes(r *server.Hertz, tagHandler *handler.TagHandler, requireAuth, optionalAuth app.HandlerFunc) {
+	tagGroup := r.Group("/api/tags")
+	{
+		tagGroup.GET("/suggest", tagHandler.Suggest)
+		tagGroup.GET("/trending", tagHandler.Trending)
+		tagGroup.GET("/lookup", tagHandler.Lookup)
+		tagGroup.GET("/:id", tagHandler.Detail)
+		tagGroup.GET("/:id/posts", optionalAuth, tagHandler.Posts)
+		tagGroup.GET("/:id/questions", optionalAuth, tagHandler.Questions)
+	}
+
+	adminGroup := r.Group("/api/tags", requireAuth, middleware.RequirePermission(rbac.PermTagManage))
+	{
+		adminGroup.POST("/:id/merge", tagHandler.Merge)
+	}
+}
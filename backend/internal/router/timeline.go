@@ -0,0 +1,18 @@
+package router
+
+import (
+	"backend/internal/handler"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+func RegisterTimelineRoutes(r *server.Hertz, timelineHandler *handler.TimelineHandler, requireAuth app.HandlerFunc) {
+	// 静态路径 following 优先于 /api/user/:id 匹配
+	timelineGroup := r.Group("/api/user/following", requireAuth)
+	{
+		timelineGroup.GET("/feed", timelineHandler.Feed)
+		timelineGroup.GET("/posts", timelineHandler.Posts)
+		timelineGroup.GET("/questions", timelineHandler.Questions)
+	}
+}
@@ -0,0 +1,38 @@
+package router
+
+import (
+	"backend/internal/handler"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+func RegisterUserRoutes(r *server.Hertz, userHandler *handler.UserHandler, requireAuth, optionalAuth app.HandlerFunc) {
+	userGroup := r.Group("/api/user")
+	{
+		userGroup.POST("/register", userHandler.Register)
+	}
+
+	// 登录用户可以看到自己是否已关注列表中的人
+	followListGroup := r.Group("/api/user", optionalAuth)
+	{
+		followListGroup.GET("/:id/following", userHandler.Following)
+		followListGroup.GET("/:id/followers", userHandler.Followers)
+	}
+
+	// 点赞、收藏和关注沿用前端已有的路径，重复提交不会产生重复记录
+	interactionGroup := r.Group("/api/user", requireAuth)
+	{
+		interactionGroup.POST("/like-post", userHandler.LikePost)
+		interactionGroup.POST("/favorite-post", userHandler.FavoritePost)
+		interactionGroup.POST("/like-question", userHandler.LikeQuestion)
+		interactionGroup.POST("/favorite-question", userHandler.FavoriteQuestion)
+		interactionGroup.POST("/follow", userHandler.Follow)
+	}
+
+	meGroup := r.Group("/api/user/me", requireAuth)
+	{
+		meGroup.GET("/export", userHandler.ExportData)
+		meGroup.DELETE("", userHandler.DeleteAccount)
+	}
+}
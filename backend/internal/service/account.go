@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"backend/internal/ent"
+	"backend/internal/ent/answer"
+	"backend/internal/ent/answervote"
+	"backend/internal/ent/comment"
+	"backend/internal/ent/follow"
+	"backend/internal/ent/refreshtoken"
+	"backend/internal/ent/session"
+	"backend/internal/ent/user"
+	"backend/internal/ent/userfavoritepost"
+	"backend/internal/ent/userfavoritequestion"
+	"backend/internal/ent/userlikepost"
+	"backend/internal/ent/userlikequestion"
+	"backend/internal/ent/verificationcode"
+	"backend/pkg/errors"
+)
+
+const (
+	// AccountDeletionGrace 是申请注销到数据被清除之间的宽限期
+	AccountDeletionGrace = 15 * 24 * time.Hour
+
+	deletedNickname = "已注销用户"
+	// unusablePassword 不是合法的 bcrypt 哈希，也短于最短密码长度，任何输入都无法匹配
+	unusablePassword = "!"
+)
+
+type DeletionResponse struct {
+	RequestedAt time.Time `json:"requestedAt"`
+	PurgeAt     time.Time `json:"purgeAt"`
+}
+
+// RequestDeletion 校验密码后登记注销申请，并让该用户全端下线。
+// 宽限期内重新登录会撤销申请，过期后由 PurgeDeletedAccounts 清除数据。
+func (s *UserService) RequestDeletion(ctx context.Context, userID int, password string) (*DeletionResponse, error) {
+	u, err := s.client.User.Query().
+		Where(user.IDEQ(userID), user.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrNotFound
+		}
+		return nil, errors.ErrInternalServer
+	}
+
+	if ok, _ := verifyPassword(u.Password, password); !ok {
+		return nil, errors.ErrInvalidCredentials
+	}
+
+	// 重复申请不会推迟清除时间
+	requestedAt := time.Now()
+	if u.DeletionRequestedAt != nil {
+		requestedAt = *u.DeletionRequestedAt
+	}
+
+	if err := s.markForDeletion(ctx, userID, requestedAt); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	return &DeletionResponse{
+		RequestedAt: requestedAt,
+		PurgeAt:     requestedAt.Add(AccountDeletionGrace),
+	}, nil
+}
+
+func (s *UserService) markForDeletion(ctx context.Context, userID int, requestedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := tx.User.UpdateOneID(userID).SetDeletionRequestedAt(requestedAt).Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+	if err := tx.Session.Update().
+		Where(session.UserIDEQ(userID), session.RevokedAtIsNil()).
+		SetRevokedAt(now).
+		Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+	if err := tx.RefreshToken.Update().
+		Where(refreshtoken.UserIDEQ(userID), refreshtoken.RevokedAtIsNil()).
+		SetRevokedAt(now).
+		Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+// PurgeDeletedAccounts 清除所有宽限期已过的注销账号，返回清除的数量。
+// 单个账号失败不影响其余账号，下一轮会重试。
+func (s *UserService) PurgeDeletedAccounts(ctx context.Context) (int, error) {
+	ids, err := s.client.User.Query().
+		Where(
+			user.DeletionRequestedAtLT(time.Now().Add(-AccountDeletionGrace)),
+			user.DeletedAtIsNil(),
+		).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, id := range ids {
+		if err := s.purgeAccount(ctx, id); err != nil {
+			log.Printf("purge account %d: %v", id, err)
+			continue
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// purgeAccount 在一个事务里清除账号的全部数据：
+// 本人发布的文章、问题连同其下的评论、点赞、收藏、标签一并删除；
+// 本人的点赞、收藏、关注关系、会话删除；在他人内容下的评论变为墓碑以保留楼层；
+// 用户行本身匿名化保留，避免评论失去作者。
+func (s *UserService) purgeAccount(ctx context.Context, userID int) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 先以条件更新占住用户行：期间用户若已登录撤销申请，这里更新不到任何行，直接放弃；
+	// 行锁同时让并发的撤销操作等到本事务结束
+	n, err := tx.User.Update().
+		Where(
+			user.IDEQ(userID),
+			user.DeletedAtIsNil(),
+			user.DeletionRequestedAtLT(time.Now().Add(-AccountDeletionGrace)),
+		).
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if n == 0 {
+		return rollback(tx, nil)
+	}
+
+	u, err := tx.User.Get(ctx, userID)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	postIDs, err := tx.User.QueryPosts(u).IDs(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	questionIDs, err := tx.User.QueryQuestions(u).IDs(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	answerIDs, err := tx.Answer.Query().Where(answer.UserIDEQ(userID)).IDs(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	votedAnswerIDs, err := tx.AnswerVote.Query().
+		Where(answervote.UserIDEQ(userID)).
+		Select(answervote.FieldAnswerID).
+		Ints(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	steps := []func() error{
+		// 本人内容连同其下的互动
+		func() error {
+			return deletePosts(ctx, tx, postIDs)
+		},
+		func() error {
+			return deleteQuestions(ctx, tx, questionIDs)
+		},
+		func() error {
+			return deleteAnswers(ctx, tx, answerIDs)
+		},
+		// 本人的互动与关系
+		func() error {
+			_, err := tx.UserLikePost.Delete().Where(userlikepost.UserIDEQ(userID)).Exec(ctx)
+			return err
+		},
+		func() error {
+			_, err := tx.UserFavoritePost.Delete().Where(userfavoritepost.UserIDEQ(userID)).Exec(ctx)
+			return err
+		},
+		func() error {
+			_, err := tx.UserLikeQuestion.Delete().Where(userlikequestion.UserIDEQ(userID)).Exec(ctx)
+			return err
+		},
+		func() error {
+			_, err := tx.UserFavoriteQuestion.Delete().Where(userfavoritequestion.UserIDEQ(userID)).Exec(ctx)
+			return err
+		},
+		// 撤回投票后重新汇总被投回答的票数
+		func() error {
+			if _, err := tx.AnswerVote.Delete().Where(answervote.UserIDEQ(userID)).Exec(ctx); err != nil {
+				return err
+			}
+			return recountVoteScores(ctx, tx, votedAnswerIDs)
+		},
+		func() error {
+			_, err := tx.Follow.Delete().
+				Where(follow.Or(follow.FollowerIDEQ(userID), follow.FollowingIDEQ(userID))).
+				Exec(ctx)
+			return err
+		},
+		func() error {
+			return tx.Comment.Update().
+				Where(comment.UserIDEQ(userID), comment.DeletedAtIsNil()).
+				SetContent("").
+				SetDeletedAt(time.Now()).
+				Exec(ctx)
+		},
+		// 登录凭据
+		func() error {
+			_, err := tx.RefreshToken.Delete().Where(refreshtoken.UserIDEQ(userID)).Exec(ctx)
+			return err
+		},
+		func() error {
+			_, err := tx.Session.Delete().Where(session.UserIDEQ(userID)).Exec(ctx)
+			return err
+		},
+		func() error {
+			_, err := tx.VerificationCode.Delete().Where(verificationcode.PhoneEQ(u.Phone)).Exec(ctx)
+			return err
+		},
+		// 手机号换成不可能注册的占位值，原号码可以重新注册
+		func() error {
+			return tx.User.UpdateOne(u).
+				SetPhone(fmt.Sprintf("d%010d", u.ID)).
+				SetNickname(deletedNickname).
+				SetPassword(unusablePassword).
+				ClearAvatar().
+				SetRole(user.RoleUser).
+				Exec(ctx)
+		},
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return rollback(tx, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// cancelDeletion 撤销尚在宽限期内的注销申请，登录成功时调用。
+func cancelDeletion(ctx context.Context, client *ent.Client, u *ent.User) error {
+	if u.DeletionRequestedAt == nil {
+		return nil
+	}
+	return client.User.Update().
+		Where(user.IDEQ(u.ID), user.DeletedAtIsNil()).
+		ClearDeletionRequestedAt().
+		Exec(ctx)
+}
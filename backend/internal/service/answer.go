@@ -0,0 +1,440 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"backend/internal/ent"
+	"backend/internal/ent/answer"
+	"backend/internal/ent/answervote"
+	"backend/internal/ent/comment"
+	"backend/internal/ent/question"
+	"backend/pkg/errors"
+)
+
+// 回答列表的排序方式
+const (
+	AnswerSortVotes = "votes"
+	AnswerSortTime  = "time"
+)
+
+type AnswerService struct {
+	client *ent.Client
+}
+
+func NewAnswerService(client *ent.Client) *AnswerService {
+	return &AnswerService{client: client}
+}
+
+type AnswerItem struct {
+	ID            int       `json:"id"`
+	QuestionID    int       `json:"questionId"`
+	Content       string    `json:"content"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+	User          *Author   `json:"user"`
+	VoteScore     int       `json:"voteScore"`
+	TotalComments int       `json:"totalComments"`
+	Accepted      bool      `json:"accepted"`
+	// MyVote 是当前用户的投票：1 赞成，-1 反对，0 未投票或未登录
+	MyVote int `json:"myVote"`
+}
+
+type AnswerPage struct {
+	// Accepted 是被采纳的回答，只在第一页返回，Answers 中不再重复出现
+	Accepted   *AnswerItem   `json:"accepted,omitempty"`
+	Answers    []*AnswerItem `json:"answers"`
+	NextCursor string        `json:"nextCursor"`
+}
+
+// Create 回答问题，作者取自登录态。
+func (s *AnswerService) Create(ctx context.Context, userID, questionID int, content string) (*AnswerItem, error) {
+	exists, err := s.client.Question.Query().Where(question.IDEQ(questionID)).Exist(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	if !exists {
+		return nil, errors.ErrQuestionNotFound
+	}
+
+	// 在事务中写入，计数 hook 对问题回答数的更新随回答一起提交
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	a, err := tx.Answer.Create().
+		SetQuestionID(questionID).
+		SetUserID(userID).
+		SetContent(content).
+		Save(ctx)
+	if err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	return s.Get(ctx, userID, a.ID)
+}
+
+// Get 返回单个回答，viewerID 为 0 表示游客。
+func (s *AnswerService) Get(ctx context.Context, viewerID, id int) (*AnswerItem, error) {
+	a, err := s.client.Answer.Query().
+		Where(answer.IDEQ(id)).
+		WithUser().
+		WithQuestion().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrAnswerNotFound
+		}
+		return nil, errors.ErrInternalServer
+	}
+
+	acceptedID := 0
+	if q := a.Edges.Question; q.AcceptedAnswerID != nil {
+		acceptedID = *q.AcceptedAnswerID
+	}
+	items, err := s.buildItems(ctx, viewerID, []*ent.Answer{a}, acceptedID)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	return items[0], nil
+}
+
+// List 分页返回问题下的回答，sort 为 votes（默认）时按票数倒序，为 time 时按发布时间倒序。
+// 按票数翻页期间若票数变化，个别回答可能重复或错过，这里不做额外处理。
+func (s *AnswerService) List(ctx context.Context, viewerID, questionID int, sort, cursor string, limit int) (*AnswerPage, error) {
+	q, err := s.client.Question.Get(ctx, questionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrQuestionNotFound
+		}
+		return nil, errors.ErrInternalServer
+	}
+	limit = feedLimit(limit)
+
+	acceptedID := 0
+	if q.AcceptedAnswerID != nil {
+		acceptedID = *q.AcceptedAnswerID
+	}
+
+	query := s.client.Answer.Query().
+		Where(answer.QuestionIDEQ(questionID), answer.IDNEQ(acceptedID)).
+		WithUser()
+
+	var nextCursor func(a *ent.Answer) string
+	if sort == AnswerSortTime {
+		after, err := DecodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		if after != nil {
+			query.Where(answer.Or(
+				answer.CreateTimeLT(after.CreateTime),
+				answer.And(answer.CreateTimeEQ(after.CreateTime), answer.IDLT(after.ID)),
+			))
+		}
+		query.Order(ent.Desc(answer.FieldCreateTime), ent.Desc(answer.FieldID))
+		nextCursor = func(a *ent.Answer) string {
+			return EncodeCursor(Cursor{CreateTime: a.CreateTime, ID: a.ID})
+		}
+	} else {
+		after, err := DecodeScoreCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		if after != nil {
+			query.Where(answer.Or(
+				answer.VoteScoreLT(after.Score),
+				answer.And(answer.VoteScoreEQ(after.Score), answer.IDLT(after.ID)),
+			))
+		}
+		query.Order(ent.Desc(answer.FieldVoteScore), ent.Desc(answer.FieldID))
+		nextCursor = func(a *ent.Answer) string {
+			return EncodeScoreCursor(ScoreCursor{Score: a.VoteScore, ID: a.ID})
+		}
+	}
+
+	// 多取一条用来判断是否还有下一页
+	answers, err := query.Limit(limit + 1).All(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	page := &AnswerPage{}
+	if len(answers) > limit {
+		answers = answers[:limit]
+		page.NextCursor = nextCursor(answers[limit-1])
+	}
+
+	// 第一页把采纳的回答置顶，和本页回答一起组装
+	if cursor == "" && acceptedID != 0 {
+		accepted, err := s.client.Answer.Query().
+			Where(answer.IDEQ(acceptedID)).
+			WithUser().
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, errors.ErrInternalServer
+		}
+		if accepted != nil {
+			answers = append([]*ent.Answer{accepted}, answers...)
+		}
+	}
+
+	items, err := s.buildItems(ctx, viewerID, answers, acceptedID)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	if len(items) > 0 && items[0].Accepted {
+		page.Accepted = items[0]
+		items = items[1:]
+	}
+	page.Answers = items
+	return page, nil
+}
+
+// Update 修改回答内容，只有作者或内容审核员可以修改。
+func (s *AnswerService) Update(ctx context.Context, userID int, canModerate bool, id int, content string) (*AnswerItem, error) {
+	if _, err := s.checkOwner(ctx, userID, canModerate, id); err != nil {
+		return nil, err
+	}
+	if err := s.client.Answer.UpdateOneID(id).SetContent(content).Exec(ctx); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	return s.Get(ctx, userID, id)
+}
+
+// Delete 删除回答及其投票和评论，被采纳的回答删除后问题恢复为未采纳。
+func (s *AnswerService) Delete(ctx context.Context, userID int, canModerate bool, id int) error {
+	if _, err := s.checkOwner(ctx, userID, canModerate, id); err != nil {
+		return err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return errors.ErrInternalServer
+	}
+	if err := deleteAnswers(ctx, tx, []int{id}); err != nil {
+		return txFailed(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.ErrInternalServer
+	}
+	return nil
+}
+
+// Vote 给回答投票，value 为 1 赞成、-1 反对、0 取消；重复提交同一票不会重复计数。
+func (s *AnswerService) Vote(ctx context.Context, userID, id, value int) (*AnswerItem, error) {
+	a, err := s.client.Answer.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrAnswerNotFound
+		}
+		return nil, errors.ErrInternalServer
+	}
+	if a.UserID == userID {
+		return nil, errors.ErrVoteOwnAnswer
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	if value == 0 {
+		_, err = tx.AnswerVote.Delete().
+			Where(answervote.AnswerIDEQ(id), answervote.UserIDEQ(userID)).
+			Exec(ctx)
+	} else {
+		err = tx.AnswerVote.Create().
+			SetAnswerID(id).
+			SetUserID(userID).
+			SetValue(value).
+			OnConflictColumns(answervote.FieldAnswerID, answervote.FieldUserID).
+			UpdateValue().
+			UpdateUpdateTime().
+			Exec(ctx)
+	}
+	if err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if err := recountVoteScores(ctx, tx, []int{id}); err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	return s.Get(ctx, userID, id)
+}
+
+// Accept 采纳问题下的一个回答，只有提问者可以采纳，重新采纳会替换之前的选择。
+func (s *AnswerService) Accept(ctx context.Context, userID, questionID, answerID int) (*AnswerItem, error) {
+	if err := s.checkAsker(ctx, userID, questionID); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.client.Answer.Query().
+		Where(answer.IDEQ(answerID), answer.QuestionIDEQ(questionID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	if !exists {
+		return nil, errors.ErrAnswerNotFound
+	}
+
+	if err := s.client.Question.UpdateOneID(questionID).
+		SetAcceptedAnswerID(answerID).
+		Exec(ctx); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	return s.Get(ctx, userID, answerID)
+}
+
+// Unaccept 撤销采纳，只有提问者可以操作。
+func (s *AnswerService) Unaccept(ctx context.Context, userID, questionID int) error {
+	if err := s.checkAsker(ctx, userID, questionID); err != nil {
+		return err
+	}
+	if err := s.client.Question.UpdateOneID(questionID).
+		ClearAcceptedAnswerID().
+		Exec(ctx); err != nil {
+		return errors.ErrInternalServer
+	}
+	return nil
+}
+
+func (s *AnswerService) checkOwner(ctx context.Context, userID int, canModerate bool, id int) (*ent.Answer, error) {
+	a, err := s.client.Answer.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrAnswerNotFound
+		}
+		return nil, errors.ErrInternalServer
+	}
+	if a.UserID != userID && !canModerate {
+		return nil, errors.ErrForbidden
+	}
+	return a, nil
+}
+
+func (s *AnswerService) checkAsker(ctx context.Context, userID, questionID int) error {
+	q, err := s.client.Question.Get(ctx, questionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.ErrQuestionNotFound
+		}
+		return errors.ErrInternalServer
+	}
+	if q.UserID != userID {
+		return errors.ErrForbidden
+	}
+	return nil
+}
+
+// buildItems 组装回答，评论数和当前用户的投票各用一条查询批量加载。
+// 调用方需要预先加载作者（WithUser）。
+func (s *AnswerService) buildItems(ctx context.Context, viewerID int, answers []*ent.Answer, acceptedID int) ([]*AnswerItem, error) {
+	items := make([]*AnswerItem, 0, len(answers))
+	if len(answers) == 0 {
+		return items, nil
+	}
+
+	ids := make([]int, len(answers))
+	for i, a := range answers {
+		ids[i] = a.ID
+	}
+
+	var counts []countRow
+	err := s.client.Answer.Query().
+		Where(answer.IDIn(ids...)).
+		Modify(withChildCounts(answer.FieldID, ids,
+			childCount{table: comment.Table, column: comment.FieldAnswerID, until: comment.FieldDeletedAt},
+		)).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+	commentCounts := make(map[int]int, len(counts))
+	for _, c := range counts {
+		commentCounts[c.ID] = c.C0
+	}
+
+	myVotes := make(map[int]int)
+	if viewerID != 0 {
+		votes, err := s.client.AnswerVote.Query().
+			Where(answervote.UserIDEQ(viewerID), answervote.AnswerIDIn(ids...)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range votes {
+			myVotes[v.AnswerID] = v.Value
+		}
+	}
+
+	for _, a := range answers {
+		items = append(items, &AnswerItem{
+			ID:            a.ID,
+			QuestionID:    a.QuestionID,
+			Content:       a.Content,
+			CreatedAt:     a.CreateTime,
+			UpdatedAt:     a.UpdateTime,
+			User:          toAuthor(a.Edges.User),
+			VoteScore:     a.VoteScore,
+			TotalComments: commentCounts[a.ID],
+			Accepted:      a.ID == acceptedID,
+			MyVote:        myVotes[a.ID],
+		})
+	}
+	return items, nil
+}
+
+// recountVoteScores 按 answer_votes 重新汇总回答的票数。
+// 直接在 UPDATE 里求和而不是在内存里加减，并发投票时也不会算错；
+// update_time 保持不变，它只反映作者对内容的修改。
+func recountVoteScores(ctx context.Context, tx *ent.Tx, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return tx.Answer.Update().
+		Where(answer.IDIn(ids...)).
+		Modify(func(u *sql.UpdateBuilder) {
+			a := sql.Table(answer.Table)
+			v := sql.Table(answervote.Table)
+			sum := sql.Select(fmt.Sprintf("COALESCE(SUM(%s), 0)", v.C(answervote.FieldValue))).
+				From(v).
+				Where(sql.ColumnsEQ(v.C(answervote.FieldAnswerID), a.C(answer.FieldID)))
+			u.Set(answer.FieldVoteScore, sql.ExprFunc(func(b *sql.Builder) {
+				b.Wrap(func(b *sql.Builder) { b.Join(sum) })
+			}))
+			u.Set(answer.FieldUpdateTime, sql.ExprFunc(func(b *sql.Builder) {
+				b.Ident(answer.FieldUpdateTime)
+			}))
+		}).
+		Exec(ctx)
+}
+
+// deleteAnswers 在事务内删除回答以及挂在回答上的投票、评论，并撤销对它们的采纳。
+func deleteAnswers(ctx context.Context, tx *ent.Tx, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if _, err := tx.AnswerVote.Delete().Where(answervote.AnswerIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.Comment.Delete().Where(comment.AnswerIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	if err := tx.Question.Update().
+		Where(question.AcceptedAnswerIDIn(ids...)).
+		ClearAcceptedAnswerID().
+		Exec(ctx); err != nil {
+		return err
+	}
+	_, err := tx.Answer.Delete().Where(answer.IDIn(ids...)).Exec(ctx)
+	return err
+}
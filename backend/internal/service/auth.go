@@ -3,10 +3,15 @@ package service
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
-	"backend/internal/config"
 	"backend/internal/ent"
+	"backend/internal/ent/refreshtoken"
+	"backend/internal/ent/session"
 	"backend/internal/ent/user"
+	"backend/internal/loginguard"
+	"backend/internal/rbac"
 	"backend/pkg/errors"
 	"backend/pkg/jwt"
 )
@@ -14,12 +19,16 @@ import (
 type AuthService struct {
 	client *ent.Client
 	jwtMgr *jwt.Manager
+	codes  *VerificationService
+	guard  *loginguard.Guard
 }
 
-func NewAuthService(client *ent.Client) *AuthService {
+func NewAuthService(client *ent.Client, jwtMgr *jwt.Manager, codes *VerificationService, guard *loginguard.Guard) *AuthService {
 	return &AuthService{
 		client: client,
-		jwtMgr: jwt.NewManager(config.AppConfig.JWTSecret),
+		jwtMgr: jwtMgr,
+		codes:  codes,
+		guard:  guard,
 	}
 }
 
@@ -33,6 +42,7 @@ type UserDetail struct {
 	ID                string `json:"id"`
 	Nickname          string `json:"nickname"`
 	Phone             string `json:"phone"`
+	Role              string `json:"role"`
 	Following         []int  `json:"following"`
 	LikePosts         []int  `json:"likePosts"`
 	FavoritePosts     []int  `json:"favoritePosts"`
@@ -42,44 +52,125 @@ type UserDetail struct {
 	FollowerCount     int    `json:"followerCount"`
 }
 
-func (s *AuthService) Login(ctx context.Context, phone, password string) (*LoginResponse, error) {
-	// 1. 查询用户及所有关联关系
-	u, err := s.client.User.
-		Query().
-		Where(user.PhoneEQ(phone)).
-		WithFollowing().
-		WithFollowedBy(). // 必须加上，否则计算 FollowerCount 会报错
-		WithLikePosts(func(q *ent.UserLikePostQuery) {
-			q.WithPost()
-		}).
-		WithFavoritePosts(func(q *ent.UserFavoritePostQuery) {
-			q.WithPost()
-		}).
-		WithLikeQuestions(func(q *ent.UserLikeQuestionQuery) {
-			q.WithQuestion()
-		}).
-		WithFavoriteQuestions(func(q *ent.UserFavoriteQuestionQuery) {
-			q.WithQuestion()
-		}).
-		Only(ctx)
+func (s *AuthService) Login(ctx context.Context, phone, password string, info *ClientInfo) (*LoginResponse, error) {
+	ip := ""
+	if info != nil {
+		ip = info.IP
+	}
+
+	// 1. 防爆破：先把这次登录记为一次失败，按近期失败次数递增延迟，超过阈值直接锁定；
+	// 登录成功或中途出错时再撤销，失败时保留
+	attempt, err := s.guard.Check(ctx, phone, ip)
+	if err != nil {
+		return nil, err
+	}
 
+	// 2. 密码校验：兼容历史明文密码，校验通过后顺手升级为 bcrypt；
+	// 手机号不存在时同样做一次 bcrypt，保证两种失败的耗时和返回一致
+	u, err := s.client.User.Query().Where(user.PhoneEQ(phone)).Only(ctx)
 	if err != nil {
+		if !ent.IsNotFound(err) {
+			s.guard.Release(ctx, attempt)
+			return nil, errors.ErrInternalServer
+		}
+		burnPasswordCheck(password)
 		return nil, errors.ErrInvalidCredentials
 	}
 
-	// 2. 密码校验 (暂时改为明文比对以匹配你数据库里的 123456)
-	// 等之后写了注册接口，记得换回 bcrypt.CompareHashAndPassword
-	if u.Password != password {
+	ok, needsRehash := verifyPassword(u.Password, password)
+	if !ok {
 		return nil, errors.ErrInvalidCredentials
 	}
+	s.guard.Succeed(ctx, attempt)
+
+	if needsRehash {
+		hashed, err := hashPassword(password)
+		if err != nil {
+			return nil, errors.ErrInternalServer
+		}
+		if err := s.client.User.UpdateOne(u).SetPassword(hashed).Exec(ctx); err != nil {
+			return nil, errors.ErrInternalServer
+		}
+	}
+
+	// 3. 查询用户及所有关联关系
+	u, err = s.queryUserDetail(ctx, phone)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	return s.completeLogin(ctx, u, info)
+}
+
+// LoginWithCode 使用短信验证码登录，成功后与密码登录一样开启新会话。
+func (s *AuthService) LoginWithCode(ctx context.Context, phone, code string, info *ClientInfo) (*LoginResponse, error) {
+	if err := s.codes.VerifyCode(ctx, phone, CodePurposeLogin, code); err != nil {
+		return nil, err
+	}
+
+	u, err := s.queryUserDetail(ctx, phone)
+	if err != nil {
+		return nil, errors.ErrCodeInvalid
+	}
+
+	return s.completeLogin(ctx, u, info)
+}
+
+// ResetPassword 凭短信验证码重置密码，并吊销该用户的全部会话。
+func (s *AuthService) ResetPassword(ctx context.Context, phone, code, newPassword string) error {
+	if err := s.codes.VerifyCode(ctx, phone, CodePurposeResetPassword, code); err != nil {
+		return err
+	}
 
-	// 3. 生成 JWT Tokens
-	tokens, err := s.jwtMgr.GenerateTokens(fmt.Sprintf("%d", u.ID), u.Phone)
+	u, err := s.client.User.Query().Where(user.PhoneEQ(phone)).Only(ctx)
+	if err != nil {
+		return errors.ErrCodeInvalid
+	}
+
+	hashed, err := hashPassword(newPassword)
+	if err != nil {
+		return errors.ErrInternalServer
+	}
+	if err := s.client.User.UpdateOne(u).SetPassword(hashed).Exec(ctx); err != nil {
+		return errors.ErrInternalServer
+	}
+
+	if err := s.revokeUserSessions(ctx, u.ID); err != nil {
+		return errors.ErrInternalServer
+	}
+	return nil
+}
+
+func (s *AuthService) queryUserDetail(ctx context.Context, phone string) (*ent.User, error) {
+	return withUserDetailEdges(s.client.User.Query().Where(user.PhoneEQ(phone))).Only(ctx)
+}
+
+// withUserDetailEdges 预加载 buildUserDetail 需要的关注、点赞和收藏记录。
+func withUserDetailEdges(q *ent.UserQuery) *ent.UserQuery {
+	return q.
+		WithFollowing().
+		WithLikePosts().
+		WithFavoritePosts().
+		WithLikeQuestions().
+		WithFavoriteQuestions()
+}
+
+// completeLogin 为已通过身份校验的用户开启新会话，再签发归属该会话的 JWT Tokens。
+// 注销宽限期内登录视为撤销注销。
+func (s *AuthService) completeLogin(ctx context.Context, u *ent.User, info *ClientInfo) (*LoginResponse, error) {
+	if err := cancelDeletion(ctx, s.client, u); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	sess, err := s.createSession(ctx, u.ID, info)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	tokens, err := s.issueTokens(ctx, u, sess.ID)
 	if err != nil {
 		return nil, errors.ErrInternalServer
 	}
 
-	// 4. 构建返回的用户详情
 	userDetail := s.buildUserDetail(u)
 
 	return &LoginResponse{
@@ -90,28 +181,55 @@ func (s *AuthService) Login(ctx context.Context, phone, password string) (*Login
 }
 
 func (s *AuthService) RefreshToken(ctx context.Context, refreshToken string) (*LoginResponse, error) {
-	claims, err := s.jwtMgr.ValidateToken(refreshToken)
+	claims, err := s.jwtMgr.ValidateRefreshToken(refreshToken)
 	if err != nil {
 		return nil, errors.ErrTokenExpired
 	}
 
-	tokens, err := s.jwtMgr.GenerateTokens(claims.UserID, claims.Phone)
+	userID, err := strconv.Atoi(claims.UserID)
 	if err != nil {
-		return nil, errors.ErrInternalServer
+		return nil, errors.ErrTokenExpired
 	}
 
-	var userID int
-	fmt.Sscanf(claims.UserID, "%d", &userID)
+	// 先确认用户还在再消费 token；用户已被清除时这个会话不会再有效，直接吊销
+	u, err := withUserDetailEdges(s.client.User.Query().Where(user.IDEQ(userID))).Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, errors.ErrInternalServer
+		}
+		if err := s.revokeSession(ctx, claims.SessionID); err != nil {
+			return nil, errors.ErrInternalServer
+		}
+		return nil, errors.ErrTokenExpired
+	}
 
-	// 为了 Refresh 之后也能拿到完整详情，这里建议也用 Query 补全 Edges
-	u, err := s.client.User.Query().
-		Where(user.IDEQ(userID)).
-		WithFollowing().
-		WithFollowedBy().
-		Only(ctx)
+	// 原子地把这枚 refresh token 标记为已使用，并发重放时只有一个请求能成功；
+	// 所属会话已被吊销（登出、踢下线）时同样无法消费
+	now := time.Now()
+	n, err := s.client.RefreshToken.Update().
+		Where(
+			refreshtoken.JtiEQ(claims.ID),
+			refreshtoken.UsedAtIsNil(),
+			refreshtoken.RevokedAtIsNil(),
+			refreshtoken.ExpiresAtGT(now),
+			refreshtoken.HasSessionWith(session.RevokedAtIsNil()),
+		).
+		SetUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	if n == 0 {
+		return nil, s.handleRefreshReuse(ctx, claims)
+	}
+
+	if err := s.client.Session.UpdateOneID(claims.SessionID).SetLastRefreshTime(now).Exec(ctx); err != nil {
+		return nil, errors.ErrInternalServer
+	}
 
+	tokens, err := s.issueTokens(ctx, u, claims.SessionID)
 	if err != nil {
-		return nil, errors.ErrNotFound
+		return nil, errors.ErrInternalServer
 	}
 
 	userDetail := s.buildUserDetail(u)
@@ -123,64 +241,102 @@ func (s *AuthService) RefreshToken(ctx context.Context, refreshToken string) (*L
 	}, nil
 }
 
+// handleRefreshReuse 处理无法消费的 refresh token：签名有效但库里已用过，
+// 说明它很可能被盗用后重放，直接吊销整个会话，逼所有持有者重新登录。
+func (s *AuthService) handleRefreshReuse(ctx context.Context, claims *jwt.Claims) error {
+	rt, err := s.client.RefreshToken.Query().
+		Where(refreshtoken.JtiEQ(claims.ID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.ErrTokenExpired
+		}
+		return errors.ErrInternalServer
+	}
+
+	if rt.UsedAt == nil {
+		// 已过期或已被吊销，不属于重放
+		return errors.ErrTokenExpired
+	}
+
+	if err := s.revokeSession(ctx, rt.SessionID); err != nil {
+		return errors.ErrInternalServer
+	}
+	return errors.ErrTokenReused
+}
+
+// issueTokens 签发一对新 token，并把 refresh token 记录到所属会话上。
+func (s *AuthService) issueTokens(ctx context.Context, u *ent.User, sessionID int) (*jwt.TokenPair, error) {
+	tokens, err := s.jwtMgr.GenerateTokens(jwt.Subject{
+		UserID:      fmt.Sprintf("%d", u.ID),
+		Phone:       u.Phone,
+		SessionID:   sessionID,
+		Role:        u.Role.String(),
+		Permissions: rbac.Permissions(u.Role.String()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.client.RefreshToken.Create().
+		SetJti(tokens.RefreshID).
+		SetSessionID(sessionID).
+		SetUserID(u.ID).
+		SetExpiresAt(tokens.RefreshExpiresAt).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
 func (s *AuthService) buildUserDetail(u *ent.User) *UserDetail {
 	detail := &UserDetail{
 		ID:                fmt.Sprintf("%d", u.ID),
 		Nickname:          u.Nickname,
 		Phone:             u.Phone,
+		Role:              u.Role.String(),
 		Following:         []int{},
 		LikePosts:         []int{},
 		FavoritePosts:     []int{},
 		LikeQuestions:     []int{},
 		FavoriteQuestions: []int{},
+		FollowingCount:    u.FollowingCount,
+		FollowerCount:     u.FollowerCount,
 	}
 
 	// 安全检查：处理 Following
 	if u.Edges.Following != nil {
 		for _, f := range u.Edges.Following {
-			detail.Following = append(detail.Following, int(f.ID))
+			detail.Following = append(detail.Following, f.FollowingID)
 		}
-		detail.FollowingCount = len(u.Edges.Following)
-	}
-
-	// 安全检查：处理 Follower
-	if u.Edges.FollowedBy != nil {
-		detail.FollowerCount = len(u.Edges.FollowedBy)
 	}
 
-	// 处理点赞文章 (Ent 的嵌套 Edge 逻辑)
+	// 处理点赞文章
 	if u.Edges.LikePosts != nil {
 		for _, lp := range u.Edges.LikePosts {
-			if lp.Edges.Post != nil {
-				detail.LikePosts = append(detail.LikePosts, int(lp.Edges.Post.ID))
-			}
+			detail.LikePosts = append(detail.LikePosts, lp.PostID)
 		}
 	}
 
 	// 处理收藏文章
 	if u.Edges.FavoritePosts != nil {
 		for _, fp := range u.Edges.FavoritePosts {
-			if fp.Edges.Post != nil {
-				detail.FavoritePosts = append(detail.FavoritePosts, int(fp.Edges.Post.ID))
-			}
+			detail.FavoritePosts = append(detail.FavoritePosts, fp.PostID)
 		}
 	}
 
 	// 处理点赞问题
 	if u.Edges.LikeQuestions != nil {
 		for _, lq := range u.Edges.LikeQuestions {
-			if lq.Edges.Question != nil {
-				detail.LikeQuestions = append(detail.LikeQuestions, int(lq.Edges.Question.id))
-			}
+			detail.LikeQuestions = append(detail.LikeQuestions, lq.QuestionID)
 		}
 	}
 
 	// 处理收藏问题
 	if u.Edges.FavoriteQuestions != nil {
 		for _, fq := range u.Edges.FavoriteQuestions {
-			if fq.Edges.Question != nil {
-				detail.FavoriteQuestions = append(detail.FavoriteQuestions, int(fq.Edges.Question.ID))
-			}
+			detail.FavoriteQuestions = append(detail.FavoriteQuestions, fq.QuestionID)
 		}
 	}
 
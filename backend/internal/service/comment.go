@@ -0,0 +1,472 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"backend/internal/ent"
+	"backend/internal/ent/answer"
+	"backend/internal/ent/comment"
+	"backend/internal/ent/post"
+	"backend/internal/ent/predicate"
+	"backend/internal/ent/question"
+	"backend/internal/ent/user"
+	"backend/pkg/errors"
+)
+
+const (
+	defaultReplyPreview = 3
+	maxReplyPreview     = 20
+)
+
+type CommentService struct {
+	client *ent.Client
+	// maxDepth 楼中楼的最大层数，一级评论为第 1 层
+	maxDepth int
+}
+
+func NewCommentService(client *ent.Client, maxDepth int) *CommentService {
+	return &CommentService{client: client, maxDepth: maxDepth}
+}
+
+// CommentTarget 指明评论挂在哪篇文章、哪个问题或哪个回答下，三者恰好设置一个。
+type CommentTarget struct {
+	PostID     int
+	QuestionID int
+	AnswerID   int
+}
+
+func (t CommentTarget) predicate() predicate.Comment {
+	switch {
+	case t.PostID != 0:
+		return comment.PostIDEQ(t.PostID)
+	case t.AnswerID != 0:
+		return comment.AnswerIDEQ(t.AnswerID)
+	default:
+		return comment.QuestionIDEQ(t.QuestionID)
+	}
+}
+
+type CommentItem struct {
+	ID        int       `json:"id"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	User      *Author   `json:"user"`
+	// ReplyTo 是被回复的用户，一级评论为空
+	ReplyTo  *Author `json:"replyTo"`
+	ParentID int     `json:"parentId,omitempty"`
+	Depth    int     `json:"depth"`
+	// Deleted 为 true 表示已删除但仍有回复的墓碑评论，内容和作者均不返回
+	Deleted    bool `json:"deleted"`
+	ReplyCount int  `json:"replyCount"`
+	// Replies 是最早的几条直接回复，只在一级评论列表中返回
+	Replies []*CommentItem `json:"replies,omitempty"`
+	// NextReplyCursor 用于继续拉取该评论的回复，为空表示已经全部返回
+	NextReplyCursor string `json:"nextReplyCursor,omitempty"`
+}
+
+type CommentPage struct {
+	Comments   []*CommentItem `json:"comments"`
+	NextCursor string         `json:"nextCursor"`
+}
+
+// Create 发表评论或回复。回复超过最大层数时挂到被回复评论的父评论下，
+// 层级不再加深，通过 replyTo 标明实际回复的是谁。
+func (s *CommentService) Create(ctx context.Context, userID int, target CommentTarget, parentID int, content string) (*CommentItem, error) {
+	if err := s.checkTarget(ctx, target); err != nil {
+		return nil, err
+	}
+
+	var parent *ent.Comment
+	if parentID != 0 {
+		var err error
+		parent, err = s.client.Comment.Query().
+			Where(comment.IDEQ(parentID), target.predicate(), comment.DeletedAtIsNil()).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, errors.ErrCommentNotFound
+			}
+			return nil, errors.ErrInternalServer
+		}
+	}
+
+	// 在事务中写入，计数 hook 对文章、问题评论数的更新随评论一起提交
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	create := tx.Comment.Create().
+		SetUserID(userID).
+		SetContent(content)
+	switch {
+	case target.PostID != 0:
+		create.SetPostID(target.PostID)
+	case target.AnswerID != 0:
+		create.SetAnswerID(target.AnswerID)
+	default:
+		create.SetQuestionID(target.QuestionID)
+	}
+	if parent != nil {
+		create.SetReplyToUserID(parent.UserID)
+		if parent.Depth < s.maxDepth {
+			create.SetParentID(parent.ID).SetDepth(parent.Depth + 1)
+		} else {
+			// 已到最深一层，与被回复的评论做兄弟节点
+			create.SetDepth(parent.Depth)
+			if parent.ParentID != 0 {
+				create.SetParentID(parent.ParentID)
+			}
+		}
+	}
+	cm, err := create.Save(ctx)
+	if err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	items, err := s.buildItems(ctx, []*ent.Comment{cm})
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	return items[0], nil
+}
+
+// ListTopLevel 按时间倒序分页返回一级评论，每条附带最早的 replyLimit 条回复和回复总数。
+func (s *CommentService) ListTopLevel(ctx context.Context, target CommentTarget, cursor string, limit, replyLimit int) (*CommentPage, error) {
+	if err := s.checkTarget(ctx, target); err != nil {
+		return nil, err
+	}
+	after, err := DecodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit = feedLimit(limit)
+	replyLimit = replyPreviewLimit(replyLimit)
+
+	query := s.client.Comment.Query().
+		Where(target.predicate(), comment.ParentIDIsNil())
+	if after != nil {
+		query.Where(comment.Or(
+			comment.CreateTimeLT(after.CreateTime),
+			comment.And(comment.CreateTimeEQ(after.CreateTime), comment.IDLT(after.ID)),
+		))
+	}
+	tops, err := query.
+		Order(ent.Desc(comment.FieldCreateTime), ent.Desc(comment.FieldID)).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	page := &CommentPage{}
+	if len(tops) > limit {
+		tops = tops[:limit]
+		last := tops[limit-1]
+		page.NextCursor = EncodeCursor(Cursor{CreateTime: last.CreateTime, ID: last.ID})
+	}
+
+	topIDs := make([]int, len(tops))
+	for i, cm := range tops {
+		topIDs[i] = cm.ID
+	}
+
+	var replies []*ent.Comment
+	if replyLimit > 0 && len(topIDs) > 0 {
+		replies, err = s.client.Comment.Query().
+			Where(firstReplies(topIDs, replyLimit)).
+			Order(ent.Asc(comment.FieldCreateTime), ent.Asc(comment.FieldID)).
+			All(ctx)
+		if err != nil {
+			return nil, errors.ErrInternalServer
+		}
+	}
+
+	// 一级评论和预览回复一起组装，作者和回复数各一条查询
+	items, err := s.buildItems(ctx, append(append([]*ent.Comment{}, tops...), replies...))
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	page.Comments = items[:len(tops)]
+
+	byID := make(map[int]*CommentItem, len(tops))
+	for _, item := range page.Comments {
+		item.Replies = []*CommentItem{}
+		byID[item.ID] = item
+	}
+	for _, reply := range items[len(tops):] {
+		parent := byID[reply.ParentID]
+		parent.Replies = append(parent.Replies, reply)
+	}
+	for _, item := range page.Comments {
+		if n := len(item.Replies); n > 0 && item.ReplyCount > n {
+			last := item.Replies[n-1]
+			item.NextReplyCursor = EncodeCursor(Cursor{CreateTime: last.CreatedAt, ID: last.ID})
+		}
+	}
+
+	return page, nil
+}
+
+// ListReplies 按时间正序分页返回某条评论的直接回复。
+func (s *CommentService) ListReplies(ctx context.Context, parentID int, cursor string, limit int) (*CommentPage, error) {
+	exists, err := s.client.Comment.Query().Where(comment.IDEQ(parentID)).Exist(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	if !exists {
+		return nil, errors.ErrCommentNotFound
+	}
+	after, err := DecodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit = feedLimit(limit)
+
+	query := s.client.Comment.Query().Where(comment.ParentIDEQ(parentID))
+	if after != nil {
+		query.Where(comment.Or(
+			comment.CreateTimeGT(after.CreateTime),
+			comment.And(comment.CreateTimeEQ(after.CreateTime), comment.IDGT(after.ID)),
+		))
+	}
+	replies, err := query.
+		Order(ent.Asc(comment.FieldCreateTime), ent.Asc(comment.FieldID)).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	page := &CommentPage{}
+	if len(replies) > limit {
+		replies = replies[:limit]
+		last := replies[limit-1]
+		page.NextCursor = EncodeCursor(Cursor{CreateTime: last.CreateTime, ID: last.ID})
+	}
+	if page.Comments, err = s.buildItems(ctx, replies); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	return page, nil
+}
+
+// Update 修改评论内容，只有作者或内容审核员可以修改，已删除的评论不能修改。
+func (s *CommentService) Update(ctx context.Context, userID int, canModerate bool, id int, content string) (*CommentItem, error) {
+	cm, err := s.checkOwner(ctx, userID, canModerate, id)
+	if err != nil {
+		return nil, err
+	}
+
+	cm, err = s.client.Comment.UpdateOne(cm).SetContent(content).Save(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	items, err := s.buildItems(ctx, []*ent.Comment{cm})
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	return items[0], nil
+}
+
+// Delete 删除评论：有回复的评论变为墓碑以保留楼层结构（墓碑不再计入评论数），没有回复的直接删除；
+// 删除后若上层墓碑已没有任何回复，也一并清理。
+func (s *CommentService) Delete(ctx context.Context, userID int, canModerate bool, id int) error {
+	cm, err := s.checkOwner(ctx, userID, canModerate, id)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return errors.ErrInternalServer
+	}
+	if err := deleteComment(ctx, tx, cm); err != nil {
+		return txFailed(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.ErrInternalServer
+	}
+	return nil
+}
+
+func deleteComment(ctx context.Context, tx *ent.Tx, cm *ent.Comment) error {
+	hasReplies, err := tx.Comment.Query().Where(comment.ParentIDEQ(cm.ID)).Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if hasReplies {
+		return tx.Comment.UpdateOne(cm).
+			SetContent("").
+			SetDeletedAt(time.Now()).
+			Exec(ctx)
+	}
+
+	if err := tx.Comment.DeleteOne(cm).Exec(ctx); err != nil {
+		return err
+	}
+	if cm.ParentID == 0 {
+		return nil
+	}
+
+	parent, err := tx.Comment.Query().
+		Where(comment.IDEQ(cm.ParentID), comment.DeletedAtNotNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return deleteComment(ctx, tx, parent)
+}
+
+func (s *CommentService) checkOwner(ctx context.Context, userID int, canModerate bool, id int) (*ent.Comment, error) {
+	cm, err := s.client.Comment.Query().
+		Where(comment.IDEQ(id), comment.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrCommentNotFound
+		}
+		return nil, errors.ErrInternalServer
+	}
+	if cm.UserID != userID && !canModerate {
+		return nil, errors.ErrForbidden
+	}
+	return cm, nil
+}
+
+func (s *CommentService) checkTarget(ctx context.Context, target CommentTarget) error {
+	if target.AnswerID != 0 {
+		exists, err := s.client.Answer.Query().Where(answer.IDEQ(target.AnswerID)).Exist(ctx)
+		if err != nil {
+			return errors.ErrInternalServer
+		}
+		if !exists {
+			return errors.ErrAnswerNotFound
+		}
+		return nil
+	}
+	if target.PostID != 0 {
+		exists, err := s.client.Post.Query().Where(post.IDEQ(target.PostID)).Exist(ctx)
+		if err != nil {
+			return errors.ErrInternalServer
+		}
+		if !exists {
+			return errors.ErrPostNotFound
+		}
+		return nil
+	}
+
+	exists, err := s.client.Question.Query().Where(question.IDEQ(target.QuestionID)).Exist(ctx)
+	if err != nil {
+		return errors.ErrInternalServer
+	}
+	if !exists {
+		return errors.ErrQuestionNotFound
+	}
+	return nil
+}
+
+// buildItems 组装评论，作者与被回复用户、直接回复数各用一条查询批量加载。
+func (s *CommentService) buildItems(ctx context.Context, comments []*ent.Comment) ([]*CommentItem, error) {
+	items := make([]*CommentItem, 0, len(comments))
+	if len(comments) == 0 {
+		return items, nil
+	}
+
+	ids := make([]int, len(comments))
+	userIDs := make([]int, 0, len(comments)*2)
+	for i, cm := range comments {
+		ids[i] = cm.ID
+		userIDs = append(userIDs, cm.UserID)
+		if cm.ReplyToUserID != 0 {
+			userIDs = append(userIDs, cm.ReplyToUserID)
+		}
+	}
+
+	users, err := s.client.User.Query().Where(user.IDIn(userIDs...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	authors := make(map[int]*Author, len(users))
+	for _, u := range users {
+		authors[u.ID] = toAuthor(u)
+	}
+
+	var counts []countRow
+	err = s.client.Comment.Query().
+		Where(comment.IDIn(ids...)).
+		Modify(withChildCounts(comment.FieldID, ids,
+			childCount{table: comment.Table, column: comment.FieldParentID},
+		)).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+	replyCounts := make(map[int]int, len(counts))
+	for _, c := range counts {
+		replyCounts[c.ID] = c.C0
+	}
+
+	for _, cm := range comments {
+		item := &CommentItem{
+			ID:         cm.ID,
+			CreatedAt:  cm.CreateTime,
+			UpdatedAt:  cm.UpdateTime,
+			ReplyTo:    authors[cm.ReplyToUserID],
+			ParentID:   cm.ParentID,
+			Depth:      cm.Depth,
+			ReplyCount: replyCounts[cm.ID],
+		}
+		if cm.DeletedAt != nil {
+			item.Deleted = true
+		} else {
+			item.Content = cm.Content
+			item.User = authors[cm.UserID]
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// firstReplies 筛出每个父评论下最早的 n 条直接回复，用窗口函数一条 SQL 完成。
+func firstReplies(parentIDs []int, n int) predicate.Comment {
+	return func(s *sql.Selector) {
+		args := make([]any, len(parentIDs))
+		for i, id := range parentIDs {
+			args[i] = id
+		}
+
+		t := sql.Table(comment.Table)
+		rank := fmt.Sprintf("ROW_NUMBER() OVER (PARTITION BY %s ORDER BY %s, %s)",
+			t.C(comment.FieldParentID), t.C(comment.FieldCreateTime), t.C(comment.FieldID))
+		ranked := sql.Select(t.C(comment.FieldID), sql.As(rank, "rn")).
+			From(t).
+			Where(sql.In(t.C(comment.FieldParentID), args...)).
+			As("ranked")
+		s.Where(sql.In(s.C(comment.FieldID),
+			sql.Select(ranked.C(comment.FieldID)).From(ranked).Where(sql.LTE(ranked.C("rn"), n)),
+		))
+	}
+}
+
+// replyPreviewLimit 把预览回复条数收敛到 [0, maxReplyPreview]，负数表示使用默认值。
+func replyPreviewLimit(n int) int {
+	switch {
+	case n < 0:
+		return defaultReplyPreview
+	case n > maxReplyPreview:
+		return maxReplyPreview
+	default:
+		return n
+	}
+}
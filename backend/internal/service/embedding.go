@@ -0,0 +1,369 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"backend/internal/ent"
+	"backend/internal/ent/post"
+	"backend/internal/ent/posttag"
+	"backend/internal/ent/question"
+	"backend/internal/ent/questiontag"
+	"backend/internal/pgvector"
+	"backend/pkg/embedding"
+)
+
+const (
+	// embedQueueSize 是待计算队列的长度，队列满时新任务被丢弃，由 Backfill 补上
+	embedQueueSize = 1024
+	// embedBatchSize 是一次交给 Embedder 的最大条数
+	embedBatchSize = 16
+	// embedContentRunes 是参与计算的正文长度，与旧服务一致取前 500 字
+	embedContentRunes = 500
+	// embedMaxAttempts 是同一内容连续失败的次数上限，达到后 Backfill 不再补算，直到内容被修改或服务重启
+	embedMaxAttempts = 3
+)
+
+// EmbeddingService 在后台为文章和问题计算 embedding。发布、修改只把 id 放进队列，
+// 模型服务慢或不可用都不会拖慢请求；丢失或失败的任务由 Backfill 定期补算。
+type EmbeddingService struct {
+	client   *ent.Client
+	embedder embedding.Embedder
+	jobs     chan embedJob
+
+	mu sync.Mutex
+	// queued 是还在队列中的任务，同一任务不重复入队；running 是正在计算的任务，
+	// 计算期间内容被修改时可以再次入队，Backfill 则跳过它们
+	queued  map[embedJob]bool
+	running map[embedJob]bool
+	// failures 记录连续失败的次数，成功或内容被修改后清零
+	failures map[embedJob]int
+}
+
+// embedJob 指向一篇文章或一个问题，table 为 post.Table 或 question.Table。
+type embedJob struct {
+	table string
+	id    int
+}
+
+// NewEmbeddingService embedder 为 nil 时不计算 embedding，入队直接忽略。
+func NewEmbeddingService(client *ent.Client, embedder embedding.Embedder) *EmbeddingService {
+	return &EmbeddingService{
+		client:   client,
+		embedder: embedder,
+		jobs:     make(chan embedJob, embedQueueSize),
+		queued:   make(map[embedJob]bool),
+		running:  make(map[embedJob]bool),
+		failures: make(map[embedJob]int),
+	}
+}
+
+// enqueue 在发布、修改后调用，内容变了之前的失败次数清零。不会阻塞，队列满时丢弃任务。
+// s 为 nil 时什么也不做，便于不需要 embedding 的场景直接传 nil。
+func (s *EmbeddingService) enqueue(table string, id int) {
+	if s == nil || s.embedder == nil {
+		return
+	}
+	job := embedJob{table: table, id: id}
+	s.mu.Lock()
+	delete(s.failures, job)
+	s.mu.Unlock()
+	s.push(job)
+}
+
+// push 把任务放进队列，已在队列中的任务直接忽略，队列满时丢弃，返回是否新入队。
+func (s *EmbeddingService) push(job embedJob) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.queued[job] {
+		return false
+	}
+	select {
+	case s.jobs <- job:
+		s.queued[job] = true
+		return true
+	default:
+		log.Printf("embedding: queue is full, dropped %s %d", job.table, job.id)
+		return false
+	}
+}
+
+// finish 记录一个任务的结果，失败次数达到 embedMaxAttempts 时记日志，之后 Backfill 跳过它。
+func (s *EmbeddingService) finish(job embedJob, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.running, job)
+	if err == nil {
+		delete(s.failures, job)
+		return
+	}
+	s.failures[job]++
+	if s.failures[job] == embedMaxAttempts {
+		log.Printf("embedding: giving up on %s %d after %d attempts: %v", job.table, job.id, embedMaxAttempts, err)
+	}
+}
+
+// start 把取出的一批任务从 queued 移到 running。
+func (s *EmbeddingService) start(batch []embedJob) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, job := range batch {
+		delete(s.queued, job)
+		s.running[job] = true
+	}
+}
+
+// skipped 返回 Backfill 不应再入队的 id：在队列中的、正在计算的和失败次数达到上限的。
+func (s *EmbeddingService) skipped(table string) []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ids []int
+	for _, jobs := range []map[embedJob]bool{s.queued, s.running} {
+		for job := range jobs {
+			if job.table == table {
+				ids = append(ids, job.id)
+			}
+		}
+	}
+	for job, n := range s.failures {
+		if job.table == table && n >= embedMaxAttempts && !s.queued[job] && !s.running[job] {
+			ids = append(ids, job.id)
+		}
+	}
+	return ids
+}
+
+// embedQuery 同步计算搜索词的向量，没有配置 embedding 时返回 nil。
+func (s *EmbeddingService) embedQuery(ctx context.Context, text string) (pgvector.Vector, error) {
+	if s == nil || s.embedder == nil {
+		return nil, nil
+	}
+	vectors, err := s.embedder.Embed(ctx, []string{text})
+	if err != nil {
+		return nil, err
+	}
+	if len(vectors[0]) != pgvector.Dimensions {
+		return nil, fmt.Errorf("got %d dimensions, the embedding column has %d", len(vectors[0]), pgvector.Dimensions)
+	}
+	return pgvector.Vector(vectors[0]), nil
+}
+
+// Run 持续从队列取任务计算，直到 ctx 结束。每次尽量凑满一批再请求模型服务。
+func (s *EmbeddingService) Run(ctx context.Context) {
+	if s.embedder == nil {
+		return
+	}
+	for {
+		var batch []embedJob
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-s.jobs:
+			batch = append(batch, job)
+		}
+	drain:
+		for len(batch) < embedBatchSize {
+			select {
+			case job := <-s.jobs:
+				batch = append(batch, job)
+			default:
+				break drain
+			}
+		}
+		s.start(batch)
+
+		byTable := make(map[string][]int)
+		for _, job := range batch {
+			byTable[job.table] = append(byTable[job.table], job.id)
+		}
+		for table, ids := range byTable {
+			err := s.embed(ctx, table, ids)
+			if err == nil || len(ids) == 1 {
+				for _, id := range ids {
+					s.finish(embedJob{table: table, id: id}, err)
+				}
+				if err != nil {
+					log.Printf("embedding: %s %v: %v", table, ids, err)
+				}
+				continue
+			}
+			// 整批失败时逐条重试，找出真正失败的那几条，不连累同批的其他记录
+			log.Printf("embedding: %s %v: %v, retrying one by one", table, ids, err)
+			for _, id := range ids {
+				err := s.embed(ctx, table, []int{id})
+				if err != nil {
+					log.Printf("embedding: %s %d: %v", table, id, err)
+				}
+				s.finish(embedJob{table: table, id: id}, err)
+			}
+		}
+	}
+}
+
+// Backfill 把还没有 embedding 的文章和问题放进队列，新的优先，最多填满队列的空位，返回入队的条数。
+// 已在队列中的和多次失败的记录被跳过，不会挤占其他记录的位置。
+func (s *EmbeddingService) Backfill(ctx context.Context) (int, error) {
+	if s.embedder == nil {
+		return 0, nil
+	}
+	free := cap(s.jobs) - len(s.jobs)
+	postIDs, err := s.client.Post.Query().
+		Where(post.EmbeddingIsNil(), post.IDNotIn(s.skipped(post.Table)...)).
+		Order(ent.Desc(post.FieldID)).
+		Limit(free / 2).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	questionIDs, err := s.client.Question.Query().
+		Where(question.EmbeddingIsNil(), question.IDNotIn(s.skipped(question.Table)...)).
+		Order(ent.Desc(question.FieldID)).
+		Limit(free - len(postIDs)).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, id := range postIDs {
+		if s.push(embedJob{table: post.Table, id: id}) {
+			n++
+		}
+	}
+	for _, id := range questionIDs {
+		if s.push(embedJob{table: question.Table, id: id}) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// embedTarget 是一条待计算向量的记录，updateTime 是读取文本时的版本。
+type embedTarget struct {
+	id         int
+	updateTime time.Time
+	text       string
+}
+
+// embed 读取最新的内容计算向量并写回。直接用 SQL 更新，不经过 ent，
+// 避免 update_time 被改成计算完成的时间。已经删除的记录跳过；
+// 计算期间被编辑过的记录不写回，留给编辑后重新入队的任务或 Backfill。
+func (s *EmbeddingService) embed(ctx context.Context, table string, ids []int) error {
+	var (
+		targets []embedTarget
+		err     error
+	)
+	switch table {
+	case post.Table:
+		targets, err = s.postTexts(ctx, ids)
+	case question.Table:
+		targets, err = s.questionTexts(ctx, ids)
+	default:
+		err = fmt.Errorf("unknown table %s", table)
+	}
+	if err != nil || len(targets) == 0 {
+		return err
+	}
+
+	texts := make([]string, len(targets))
+	for i, t := range targets {
+		texts[i] = t.text
+	}
+	vectors, err := s.embedder.Embed(ctx, texts)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf("UPDATE %s SET %s = $1 WHERE %s = $2 AND %s = $3", table, post.FieldEmbedding, post.FieldID, post.FieldUpdateTime)
+	for i, t := range targets {
+		if len(vectors[i]) != pgvector.Dimensions {
+			return fmt.Errorf("got %d dimensions, the embedding column has %d", len(vectors[i]), pgvector.Dimensions)
+		}
+		if _, err := s.client.ExecContext(ctx, query, pgvector.Vector(vectors[i]), t.id, t.updateTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// postTexts 拼出文章的待计算文本：标题、标签和正文开头。格式与旧服务相同，
+// 导入的历史向量和新算的向量可以放在一起比较。
+func (s *EmbeddingService) postTexts(ctx context.Context, ids []int) ([]embedTarget, error) {
+	posts, err := s.client.Post.Query().
+		Where(post.IDIn(ids...)).
+		Select(post.FieldUpdateTime, post.FieldTitle, post.FieldContent).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var tags []parentTag
+	err = s.client.PostTag.Query().
+		Where(posttag.PostIDIn(ids...)).
+		Modify(withTagNames(posttag.FieldPostID, posttag.FieldTagID, posttag.FieldID)).
+		Scan(ctx, &tags)
+	if err != nil {
+		return nil, err
+	}
+	tagsByID := groupTagNames(tags)
+
+	targets := make([]embedTarget, len(posts))
+	for i, p := range posts {
+		targets[i] = embedTarget{
+			id:         p.ID,
+			updateTime: p.UpdateTime,
+			text:       fmt.Sprintf("标题: %s; 标签: %s; 正文: %s", p.Title, embedTagList(tagsByID[p.ID]), truncateRunes(p.Content, embedContentRunes)),
+		}
+	}
+	return targets, nil
+}
+
+// questionTexts 拼出问题的待计算文本。旧服务的问题没有描述，只用标题和标签；有描述时追加在后面。
+func (s *EmbeddingService) questionTexts(ctx context.Context, ids []int) ([]embedTarget, error) {
+	questions, err := s.client.Question.Query().
+		Where(question.IDIn(ids...)).
+		Select(question.FieldUpdateTime, question.FieldTitle, question.FieldBody).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var tags []parentTag
+	err = s.client.QuestionTag.Query().
+		Where(questiontag.QuestionIDIn(ids...)).
+		Modify(withTagNames(questiontag.FieldQuestionID, questiontag.FieldTagID, questiontag.FieldID)).
+		Scan(ctx, &tags)
+	if err != nil {
+		return nil, err
+	}
+	tagsByID := groupTagNames(tags)
+
+	targets := make([]embedTarget, len(questions))
+	for i, q := range questions {
+		text := fmt.Sprintf("提问标题: %s; 标签: %s", q.Title, embedTagList(tagsByID[q.ID]))
+		if q.Body != "" {
+			text += "; 描述: " + truncateRunes(q.Body, embedContentRunes)
+		}
+		targets[i] = embedTarget{id: q.ID, updateTime: q.UpdateTime, text: text}
+	}
+	return targets, nil
+}
+
+func embedTagList(names []string) string {
+	if len(names) == 0 {
+		return "无"
+	}
+	return strings.Join(names, ", ")
+}
+
+// truncateRunes 保留 s 的前 n 个字符，与 truncate 按字节截断不同。
+func truncateRunes(s string, n int) string {
+	for i := range s {
+		if n == 0 {
+			return s[:i]
+		}
+		n--
+	}
+	return s
+}
@@ -0,0 +1,449 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend/internal/ent"
+	"backend/internal/ent/answer"
+	"backend/internal/ent/answervote"
+	"backend/internal/ent/comment"
+	"backend/internal/ent/follow"
+	"backend/internal/ent/posttag"
+	"backend/internal/ent/questiontag"
+	"backend/internal/ent/session"
+	"backend/internal/ent/tag"
+	"backend/internal/ent/user"
+	"backend/internal/ent/userfavoritepost"
+	"backend/internal/ent/userfavoritequestion"
+	"backend/internal/ent/userlikepost"
+	"backend/internal/ent/userlikequestion"
+	"backend/pkg/errors"
+)
+
+type exportProfile struct {
+	ID                  int        `json:"id"`
+	Phone               string     `json:"phone"`
+	Nickname            string     `json:"nickname"`
+	Avatar              string     `json:"avatar"`
+	Role                string     `json:"role"`
+	CreatedAt           time.Time  `json:"createdAt"`
+	DeletionRequestedAt *time.Time `json:"deletionRequestedAt,omitempty"`
+}
+
+type exportContent struct {
+	ID        int       `json:"id"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content,omitempty"`
+	Tags      []string  `json:"tags"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type exportComment struct {
+	ID         int       `json:"id"`
+	Content    string    `json:"content"`
+	PostID     int       `json:"postId,omitempty"`
+	QuestionID int       `json:"questionId,omitempty"`
+	AnswerID   int       `json:"answerId,omitempty"`
+	ParentID   int       `json:"parentId,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type exportAnswer struct {
+	ID         int       `json:"id"`
+	QuestionID int       `json:"questionId"`
+	Content    string    `json:"content"`
+	VoteScore  int       `json:"voteScore"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+type exportVote struct {
+	AnswerID int `json:"answerId"`
+	Value    int `json:"value"`
+}
+
+type exportInteractions struct {
+	Posts     []int `json:"posts"`
+	Questions []int `json:"questions"`
+}
+
+type exportFollow struct {
+	UserID   int       `json:"userId"`
+	Nickname string    `json:"nickname"`
+	Since    time.Time `json:"since"`
+}
+
+type exportSession struct {
+	Device    string     `json:"device"`
+	UserAgent string     `json:"userAgent"`
+	IP        string     `json:"ip"`
+	CreatedAt time.Time  `json:"createdAt"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+const exportReadme = `# Quibli 个人数据导出
+
+- profile.json      账号资料
+- posts.json        发布的文章，posts/ 下是对应的 Markdown 正文
+- questions.json    发布的问题，questions/ 下是对应的 Markdown 正文
+- answers.json      发表的回答
+- votes.json        对回答的投票，1 为赞成，-1 为反对
+- comments.json     发表的评论
+- likes.json        点赞过的文章与问题 ID
+- favorites.json    收藏过的文章与问题 ID
+- following.json    关注的用户
+- followers.json    粉丝
+- sessions.json     登录会话记录
+`
+
+// ExportData 把用户发布和互动过的全部数据打包成 ZIP：结构化数据为 JSON，正文另附 Markdown。
+func (s *UserService) ExportData(ctx context.Context, userID int) ([]byte, error) {
+	u, err := s.client.User.Query().
+		Where(user.IDEQ(userID), user.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrNotFound
+		}
+		return nil, errors.ErrInternalServer
+	}
+
+	files, err := s.collectExport(ctx, u)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range files {
+		w, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     f.name,
+			Method:   zip.Deflate,
+			Modified: time.Now(),
+		})
+		if err != nil {
+			return nil, errors.ErrInternalServer
+		}
+		if _, err := w.Write(f.data); err != nil {
+			return nil, errors.ErrInternalServer
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	return buf.Bytes(), nil
+}
+
+type exportFile struct {
+	name string
+	data []byte
+}
+
+func (s *UserService) collectExport(ctx context.Context, u *ent.User) ([]exportFile, error) {
+	files := []exportFile{{name: "README.md", data: []byte(exportReadme)}}
+	addJSON := func(name string, v interface{}) error {
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		files = append(files, exportFile{name: name, data: data})
+		return nil
+	}
+
+	if err := addJSON("profile.json", exportProfile{
+		ID:                  u.ID,
+		Phone:               u.Phone,
+		Nickname:            u.Nickname,
+		Avatar:              u.Avatar,
+		Role:                u.Role.String(),
+		CreatedAt:           u.CreateTime,
+		DeletionRequestedAt: u.DeletionRequestedAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	// 文章
+	posts, err := s.client.User.QueryPosts(u).Select(postColumns...).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	postIDs := make([]int, len(posts))
+	for i, p := range posts {
+		postIDs[i] = p.ID
+	}
+	postTags, err := s.client.PostTag.Query().Where(posttag.PostIDIn(postIDs...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tagIDs := make([]int, 0, len(postTags))
+	for _, pt := range postTags {
+		tagIDs = append(tagIDs, pt.TagID)
+	}
+
+	// 问题
+	questions, err := s.client.User.QueryQuestions(u).Select(questionColumns...).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	questionIDs := make([]int, len(questions))
+	for i, q := range questions {
+		questionIDs[i] = q.ID
+	}
+	questionTags, err := s.client.QuestionTag.Query().Where(questiontag.QuestionIDIn(questionIDs...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, qt := range questionTags {
+		tagIDs = append(tagIDs, qt.TagID)
+	}
+
+	tags, err := s.client.Tag.Query().Where(tag.IDIn(tagIDs...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tagNames := make(map[int]string, len(tags))
+	for _, t := range tags {
+		tagNames[t.ID] = t.Name
+	}
+
+	postTagNames := make(map[int][]string)
+	for _, pt := range postTags {
+		postTagNames[pt.PostID] = append(postTagNames[pt.PostID], tagNames[pt.TagID])
+	}
+	exportedPosts := make([]exportContent, 0, len(posts))
+	for _, p := range posts {
+		item := exportContent{
+			ID:        p.ID,
+			Title:     p.Title,
+			Content:   p.Content,
+			Tags:      nonNil(postTagNames[p.ID]),
+			CreatedAt: p.CreateTime,
+			UpdatedAt: p.UpdateTime,
+		}
+		exportedPosts = append(exportedPosts, item)
+		files = append(files, exportFile{name: fmt.Sprintf("posts/%d.md", p.ID), data: renderMarkdown(item)})
+	}
+	if err := addJSON("posts.json", exportedPosts); err != nil {
+		return nil, err
+	}
+
+	questionTagNames := make(map[int][]string)
+	for _, qt := range questionTags {
+		questionTagNames[qt.QuestionID] = append(questionTagNames[qt.QuestionID], tagNames[qt.TagID])
+	}
+	exportedQuestions := make([]exportContent, 0, len(questions))
+	for _, q := range questions {
+		item := exportContent{
+			ID:        q.ID,
+			Title:     q.Title,
+			Content:   q.Body,
+			Tags:      nonNil(questionTagNames[q.ID]),
+			CreatedAt: q.CreateTime,
+			UpdatedAt: q.UpdateTime,
+		}
+		exportedQuestions = append(exportedQuestions, item)
+		files = append(files, exportFile{name: fmt.Sprintf("questions/%d.md", q.ID), data: renderMarkdown(item)})
+	}
+	if err := addJSON("questions.json", exportedQuestions); err != nil {
+		return nil, err
+	}
+
+	// 回答与投票
+	answers, err := s.client.Answer.Query().
+		Where(answer.UserIDEQ(u.ID)).
+		Order(ent.Asc(answer.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exportedAnswers := make([]exportAnswer, 0, len(answers))
+	for _, a := range answers {
+		exportedAnswers = append(exportedAnswers, exportAnswer{
+			ID:         a.ID,
+			QuestionID: a.QuestionID,
+			Content:    a.Content,
+			VoteScore:  a.VoteScore,
+			CreatedAt:  a.CreateTime,
+			UpdatedAt:  a.UpdateTime,
+		})
+	}
+	if err := addJSON("answers.json", exportedAnswers); err != nil {
+		return nil, err
+	}
+
+	votes, err := s.client.AnswerVote.Query().
+		Where(answervote.UserIDEQ(u.ID)).
+		Order(ent.Asc(answervote.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exportedVotes := make([]exportVote, 0, len(votes))
+	for _, v := range votes {
+		exportedVotes = append(exportedVotes, exportVote{AnswerID: v.AnswerID, Value: v.Value})
+	}
+	if err := addJSON("votes.json", exportedVotes); err != nil {
+		return nil, err
+	}
+
+	// 评论
+	comments, err := s.client.Comment.Query().
+		Where(comment.UserIDEQ(u.ID)).
+		Order(ent.Asc(comment.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exportedComments := make([]exportComment, 0, len(comments))
+	for _, cm := range comments {
+		exportedComments = append(exportedComments, exportComment{
+			ID:         cm.ID,
+			Content:    cm.Content,
+			PostID:     cm.PostID,
+			QuestionID: cm.QuestionID,
+			AnswerID:   cm.AnswerID,
+			ParentID:   cm.ParentID,
+			CreatedAt:  cm.CreateTime,
+		})
+	}
+	if err := addJSON("comments.json", exportedComments); err != nil {
+		return nil, err
+	}
+
+	// 点赞与收藏
+	likes := exportInteractions{}
+	if likes.Posts, err = s.client.UserLikePost.Query().
+		Where(userlikepost.UserIDEQ(u.ID)).
+		Select(userlikepost.FieldPostID).
+		Ints(ctx); err != nil {
+		return nil, err
+	}
+	if likes.Questions, err = s.client.UserLikeQuestion.Query().
+		Where(userlikequestion.UserIDEQ(u.ID)).
+		Select(userlikequestion.FieldQuestionID).
+		Ints(ctx); err != nil {
+		return nil, err
+	}
+	likes.Posts, likes.Questions = nonNil(likes.Posts), nonNil(likes.Questions)
+	if err := addJSON("likes.json", likes); err != nil {
+		return nil, err
+	}
+
+	favorites := exportInteractions{}
+	if favorites.Posts, err = s.client.UserFavoritePost.Query().
+		Where(userfavoritepost.UserIDEQ(u.ID)).
+		Select(userfavoritepost.FieldPostID).
+		Ints(ctx); err != nil {
+		return nil, err
+	}
+	if favorites.Questions, err = s.client.UserFavoriteQuestion.Query().
+		Where(userfavoritequestion.UserIDEQ(u.ID)).
+		Select(userfavoritequestion.FieldQuestionID).
+		Ints(ctx); err != nil {
+		return nil, err
+	}
+	favorites.Posts, favorites.Questions = nonNil(favorites.Posts), nonNil(favorites.Questions)
+	if err := addJSON("favorites.json", favorites); err != nil {
+		return nil, err
+	}
+
+	// 关注关系
+	following, err := s.client.Follow.Query().Where(follow.FollowerIDEQ(u.ID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	followers, err := s.client.Follow.Query().Where(follow.FollowingIDEQ(u.ID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	peerIDs := make([]int, 0, len(following)+len(followers))
+	for _, f := range following {
+		peerIDs = append(peerIDs, f.FollowingID)
+	}
+	for _, f := range followers {
+		peerIDs = append(peerIDs, f.FollowerID)
+	}
+	peers, err := s.client.User.Query().Where(user.IDIn(peerIDs...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nicknames := make(map[int]string, len(peers))
+	for _, p := range peers {
+		nicknames[p.ID] = p.Nickname
+	}
+
+	exportedFollowing := make([]exportFollow, 0, len(following))
+	for _, f := range following {
+		exportedFollowing = append(exportedFollowing, exportFollow{
+			UserID:   f.FollowingID,
+			Nickname: nicknames[f.FollowingID],
+			Since:    f.CreateTime,
+		})
+	}
+	if err := addJSON("following.json", exportedFollowing); err != nil {
+		return nil, err
+	}
+	exportedFollowers := make([]exportFollow, 0, len(followers))
+	for _, f := range followers {
+		exportedFollowers = append(exportedFollowers, exportFollow{
+			UserID:   f.FollowerID,
+			Nickname: nicknames[f.FollowerID],
+			Since:    f.CreateTime,
+		})
+	}
+	if err := addJSON("followers.json", exportedFollowers); err != nil {
+		return nil, err
+	}
+
+	// 会话
+	sessions, err := s.client.Session.Query().
+		Where(session.UserIDEQ(u.ID)).
+		Order(ent.Desc(session.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exportedSessions := make([]exportSession, 0, len(sessions))
+	for _, sess := range sessions {
+		exportedSessions = append(exportedSessions, exportSession{
+			Device:    sess.Device,
+			UserAgent: sess.UserAgent,
+			IP:        sess.IP,
+			CreatedAt: sess.CreateTime,
+			RevokedAt: sess.RevokedAt,
+		})
+	}
+	if err := addJSON("sessions.json", exportedSessions); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func renderMarkdown(item exportContent) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "# %s\n\n", item.Title)
+	fmt.Fprintf(&b, "- 发布时间：%s\n", item.CreatedAt.Format(time.RFC3339))
+	if len(item.Tags) > 0 {
+		fmt.Fprintf(&b, "- 标签：%s\n", strings.Join(item.Tags, "、"))
+	}
+	if item.Content != "" {
+		fmt.Fprintf(&b, "\n%s\n", item.Content)
+	}
+	return b.Bytes()
+}
+
+// nonNil 把 nil 切片换成空切片，让 JSON 里输出 [] 而不是 null。
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
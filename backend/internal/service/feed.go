@@ -0,0 +1,167 @@
+package service
+
+import (
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"backend/internal/ent/post"
+	"backend/internal/ent/question"
+	"backend/pkg/errors"
+)
+
+const (
+	defaultFeedLimit = 10
+	maxFeedLimit     = 50
+)
+
+// postColumns、questionColumns 是读取文章、问题时加载的列。embedding 是上千维的向量，
+// 只有 EmbeddingService 用得到，其余读取一律不加载它。
+var (
+	postColumns     = columnsExcept(post.Columns, post.FieldEmbedding)
+	questionColumns = columnsExcept(question.Columns, question.FieldEmbedding)
+)
+
+func columnsExcept(columns []string, except string) []string {
+	return slices.DeleteFunc(slices.Clone(columns), func(c string) bool { return c == except })
+}
+
+// Cursor 定位信息流中的一条记录，下一页从它之后开始。
+// 按 (create_time, id) 做键集分页，翻页期间有新内容发布也不会重复或遗漏。
+type Cursor struct {
+	CreateTime time.Time
+	ID         int
+}
+
+// EncodeCursor 把游标编码成对前端不透明的字符串。
+// 时间精确到微秒，与 PostgreSQL timestamptz 的精度一致。
+func EncodeCursor(c Cursor) string {
+	raw := fmt.Sprintf("%d:%d", c.CreateTime.UnixMicro(), c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor 解析 EncodeCursor 生成的游标，空字符串表示从第一页开始。
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.ErrInvalidCursor
+	}
+	micros, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, errors.ErrInvalidCursor
+	}
+	us, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return nil, errors.ErrInvalidCursor
+	}
+	n, err := strconv.Atoi(id)
+	if err != nil || n <= 0 {
+		return nil, errors.ErrInvalidCursor
+	}
+	return &Cursor{CreateTime: time.UnixMicro(us), ID: n}, nil
+}
+
+// ScoreCursor 是按分数倒序分页时的游标，分数相同再按 id 倒序。
+type ScoreCursor struct {
+	Score int
+	ID    int
+}
+
+// EncodeScoreCursor 与 EncodeCursor 类似，前缀 s 用来区分两种游标。
+func EncodeScoreCursor(c ScoreCursor) string {
+	raw := fmt.Sprintf("s:%d:%d", c.Score, c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeScoreCursor 解析 EncodeScoreCursor 生成的游标，空字符串表示从第一页开始。
+func DecodeScoreCursor(s string) (*ScoreCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.ErrInvalidCursor
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 || parts[0] != "s" {
+		return nil, errors.ErrInvalidCursor
+	}
+	score, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, errors.ErrInvalidCursor
+	}
+	id, err := strconv.Atoi(parts[2])
+	if err != nil || id <= 0 {
+		return nil, errors.ErrInvalidCursor
+	}
+	return &ScoreCursor{Score: score, ID: id}, nil
+}
+
+// feedLimit 把请求的条数收敛到 [1, maxFeedLimit]，0 表示使用默认值。
+func feedLimit(limit int) int {
+	switch {
+	case limit <= 0:
+		return defaultFeedLimit
+	case limit > maxFeedLimit:
+		return maxFeedLimit
+	default:
+		return limit
+	}
+}
+
+// childCount 描述一张需要按父记录分组计数的子表，column 是指向父记录的外键列。
+// userColumn 不为空时只数 userColumn = userID 的行，例如当前用户是否点过赞；
+// until 不为空时跳过该列已有值的行，例如已删除只留占位的评论。
+type childCount struct {
+	table      string
+	column     string
+	userColumn string
+	userID     int
+	until      string
+}
+
+// countRow 接收 withChildCounts 的查询结果，c0..c2 依次对应传入的子表，未传入的列保持为零。
+type countRow struct {
+	ID int `sql:"id"`
+	C0 int `sql:"c0"`
+	C1 int `sql:"c1"`
+	C2 int `sql:"c2"`
+}
+
+// withChildCounts 返回一个查询修饰器：把每张子表先按外键 GROUP BY 成派生表再 LEFT JOIN 到父表上，
+// 一条 SQL 拿到整页记录的全部计数，不再为每一行执行相关子查询。最多支持三张子表。
+func withChildCounts(idColumn string, ids []int, children ...childCount) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		args := make([]any, len(ids))
+		for i, id := range ids {
+			args[i] = id
+		}
+
+		s.Select(sql.As(s.C(idColumn), "id"))
+		for i, child := range children {
+			t := sql.Table(child.table)
+			where := sql.In(t.C(child.column), args...)
+			if child.userColumn != "" {
+				where = sql.And(where, sql.EQ(t.C(child.userColumn), child.userID))
+			}
+			if child.until != "" {
+				where = sql.And(where, sql.IsNull(t.C(child.until)))
+			}
+			grouped := sql.Select(t.C(child.column), sql.As(sql.Count("*"), "n")).
+				From(t).
+				Where(where).
+				GroupBy(t.C(child.column)).
+				As(fmt.Sprintf("g%d", i))
+			s.LeftJoin(grouped).On(s.C(idColumn), grouped.C(child.column))
+			s.AppendSelect(sql.As(fmt.Sprintf("COALESCE(%s, 0)", grouped.C("n")), fmt.Sprintf("c%d", i)))
+		}
+	}
+}
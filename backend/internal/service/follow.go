@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/ent"
+	"backend/internal/ent/follow"
+	"backend/internal/ent/predicate"
+	"backend/internal/ent/user"
+	"backend/pkg/errors"
+)
+
+// FollowUser 是关注列表、粉丝列表中的一项。
+type FollowUser struct {
+	ID             int       `json:"id"`
+	Nickname       string    `json:"nickname"`
+	Avatar         string    `json:"avatar"`
+	FollowerCount  int       `json:"followerCount"`
+	FollowingCount int       `json:"followingCount"`
+	FollowedAt     time.Time `json:"followedAt"`
+	// IsFollowing 表示当前登录用户是否关注了此人，游客恒为 false
+	IsFollowing bool `json:"isFollowing"`
+}
+
+type FollowPage struct {
+	Users      []*FollowUser `json:"users"`
+	NextCursor string        `json:"nextCursor"`
+}
+
+// ToggleFollow 关注或取消关注用户，want 为空表示切换，返回的 count 为对方最新的粉丝数。
+func (s *UserService) ToggleFollow(ctx context.Context, userID, targetID int, want *bool) (*ToggleResult, error) {
+	if userID == targetID {
+		return nil, errors.ErrFollowSelf
+	}
+	if err := s.checkUser(ctx, targetID); err != nil {
+		return nil, err
+	}
+	return interaction{
+		add: func(ctx context.Context, client *ent.Client) error {
+			return doNothingOnConflict(client.Follow.Create().
+				SetFollowerID(userID).
+				SetFollowingID(targetID).
+				OnConflictColumns(follow.FieldFollowerID, follow.FieldFollowingID).
+				DoNothing().
+				Exec(ctx))
+		},
+		remove: func(ctx context.Context, client *ent.Client) (int, error) {
+			return client.Follow.Delete().
+				Where(follow.FollowerIDEQ(userID), follow.FollowingIDEQ(targetID)).
+				Exec(ctx)
+		},
+		count: func(ctx context.Context, client *ent.Client) (int, error) {
+			return client.User.Query().Where(user.IDEQ(targetID)).Select(user.FieldFollowerCount).Int(ctx)
+		},
+	}.toggle(ctx, s.client, want)
+}
+
+// ListFollowing 按关注时间倒序分页返回 userID 关注的人。
+func (s *UserService) ListFollowing(ctx context.Context, viewerID, userID int, cursor string, limit int) (*FollowPage, error) {
+	return s.listFollows(ctx, viewerID, userID, cursor, limit, false)
+}
+
+// ListFollowers 按关注时间倒序分页返回 userID 的粉丝。
+func (s *UserService) ListFollowers(ctx context.Context, viewerID, userID int, cursor string, limit int) (*FollowPage, error) {
+	return s.listFollows(ctx, viewerID, userID, cursor, limit, true)
+}
+
+// listFollows 是两个列表的共同实现：followers 为 true 时列出粉丝，否则列出关注的人。
+// 用户资料和当前用户的关注状态各用一条查询批量加载。
+func (s *UserService) listFollows(ctx context.Context, viewerID, userID int, cursor string, limit int, followers bool) (*FollowPage, error) {
+	if err := s.checkUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	after, err := DecodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit = feedLimit(limit)
+
+	var owner predicate.Follow
+	if followers {
+		owner = follow.FollowingIDEQ(userID)
+	} else {
+		owner = follow.FollowerIDEQ(userID)
+	}
+	query := s.client.Follow.Query().Where(owner)
+	if after != nil {
+		query.Where(follow.Or(
+			follow.CreateTimeLT(after.CreateTime),
+			follow.And(follow.CreateTimeEQ(after.CreateTime), follow.IDLT(after.ID)),
+		))
+	}
+	// 多取一条用来判断是否还有下一页
+	follows, err := query.
+		Order(ent.Desc(follow.FieldCreateTime), ent.Desc(follow.FieldID)).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	page := &FollowPage{Users: []*FollowUser{}}
+	if len(follows) > limit {
+		follows = follows[:limit]
+		last := follows[limit-1]
+		page.NextCursor = EncodeCursor(Cursor{CreateTime: last.CreateTime, ID: last.ID})
+	}
+	if len(follows) == 0 {
+		return page, nil
+	}
+
+	ids := make([]int, len(follows))
+	for i, f := range follows {
+		if followers {
+			ids[i] = f.FollowerID
+		} else {
+			ids[i] = f.FollowingID
+		}
+	}
+
+	users, err := s.client.User.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	byID := make(map[int]*ent.User, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+
+	followed := make(map[int]bool)
+	if viewerID != 0 {
+		targets, err := s.client.Follow.Query().
+			Where(follow.FollowerIDEQ(viewerID), follow.FollowingIDIn(ids...)).
+			Select(follow.FieldFollowingID).
+			Ints(ctx)
+		if err != nil {
+			return nil, errors.ErrInternalServer
+		}
+		for _, id := range targets {
+			followed[id] = true
+		}
+	}
+
+	for i, f := range follows {
+		u, ok := byID[ids[i]]
+		if !ok {
+			continue
+		}
+		page.Users = append(page.Users, &FollowUser{
+			ID:             u.ID,
+			Nickname:       u.Nickname,
+			Avatar:         u.Avatar,
+			FollowerCount:  u.FollowerCount,
+			FollowingCount: u.FollowingCount,
+			FollowedAt:     f.CreateTime,
+			IsFollowing:    followed[u.ID],
+		})
+	}
+	return page, nil
+}
+
+// checkUser 确认用户存在且没有被清除。
+func (s *UserService) checkUser(ctx context.Context, userID int) error {
+	exists, err := s.client.User.Query().
+		Where(user.IDEQ(userID), user.DeletedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		return errors.ErrInternalServer
+	}
+	if !exists {
+		return errors.ErrUserNotFound
+	}
+	return nil
+}
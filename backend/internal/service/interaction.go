@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+
+	"backend/internal/ent"
+	"backend/internal/ent/post"
+	"backend/internal/ent/question"
+	"backend/internal/ent/userfavoritepost"
+	"backend/internal/ent/userfavoritequestion"
+	"backend/internal/ent/userlikepost"
+	"backend/internal/ent/userlikequestion"
+	"backend/pkg/errors"
+)
+
+// ToggleResult 是点赞、收藏操作之后的状态：status 表示当前是否已点赞/收藏，count 为最新总数。
+type ToggleResult struct {
+	Status bool `json:"status"`
+	Count  int  `json:"count"`
+}
+
+// interaction 把一种点赞/收藏关系的增删查抽象出来，四种关系共用同一套切换逻辑。
+// 三个函数都在 toggle 开启的事务里执行，client 由 toggle 传入。
+type interaction struct {
+	// add 在记录已存在时什么也不做
+	add func(ctx context.Context, client *ent.Client) error
+	// remove 返回删除的行数
+	remove func(ctx context.Context, client *ent.Client) (int, error)
+	// count 读取被点赞/收藏内容上的冗余计数
+	count func(ctx context.Context, client *ent.Client) (int, error)
+}
+
+// toggle 切换或设置关系状态。want 为空时切换：先尝试删除，没删到再插入；
+// want 不为空时直接设为目标状态，重复提交结果相同。
+// 插入依赖唯一索引和 ON CONFLICT DO NOTHING，连点或并发请求都不会产生重复记录，也不会报错。
+// 关系行与计数 hook 的更新在同一事务中提交。
+func (i interaction) toggle(ctx context.Context, client *ent.Client, want *bool) (*ToggleResult, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	c := tx.Client()
+
+	var status bool
+	switch {
+	case want != nil && *want:
+		status = true
+	case want != nil:
+		status = false
+	default:
+		n, err := i.remove(ctx, c)
+		if err != nil {
+			return nil, txFailed(tx, err)
+		}
+		status = n == 0
+	}
+
+	if status {
+		if err := i.add(ctx, c); err != nil {
+			return nil, txFailed(tx, err)
+		}
+	} else if want != nil {
+		if _, err := i.remove(ctx, c); err != nil {
+			return nil, txFailed(tx, err)
+		}
+	}
+
+	count, err := i.count(ctx, c)
+	if err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	return &ToggleResult{Status: status, Count: count}, nil
+}
+
+// ToggleLikePost 点赞或取消点赞文章，want 为空表示切换。
+func (s *UserService) ToggleLikePost(ctx context.Context, userID, postID int, want *bool) (*ToggleResult, error) {
+	if err := s.checkPost(ctx, postID); err != nil {
+		return nil, err
+	}
+	return interaction{
+		add: func(ctx context.Context, client *ent.Client) error {
+			return doNothingOnConflict(client.UserLikePost.Create().
+				SetUserID(userID).
+				SetPostID(postID).
+				OnConflictColumns(userlikepost.FieldUserID, userlikepost.FieldPostID).
+				DoNothing().
+				Exec(ctx))
+		},
+		remove: func(ctx context.Context, client *ent.Client) (int, error) {
+			return client.UserLikePost.Delete().
+				Where(userlikepost.UserIDEQ(userID), userlikepost.PostIDEQ(postID)).
+				Exec(ctx)
+		},
+		count: func(ctx context.Context, client *ent.Client) (int, error) {
+			return client.Post.Query().Where(post.IDEQ(postID)).Select(post.FieldLikeCount).Int(ctx)
+		},
+	}.toggle(ctx, s.client, want)
+}
+
+// ToggleFavoritePost 收藏或取消收藏文章，want 为空表示切换。
+func (s *UserService) ToggleFavoritePost(ctx context.Context, userID, postID int, want *bool) (*ToggleResult, error) {
+	if err := s.checkPost(ctx, postID); err != nil {
+		return nil, err
+	}
+	return interaction{
+		add: func(ctx context.Context, client *ent.Client) error {
+			return doNothingOnConflict(client.UserFavoritePost.Create().
+				SetUserID(userID).
+				SetPostID(postID).
+				OnConflictColumns(userfavoritepost.FieldUserID, userfavoritepost.FieldPostID).
+				DoNothing().
+				Exec(ctx))
+		},
+		remove: func(ctx context.Context, client *ent.Client) (int, error) {
+			return client.UserFavoritePost.Delete().
+				Where(userfavoritepost.UserIDEQ(userID), userfavoritepost.PostIDEQ(postID)).
+				Exec(ctx)
+		},
+		count: func(ctx context.Context, client *ent.Client) (int, error) {
+			return client.Post.Query().Where(post.IDEQ(postID)).Select(post.FieldFavoriteCount).Int(ctx)
+		},
+	}.toggle(ctx, s.client, want)
+}
+
+// ToggleLikeQuestion 点赞或取消点赞问题，want 为空表示切换。
+func (s *UserService) ToggleLikeQuestion(ctx context.Context, userID, questionID int, want *bool) (*ToggleResult, error) {
+	if err := s.checkQuestion(ctx, questionID); err != nil {
+		return nil, err
+	}
+	return interaction{
+		add: func(ctx context.Context, client *ent.Client) error {
+			return doNothingOnConflict(client.UserLikeQuestion.Create().
+				SetUserID(userID).
+				SetQuestionID(questionID).
+				OnConflictColumns(userlikequestion.FieldUserID, userlikequestion.FieldQuestionID).
+				DoNothing().
+				Exec(ctx))
+		},
+		remove: func(ctx context.Context, client *ent.Client) (int, error) {
+			return client.UserLikeQuestion.Delete().
+				Where(userlikequestion.UserIDEQ(userID), userlikequestion.QuestionIDEQ(questionID)).
+				Exec(ctx)
+		},
+		count: func(ctx context.Context, client *ent.Client) (int, error) {
+			return client.Question.Query().Where(question.IDEQ(questionID)).Select(question.FieldLikeCount).Int(ctx)
+		},
+	}.toggle(ctx, s.client, want)
+}
+
+// ToggleFavoriteQuestion 收藏或取消收藏问题，want 为空表示切换。
+func (s *UserService) ToggleFavoriteQuestion(ctx context.Context, userID, questionID int, want *bool) (*ToggleResult, error) {
+	if err := s.checkQuestion(ctx, questionID); err != nil {
+		return nil, err
+	}
+	return interaction{
+		add: func(ctx context.Context, client *ent.Client) error {
+			return doNothingOnConflict(client.UserFavoriteQuestion.Create().
+				SetUserID(userID).
+				SetQuestionID(questionID).
+				OnConflictColumns(userfavoritequestion.FieldUserID, userfavoritequestion.FieldQuestionID).
+				DoNothing().
+				Exec(ctx))
+		},
+		remove: func(ctx context.Context, client *ent.Client) (int, error) {
+			return client.UserFavoriteQuestion.Delete().
+				Where(userfavoritequestion.UserIDEQ(userID), userfavoritequestion.QuestionIDEQ(questionID)).
+				Exec(ctx)
+		},
+		count: func(ctx context.Context, client *ent.Client) (int, error) {
+			return client.Question.Query().Where(question.IDEQ(questionID)).Select(question.FieldFavoriteCount).Int(ctx)
+		},
+	}.toggle(ctx, s.client, want)
+}
+
+func (s *UserService) checkPost(ctx context.Context, postID int) error {
+	exists, err := s.client.Post.Query().Where(post.IDEQ(postID)).Exist(ctx)
+	if err != nil {
+		return errors.ErrInternalServer
+	}
+	if !exists {
+		return errors.ErrPostNotFound
+	}
+	return nil
+}
+
+func (s *UserService) checkQuestion(ctx context.Context, questionID int) error {
+	exists, err := s.client.Question.Query().Where(question.IDEQ(questionID)).Exist(ctx)
+	if err != nil {
+		return errors.ErrInternalServer
+	}
+	if !exists {
+		return errors.ErrQuestionNotFound
+	}
+	return nil
+}
+
+// doNothingOnConflict 吞掉 ON CONFLICT DO NOTHING 在记录已存在时返回的 sql.ErrNoRows：
+// PostgreSQL 下没有插入任何行，RETURNING 也就没有结果。
+func doNothingOnConflict(err error) error {
+	if stderrors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"crypto/subtle"
+	"sync"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const passwordHashCost = bcrypt.DefaultCost
+
+var (
+	dummyHashOnce sync.Once
+	dummyHash     string
+)
+
+func hashPassword(password string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), passwordHashCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hashed), nil
+}
+
+// verifyPassword 校验密码，needsRehash 为 true 表示库里存的是旧版明文密码，
+// 校验通过后调用方应当立即替换为 bcrypt 哈希。
+func verifyPassword(stored, password string) (ok bool, needsRehash bool) {
+	if _, err := bcrypt.Cost([]byte(stored)); err == nil {
+		return bcrypt.CompareHashAndPassword([]byte(stored), []byte(password)) == nil, false
+	}
+	ok = subtle.ConstantTimeCompare([]byte(stored), []byte(password)) == 1
+	return ok, ok
+}
+
+// burnPasswordCheck 在用户不存在时做一次等价的 bcrypt 比对，
+// 让“手机号未注册”和“密码错误”耗时一致，无法借响应时间枚举手机号。
+func burnPasswordCheck(password string) {
+	dummyHashOnce.Do(func() {
+		dummyHash, _ = hashPassword("quibli-timing-equalizer")
+	})
+	verifyPassword(dummyHash, password)
+}
@@ -0,0 +1,331 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/dto"
+	"backend/internal/ent"
+	"backend/internal/ent/comment"
+	"backend/internal/ent/post"
+	"backend/internal/ent/posttag"
+	"backend/internal/ent/predicate"
+	"backend/internal/ent/userfavoritepost"
+	"backend/internal/ent/userlikepost"
+	"backend/pkg/errors"
+)
+
+type PostService struct {
+	client *ent.Client
+	embeds *EmbeddingService
+}
+
+// NewPostService embeds 为 nil 时发布和修改后不计算 embedding。
+func NewPostService(client *ent.Client, embeds *EmbeddingService) *PostService {
+	return &PostService{client: client, embeds: embeds}
+}
+
+// Author 是内容列表和详情里展示的作者信息。
+type Author struct {
+	ID       int    `json:"id"`
+	Nickname string `json:"nickname"`
+	Avatar   string `json:"avatar"`
+}
+
+type PostDetail struct {
+	ID             int       `json:"id"`
+	Title          string    `json:"title"`
+	Content        string    `json:"content"`
+	PublishedAt    time.Time `json:"publishedAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+	TotalLikes     int       `json:"totalLikes"`
+	TotalFavorites int       `json:"totalFavorites"`
+	TotalComments  int       `json:"totalComments"`
+	User           *Author   `json:"user"`
+	Tags           []string  `json:"tags"`
+	// IsLiked、IsFavorited 是当前用户是否点赞、收藏过，游客总是 false
+	IsLiked     bool `json:"isLiked"`
+	IsFavorited bool `json:"isFavorited"`
+}
+
+// Publish 发布文章，作者取自登录态，文章与标签在同一事务中写入。
+func (s *PostService) Publish(ctx context.Context, userID int, req *dto.CreatePostRequest) (*PostDetail, error) {
+	tagNames := normalizeTagNames(req.Tags)
+	if !validTagNames(tagNames) {
+		return nil, errors.ErrInvalidTags
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	p, err := tx.Post.Create().
+		SetTitle(req.Title).
+		SetContent(req.Content).
+		SetUserID(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if err := setPostTags(ctx, tx, p.ID, tagNames); err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	s.embeds.enqueue(post.Table, p.ID)
+
+	return s.Get(ctx, userID, p.ID)
+}
+
+// Get 返回文章详情，包含作者、标签和点赞、收藏、评论数，viewerID 为 0 表示游客。
+func (s *PostService) Get(ctx context.Context, viewerID, id int) (*PostDetail, error) {
+	p, err := s.client.Post.Query().
+		Where(post.IDEQ(id)).
+		WithUser().
+		Select(postColumns...).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrPostNotFound
+		}
+		return nil, errors.ErrInternalServer
+	}
+
+	details, err := s.buildDetails(ctx, viewerID, []*ent.Post{p})
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	return details[0], nil
+}
+
+type PostFeed struct {
+	PostItems []*PostDetail `json:"postItems"`
+	// NextCursor 为空表示没有更多了
+	NextCursor string `json:"nextCursor"`
+}
+
+// List 按发布时间倒序返回一页文章，cursor 为上一页返回的 nextCursor，viewerID 为 0 表示游客。
+func (s *PostService) List(ctx context.Context, viewerID int, cursor string, limit int) (*PostFeed, error) {
+	return s.list(ctx, viewerID, cursor, limit)
+}
+
+// list 是文章信息流的共同实现，where 用来限定范围，例如只看关注的人。
+func (s *PostService) list(ctx context.Context, viewerID int, cursor string, limit int, where ...predicate.Post) (*PostFeed, error) {
+	after, err := DecodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit = feedLimit(limit)
+
+	query := s.client.Post.Query().Where(where...).WithUser()
+	if after != nil {
+		query.Where(post.Or(
+			post.CreateTimeLT(after.CreateTime),
+			post.And(post.CreateTimeEQ(after.CreateTime), post.IDLT(after.ID)),
+		))
+	}
+	// 多取一条用来判断是否还有下一页
+	posts, err := query.
+		Order(ent.Desc(post.FieldCreateTime), ent.Desc(post.FieldID)).
+		Limit(limit + 1).
+		Select(postColumns...).
+		All(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	feed := &PostFeed{}
+	if len(posts) > limit {
+		posts = posts[:limit]
+		last := posts[limit-1]
+		feed.NextCursor = EncodeCursor(Cursor{CreateTime: last.CreateTime, ID: last.ID})
+	}
+	if feed.PostItems, err = s.buildDetails(ctx, viewerID, posts); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	return feed, nil
+}
+
+// buildDetails 为一批文章组装详情，计数取自冗余计数列，标签和当前用户的点赞、收藏状态各用一条查询批量加载。
+// 调用方需要预先加载作者（WithUser）。
+func (s *PostService) buildDetails(ctx context.Context, viewerID int, posts []*ent.Post) ([]*PostDetail, error) {
+	details := make([]*PostDetail, 0, len(posts))
+	if len(posts) == 0 {
+		return details, nil
+	}
+
+	ids := make([]int, len(posts))
+	for i, p := range posts {
+		ids[i] = p.ID
+	}
+
+	var tags []parentTag
+	err := s.client.PostTag.Query().
+		Where(posttag.PostIDIn(ids...)).
+		Modify(withTagNames(posttag.FieldPostID, posttag.FieldTagID, posttag.FieldID)).
+		Scan(ctx, &tags)
+	if err != nil {
+		return nil, err
+	}
+	tagsByID := groupTagNames(tags)
+
+	mine := make(map[int]countRow)
+	if viewerID != 0 {
+		var rows []countRow
+		err := s.client.Post.Query().
+			Where(post.IDIn(ids...)).
+			Modify(withChildCounts(post.FieldID, ids,
+				childCount{table: userlikepost.Table, column: userlikepost.FieldPostID, userColumn: userlikepost.FieldUserID, userID: viewerID},
+				childCount{table: userfavoritepost.Table, column: userfavoritepost.FieldPostID, userColumn: userfavoritepost.FieldUserID, userID: viewerID},
+			)).
+			Scan(ctx, &rows)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rows {
+			mine[r.ID] = r
+		}
+	}
+
+	for _, p := range posts {
+		details = append(details, &PostDetail{
+			ID:             p.ID,
+			Title:          p.Title,
+			Content:        p.Content,
+			PublishedAt:    p.CreateTime,
+			UpdatedAt:      p.UpdateTime,
+			TotalLikes:     p.LikeCount,
+			TotalFavorites: p.FavoriteCount,
+			TotalComments:  p.CommentCount,
+			User:           toAuthor(p.Edges.User),
+			Tags:           nonNil(tagsByID[p.ID]),
+			IsLiked:        mine[p.ID].C0 > 0,
+			IsFavorited:    mine[p.ID].C1 > 0,
+		})
+	}
+	return details, nil
+}
+
+// Update 修改文章标题、正文并整体替换标签，只有作者或内容审核员可以修改。
+func (s *PostService) Update(ctx context.Context, userID int, canModerate bool, id int, req *dto.UpdatePostRequest) (*PostDetail, error) {
+	tagNames := normalizeTagNames(req.Tags)
+	if !validTagNames(tagNames) {
+		return nil, errors.ErrInvalidTags
+	}
+
+	if err := s.checkOwner(ctx, userID, canModerate, id); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	if err := tx.Post.UpdateOneID(id).
+		SetTitle(req.Title).
+		SetContent(req.Content).
+		// 旧向量随编辑作废，重新计算的任务即使被丢弃，Backfill 也能按 embedding 为空补上
+		ClearEmbedding().
+		Exec(ctx); err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if _, err := tx.PostTag.Delete().Where(posttag.PostIDEQ(id)).Exec(ctx); err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if err := setPostTags(ctx, tx, id, tagNames); err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	s.embeds.enqueue(post.Table, id)
+
+	return s.Get(ctx, userID, id)
+}
+
+// Delete 删除文章及其评论、点赞、收藏和标签关联，只有作者或内容审核员可以删除。
+func (s *PostService) Delete(ctx context.Context, userID int, canModerate bool, id int) error {
+	if err := s.checkOwner(ctx, userID, canModerate, id); err != nil {
+		return err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return errors.ErrInternalServer
+	}
+	if err := deletePosts(ctx, tx, []int{id}); err != nil {
+		return txFailed(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.ErrInternalServer
+	}
+	return nil
+}
+
+func (s *PostService) checkOwner(ctx context.Context, userID int, canModerate bool, id int) error {
+	p, err := s.client.Post.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.ErrPostNotFound
+		}
+		return errors.ErrInternalServer
+	}
+	if p.UserID != userID && !canModerate {
+		return errors.ErrForbidden
+	}
+	return nil
+}
+
+func setPostTags(ctx context.Context, tx *ent.Tx, postID int, names []string) error {
+	tags, err := upsertTags(ctx, tx, names)
+	if err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	creates := make([]*ent.PostTagCreate, len(tags))
+	for i, t := range tags {
+		creates[i] = tx.PostTag.Create().SetPostID(postID).SetTagID(t.ID)
+	}
+	return tx.PostTag.CreateBulk(creates...).Exec(ctx)
+}
+
+// deletePosts 在事务内删除文章以及挂在文章上的全部关联行。
+func deletePosts(ctx context.Context, tx *ent.Tx, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if _, err := tx.UserLikePost.Delete().Where(userlikepost.PostIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.UserFavoritePost.Delete().Where(userfavoritepost.PostIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.PostTag.Delete().Where(posttag.PostIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.Comment.Delete().Where(comment.PostIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	_, err := tx.Post.Delete().Where(post.IDIn(ids...)).Exec(ctx)
+	return err
+}
+
+func toAuthor(u *ent.User) *Author {
+	if u == nil {
+		return nil
+	}
+	return &Author{
+		ID:       u.ID,
+		Nickname: u.Nickname,
+		Avatar:   u.Avatar,
+	}
+}
+
+// txFailed 回滚事务，并把错误统一为 ErrInternalServer 交给 handler。
+func txFailed(tx *ent.Tx, err error) error {
+	_ = rollback(tx, err)
+	return errors.ErrInternalServer
+}
@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/dto"
+	"backend/internal/ent"
+	"backend/internal/ent/answer"
+	"backend/internal/ent/comment"
+	"backend/internal/ent/predicate"
+	"backend/internal/ent/question"
+	"backend/internal/ent/questiontag"
+	"backend/internal/ent/userfavoritequestion"
+	"backend/internal/ent/userlikequestion"
+	"backend/pkg/errors"
+)
+
+type QuestionService struct {
+	client *ent.Client
+	embeds *EmbeddingService
+}
+
+// NewQuestionService embeds 为 nil 时发布和修改后不计算 embedding。
+func NewQuestionService(client *ent.Client, embeds *EmbeddingService) *QuestionService {
+	return &QuestionService{client: client, embeds: embeds}
+}
+
+type QuestionDetail struct {
+	ID             int       `json:"id"`
+	Title          string    `json:"title"`
+	Body           string    `json:"body"`
+	PublishedAt    time.Time `json:"publishedAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+	TotalAnswers   int       `json:"totalAnswers"`
+	TotalComments  int       `json:"totalComments"`
+	TotalLikes     int       `json:"totalLikes"`
+	TotalFavorites int       `json:"totalFavorites"`
+	// AcceptedAnswerID 是被采纳的回答，未采纳时为空
+	AcceptedAnswerID *int     `json:"acceptedAnswerId"`
+	User             *Author  `json:"user"`
+	Tags             []string `json:"tags"`
+	// IsLiked、IsFavorited 是当前用户是否点赞、收藏过，游客总是 false
+	IsLiked     bool `json:"isLiked"`
+	IsFavorited bool `json:"isFavorited"`
+}
+
+// 问题列表的筛选条件
+const (
+	// QuestionFilterUnanswered 只看还没有回答的问题
+	QuestionFilterUnanswered = "unanswered"
+	// QuestionFilterUnaccepted 只看还没有采纳回答的问题
+	QuestionFilterUnaccepted = "unaccepted"
+)
+
+// Publish 发布问题，作者取自登录态，问题与标签在同一事务中写入。
+func (s *QuestionService) Publish(ctx context.Context, userID int, req *dto.CreateQuestionRequest) (*QuestionDetail, error) {
+	tagNames := normalizeTagNames(req.Tags)
+	if !validTagNames(tagNames) {
+		return nil, errors.ErrInvalidTags
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	q, err := tx.Question.Create().
+		SetTitle(req.Title).
+		SetBody(req.Body).
+		SetUserID(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if err := setQuestionTags(ctx, tx, q.ID, tagNames); err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	s.embeds.enqueue(question.Table, q.ID)
+
+	return s.Get(ctx, userID, q.ID)
+}
+
+// Get 返回问题详情，包含作者、标签和回答、评论、点赞、收藏数，viewerID 为 0 表示游客。
+func (s *QuestionService) Get(ctx context.Context, viewerID, id int) (*QuestionDetail, error) {
+	q, err := s.client.Question.Query().
+		Where(question.IDEQ(id)).
+		WithUser().
+		Select(questionColumns...).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrQuestionNotFound
+		}
+		return nil, errors.ErrInternalServer
+	}
+
+	details, err := s.buildDetails(ctx, viewerID, []*ent.Question{q})
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	return details[0], nil
+}
+
+type QuestionFeed struct {
+	QuestionItems []*QuestionDetail `json:"questionItems"`
+	// NextCursor 为空表示没有更多了
+	NextCursor string `json:"nextCursor"`
+}
+
+// List 按发布时间倒序返回一页问题，cursor 为上一页返回的 nextCursor，filter 为空表示不筛选，viewerID 为 0 表示游客。
+func (s *QuestionService) List(ctx context.Context, viewerID int, cursor string, limit int, filter string) (*QuestionFeed, error) {
+	var where []predicate.Question
+	switch filter {
+	case QuestionFilterUnanswered:
+		where = append(where, question.Not(question.HasAnswers()))
+	case QuestionFilterUnaccepted:
+		where = append(where, question.AcceptedAnswerIDIsNil())
+	}
+	return s.list(ctx, viewerID, cursor, limit, where...)
+}
+
+// list 是问题信息流的共同实现，where 用来限定范围，例如筛选条件或只看关注的人。
+func (s *QuestionService) list(ctx context.Context, viewerID int, cursor string, limit int, where ...predicate.Question) (*QuestionFeed, error) {
+	after, err := DecodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit = feedLimit(limit)
+
+	query := s.client.Question.Query().Where(where...).WithUser()
+	if after != nil {
+		query.Where(question.Or(
+			question.CreateTimeLT(after.CreateTime),
+			question.And(question.CreateTimeEQ(after.CreateTime), question.IDLT(after.ID)),
+		))
+	}
+	// 多取一条用来判断是否还有下一页
+	questions, err := query.
+		Order(ent.Desc(question.FieldCreateTime), ent.Desc(question.FieldID)).
+		Limit(limit + 1).
+		Select(questionColumns...).
+		All(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	feed := &QuestionFeed{}
+	if len(questions) > limit {
+		questions = questions[:limit]
+		last := questions[limit-1]
+		feed.NextCursor = EncodeCursor(Cursor{CreateTime: last.CreateTime, ID: last.ID})
+	}
+	if feed.QuestionItems, err = s.buildDetails(ctx, viewerID, questions); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	return feed, nil
+}
+
+// buildDetails 为一批问题组装详情，计数取自冗余计数列，标签和当前用户的点赞、收藏状态各用一条查询批量加载。
+// 调用方需要预先加载作者（WithUser）。
+func (s *QuestionService) buildDetails(ctx context.Context, viewerID int, questions []*ent.Question) ([]*QuestionDetail, error) {
+	details := make([]*QuestionDetail, 0, len(questions))
+	if len(questions) == 0 {
+		return details, nil
+	}
+
+	ids := make([]int, len(questions))
+	for i, q := range questions {
+		ids[i] = q.ID
+	}
+
+	var tags []parentTag
+	err := s.client.QuestionTag.Query().
+		Where(questiontag.QuestionIDIn(ids...)).
+		Modify(withTagNames(questiontag.FieldQuestionID, questiontag.FieldTagID, questiontag.FieldID)).
+		Scan(ctx, &tags)
+	if err != nil {
+		return nil, err
+	}
+	tagsByID := groupTagNames(tags)
+
+	mine := make(map[int]countRow)
+	if viewerID != 0 {
+		var rows []countRow
+		err := s.client.Question.Query().
+			Where(question.IDIn(ids...)).
+			Modify(withChildCounts(question.FieldID, ids,
+				childCount{table: userlikequestion.Table, column: userlikequestion.FieldQuestionID, userColumn: userlikequestion.FieldUserID, userID: viewerID},
+				childCount{table: userfavoritequestion.Table, column: userfavoritequestion.FieldQuestionID, userColumn: userfavoritequestion.FieldUserID, userID: viewerID},
+			)).
+			Scan(ctx, &rows)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rows {
+			mine[r.ID] = r
+		}
+	}
+
+	for _, q := range questions {
+		details = append(details, &QuestionDetail{
+			ID:               q.ID,
+			Title:            q.Title,
+			Body:             q.Body,
+			PublishedAt:      q.CreateTime,
+			UpdatedAt:        q.UpdateTime,
+			TotalAnswers:     q.AnswerCount,
+			TotalComments:    q.CommentCount,
+			TotalLikes:       q.LikeCount,
+			TotalFavorites:   q.FavoriteCount,
+			AcceptedAnswerID: q.AcceptedAnswerID,
+			User:             toAuthor(q.Edges.User),
+			Tags:             nonNil(tagsByID[q.ID]),
+			IsLiked:          mine[q.ID].C0 > 0,
+			IsFavorited:      mine[q.ID].C1 > 0,
+		})
+	}
+	return details, nil
+}
+
+// Update 修改问题标题、描述并整体替换标签，只有作者或内容审核员可以修改。
+func (s *QuestionService) Update(ctx context.Context, userID int, canModerate bool, id int, req *dto.UpdateQuestionRequest) (*QuestionDetail, error) {
+	tagNames := normalizeTagNames(req.Tags)
+	if !validTagNames(tagNames) {
+		return nil, errors.ErrInvalidTags
+	}
+
+	if err := s.checkOwner(ctx, userID, canModerate, id); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	if err := tx.Question.UpdateOneID(id).
+		SetTitle(req.Title).
+		SetBody(req.Body).
+		// 旧向量随编辑作废，重新计算的任务即使被丢弃，Backfill 也能按 embedding 为空补上
+		ClearEmbedding().
+		Exec(ctx); err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if _, err := tx.QuestionTag.Delete().Where(questiontag.QuestionIDEQ(id)).Exec(ctx); err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if err := setQuestionTags(ctx, tx, id, tagNames); err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	s.embeds.enqueue(question.Table, id)
+
+	return s.Get(ctx, userID, id)
+}
+
+// Delete 删除问题及其回答、评论、点赞、收藏和标签关联，只有作者或内容审核员可以删除。
+func (s *QuestionService) Delete(ctx context.Context, userID int, canModerate bool, id int) error {
+	if err := s.checkOwner(ctx, userID, canModerate, id); err != nil {
+		return err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return errors.ErrInternalServer
+	}
+	if err := deleteQuestions(ctx, tx, []int{id}); err != nil {
+		return txFailed(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.ErrInternalServer
+	}
+	return nil
+}
+
+func (s *QuestionService) checkOwner(ctx context.Context, userID int, canModerate bool, id int) error {
+	q, err := s.client.Question.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.ErrQuestionNotFound
+		}
+		return errors.ErrInternalServer
+	}
+	if q.UserID != userID && !canModerate {
+		return errors.ErrForbidden
+	}
+	return nil
+}
+
+func setQuestionTags(ctx context.Context, tx *ent.Tx, questionID int, names []string) error {
+	tags, err := upsertTags(ctx, tx, names)
+	if err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	creates := make([]*ent.QuestionTagCreate, len(tags))
+	for i, t := range tags {
+		creates[i] = tx.QuestionTag.Create().SetQuestionID(questionID).SetTagID(t.ID)
+	}
+	return tx.QuestionTag.CreateBulk(creates...).Exec(ctx)
+}
+
+// deleteQuestions 在事务内删除问题以及挂在问题上的全部关联行。
+func deleteQuestions(ctx context.Context, tx *ent.Tx, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	answerIDs, err := tx.Answer.Query().Where(answer.QuestionIDIn(ids...)).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if err := deleteAnswers(ctx, tx, answerIDs); err != nil {
+		return err
+	}
+	if _, err := tx.UserLikeQuestion.Delete().Where(userlikequestion.QuestionIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.UserFavoriteQuestion.Delete().Where(userfavoritequestion.QuestionIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.QuestionTag.Delete().Where(questiontag.QuestionIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.Comment.Delete().Where(comment.QuestionIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	_, err = tx.Question.Delete().Where(question.IDIn(ids...)).Exec(ctx)
+	return err
+}
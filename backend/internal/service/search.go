@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect/sql"
+
+	"backend/internal/ent"
+	"backend/internal/ent/post"
+	"backend/internal/ent/question"
+	"backend/internal/pgvector"
+	"backend/pkg/errors"
+)
+
+// 搜索方式
+const (
+	// SearchHybrid 同时做关键词匹配和向量检索，两路结果按倒数排名融合
+	SearchHybrid = "hybrid"
+	// SearchLexical 只做关键词匹配，没有配置 embedding 或计算失败时也会退化成这种方式
+	SearchLexical = "lexical"
+)
+
+const (
+	// searchMaxResults 是可以翻到的结果总数，每路最多取这么多候选
+	searchMaxResults = 200
+	// searchMaxTerms 是参与匹配的关键词个数，多余的忽略
+	searchMaxTerms = 5
+	// searchContentRunes 是同时匹配正文的最短关键词长度。pg_trgm 的 GIN 索引对不足三个字的子串
+	// 取不出三元组，只能扫描整个索引，更短的关键词只匹配标题
+	searchContentRunes = 3
+	// rrfK 是倒数排名融合的平滑常数，越大越看重排名靠后的结果，60 是论文中的常用值
+	rrfK = 60
+	// queryEmbedTimeout 是计算搜索词向量的最长等待时间，超时后只用关键词结果
+	queryEmbedTimeout = 3 * time.Second
+)
+
+// SearchService 提供文章和问题的搜索。关键词匹配擅长专有名词和短词，向量检索擅长近义表达，
+// 两路各取候选后用 RRF（reciprocal rank fusion）合并，不需要把两种分数换算到同一尺度。
+type SearchService struct {
+	client    *ent.Client
+	posts     *PostService
+	questions *QuestionService
+	tags      *TagService
+	embeds    *EmbeddingService
+
+	mu          sync.Mutex
+	suggestions map[string]suggestEntry
+}
+
+func NewSearchService(client *ent.Client, posts *PostService, questions *QuestionService, tags *TagService, embeds *EmbeddingService) *SearchService {
+	return &SearchService{
+		client:      client,
+		posts:       posts,
+		questions:   questions,
+		tags:        tags,
+		embeds:      embeds,
+		suggestions: make(map[string]suggestEntry),
+	}
+}
+
+type PostSearchResult struct {
+	PostItems []*PostDetail `json:"postItems"`
+	HasMore   bool          `json:"hasMore"`
+	// Mode 是实际使用的搜索方式
+	Mode string `json:"mode"`
+}
+
+type QuestionSearchResult struct {
+	QuestionItems []*QuestionDetail `json:"questionItems"`
+	HasMore       bool              `json:"hasMore"`
+	// Mode 是实际使用的搜索方式
+	Mode string `json:"mode"`
+}
+
+// SearchPosts 返回第 page 页（从 1 开始）与 keyword 相关的文章，按相关度排列，viewerID 为 0 表示游客。
+func (s *SearchService) SearchPosts(ctx context.Context, viewerID int, keyword, mode string, page, limit int) (*PostSearchResult, error) {
+	result := &PostSearchResult{PostItems: []*PostDetail{}, Mode: SearchLexical}
+	keyword, terms := searchTerms(keyword)
+	offset, limit, ok := searchPage(page, limit)
+	if len(terms) == 0 || !ok {
+		return result, nil
+	}
+
+	query := s.client.Post.Query()
+	for _, t := range terms {
+		if utf8.RuneCountInString(t) < searchContentRunes {
+			query.Where(post.TitleContainsFold(t))
+			continue
+		}
+		query.Where(post.Or(post.TitleContainsFold(t), post.ContentContainsFold(t)))
+	}
+	lexical, err := query.
+		Order(post.OrderOption(lexicalOrder(post.FieldTitle, keyword)), ent.Desc(post.FieldID)).
+		Limit(offset + limit + 1).
+		IDs(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	ranked := [][]int{lexical}
+	if v := s.queryVector(ctx, keyword, mode); v != nil {
+		semantic, err := s.client.Post.Query().
+			Where(post.EmbeddingNotNil()).
+			Order(post.OrderOption(pgvector.OrderByCosineDistance(post.FieldEmbedding, v))).
+			Limit(offset + limit + 1).
+			IDs(ctx)
+		if err != nil {
+			return nil, errors.ErrInternalServer
+		}
+		ranked = append(ranked, semantic)
+		result.Mode = SearchHybrid
+	}
+
+	ids := fuseRanks(ranked...)
+	ids, result.HasMore = pageOf(ids, offset, limit)
+	if len(ids) == 0 {
+		return result, nil
+	}
+	posts, err := s.client.Post.Query().Where(post.IDIn(ids...)).WithUser().Select(postColumns...).All(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	byID := make(map[int]*ent.Post, len(posts))
+	for _, p := range posts {
+		byID[p.ID] = p
+	}
+	ordered := make([]*ent.Post, 0, len(ids))
+	for _, id := range ids {
+		if p, ok := byID[id]; ok {
+			ordered = append(ordered, p)
+		}
+	}
+	if result.PostItems, err = s.posts.buildDetails(ctx, viewerID, ordered); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	return result, nil
+}
+
+// SearchQuestions 返回第 page 页（从 1 开始）与 keyword 相关的问题，按相关度排列，viewerID 为 0 表示游客。
+func (s *SearchService) SearchQuestions(ctx context.Context, viewerID int, keyword, mode string, page, limit int) (*QuestionSearchResult, error) {
+	result := &QuestionSearchResult{QuestionItems: []*QuestionDetail{}, Mode: SearchLexical}
+	keyword, terms := searchTerms(keyword)
+	offset, limit, ok := searchPage(page, limit)
+	if len(terms) == 0 || !ok {
+		return result, nil
+	}
+
+	query := s.client.Question.Query()
+	for _, t := range terms {
+		if utf8.RuneCountInString(t) < searchContentRunes {
+			query.Where(question.TitleContainsFold(t))
+			continue
+		}
+		query.Where(question.Or(question.TitleContainsFold(t), question.BodyContainsFold(t)))
+	}
+	lexical, err := query.
+		Order(question.OrderOption(lexicalOrder(question.FieldTitle, keyword)), ent.Desc(question.FieldID)).
+		Limit(offset + limit + 1).
+		IDs(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	ranked := [][]int{lexical}
+	if v := s.queryVector(ctx, keyword, mode); v != nil {
+		semantic, err := s.client.Question.Query().
+			Where(question.EmbeddingNotNil()).
+			Order(question.OrderOption(pgvector.OrderByCosineDistance(question.FieldEmbedding, v))).
+			Limit(offset + limit + 1).
+			IDs(ctx)
+		if err != nil {
+			return nil, errors.ErrInternalServer
+		}
+		ranked = append(ranked, semantic)
+		result.Mode = SearchHybrid
+	}
+
+	ids := fuseRanks(ranked...)
+	ids, result.HasMore = pageOf(ids, offset, limit)
+	if len(ids) == 0 {
+		return result, nil
+	}
+	questions, err := s.client.Question.Query().Where(question.IDIn(ids...)).WithUser().Select(questionColumns...).All(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	byID := make(map[int]*ent.Question, len(questions))
+	for _, q := range questions {
+		byID[q.ID] = q
+	}
+	ordered := make([]*ent.Question, 0, len(ids))
+	for _, id := range ids {
+		if q, ok := byID[id]; ok {
+			ordered = append(ordered, q)
+		}
+	}
+	if result.QuestionItems, err = s.questions.buildDetails(ctx, viewerID, ordered); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	return result, nil
+}
+
+// queryVector 在 hybrid 方式下计算搜索词的向量，没有配置 embedding、超时或失败时返回 nil，只用关键词结果。
+func (s *SearchService) queryVector(ctx context.Context, keyword, mode string) pgvector.Vector {
+	if mode == SearchLexical {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, queryEmbedTimeout)
+	defer cancel()
+	v, err := s.embeds.embedQuery(ctx, keyword)
+	if err != nil {
+		log.Printf("search: falling back to lexical: %v", err)
+		return nil
+	}
+	return v
+}
+
+// searchTerms 把搜索词的空白压成一个空格，并拆出参与匹配的关键词，每个关键词都必须出现在标题或正文中，
+// 不足 searchContentRunes 个字的只看标题。
+func searchTerms(keyword string) (string, []string) {
+	terms := strings.Fields(keyword)
+	if len(terms) > searchMaxTerms {
+		terms = terms[:searchMaxTerms]
+	}
+	return strings.Join(terms, " "), terms
+}
+
+// searchPage 把页码换算成偏移量，超出可翻范围时返回 false。
+func searchPage(page, limit int) (int, int, bool) {
+	limit = feedLimit(limit)
+	if page <= 0 {
+		page = 1
+	}
+	offset := (page - 1) * limit
+	return offset, limit, offset < searchMaxResults
+}
+
+// lexicalOrder 先排标题中整体包含搜索词的，再按标题与搜索词的 pg_trgm 词相似度排列。
+func lexicalOrder(titleColumn, keyword string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		title := s.C(titleColumn)
+		s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+			b.Wrap(func(b *sql.Builder) {
+				b.Join(sql.ContainsFold(title, keyword))
+			})
+			b.WriteString(" DESC, word_similarity(").Arg(keyword).WriteString(", ").Ident(title).WriteString(") DESC")
+		}))
+	}
+}
+
+// fuseRanks 按倒数排名融合多路有序结果：每出现在一路的第 r 名（从 1 开始）得 1/(rrfK+r) 分，
+// 按总分从高到低排列，同分时 id 大的（较新的）在前。
+func fuseRanks(lists ...[]int) []int {
+	scores := make(map[int]float64)
+	for _, ids := range lists {
+		for r, id := range ids {
+			scores[id] += 1 / float64(rrfK+r+1)
+		}
+	}
+	fused := make([]int, 0, len(scores))
+	for id := range scores {
+		fused = append(fused, id)
+	}
+	sort.Slice(fused, func(i, j int) bool {
+		if scores[fused[i]] != scores[fused[j]] {
+			return scores[fused[i]] > scores[fused[j]]
+		}
+		return fused[i] > fused[j]
+	})
+	return fused
+}
+
+// pageOf 取出 [offset, offset+limit) 这一页，并报告后面是否还有结果。
+func pageOf(ids []int, offset, limit int) ([]int, bool) {
+	if offset >= len(ids) {
+		return nil, false
+	}
+	end := offset + limit
+	if end >= len(ids) {
+		return ids[offset:], false
+	}
+	return ids[offset:end], end < searchMaxResults
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"backend/internal/ent"
+	"backend/internal/ent/predicate"
+	"backend/internal/ent/refreshtoken"
+	"backend/internal/ent/session"
+	"backend/pkg/errors"
+)
+
+// ClientInfo 描述发起登录的客户端，用于会话列表展示。
+type ClientInfo struct {
+	Device    string
+	UserAgent string
+	IP        string
+}
+
+type SessionItem struct {
+	ID              int       `json:"id"`
+	Device          string    `json:"device"`
+	UserAgent       string    `json:"userAgent"`
+	IP              string    `json:"ip"`
+	CreatedAt       time.Time `json:"createdAt"`
+	LastRefreshedAt time.Time `json:"lastRefreshedAt"`
+	Current         bool      `json:"current"`
+}
+
+func (s *AuthService) createSession(ctx context.Context, userID int, info *ClientInfo) (*ent.Session, error) {
+	create := s.client.Session.Create().SetUserID(userID)
+	if info != nil {
+		create.
+			SetDevice(truncate(info.Device, 100)).
+			SetUserAgent(truncate(info.UserAgent, 512)).
+			SetIP(truncate(info.IP, 64))
+	}
+	return create.Save(ctx)
+}
+
+// IsSessionActive 供鉴权中间件使用，会话被吊销后其 access token 立即失效。
+func (s *AuthService) IsSessionActive(ctx context.Context, userID, sessionID int) (bool, error) {
+	return s.client.Session.Query().
+		Where(
+			session.IDEQ(sessionID),
+			session.UserIDEQ(userID),
+			session.RevokedAtIsNil(),
+		).
+		Exist(ctx)
+}
+
+// Logout 吊销当前 access token 所属的会话。
+func (s *AuthService) Logout(ctx context.Context, userID, sessionID int) error {
+	return s.RevokeSession(ctx, userID, sessionID)
+}
+
+func (s *AuthService) ListSessions(ctx context.Context, userID, currentSessionID int) ([]*SessionItem, error) {
+	sessions, err := s.client.Session.Query().
+		Where(
+			session.UserIDEQ(userID),
+			session.RevokedAtIsNil(),
+			session.HasRefreshTokensWith(
+				refreshtoken.RevokedAtIsNil(),
+				refreshtoken.UsedAtIsNil(),
+				refreshtoken.ExpiresAtGT(time.Now()),
+			),
+		).
+		Order(ent.Desc(session.FieldLastRefreshTime)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	items := make([]*SessionItem, 0, len(sessions))
+	for _, sess := range sessions {
+		items = append(items, &SessionItem{
+			ID:              sess.ID,
+			Device:          sess.Device,
+			UserAgent:       sess.UserAgent,
+			IP:              sess.IP,
+			CreatedAt:       sess.CreateTime,
+			LastRefreshedAt: sess.LastRefreshTime,
+			Current:         sess.ID == currentSessionID,
+		})
+	}
+	return items, nil
+}
+
+// RevokeSession 吊销用户名下的某个会话，会话不存在或不属于该用户时返回 ErrNotFound。
+func (s *AuthService) RevokeSession(ctx context.Context, userID, sessionID int) error {
+	owned, err := s.client.Session.Query().
+		Where(session.IDEQ(sessionID), session.UserIDEQ(userID)).
+		Exist(ctx)
+	if err != nil {
+		return errors.ErrInternalServer
+	}
+	if !owned {
+		return errors.ErrNotFound
+	}
+
+	if err := s.revokeSession(ctx, sessionID); err != nil {
+		return errors.ErrInternalServer
+	}
+	return nil
+}
+
+func (s *AuthService) revokeSession(ctx context.Context, sessionID int) error {
+	return s.revokeSessionsWhere(ctx,
+		[]predicate.Session{session.IDEQ(sessionID)},
+		[]predicate.RefreshToken{refreshtoken.SessionIDEQ(sessionID)},
+	)
+}
+
+// revokeUserSessions 吊销用户的全部会话，用于重置密码等需要全端下线的场景。
+func (s *AuthService) revokeUserSessions(ctx context.Context, userID int) error {
+	return s.revokeSessionsWhere(ctx,
+		[]predicate.Session{session.UserIDEQ(userID)},
+		[]predicate.RefreshToken{refreshtoken.UserIDEQ(userID)},
+	)
+}
+
+func (s *AuthService) revokeSessionsWhere(ctx context.Context, sessionPreds []predicate.Session, tokenPreds []predicate.RefreshToken) error {
+	now := time.Now()
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := tx.Session.Update().
+		Where(append(sessionPreds, session.RevokedAtIsNil())...).
+		SetRevokedAt(now).
+		Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+	if err := tx.RefreshToken.Update().
+		Where(append(tokenPreds, refreshtoken.RevokedAtIsNil())...).
+		SetRevokedAt(now).
+		Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return rerr
+	}
+	return err
+}
+
+// truncate 按字节截断，保证不会切断多字节字符。
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"backend/internal/ent"
+	"backend/internal/ent/post"
+	"backend/internal/ent/predicate"
+	"backend/internal/ent/question"
+	"backend/internal/pgvector"
+	"backend/pkg/errors"
+)
+
+const (
+	// defaultSuggestLimit 与旧服务一致，一次给出 7 条建议
+	defaultSuggestLimit = 7
+	maxSuggestLimit     = 10
+	// suggestSemanticRunes 是开始混入语义相近标题的输入长度，更短的输入只按前缀匹配，不请求模型服务
+	suggestSemanticRunes = 4
+	// suggestMaxDistance 是语义建议允许的最大余弦距离，太远的标题不如不给
+	suggestMaxDistance = 0.6
+	// suggestEmbedTimeout 比搜索更短，边输入边请求时宁可只给前缀结果
+	suggestEmbedTimeout = time.Second
+	// suggestTTL 内相同的输入直接使用缓存；缓存超过 suggestCacheSize 条时先清掉过期的，仍然满就整个清空
+	suggestTTL       = time.Minute
+	suggestCacheSize = 1000
+)
+
+type suggestEntry struct {
+	titles    []string
+	expiresAt time.Time
+}
+
+// titleDistance 是语义建议查询的一行结果。
+type titleDistance struct {
+	Title    string  `sql:"title"`
+	Distance float64 `sql:"distance"`
+}
+
+// Suggest 返回边输入边展示的搜索建议：以 keyword 开头的文章、问题标题和标签名，
+// 输入达到 suggestSemanticRunes 个字后再混入语义相近的标题。同一标题在文章和问题中只出现一次。
+func (s *SearchService) Suggest(ctx context.Context, keyword string, limit int) ([]string, error) {
+	keyword = strings.Join(strings.Fields(keyword), " ")
+	if limit <= 0 || limit > maxSuggestLimit {
+		limit = defaultSuggestLimit
+	}
+	if keyword == "" {
+		return []string{}, nil
+	}
+
+	key := strings.ToLower(keyword)
+	s.mu.Lock()
+	entry, ok := s.suggestions[key]
+	s.mu.Unlock()
+	if !ok || time.Now().After(entry.expiresAt) {
+		titles, err := s.suggest(ctx, keyword)
+		if err != nil {
+			return nil, err
+		}
+		entry = suggestEntry{titles: titles, expiresAt: time.Now().Add(suggestTTL)}
+		s.mu.Lock()
+		s.cacheSuggestions(key, entry)
+		s.mu.Unlock()
+	}
+
+	if len(entry.titles) > limit {
+		return entry.titles[:limit], nil
+	}
+	return entry.titles, nil
+}
+
+// suggest 按最大条数计算建议，Suggest 再按 limit 截取。前缀结果先占一半位置，
+// 接着是语义结果，剩下的位置再由前缀结果补满。
+func (s *SearchService) suggest(ctx context.Context, keyword string) ([]string, error) {
+	prefix, err := s.prefixSuggestions(ctx, keyword)
+	if err != nil {
+		return nil, err
+	}
+	var semantic []string
+	if utf8.RuneCountInString(keyword) >= suggestSemanticRunes {
+		if semantic, err = s.semanticSuggestions(ctx, keyword); err != nil {
+			return nil, err
+		}
+	}
+
+	out := []string{}
+	seen := make(map[string]bool)
+	add := func(titles []string, n int) []string {
+		for len(titles) > 0 && len(out) < n {
+			title := strings.TrimSpace(titles[0])
+			titles = titles[1:]
+			if k := strings.ToLower(title); title != "" && !seen[k] {
+				seen[k] = true
+				out = append(out, title)
+			}
+		}
+		return titles
+	}
+	if len(semantic) > 0 {
+		prefix = add(prefix, maxSuggestLimit/2)
+	}
+	add(semantic, maxSuggestLimit)
+	add(prefix, maxSuggestLimit)
+	return out, nil
+}
+
+// prefixSuggestions 返回以 keyword 开头的标签名和标题，标签在前，标题按点赞数倒序。
+// 标题前缀区分大小写才能用上 varchar_pattern_ops 索引，因此同时匹配首字母大写和全小写的写法。
+func (s *SearchService) prefixSuggestions(ctx context.Context, keyword string) ([]string, error) {
+	var out []string
+	tags, err := s.tags.Suggest(ctx, keyword, maxSuggestLimit)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tags {
+		out = append(out, t.Name)
+	}
+
+	variants := prefixVariants(keyword)
+	postPreds := make([]predicate.Post, len(variants))
+	questionPreds := make([]predicate.Question, len(variants))
+	for i, v := range variants {
+		postPreds[i] = post.TitleHasPrefix(v)
+		questionPreds[i] = question.TitleHasPrefix(v)
+	}
+	posts, err := s.client.Post.Query().
+		Where(post.Or(postPreds...)).
+		Order(ent.Desc(post.FieldLikeCount), ent.Desc(post.FieldID)).
+		Limit(maxSuggestLimit).
+		Select(post.FieldTitle).
+		Strings(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	questions, err := s.client.Question.Query().
+		Where(question.Or(questionPreds...)).
+		Order(ent.Desc(question.FieldLikeCount), ent.Desc(question.FieldID)).
+		Limit(maxSuggestLimit).
+		Select(question.FieldTitle).
+		Strings(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	return append(append(out, posts...), questions...), nil
+}
+
+// semanticSuggestions 返回与 keyword 语义相近的文章和问题标题，按距离从近到远排列。
+// 没有配置 embedding、超时或失败时返回空，不影响前缀结果。
+func (s *SearchService) semanticSuggestions(ctx context.Context, keyword string) ([]string, error) {
+	embedCtx, cancel := context.WithTimeout(ctx, suggestEmbedTimeout)
+	v, err := s.embeds.embedQuery(embedCtx, keyword)
+	cancel()
+	if err != nil {
+		log.Printf("suggest: skipping semantic suggestions: %v", err)
+		return nil, nil
+	}
+	if v == nil {
+		return nil, nil
+	}
+
+	var rows, questionRows []titleDistance
+	err = s.client.Post.Query().
+		Where(post.EmbeddingNotNil(), predicate.Post(pgvector.CosineDistanceLT(post.FieldEmbedding, v, suggestMaxDistance))).
+		Order(post.OrderOption(pgvector.OrderByCosineDistance(post.FieldEmbedding, v))).
+		Limit(maxSuggestLimit).
+		Select(post.FieldTitle).
+		Modify(pgvector.SelectCosineDistance(post.FieldEmbedding, v, "distance")).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	err = s.client.Question.Query().
+		Where(question.EmbeddingNotNil(), predicate.Question(pgvector.CosineDistanceLT(question.FieldEmbedding, v, suggestMaxDistance))).
+		Order(question.OrderOption(pgvector.OrderByCosineDistance(question.FieldEmbedding, v))).
+		Limit(maxSuggestLimit).
+		Select(question.FieldTitle).
+		Modify(pgvector.SelectCosineDistance(question.FieldEmbedding, v, "distance")).
+		Scan(ctx, &questionRows)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	rows = append(rows, questionRows...)
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i].Distance < rows[j].Distance })
+	titles := make([]string, len(rows))
+	for i, r := range rows {
+		titles[i] = r.Title
+	}
+	return titles, nil
+}
+
+// cacheSuggestions 写入一条缓存，调用方持有 mu。
+func (s *SearchService) cacheSuggestions(key string, entry suggestEntry) {
+	if len(s.suggestions) >= suggestCacheSize {
+		now := time.Now()
+		for k, e := range s.suggestions {
+			if now.After(e.expiresAt) {
+				delete(s.suggestions, k)
+			}
+		}
+		if len(s.suggestions) >= suggestCacheSize {
+			s.suggestions = make(map[string]suggestEntry)
+		}
+	}
+	s.suggestions[key] = entry
+}
+
+// prefixVariants 返回 keyword 原样、首字母大写和全小写三种写法，去掉重复的。
+func prefixVariants(keyword string) []string {
+	r, size := utf8.DecodeRuneInString(keyword)
+	var out []string
+	for _, v := range []string{keyword, string(unicode.ToUpper(r)) + keyword[size:], strings.ToLower(keyword)} {
+		if !slices.Contains(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
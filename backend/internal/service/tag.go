@@ -0,0 +1,495 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect/sql"
+
+	"backend/internal/ent"
+	"backend/internal/ent/post"
+	"backend/internal/ent/posttag"
+	"backend/internal/ent/question"
+	"backend/internal/ent/questiontag"
+	"backend/internal/ent/tag"
+	"backend/internal/ent/tagalias"
+	"backend/pkg/errors"
+)
+
+const (
+	maxTagsPerItem = 10
+	maxTagLength   = 50
+)
+
+const (
+	defaultTrendingDays = 7
+	maxTrendingDays     = 30
+	maxTrendingTags     = 50
+	// trendingTTL 内重复请求直接使用缓存的统计结果
+	trendingTTL = 5 * time.Minute
+)
+
+type TagService struct {
+	client    *ent.Client
+	posts     *PostService
+	questions *QuestionService
+
+	mu       sync.Mutex
+	trending map[int]trendingEntry
+}
+
+func NewTagService(client *ent.Client, posts *PostService, questions *QuestionService) *TagService {
+	return &TagService{
+		client:    client,
+		posts:     posts,
+		questions: questions,
+		trending:  make(map[int]trendingEntry),
+	}
+}
+
+// TagSummary 是自动补全和热门标签中的一项。
+type TagSummary struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	PostCount     int    `json:"postCount"`
+	QuestionCount int    `json:"questionCount"`
+	// Alias 是命中的别名，按标签名本身命中时为空
+	Alias string `json:"alias,omitempty"`
+	// RecentUses 是热门标签在统计窗口内被使用的次数，自动补全时为 0
+	RecentUses int `json:"recentUses,omitempty"`
+}
+
+type TagDetail struct {
+	ID            int      `json:"id"`
+	Name          string   `json:"name"`
+	PostCount     int      `json:"postCount"`
+	QuestionCount int      `json:"questionCount"`
+	Aliases       []string `json:"aliases"`
+}
+
+type trendingEntry struct {
+	tags      []*TagSummary
+	expiresAt time.Time
+}
+
+// Suggest 返回名称或别名以 prefix 开头的标签，完全匹配的排在最前，其余按使用次数倒序。
+func (s *TagService) Suggest(ctx context.Context, prefix string, limit int) ([]*TagSummary, error) {
+	prefix = NormalizeTagName(prefix)
+	limit = feedLimit(limit)
+	out := []*TagSummary{}
+	if prefix == "" {
+		return out, nil
+	}
+
+	usage := func(s *sql.Selector) {
+		s.OrderBy(sql.Desc(fmt.Sprintf("%s + %s", s.C(tag.FieldPostCount), s.C(tag.FieldQuestionCount))), s.C(tag.FieldName))
+	}
+	tags, err := s.client.Tag.Query().
+		Where(tag.NameHasPrefix(prefix)).
+		Modify(usage).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	aliases, err := s.client.TagAlias.Query().
+		Where(tagalias.NameHasPrefix(prefix)).
+		WithTag().
+		Order(ent.Asc(tagalias.FieldName)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	seen := make(map[int]bool, len(tags)+len(aliases))
+	for _, t := range tags {
+		seen[t.ID] = true
+		out = append(out, toTagSummary(t))
+	}
+	for _, a := range aliases {
+		if a.Edges.Tag == nil || seen[a.TagID] {
+			continue
+		}
+		seen[a.TagID] = true
+		summary := toTagSummary(a.Edges.Tag)
+		summary.Alias = a.Name
+		out = append(out, summary)
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		ei, ej := matchesExactly(out[i], prefix), matchesExactly(out[j], prefix)
+		if ei != ej {
+			return ei
+		}
+		return out[i].PostCount+out[i].QuestionCount > out[j].PostCount+out[j].QuestionCount
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func matchesExactly(t *TagSummary, name string) bool {
+	return t.Name == name || t.Alias == name
+}
+
+// Get 返回标签详情。
+func (s *TagService) Get(ctx context.Context, id int) (*TagDetail, error) {
+	t, err := s.client.Tag.Query().
+		Where(tag.IDEQ(id)).
+		WithAliases(func(q *ent.TagAliasQuery) {
+			q.Order(ent.Asc(tagalias.FieldName))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrTagNotFound
+		}
+		return nil, errors.ErrInternalServer
+	}
+
+	detail := &TagDetail{
+		ID:            t.ID,
+		Name:          t.Name,
+		PostCount:     t.PostCount,
+		QuestionCount: t.QuestionCount,
+		Aliases:       []string{},
+	}
+	for _, a := range t.Edges.Aliases {
+		detail.Aliases = append(detail.Aliases, a.Name)
+	}
+	return detail, nil
+}
+
+// Lookup 按名称查找标签，名称按写入时的规则规范化，命中别名时返回合并后的标签。
+func (s *TagService) Lookup(ctx context.Context, name string) (*TagDetail, error) {
+	name = NormalizeTagName(name)
+	id, err := s.client.Tag.Query().Where(tag.NameEQ(name)).OnlyID(ctx)
+	if ent.IsNotFound(err) {
+		id, err = s.client.TagAlias.Query().
+			Where(tagalias.NameEQ(name)).
+			Select(tagalias.FieldTagID).
+			Int(ctx)
+	}
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrTagNotFound
+		}
+		return nil, errors.ErrInternalServer
+	}
+	return s.Get(ctx, id)
+}
+
+// Posts 按发布时间倒序返回一页带有该标签的文章，viewerID 为 0 表示游客。
+func (s *TagService) Posts(ctx context.Context, viewerID, id int, cursor string, limit int) (*PostFeed, error) {
+	if err := s.checkTag(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.posts.list(ctx, viewerID, cursor, limit, post.HasTagsWith(posttag.TagIDEQ(id)))
+}
+
+// Questions 按发布时间倒序返回一页带有该标签的问题，viewerID 为 0 表示游客。
+func (s *TagService) Questions(ctx context.Context, viewerID, id int, cursor string, limit int) (*QuestionFeed, error) {
+	if err := s.checkTag(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.questions.list(ctx, viewerID, cursor, limit, question.HasTagsWith(questiontag.TagIDEQ(id)))
+}
+
+// Trending 返回最近 days 天内发布的文章和问题中使用最多的标签。
+// 统计结果按天数缓存 trendingTTL，limit 只是从缓存里截取。
+func (s *TagService) Trending(ctx context.Context, days, limit int) ([]*TagSummary, error) {
+	switch {
+	case days <= 0:
+		days = defaultTrendingDays
+	case days > maxTrendingDays:
+		days = maxTrendingDays
+	}
+	limit = feedLimit(limit)
+
+	s.mu.Lock()
+	entry, ok := s.trending[days]
+	s.mu.Unlock()
+	if !ok || time.Now().After(entry.expiresAt) {
+		tags, err := s.countTrending(ctx, time.Now().AddDate(0, 0, -days))
+		if err != nil {
+			return nil, errors.ErrInternalServer
+		}
+		entry = trendingEntry{tags: tags, expiresAt: time.Now().Add(trendingTTL)}
+		s.mu.Lock()
+		s.trending[days] = entry
+		s.mu.Unlock()
+	}
+
+	if len(entry.tags) > limit {
+		return entry.tags[:limit], nil
+	}
+	return entry.tags, nil
+}
+
+// countTrending 统计 since 之后发布的内容上各标签出现的次数，
+// 先用 (create_time, id) 索引圈出时间窗口内的内容，再连接关联表计数。
+func (s *TagService) countTrending(ctx context.Context, since time.Time) ([]*TagSummary, error) {
+	rows, err := s.client.QueryContext(ctx, `
+		SELECT t.id, t.name, t.post_count, t.question_count, r.uses
+		FROM (
+			SELECT u.tag_id, count(*) AS uses
+			FROM (
+				SELECT pt.tag_id FROM post_tags pt JOIN posts p ON p.id = pt.post_id WHERE p.create_time >= $1
+				UNION ALL
+				SELECT qt.tag_id FROM question_tags qt JOIN questions q ON q.id = qt.question_id WHERE q.create_time >= $1
+			) u
+			GROUP BY u.tag_id
+		) r
+		JOIN tags t ON t.id = r.tag_id
+		ORDER BY r.uses DESC, t.post_count + t.question_count DESC, t.id
+		LIMIT $2`, since, maxTrendingTags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := []*TagSummary{}
+	for rows.Next() {
+		t := &TagSummary{}
+		if err := rows.Scan(&t.ID, &t.Name, &t.PostCount, &t.QuestionCount, &t.RecentUses); err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+	return tags, rows.Err()
+}
+
+// Merge 把 sourceID 合并进 targetID：文章、问题上的关联改指向目标标签，
+// 同时带有两个标签的内容只保留一条关联；源标签的名称和别名都成为目标标签的别名，源标签随后删除。
+func (s *TagService) Merge(ctx context.Context, sourceID, targetID int) (*TagDetail, error) {
+	if sourceID == targetID {
+		return nil, errors.ErrTagMergeSelf
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	// 锁住两个标签，并发合并同一个标签时依次执行
+	rows, err := tx.QueryContext(ctx, `SELECT id FROM tags WHERE id IN ($1, $2) ORDER BY id FOR UPDATE`, sourceID, targetID)
+	if err != nil {
+		return nil, txFailed(tx, err)
+	}
+	rows.Close()
+
+	source, err := tx.Tag.Get(ctx, sourceID)
+	if err != nil {
+		return nil, tagFailed(tx, err)
+	}
+	if _, err := tx.Tag.Get(ctx, targetID); err != nil {
+		return nil, tagFailed(tx, err)
+	}
+
+	if _, err := tx.PostTag.Delete().
+		Where(
+			posttag.TagIDEQ(sourceID),
+			posttag.HasPostWith(post.HasTagsWith(posttag.TagIDEQ(targetID))),
+		).
+		Exec(ctx); err != nil {
+		return nil, txFailed(tx, err)
+	}
+	movedPosts, err := tx.PostTag.Update().Where(posttag.TagIDEQ(sourceID)).SetTagID(targetID).Save(ctx)
+	if err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if _, err := tx.QuestionTag.Delete().
+		Where(
+			questiontag.TagIDEQ(sourceID),
+			questiontag.HasQuestionWith(question.HasTagsWith(questiontag.TagIDEQ(targetID))),
+		).
+		Exec(ctx); err != nil {
+		return nil, txFailed(tx, err)
+	}
+	movedQuestions, err := tx.QuestionTag.Update().Where(questiontag.TagIDEQ(sourceID)).SetTagID(targetID).Save(ctx)
+	if err != nil {
+		return nil, txFailed(tx, err)
+	}
+	// 改外键不经过计数 hook，这里手动把移过来的关联数加到目标标签上
+	if err := tx.Tag.UpdateOneID(targetID).
+		AddPostCount(movedPosts).
+		AddQuestionCount(movedQuestions).
+		Exec(ctx); err != nil {
+		return nil, txFailed(tx, err)
+	}
+
+	if err := tx.TagAlias.Update().Where(tagalias.TagIDEQ(sourceID)).SetTagID(targetID).Exec(ctx); err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if err := tx.TagAlias.Create().SetName(source.Name).SetTagID(targetID).Exec(ctx); err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if err := tx.Tag.DeleteOneID(sourceID).Exec(ctx); err != nil {
+		return nil, txFailed(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	return s.Get(ctx, targetID)
+}
+
+func (s *TagService) checkTag(ctx context.Context, id int) error {
+	exists, err := s.client.Tag.Query().Where(tag.IDEQ(id)).Exist(ctx)
+	if err != nil {
+		return errors.ErrInternalServer
+	}
+	if !exists {
+		return errors.ErrTagNotFound
+	}
+	return nil
+}
+
+// tagFailed 回滚事务，标签不存在时返回 ErrTagNotFound。
+func tagFailed(tx *ent.Tx, err error) error {
+	if ent.IsNotFound(err) {
+		_ = tx.Rollback()
+		return errors.ErrTagNotFound
+	}
+	return txFailed(tx, err)
+}
+
+func toTagSummary(t *ent.Tag) *TagSummary {
+	return &TagSummary{
+		ID:            t.ID,
+		Name:          t.Name,
+		PostCount:     t.PostCount,
+		QuestionCount: t.QuestionCount,
+	}
+}
+
+// NormalizeTagName 统一标签的写法：去掉首尾空白和开头的 #，连续空白压成一个空格，字母转小写，
+// 让 "Go"、" go "、"#go" 落到同一个标签上。dbmigrate 中对历史数据做的是同样的转换，cmd/import-legacy 导入旧库标签时也调用它。
+func NormalizeTagName(name string) string {
+	name = strings.TrimLeft(strings.TrimSpace(name), "#")
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// normalizeTagNames 规范化每个标签并去掉空标签和重复标签，保持用户输入的顺序。
+func normalizeTagNames(names []string) []string {
+	seen := make(map[string]bool, len(names))
+	out := make([]string, 0, len(names))
+	for _, name := range names {
+		name = NormalizeTagName(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		out = append(out, name)
+	}
+	return out
+}
+
+// validTagNames 校验规范化后的标签数量和长度。
+func validTagNames(names []string) bool {
+	if len(names) > maxTagsPerItem {
+		return false
+	}
+	for _, name := range names {
+		if utf8.RuneCountInString(name) > maxTagLength {
+			return false
+		}
+	}
+	return true
+}
+
+// upsertTags 在事务内确保标签存在，返回与 names 顺序一致且不重复的标签。
+// 命中别名的名称解析到合并后的标签，不会再创建同名标签。
+// 并发创建同名标签时由 ON CONFLICT DO NOTHING 兜底，随后统一按名称查回。
+func upsertTags(ctx context.Context, tx *ent.Tx, names []string) ([]*ent.Tag, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	aliases, err := tx.TagAlias.Query().Where(tagalias.NameIn(names...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	aliasOf := make(map[string]int, len(aliases))
+	for _, a := range aliases {
+		aliasOf[a.Name] = a.TagID
+	}
+
+	var (
+		creates []*ent.TagCreate
+		plain   []string
+	)
+	for _, name := range names {
+		if _, ok := aliasOf[name]; !ok {
+			creates = append(creates, tx.Tag.Create().SetName(name))
+			plain = append(plain, name)
+		}
+	}
+	if len(creates) > 0 {
+		if err := tx.Tag.CreateBulk(creates...).
+			OnConflictColumns(tag.FieldName).
+			DoNothing().
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	aliasIDs := make([]int, 0, len(aliasOf))
+	for _, id := range aliasOf {
+		aliasIDs = append(aliasIDs, id)
+	}
+	tags, err := tx.Tag.Query().Where(tag.Or(tag.NameIn(plain...), tag.IDIn(aliasIDs...))).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*ent.Tag, len(tags))
+	byID := make(map[int]*ent.Tag, len(tags))
+	for _, t := range tags {
+		byName[t.Name] = t
+		byID[t.ID] = t
+	}
+	seen := make(map[int]bool, len(names))
+	ordered := make([]*ent.Tag, 0, len(names))
+	for _, name := range names {
+		t, ok := byName[name]
+		if id, aliased := aliasOf[name]; aliased {
+			t, ok = byID[id]
+		}
+		if ok && !seen[t.ID] {
+			seen[t.ID] = true
+			ordered = append(ordered, t)
+		}
+	}
+	return ordered, nil
+}
+
+// parentTag 是 withTagNames 查询结果的一行。
+type parentTag struct {
+	ParentID int    `sql:"parent_id"`
+	Name     string `sql:"name"`
+}
+
+// withTagNames 返回作用在 PostTag/QuestionTag 查询上的修饰器，
+// 连接 tags 表直接取出标签名，并按关联创建顺序排列。
+func withTagNames(parentColumn, tagColumn, orderColumn string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		t := sql.Table(tag.Table)
+		s.Join(t).On(s.C(tagColumn), t.C(tag.FieldID))
+		s.Select(sql.As(s.C(parentColumn), "parent_id"), sql.As(t.C(tag.FieldName), "name"))
+		s.OrderBy(s.C(orderColumn))
+	}
+}
+
+func groupTagNames(rows []parentTag) map[int][]string {
+	out := make(map[int][]string)
+	for _, r := range rows {
+		out[r.ParentID] = append(out[r.ParentID], r.Name)
+	}
+	return out
+}
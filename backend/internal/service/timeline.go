@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lib/pq"
+
+	"backend/internal/ent"
+	"backend/internal/ent/follow"
+	"backend/internal/ent/post"
+	"backend/internal/ent/predicate"
+	"backend/internal/ent/question"
+	"backend/internal/ent/user"
+	"backend/pkg/errors"
+)
+
+// 关注动态中的内容类型
+const (
+	TimelinePost     = "post"
+	TimelineQuestion = "question"
+)
+
+// TimelineService 提供关注动态：当前用户关注的人发布的文章和问题。
+type TimelineService struct {
+	client    *ent.Client
+	posts     *PostService
+	questions *QuestionService
+}
+
+func NewTimelineService(client *ent.Client, posts *PostService, questions *QuestionService) *TimelineService {
+	return &TimelineService{client: client, posts: posts, questions: questions}
+}
+
+// TimelineItem 是混合动态中的一项，Type 决定 Post 和 Question 哪个有值。
+type TimelineItem struct {
+	Type     string          `json:"type"`
+	Post     *PostDetail     `json:"post,omitempty"`
+	Question *QuestionDetail `json:"question,omitempty"`
+}
+
+type TimelineFeed struct {
+	Items []*TimelineItem `json:"items"`
+	// NextCursor 为空表示没有更多了
+	NextCursor string `json:"nextCursor"`
+}
+
+// Posts 按发布时间倒序返回一页关注的人发布的文章。
+func (s *TimelineService) Posts(ctx context.Context, userID int, cursor string, limit int) (*PostFeed, error) {
+	ids, err := s.followees(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		if _, err := DecodeCursor(cursor); err != nil {
+			return nil, err
+		}
+		return &PostFeed{PostItems: []*PostDetail{}}, nil
+	}
+	return s.posts.list(ctx, userID, cursor, limit, predicate.Post(authoredBy(ids)))
+}
+
+// Questions 按发布时间倒序返回一页关注的人发布的问题。
+func (s *TimelineService) Questions(ctx context.Context, userID int, cursor string, limit int) (*QuestionFeed, error) {
+	ids, err := s.followees(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		if _, err := DecodeCursor(cursor); err != nil {
+			return nil, err
+		}
+		return &QuestionFeed{QuestionItems: []*QuestionDetail{}}, nil
+	}
+	return s.questions.list(ctx, userID, cursor, limit, predicate.Question(authoredBy(ids)))
+}
+
+// Feed 把关注的人发布的文章和问题按发布时间倒序合并成一条动态。
+// 两张表各按游标取 limit+1 条再归并，每页固定两条列表查询，与关注人数无关。
+func (s *TimelineService) Feed(ctx context.Context, userID int, cursor string, limit int) (*TimelineFeed, error) {
+	after, err := decodeTimelineCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit = feedLimit(limit)
+
+	ids, err := s.followees(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	feed := &TimelineFeed{Items: []*TimelineItem{}}
+	if len(ids) == 0 {
+		return feed, nil
+	}
+
+	postQuery := s.client.Post.Query().Where(predicate.Post(authoredBy(ids))).WithUser()
+	questionQuery := s.client.Question.Query().Where(predicate.Question(authoredBy(ids))).WithUser()
+	if after != nil {
+		postQuery.Where(predicate.Post(after.predicate(timelineRankPost)))
+		questionQuery.Where(predicate.Question(after.predicate(timelineRankQuestion)))
+	}
+	// 各多取一条，归并后用来判断是否还有下一页
+	posts, err := postQuery.
+		Order(ent.Desc(post.FieldCreateTime), ent.Desc(post.FieldID)).
+		Limit(limit + 1).
+		Select(postColumns...).
+		All(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	questions, err := questionQuery.
+		Order(ent.Desc(question.FieldCreateTime), ent.Desc(question.FieldID)).
+		Limit(limit + 1).
+		Select(questionColumns...).
+		All(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	// 归并两条有序列表，顺序与 timelineCursor 的比较规则一致
+	var (
+		order   []timelineCursor
+		pi, qi  int
+		hasMore bool
+	)
+	for pi < len(posts) || qi < len(questions) {
+		if len(order) == limit {
+			hasMore = true
+			break
+		}
+		var next timelineCursor
+		if qi < len(questions) {
+			q := questions[qi]
+			next = timelineCursor{CreateTime: q.CreateTime, Rank: timelineRankQuestion, ID: q.ID}
+		}
+		if pi < len(posts) {
+			p := posts[pi]
+			c := timelineCursor{CreateTime: p.CreateTime, Rank: timelineRankPost, ID: p.ID}
+			if qi == len(questions) || c.before(next) {
+				next = c
+			}
+		}
+		if next.Rank == timelineRankPost {
+			pi++
+		} else {
+			qi++
+		}
+		order = append(order, next)
+	}
+	posts, questions = posts[:pi], questions[:qi]
+	if hasMore {
+		feed.NextCursor = order[len(order)-1].encode()
+	}
+
+	postDetails, err := s.posts.buildDetails(ctx, userID, posts)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	questionDetails, err := s.questions.buildDetails(ctx, userID, questions)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	pi, qi = 0, 0
+	for _, c := range order {
+		if c.Rank == timelineRankPost {
+			feed.Items = append(feed.Items, &TimelineItem{Type: TimelinePost, Post: postDetails[pi]})
+			pi++
+		} else {
+			feed.Items = append(feed.Items, &TimelineItem{Type: TimelineQuestion, Question: questionDetails[qi]})
+			qi++
+		}
+	}
+	return feed, nil
+}
+
+// followees 返回 userID 关注的、账号未被清除的用户。
+func (s *TimelineService) followees(ctx context.Context, userID int) ([]int, error) {
+	ids, err := s.client.Follow.Query().
+		Where(follow.FollowerIDEQ(userID), follow.HasFollowingWith(user.DeletedAtIsNil())).
+		Select(follow.FieldFollowingID).
+		Ints(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	return ids, nil
+}
+
+// authoredBy 限定作者在 ids 之中。ids 作为一个数组参数传给 = ANY，
+// 关注几千人时 SQL 和参数个数都不会随之膨胀。
+func authoredBy(ids []int) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Ident(s.C(post.FieldUserID)).WriteString(" = ANY(").Arg(pq.Array(ids)).WriteString(")")
+		}))
+	}
+}
+
+// 混合动态里发布时间相同时问题排在文章前面，同类内容再按 id 倒序。
+const (
+	timelineRankPost     = 0
+	timelineRankQuestion = 1
+)
+
+// timelineCursor 定位混合动态中的一条内容。两张表的 id 会重复，所以游标里要带上类型。
+type timelineCursor struct {
+	CreateTime time.Time
+	Rank       int
+	ID         int
+}
+
+// before 表示 c 在动态中排在 o 前面。
+func (c timelineCursor) before(o timelineCursor) bool {
+	if !c.CreateTime.Equal(o.CreateTime) {
+		return c.CreateTime.After(o.CreateTime)
+	}
+	if c.Rank != o.Rank {
+		return c.Rank > o.Rank
+	}
+	return c.ID > o.ID
+}
+
+// predicate 返回类型为 rank 的表中排在游标之后的记录。
+func (c timelineCursor) predicate(rank int) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		createTime := s.C(post.FieldCreateTime)
+		switch {
+		case rank < c.Rank:
+			s.Where(sql.LTE(createTime, c.CreateTime))
+		case rank > c.Rank:
+			s.Where(sql.LT(createTime, c.CreateTime))
+		default:
+			s.Where(sql.Or(
+				sql.LT(createTime, c.CreateTime),
+				sql.And(sql.EQ(createTime, c.CreateTime), sql.LT(s.C(post.FieldID), c.ID)),
+			))
+		}
+	}
+}
+
+// encode 与 EncodeCursor 类似，前缀 t 用来区分游标种类。
+func (c timelineCursor) encode() string {
+	raw := fmt.Sprintf("t:%d:%d:%d", c.CreateTime.UnixMicro(), c.Rank, c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeTimelineCursor 解析混合动态的游标，空字符串表示从第一页开始。
+func decodeTimelineCursor(s string) (*timelineCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.ErrInvalidCursor
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 4 || parts[0] != "t" {
+		return nil, errors.ErrInvalidCursor
+	}
+	us, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, errors.ErrInvalidCursor
+	}
+	rank, err := strconv.Atoi(parts[2])
+	if err != nil || (rank != timelineRankPost && rank != timelineRankQuestion) {
+		return nil, errors.ErrInvalidCursor
+	}
+	id, err := strconv.Atoi(parts[3])
+	if err != nil || id <= 0 {
+		return nil, errors.ErrInvalidCursor
+	}
+	return &timelineCursor{CreateTime: time.UnixMicro(us), Rank: rank, ID: id}, nil
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+
+	"backend/internal/dto"
+	"backend/internal/ent"
+	"backend/internal/ent/user"
+	"backend/pkg/errors"
+)
+
+type UserService struct {
+	client *ent.Client
+}
+
+func NewUserService(client *ent.Client) *UserService {
+	return &UserService{client: client}
+}
+
+type RegisterResponse struct {
+	ID       int    `json:"id"`
+	Phone    string `json:"phone"`
+	Nickname string `json:"nickname"`
+}
+
+func (s *UserService) Register(ctx context.Context, req *dto.RegisterRequest) (*RegisterResponse, error) {
+	exists, err := s.client.User.Query().Where(user.PhoneEQ(req.Phone)).Exist(ctx)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+	if exists {
+		return nil, errors.ErrPhoneRegistered
+	}
+
+	hashed, err := hashPassword(req.Password)
+	if err != nil {
+		return nil, errors.ErrInternalServer
+	}
+
+	u, err := s.client.User.Create().
+		SetPhone(req.Phone).
+		SetPassword(hashed).
+		SetNickname(req.Nickname).
+		Save(ctx)
+	if err != nil {
+		// 并发注册同一手机号时，唯一索引兜底
+		if ent.IsConstraintError(err) {
+			return nil, errors.ErrPhoneRegistered
+		}
+		return nil, errors.ErrInternalServer
+	}
+
+	return &RegisterResponse{
+		ID:       u.ID,
+		Phone:    u.Phone,
+		Nickname: u.Nickname,
+	}, nil
+}
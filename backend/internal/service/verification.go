@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+
+	"backend/internal/ent"
+	"backend/internal/ent/user"
+	"backend/internal/ent/verificationcode"
+	"backend/pkg/errors"
+	"backend/pkg/sms"
+)
+
+const (
+	CodePurposeLogin         = "login"
+	CodePurposeResetPassword = "reset_password"
+
+	codeTTL         = 5 * time.Minute
+	codeResendAfter = 60 * time.Second
+	codeMaxAttempts = 5
+	codeHourlyLimit = 10
+	// 同一 IP 每小时最多请求的验证码数，不分手机号和用途，防止换着号码刷短信
+	codeIPHourlyLimit = 30
+)
+
+type VerificationService struct {
+	client *ent.Client
+	sender sms.Sender
+	secret []byte
+}
+
+func NewVerificationService(client *ent.Client, sender sms.Sender, secret string) *VerificationService {
+	return &VerificationService{
+		client: client,
+		sender: sender,
+		secret: []byte(secret),
+	}
+}
+
+// SendCode 生成六位验证码并通过短信下发。
+// 手机号未注册时同样返回成功但不发短信，避免接口被用来探测手机号是否注册。
+// ip 为空时只按手机号限流。
+func (s *VerificationService) SendCode(ctx context.Context, phone, purpose, ip string) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return errors.ErrInternalServer
+	}
+	// 按手机号和 IP 串行化，否则并发请求都会在对方落库前通过冷却检查
+	if err := advisoryLock(ctx, tx, "sms:phone:"+phone); err != nil {
+		return txFailed(tx, err)
+	}
+	if ip != "" {
+		if err := advisoryLock(ctx, tx, "sms:ip:"+ip); err != nil {
+			return txFailed(tx, err)
+		}
+	}
+
+	now := time.Now()
+	recent, err := tx.VerificationCode.Query().
+		Where(
+			verificationcode.PhoneEQ(phone),
+			verificationcode.PurposeEQ(verificationcode.Purpose(purpose)),
+			verificationcode.CreateTimeGT(now.Add(-time.Hour)),
+		).
+		Order(ent.Desc(verificationcode.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return txFailed(tx, err)
+	}
+	if len(recent) > 0 && now.Sub(recent[0].CreateTime) < codeResendAfter {
+		return rollback(tx, errors.ErrCodeCooldown)
+	}
+	if len(recent) >= codeHourlyLimit {
+		return rollback(tx, errors.ErrCodeCooldown)
+	}
+	if ip != "" {
+		sent, err := tx.VerificationCode.Query().
+			Where(
+				verificationcode.IPEQ(ip),
+				verificationcode.CreateTimeGT(now.Add(-time.Hour)),
+			).
+			Count(ctx)
+		if err != nil {
+			return txFailed(tx, err)
+		}
+		if sent >= codeIPHourlyLimit {
+			return rollback(tx, errors.ErrCodeCooldown)
+		}
+	}
+
+	registered, err := tx.User.Query().Where(user.PhoneEQ(phone)).Exist(ctx)
+	if err != nil {
+		return txFailed(tx, err)
+	}
+
+	code, err := randomCode()
+	if err != nil {
+		return txFailed(tx, err)
+	}
+
+	// 未注册也落库，这样冷却时间对两种情况表现一致
+	err = tx.VerificationCode.Create().
+		SetPhone(phone).
+		SetIP(ip).
+		SetPurpose(verificationcode.Purpose(purpose)).
+		SetCodeHash(s.hashCode(phone, purpose, code)).
+		SetExpiresAt(now.Add(codeTTL)).
+		Exec(ctx)
+	if err != nil {
+		return txFailed(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.ErrInternalServer
+	}
+
+	if !registered {
+		return nil
+	}
+
+	message := fmt.Sprintf("【Quibli】您的验证码是 %s，%d 分钟内有效，请勿泄露给他人。", code, int(codeTTL.Minutes()))
+	if err := s.sender.Send(ctx, phone, message); err != nil {
+		return errors.ErrInternalServer
+	}
+	return nil
+}
+
+// VerifyCode 校验并消费验证码，只认该手机号最近一次下发的验证码。
+func (s *VerificationService) VerifyCode(ctx context.Context, phone, purpose, code string) error {
+	vc, err := s.client.VerificationCode.Query().
+		Where(
+			verificationcode.PhoneEQ(phone),
+			verificationcode.PurposeEQ(verificationcode.Purpose(purpose)),
+		).
+		Order(ent.Desc(verificationcode.FieldCreateTime)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.ErrCodeInvalid
+		}
+		return errors.ErrInternalServer
+	}
+	if vc.ConsumedAt != nil || time.Now().After(vc.ExpiresAt) {
+		return errors.ErrCodeInvalid
+	}
+
+	// 先原子地占用一次尝试机会，再比对，防止并发请求绕过次数限制
+	n, err := s.client.VerificationCode.Update().
+		Where(
+			verificationcode.IDEQ(vc.ID),
+			verificationcode.AttemptsLT(codeMaxAttempts),
+		).
+		AddAttempts(1).
+		Save(ctx)
+	if err != nil {
+		return errors.ErrInternalServer
+	}
+	if n == 0 {
+		return errors.ErrCodeTooManyAttempts
+	}
+
+	expected := s.hashCode(phone, purpose, code)
+	if !hmac.Equal([]byte(expected), []byte(vc.CodeHash)) {
+		return errors.ErrCodeInvalid
+	}
+
+	n, err = s.client.VerificationCode.Update().
+		Where(
+			verificationcode.IDEQ(vc.ID),
+			verificationcode.ConsumedAtIsNil(),
+		).
+		SetConsumedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return errors.ErrInternalServer
+	}
+	if n == 0 {
+		return errors.ErrCodeInvalid
+	}
+	return nil
+}
+
+func (s *VerificationService) hashCode(phone, purpose, code string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(phone + ":" + purpose + ":" + code))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func randomCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// advisoryLock 在事务内取得 key 对应的 Postgres 事务级咨询锁，提交或回滚时自动释放。
+func advisoryLock(ctx context.Context, tx *ent.Tx, key string) error {
+	_, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", key)
+	return err
+}
@@ -2,19 +2,34 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"log"
+	"time"
 
 	"backend/internal/config"
+	"backend/internal/dbmigrate"
 	"backend/internal/ent"
 	"backend/internal/handler"
+	"backend/internal/loginguard"
+	"backend/internal/middleware"
+	"backend/internal/pgvector"
 	"backend/internal/router"
 	"backend/internal/service"
+	"backend/pkg/embedding"
+	"backend/pkg/jwt"
+	"backend/pkg/sms"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/app/server"
 	"github.com/cloudwego/hertz/pkg/common/utils"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 	_ "github.com/lib/pq"
+
+	// 注册 schema 中的默认值与 hook
+	_ "backend/internal/ent/runtime"
 )
 
 func main() {
@@ -23,24 +38,88 @@ func main() {
 	}
 
 	dbDSN := config.GetDBDSN()
-	client, err := ent.Open("postgres", dbDSN)
+	db, err := sql.Open("postgres", dbDSN)
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
 	defer client.Close()
 
+	if err := dbmigrate.BeforeSchema(context.Background(), db); err != nil {
+		log.Fatalf("Failed to migrate data: %v", err)
+	}
 	if err := client.Schema.Create(context.Background()); err != nil {
 		log.Fatalf("Failed to create schema: %v", err)
 	}
 
-	authService := service.NewAuthService(client)
-	authHandler := handler.NewAuthHandler(authService)
-	router := router.NewRouter(authHandler)
+	jwtMgr, err := newJWTManager(config.AppConfig)
+	if err != nil {
+		log.Fatalf("Failed to load JWT keys: %v", err)
+	}
+
+	smsSender := sms.NewLogSender(config.AppConfig.SMSLogFile)
+
+	embedder, err := newEmbedder(config.AppConfig)
+	if err != nil {
+		log.Fatalf("Failed to set up embedding: %v", err)
+	}
+	if embedder == nil {
+		log.Printf("Embedding disabled (EMBEDDING_PROVIDER=none); search and suggestions use keyword matching only")
+	}
+
+	var attemptStore loginguard.AttemptStore = loginguard.NewEntStore(client)
+	if config.AppConfig.LoginGuardStore == "memory" {
+		attemptStore = loginguard.NewMemoryStore()
+	}
+
+	codeService := service.NewVerificationService(client, smsSender, config.AppConfig.SMSCodeSecret)
+	authService := service.NewAuthService(client, jwtMgr, codeService, loginguard.New(attemptStore))
+	userService := service.NewUserService(client)
+	go runAccountPurge(context.Background(), userService)
+	embeddingService := service.NewEmbeddingService(client, embedder)
+	go embeddingService.Run(context.Background())
+	go runEmbeddingBackfill(context.Background(), embeddingService)
+	postService := service.NewPostService(client, embeddingService)
+	questionService := service.NewQuestionService(client, embeddingService)
+	commentService := service.NewCommentService(client, config.AppConfig.CommentMaxDepth)
+	answerService := service.NewAnswerService(client)
+	timelineService := service.NewTimelineService(client, postService, questionService)
+	tagService := service.NewTagService(client, postService, questionService)
+	searchService := service.NewSearchService(client, postService, questionService, tagService, embeddingService)
+	authHandler := handler.NewAuthHandler(authService, codeService)
+	userHandler := handler.NewUserHandler(userService)
+	jwksHandler := handler.NewJWKSHandler(jwtMgr)
+	postHandler := handler.NewPostHandler(postService)
+	questionHandler := handler.NewQuestionHandler(questionService)
+	commentHandler := handler.NewCommentHandler(commentService)
+	answerHandler := handler.NewAnswerHandler(answerService)
+	timelineHandler := handler.NewTimelineHandler(timelineService)
+	tagHandler := handler.NewTagHandler(tagService)
+	searchHandler := handler.NewSearchHandler(searchService)
+	router := router.NewRouter(
+		authHandler,
+		userHandler,
+		jwksHandler,
+		postHandler,
+		questionHandler,
+		commentHandler,
+		answerHandler,
+		timelineHandler,
+		tagHandler,
+		searchHandler,
+		middleware.JWT(jwtMgr, authService),
+		middleware.OptionalJWT(jwtMgr, authService),
+	)
 
 	h := server.Default(
 		server.WithHostPorts(config.AppConfig.ServerPort),
 		server.WithMaxRequestBodySize(10<<20),
 	)
+	// 默认信任任意来源的 X-Forwarded-For，改为只信任配置的代理，登录和验证码的按 IP 限流依赖它
+	h.SetClientIPFunc(app.ClientIPWithOption(app.ClientIPOptions{
+		RemoteIPHeaders: []string{"X-Forwarded-For", "X-Real-IP"},
+		TrustedCIDRs:    config.AppConfig.TrustedProxies,
+	}))
 
 	h.Use(func(ctx context.Context, c *app.RequestContext) {
 		c.Header("Access-Control-Allow-Origin", "*")
@@ -63,3 +142,93 @@ func main() {
 
 	h.Spin()
 }
+
+// runAccountPurge 定期清除注销宽限期已过的账号。
+func runAccountPurge(ctx context.Context, userService *service.UserService) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		n, err := userService.PurgeDeletedAccounts(ctx)
+		if err != nil {
+			log.Printf("Failed to purge deleted accounts: %v", err)
+		} else if n > 0 {
+			log.Printf("Purged %d deleted accounts", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runEmbeddingBackfill 定期把缺少 embedding 的文章和问题放进计算队列，
+// 覆盖入队时队列已满、计算失败、服务重启丢失队列以及导入的历史数据。
+func runEmbeddingBackfill(ctx context.Context, embeddingService *service.EmbeddingService) {
+	ticker := time.NewTicker(10 * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		n, err := embeddingService.Backfill(ctx)
+		if err != nil {
+			log.Printf("Failed to backfill embeddings: %v", err)
+		} else if n > 0 {
+			log.Printf("Queued %d items for embedding", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// newEmbedder 按 EMBEDDING_PROVIDER 创建 Embedder，none 时返回 nil，不计算 embedding。
+// 模型输出的维度必须与 embedding 列一致，hash 直接按列的维度生成。
+func newEmbedder(cfg *config.Config) (embedding.Embedder, error) {
+	var e embedding.Embedder
+	switch cfg.EmbeddingProvider {
+	case "none":
+		return nil, nil
+	case "hash":
+		e = embedding.NewHashEmbedder(pgvector.Dimensions)
+	default:
+		if cfg.EmbeddingBaseURL == "" || cfg.EmbeddingModel == "" {
+			return nil, fmt.Errorf("EMBEDDING_BASE_URL and EMBEDDING_MODEL are required")
+		}
+		e = embedding.NewOpenAIEmbedder(cfg.EmbeddingBaseURL, cfg.EmbeddingAPIKey, cfg.EmbeddingModel, pgvector.Dimensions)
+	}
+	if cfg.EmbeddingCacheSize > 0 {
+		e = embedding.NewCachedEmbedder(e, cfg.EmbeddingCacheSize)
+	}
+	return e, nil
+}
+
+// newJWTManager 未配置密钥目录时沿用 HS256；否则用 JWT_SIGNING_KID 对应的私钥签名，
+// 目录里的其余密钥只用于验签，轮换密钥时把旧私钥换成公钥文件即可。
+func newJWTManager(cfg *config.Config) (*jwt.Manager, error) {
+	if cfg.JWTKeysDir == "" {
+		return jwt.NewManager(cfg.JWTSecret), nil
+	}
+
+	keys, err := jwt.LoadKeyDir(cfg.JWTKeysDir)
+	if err != nil {
+		return nil, err
+	}
+	signingKey, ok := keys[cfg.JWTSigningKeyID]
+	if !ok {
+		return nil, fmt.Errorf("signing key %q not found in %s", cfg.JWTSigningKeyID, cfg.JWTKeysDir)
+	}
+
+	verifyKeys := make([]*jwt.Key, 0, len(keys)+1)
+	for _, k := range keys {
+		verifyKeys = append(verifyKeys, k)
+	}
+	if cfg.JWTLegacyHS256 {
+		verifyKeys = append(verifyKeys, jwt.NewHMACKey("", cfg.JWTSecret))
+	}
+	return jwt.NewKeyManager(signingKey, verifyKeys...)
+}
@@ -0,0 +1,97 @@
+package embedding
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"sync"
+)
+
+// CachedEmbedder 按文本内容的 SHA-256 缓存 next 的结果，超过 size 条时淘汰最久未用的。
+// 热门搜索词、未改动正文的重新计算都不会再请求模型服务。
+type CachedEmbedder struct {
+	next Embedder
+	size int
+
+	mu    sync.Mutex
+	items map[[sha256.Size]byte]*list.Element
+	// order 的表头是最近用过的
+	order *list.List
+}
+
+type cacheEntry struct {
+	key    [sha256.Size]byte
+	vector []float32
+}
+
+func NewCachedEmbedder(next Embedder, size int) *CachedEmbedder {
+	return &CachedEmbedder{
+		next:  next,
+		size:  size,
+		items: make(map[[sha256.Size]byte]*list.Element),
+		order: list.New(),
+	}
+}
+
+func (e *CachedEmbedder) Dimensions() int {
+	return e.next.Dimensions()
+}
+
+// Embed 只把没有命中缓存的文本交给 next，同一批里重复的文本也只算一次。
+func (e *CachedEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	out := make([][]float32, len(texts))
+	// missTexts 是去重后未命中的文本，missAt 记录它们在 texts 中出现的位置
+	var (
+		missTexts []string
+		missAt    = make(map[[sha256.Size]byte][]int)
+	)
+
+	e.mu.Lock()
+	for i, text := range texts {
+		key := sha256.Sum256([]byte(text))
+		if el, ok := e.items[key]; ok {
+			e.order.MoveToFront(el)
+			out[i] = el.Value.(*cacheEntry).vector
+			continue
+		}
+		if _, ok := missAt[key]; !ok {
+			missTexts = append(missTexts, text)
+		}
+		missAt[key] = append(missAt[key], i)
+	}
+	e.mu.Unlock()
+
+	if len(missTexts) == 0 {
+		return out, nil
+	}
+	vectors, err := e.next.Embed(ctx, missTexts)
+	if err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for j, text := range missTexts {
+		key := sha256.Sum256([]byte(text))
+		for _, i := range missAt[key] {
+			out[i] = vectors[j]
+		}
+		e.add(key, vectors[j])
+	}
+	return out, nil
+}
+
+// add 写入一条缓存，调用方持有 mu。
+func (e *CachedEmbedder) add(key [sha256.Size]byte, vector []float32) {
+	if el, ok := e.items[key]; ok {
+		e.order.MoveToFront(el)
+		el.Value.(*cacheEntry).vector = vector
+		return
+	}
+	e.items[key] = e.order.PushFront(&cacheEntry{key: key, vector: vector})
+	for e.order.Len() > e.size {
+		oldest := e.order.Back()
+		e.order.Remove(oldest)
+		delete(e.items, oldest.Value.(*cacheEntry).key)
+	}
+}
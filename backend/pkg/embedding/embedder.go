@@ -0,0 +1,135 @@
+// Package embedding 把文本转换成向量，供语义搜索使用。
+package embedding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Embedder 计算一组文本的向量，返回值与 texts 一一对应，每个向量的长度都是 Dimensions()。
+// 接入新的模型服务时实现该接口即可。返回的切片可能被缓存共享，调用方不要修改。
+type Embedder interface {
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+	Dimensions() int
+}
+
+// openAIMaxBatch 是一次请求最多提交的文本数，DashScope 兼容模式的上限是 10。
+const openAIMaxBatch = 10
+
+// OpenAIEmbedder 调用兼容 OpenAI /embeddings 接口的服务，
+// 包括 DashScope 的兼容模式以及 Ollama、vLLM 等本地服务。
+type OpenAIEmbedder struct {
+	baseURL string
+	apiKey  string
+	model   string
+	dim     int
+	client  *http.Client
+}
+
+// NewOpenAIEmbedder baseURL 不含 /embeddings，例如 https://dashscope.aliyuncs.com/compatible-mode/v1；
+// apiKey 为空时不发送 Authorization 头。dim 必须与模型输出的维度一致。
+func NewOpenAIEmbedder(baseURL, apiKey, model string, dim int) *OpenAIEmbedder {
+	return &OpenAIEmbedder{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		apiKey:  apiKey,
+		model:   model,
+		dim:     dim,
+		client:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (e *OpenAIEmbedder) Dimensions() int {
+	return e.dim
+}
+
+func (e *OpenAIEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	out := make([][]float32, 0, len(texts))
+	for start := 0; start < len(texts); start += openAIMaxBatch {
+		end := min(start+openAIMaxBatch, len(texts))
+		vectors, err := e.request(ctx, texts[start:end])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, vectors...)
+	}
+	return out, nil
+}
+
+type openAIRequest struct {
+	Model          string   `json:"model"`
+	Input          []string `json:"input"`
+	EncodingFormat string   `json:"encoding_format"`
+}
+
+type openAIResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+	// DashScope 原生接口的错误信息在顶层
+	Message string `json:"message"`
+}
+
+func (e *OpenAIEmbedder) request(ctx context.Context, texts []string) ([][]float32, error) {
+	body, err := json.Marshal(openAIRequest{Model: e.model, Input: texts, EncodingFormat: "float"})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.baseURL+"/embeddings", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if e.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+e.apiKey)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("embedding: %w", err)
+	}
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, 64<<20))
+	if err != nil {
+		return nil, fmt.Errorf("embedding: %w", err)
+	}
+
+	var result openAIResponse
+	if err := json.Unmarshal(raw, &result); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("embedding: %s", resp.Status)
+		}
+		return nil, fmt.Errorf("embedding: invalid response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK || result.Error != nil {
+		msg := result.Message
+		if result.Error != nil {
+			msg = result.Error.Message
+		}
+		return nil, fmt.Errorf("embedding: %s: %s", resp.Status, msg)
+	}
+
+	if len(result.Data) != len(texts) {
+		return nil, fmt.Errorf("embedding: got %d vectors for %d texts", len(result.Data), len(texts))
+	}
+	vectors := make([][]float32, len(texts))
+	for _, d := range result.Data {
+		if d.Index < 0 || d.Index >= len(texts) || vectors[d.Index] != nil {
+			return nil, fmt.Errorf("embedding: unexpected index %d", d.Index)
+		}
+		if len(d.Embedding) != e.dim {
+			return nil, fmt.Errorf("embedding: model %s returned %d dimensions, want %d", e.model, len(d.Embedding), e.dim)
+		}
+		vectors[d.Index] = d.Embedding
+	}
+	return vectors, nil
+}
@@ -0,0 +1,94 @@
+package embedding
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// HashEmbedder 不调用任何模型，把词散列到固定维度上得到向量，用于测试和离线开发。
+// 同一段文本总是得到同一个向量，共享词语越多的文本越相似，但没有任何语义理解能力。
+// 英文等按单词切分，中文取单字和相邻两字。
+type HashEmbedder struct {
+	dim int
+}
+
+func NewHashEmbedder(dim int) *HashEmbedder {
+	return &HashEmbedder{dim: dim}
+}
+
+func (e *HashEmbedder) Dimensions() int {
+	return e.dim
+}
+
+func (e *HashEmbedder) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	out := make([][]float32, len(texts))
+	for i, text := range texts {
+		out[i] = e.embed(text)
+	}
+	return out, nil
+}
+
+// embed 对每个词取 FNV-1a 散列，低位决定落在哪一维，最高位决定加还是减，最后归一化成单位向量。
+// 没有任何词的文本得到零向量。
+func (e *HashEmbedder) embed(text string) []float32 {
+	v := make([]float32, e.dim)
+	for _, token := range tokenize(text) {
+		h := fnv.New64a()
+		h.Write([]byte(token))
+		sum := h.Sum64()
+		if sum>>63 == 1 {
+			v[sum%uint64(e.dim)]--
+		} else {
+			v[sum%uint64(e.dim)]++
+		}
+	}
+
+	var norm float64
+	for _, x := range v {
+		norm += float64(x) * float64(x)
+	}
+	if norm == 0 {
+		return v
+	}
+	scale := float32(1 / math.Sqrt(norm))
+	for i := range v {
+		v[i] *= scale
+	}
+	return v
+}
+
+func tokenize(text string) []string {
+	var (
+		tokens []string
+		word   strings.Builder
+		prev   rune
+	)
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, word.String())
+			word.Reset()
+		}
+	}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flush()
+			tokens = append(tokens, string(r))
+			if prev != 0 {
+				tokens = append(tokens, string([]rune{prev, r}))
+			}
+			prev = r
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word.WriteRune(r)
+		default:
+			flush()
+		}
+		prev = 0
+	}
+	flush()
+	return tokens
+}
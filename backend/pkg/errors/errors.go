@@ -17,10 +17,29 @@ func New(code int, message string) *AppError {
 }
 
 var (
-	ErrUnauthorized       = New(401, "Unauthorized")
-	ErrBadRequest         = New(400, "Bad Request")
-	ErrNotFound           = New(404, "Not Found")
-	ErrInternalServer     = New(500, "Internal Server Error")
-	ErrInvalidCredentials = New(401, "用户名或密码错误")
-	ErrTokenExpired       = New(401, "Refresh Token 已失效，请重新登录")
+	ErrUnauthorized        = New(401, "Unauthorized")
+	ErrBadRequest          = New(400, "Bad Request")
+	ErrForbidden           = New(403, "权限不足")
+	ErrNotFound            = New(404, "Not Found")
+	ErrInternalServer      = New(500, "Internal Server Error")
+	ErrInvalidCredentials  = New(401, "用户名或密码错误")
+	ErrLoginLocked         = New(423, "登录失败次数过多，请15分钟后再试")
+	ErrTokenExpired        = New(401, "Refresh Token 已失效，请重新登录")
+	ErrTokenReused         = New(401, "登录状态异常，请重新登录")
+	ErrTokenInvalid        = New(401, "Access Token 无效或已过期")
+	ErrPhoneRegistered     = New(409, "该手机号已注册")
+	ErrCodeInvalid         = New(400, "验证码错误或已过期")
+	ErrCodeCooldown        = New(429, "验证码发送过于频繁，请稍后再试")
+	ErrCodeTooManyAttempts = New(429, "验证码错误次数过多，请重新获取")
+	ErrUserNotFound        = New(404, "用户不存在")
+	ErrFollowSelf          = New(400, "不能关注自己")
+	ErrPostNotFound        = New(404, "文章不存在")
+	ErrQuestionNotFound    = New(404, "问题不存在")
+	ErrCommentNotFound     = New(404, "评论不存在")
+	ErrAnswerNotFound      = New(404, "回答不存在")
+	ErrVoteOwnAnswer       = New(400, "不能给自己的回答投票")
+	ErrInvalidCursor       = New(400, "分页游标无效")
+	ErrInvalidTags         = New(400, "标签最多10个，每个不超过50个字")
+	ErrTagNotFound         = New(404, "标签不存在")
+	ErrTagMergeSelf        = New(400, "不能把标签合并到自身")
 )
@@ -1,86 +1,205 @@
 package jwt
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+
+	AccessTokenTTL  = 15 * time.Minute
+	RefreshTokenTTL = 7 * 24 * time.Hour
+)
+
+var ErrWrongTokenType = errors.New("unexpected token type")
+
 type Claims struct {
-	UserID string `json:"sub"`
-	Phone  string `json:"name"`
+	UserID    string `json:"sub"`
+	Phone     string `json:"name"`
+	TokenType string `json:"typ"`
+	// SessionID 标识签发该 token 的登录会话，会话被吊销后它名下的 token 一律失效
+	SessionID int `json:"sid"`
+	// Role 与 Permissions 在签发时从数据库读取，角色变更在下一次刷新后生效
+	Role        string   `json:"role,omitempty"`
+	Permissions []string `json:"perms,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// Subject 描述 token 所代表的用户及其会话。
+type Subject struct {
+	UserID      string
+	Phone       string
+	SessionID   int
+	Role        string
+	Permissions []string
+}
+
 type TokenPair struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
+
+	// 以下字段供服务端持久化 refresh token 使用，不返回给前端
+	RefreshID        string    `json:"-"`
+	RefreshExpiresAt time.Time `json:"-"`
 }
 
 type Manager struct {
-	secretKey string
+	signingKey *Key
+	// verifyKeys 按 kid 索引，轮换密钥时旧公钥留在这里直到它签发的 token 全部过期
+	verifyKeys map[string]*Key
 }
 
+// NewManager 使用 HS256 对称密钥签发和校验 token，token 不带 kid。
 func NewManager(secretKey string) *Manager {
-	return &Manager{secretKey: secretKey}
+	key := NewHMACKey("", secretKey)
+	return &Manager{
+		signingKey: key,
+		verifyKeys: map[string]*Key{key.ID: key},
+	}
 }
 
-func (m *Manager) GenerateTokens(userID, phone string) (*TokenPair, error) {
+// NewKeyManager 使用 signingKey 签发 token，并接受 verifyKeys 中任意一把密钥签发的 token。
+// signingKey 本身总是可以验签，无需重复传入。
+func NewKeyManager(signingKey *Key, verifyKeys ...*Key) (*Manager, error) {
+	if signingKey == nil || !signingKey.CanSign() {
+		return nil, errors.New("signing key must contain a private key")
+	}
+
+	m := &Manager{
+		signingKey: signingKey,
+		verifyKeys: map[string]*Key{signingKey.ID: signingKey},
+	}
+	for _, k := range verifyKeys {
+		if existing, ok := m.verifyKeys[k.ID]; ok && existing != k {
+			return nil, fmt.Errorf("duplicate key id %q", k.ID)
+		}
+		m.verifyKeys[k.ID] = k
+	}
+	return m, nil
+}
+
+func (m *Manager) GenerateTokens(sub Subject) (*TokenPair, error) {
 	now := time.Now()
 	nowNumeric := jwt.NewNumericDate(now)
-	expireNumeric := jwt.NewNumericDate(now.Add(15 * time.Minute))
+	expireNumeric := jwt.NewNumericDate(now.Add(AccessTokenTTL))
 
 	claims := Claims{
-		UserID: userID,
-		Phone:  phone,
+		UserID:      sub.UserID,
+		Phone:       sub.Phone,
+		TokenType:   TokenTypeAccess,
+		SessionID:   sub.SessionID,
+		Role:        sub.Role,
+		Permissions: sub.Permissions,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        newID(),
 			ExpiresAt: expireNumeric,
 			IssuedAt:  nowNumeric,
 		},
 	}
 
-	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(m.secretKey))
+	accessToken, err := m.sign(claims)
 	if err != nil {
 		return nil, err
 	}
 
-	refreshExpireNumeric := jwt.NewNumericDate(now.Add(7 * 24 * time.Hour))
+	refreshExpiresAt := now.Add(RefreshTokenTTL)
+	// refresh token 不携带角色，刷新时总会从数据库重新读取
 	refreshClaims := Claims{
-		UserID: userID,
-		Phone:  phone,
+		UserID:    sub.UserID,
+		Phone:     sub.Phone,
+		TokenType: TokenTypeRefresh,
+		SessionID: sub.SessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: refreshExpireNumeric,
+			ID:        newID(),
+			ExpiresAt: jwt.NewNumericDate(refreshExpiresAt),
 			IssuedAt:  nowNumeric,
 		},
 	}
 
-	refreshToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, refreshClaims).SignedString([]byte(m.secretKey))
+	refreshToken, err := m.sign(refreshClaims)
 	if err != nil {
 		return nil, err
 	}
 
 	return &TokenPair{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		AccessToken:      accessToken,
+		RefreshToken:     refreshToken,
+		RefreshID:        refreshClaims.ID,
+		RefreshExpiresAt: refreshExpiresAt,
 	}, nil
 }
 
-func (m *Manager) ValidateToken(tokenString string) (*Claims, error) {
+// ValidateAccessToken 校验 access token，refresh token 会被拒绝。
+func (m *Manager) ValidateAccessToken(tokenString string) (*Claims, error) {
+	claims, err := m.validate(tokenString, TokenTypeAccess)
+	if err != nil {
+		return nil, err
+	}
+	if claims.SessionID == 0 {
+		return nil, errors.New("invalid token")
+	}
+	return claims, nil
+}
+
+// ValidateRefreshToken 校验 refresh token，access token 会被拒绝。
+func (m *Manager) ValidateRefreshToken(tokenString string) (*Claims, error) {
+	claims, err := m.validate(tokenString, TokenTypeRefresh)
+	if err != nil {
+		return nil, err
+	}
+	if claims.ID == "" || claims.SessionID == 0 {
+		return nil, errors.New("invalid token")
+	}
+	return claims, nil
+}
+
+func (m *Manager) sign(claims Claims) (string, error) {
+	token := jwt.NewWithClaims(m.signingKey.Method, claims)
+	if m.signingKey.ID != "" {
+		token.Header["kid"] = m.signingKey.ID
+	}
+	return token.SignedString(m.signingKey.signKey)
+}
+
+func (m *Manager) validate(tokenString, tokenType string) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := m.verifyKeys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		// 算法必须与 kid 对应的密钥一致，防止 alg 混淆攻击
+		if token.Method.Alg() != key.Method.Alg() {
 			return nil, errors.New("unexpected signing method")
 		}
-		return []byte(m.secretKey), nil
+		return key.verifyKey, nil
 	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
-		return claims, nil
+	claims, ok := token.Claims.(*Claims)
+	if !ok || !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+	if claims.TokenType != tokenType {
+		return nil, ErrWrongTokenType
 	}
+	return claims, nil
+}
 
-	return nil, errors.New("invalid token")
+func newID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
 }
@@ -27,6 +27,10 @@ func Unauthorized(message string) *Response {
 	return Error(http.StatusUnauthorized, message)
 }
 
+func Forbidden(message string) *Response {
+	return Error(http.StatusForbidden, message)
+}
+
 func BadRequest(message string) *Response {
 	return Error(http.StatusBadRequest, message)
 }
@@ -38,3 +42,11 @@ func NotFound(message string) *Response {
 func InternalServerError(message string) *Response {
 	return Error(http.StatusInternalServerError, message)
 }
+
+func Conflict(message string) *Response {
+	return Error(http.StatusConflict, message)
+}
+
+func TooManyRequests(message string) *Response {
+	return Error(http.StatusTooManyRequests, message)
+}
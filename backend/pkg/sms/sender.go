@@ -0,0 +1,45 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// Sender 负责把短信投递给手机号，接入真实短信网关时实现该接口即可。
+type Sender interface {
+	Send(ctx context.Context, phone, message string) error
+}
+
+// LogSender 不真正发短信，而是把内容写进日志或文件，用于本地开发和离线测试。
+type LogSender struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewLogSender path 为空时输出到标准日志，否则追加写入该文件。
+func NewLogSender(path string) *LogSender {
+	return &LogSender{path: path}
+}
+
+func (s *LogSender) Send(_ context.Context, phone, message string) error {
+	if s.path == "" {
+		log.Printf("[sms] to=%s message=%q", phone, message)
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintf(f, "%s\t%s\t%s\n", time.Now().Format(time.RFC3339), phone, message)
+	return err
+}